@@ -0,0 +1,278 @@
+// Package main implements the jsonschemadoc CLI tool, which renders a JSON
+// Schema file as Markdown or HTML reference documentation (see
+// [schemadoc.Render]).
+//
+// Usage:
+//
+//	//go:generate go run go.jacobcolvin.com/x/jsonschema/cmd/jsonschemadoc -o CONFIG.md config.schema.json
+//
+// The schema may be any JSON Schema document: one jsonschemagen writes, or a
+// Helm chart's values.schema.json from magicschema. A file argument of "-"
+// reads the schema from standard input. Properties are documented in the
+// order the file lists them.
+//
+// The format is -format's, "markdown" or "html", or without it, "html" for
+// an -o path ending in .html or .htm and "markdown" otherwise. Each -template
+// file is parsed over the format's default templates, so a {{define}} in it
+// replaces one part of the output:
+//
+//	jsonschemadoc -template property-table.tmpl -o values.md values.schema.json
+//
+// With -check, nothing is written: the tool renders into memory, compares
+// the result with the -o file, and prints a unified diff when they differ,
+// exiting non-zero. A CI step running the //go:generate line with -check
+// catches documentation that has drifted from its schema.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonptr"
+	"go.jacobcolvin.com/x/jsonschema/schemadoc"
+)
+
+type config struct {
+	Input     string
+	Output    string
+	Format    string
+	Title     string
+	Templates []string
+	Check     bool
+}
+
+func main() {
+	cfg := config{}
+
+	flag.StringVar(&cfg.Output, "o", "", "output file path (default: stdout)")
+	flag.StringVar(&cfg.Format, "format", "", `output format: "markdown" or "html" (default from -o, else "markdown")`)
+	flag.StringVar(&cfg.Title, "title", "", "document title (default: the schema's title)")
+	flag.Func("template", "template file parsed over the default templates; repeatable", func(path string) error {
+		cfg.Templates = append(cfg.Templates, path)
+
+		return nil
+	})
+	flag.BoolVar(&cfg.Check, "check", false, "compare the output with the -o file instead of writing it")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: jsonschemadoc [flags] schema.json\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg.Input = flag.Arg(0)
+
+	err := run(cfg, os.Stdin, os.Stdout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "jsonschemadoc: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(cfg config, stdin io.Reader, stdout io.Writer) error {
+	format, err := outputFormat(cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.Check && cfg.Output == "" {
+		return errors.New("-check requires -o")
+	}
+
+	var data []byte
+	if cfg.Input == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(cfg.Input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("read schema: %w", err)
+	}
+
+	schema, err := jsonschema.ParseSchema(data)
+	if err != nil {
+		return fmt.Errorf("parse schema: %w", err)
+	}
+
+	err = orderProperties(schema, data)
+	if err != nil {
+		return fmt.Errorf("parse schema: %w", err)
+	}
+
+	opts := []schemadoc.Option{schemadoc.WithTitle(cfg.Title)}
+
+	for _, path := range cfg.Templates {
+		text, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read template: %w", err)
+		}
+
+		opts = append(opts, schemadoc.WithTemplate(string(text)))
+	}
+
+	var buf bytes.Buffer
+
+	err = schemadoc.Render(&buf, schema, format, opts...)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case cfg.Check:
+		return checkFile(stdout, cfg.Output, buf.Bytes())
+	case cfg.Output != "":
+		err = os.WriteFile(cfg.Output, buf.Bytes(), 0o644)
+		if err != nil {
+			return fmt.Errorf("write output: %w", err)
+		}
+
+		return nil
+	default:
+		_, err = stdout.Write(buf.Bytes())
+
+		return err
+	}
+}
+
+// outputFormat returns the format -format names, or the one -o's extension
+// implies.
+func outputFormat(cfg config) (schemadoc.Format, error) {
+	switch cfg.Format {
+	case string(schemadoc.Markdown), string(schemadoc.HTML):
+		return schemadoc.Format(cfg.Format), nil
+	case "":
+		switch strings.ToLower(filepath.Ext(cfg.Output)) {
+		case ".html", ".htm":
+			return schemadoc.HTML, nil
+		}
+
+		return schemadoc.Markdown, nil
+	default:
+		return "", fmt.Errorf("unsupported -format %q: must be %q or %q", cfg.Format, schemadoc.Markdown, schemadoc.HTML)
+	}
+}
+
+// orderProperties sets the PropertyOrder of each schema in s with properties
+// to the order data, the document s was parsed from, lists them in, which
+// parsing does not keep.
+func orderProperties(s *jsonschema.Schema, data []byte) error {
+	keys, err := objectKeys(data)
+	if err != nil {
+		return err
+	}
+
+	for loc, node := range jsonschema.Schemas(s) {
+		if names, ok := keys[loc.Pointer+"/properties"]; ok && len(node.Properties) > 0 {
+			node.PropertyOrder = names
+		}
+	}
+
+	return nil
+}
+
+// objectKeys returns the member names of each object in the JSON document
+// data, in document order, by the object's JSON Pointer.
+func objectKeys(data []byte) (map[string][]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	keys := map[string][]string{}
+
+	var walk func(ptr string) error
+
+	walk = func(ptr string) error {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch tok {
+		case json.Delim('{'):
+			var names []string
+
+			for dec.More() {
+				tok, err := dec.Token()
+				if err != nil {
+					return err
+				}
+
+				name, _ := tok.(string)
+				names = append(names, name)
+
+				err = walk(ptr + "/" + jsonptr.Escape(name))
+				if err != nil {
+					return err
+				}
+			}
+
+			keys[ptr] = names
+
+		case json.Delim('['):
+			for i := 0; dec.More(); i++ {
+				err := walk(ptr + "/" + strconv.Itoa(i))
+				if err != nil {
+					return err
+				}
+			}
+
+		default:
+			return nil
+		}
+
+		// The closing delimiter.
+		_, err = dec.Token()
+
+		return err
+	}
+
+	return keys, walk("")
+}
+
+// checkFile compares data with the file at path, writing a unified diff to w
+// and returning an error when they differ or the file is missing.
+func checkFile(w io.Writer, path string, data []byte) error {
+	current, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("read %q: %w", path, err)
+	}
+
+	if err == nil && bytes.Equal(current, data) {
+		return nil
+	}
+
+	name := filepath.ToSlash(path)
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(current)),
+		B:        difflib.SplitLines(string(data)),
+		FromFile: "a/" + name,
+		ToFile:   "b/" + name,
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Errorf("diff %q: %w", path, err)
+	}
+
+	_, err = io.WriteString(w, diff)
+	if err != nil {
+		return err
+	}
+
+	return fmt.Errorf("%s is out of date; rerun jsonschemadoc without -check", path)
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// valuesSchema is a Helm values schema as magicschema writes it: Draft 7,
+// with nested inline objects and properties in values.yaml order.
+const valuesSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "title": "my-chart",
+  "type": "object",
+  "properties": {
+    "replicaCount": {"type": "integer", "description": "Number of replicas", "minimum": 1},
+    "image": {
+      "type": "object",
+      "properties": {
+        "repository": {"type": "string"},
+        "pullPolicy": {"type": "string", "enum": ["Always", "IfNotPresent"]}
+      },
+      "required": ["repository"]
+    },
+    "affinity": {"type": "object"}
+  }
+}
+`
+
+func writeSchema(t *testing.T, dir string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, "values.schema.json")
+	require.NoError(t, os.WriteFile(path, []byte(valuesSchema), 0o644))
+
+	return path
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	input := writeSchema(t, dir)
+
+	var stdout bytes.Buffer
+
+	require.NoError(t, run(config{Input: input}, nil, &stdout))
+	assert.Equal(t, `<a id="root"></a>
+
+# my-chart
+
+## Contents
+
+- [image](#image)
+
+**Type:** object
+
+| Property | Type | Required | Default | Constraints | Description |
+| --- | --- | --- | --- | --- | --- |
+| `+"`replicaCount`"+` | integer | no |  | minimum: `+"`1`"+` | Number of replicas |
+| `+"`image`"+` | [object](#image) | no |  |  |  |
+| `+"`affinity`"+` | object | no |  |  |  |
+
+<a id="image"></a>
+
+## image
+
+**Type:** object
+
+| Property | Type | Required | Default | Constraints | Description |
+| --- | --- | --- | --- | --- | --- |
+| `+"`repository`"+` | string | yes |  |  |  |
+| `+"`pullPolicy`"+` | string | no |  |  | Allowed values:<br>`+"`\"Always\"`<br>`\"IfNotPresent\"`"+` |
+`, stdout.String())
+}
+
+func TestRun_Outputs(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		cfg  config
+		want []string
+	}{
+		"html from extension": {
+			cfg:  config{Output: "values.html", Title: "Values"},
+			want: []string{"<!DOCTYPE html>", "<h1>Values</h1>", `<a href="#image">object</a>`},
+		},
+		"explicit format": {
+			cfg:  config{Output: "values.txt", Format: "html"},
+			want: []string{"<title>my-chart</title>"},
+		},
+		"template override": {
+			cfg:  config{Output: "values.md", Templates: []string{"table.tmpl"}},
+			want: []string{"* replicaCount\n* image\n* affinity", "* repository\n* pullPolicy"},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			tc.cfg.Input = writeSchema(t, dir)
+			tc.cfg.Output = filepath.Join(dir, tc.cfg.Output)
+
+			for i, tmpl := range tc.cfg.Templates {
+				tc.cfg.Templates[i] = filepath.Join(dir, tmpl)
+				require.NoError(t, os.WriteFile(tc.cfg.Templates[i],
+					[]byte(`{{define "properties"}}{{range $i, $p := .}}{{if $i}}{{"\n"}}{{end}}* {{$p.Name}}{{end}}{{end}}`), 0o644))
+			}
+
+			require.NoError(t, run(tc.cfg, nil, &bytes.Buffer{}))
+
+			out, err := os.ReadFile(tc.cfg.Output)
+			require.NoError(t, err)
+
+			for _, want := range tc.want {
+				assert.Contains(t, string(out), want)
+			}
+		})
+	}
+}
+
+func TestRun_Stdin(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+
+	require.NoError(t, run(config{Input: "-", Title: "From stdin"}, strings.NewReader(valuesSchema), &stdout))
+	assert.True(t, strings.HasPrefix(stdout.String(), "<a id=\"root\"></a>\n\n# From stdin\n"), stdout.String())
+}
+
+func TestRun_Check(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cfg := config{Input: writeSchema(t, dir), Output: filepath.Join(dir, "VALUES.md"), Check: true}
+
+	// A missing file is out of date.
+	var stdout bytes.Buffer
+
+	err := run(cfg, nil, &stdout)
+	require.ErrorContains(t, err, "is out of date")
+	assert.Contains(t, stdout.String(), "+# my-chart")
+
+	cfg.Check = false
+	require.NoError(t, run(cfg, nil, &bytes.Buffer{}))
+
+	cfg.Check = true
+	require.NoError(t, run(cfg, nil, &bytes.Buffer{}))
+
+	// A schema change makes the file stale again.
+	stale := strings.Replace(valuesSchema, "Number of replicas", "Replica count", 1)
+	require.NoError(t, os.WriteFile(cfg.Input, []byte(stale), 0o644))
+
+	stdout.Reset()
+
+	err = run(cfg, nil, &stdout)
+	require.ErrorContains(t, err, "is out of date")
+	assert.Contains(t, stdout.String(), "-| `replicaCount` | integer | no |  | minimum: `1` | Number of replicas |")
+	assert.Contains(t, stdout.String(), "+| `replicaCount` | integer | no |  | minimum: `1` | Replica count |")
+}
+
+func TestRun_Errors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	input := writeSchema(t, dir)
+
+	bad := filepath.Join(dir, "bad.json")
+	require.NoError(t, os.WriteFile(bad, []byte(`[1]`), 0o644))
+
+	badTemplate := filepath.Join(dir, "bad.tmpl")
+	require.NoError(t, os.WriteFile(badTemplate, []byte(`{{define "type"}}{{.Name}`), 0o644))
+
+	tcs := map[string]struct {
+		cfg  config
+		want string
+	}{
+		"unknown format": {
+			cfg:  config{Input: input, Format: "pdf"},
+			want: `unsupported -format "pdf"`,
+		},
+		"check without output": {
+			cfg:  config{Input: input, Check: true},
+			want: "-check requires -o",
+		},
+		"missing schema": {
+			cfg:  config{Input: filepath.Join(dir, "missing.json")},
+			want: "read schema",
+		},
+		"not a schema": {
+			cfg:  config{Input: bad},
+			want: "parse schema",
+		},
+		"bad template": {
+			cfg:  config{Input: input, Templates: []string{badTemplate}},
+			want: "parse template",
+		},
+		"missing template": {
+			cfg:  config{Input: input, Templates: []string{filepath.Join(dir, "missing.tmpl")}},
+			want: "read template",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := run(tc.cfg, nil, &bytes.Buffer{})
+			require.ErrorContains(t, err, tc.want)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by jsonschema.CommentTable.GoSource. DO NOT EDIT.
+
+package configfile
+
+import "go.jacobcolvin.com/x/jsonschema"
+
+// fileComments holds Go doc comments extracted at build time; it is a
+// jsonschema.DescriptionProvider.
+var fileComments = jsonschema.CommentTable{
+	"go.jacobcolvin.com/x/jsonschema/cmd/jsonschemagen/internal/configfile": {
+		"Comments": {
+			Doc: "Comments configures the Go doc comment provider.",
+			Fields: map[string]string{
+				"Deprecation":          "Deprecation moves a \"Deprecated: \" paragraph out of the description\nand into the deprecated keyword.",
+				"DocLinkBaseURL":       "DocLinkBaseURL is the base URL of the doc links in a\nmarkdownDescription, in place of pkg.go.dev.",
+				"DocTitles":            "DocTitles sets each title from the first sentence of its comment.",
+				"Enums":                "Enums sets a named type's enum from the constants its package\ndeclares for it.",
+				"MarkdownDescriptions": "MarkdownDescriptions adds a markdownDescription rendered from each\ncomment.",
+				"Markers":              "Markers applies the kubebuilder-style \"+name=value\" markers of field\nand type comments.",
+			},
+		},
+		"File": {
+			Doc: "File is a jsonschemagen configuration file. Each field maps to the\njsonschema generation option of the same name; an omitted field leaves\nthe option at its default.",
+			Fields: map[string]string{
+				"AdditionalProperties": "AdditionalProperties allows properties a struct does not declare.",
+				"Comments":             "Comments extracts Go doc comments as descriptions, configuring the\ncomment provider. An empty object extracts them with its defaults.",
+				"Defaults":             "Defaults seeds the property defaults of a generated type's root schema\nfrom an instance of it, keyed by the name of the type in the target\npackage. The instance is a value or a constructor call, such as\n\"DefaultConfig()\".",
+				"Definitions":          "Definitions extracts shared types into $defs (definitions under draft\n7) and refers to them with $ref. Defaults to true.",
+				"DescriptionProvider":  "DescriptionProvider supplies type and field descriptions in place of\ndoc comments: a jsonschema.DescriptionProvider.",
+				"Draft":                "Draft is the JSON Schema draft to generate: 7 or 2020 (the default).",
+				"FieldInterpreters":    "FieldInterpreters are consulted for every struct field, in order: each\na jsonschema.FieldInterpreter.",
+				"FieldNaming":          "FieldNaming names the properties of struct fields: a\njsonschema.FieldNaming.",
+				"GenericDefinitions":   "GenericDefinitions shares one definition between the instantiations of\na generic type, as a $dynamicRef template under this absolute base URI.",
+				"Namer":                "Namer names the definitions of Go types: a jsonschema.Namer.",
+				"Nullable":             "Nullable makes pointers, slices, and maps admit null. Defaults to true.",
+				"Options":              "Options are generation options the file has no field for, applied\nafter the rest in order: each a jsonschema.GenerateOption.",
+				"RootTitle":            "RootTitle sets the root schema's title to its type's name.",
+				"TagInterpreters":      "TagInterpreters read struct tags, applied in order.",
+				"TypeSchemaExtenders":  "TypeSchemaExtenders adjust reflection-generated schemas, in order: each\na jsonschema.TypeSchemaExtender.",
+				"TypeSchemaProviders":  "TypeSchemaProviders supply the schemas of the types they recognize,\nthe last one first: each a jsonschema.TypeSchemaProvider.",
+				"TypeSchemas":          "TypeSchemas override the schemas of specific Go types.",
+				"Unions":               "Unions generate interfaces as discriminated unions, each a\n*jsonschema.Union registered with jsonschema.WithUnion.",
+			},
+		},
+		"Ref": {
+			Doc: "Ref refers to a Go identifier the generated helper program uses: a name\ndeclared in the target package, such as \"NewProvider\", or one qualified by\nthe import path of its package, such as\n\"go.jacobcolvin.com/x/jsonschema/interpreters/validate.NewInterpreter\". A\ntrailing \"()\" calls the function with no arguments and uses its result;\nwithout one, the identifier's value is used.",
+		},
+		"TagInterpreter": {
+			Doc: "TagInterpreter registers a jsonschema.TagInterpreter under a struct tag key.",
+			Fields: map[string]string{
+				"Interpreter": "Interpreter is the jsonschema.TagInterpreter.",
+				"Tag":         "Tag is the struct tag key the interpreter reads, such as \"validate\".",
+			},
+		},
+		"TypeSchema": {
+			Doc: "TypeSchema overrides the schema of one Go type.",
+			Fields: map[string]string{
+				"Nullability": "Nullability makes every occurrence of the type admit null (\"allowed\")\nor none of them (\"forbidden\"), in place of the pointer-ness of each\noccurrence.",
+				"Schema":      "Schema is the type's value schema. Generation adds the null branch a\npointer to the type takes, unless Verbatim is set.",
+				"Type":        "Type is the Go type, a Ref without the call parentheses.",
+				"Verbatim":    "Verbatim emits Schema exactly as given, with no null branch added.",
+			},
+		},
+	},
+}
@@ -0,0 +1,356 @@
+// Package configfile reads jsonschemagen's -config file, which sets the
+// generation options its flags do not cover: type overrides, nullability and
+// definitions, defaults, naming, and the providers, extenders, and
+// interpreters a generator program would otherwise register by hand. The
+// file is YAML or JSON and is validated against the schema [Schema]
+// generates for [File].
+package configfile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+//go:generate go run go.jacobcolvin.com/x/jsonschema/cmd/jsonschemagen -type File -comment-table comments.go -comment-var fileComments
+
+// File is a jsonschemagen configuration file. Each field maps to the
+// jsonschema generation option of the same name; an omitted field leaves
+// the option at its default.
+type File struct {
+	// Draft is the JSON Schema draft to generate: 7 or 2020 (the default).
+	Draft int `json:"draft,omitempty" jsonschema:"enum=7|2020"`
+
+	// Comments extracts Go doc comments as descriptions, configuring the
+	// comment provider. An empty object extracts them with its defaults.
+	Comments *Comments `json:"comments,omitempty"`
+
+	// AdditionalProperties allows properties a struct does not declare.
+	AdditionalProperties bool `json:"additionalProperties,omitempty"`
+
+	// Nullable makes pointers, slices, and maps admit null. Defaults to true.
+	Nullable *bool `json:"nullable,omitempty"`
+
+	// Definitions extracts shared types into $defs (definitions under draft
+	// 7) and refers to them with $ref. Defaults to true.
+	Definitions *bool `json:"definitions,omitempty"`
+
+	// RootTitle sets the root schema's title to its type's name.
+	RootTitle bool `json:"rootTitle,omitempty"`
+
+	// GenericDefinitions shares one definition between the instantiations of
+	// a generic type, as a $dynamicRef template under this absolute base URI.
+	GenericDefinitions string `json:"genericDefinitions,omitempty" jsonschema:"format=uri"`
+
+	// FieldNaming names the properties of struct fields: a
+	// jsonschema.FieldNaming.
+	FieldNaming Ref `json:"fieldNaming,omitempty"`
+
+	// Namer names the definitions of Go types: a jsonschema.Namer.
+	Namer Ref `json:"namer,omitempty"`
+
+	// DescriptionProvider supplies type and field descriptions in place of
+	// doc comments: a jsonschema.DescriptionProvider.
+	DescriptionProvider Ref `json:"descriptionProvider,omitempty"`
+
+	// TagInterpreters read struct tags, applied in order.
+	TagInterpreters []TagInterpreter `json:"tagInterpreters,omitempty"`
+
+	// FieldInterpreters are consulted for every struct field, in order: each
+	// a jsonschema.FieldInterpreter.
+	FieldInterpreters []Ref `json:"fieldInterpreters,omitempty"`
+
+	// TypeSchemaProviders supply the schemas of the types they recognize,
+	// the last one first: each a jsonschema.TypeSchemaProvider.
+	TypeSchemaProviders []Ref `json:"typeSchemaProviders,omitempty"`
+
+	// TypeSchemaExtenders adjust reflection-generated schemas, in order: each
+	// a jsonschema.TypeSchemaExtender.
+	TypeSchemaExtenders []Ref `json:"typeSchemaExtenders,omitempty"`
+
+	// TypeSchemas override the schemas of specific Go types.
+	TypeSchemas []TypeSchema `json:"typeSchemas,omitempty"`
+
+	// Unions generate interfaces as discriminated unions, each a
+	// *jsonschema.Union registered with jsonschema.WithUnion.
+	Unions []Ref `json:"unions,omitempty"`
+
+	// Options are generation options the file has no field for, applied
+	// after the rest in order: each a jsonschema.GenerateOption.
+	Options []Ref `json:"options,omitempty"`
+
+	// Defaults seeds the property defaults of a generated type's root schema
+	// from an instance of it, keyed by the name of the type in the target
+	// package. The instance is a value or a constructor call, such as
+	// "DefaultConfig()".
+	Defaults map[string]Ref `json:"defaults,omitempty"`
+}
+
+// Comments configures the Go doc comment provider.
+type Comments struct {
+	// Deprecation moves a "Deprecated: " paragraph out of the description
+	// and into the deprecated keyword.
+	Deprecation bool `json:"deprecation,omitempty"`
+
+	// DocTitles sets each title from the first sentence of its comment.
+	DocTitles bool `json:"docTitles,omitempty"`
+
+	// MarkdownDescriptions adds a markdownDescription rendered from each
+	// comment.
+	MarkdownDescriptions bool `json:"markdownDescriptions,omitempty"`
+
+	// DocLinkBaseURL is the base URL of the doc links in a
+	// markdownDescription, in place of pkg.go.dev.
+	DocLinkBaseURL string `json:"docLinkBaseURL,omitempty" jsonschema:"format=uri"`
+
+	// Enums sets a named type's enum from the constants its package
+	// declares for it.
+	Enums bool `json:"enums,omitempty"`
+
+	// Markers applies the kubebuilder-style "+name=value" markers of field
+	// and type comments.
+	Markers bool `json:"markers,omitempty"`
+}
+
+// Docs reports whether c sets any option the comment provider's doc
+// interpreter applies.
+func (c *Comments) Docs() bool {
+	return c.Deprecation || c.DocTitles || c.MarkdownDescriptions || c.DocLinkBaseURL != ""
+}
+
+// TagInterpreter registers a jsonschema.TagInterpreter under a struct tag key.
+type TagInterpreter struct {
+	// Tag is the struct tag key the interpreter reads, such as "validate".
+	Tag string `json:"tag" jsonschema:"minLength=1"`
+
+	// Interpreter is the jsonschema.TagInterpreter.
+	Interpreter Ref `json:"interpreter"`
+}
+
+// TypeSchema overrides the schema of one Go type.
+type TypeSchema struct {
+	// Type is the Go type, a Ref without the call parentheses.
+	Type Ref `json:"type"`
+
+	// Schema is the type's value schema. Generation adds the null branch a
+	// pointer to the type takes, unless Verbatim is set.
+	Schema map[string]any `json:"schema"`
+
+	// Verbatim emits Schema exactly as given, with no null branch added.
+	Verbatim bool `json:"verbatim,omitempty"`
+
+	// Nullability makes every occurrence of the type admit null ("allowed")
+	// or none of them ("forbidden"), in place of the pointer-ness of each
+	// occurrence.
+	Nullability string `json:"nullability,omitempty" jsonschema:"enum=allowed|forbidden"`
+}
+
+// Ref refers to a Go identifier the generated helper program uses: a name
+// declared in the target package, such as "NewProvider", or one qualified by
+// the import path of its package, such as
+// "go.jacobcolvin.com/x/jsonschema/interpreters/validate.NewInterpreter". A
+// trailing "()" calls the function with no arguments and uses its result;
+// without one, the identifier's value is used.
+type Ref string
+
+// refPattern matches a [Ref]. [Ref.Parse] checks what it leaves to Go: that
+// the name is an exported identifier and the import path a plausible one.
+const refPattern = `^(?:[^\s"\\]+\.)?[^\s."\\/()]+(?:\(\))?$`
+
+// JSONSchemaExtend constrains a Ref's string to the reference syntax.
+func (Ref) JSONSchemaExtend(_ context.Context, _ jsonschema.TypeContext, ts *jsonschema.TypeSchema) error {
+	ts.Value.Pattern = refPattern
+
+	return nil
+}
+
+// Parse splits r into the import path of its package (empty for the target
+// package), its name, and whether it is called.
+func (r Ref) Parse() (string, string, bool, error) {
+	s, call := strings.CutSuffix(string(r), "()")
+
+	path, name := "", s
+	if i := strings.LastIndex(s, "."); i >= 0 {
+		path, name = s[:i], s[i+1:]
+		if path == "" || strings.ContainsAny(path, " \t\n\"`\\") {
+			return "", "", false, fmt.Errorf("%w: %q: invalid import path %q", ErrInvalid, r, path)
+		}
+	}
+
+	if !token.IsIdentifier(name) || !token.IsExported(name) {
+		return "", "", false, fmt.Errorf("%w: %q: %q is not an exported Go identifier", ErrInvalid, r, name)
+	}
+
+	return path, name, call, nil
+}
+
+// ErrInvalid indicates a configuration file that does not match its schema
+// or refers to Go identifiers that cannot be.
+var ErrInvalid = errors.New("invalid configuration file")
+
+// Schema returns the JSON Schema of a configuration file, described by the
+// doc comments of [File] and the types it holds. A file omits a setting
+// rather than writing null, so no property admits null.
+func Schema(ctx context.Context) (*jsonschema.Schema, error) {
+	//nolint:wrapcheck // Generation errors name the field at fault.
+	return jsonschema.Generate(ctx, reflect.TypeFor[File](),
+		jsonschema.WithDescriptionProvider(fileComments),
+		jsonschema.WithNullable(false),
+		jsonschema.WithRootTitle(true),
+	)
+}
+
+// validator compiles [Schema] once.
+var validator = sync.OnceValues(func() (*jsonschema.Validator, error) {
+	ctx := context.Background()
+
+	schema, err := Schema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:wrapcheck // A compile error here is a bug in File's schema.
+	return jsonschema.Compile(ctx, schema)
+})
+
+// Load reads the configuration file at path. The file is YAML, which
+// includes JSON; it must be valid against [Schema], and its references must
+// parse.
+func Load(ctx context.Context, path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+
+	var doc any
+
+	err = yaml.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalid, path, err)
+	}
+
+	// An empty file is an empty configuration.
+	if doc == nil {
+		doc = map[string]any{}
+	}
+
+	v, err := validator()
+	if err != nil {
+		return nil, err
+	}
+
+	err = v.Validate(ctx, doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalid, path, err)
+	}
+
+	// The document matches File's schema, so it decodes into a File.
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalid, path, err)
+	}
+
+	var f File
+
+	err = json.Unmarshal(raw, &f)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalid, path, err)
+	}
+
+	err = f.check()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return &f, nil
+}
+
+// check reports what the schema cannot: a reference that does not parse, a
+// type that is called, a defaults key that is not a type name, an override
+// schema that is not one or sets a nullability it ignores, and two
+// description sources.
+func (f *File) check() error {
+	refs := []Ref{f.FieldNaming, f.Namer, f.DescriptionProvider}
+	refs = append(refs, f.FieldInterpreters...)
+	refs = append(refs, f.TypeSchemaProviders...)
+	refs = append(refs, f.TypeSchemaExtenders...)
+	refs = append(refs, f.Unions...)
+	refs = append(refs, f.Options...)
+
+	for _, ti := range f.TagInterpreters {
+		refs = append(refs, ti.Interpreter)
+	}
+
+	for name, ref := range f.Defaults {
+		if !token.IsIdentifier(name) || !token.IsExported(name) {
+			return fmt.Errorf("%w: defaults: %q is not an exported Go type name", ErrInvalid, name)
+		}
+
+		refs = append(refs, ref)
+	}
+
+	for _, ref := range refs {
+		if ref == "" {
+			continue
+		}
+
+		_, _, _, err := ref.Parse()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, ts := range f.TypeSchemas {
+		_, _, call, err := ts.Type.Parse()
+		if err != nil {
+			return err
+		}
+
+		if call {
+			return fmt.Errorf("%w: typeSchemas: %q names a type, which is not called", ErrInvalid, ts.Type)
+		}
+
+		if ts.Verbatim && ts.Nullability != "" {
+			return fmt.Errorf("%w: typeSchemas: %s: nullability does not apply to a verbatim schema", ErrInvalid, ts.Type)
+		}
+
+		_, err = ts.SchemaJSON()
+		if err != nil {
+			return fmt.Errorf("%w: typeSchemas: %s: %w", ErrInvalid, ts.Type, err)
+		}
+	}
+
+	if f.Comments != nil && f.DescriptionProvider != "" {
+		return fmt.Errorf("%w: comments and descriptionProvider are mutually exclusive", ErrInvalid)
+	}
+
+	return nil
+}
+
+// SchemaJSON returns the override schema as JSON, checking that it decodes
+// as a [jsonschema.Schema].
+func (ts TypeSchema) SchemaJSON() ([]byte, error) {
+	data, err := json.Marshal(ts.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("marshal schema: %w", err)
+	}
+
+	var s jsonschema.Schema
+
+	err = json.Unmarshal(data, &s)
+	if err != nil {
+		return nil, fmt.Errorf("decode schema: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,220 @@
+package configfile_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema/cmd/jsonschemagen/internal/configfile"
+)
+
+func writeConfig(t *testing.T, name, data string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	nullable := false
+
+	tcs := map[string]struct {
+		name string
+		data string
+		want configfile.File
+	}{
+		"empty": {
+			name: "empty.yaml",
+			data: "",
+			want: configfile.File{},
+		},
+		"yaml": {
+			name: "config.yaml",
+			data: `
+draft: 7
+comments:
+  docTitles: true
+  markers: true
+nullable: false
+namer: NewNamer()
+tagInterpreters:
+  - tag: validate
+    interpreter: go.jacobcolvin.com/x/jsonschema/interpreters/validate.NewInterpreter()
+typeSchemas:
+  - type: time.Duration
+    schema: {type: string}
+    nullability: forbidden
+unions: [PetUnion()]
+options: [SchemaOptions()]
+defaults:
+  Config: DefaultConfig()
+`,
+			want: configfile.File{
+				Draft:    7,
+				Comments: &configfile.Comments{DocTitles: true, Markers: true},
+				Nullable: &nullable,
+				Namer:    "NewNamer()",
+				TagInterpreters: []configfile.TagInterpreter{{
+					Tag:         "validate",
+					Interpreter: "go.jacobcolvin.com/x/jsonschema/interpreters/validate.NewInterpreter()",
+				}},
+				TypeSchemas: []configfile.TypeSchema{{
+					Type:        "time.Duration",
+					Schema:      map[string]any{"type": "string"},
+					Nullability: "forbidden",
+				}},
+				Unions:   []configfile.Ref{"PetUnion()"},
+				Options:  []configfile.Ref{"SchemaOptions()"},
+				Defaults: map[string]configfile.Ref{"Config": "DefaultConfig()"},
+			},
+		},
+		"json": {
+			name: "config.json",
+			data: `{"rootTitle": true, "typeSchemaProviders": ["example.com/types.Provider"]}`,
+			want: configfile.File{
+				RootTitle:           true,
+				TypeSchemaProviders: []configfile.Ref{"example.com/types.Provider"},
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := configfile.Load(t.Context(), writeConfig(t, tc.name, tc.data))
+			require.NoError(t, err)
+			assert.Equal(t, &tc.want, got)
+		})
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		data string
+		want string
+	}{
+		"not yaml": {
+			data: "draft: [7",
+		},
+		"unknown field": {
+			data: "drafts: 7\n",
+		},
+		"unsupported draft": {
+			data: "draft: 4\n",
+		},
+		"null setting": {
+			data: "namer: null\n",
+		},
+		"malformed ref": {
+			data: "namer: New Namer()\n",
+		},
+		"unexported ref": {
+			data: "namer: newNamer()\n",
+			want: `"newNamer" is not an exported Go identifier`,
+		},
+		"unexported option": {
+			data: "options: [strict()]\n",
+			want: `"strict" is not an exported Go identifier`,
+		},
+		"empty tag": {
+			data: "tagInterpreters: [{tag: '', interpreter: New()}]\n",
+		},
+		"called type": {
+			data: "typeSchemas: [{type: Duration(), schema: {}}]\n",
+			want: "names a type, which is not called",
+		},
+		"verbatim nullability": {
+			data: "typeSchemas: [{type: Duration, schema: {}, verbatim: true, nullability: allowed}]\n",
+			want: "nullability does not apply to a verbatim schema",
+		},
+		"schema not a schema": {
+			data: "typeSchemas: [{type: Duration, schema: {type: 3}}]\n",
+			want: "decode schema",
+		},
+		"unexported defaults key": {
+			data: "defaults: {config: DefaultConfig()}\n",
+			want: `"config" is not an exported Go type name`,
+		},
+		"two description sources": {
+			data: "comments: {}\ndescriptionProvider: Descriptions\n",
+			want: "comments and descriptionProvider are mutually exclusive",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := configfile.Load(t.Context(), writeConfig(t, "config.yaml", tc.data))
+			require.ErrorIs(t, err, configfile.ErrInvalid)
+
+			if tc.want != "" {
+				assert.ErrorContains(t, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestRefParse(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		ref  configfile.Ref
+		path string
+		name string
+		call bool
+		err  bool
+	}{
+		"target value":  {ref: "Namer", name: "Namer"},
+		"target call":   {ref: "NewNamer()", name: "NewNamer", call: true},
+		"qualified":     {ref: "time.Duration", path: "time", name: "Duration"},
+		"dotted path":   {ref: "example.com/a/b.New()", path: "example.com/a/b", name: "New", call: true},
+		"unexported":    {ref: "example.com/a.new", err: true},
+		"empty path":    {ref: ".New", err: true},
+		"not a name":    {ref: "example.com/a.", err: true},
+		"quoted path":   {ref: `example.com/"a.New`, err: true},
+		"arguments":     {ref: "New(1)", err: true},
+		"only a suffix": {ref: "()", err: true},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path, name, call, err := tc.ref.Parse()
+			if tc.err {
+				require.ErrorIs(t, err, configfile.ErrInvalid)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.path, path)
+			assert.Equal(t, tc.name, name)
+			assert.Equal(t, tc.call, call)
+		})
+	}
+}
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	schema, err := configfile.Schema(t.Context())
+	require.NoError(t, err)
+
+	assert.Equal(t, "File", schema.Title)
+	assert.Contains(t, schema.Properties, "typeSchemas")
+	assert.Equal(t, "File is a jsonschemagen configuration file. Each field maps to the\n"+
+		"jsonschema generation option of the same name; an omitted field leaves\n"+
+		"the option at its default.", schema.Description)
+}
@@ -5,6 +5,69 @@
 //
 //	//go:generate go run go.jacobcolvin.com/x/jsonschema/cmd/jsonschemagen -type Config -o config.schema.json
 //
+// With -out-dir, the tool writes a set of documents instead of one (see
+// [jsonschema.GenerateFiles]): one per Go package, or one per type with
+// -split type, each carrying an $id built from -id-template and referring to
+// the others by relative URI. The files land under the directory at their
+// $id's path below the template's directory, so
+//
+//	jsonschemagen -type Config -out-dir schemas -id-template 'https://example.com/schemas/{name}.json'
+//
+// writes schemas/config.json, schemas/types.json, and so on.
+//
+// With -go-validator, the tool also compiles the generated schema and writes a
+// specialized Go validator for it (see [jsonschema.GenerateGo]) into the
+// target package: a func(any) error named by -go-func, default
+// "Validate"+type, and a typed entry point taking a *type, named with a
+// "Value" suffix. The helper build sees a stub in place of that file, declaring
+// the same entry points, so a validator left stale by a renamed or removed
+// field never blocks its own regeneration.
+//
+// With -comment-table, the tool also extracts the Go doc comments of every
+// package the schema reaches and writes them into the target package as a
+// [jsonschema.CommentTable] variable named by -comment-var, default
+// type+"Comments" (see [jsonschema.GoCommentProvider.ExtractTable]), so a
+// binary deployed without sources can still generate described schemas:
+//
+//	//go:generate go run go.jacobcolvin.com/x/jsonschema/cmd/jsonschemagen -type Config -comment-table config_comments.go
+//
+// Several types can be generated in one run, sharing one helper build: repeat
+// -type (or give it a comma-separated list), or pass -all-exported to take
+// every exported type the package declares, narrowed by -include and -exclude
+// globs matched against the type name. Each schema is written to the file
+// -o-template names, whose {type} placeholder is the Go type name and {name}
+// its lower-case form:
+//
+//	//go:generate go run go.jacobcolvin.com/x/jsonschema/cmd/jsonschemagen -all-exported -exclude *Func -o-template schemas/{name}.json
+//
+// -all-exported leaves out generic types, aliases, and types declared as a
+// func, channel, or interface type literal, none of which has a schema of its
+// own. With several types, -go-validator writes one validator per type into a
+// single file, and -comment-table a single table covering them all, named by
+// the then-required -comment-var.
+//
+// With -check, nothing is written: the tool regenerates every output into
+// memory, compares it with the file on disk, and prints a unified diff of each
+// one that differs, exiting non-zero if any does. Under -out-dir, a schema file
+// the run no longer produces, left by a removed type or package, is reported
+// as a deletion. A CI step running the //go:generate lines with -check catches
+// a forgotten regeneration:
+//
+//	go run go.jacobcolvin.com/x/jsonschema/cmd/jsonschemagen -check -type Config -o config.schema.json
+//
+// The flags cover the common options. A -config file, YAML or JSON, sets the
+// full generation option set instead: type schema overrides, nullability,
+// definitions, root titles, the comment provider's options, and the namer,
+// field naming, providers, extenders, interpreters, unions, and any further
+// GenerateOption, each a reference to a Go identifier the helper program
+// calls, such as "NewNamer()" in the target package or
+// "go.jacobcolvin.com/x/jsonschema/interpreters/validate.NewInterpreter()" in
+// another. Its defaults entries seed a type's property defaults from a
+// constructor. -config-schema prints the schema the file is validated
+// against, for editor completion:
+//
+//	//go:generate go run go.jacobcolvin.com/x/jsonschema/cmd/jsonschemagen -type Config -config jsonschema.yaml -o config.schema.json
+//
 // The tool builds a small helper program that imports the target package, calls
 // [jsonschema.Generate], and writes the resulting JSON to a hand-off file the
 // tool reads back, reusing the library's
@@ -14,6 +77,16 @@
 // resolution, replace directives, and checksum verification. That module must be
 // able to resolve go.jacobcolvin.com/x/jsonschema (via a require, a workspace, or
 // a tool directive).
+//
+// With -backend static, the tool instead type-checks the package and builds
+// each schema from its go/types information (see [jsonschema.GenerateStatic]).
+// No helper is built or run, so the module need not resolve the library, and
+// a sandbox that forbids executing built binaries is no obstacle. The static
+// backend serves -draft, -additional-properties, and the output flags; a type
+// whose schema depends on its own code (a JSONSchema, JSONSchemaExtend,
+// MarshalJSON, or MarshalText method) is an error. -backend auto tries the
+// static backend and falls back to the helper for such a type, or for a flag
+// only the helper serves.
 package main
 
 import (
@@ -24,13 +97,24 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"go/ast"
+	"go/parser"
 	"go/token"
 	"io"
+	"io/fs"
+	"maps"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 	"text/template"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"go.jacobcolvin.com/x/jsonschema/cmd/jsonschemagen/internal/configfile"
 )
 
 // jsonschemaModule is the module path of the jsonschema library the helper
@@ -38,25 +122,62 @@ import (
 const jsonschemaModule = "go.jacobcolvin.com/x/jsonschema"
 
 type config struct {
-	TypeName             string
+	Types                []string
+	Include              []string
+	Exclude              []string
 	Output               string
+	OutputTemplate       string
+	OutDir               string
+	IDTemplate           string
+	Split                string
+	GoValidator          string
+	GoFunc               string
+	CommentTable         string
+	CommentVar           string
 	Draft                string
 	Indent               string
+	Config               string
+	Backend              string
+	ConfigSchema         bool
+	AllExported          bool
 	Comments             bool
 	AdditionalProperties bool
 	Validate             bool
+	Check                bool
+
+	// Package is the target package's name, resolved by run rather than set
+	// by a flag; the generated validator's package clause uses it.
+	Package string
+
+	// File is the loaded -config file, or nil without one.
+	File *configfile.File
 }
 
 func main() {
 	cfg := config{}
 
-	flag.StringVar(&cfg.TypeName, "type", "", "Go type name to generate schema for (required)")
+	flag.Func("type", "Go type name to generate schema for; repeatable or comma-separated", listFlag(&cfg.Types))
+	flag.BoolVar(&cfg.AllExported, "all-exported", false, "generate every exported type of the package")
+	flag.Func("include", "with -all-exported, only generate types matching this glob; repeatable", listFlag(&cfg.Include))
+	flag.Func("exclude", "with -all-exported, skip types matching this glob; repeatable", listFlag(&cfg.Exclude))
 	flag.StringVar(&cfg.Output, "o", "", "output file path (default: stdout)")
-	flag.StringVar(&cfg.Draft, "draft", "2020", `JSON Schema draft: "7" or "2020"`)
+	flag.StringVar(&cfg.OutputTemplate, "o-template", "", "output file path of each type, with {type} and {name} placeholders")
+	flag.StringVar(&cfg.OutDir, "out-dir", "", "write one schema file per package or type under this directory")
+	flag.StringVar(&cfg.IDTemplate, "id-template", "", "$id template of each -out-dir file, with {name} and {package} placeholders")
+	flag.StringVar(&cfg.Split, "split", "package", `-out-dir file per "package" or per "type"`)
+	flag.StringVar(&cfg.Draft, "draft", "", `JSON Schema draft: "7" or "2020" (default "2020")`)
 	flag.BoolVar(&cfg.Comments, "comments", false, "extract Go doc comments as descriptions")
 	flag.BoolVar(&cfg.AdditionalProperties, "additional-properties", false, "allow additional properties")
 	flag.StringVar(&cfg.Indent, "indent", "  ", "JSON indentation string")
 	flag.BoolVar(&cfg.Validate, "validate", false, "add validate tag interpreter")
+	flag.StringVar(&cfg.GoValidator, "go-validator", "", "also write a generated Go validator for the schema to this file")
+	flag.StringVar(&cfg.GoFunc, "go-func", "", `name of the generated validator (default "Validate"+type)`)
+	flag.StringVar(&cfg.CommentTable, "comment-table", "", "also write the extracted Go doc comments as a Go file to this path")
+	flag.StringVar(&cfg.CommentVar, "comment-var", "", `name of the comment table variable (default type+"Comments")`)
+	flag.StringVar(&cfg.Config, "config", "", "YAML or JSON file setting the generation options")
+	flag.BoolVar(&cfg.ConfigSchema, "config-schema", false, "print the JSON Schema of the -config file and exit")
+	flag.BoolVar(&cfg.Check, "check", false, "compare the outputs with the files on disk instead of writing them")
+	flag.StringVar(&cfg.Backend, "backend", backendHelper, `generation backend: "helper", "static", or "auto"`)
 	flag.Parse()
 
 	// Reject leftover positional arguments so a mistyped invocation (a stray
@@ -75,14 +196,6 @@ func main() {
 }
 
 func run(cfg config, stdout io.Writer) error {
-	if cfg.TypeName == "" {
-		return fmt.Errorf("-type flag is required")
-	}
-
-	if cfg.Draft != "7" && cfg.Draft != "2020" {
-		return fmt.Errorf("unsupported draft %q: must be \"7\" or \"2020\"", cfg.Draft)
-	}
-
 	// The indent string is embedded verbatim into json.MarshalIndent, which does
 	// not validate it. A non-whitespace indent is repeated between JSON tokens
 	// and produces output that no longer parses, so reject it up front.
@@ -90,6 +203,54 @@ func run(cfg config, stdout io.Writer) error {
 		return fmt.Errorf("invalid -indent %q: must contain only whitespace", cfg.Indent)
 	}
 
+	err := checkConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.ConfigSchema {
+		return writeConfigSchema(stdout, cfg.Indent)
+	}
+
+	err = checkTypes(cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.GoFunc != "" && cfg.GoValidator == "" {
+		return fmt.Errorf("-go-func requires -go-validator")
+	}
+
+	if cfg.CommentVar != "" && cfg.CommentTable == "" {
+		return fmt.Errorf("-comment-var requires -comment-table")
+	}
+
+	err = checkOutDir(cfg)
+	if err != nil {
+		return err
+	}
+
+	err = checkOutputs(cfg)
+	if err != nil {
+		return err
+	}
+
+	err = checkBackend(cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.Draft != "" && cfg.Draft != "7" && cfg.Draft != "2020" {
+		return fmt.Errorf("unsupported draft %q: must be \"7\" or \"2020\"", cfg.Draft)
+	}
+
+	if cfg.Config != "" {
+		cfg.File, err = configfile.Load(context.Background(), cfg.Config)
+		if err != nil {
+			return err
+		}
+	}
+
 	goMod, err := ensureInModule()
 	if err != nil {
 		return err
@@ -105,25 +266,377 @@ func run(cfg config, stdout io.Writer) error {
 		return err
 	}
 
-	importPath, err := resolveImportPath(inWork)
+	pkg, err := resolvePackage(inWork)
 	if err != nil {
 		return fmt.Errorf("resolve import path: %w", err)
 	}
 
-	output, err := runGenerate(goMod, cfg, importPath, inWork)
+	cfg.Package = pkg.Name
+
+	if cfg.AllExported {
+		cfg.Types, err = exportedTypes(pkg, cfg.Include, cfg.Exclude)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = checkDefaults(cfg)
+	if err != nil {
+		return err
+	}
+
+	out, err := generate(goMod, cfg, pkg.ImportPath, inWork)
+	if err != nil {
+		return err
+	}
+
+	files, err := outputFiles(cfg, out)
 	if err != nil {
 		return err
 	}
 
-	if cfg.Output != "" {
-		return writeFileAtomic(cfg.Output, output, 0o644)
+	if cfg.Check {
+		orphans, err := orphanedFiles(cfg.OutDir, files)
+		if err != nil {
+			return err
+		}
+
+		return checkFiles(stdout, files, orphans)
+	}
+
+	for _, f := range files {
+		err := f.write()
+		if err != nil {
+			return err
+		}
+	}
+
+	if cfg.OutDir != "" || cfg.Output != "" || cfg.OutputTemplate != "" {
+		return nil
 	}
 
-	_, err = stdout.Write(output)
+	_, err = stdout.Write(out.schemas[0])
 
 	return err
 }
 
+// listFlag returns a [flag.Func] callback appending the comma-separated
+// values of each occurrence of a flag to list.
+func listFlag(list *[]string) func(string) error {
+	return func(v string) error {
+		*list = append(*list, strings.Split(v, ",")...)
+		return nil
+	}
+}
+
+// checkTypes validates the flags selecting the types: -type names them, and
+// -all-exported takes them from the package, narrowed by -include and
+// -exclude.
+func checkTypes(cfg config) error {
+	if !cfg.AllExported {
+		if len(cfg.Types) == 0 {
+			return fmt.Errorf("-type flag is required unless -all-exported is set")
+		}
+
+		if len(cfg.Include) > 0 || len(cfg.Exclude) > 0 {
+			return fmt.Errorf("-include and -exclude require -all-exported")
+		}
+
+		for i, name := range cfg.Types {
+			if slices.Contains(cfg.Types[:i], name) {
+				return fmt.Errorf("duplicate -type %q", name)
+			}
+		}
+
+		return nil
+	}
+
+	if len(cfg.Types) > 0 {
+		return fmt.Errorf("-type and -all-exported are mutually exclusive")
+	}
+
+	for _, glob := range slices.Concat(cfg.Include, cfg.Exclude) {
+		_, err := path.Match(glob, "")
+		if err != nil {
+			return fmt.Errorf("invalid glob %q: %w", glob, err)
+		}
+	}
+
+	return nil
+}
+
+// checkOutDir validates the -out-dir flags: -id-template and -split only
+// configure it, and it replaces the single document -o and -go-validator
+// work from.
+func checkOutDir(cfg config) error {
+	if cfg.OutDir == "" {
+		if cfg.IDTemplate != "" {
+			return fmt.Errorf("-id-template requires -out-dir")
+		}
+
+		if cfg.Split != "" && cfg.Split != "package" {
+			return fmt.Errorf("-split requires -out-dir")
+		}
+
+		return nil
+	}
+
+	switch {
+	case cfg.IDTemplate == "":
+		return fmt.Errorf("-out-dir requires -id-template")
+	case cfg.Output != "":
+		return fmt.Errorf("-o and -out-dir are mutually exclusive")
+	case cfg.OutputTemplate != "":
+		return fmt.Errorf("-o-template and -out-dir are mutually exclusive")
+	case cfg.GoValidator != "":
+		return fmt.Errorf("-go-validator cannot be combined with -out-dir")
+	case cfg.AllExported || len(cfg.Types) > 1:
+		return fmt.Errorf("-out-dir takes a single -type")
+	case cfg.Split != "" && cfg.Split != "package" && cfg.Split != "type":
+		return fmt.Errorf("unsupported -split %q: must be \"package\" or \"type\"", cfg.Split)
+	}
+
+	return nil
+}
+
+// checkOutputs validates the flags naming the schema files. One type's
+// schema goes to -o or stdout; several types need -o-template, and name
+// their validators and comment table without the per-type defaults. -check
+// compares files, so it needs the schema in one.
+func checkOutputs(cfg config) error {
+	if cfg.Output != "" && cfg.OutputTemplate != "" {
+		return fmt.Errorf("-o and -o-template are mutually exclusive")
+	}
+
+	if cfg.OutputTemplate != "" {
+		err := checkOutputTemplate(cfg.OutputTemplate)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cfg.Check && cfg.Output == "" && cfg.OutputTemplate == "" && cfg.OutDir == "" {
+		return fmt.Errorf("-check requires -o, -o-template, or -out-dir")
+	}
+
+	if !cfg.AllExported && len(cfg.Types) <= 1 {
+		return nil
+	}
+
+	switch {
+	case cfg.Output != "":
+		return fmt.Errorf("-o takes a single type; name each type's file with -o-template")
+	case cfg.OutputTemplate == "" && cfg.OutDir == "":
+		return fmt.Errorf("generating several types requires -o-template")
+	case cfg.GoFunc != "":
+		return fmt.Errorf(`-go-func takes a single type; each validator is named "Validate"+type`)
+	case cfg.CommentTable != "" && cfg.CommentVar == "":
+		return fmt.Errorf("-comment-table requires -comment-var when generating several types")
+	}
+
+	return nil
+}
+
+// outputPlaceholder matches one placeholder of an -o-template.
+var outputPlaceholder = regexp.MustCompile(`\{[^{}]*\}`)
+
+// checkOutputTemplate reports an -o-template with no placeholder, which
+// would name the same file for every type, or with one other than {type}
+// and {name}.
+func checkOutputTemplate(tmpl string) error {
+	placeholders := outputPlaceholder.FindAllString(tmpl, -1)
+	if len(placeholders) == 0 {
+		return fmt.Errorf("invalid -o-template %q: has no {type} or {name} placeholder", tmpl)
+	}
+
+	for _, p := range placeholders {
+		if p != "{type}" && p != "{name}" {
+			return fmt.Errorf("invalid -o-template %q: unknown placeholder %s", tmpl, p)
+		}
+	}
+
+	return nil
+}
+
+// expandOutputTemplate returns the -o-template path of the type named
+// typeName: {type} is the name itself and {name} its lower-case form.
+func expandOutputTemplate(tmpl, typeName string) string {
+	return strings.NewReplacer("{type}", typeName, "{name}", strings.ToLower(typeName)).Replace(tmpl)
+}
+
+// outputFile is one file a run produces.
+type outputFile struct {
+	path string
+	data []byte
+	// Mkdir creates the file's directory first: the file is one of a set
+	// -out-dir or -o-template lays out, rather than a path named outright.
+	mkdir bool
+}
+
+// write writes the file atomically, like -o.
+func (f outputFile) write() error {
+	if f.mkdir {
+		err := os.MkdirAll(filepath.Dir(f.path), 0o755)
+		if err != nil {
+			return fmt.Errorf("create output directory: %w", err)
+		}
+	}
+
+	return writeFileAtomic(f.path, f.data, 0o644)
+}
+
+// outputFiles returns the files the generated output is written to: the
+// validator and comment table, then the schema documents. A schema bound for
+// stdout is not among them. Two types whose -o-template paths coincide (they
+// differ only in case, under {name}) are an error.
+func outputFiles(cfg config, gen *generated) ([]outputFile, error) {
+	var files []outputFile
+
+	if cfg.GoValidator != "" {
+		files = append(files, outputFile{path: cfg.GoValidator, data: gen.validator})
+	}
+
+	if cfg.CommentTable != "" {
+		files = append(files, outputFile{path: cfg.CommentTable, data: gen.comments})
+	}
+
+	switch {
+	case cfg.OutDir != "":
+		for _, name := range slices.Sorted(maps.Keys(gen.files)) {
+			file := filepath.Join(cfg.OutDir, filepath.FromSlash(name))
+			files = append(files, outputFile{path: file, data: gen.files[name], mkdir: true})
+		}
+
+	case cfg.OutputTemplate != "":
+		typeOf := map[string]string{}
+
+		for i, typeName := range cfg.Types {
+			file := filepath.Clean(expandOutputTemplate(cfg.OutputTemplate, typeName))
+			if other, ok := typeOf[file]; ok {
+				return nil, fmt.Errorf("-o-template names the same file %q for %s and %s", file, other, typeName)
+			}
+
+			typeOf[file] = typeName
+			files = append(files, outputFile{path: file, data: gen.schemas[i], mkdir: true})
+		}
+
+	case cfg.Output != "":
+		files = append(files, outputFile{path: cfg.Output, data: gen.schemas[0]})
+	}
+
+	return files, nil
+}
+
+// checkFiles compares each file with the one on disk at its path, writing a
+// unified diff of every file that differs (or is missing) to w, and of every
+// orphan (a file on disk the run no longer generates) as a deletion, and
+// reports whether any did. Nothing is written to disk.
+func checkFiles(w io.Writer, files []outputFile, orphans []string) error {
+	stale := 0
+
+	// diff writes the diff from current, the file at path, to want, the file
+	// named toFile.
+	diff := func(path string, current, want []byte, toFile string) error {
+		text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(current)),
+			B:        difflib.SplitLines(string(want)),
+			FromFile: "a/" + filepath.ToSlash(path),
+			ToFile:   toFile,
+			Context:  3,
+		})
+		if err != nil {
+			return fmt.Errorf("diff %q: %w", path, err)
+		}
+
+		_, err = io.WriteString(w, text)
+
+		return err
+	}
+
+	for _, f := range files {
+		current, err := os.ReadFile(f.path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("read %q: %w", f.path, err)
+		}
+
+		if err == nil && bytes.Equal(current, f.data) {
+			continue
+		}
+
+		stale++
+
+		err = diff(f.path, current, f.data, "b/"+filepath.ToSlash(f.path))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, path := range orphans {
+		current, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read %q: %w", path, err)
+		}
+
+		stale++
+
+		err = diff(path, current, nil, "/dev/null")
+		if err != nil {
+			return err
+		}
+	}
+
+	if stale > 0 {
+		return fmt.Errorf("%d of %d generated files are out of date; rerun jsonschemagen without -check",
+			stale, len(files)+len(orphans))
+	}
+
+	return nil
+}
+
+// orphanedFiles returns the files under the -out-dir directory dir that carry
+// the extension of a generated schema document but are not among files: the
+// documents of a type or package no longer generated. It returns none
+// without -out-dir or before the directory exists.
+func orphanedFiles(dir string, files []outputFile) ([]string, error) {
+	if dir == "" {
+		return nil, nil
+	}
+
+	generated := map[string]bool{}
+	exts := map[string]bool{}
+
+	for _, f := range files {
+		generated[filepath.Clean(f.path)] = true
+
+		// Of the files, only the schema documents create their directories.
+		if f.mkdir {
+			exts[filepath.Ext(f.path)] = true
+		}
+	}
+
+	var orphans []string
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		switch {
+		case errors.Is(err, fs.ErrNotExist) && path == dir:
+			return fs.SkipAll
+		case err != nil:
+			return err
+		case d.IsDir() || !exts[filepath.Ext(path)] || generated[filepath.Clean(path)]:
+			return nil
+		}
+
+		orphans = append(orphans, path)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list -out-dir: %w", err)
+	}
+
+	return orphans, nil
+}
+
 // writeFileAtomic writes data to path by writing a temp file in the same
 // directory and renaming it into place, so a failed write never truncates or
 // corrupts a file already at path (unlike os.WriteFile, which opens with
@@ -160,12 +673,23 @@ func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
 	return nil
 }
 
-// resolveImportPath returns the import path of the current package. It rejects a
+// listedPackage is the part of the `go list` description of the current
+// package the tool reads.
+type listedPackage struct {
+	Name       string
+	ImportPath string
+	Dir        string
+	GoFiles    []string
+	CgoFiles   []string
+}
+
+// resolvePackage describes the current package. It rejects a
 // main package up front: jsonschemagen builds a helper that imports the target
 // package to reflect over it, and Go forbids importing a package main, which
 // would otherwise fail late with the opaque "is a program, not an importable
 // package" build error. The package name comes from the same `go list` call, so
-// the check costs no extra process.
+// the check costs no extra process, and so do the source files -all-exported
+// reads.
 //
 // Outside a workspace it passes -mod=readonly so an inherited vendor directory
 // (auto-selected when vendor/modules.txt exists) does not make `go list` fail
@@ -175,37 +699,106 @@ func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
 // and rewriting the user's go.mod/go.sum. An untidy module instead fails with
 // go's standard "run go mod tidy" guidance. -mod is rejected in workspace mode,
 // where the workspace already governs resolution.
-func resolveImportPath(inWork bool) (string, error) {
+func resolvePackage(inWork bool) (listedPackage, error) {
 	args := []string{"list"}
 	if !inWork {
 		args = append(args, "-mod=readonly")
 	}
 
-	args = append(args, "-f", "{{.Name}} {{.ImportPath}}", ".")
+	args = append(args, "-json=Name,ImportPath,Dir,GoFiles,CgoFiles", ".")
 
 	cmd := exec.CommandContext(context.Background(), "go", args...)
 	out, err := cmd.Output()
 	if err != nil {
-		return "", cmdError(err)
+		return listedPackage{}, cmdError(err)
 	}
 
-	// A package name and an import path are each a single space-free token, so
-	// the first space separates them.
-	name, importPath, ok := strings.Cut(strings.TrimSpace(string(out)), " ")
-	if !ok {
-		return "", fmt.Errorf("parse go list output %q", strings.TrimSpace(string(out)))
+	var pkg listedPackage
+
+	err = json.Unmarshal(out, &pkg)
+	if err != nil {
+		return listedPackage{}, fmt.Errorf("parse go list output: %w", err)
 	}
 
-	if name == "main" {
-		return "", fmt.Errorf(
+	if pkg.Name == "main" {
+		return listedPackage{}, fmt.Errorf(
 			"cannot generate a schema for a type in package main (%s): jsonschemagen imports "+
 				"the target package to reflect over it, which Go does not allow for a main package; "+
 				"move the type to an importable (non-main) package",
-			importPath,
+			pkg.ImportPath,
 		)
 	}
 
-	return importPath, nil
+	return pkg, nil
+}
+
+// exportedTypes returns the names of the types -all-exported generates: the
+// exported types declared in the package's source files for the current build,
+// matching an include glob (when there are any) and no exclude glob, in sorted
+// order. Generic types, which the helper cannot name without type arguments,
+// and aliases, whose schema is their target's, are left out, as are types
+// declared as a func, channel, or interface literal, which no schema describes.
+func exportedTypes(pkg listedPackage, include, exclude []string) ([]string, error) {
+	fset := token.NewFileSet()
+
+	var names []string
+
+	for _, file := range slices.Concat(pkg.GoFiles, pkg.CgoFiles) {
+		f, err := parser.ParseFile(fset, filepath.Join(pkg.Dir, file), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parse package: %w", err)
+		}
+
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok || !generable(ts) {
+					continue
+				}
+
+				name := ts.Name.Name
+				if (len(include) == 0 || matchAny(include, name)) && !matchAny(exclude, name) {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no exported type in %s matches -all-exported", pkg.ImportPath)
+	}
+
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// generable reports whether -all-exported takes the declared type.
+func generable(ts *ast.TypeSpec) bool {
+	if !ts.Name.IsExported() || ts.TypeParams != nil || ts.Assign.IsValid() {
+		return false
+	}
+
+	switch ts.Type.(type) {
+	case *ast.FuncType, *ast.ChanType, *ast.InterfaceType:
+		return false
+	}
+
+	return true
+}
+
+// matchAny reports whether name matches one of globs. The globs were checked
+// for syntax up front, so a match error cannot occur.
+func matchAny(globs []string, name string) bool {
+	return slices.ContainsFunc(globs, func(glob string) bool {
+		ok, _ := path.Match(glob, name)
+		return ok
+	})
 }
 
 // ensureInModule verifies the tool is running inside a module and returns the
@@ -229,8 +822,21 @@ func ensureInModule() (string, error) {
 	return goMod, nil
 }
 
+// generated is the output the helper hands back.
+type generated struct {
+	// Schemas holds each type's schema, in the order of config.Types.
+	schemas   [][]byte
+	validator []byte
+	comments  []byte
+	// Files holds the -out-dir documents by slash-separated path.
+	files map[string][]byte
+}
+
 // runGenerate builds and runs the helper inside the user's module and returns
-// the generated schema. The helper writes the schema to a hand-off file in the
+// the generated schema of each type (the documents, under -out-dir), and the generated
+// validator source when -go-validator is set, and the comment table source
+// when -comment-table is set. The helper writes each to a
+// hand-off file in the
 // temp dir rather than to its stdout: the helper imports the target package, so
 // every init function in that package and its transitive dependencies runs
 // before generation, and anything they print to stdout would otherwise be
@@ -250,7 +856,7 @@ func ensureInModule() (string, error) {
 // go.work.sum are already complete. A single-module build seeds a redirected
 // modfile so the helper's dependencies (e.g. golang.org/x/tools for -comments)
 // resolve from the module cache without mutating the user's go.mod/go.sum.
-func runGenerate(goMod string, cfg config, importPath string, inWork bool) ([]byte, error) {
+func runGenerate(goMod string, cfg config, importPath string, inWork bool) (*generated, error) {
 	cwd, err := os.Getwd()
 	if err != nil {
 		return nil, fmt.Errorf("resolve working directory: %w", err)
@@ -262,11 +868,9 @@ func runGenerate(goMod string, cfg config, importPath string, inWork bool) ([]by
 	}
 	defer os.RemoveAll(tempDir)
 
-	schemaPath := filepath.Join(tempDir, "schema.json")
-
 	var helper bytes.Buffer
 
-	err = renderMainGo(&helper, cfg, importPath, schemaPath)
+	err = renderMainGo(&helper, cfg, importPath, tempDir)
 	if err != nil {
 		return nil, fmt.Errorf("render helper: %w", err)
 	}
@@ -285,9 +889,31 @@ func runGenerate(goMod string, cfg config, importPath string, inWork bool) ([]by
 	virtual := filepath.Join(cwd, pkgBase, "main.go")
 	pkgArg := "./" + pkgBase
 
+	replace := map[string]string{virtual: backing}
+
+	// The validator from an earlier run is part of the target package, and
+	// reads the fields of the types it validates; once one is renamed or
+	// removed, the package no longer compiles with it. The overlay hides it
+	// behind a stub with the same entry points.
+	if cfg.GoValidator != "" {
+		target, err := filepath.Abs(cfg.GoValidator)
+		if err != nil {
+			return nil, fmt.Errorf("resolve -go-validator: %w", err)
+		}
+
+		stub := filepath.Join(tempDir, "validator_stub.go")
+
+		err = os.WriteFile(stub, validatorStub(cfg), 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("write validator stub: %w", err)
+		}
+
+		replace[target] = stub
+	}
+
 	overlayPath := filepath.Join(tempDir, "overlay.json")
 
-	err = writeOverlay(overlayPath, virtual, backing)
+	err = writeOverlay(overlayPath, replace)
 	if err != nil {
 		return nil, err
 	}
@@ -324,22 +950,98 @@ func runGenerate(goMod string, cfg config, importPath string, inWork bool) ([]by
 		fmt.Fprintf(os.Stderr, "%s", out)
 	}
 
-	schema, err := os.ReadFile(schemaPath)
+	gen := &generated{}
+
+	if cfg.CommentTable != "" {
+		gen.comments, err = os.ReadFile(commentTablePath(tempDir))
+		if err != nil {
+			return nil, fmt.Errorf("read generated comment table: %w", err)
+		}
+	}
+
+	if cfg.OutDir != "" {
+		gen.files, err = readFiles(filesPath(tempDir))
+		if err != nil {
+			return nil, err
+		}
+
+		return gen, nil
+	}
+
+	for i := range cfg.Types {
+		schema, err := os.ReadFile(schemaPath(tempDir, i))
+		if err != nil {
+			return nil, fmt.Errorf("read generated schema: %w", err)
+		}
+
+		gen.schemas = append(gen.schemas, schema)
+	}
+
+	if cfg.GoValidator == "" {
+		return gen, nil
+	}
+
+	gen.validator, err = os.ReadFile(validatorPath(tempDir))
+	if err != nil {
+		return nil, fmt.Errorf("read generated validator: %w", err)
+	}
+
+	return gen, nil
+}
+
+// readFiles reads back the -out-dir documents the helper wrote under dir,
+// keyed by slash-separated path relative to it.
+func readFiles(dir string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+
+	err := fs.WalkDir(os.DirFS(dir), ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			return err
+		}
+
+		files[name] = data
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("read generated schema: %w", err)
+		return nil, fmt.Errorf("read generated schemas: %w", err)
 	}
 
-	return schema, nil
+	return files, nil
 }
 
-// writeOverlay writes a go build overlay mapping the virtual helper file to its
-// backing file. The go tool reads the overlay to build a package whose source
-// lives outside the directory it nominally occupies.
-func writeOverlay(path, virtual, backing string) error {
+// validatorStub returns the source standing in for the -go-validator file in
+// the helper build: the package clause and, so code in the package calling
+// the validator still compiles, each entry point with an empty body.
+func validatorStub(cfg config) []byte {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "package %s\n", cfg.Package)
+
+	for _, typeName := range cfg.Types {
+		name := goFuncName(cfg, typeName)
+		fmt.Fprintf(&b, "\nfunc %s(any) error { return nil }\n", name)
+		fmt.Fprintf(&b, "\nfunc %sValue(*%s) error { return nil }\n", name, typeName)
+	}
+
+	return b.Bytes()
+}
+
+// writeOverlay writes a go build overlay replacing each path in replace with
+// its backing file: the virtual helper file, and any file of the target
+// package the helper build must not see as it is on disk. The go tool reads
+// the overlay to build a package whose source lives outside the directory it
+// nominally occupies.
+func writeOverlay(path string, replace map[string]string) error {
 	overlay := struct {
 		Replace map[string]string `json:"Replace"`
 	}{
-		Replace: map[string]string{virtual: backing},
+		Replace: replace,
 	}
 
 	data, err := json.Marshal(overlay)
@@ -421,88 +1123,210 @@ func copyFile(src, dst string) error {
 	return os.WriteFile(dst, data, 0o644)
 }
 
-var mainGoTmpl = template.Must(template.New("main.go").Parse(`package main
+var mainGoTmpl = template.Must(template.New("main.go").Funcs(template.FuncMap{"join": strings.Join}).Parse(`package main
 
 import (
 	"context"
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 
 	"go.jacobcolvin.com/x/jsonschema"
-	{{- if .Validate}}
-	"go.jacobcolvin.com/x/jsonschema/interpreters/validate"
+	{{- range .Imports}}
+	{{.}}
 	{{- end}}
 
 	target "{{.ImportPath}}"
 )
 
 func main() {
-	t := reflect.TypeFor[target.{{.TypeName}}]()
+	types := []reflect.Type{
+		{{- range .TypeNames}}
+		reflect.TypeFor[target.{{.}}](),
+		{{- end}}
+	}
+	{{- range .Setup}}
+	{{.}}
+	{{- end}}
 	opts := []jsonschema.GenerateOption{
-		{{- if .Draft7}}
-		jsonschema.WithDraft(jsonschema.Draft7),
+		{{- range .Options}}
+		{{.}},
 		{{- end}}
-		{{- if .Comments}}
-		jsonschema.WithDescriptionProvider(jsonschema.NewGoCommentProvider()),
+	}
+	{{- if .Defaults}}
+	defaults := map[reflect.Type]any{
+		{{- range .Defaults}}
+		{{.}},
 		{{- end}}
-		{{- if .AdditionalProperties}}
-		jsonschema.WithAdditionalProperties(true),
+	}
+	{{- end}}
+	{{- if .FilesLiteral}}
+	{{- if .Defaults}}
+	if d, ok := defaults[types[0]]; ok {
+		opts = append(opts, jsonschema.WithDefaultsFrom(d))
+	}
+	{{- end}}
+	files, err := jsonschema.GenerateFiles(context.Background(), types[0], jsonschema.FileLayout{
+		IDTemplate: {{.IDTemplateLiteral}},
+		{{- if .SplitByType}}
+		Split:      jsonschema.SplitByType,
 		{{- end}}
-		{{- if .Validate}}
-		jsonschema.WithTagInterpreter("validate", validate.NewInterpreter()),
+	}, opts...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	for name, schema := range files.Files {
+		data, err := json.MarshalIndent(schema, "", {{.IndentLiteral}})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data = append(data, '\n')
+		path := filepath.Join({{.FilesLiteral}}, filepath.FromSlash(name))
+		err = os.MkdirAll(filepath.Dir(path), 0o700)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		err = os.WriteFile(path, data, 0o600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+	}
+	{{- else}}
+	schemas := make([]*jsonschema.Schema, len(types))
+	for i, t := range types {
+		{{- if .Defaults}}
+		opts := opts
+		if d, ok := defaults[t]; ok {
+			opts = append(opts[:len(opts):len(opts)], jsonschema.WithDefaultsFrom(d))
+		}
 		{{- end}}
+		schema, err := jsonschema.Generate(context.Background(), t, opts...)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data, err := json.MarshalIndent(schema, "", {{.IndentLiteral}})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data = append(data, '\n')
+		err = os.WriteFile(filepath.Join({{.DirLiteral}}, fmt.Sprintf("schema-%d.json", i)), data, 0o600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		schemas[i] = schema
 	}
-	schema, err := jsonschema.Generate(context.Background(), t, opts...)
+	{{- end}}
+	{{- if .GoFuncLiterals}}
+	var funcs []jsonschema.GoFunc
+	for i, name := range []string{ {{- join .GoFuncLiterals ", " -}} } {
+		validator, err := jsonschema.Compile(context.Background(), schemas[i])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		funcs = append(funcs, jsonschema.GoFunc{Name: name, Validator: validator, Type: types[i]})
+	}
+	src, err := jsonschema.GenerateGo(context.Background(), jsonschema.GoConfig{
+		Package:    {{.PackageLiteral}},
+		ImportPath: {{.ImportPathLiteral}},
+		Funcs:      funcs,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	err = os.WriteFile({{.GoOutputLiteral}}, src, 0o600)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	{{- end}}
+	{{- if .CommentVarLiteral}}
+	table, err := {{.CommentProvider}}.ExtractTable(context.Background(), types, opts...)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
-	data, err := json.MarshalIndent(schema, "", {{.IndentLiteral}})
+	comments, err := table.GoSource({{.PackageLiteral}}, {{.CommentVarLiteral}})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
-	data = append(data, '\n')
-	err = os.WriteFile({{.OutputLiteral}}, data, 0o600)
+	err = os.WriteFile({{.CommentOutputLiteral}}, comments, 0o600)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
+	{{- end}}
+}
+{{- if .DecodeSchema}}
+
+func decodeSchema(data string) *jsonschema.Schema {
+	var schema jsonschema.Schema
+	err := json.Unmarshal([]byte(data), &schema)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	return &schema
 }
+{{- end}}
 `))
 
 type templateData struct {
 	ImportPath           string
-	TypeName             string
+	TypeNames            []string
 	IndentLiteral        string
-	OutputLiteral        string
-	Draft7               bool
-	Comments             bool
-	AdditionalProperties bool
-	Validate             bool
+	DirLiteral           string
+	PackageLiteral       string
+	ImportPathLiteral    string
+	GoFuncLiterals       []string
+	GoOutputLiteral      string
+	CommentVarLiteral    string
+	CommentOutputLiteral string
+	CommentProvider      string
+	FilesLiteral         string
+	IDTemplateLiteral    string
+	Imports              []string
+	Setup                []string
+	Options              []string
+	Defaults             []string
+	SplitByType          bool
+	DecodeSchema         bool
 }
 
-// renderMainGo renders the helper program. The schema lands at outPath, a
-// tool-owned file in the temp dir interpolated as a quoted Go string literal
-// (like the indent), so the helper's stdout stays free for init-time noise from
-// the target package's dependency graph.
-func renderMainGo(w io.Writer, cfg config, importPath, outPath string) error {
-	// Guard against injection: the type name and import path are interpolated
+// renderMainGo renders the helper program. Each type's schema lands in dir, a
+// tool-owned temp dir interpolated as a quoted Go string literal (like the
+// indent), at [schemaPath], so the helper's stdout stays free for init-time
+// noise from the target package's dependency graph. The generated validator,
+// when requested, lands beside it at [validatorPath], and the comment table
+// source at [commentTablePath].
+func renderMainGo(w io.Writer, cfg config, importPath, dir string) error {
+	// Guard against injection: the type names and import path are interpolated
 	// into a Go source template.
-	if !token.IsIdentifier(cfg.TypeName) {
-		return fmt.Errorf("invalid type name %q: must be a Go identifier", cfg.TypeName)
-	}
+	for _, name := range cfg.Types {
+		if !token.IsIdentifier(name) {
+			return fmt.Errorf("invalid type name %q: must be a Go identifier", name)
+		}
 
-	// The type is referenced as target.<TypeName> from a separate generated
-	// package, so an unexported name is inaccessible and would otherwise fail
-	// late with an opaque "undefined: target.<name>" compiler error. A non-empty
-	// name is guaranteed by token.IsIdentifier above, and token.IsExported then
-	// applies Go's own definition of exported-ness (the first rune is an
-	// upper-case letter), handling a non-ASCII initial letter correctly.
-	if !token.IsExported(cfg.TypeName) {
-		return fmt.Errorf("invalid type name %q: must be an exported (capitalized) Go identifier", cfg.TypeName)
+		// The type is referenced as target.<TypeName> from a separate generated
+		// package, so an unexported name is inaccessible and would otherwise fail
+		// late with an opaque "undefined: target.<name>" compiler error. A non-empty
+		// name is guaranteed by token.IsIdentifier above, and token.IsExported then
+		// applies Go's own definition of exported-ness (the first rune is an
+		// upper-case letter), handling a non-ASCII initial letter correctly.
+		if !token.IsExported(name) {
+			return fmt.Errorf("invalid type name %q: must be an exported (capitalized) Go identifier", name)
+		}
 	}
 
 	if !isValidImportPath(importPath) {
@@ -510,19 +1334,102 @@ func renderMainGo(w io.Writer, cfg config, importPath, outPath string) error {
 	}
 
 	data := templateData{
-		ImportPath:           importPath,
-		TypeName:             cfg.TypeName,
-		Draft7:               cfg.Draft == "7",
-		Comments:             cfg.Comments,
-		AdditionalProperties: cfg.AdditionalProperties,
-		Validate:             cfg.Validate,
-		IndentLiteral:        fmt.Sprintf("%q", cfg.Indent),
-		OutputLiteral:        fmt.Sprintf("%q", outPath),
+		ImportPath:    importPath,
+		TypeNames:     cfg.Types,
+		IndentLiteral: fmt.Sprintf("%q", cfg.Indent),
+		DirLiteral:    fmt.Sprintf("%q", dir),
+	}
+
+	if cfg.File != nil {
+		err := addFileOptions(&data, cfg.File, importPath)
+		if err != nil {
+			return err
+		}
+	} else {
+		addFlagOptions(&data, cfg)
+	}
+
+	if cfg.OutDir != "" {
+		data.FilesLiteral = fmt.Sprintf("%q", filesPath(dir))
+		data.IDTemplateLiteral = fmt.Sprintf("%q", cfg.IDTemplate)
+		data.SplitByType = cfg.Split == "type"
+	}
+
+	// The generated validator and comment table are declared in the target
+	// package, whose name is interpolated into their package clause.
+	if cfg.GoValidator != "" || cfg.CommentTable != "" {
+		if !token.IsIdentifier(cfg.Package) {
+			return fmt.Errorf("invalid package name %q", cfg.Package)
+		}
+
+		data.PackageLiteral = fmt.Sprintf("%q", cfg.Package)
+	}
+
+	if cfg.GoValidator != "" {
+		for _, typeName := range cfg.Types {
+			name := goFuncName(cfg, typeName)
+			if !token.IsIdentifier(name) || !token.IsExported(name) {
+				return fmt.Errorf("invalid -go-func %q: must be an exported Go identifier", name)
+			}
+
+			data.GoFuncLiterals = append(data.GoFuncLiterals, fmt.Sprintf("%q", name))
+		}
+
+		data.ImportPathLiteral = fmt.Sprintf("%q", importPath)
+		data.GoOutputLiteral = fmt.Sprintf("%q", validatorPath(dir))
+	}
+
+	if cfg.CommentTable != "" {
+		// Several types have no default name; checkOutputs requires
+		// -comment-var for them.
+		name := cfg.CommentVar
+		if name == "" && len(cfg.Types) == 1 {
+			name = cfg.Types[0] + "Comments"
+		}
+
+		if !token.IsIdentifier(name) {
+			return fmt.Errorf("invalid -comment-var %q: must be a Go identifier", name)
+		}
+
+		data.CommentVarLiteral = fmt.Sprintf("%q", name)
+		data.CommentOutputLiteral = fmt.Sprintf("%q", commentTablePath(dir))
+
+		// The table serves what the configured provider's descriptions say,
+		// without the marker lines it drops once it reads them.
+		data.CommentProvider = cmp.Or(data.CommentProvider, "jsonschema.NewGoCommentProvider()")
 	}
 
 	return mainGoTmpl.Execute(w, data)
 }
 
+// goFuncName returns the name of the generated validator of the type named
+// typeName: -go-func's, or "Validate"+type.
+func goFuncName(cfg config, typeName string) string {
+	return cmp.Or(cfg.GoFunc, "Validate"+typeName)
+}
+
+// schemaPath returns the hand-off path of the schema of the i'th type in dir.
+func schemaPath(dir string, i int) string {
+	return filepath.Join(dir, fmt.Sprintf("schema-%d.json", i))
+}
+
+// validatorPath returns the hand-off path of the generated validator source
+// in dir.
+func validatorPath(dir string) string {
+	return filepath.Join(dir, "validator.go.out")
+}
+
+// commentTablePath returns the hand-off path of the generated comment table
+// source in dir.
+func commentTablePath(dir string) string {
+	return filepath.Join(dir, "comments.go.out")
+}
+
+// filesPath returns the hand-off directory of the -out-dir documents in dir.
+func filesPath(dir string) string {
+	return filepath.Join(dir, "files")
+}
+
 // isValidImportPath reports whether p is a plausible Go import path, rejecting
 // characters that could break out of the import declaration string literal. It
 // also rejects the DEL (0x7f) and C1 (0x80-0x9f) control characters, which have
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_BackendFlags(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		cfg  config
+		want string
+	}{
+		"unknown backend": {
+			cfg:  config{Types: []string{"Foo"}, Backend: "reflect"},
+			want: `unsupported -backend "reflect"`,
+		},
+		"static with comments": {
+			cfg:  config{Types: []string{"Foo"}, Backend: backendStatic, Comments: true},
+			want: "-comments requires the helper",
+		},
+		"static with config": {
+			cfg:  config{Types: []string{"Foo"}, Backend: backendStatic, Config: "c.yaml"},
+			want: "-config requires the helper",
+		},
+		"static with go validator": {
+			cfg:  config{Types: []string{"Foo"}, Backend: backendStatic, GoValidator: "v.go"},
+			want: "-go-validator requires the helper",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := run(tc.cfg, &bytes.Buffer{})
+			require.ErrorContains(t, err, tc.want)
+		})
+	}
+}
+
+const backendTypes = `package testmod
+
+import "time"
+
+type Config struct {
+	Name    string            ` + "`" + `json:"name" jsonschema:"minLength=1"` + "`" + `
+	Port    int               ` + "`" + `json:"port,omitempty"` + "`" + `
+	Started time.Time         ` + "`" + `json:"started"` + "`" + `
+	Labels  map[string]string ` + "`" + `json:"labels"` + "`" + `
+	Peers   []*Peer           ` + "`" + `json:"peers"` + "`" + `
+}
+
+type Peer struct {
+	Addr string ` + "`" + `json:"addr"` + "`" + `
+	Next *Peer  ` + "`" + `json:"next,omitempty"` + "`" + `
+}
+
+type Version struct {
+	Major int
+}
+
+func (v Version) MarshalText() ([]byte, error) { return nil, nil }
+`
+
+// TestIntegrationBackendStaticMatchesHelper generates the same types with
+// each backend and requires byte-identical output.
+func TestIntegrationBackendStaticMatchesHelper(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, backendTypes)
+
+	schemas := map[string][]byte{}
+
+	for _, backend := range []string{backendHelper, backendStatic} {
+		cmd := exec.CommandContext(t.Context(), binary, "-backend", backend, "-draft", "7",
+			"-type", "Config,Peer", "-o-template", backend+"-{name}.json")
+		cmd.Dir = dir
+
+		_, err := cmd.Output()
+		require.NoError(t, err, "stderr: %s", cmdStderr(err))
+
+		for _, name := range []string{"config", "peer"} {
+			data, err := os.ReadFile(filepath.Join(dir, backend+"-"+name+".json"))
+			require.NoError(t, err)
+
+			schemas[backend+"/"+name] = data
+		}
+	}
+
+	assert.Equal(t, string(schemas["helper/config"]), string(schemas["static/config"]))
+	assert.Equal(t, string(schemas["helper/peer"]), string(schemas["static/peer"]))
+}
+
+func TestIntegrationBackendUnsupportedType(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, backendTypes)
+
+	// The static backend reports a type whose encoding is its own code.
+	cmd := exec.CommandContext(t.Context(), binary, "-backend", "static", "-type", "Version")
+	cmd.Dir = dir
+
+	_, err := cmd.Output()
+	require.Error(t, err)
+	assert.Contains(t, cmdStderr(err), "has a MarshalText method")
+
+	// Auto falls back to the helper, which reflects the text form.
+	cmd = exec.CommandContext(t.Context(), binary, "-backend", "auto", "-type", "Version")
+	cmd.Dir = dir
+
+	out, err := cmd.Output()
+	require.NoError(t, err, "stderr: %s", cmdStderr(err))
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "string"
+	}`, string(out))
+}
+
+func TestIntegrationBackendStaticWithoutDependency(t *testing.T) {
+	t.Parallel()
+
+	// The static backend builds no helper, so a module that does not require
+	// jsonschema, which the helper cannot be built in, generates offline.
+	binary := buildBinary(t)
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"),
+		[]byte("module example.com/app\n\ngo "+testGoVersion+"\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "types.go"), []byte(`package app
+
+type Config struct {
+	Name string `+"`"+`json:"name"`+"`"+`
+}
+`), 0o644))
+
+	cmd := exec.CommandContext(t.Context(), binary, "-backend", "static", "-type", "Config")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOPROXY=off", "GOFLAGS=")
+
+	out, err := cmd.Output()
+	require.NoError(t, err, "stderr: %s", cmdStderr(err))
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {"name": {"type": "string"}},
+		"required": ["name"],
+		"additionalProperties": false
+	}`, string(out))
+}
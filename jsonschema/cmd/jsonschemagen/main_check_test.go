@@ -0,0 +1,198 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrationCheck(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, `package testmod
+
+type Config struct {
+	Name string `+"`"+`json:"name"`+"`"+`
+}
+
+type Limits struct {
+	Max int `+"`"+`json:"max"`+"`"+`
+}
+`)
+
+	generate := func(args ...string) ([]byte, error) {
+		cmd := exec.CommandContext(t.Context(), binary, args...)
+		cmd.Dir = dir
+
+		return cmd.Output()
+	}
+
+	args := []string{"-type", "Config,Limits", "-o-template", "{name}.schema.json"}
+
+	_, err := generate(args...)
+	require.NoError(t, err, "stderr: %s", cmdStderr(err))
+
+	// Up-to-date outputs pass silently.
+	out, err := generate(append(args, "-check")...)
+	require.NoError(t, err, "stderr: %s", cmdStderr(err))
+	assert.Empty(t, out)
+
+	// A stale file and a missing one each fail with a diff, and -check
+	// leaves both as they were.
+	configPath := filepath.Join(dir, "config.schema.json")
+	stale := []byte(`{"type": "object"}` + "\n")
+	require.NoError(t, os.WriteFile(configPath, stale, 0o644))
+	require.NoError(t, os.Remove(filepath.Join(dir, "limits.schema.json")))
+
+	out, err = generate(append(args, "-check")...)
+	require.Error(t, err)
+	assert.Contains(t, cmdStderr(err), "2 of 2 generated files are out of date")
+	assert.Contains(t, string(out), "--- a/config.schema.json\n+++ b/config.schema.json\n")
+	assert.Contains(t, string(out), "-{\"type\": \"object\"}\n")
+	assert.Contains(t, string(out), "+  \"$schema\": \"https://json-schema.org/draft/2020-12/schema\",\n")
+	assert.Contains(t, string(out), "+++ b/limits.schema.json\n")
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, stale, data)
+	assert.NoFileExists(t, filepath.Join(dir, "limits.schema.json"))
+}
+
+func TestIntegrationCheckGoValidator(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, `package testmod
+
+type Item struct {
+	ID string `+"`"+`json:"id"`+"`"+`
+}
+`)
+
+	args := []string{"-type", "Item", "-o", "item.schema.json", "-go-validator", "item_validate.go"}
+
+	cmd := exec.CommandContext(t.Context(), binary, args...)
+	cmd.Dir = dir
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "output: %s", out)
+
+	// Record the requirements the generated file's imports add, as a tidy
+	// checked-in module would have them.
+	cmd = exec.CommandContext(t.Context(), "go", "build", "-mod=mod", "./...")
+	cmd.Dir = dir
+
+	out, err = cmd.CombinedOutput()
+	require.NoError(t, err, "output: %s", out)
+
+	// A hand edit to any output, not only the schema, is stale.
+	validatorPath := filepath.Join(dir, "item_validate.go")
+	src, err := os.ReadFile(validatorPath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(validatorPath, append(src, "// edited\n"...), 0o644))
+
+	cmd = exec.CommandContext(t.Context(), binary, append(args, "-check")...)
+	cmd.Dir = dir
+
+	stdout, err := cmd.Output()
+	require.Error(t, err)
+	assert.Contains(t, cmdStderr(err), "1 of 2 generated files are out of date")
+	assert.Contains(t, string(stdout), "--- a/item_validate.go\n")
+	assert.Contains(t, string(stdout), "-// edited\n")
+}
+
+func TestIntegrationCheckGoValidatorStaleField(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, `package testmod
+
+type Item struct {
+	ID string `+"`"+`json:"id"`+"`"+`
+}
+`)
+
+	args := []string{"-type", "Item", "-o", "item.schema.json", "-go-validator", "item_validate.go"}
+
+	cmd := exec.CommandContext(t.Context(), binary, args...)
+	cmd.Dir = dir
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "output: %s", out)
+
+	cmd = exec.CommandContext(t.Context(), "go", "build", "-mod=mod", "./...")
+	cmd.Dir = dir
+
+	out, err = cmd.CombinedOutput()
+	require.NoError(t, err, "output: %s", out)
+
+	// Renaming the field leaves the validator on disk reading a field that
+	// no longer exists: -check reports it as stale with a diff rather than
+	// failing to compile the package. The JSON name is unchanged, so the
+	// schema is current.
+	types := filepath.Join(dir, "types.go")
+	src, err := os.ReadFile(types)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(types, bytes.ReplaceAll(src, []byte("ID string"), []byte("Key string")), 0o644))
+
+	cmd = exec.CommandContext(t.Context(), binary, append(args, "-check")...)
+	cmd.Dir = dir
+
+	stdout, err := cmd.Output()
+	require.Error(t, err)
+	assert.Contains(t, cmdStderr(err), "1 of 2 generated files are out of date")
+	assert.Contains(t, string(stdout), "--- a/item_validate.go\n")
+	assert.Contains(t, string(stdout), "-\tobj[\"id\"] = genrt.String(string(v.ID))\n")
+	assert.Contains(t, string(stdout), "+\tobj[\"id\"] = genrt.String(string(v.Key))\n")
+}
+
+func TestIntegrationCheckOutDirOrphan(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, `package testmod
+
+type Config struct {
+	Name string `+"`"+`json:"name"`+"`"+`
+}
+`)
+
+	generate := func(args ...string) ([]byte, error) {
+		cmd := exec.CommandContext(t.Context(), binary, args...)
+		cmd.Dir = dir
+
+		return cmd.Output()
+	}
+
+	args := []string{"-type", "Config", "-out-dir", "schemas", "-id-template", "https://example.com/schemas/{name}.json"}
+
+	_, err := generate(args...)
+	require.NoError(t, err, "stderr: %s", cmdStderr(err))
+
+	// A document left behind by a package no longer generated fails the
+	// check as a deletion; files of other extensions are not schemas.
+	orphan := filepath.Join(dir, "schemas", "old", "removed.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(orphan), 0o755))
+	require.NoError(t, os.WriteFile(orphan, []byte(`{"type": "object"}`+"\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "schemas", "README.md"), []byte("Schemas.\n"), 0o644))
+
+	out, err := generate(append(args, "-check")...)
+	require.Error(t, err)
+	assert.Contains(t, cmdStderr(err), "1 of 2 generated files are out of date")
+	assert.Contains(t, string(out), "--- a/schemas/old/removed.json\n+++ /dev/null\n")
+	assert.Contains(t, string(out), "-{\"type\": \"object\"}\n")
+	assert.NotContains(t, string(out), "README.md")
+	assert.FileExists(t, orphan)
+
+	require.NoError(t, os.Remove(orphan))
+
+	out, err = generate(append(args, "-check")...)
+	require.NoError(t, err, "stderr: %s", cmdStderr(err))
+	assert.Empty(t, out)
+}
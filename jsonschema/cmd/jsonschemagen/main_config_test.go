@@ -0,0 +1,304 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema/cmd/jsonschemagen/internal/configfile"
+)
+
+func TestRun_ConfigFlags(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		cfg  config
+		want string
+	}{
+		"draft with config": {
+			cfg:  config{Types: []string{"Foo"}, Config: "c.yaml", Draft: "7"},
+			want: "-draft cannot be combined with -config",
+		},
+		"comments with config": {
+			cfg:  config{Types: []string{"Foo"}, Config: "c.yaml", Comments: true},
+			want: "-comments cannot be combined with -config",
+		},
+		"additional properties with config": {
+			cfg:  config{Types: []string{"Foo"}, Config: "c.yaml", AdditionalProperties: true},
+			want: "-additional-properties cannot be combined with -config",
+		},
+		"validate with config": {
+			cfg:  config{Types: []string{"Foo"}, Config: "c.yaml", Validate: true},
+			want: "-validate cannot be combined with -config",
+		},
+		"config schema with config": {
+			cfg:  config{ConfigSchema: true, Config: "c.yaml"},
+			want: "-config-schema and -config are mutually exclusive",
+		},
+		"config schema with type": {
+			cfg:  config{ConfigSchema: true, Types: []string{"Foo"}},
+			want: "-config-schema takes no -type or -all-exported",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := run(tc.cfg, &bytes.Buffer{})
+			require.ErrorContains(t, err, tc.want)
+		})
+	}
+}
+
+func TestRun_ConfigSchema(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	require.NoError(t, run(config{ConfigSchema: true, Indent: "  "}, &out))
+
+	want, err := configfile.Schema(t.Context())
+	require.NoError(t, err)
+
+	wantJSON, err := json.Marshal(want)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(wantJSON), out.String())
+}
+
+func TestRenderMainGoConfigFile(t *testing.T) {
+	t.Parallel()
+
+	nullable := false
+
+	cfg := config{
+		Types:  []string{"Config"},
+		Indent: "  ",
+		File: &configfile.File{
+			Draft:    7,
+			Comments: &configfile.Comments{DocTitles: true, Enums: true},
+			Nullable: &nullable,
+			Namer:    "NewNamer()",
+			TagInterpreters: []configfile.TagInterpreter{
+				{Tag: "validate", Interpreter: "go.jacobcolvin.com/x/jsonschema/interpreters/validate.NewInterpreter()"},
+			},
+			TypeSchemaExtenders: []configfile.Ref{"go.jacobcolvin.com/x/jsonschema/interpreters/validate.NewInterpreter()"},
+			TypeSchemas: []configfile.TypeSchema{
+				{Type: "time.Duration", Schema: map[string]any{"type": "string"}, Nullability: "forbidden"},
+			},
+			Unions:   []configfile.Ref{"PetUnion()"},
+			Options:  []configfile.Ref{"go.jacobcolvin.com/x/jsonschema/interpreters/validate.Option"},
+			Defaults: map[string]configfile.Ref{"Config": "DefaultConfig()"},
+		},
+	}
+
+	var buf bytes.Buffer
+
+	require.NoError(t, renderMainGo(&buf, cfg, "example.com/foo", "/tmp/gen"))
+
+	src := buf.String()
+
+	// Each package is imported once, under its own alias.
+	assert.Contains(t, src, "\tref0 \"go.jacobcolvin.com/x/jsonschema/interpreters/validate\"\n")
+	assert.Contains(t, src, "\tref1 \"time\"\n")
+	assert.Contains(t, src, "\tprovider := jsonschema.NewGoCommentProvider(jsonschema.WithDocTitles())\n")
+
+	for _, opt := range []string{
+		"jsonschema.WithDraft(jsonschema.Draft7),",
+		"jsonschema.WithDescriptionProvider(provider),",
+		"jsonschema.WithFieldInterpreter(provider.Docs()),",
+		"jsonschema.WithTypeSchemaExtender(provider.Enums()),",
+		"jsonschema.WithNullable(false),",
+		"jsonschema.WithNamer(target.NewNamer()),",
+		`jsonschema.WithTagInterpreter("validate", ref0.NewInterpreter()),`,
+		"jsonschema.WithTypeSchemaExtender(ref0.NewInterpreter()),",
+		`jsonschema.WithTypeSchema(reflect.TypeFor[ref1.Duration](), jsonschema.TypeSchema{Value: decodeSchema("{\"type\":\"string\"}"), Nullability: jsonschema.NullForbidden}),`,
+		"jsonschema.WithUnion(target.PetUnion()),",
+		"ref0.Option,",
+		"reflect.TypeFor[target.Config](): target.DefaultConfig(),",
+		"func decodeSchema(data string) *jsonschema.Schema {",
+	} {
+		assert.Contains(t, src, opt)
+	}
+}
+
+func TestIntegrationConfigFile(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, `package testmod
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+// Config is the service configuration.
+type Config struct {
+	// Name names the service.
+	Name    string        `+"`"+`json:"name" validate:"required,min=3"`+"`"+`
+	Timeout time.Duration `+"`"+`json:"timeout"`+"`"+`
+	Tags    []string      `+"`"+`json:"tags"`+"`"+`
+	Peer    *Peer         `+"`"+`json:"peer,omitempty"`+"`"+`
+	Shape   Shape         `+"`"+`json:"shape"`+"`"+`
+}
+
+type Peer struct {
+	Addr string `+"`"+`json:"addr"`+"`"+`
+}
+
+type Shape interface{ Area() float64 }
+
+type Square struct {
+	Kind string  `+"`"+`json:"kind"`+"`"+`
+	Side float64 `+"`"+`json:"side"`+"`"+`
+}
+
+func (s Square) Area() float64 { return s.Side * s.Side }
+
+type Circle struct {
+	Kind   string  `+"`"+`json:"kind"`+"`"+`
+	Radius float64 `+"`"+`json:"radius"`+"`"+`
+}
+
+func (c Circle) Area() float64 { return 3 * c.Radius * c.Radius }
+
+func ShapeUnion() *jsonschema.Union[Shape] {
+	u, err := jsonschema.NewUnion[Shape]("kind",
+		jsonschema.Variant[Square]("square"),
+		jsonschema.Variant[Circle]("circle"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
+
+// ReadOnlyOption sets an option the config file has no field for.
+func ReadOnlyOption() jsonschema.GenerateOption {
+	return jsonschema.WithTypeSchemaExtender(jsonschema.TypeSchemaExtenderFunc(
+		func(_ context.Context, tc jsonschema.TypeContext, ts *jsonschema.TypeSchema) error {
+			if tc.Type == reflect.TypeFor[Peer]() {
+				ts.Value.ReadOnly = true
+			}
+
+			return nil
+		}))
+}
+
+func DefaultConfig() Config {
+	return Config{Name: "svc", Timeout: time.Second}
+}
+
+func NewNamer() jsonschema.Namer {
+	return jsonschema.NamerFunc(func(tc jsonschema.TypeContext) string {
+		return "testmod." + tc.Type.Name()
+	})
+}
+`)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "jsonschema.yaml"), []byte(`
+comments: {}
+nullable: false
+rootTitle: true
+namer: NewNamer()
+tagInterpreters:
+  - tag: validate
+    interpreter: go.jacobcolvin.com/x/jsonschema/interpreters/validate.NewInterpreter()
+typeSchemas:
+  - type: time.Duration
+    schema: {type: string, pattern: '^[0-9]+(ns|us|ms|s|m|h)$'}
+unions: [ShapeUnion()]
+options: [ReadOnlyOption()]
+defaults:
+  Config: DefaultConfig()
+`), 0o644))
+
+	// The package imports jsonschema for its namer, which the test module
+	// must require directly.
+	tidy := exec.CommandContext(t.Context(), "go", "build", "-mod=mod", "./...")
+	tidy.Dir = dir
+	tidyOut, err := tidy.CombinedOutput()
+	require.NoError(t, err, "%s", tidyOut)
+
+	cmd := exec.CommandContext(t.Context(), binary, "-type", "Config", "-config", "jsonschema.yaml")
+	cmd.Dir = dir
+
+	out, err := cmd.Output()
+	require.NoError(t, err, "stderr: %s", cmdStderr(err))
+
+	var schema struct {
+		Title      string                    `json:"title"`
+		Properties map[string]map[string]any `json:"properties"`
+		Defs       map[string]any            `json:"$defs"`
+	}
+
+	require.NoError(t, json.Unmarshal(out, &schema))
+
+	// The namer names the root title and the definitions alike.
+	assert.Equal(t, "testmod.Config", schema.Title)
+	assert.Contains(t, schema.Defs, "testmod.Peer")
+	assert.Equal(t, map[string]any{"$ref": "#/$defs/testmod.Peer"}, schema.Properties["peer"])
+
+	assert.Equal(t, "Name names the service.", schema.Properties["name"]["description"])
+	assert.InDelta(t, 3, schema.Properties["name"]["minLength"], 0)
+	assert.Equal(t, "svc", schema.Properties["name"]["default"])
+
+	assert.Equal(t, "string", schema.Properties["timeout"]["type"])
+	assert.Equal(t, "^[0-9]+(ns|us|ms|s|m|h)$", schema.Properties["timeout"]["pattern"])
+	assert.InDelta(t, 1e9, schema.Properties["timeout"]["default"], 0)
+
+	// The union is a oneOf over its variants; the option is applied too.
+	assert.Contains(t, schema.Defs, "testmod.Square")
+	assert.Contains(t, schema.Defs["testmod.Shape"], "oneOf")
+	assert.Equal(t, true, schema.Defs["testmod.Peer"].(map[string]any)["readOnly"])
+
+	// Without nullable, a slice is not an anyOf with null.
+	assert.Equal(t, "array", schema.Properties["tags"]["type"])
+}
+
+func TestIntegrationConfigFileErrors(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+
+	tcs := map[string]struct {
+		config string
+		want   string
+	}{
+		"schema violation": {
+			config: "draft: 4\n",
+			want:   "invalid configuration file",
+		},
+		"unknown defaults type": {
+			config: "defaults:\n  Other: DefaultOther()\n",
+			want:   "config defaults name Other, which is not a generated type",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := createTestModule(t, "package testmod\n\ntype Config struct {\n\tName string\n}\n")
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "jsonschema.yaml"), []byte(tc.config), 0o644))
+
+			cmd := exec.CommandContext(t.Context(), binary, "-type", "Config", "-config", "jsonschema.yaml")
+			cmd.Dir = dir
+
+			_, err := cmd.Output()
+			require.Error(t, err)
+			assert.Contains(t, cmdStderr(err), tc.want)
+		})
+	}
+}
@@ -64,6 +64,65 @@ type Secret struct {
 	}`, string(out))
 }
 
+func TestIntegrationOutDir(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := t.TempDir()
+	writeModuleFiles(t, dir, "example.com/app")
+
+	typesDir := filepath.Join(dir, "types")
+	require.NoError(t, os.MkdirAll(typesDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(typesDir, "types.go"), []byte(`package types
+
+type Item struct {
+	SKU string `+"`"+`json:"sku"`+"`"+`
+}
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.go"), []byte(`package app
+
+import "example.com/app/types"
+
+type Order struct {
+	Item types.Item `+"`"+`json:"item"`+"`"+`
+}
+`), 0o644))
+
+	cmd := exec.CommandContext(t.Context(), binary, "-type", "Order",
+		"-out-dir", "schemas", "-id-template", "https://example.com/schemas/{name}.json")
+	cmd.Dir = dir
+
+	out, err := cmd.Output()
+	require.NoError(t, err, "stderr: %s", cmdStderr(err))
+	assert.Empty(t, out)
+
+	app, err := os.ReadFile(filepath.Join(dir, "schemas", "app.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$id": "https://example.com/schemas/app.json",
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {"item": {"$ref": "types.json#/$defs/Item"}},
+		"required": ["item"],
+		"additionalProperties": false
+	}`, string(app))
+
+	types, err := os.ReadFile(filepath.Join(dir, "schemas", "types.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$id": "https://example.com/schemas/types.json",
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$defs": {
+			"Item": {
+				"type": "object",
+				"properties": {"sku": {"type": "string"}},
+				"required": ["sku"],
+				"additionalProperties": false
+			}
+		}
+	}`, string(types))
+}
+
 func TestIntegrationCommentsSeedsMissingChecksums(t *testing.T) {
 	t.Parallel()
 
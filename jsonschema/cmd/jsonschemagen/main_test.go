@@ -24,9 +24,9 @@ func TestRenderMainGo(t *testing.T) {
 	}{
 		"defaults": {
 			cfg: config{
-				TypeName: "Config",
-				Draft:    "2020",
-				Indent:   "  ",
+				Types:  []string{"Config"},
+				Draft:  "2020",
+				Indent: "  ",
 			},
 			importPath: "example.com/myapp",
 			want: `package main
@@ -36,6 +36,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 
 	"go.jacobcolvin.com/x/jsonschema"
@@ -44,21 +45,212 @@ import (
 )
 
 func main() {
-	t := reflect.TypeFor[target.Config]()
+	types := []reflect.Type{
+		reflect.TypeFor[target.Config](),
+	}
 	opts := []jsonschema.GenerateOption{
 	}
-	schema, err := jsonschema.Generate(context.Background(), t, opts...)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "%v\n", err)
-		os.Exit(1)
+	schemas := make([]*jsonschema.Schema, len(types))
+	for i, t := range types {
+		schema, err := jsonschema.Generate(context.Background(), t, opts...)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data = append(data, '\n')
+		err = os.WriteFile(filepath.Join("/tmp/gen", fmt.Sprintf("schema-%d.json", i)), data, 0o600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		schemas[i] = schema
+	}
+}
+`,
+		},
+		"draft7": {
+			cfg: config{
+				Types:  []string{"Settings"},
+				Draft:  "7",
+				Indent: "\t",
+			},
+			importPath: "example.com/pkg",
+			want: `package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"go.jacobcolvin.com/x/jsonschema"
+
+	target "example.com/pkg"
+)
+
+func main() {
+	types := []reflect.Type{
+		reflect.TypeFor[target.Settings](),
+	}
+	opts := []jsonschema.GenerateOption{
+		jsonschema.WithDraft(jsonschema.Draft7),
+	}
+	schemas := make([]*jsonschema.Schema, len(types))
+	for i, t := range types {
+		schema, err := jsonschema.Generate(context.Background(), t, opts...)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data, err := json.MarshalIndent(schema, "", "\t")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data = append(data, '\n')
+		err = os.WriteFile(filepath.Join("/tmp/gen", fmt.Sprintf("schema-%d.json", i)), data, 0o600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		schemas[i] = schema
+	}
+}
+`,
+		},
+		"all options": {
+			cfg: config{
+				Types:                []string{"MyType"},
+				Draft:                "2020",
+				Comments:             true,
+				AdditionalProperties: true,
+				Validate:             true,
+				Indent:               "    ",
+			},
+			importPath: "example.com/full",
+			want: `package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/interpreters/validate"
+
+	target "example.com/full"
+)
+
+func main() {
+	types := []reflect.Type{
+		reflect.TypeFor[target.MyType](),
+	}
+	opts := []jsonschema.GenerateOption{
+		jsonschema.WithDescriptionProvider(jsonschema.NewGoCommentProvider()),
+		jsonschema.WithAdditionalProperties(true),
+		jsonschema.WithTagInterpreter("validate", validate.NewInterpreter()),
+	}
+	schemas := make([]*jsonschema.Schema, len(types))
+	for i, t := range types {
+		schema, err := jsonschema.Generate(context.Background(), t, opts...)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data, err := json.MarshalIndent(schema, "", "    ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data = append(data, '\n')
+		err = os.WriteFile(filepath.Join("/tmp/gen", fmt.Sprintf("schema-%d.json", i)), data, 0o600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		schemas[i] = schema
 	}
-	data, err := json.MarshalIndent(schema, "", "  ")
+}
+`,
+		},
+		"go validator": {
+			cfg: config{
+				Types:       []string{"MyType"},
+				Indent:      "  ",
+				GoValidator: "myapp_validate.go",
+				Package:     "myapp",
+			},
+			importPath: "example.com/myapp",
+			want: `package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"go.jacobcolvin.com/x/jsonschema"
+
+	target "example.com/myapp"
+)
+
+func main() {
+	types := []reflect.Type{
+		reflect.TypeFor[target.MyType](),
+	}
+	opts := []jsonschema.GenerateOption{
+	}
+	schemas := make([]*jsonschema.Schema, len(types))
+	for i, t := range types {
+		schema, err := jsonschema.Generate(context.Background(), t, opts...)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data = append(data, '\n')
+		err = os.WriteFile(filepath.Join("/tmp/gen", fmt.Sprintf("schema-%d.json", i)), data, 0o600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		schemas[i] = schema
+	}
+	var funcs []jsonschema.GoFunc
+	for i, name := range []string{"ValidateMyType"} {
+		validator, err := jsonschema.Compile(context.Background(), schemas[i])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		funcs = append(funcs, jsonschema.GoFunc{Name: name, Validator: validator, Type: types[i]})
+	}
+	src, err := jsonschema.GenerateGo(context.Background(), jsonschema.GoConfig{
+		Package:    "myapp",
+		ImportPath: "example.com/myapp",
+		Funcs:      funcs,
+	})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
-	data = append(data, '\n')
-	err = os.WriteFile("/tmp/gen/schema.json", data, 0o600)
+	err = os.WriteFile("/tmp/gen/validator.go.out", src, 0o600)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
@@ -66,13 +258,14 @@ func main() {
 }
 `,
 		},
-		"draft7": {
+		"comment table": {
 			cfg: config{
-				TypeName: "Settings",
-				Draft:    "7",
-				Indent:   "\t",
+				Types:        []string{"MyType"},
+				Indent:       "  ",
+				CommentTable: "mytype_comments.go",
+				Package:      "myapp",
 			},
-			importPath: "example.com/pkg",
+			importPath: "example.com/myapp",
 			want: `package main
 
 import (
@@ -80,30 +273,51 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 
 	"go.jacobcolvin.com/x/jsonschema"
 
-	target "example.com/pkg"
+	target "example.com/myapp"
 )
 
 func main() {
-	t := reflect.TypeFor[target.Settings]()
+	types := []reflect.Type{
+		reflect.TypeFor[target.MyType](),
+	}
 	opts := []jsonschema.GenerateOption{
-		jsonschema.WithDraft(jsonschema.Draft7),
 	}
-	schema, err := jsonschema.Generate(context.Background(), t, opts...)
+	schemas := make([]*jsonschema.Schema, len(types))
+	for i, t := range types {
+		schema, err := jsonschema.Generate(context.Background(), t, opts...)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data = append(data, '\n')
+		err = os.WriteFile(filepath.Join("/tmp/gen", fmt.Sprintf("schema-%d.json", i)), data, 0o600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		schemas[i] = schema
+	}
+	table, err := jsonschema.NewGoCommentProvider().ExtractTable(context.Background(), types, opts...)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
-	data, err := json.MarshalIndent(schema, "", "\t")
+	comments, err := table.GoSource("myapp", "MyTypeComments")
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
-	data = append(data, '\n')
-	err = os.WriteFile("/tmp/gen/schema.json", data, 0o600)
+	err = os.WriteFile("/tmp/gen/comments.go.out", comments, 0o600)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
@@ -111,16 +325,16 @@ func main() {
 }
 `,
 		},
-		"all options": {
+		"several types": {
 			cfg: config{
-				TypeName:             "MyType",
-				Draft:                "2020",
-				Comments:             true,
-				AdditionalProperties: true,
-				Validate:             true,
-				Indent:               "    ",
+				Types:        []string{"Server", "Client"},
+				Indent:       "  ",
+				GoValidator:  "validators.go",
+				CommentTable: "comments.go",
+				CommentVar:   "Docs",
+				Package:      "myapp",
 			},
-			importPath: "example.com/full",
+			importPath: "example.com/myapp",
 			want: `package main
 
 import (
@@ -128,37 +342,139 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 
 	"go.jacobcolvin.com/x/jsonschema"
-	"go.jacobcolvin.com/x/jsonschema/interpreters/validate"
 
-	target "example.com/full"
+	target "example.com/myapp"
 )
 
 func main() {
-	t := reflect.TypeFor[target.MyType]()
+	types := []reflect.Type{
+		reflect.TypeFor[target.Server](),
+		reflect.TypeFor[target.Client](),
+	}
 	opts := []jsonschema.GenerateOption{
-		jsonschema.WithDescriptionProvider(jsonschema.NewGoCommentProvider()),
-		jsonschema.WithAdditionalProperties(true),
-		jsonschema.WithTagInterpreter("validate", validate.NewInterpreter()),
 	}
-	schema, err := jsonschema.Generate(context.Background(), t, opts...)
+	schemas := make([]*jsonschema.Schema, len(types))
+	for i, t := range types {
+		schema, err := jsonschema.Generate(context.Background(), t, opts...)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data = append(data, '\n')
+		err = os.WriteFile(filepath.Join("/tmp/gen", fmt.Sprintf("schema-%d.json", i)), data, 0o600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		schemas[i] = schema
+	}
+	var funcs []jsonschema.GoFunc
+	for i, name := range []string{"ValidateServer", "ValidateClient"} {
+		validator, err := jsonschema.Compile(context.Background(), schemas[i])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		funcs = append(funcs, jsonschema.GoFunc{Name: name, Validator: validator, Type: types[i]})
+	}
+	src, err := jsonschema.GenerateGo(context.Background(), jsonschema.GoConfig{
+		Package:    "myapp",
+		ImportPath: "example.com/myapp",
+		Funcs:      funcs,
+	})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
-	data, err := json.MarshalIndent(schema, "", "    ")
+	err = os.WriteFile("/tmp/gen/validator.go.out", src, 0o600)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
-	data = append(data, '\n')
-	err = os.WriteFile("/tmp/gen/schema.json", data, 0o600)
+	table, err := jsonschema.NewGoCommentProvider().ExtractTable(context.Background(), types, opts...)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
+	comments, err := table.GoSource("myapp", "Docs")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	err = os.WriteFile("/tmp/gen/comments.go.out", comments, 0o600)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+`,
+		},
+		"out dir": {
+			cfg: config{
+				Types:      []string{"MyType"},
+				Indent:     "  ",
+				OutDir:     "schemas",
+				IDTemplate: "https://example.com/{name}.json",
+				Split:      "type",
+			},
+			importPath: "example.com/myapp",
+			want: `package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"go.jacobcolvin.com/x/jsonschema"
+
+	target "example.com/myapp"
+)
+
+func main() {
+	types := []reflect.Type{
+		reflect.TypeFor[target.MyType](),
+	}
+	opts := []jsonschema.GenerateOption{
+	}
+	files, err := jsonschema.GenerateFiles(context.Background(), types[0], jsonschema.FileLayout{
+		IDTemplate: "https://example.com/{name}.json",
+		Split:      jsonschema.SplitByType,
+	}, opts...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	for name, schema := range files.Files {
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		data = append(data, '\n')
+		path := filepath.Join("/tmp/gen/files", filepath.FromSlash(name))
+		err = os.MkdirAll(filepath.Dir(path), 0o700)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		err = os.WriteFile(path, data, 0o600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+	}
 }
 `,
 		},
@@ -170,7 +486,7 @@ func main() {
 
 			var buf bytes.Buffer
 
-			err := renderMainGo(&buf, tc.cfg, tc.importPath, "/tmp/gen/schema.json")
+			err := renderMainGo(&buf, tc.cfg, tc.importPath, "/tmp/gen")
 			require.NoError(t, err)
 			assert.Equal(t, tc.want, buf.String())
 		})
@@ -188,7 +504,7 @@ func TestRun_MissingType(t *testing.T) {
 func TestRun_InvalidDraft(t *testing.T) {
 	t.Parallel()
 
-	err := run(config{TypeName: "Foo", Draft: "4"}, &bytes.Buffer{})
+	err := run(config{Types: []string{"Foo"}, Draft: "4"}, &bytes.Buffer{})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "unsupported draft")
 }
@@ -196,7 +512,7 @@ func TestRun_InvalidDraft(t *testing.T) {
 func TestRun_InvalidIndent(t *testing.T) {
 	t.Parallel()
 
-	err := run(config{TypeName: "Foo", Draft: "2020", Indent: "xx"}, &bytes.Buffer{})
+	err := run(config{Types: []string{"Foo"}, Draft: "2020", Indent: "xx"}, &bytes.Buffer{})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid -indent")
 }
@@ -447,6 +763,317 @@ type Item struct {
 	}`, string(data))
 }
 
+func TestIntegration_GoValidator(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, `package testmod
+
+type Item struct {
+	ID    string `+"`"+`json:"id" jsonschema:"minLength=2"`+"`"+`
+	Count int    `+"`"+`json:"count" jsonschema:"maximum=9"`+"`"+`
+}
+`)
+
+	cmd := exec.CommandContext(t.Context(), binary, "-type", "Item", "-o", "item.schema.json",
+		"-go-validator", "item_validate.go")
+	cmd.Dir = dir
+
+	cmdOut, err := cmd.CombinedOutput()
+	require.NoError(t, err, "output: %s", cmdOut)
+
+	src, err := os.ReadFile(filepath.Join(dir, "item_validate.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "package testmod")
+	assert.Contains(t, string(src), "func ValidateItem(instance any) error")
+	assert.Contains(t, string(src), "func ValidateItemValue(v *Item) error")
+
+	// The generated file must compile in the target package and agree with
+	// the runtime validator.
+	test := `package testmod
+
+import "testing"
+
+func TestGenerated(t *testing.T) {
+	if err := ValidateItemValue(&Item{ID: "ab", Count: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateItemValue(&Item{ID: "a", Count: 10}); err == nil {
+		t.Fatal("want error")
+	}
+}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "item_test.go"), []byte(test), 0o644))
+
+	// -mod=mod lets go record the requirements the generated file's
+	// imports add to the test module.
+	cmd = exec.CommandContext(t.Context(), "go", "test", "-mod=mod", "./...")
+	cmd.Dir = dir
+
+	cmdOut, err = cmd.CombinedOutput()
+	require.NoError(t, err, "output: %s", cmdOut)
+}
+
+func TestIntegration_GoValidatorStaleField(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, `package testmod
+
+type Item struct {
+	ID    string `+"`"+`json:"id"`+"`"+`
+	Count int    `+"`"+`json:"count"`+"`"+`
+}
+`)
+
+	generate := func() {
+		t.Helper()
+
+		cmd := exec.CommandContext(t.Context(), binary, "-type", "Item", "-o", "item.schema.json",
+			"-go-validator", "item_validate.go")
+		cmd.Dir = dir
+
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "output: %s", out)
+	}
+
+	generate()
+
+	// Record the requirements the generated file's imports add, as a tidy
+	// checked-in module would have them.
+	build := func() {
+		t.Helper()
+
+		cmd := exec.CommandContext(t.Context(), "go", "build", "-mod=mod", "./...")
+		cmd.Dir = dir
+
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "output: %s", out)
+	}
+
+	build()
+
+	// Code in the package calling the validator keeps compiling in the
+	// helper build.
+	check := "package testmod\n\nfunc Check(v *Item) error { return ValidateItemValue(v) }\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "check.go"), []byte(check), 0o644))
+
+	// Renaming a field leaves the validator reading one that no longer
+	// exists; regenerating must still succeed and replace it.
+	types := filepath.Join(dir, "types.go")
+	src, err := os.ReadFile(types)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(types, bytes.ReplaceAll(src, []byte("Count int "), []byte("Total int ")), 0o644))
+
+	generate()
+
+	src, err = os.ReadFile(filepath.Join(dir, "item_validate.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "v.Total")
+	assert.NotContains(t, string(src), "v.Count")
+
+	build()
+}
+
+func TestRun_OutDirFlags(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		cfg  config
+		want string
+	}{
+		"id template without out dir": {
+			cfg:  config{IDTemplate: "https://example.com/{name}.json"},
+			want: "-id-template requires -out-dir",
+		},
+		"split without out dir": {
+			cfg:  config{Split: "type"},
+			want: "-split requires -out-dir",
+		},
+		"out dir without id template": {
+			cfg:  config{OutDir: "schemas"},
+			want: "-out-dir requires -id-template",
+		},
+		"out dir and output": {
+			cfg:  config{OutDir: "schemas", IDTemplate: "https://example.com/{name}.json", Output: "schema.json"},
+			want: "-o and -out-dir are mutually exclusive",
+		},
+		"out dir and go validator": {
+			cfg:  config{OutDir: "schemas", IDTemplate: "https://example.com/{name}.json", GoValidator: "v.go"},
+			want: "-go-validator cannot be combined with -out-dir",
+		},
+		"unknown split": {
+			cfg:  config{OutDir: "schemas", IDTemplate: "https://example.com/{name}.json", Split: "file"},
+			want: "unsupported -split",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.cfg.Types, tc.cfg.Draft, tc.cfg.Indent = []string{"Foo"}, "2020", "  "
+
+			err := run(tc.cfg, &bytes.Buffer{})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.want)
+		})
+	}
+}
+
+func TestRun_GoFuncWithoutGoValidator(t *testing.T) {
+	t.Parallel()
+
+	err := run(config{Types: []string{"Foo"}, Draft: "2020", Indent: "  ", GoFunc: "Check"}, &bytes.Buffer{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "-go-func requires -go-validator")
+}
+
+func TestRun_CommentVarWithoutCommentTable(t *testing.T) {
+	t.Parallel()
+
+	err := run(config{Types: []string{"Foo"}, Draft: "2020", Indent: "  ", CommentVar: "Docs"}, &bytes.Buffer{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "-comment-var requires -comment-table")
+}
+
+func TestRun_TypeFlags(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		cfg  config
+		want string
+	}{
+		"no type": {
+			cfg:  config{},
+			want: "-type flag is required",
+		},
+		"duplicate type": {
+			cfg:  config{Types: []string{"Foo", "Bar", "Foo"}, OutputTemplate: "{name}.json"},
+			want: `duplicate -type "Foo"`,
+		},
+		"type and all exported": {
+			cfg:  config{Types: []string{"Foo"}, AllExported: true},
+			want: "-type and -all-exported are mutually exclusive",
+		},
+		"include without all exported": {
+			cfg:  config{Types: []string{"Foo"}, Include: []string{"F*"}},
+			want: "-include and -exclude require -all-exported",
+		},
+		"bad glob": {
+			cfg:  config{AllExported: true, Exclude: []string{"[F"}, OutputTemplate: "{name}.json"},
+			want: `invalid glob "[F"`,
+		},
+		"several types to stdout": {
+			cfg:  config{Types: []string{"Foo", "Bar"}},
+			want: "generating several types requires -o-template",
+		},
+		"several types to one file": {
+			cfg:  config{Types: []string{"Foo", "Bar"}, Output: "schema.json"},
+			want: "-o takes a single type",
+		},
+		"all exported to out dir": {
+			cfg:  config{AllExported: true, OutDir: "schemas", IDTemplate: "https://example.com/{name}.json"},
+			want: "-out-dir takes a single -type",
+		},
+		"several types with go func": {
+			cfg:  config{Types: []string{"Foo", "Bar"}, OutputTemplate: "{name}.json", GoValidator: "v.go", GoFunc: "Check"},
+			want: "-go-func takes a single type",
+		},
+		"several types without comment var": {
+			cfg:  config{Types: []string{"Foo", "Bar"}, OutputTemplate: "{name}.json", CommentTable: "c.go"},
+			want: "-comment-table requires -comment-var when generating several types",
+		},
+		"output and template": {
+			cfg:  config{Types: []string{"Foo"}, Output: "foo.json", OutputTemplate: "{name}.json"},
+			want: "-o and -o-template are mutually exclusive",
+		},
+		"template without placeholder": {
+			cfg:  config{Types: []string{"Foo"}, OutputTemplate: "schema.json"},
+			want: "has no {type} or {name} placeholder",
+		},
+		"template with unknown placeholder": {
+			cfg:  config{Types: []string{"Foo"}, OutputTemplate: "{package}/{name}.json"},
+			want: "unknown placeholder {package}",
+		},
+		"check to stdout": {
+			cfg:  config{Types: []string{"Foo"}, Check: true},
+			want: "-check requires -o, -o-template, or -out-dir",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.cfg.Draft, tc.cfg.Indent = "2020", "  "
+
+			err := run(tc.cfg, &bytes.Buffer{})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.want)
+		})
+	}
+}
+
+func TestIntegration_CommentTable(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, `package testmod
+
+// Server holds server configuration.
+type Server struct {
+	// Host is the server hostname.
+	Host string `+"`"+`json:"host"`+"`"+`
+	// Port is the server port number.
+	Port int `+"`"+`json:"port"`+"`"+`
+}
+`)
+
+	cmd := exec.CommandContext(t.Context(), binary, "-type", "Server", "-o", "server.schema.json",
+		"-comment-table", "server_comments.go")
+	cmd.Dir = dir
+
+	cmdOut, err := cmd.CombinedOutput()
+	require.NoError(t, err, "output: %s", cmdOut)
+
+	src, err := os.ReadFile(filepath.Join(dir, "server_comments.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "package testmod")
+	assert.Contains(t, string(src), "var ServerComments = jsonschema.CommentTable{")
+
+	// The generated table must compile in the target package and describe
+	// the type as the comment provider would, with no sources to load.
+	test := `package testmod
+
+import (
+	"testing"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+func TestGenerated(t *testing.T) {
+	s, err := jsonschema.GenerateFor[Server](t.Context(), jsonschema.WithDescriptionProvider(ServerComments))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Description != "Server holds server configuration." {
+		t.Fatalf("description %q", s.Description)
+	}
+	if s.Properties["port"].Description != "Port is the server port number." {
+		t.Fatalf("port description %q", s.Properties["port"].Description)
+	}
+}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "server_test.go"), []byte(test), 0o644))
+
+	cmd = exec.CommandContext(t.Context(), "go", "test", "-mod=mod", "./...")
+	cmd.Dir = dir
+
+	cmdOut, err = cmd.CombinedOutput()
+	require.NoError(t, err, "output: %s", cmdOut)
+}
+
 func TestIntegration_MissingType(t *testing.T) {
 	t.Parallel()
 
@@ -526,14 +1153,14 @@ func TestRenderMainGoRejectsInjectedTypeName(t *testing.T) {
 	// rejects any value that is not a plain Go identifier rather than emitting
 	// source a crafted name could escape into.
 	cfg := config{
-		TypeName: "Foo]()\n\tos.Exit(0)\n\t//",
-		Draft:    "2020",
-		Indent:   "  ",
+		Types:  []string{"Foo]()\n\tos.Exit(0)\n\t//"},
+		Draft:  "2020",
+		Indent: "  ",
 	}
 
 	var b strings.Builder
 
-	err := renderMainGo(&b, cfg, "example.com/myapp", "/tmp/gen/schema.json")
+	err := renderMainGo(&b, cfg, "example.com/myapp", "/tmp/gen")
 	require.Error(t, err, "renderMainGo should reject TypeName with special characters")
 }
 
@@ -544,18 +1171,39 @@ func TestRenderMainGoRejectsUnexportedTypeName(t *testing.T) {
 	// package, so renderMainGo rejects it up front with a clear message instead
 	// of deferring to an opaque "undefined: target.myConfig" compiler error.
 	cfg := config{
-		TypeName: "myConfig",
-		Draft:    "2020",
-		Indent:   "  ",
+		Types:  []string{"myConfig"},
+		Draft:  "2020",
+		Indent: "  ",
 	}
 
 	var b strings.Builder
 
-	err := renderMainGo(&b, cfg, "example.com/myapp", "/tmp/gen/schema.json")
+	err := renderMainGo(&b, cfg, "example.com/myapp", "/tmp/gen")
 	require.Error(t, err, "renderMainGo should reject an unexported TypeName")
 	assert.Contains(t, err.Error(), "exported")
 }
 
+func TestRenderMainGoRejectsInvalidGoFunc(t *testing.T) {
+	t.Parallel()
+
+	// The validator name is interpolated into the helper and then becomes a
+	// function declaration, so it must be an exported Go identifier.
+	cfg := config{
+		Types:       []string{"Foo"},
+		Draft:       "2020",
+		Indent:      "  ",
+		GoValidator: "foo_validate.go",
+		GoFunc:      "validate-foo",
+		Package:     "myapp",
+	}
+
+	var b strings.Builder
+
+	err := renderMainGo(&b, cfg, "example.com/myapp", "/tmp/gen")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "-go-func")
+}
+
 func TestRenderMainGoRejectsInjectedImportPath(t *testing.T) {
 	t.Parallel()
 
@@ -564,16 +1212,16 @@ func TestRenderMainGoRejectsInjectedImportPath(t *testing.T) {
 	// backtick, backslash, or whitespace characters that could break out of the
 	// import declaration's string literal.
 	cfg := config{
-		TypeName: "Foo",
-		Draft:    "2020",
-		Indent:   "  ",
+		Types:  []string{"Foo"},
+		Draft:  "2020",
+		Indent: "  ",
 	}
 
 	malicious := `example.com/myapp"` + "\n\t\"os"
 
 	var b strings.Builder
 
-	err := renderMainGo(&b, cfg, malicious, "/tmp/gen/schema.json")
+	err := renderMainGo(&b, cfg, malicious, "/tmp/gen")
 	require.Error(t, err, "renderMainGo should reject ImportPath with injection characters")
 }
 
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrationSeveralTypes(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, `package testmod
+
+type Server struct {
+	Host string `+"`"+`json:"host"`+"`"+`
+}
+
+type Client struct {
+	Retries int `+"`"+`json:"retries"`+"`"+`
+}
+`)
+
+	// Repeated and comma-separated -type values accumulate, and each schema
+	// lands at its -o-template path, directories included.
+	cmd := exec.CommandContext(t.Context(), binary, "-type", "Server", "-type", "Client",
+		"-o-template", "schemas/{name}.schema.json", "-go-validator", "validate.go")
+	cmd.Dir = dir
+
+	out, err := cmd.Output()
+	require.NoError(t, err, "stderr: %s", cmdStderr(err))
+	assert.Empty(t, out)
+
+	server, err := os.ReadFile(filepath.Join(dir, "schemas", "server.schema.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {"host": {"type": "string"}},
+		"required": ["host"],
+		"additionalProperties": false
+	}`, string(server))
+
+	client, err := os.ReadFile(filepath.Join(dir, "schemas", "client.schema.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {"retries": {"type": "integer"}},
+		"required": ["retries"],
+		"additionalProperties": false
+	}`, string(client))
+
+	src, err := os.ReadFile(filepath.Join(dir, "validate.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "func ValidateServer(instance any) error")
+	assert.Contains(t, string(src), "func ValidateClientValue(v *Client) error")
+
+	// -mod=mod lets go record the requirements the generated file's
+	// imports add to the test module.
+	vet := exec.CommandContext(t.Context(), "go", "vet", "-mod=mod", ".")
+	vet.Dir = dir
+
+	vetOut, err := vet.CombinedOutput()
+	require.NoError(t, err, "output: %s", vetOut)
+}
+
+func TestIntegrationAllExported(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, `package testmod
+
+type Server struct {
+	Host string `+"`"+`json:"host"`+"`"+`
+}
+
+type ServerList []Server
+
+type Client struct {
+	Retries int `+"`"+`json:"retries"`+"`"+`
+}
+
+type ClientOptions struct {
+	Verbose bool `+"`"+`json:"verbose"`+"`"+`
+}
+
+type (
+	Handler     func(Server) error
+	Events      chan Server
+	Store       interface{ Get() Server }
+	Page[T any] struct{ Items []T }
+	Host        = Server
+	internal    struct{}
+)
+`)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ignored.go"), []byte(`//go:build ignore
+
+package testmod
+
+type Ignored struct{}
+`), 0o644))
+
+	cmd := exec.CommandContext(t.Context(), binary, "-all-exported",
+		"-include", "S*,C*", "-exclude", "*Options", "-o-template", "{type}.json")
+	cmd.Dir = dir
+
+	out, err := cmd.Output()
+	require.NoError(t, err, "stderr: %s", cmdStderr(err))
+	assert.Empty(t, out)
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+
+	var names []string
+	for _, m := range matches {
+		names = append(names, filepath.Base(m))
+	}
+
+	assert.ElementsMatch(t, []string{"Client.json", "Server.json", "ServerList.json"}, names)
+
+	list, err := os.ReadFile(filepath.Join(dir, "ServerList.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(list), `"$ref": "#/$defs/Server"`)
+}
+
+func TestIntegrationAllExportedNoMatch(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, `package testmod
+
+type Server struct{}
+`)
+
+	cmd := exec.CommandContext(t.Context(), binary, "-all-exported", "-include", "Client*",
+		"-o-template", "{name}.json")
+	cmd.Dir = dir
+
+	out, err := cmd.CombinedOutput()
+	require.Error(t, err)
+	assert.Contains(t, string(out), "no exported type in example.com/testmod matches -all-exported")
+}
+
+func TestIntegrationOutputTemplateCollision(t *testing.T) {
+	t.Parallel()
+
+	binary := buildBinary(t)
+	dir := createTestModule(t, `package testmod
+
+type URL struct{}
+
+type Url struct{}
+`)
+
+	cmd := exec.CommandContext(t.Context(), binary, "-type", "URL,Url", "-o-template", "{name}.json")
+	cmd.Dir = dir
+
+	out, err := cmd.CombinedOutput()
+	require.Error(t, err)
+	assert.Contains(t, string(out), `-o-template names the same file "url.json" for URL and Url`)
+	assert.NoFileExists(t, filepath.Join(dir, "url.json"))
+}
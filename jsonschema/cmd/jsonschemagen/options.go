@@ -0,0 +1,345 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.jacobcolvin.com/x/jsonschema/cmd/jsonschemagen/internal/configfile"
+)
+
+// validateImport is the import path of the validate tag interpreter the
+// -validate flag registers.
+const validateImport = jsonschemaModule + "/interpreters/validate"
+
+// checkConfig validates -config and -config-schema against the flags whose
+// settings the configuration file takes over.
+func checkConfig(cfg config) error {
+	if cfg.ConfigSchema {
+		switch {
+		case cfg.Config != "":
+			return fmt.Errorf("-config-schema and -config are mutually exclusive")
+		case len(cfg.Types) > 0 || cfg.AllExported:
+			return fmt.Errorf("-config-schema takes no -type or -all-exported")
+		}
+
+		return nil
+	}
+
+	if cfg.Config == "" {
+		return nil
+	}
+
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"-draft", cfg.Draft != ""},
+		{"-comments", cfg.Comments},
+		{"-additional-properties", cfg.AdditionalProperties},
+		{"-validate", cfg.Validate},
+	} {
+		if f.set {
+			return fmt.Errorf("%s cannot be combined with -config; set it in the config file", f.name)
+		}
+	}
+
+	return nil
+}
+
+// writeConfigSchema writes the JSON Schema of the -config file to w.
+func writeConfigSchema(w io.Writer, indent string) error {
+	schema, err := configfile.Schema(context.Background())
+	if err != nil {
+		return fmt.Errorf("generate config schema: %w", err)
+	}
+
+	data, err := json.MarshalIndent(schema, "", indent)
+	if err != nil {
+		return fmt.Errorf("marshal config schema: %w", err)
+	}
+
+	_, err = w.Write(append(data, '\n'))
+
+	return err
+}
+
+// checkDefaults reports a defaults entry of the configuration file naming a
+// type the run does not generate, which would otherwise be silently unused.
+func checkDefaults(cfg config) error {
+	if cfg.File == nil {
+		return nil
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(cfg.File.Defaults)) {
+		if !slices.Contains(cfg.Types, name) {
+			return fmt.Errorf("config defaults name %s, which is not a generated type", name)
+		}
+	}
+
+	return nil
+}
+
+// addFlagOptions renders the generation options the flags select into data.
+func addFlagOptions(data *templateData, cfg config) {
+	if cfg.Draft == "7" {
+		data.Options = append(data.Options, "jsonschema.WithDraft(jsonschema.Draft7)")
+	}
+
+	if cfg.Comments {
+		data.Options = append(data.Options,
+			"jsonschema.WithDescriptionProvider(jsonschema.NewGoCommentProvider())")
+	}
+
+	if cfg.AdditionalProperties {
+		data.Options = append(data.Options, "jsonschema.WithAdditionalProperties(true)")
+	}
+
+	if cfg.Validate {
+		data.Imports = append(data.Imports, strconv.Quote(validateImport))
+		data.Options = append(data.Options,
+			`jsonschema.WithTagInterpreter("validate", validate.NewInterpreter())`)
+	}
+}
+
+// addFileOptions renders the generation options of the configuration file f
+// into data. Each option is registered as a hand-written generator would,
+// in the order the file's fields are declared, and each [configfile.Ref] is
+// rendered as an expression of the helper program by refs.
+func addFileOptions(data *templateData, f *configfile.File, importPath string) error {
+	refs := refRenderer{target: importPath, aliases: map[string]string{}}
+
+	add := func(format string, args ...any) {
+		data.Options = append(data.Options, fmt.Sprintf(format, args...))
+	}
+
+	if f.Draft == 7 {
+		add("jsonschema.WithDraft(jsonschema.Draft7)")
+	}
+
+	if c := f.Comments; c != nil {
+		var opts []string
+		if c.Deprecation {
+			opts = append(opts, "jsonschema.WithDeprecation()")
+		}
+
+		if c.DocTitles {
+			opts = append(opts, "jsonschema.WithDocTitles()")
+		}
+
+		if c.MarkdownDescriptions {
+			opts = append(opts, "jsonschema.WithMarkdownDescriptions()")
+		}
+
+		if c.DocLinkBaseURL != "" {
+			opts = append(opts, fmt.Sprintf("jsonschema.WithDocLinkBaseURL(%q)", c.DocLinkBaseURL))
+		}
+
+		data.Setup = append(data.Setup, fmt.Sprintf("provider := jsonschema.NewGoCommentProvider(%s)",
+			strings.Join(opts, ", ")))
+		data.CommentProvider = "provider"
+
+		add("jsonschema.WithDescriptionProvider(provider)")
+
+		if c.Docs() {
+			add("jsonschema.WithFieldInterpreter(provider.Docs())")
+			add("jsonschema.WithTypeSchemaExtender(provider.Docs())")
+		}
+
+		if c.Enums {
+			add("jsonschema.WithTypeSchemaExtender(provider.Enums())")
+		}
+
+		if c.Markers {
+			add("jsonschema.WithFieldInterpreter(provider.Markers())")
+			add("jsonschema.WithTypeSchemaExtender(provider.Markers())")
+		}
+	}
+
+	if f.AdditionalProperties {
+		add("jsonschema.WithAdditionalProperties(true)")
+	}
+
+	if f.Nullable != nil {
+		add("jsonschema.WithNullable(%t)", *f.Nullable)
+	}
+
+	if f.Definitions != nil {
+		add("jsonschema.WithDefinitions(%t)", *f.Definitions)
+	}
+
+	if f.RootTitle {
+		add("jsonschema.WithRootTitle(true)")
+	}
+
+	if f.GenericDefinitions != "" {
+		add("jsonschema.WithGenericDefinitions(%q)", f.GenericDefinitions)
+	}
+
+	for _, o := range []struct {
+		option string
+		ref    configfile.Ref
+	}{
+		{"WithFieldNaming", f.FieldNaming},
+		{"WithNamer", f.Namer},
+		{"WithDescriptionProvider", f.DescriptionProvider},
+	} {
+		if o.ref == "" {
+			continue
+		}
+
+		expr, err := refs.expr(o.ref)
+		if err != nil {
+			return err
+		}
+
+		add("jsonschema.%s(%s)", o.option, expr)
+	}
+
+	for _, ti := range f.TagInterpreters {
+		expr, err := refs.expr(ti.Interpreter)
+		if err != nil {
+			return err
+		}
+
+		add("jsonschema.WithTagInterpreter(%q, %s)", ti.Tag, expr)
+	}
+
+	for _, o := range []struct {
+		option string
+		refs   []configfile.Ref
+	}{
+		{"WithFieldInterpreter", f.FieldInterpreters},
+		{"WithTypeSchemaProvider", f.TypeSchemaProviders},
+		{"WithTypeSchemaExtender", f.TypeSchemaExtenders},
+	} {
+		for _, ref := range o.refs {
+			expr, err := refs.expr(ref)
+			if err != nil {
+				return err
+			}
+
+			add("jsonschema.%s(%s)", o.option, expr)
+		}
+	}
+
+	for _, ts := range f.TypeSchemas {
+		expr, err := typeSchemaExpr(&refs, ts)
+		if err != nil {
+			return err
+		}
+
+		add("jsonschema.WithTypeSchema(%s)", expr)
+
+		data.DecodeSchema = true
+	}
+
+	for _, ref := range f.Unions {
+		expr, err := refs.expr(ref)
+		if err != nil {
+			return err
+		}
+
+		add("jsonschema.WithUnion(%s)", expr)
+	}
+
+	for _, ref := range f.Options {
+		expr, err := refs.expr(ref)
+		if err != nil {
+			return err
+		}
+
+		data.Options = append(data.Options, expr)
+	}
+
+	// The map is ranged in key order so the helper's source is stable.
+	for _, name := range slices.Sorted(maps.Keys(f.Defaults)) {
+		expr, err := refs.expr(f.Defaults[name])
+		if err != nil {
+			return err
+		}
+
+		data.Defaults = append(data.Defaults, fmt.Sprintf("reflect.TypeFor[target.%s](): %s", name, expr))
+	}
+
+	data.Imports = append(data.Imports, refs.imports...)
+
+	return nil
+}
+
+// typeSchemaExpr renders the arguments of the [jsonschema.WithTypeSchema]
+// option of ts: the type and the [jsonschema.TypeSchema], whose schema the
+// helper decodes from its JSON.
+func typeSchemaExpr(refs *refRenderer, ts configfile.TypeSchema) (string, error) {
+	typ, err := refs.expr(ts.Type)
+	if err != nil {
+		return "", err
+	}
+
+	schema, err := ts.SchemaJSON()
+	if err != nil {
+		return "", fmt.Errorf("%w: typeSchemas: %s: %w", configfile.ErrInvalid, ts.Type, err)
+	}
+
+	field := "Value"
+	if ts.Verbatim {
+		field = "Verbatim"
+	}
+
+	fields := fmt.Sprintf("%s: decodeSchema(%s)", field, strconv.Quote(string(schema)))
+
+	switch ts.Nullability {
+	case "allowed":
+		fields += ", Nullability: jsonschema.NullAllowed"
+	case "forbidden":
+		fields += ", Nullability: jsonschema.NullForbidden"
+	}
+
+	return fmt.Sprintf("reflect.TypeFor[%s](), jsonschema.TypeSchema{%s}", typ, fields), nil
+}
+
+// refRenderer renders [configfile.Ref] values as Go expressions of the helper
+// program. A name in the target package is qualified by the helper's target
+// import; any other package is imported under an alias of its own, so two
+// packages of the same name do not collide.
+type refRenderer struct {
+	target  string
+	aliases map[string]string
+	imports []string
+}
+
+// expr returns the expression of ref, importing its package if needed.
+func (r *refRenderer) expr(ref configfile.Ref) (string, error) {
+	path, name, call, err := ref.Parse()
+	if err != nil {
+		return "", err
+	}
+
+	qual := "target"
+
+	if path != "" && path != r.target {
+		// The path is interpolated into the import block, so it gets the same
+		// guard as the target's.
+		if !isValidImportPath(path) {
+			return "", fmt.Errorf("%w: %q: invalid import path %q", configfile.ErrInvalid, ref, path)
+		}
+
+		qual = r.aliases[path]
+		if qual == "" {
+			qual = fmt.Sprintf("ref%d", len(r.aliases))
+			r.aliases[path] = qual
+			r.imports = append(r.imports, qual+" "+strconv.Quote(path))
+		}
+	}
+
+	if call {
+		return qual + "." + name + "()", nil
+	}
+
+	return qual + "." + name, nil
+}
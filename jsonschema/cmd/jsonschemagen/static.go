@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/types"
+
+	"golang.org/x/tools/go/packages"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+// The -backend values.
+const (
+	// BackendHelper builds and runs a helper program that reflects over the
+	// target's types.
+	backendHelper = "helper"
+	// BackendStatic type-checks the target package and generates from its
+	// go/types information, running none of its code.
+	backendStatic = "static"
+	// BackendAuto tries the static backend and falls back to the helper for a
+	// run it cannot serve.
+	backendAuto = "auto"
+)
+
+// checkBackend validates -backend, and that -backend static is not combined
+// with a flag only the helper serves.
+func checkBackend(cfg config) error {
+	switch cfg.Backend {
+	case "", backendHelper, backendAuto:
+		return nil
+	case backendStatic:
+		if flag := helperOnlyFlag(cfg); flag != "" {
+			return fmt.Errorf("%s requires the helper; use -backend helper or auto", flag)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported -backend %q: must be %q, %q, or %q",
+			cfg.Backend, backendHelper, backendStatic, backendAuto)
+	}
+}
+
+// helperOnlyFlag returns the first flag set in cfg whose output needs the
+// helper, or "" when the static backend can serve the run. Comments,
+// interpreters, validators, and the -config file's options all run Go code
+// of the target's or the library's against a [reflect.Type].
+func helperOnlyFlag(cfg config) string {
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"-comments", cfg.Comments},
+		{"-validate", cfg.Validate},
+		{"-config", cfg.Config != ""},
+		{"-go-validator", cfg.GoValidator != ""},
+		{"-comment-table", cfg.CommentTable != ""},
+		{"-out-dir", cfg.OutDir != ""},
+	} {
+		if f.set {
+			return f.name
+		}
+	}
+
+	return ""
+}
+
+// generate produces the run's outputs with the backend -backend selects.
+// Under auto, a run the static backend cannot serve, for its flags or for a
+// type whose schema depends on its own code (a provider, an extender, or a
+// marshaler), is generated by the helper instead.
+func generate(goMod string, cfg config, importPath string, inWork bool) (*generated, error) {
+	static := cfg.Backend == backendStatic || cfg.Backend == backendAuto && helperOnlyFlag(cfg) == ""
+	if static {
+		out, err := generateStatic(cfg, inWork)
+		if cfg.Backend == backendStatic || !errors.Is(err, jsonschema.ErrStaticUnsupported) {
+			return out, err
+		}
+	}
+
+	return runGenerate(goMod, cfg, importPath, inWork)
+}
+
+// generateStatic generates each type's schema with [jsonschema.GenerateStatic]
+// from the type-checked current package. Only the compiler runs: the
+// package's dependencies are compiled for their export data, but nothing is
+// linked or executed, and the module need not require the jsonschema
+// library.
+func generateStatic(cfg config, inWork bool) (*generated, error) {
+	var flags []string
+	if !inWork {
+		// As for resolvePackage: bypass an inherited vendor directory without
+		// letting the load rewrite the user's go.mod.
+		flags = append(flags, "-mod=readonly")
+	}
+
+	pkgs, err := packages.Load(&packages.Config{
+		Context:    context.Background(),
+		Mode:       packages.NeedName | packages.NeedTypes,
+		BuildFlags: flags,
+	}, ".")
+	if err != nil {
+		return nil, fmt.Errorf("load package: %w", err)
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("load package: found %d packages", len(pkgs))
+	}
+
+	if errs := pkgs[0].Errors; len(errs) > 0 {
+		return nil, fmt.Errorf("load package: %w", errs[0])
+	}
+
+	var opts []jsonschema.GenerateOption
+	if cfg.Draft == "7" {
+		opts = append(opts, jsonschema.WithDraft(jsonschema.Draft7))
+	}
+
+	if cfg.AdditionalProperties {
+		opts = append(opts, jsonschema.WithAdditionalProperties(true))
+	}
+
+	gen := &generated{}
+	scope := pkgs[0].Types.Scope()
+
+	for _, name := range cfg.Types {
+		obj, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a type declared in %s", name, pkgs[0].PkgPath)
+		}
+
+		schema, err := jsonschema.GenerateStatic(context.Background(), obj.Type(), opts...)
+		if err != nil {
+			return nil, fmt.Errorf("generate %s: %w", name, err)
+		}
+
+		data, err := json.MarshalIndent(schema, "", cfg.Indent)
+		if err != nil {
+			return nil, fmt.Errorf("marshal schema: %w", err)
+		}
+
+		gen.schemas = append(gen.schemas, append(data, '\n'))
+	}
+
+	return gen, nil
+}
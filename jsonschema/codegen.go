@@ -0,0 +1,1802 @@
+package jsonschema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/refresolve"
+	"go.jacobcolvin.com/x/jsonschema/internal/regexcache"
+	"go.jacobcolvin.com/x/jsonschema/internal/schemashape"
+	"go.jacobcolvin.com/x/jsonschema/internal/typename"
+	"go.jacobcolvin.com/x/jsonschema/internal/uriref"
+)
+
+// Import paths of the packages generated code depends on.
+const (
+	genrtImportPath      = "go.jacobcolvin.com/x/jsonschema/genrt"
+	jsonschemaImportPath = "go.jacobcolvin.com/x/jsonschema"
+)
+
+// GoConfig configures [GenerateGo].
+type GoConfig struct {
+	// Package is the package name in the generated file's package clause.
+	Package string
+
+	// ImportPath is the import path of the package the generated file
+	// belongs to. A typed entry point whose [GoFunc.Type] is declared in that
+	// package refers to it unqualified; types from any other package are
+	// imported. It may be empty when no typed entry point is generated or
+	// every type is declared elsewhere.
+	ImportPath string
+
+	// Funcs lists the validators to generate, one set of functions each.
+	Funcs []GoFunc
+}
+
+// GoFunc describes one generated validator.
+type GoFunc struct {
+	// Validator is the compiled schema the code is generated from. Its
+	// draft, vocabularies, format and content assertion, and ref resolution
+	// are all fixed into the generated code.
+	Validator *Validator
+
+	// Type, when non-nil, additionally generates a typed entry point named
+	// Name+"Value" taking a pointer to Type. It converts the value to its
+	// JSON form directly, without marshaling and decoding, and validates it
+	// as [Validator.ValidateValue] would. Parts of the value whose JSON form
+	// depends on a MarshalJSON or MarshalText method, an interface's dynamic
+	// type, or an embedded struct fall back to encoding/json for that part
+	// only.
+	Type reflect.Type
+
+	// Name is the name of the generated func(any) error entry point, which
+	// validates like [Validator.Validate]. It must be a Go identifier.
+	// Unexported helpers derive their names from it.
+	Name string
+}
+
+// GenerateGo compiles each configured [Validator] into Go source for a
+// specialized validator: a func(instance any) error per [GoFunc] that
+// validates like [Validator.Validate], and optionally a typed entry point
+// (see [GoFunc.Type]). The keyword logic is unrolled into one function per
+// schema node, with $ref targets, patterns, and constant messages resolved at
+// generation time, so validating an instance walks no schema. The result
+// reports the same [*ValidationError] tree as the [Validator]: the same
+// instance and schema paths, keywords, messages, and causes.
+//
+// The generated file imports this module's genrt package, the runtime the
+// emitted code shares with the [Validator] walk.
+//
+// The context is passed to the [RefResolver] for remote refs that resolve
+// only when first reached, which generation resolves once and fixes into the
+// code. GenerateGo returns an error wrapping [ErrCodeGen] for a schema the
+// generated code cannot reproduce: one using $dynamicRef, a custom keyword
+// registered with [WithKeyword], a format checker registered with
+// [WithFormatValidator] (the built-in checkers are supported), a $ref whose
+// resolution fails, or a [Validator] compiled with a validation limit such as
+// [WithFailFast] or [WithMaxSteps].
+func GenerateGo(ctx context.Context, cfg GoConfig) ([]byte, error) {
+	err := checkGoConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &goEmitter{
+		cfg:         cfg,
+		imports:     map[string]string{},
+		segVars:     map[string]string{},
+		patternVars: map[string]string{},
+		formatVars:  map[string]string{},
+		valueFuncs:  map[reflect.Type]string{},
+		prefix:      goHelperPrefix(cfg.Funcs[0].Name),
+	}
+
+	e.imports[jsonschemaImportPath] = "jsonschema"
+	e.imports[genrtImportPath] = "genrt"
+
+	for _, f := range cfg.Funcs {
+		err := e.emitFunc(ctx, f)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrCodeGen, f.Name, err)
+		}
+	}
+
+	return e.output()
+}
+
+// checkGoConfig rejects a [GoConfig] whose names cannot form valid,
+// non-colliding Go declarations.
+func checkGoConfig(cfg GoConfig) error {
+	if !token.IsIdentifier(cfg.Package) {
+		return fmt.Errorf("%w: invalid package name %q", ErrCodeGen, cfg.Package)
+	}
+
+	if len(cfg.Funcs) == 0 {
+		return fmt.Errorf("%w: no functions to generate", ErrCodeGen)
+	}
+
+	prefixes := map[string]string{}
+	names := map[string]bool{}
+
+	for _, f := range cfg.Funcs {
+		names[f.Name] = true
+	}
+
+	for _, f := range cfg.Funcs {
+		if !token.IsIdentifier(f.Name) {
+			return fmt.Errorf("%w: invalid function name %q", ErrCodeGen, f.Name)
+		}
+
+		if f.Validator == nil {
+			return fmt.Errorf("%w: %s: nil Validator", ErrCodeGen, f.Name)
+		}
+
+		p := goHelperPrefix(f.Name)
+		if prev, dup := prefixes[p]; dup {
+			return fmt.Errorf("%w: function names %q and %q collide", ErrCodeGen, prev, f.Name)
+		}
+
+		prefixes[p] = f.Name
+
+		if f.Type != nil && names[f.Name+"Value"] {
+			return fmt.Errorf("%w: typed entry point %sValue collides with a function name", ErrCodeGen, f.Name)
+		}
+	}
+
+	return nil
+}
+
+// goHelperPrefix returns the prefix of the unexported helpers generated for
+// the entry point named name.
+func goHelperPrefix(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+
+	return string(unicode.ToLower(r)) + name[size:]
+}
+
+// goEmitter accumulates the declarations of one generated file. The
+// package-level variables (segment runs, patterns, format checkers) and the
+// typed value conversions are shared by every function in the file, and named
+// for its first function so several generated files can share a package.
+type goEmitter struct {
+	valueFuncs  map[reflect.Type]string
+	imports     map[string]string
+	segVars     map[string]string
+	patternVars map[string]string
+	formatVars  map[string]string
+	cfg         GoConfig
+	prefix      string
+	vars        bytes.Buffer
+	funcs       bytes.Buffer
+	nvars       int
+}
+
+// output assembles and formats the generated file.
+func (e *goEmitter) output() ([]byte, error) {
+	var b bytes.Buffer
+
+	b.WriteString("// Code generated by jsonschema.GenerateGo. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\nimport (\n", e.cfg.Package)
+
+	// Standard-library imports first, then the rest, as goimports groups
+	// them.
+	paths := slices.Sorted(maps.Keys(e.imports))
+	std := func(path string) bool { return !strings.Contains(strings.Split(path, "/")[0], ".") }
+
+	for i, group := range [][]string{
+		slices.DeleteFunc(slices.Clone(paths), func(p string) bool { return !std(p) }),
+		slices.DeleteFunc(slices.Clone(paths), std),
+	} {
+		if i > 0 && len(group) > 0 {
+			b.WriteByte('\n')
+		}
+
+		for _, path := range group {
+			if name := e.imports[path]; name != pathBase(path) {
+				fmt.Fprintf(&b, "\t%s %q\n", name, path)
+			} else {
+				fmt.Fprintf(&b, "\t%q\n", path)
+			}
+		}
+	}
+
+	b.WriteString(")\n\n")
+
+	if e.vars.Len() > 0 {
+		b.WriteString("var (\n")
+		b.Write(e.vars.Bytes())
+		b.WriteString(")\n\n")
+	}
+
+	b.Write(e.funcs.Bytes())
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%w: format generated source: %w", ErrCodeGen, err)
+	}
+
+	return src, nil
+}
+
+func pathBase(path string) string {
+	return path[strings.LastIndexByte(path, '/')+1:]
+}
+
+// use records an import of path, returning the name the generated code refers
+// to it by: its own name, or that name numbered when another import already
+// claims it.
+func (e *goEmitter) use(path string) string {
+	if name, ok := e.imports[path]; ok {
+		return name
+	}
+
+	base := goIdentFragment(pathBase(path))
+	name := base
+
+	for n := 2; slices.Contains(slices.Collect(maps.Values(e.imports)), name); n++ {
+		name = fmt.Sprintf("%s%d", base, n)
+	}
+
+	e.imports[path] = name
+
+	return name
+}
+
+// newVar declares a package-level variable with the given initializer and
+// returns its name.
+func (e *goEmitter) newVar(init string) string {
+	name := fmt.Sprintf("%sVar%d", e.prefix, e.nvars)
+	e.nvars++
+
+	fmt.Fprintf(&e.vars, "\t%s = %s\n", name, init)
+
+	return name
+}
+
+// segVar returns the package-level variable holding the static segment run
+// segs.
+func (e *goEmitter) segVar(segs ...Segment) string {
+	var lit strings.Builder
+
+	lit.WriteString("[]jsonschema.Segment{")
+
+	for i, s := range segs {
+		if i > 0 {
+			lit.WriteString(", ")
+		}
+
+		if s.IsIndex {
+			fmt.Fprintf(&lit, "{Index: %d, IsIndex: true}", s.Index)
+		} else {
+			fmt.Fprintf(&lit, "{Key: %s}", strconv.Quote(s.Key))
+		}
+	}
+
+	lit.WriteString("}")
+
+	key := lit.String()
+	if name, ok := e.segVars[key]; ok {
+		return name
+	}
+
+	name := e.newVar(key)
+	e.segVars[key] = name
+
+	return name
+}
+
+// patternVar returns the package-level variable holding the compiled pattern.
+func (e *goEmitter) patternVar(pattern string) string {
+	if name, ok := e.patternVars[pattern]; ok {
+		return name
+	}
+
+	name := e.newVar(fmt.Sprintf("genrt.Pattern(%s)", strconv.Quote(pattern)))
+	e.patternVars[pattern] = name
+
+	return name
+}
+
+// formatVar returns the package-level variable holding the built-in checker
+// for the named format.
+func (e *goEmitter) formatVar(name string) string {
+	if v, ok := e.formatVars[name]; ok {
+		return v
+	}
+
+	v := e.newVar(fmt.Sprintf("genrt.Format(%s)", strconv.Quote(name)))
+	e.formatVars[name] = v
+
+	return v
+}
+
+// goFuncGen generates the functions of one [GoFunc]: the entry points and
+// one function per reachable schema node that is neither the boolean false
+// schema (inlined at each use) nor trivially true (elided at each use).
+type goFuncGen struct {
+	e      *goEmitter
+	v      *validator
+	ids    map[*Schema]int
+	where  map[*Schema]string
+	refs   map[*Schema]refresolve.Result
+	cyclic map[*Schema]bool
+	w      *bytes.Buffer
+	prefix string
+	nodes  []*Schema
+	tmp    int
+}
+
+// goEdge is a subschema a node's keywords apply, in place (at the same
+// instance location, so able to close a cycle) or to a descendant.
+type goEdge struct {
+	to      *Schema
+	seg     []Segment
+	inPlace bool
+}
+
+// emitFunc generates the functions of one [GoFunc].
+func (e *goEmitter) emitFunc(ctx context.Context, f GoFunc) error {
+	if f.Validator.proto.limits.active() {
+		return errors.New("validation limits (fail-fast, max errors, depth, nodes, steps) are not generated")
+	}
+
+	g := &goFuncGen{
+		e:      e,
+		v:      f.Validator.proto.forInstance(ctx),
+		ids:    map[*Schema]int{},
+		where:  map[*Schema]string{},
+		refs:   map[*Schema]refresolve.Result{},
+		cyclic: map[*Schema]bool{},
+		w:      &e.funcs,
+		prefix: goHelperPrefix(f.Name),
+	}
+
+	root := g.v.root
+
+	err := g.collect(root, nil)
+	if err != nil {
+		return err
+	}
+
+	g.findCycles()
+
+	fmt.Fprintf(g.w, "// %s validates instance against the schema it was generated from, reporting\n", f.Name)
+	fmt.Fprintf(g.w, "// the errors [jsonschema.Validator.Validate] reports.\n")
+	fmt.Fprintf(g.w, "func %s(instance any) error {\n", f.Name)
+
+	root1 := g.call(root, "x", "nil", "nil", "nil", "")
+	if root1 == "" || IsFalseSchema(root) {
+		g.p("_, err := genrt.Normalize(instance)")
+	} else {
+		g.p("x, err := genrt.Normalize(instance)")
+	}
+
+	g.p("if err != nil {\nreturn err\n}\n")
+	g.emitRootResult(root, root1)
+	g.p("}\n")
+
+	if f.Type != nil {
+		err := g.emitTyped(f, root)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, s := range g.nodes {
+		err := g.emitNode(s)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// emitRootResult writes the statements returning the result of validating x
+// against root, given the root call expression.
+func (g *goFuncGen) emitRootResult(root *Schema, call string) {
+	switch {
+	case call == "":
+		g.p("return nil")
+	case IsFalseSchema(root):
+		g.p("return genrt.Result(%s)", call)
+	default:
+		g.p("var st genrt.State\n")
+		g.p("return genrt.Result(%s)", strings.Replace(call, "(st,", "(&st,", 1))
+	}
+}
+
+// p writes one formatted line of the function being generated.
+func (g *goFuncGen) p(format string, args ...any) {
+	fmt.Fprintf(g.w, format, args...)
+	g.w.WriteByte('\n')
+}
+
+// tmpName returns a fresh local variable name with the given stem.
+func (g *goFuncGen) tmpName(stem string) string {
+	g.tmp++
+
+	return fmt.Sprintf("%s%d", stem, g.tmp)
+}
+
+// fn returns the name of the function generated for node s.
+func (g *goFuncGen) fn(s *Schema) string {
+	return fmt.Sprintf("%sNode%d", g.prefix, g.ids[s])
+}
+
+// call returns the expression validating x against sub at instance location
+// ip and schema location sp, or "" when sub is trivially true and the call
+// is elided. A false sub is inlined, labeled with the applicator keyword
+// label the walk stamps on it (empty at the sites that stamp none).
+func (g *goFuncGen) call(sub *Schema, x, ip, sp, ann, label string) string {
+	if IsFalseSchema(sub) {
+		return fmt.Sprintf("genrt.False(%s, %s, %s)", ip, sp, strconv.Quote(label))
+	}
+
+	if !g.hasWork(sub) {
+		return ""
+	}
+
+	return fmt.Sprintf("%s(st, %s, %s, %s, %s)", g.fn(sub), x, ip, sp, ann)
+}
+
+// onlyRef reports whether the walk evaluates only s's $ref row: a Draft-07
+// $ref suppresses its siblings.
+func (g *goFuncGen) onlyRef(s *Schema) bool {
+	return !g.v.profile.honorRefSiblings && s.Ref != ""
+}
+
+// rows returns the dispatch rows the walk evaluates for s, in table order.
+func (g *goFuncGen) rows(s *Schema) []*keywordEntry {
+	onlyRef := g.onlyRef(s)
+
+	var rows []*keywordEntry
+
+	for _, e := range g.v.activeRows {
+		if onlyRef && !e.isRef {
+			continue
+		}
+
+		rows = append(rows, e)
+	}
+
+	return rows
+}
+
+// hasWork reports whether validating against s can do anything: fail, or
+// record an annotation. A schema without work is elided at every use, as the
+// walk would return no errors for it.
+func (g *goFuncGen) hasWork(s *Schema) bool {
+	if s == nil {
+		return false
+	}
+
+	if IsFalseSchema(s) {
+		return true
+	}
+
+	for _, e := range g.rows(s) {
+		if rowPresent(e, s, g.v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rowPresent reports whether s sets any keyword of dispatch row e that the
+// row's eval step acts on.
+//
+//nolint:cyclop // One case per dispatch row.
+func rowPresent(e *keywordEntry, s *Schema, v *validator) bool {
+	switch e.name {
+	case "$ref":
+		return s.Ref != ""
+	case "$dynamicRef":
+		return s.DynamicRef != ""
+	case "type":
+		return s.Type != "" || len(s.Types) > 0
+	case "enum":
+		return s.Enum != nil
+	case "const":
+		return s.Const != nil
+	case "numeric":
+		return computeBounds(s) != nil
+	case "string":
+		return s.MinLength != nil || s.MaxLength != nil || s.Pattern != ""
+	case "format":
+		return s.Format != ""
+	case "array.items":
+		return computeItemsPlan(v, s) != nil
+	case "contains":
+		return s.Contains != nil
+	case "array.length":
+		return s.MinItems != nil || s.MaxItems != nil || s.UniqueItems
+	case "object.applicators":
+		return len(s.Properties) > 0 || len(s.PatternProperties) > 0 ||
+			s.AdditionalProperties != nil || s.PropertyNames != nil
+	case "dependentSchemas":
+		return len(s.DependentSchemas) > 0
+	case "object.count":
+		return len(s.Required) > 0 || s.MinProperties != nil || s.MaxProperties != nil
+	case "dependentRequired":
+		return len(s.DependentRequired) > 0
+	case "dependencies.legacy":
+		return len(s.DependencySchemas) > 0 || len(s.DependencyStrings) > 0
+	case "allOf":
+		return len(s.AllOf) > 0
+	case "anyOf":
+		return len(s.AnyOf) > 0
+	case "oneOf":
+		return len(s.OneOf) > 0
+	case "not":
+		return s.Not != nil
+	case "ifThenElse":
+		return s.If != nil
+	case "content":
+		return s.ContentEncoding != "" || s.ContentMediaType != ""
+	case "unevaluatedProperties":
+		return s.UnevaluatedProperties != nil
+	case "unevaluatedItems":
+		return s.UnevaluatedItems != nil
+	default:
+		// A custom keyword's row, named for the keyword.
+		_, ok := s.Extra[e.name]
+
+		return ok
+	}
+}
+
+// collect numbers the nodes reachable from s in depth-first order, resolving
+// each $ref once, and rejects the constructs generated code cannot
+// reproduce. The loc is the schema location s was first reached at, kept for
+// the generated function's doc comment.
+func (g *goFuncGen) collect(s *Schema, loc []Segment) error {
+	if s == nil || IsFalseSchema(s) || !g.hasWork(s) {
+		return nil
+	}
+
+	if _, seen := g.ids[s]; seen {
+		return nil
+	}
+
+	g.ids[s] = len(g.nodes)
+	g.nodes = append(g.nodes, s)
+	g.where[s] = "#" + segmentsPointer(loc)
+
+	edges, err := g.edges(s)
+	if err != nil {
+		return fmt.Errorf("at %s: %w", g.where[s], err)
+	}
+
+	for _, edge := range edges {
+		err := g.collect(edge.to, append(loc[:len(loc):len(loc)], edge.seg...))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// edges returns the subschemas s's evaluated keywords apply, in evaluation
+// order.
+//
+//nolint:cyclop,gocognit // One case per applicator row.
+func (g *goFuncGen) edges(s *Schema) ([]goEdge, error) {
+	var out []goEdge
+
+	add := func(to *Schema, inPlace bool, seg ...Segment) {
+		out = append(out, goEdge{to: to, inPlace: inPlace, seg: seg})
+	}
+
+	for _, e := range g.rows(s) {
+		if !rowPresent(e, s, g.v) {
+			continue
+		}
+
+		switch e.name {
+		case "$ref":
+			res := g.v.resolveRef(s, s.Ref)
+			if res.Err != nil {
+				return nil, fmt.Errorf("resolve $ref %q: %w", s.Ref, res.Err)
+			}
+
+			g.refs[s] = res
+			add(res.Target, true, Segment{Key: KeywordRef})
+
+		case "$dynamicRef":
+			return nil, fmt.Errorf("$dynamicRef %q resolves through the dynamic scope", s.DynamicRef)
+
+		case "format":
+			if fv, ok := g.v.formatCheckers[s.Format]; ok {
+				if _, builtin := fv.(builtinFormat); !builtin {
+					return nil, fmt.Errorf("format %q has a registered checker", s.Format)
+				}
+			}
+
+		case "array.items":
+			plan := computeItemsPlan(g.v, s)
+			for i, ps := range plan.tuple {
+				add(ps, false, Segment{Key: plan.tupleLabel}, Segment{Index: i, IsIndex: true})
+			}
+
+			if plan.rest != nil {
+				add(plan.rest, false, Segment{Key: plan.restLabel})
+			}
+
+		case "contains":
+			add(s.Contains, false, Segment{Key: KeywordContains})
+
+		case "object.applicators":
+			for _, name := range slices.Sorted(maps.Keys(s.Properties)) {
+				add(s.Properties[name], false, Segment{Key: KeywordProperties}, Segment{Key: name})
+			}
+
+			for _, pattern := range slices.Sorted(maps.Keys(s.PatternProperties)) {
+				add(s.PatternProperties[pattern], false, Segment{Key: KeywordPatternProperties}, Segment{Key: pattern})
+			}
+
+			add(s.AdditionalProperties, false, Segment{Key: KeywordAdditionalProperties})
+			add(s.PropertyNames, false, Segment{Key: KeywordPropertyNames})
+
+		case "dependentSchemas":
+			for _, name := range slices.Sorted(maps.Keys(s.DependentSchemas)) {
+				add(s.DependentSchemas[name], true, Segment{Key: KeywordDependentSchemas}, Segment{Key: name})
+			}
+
+		case "dependencies.legacy":
+			for _, name := range slices.Sorted(maps.Keys(s.DependencySchemas)) {
+				add(s.DependencySchemas[name], true, Segment{Key: KeywordDependencies}, Segment{Key: name})
+			}
+
+		case "allOf", "anyOf", "oneOf":
+			for i, sub := range map[string][]*Schema{"allOf": s.AllOf, "anyOf": s.AnyOf, "oneOf": s.OneOf}[e.name] {
+				add(sub, true, Segment{Key: e.name}, Segment{Index: i, IsIndex: true})
+			}
+
+		case "not":
+			add(s.Not, true, Segment{Key: KeywordNot})
+
+		case "ifThenElse":
+			add(s.If, true, Segment{Key: KeywordIf})
+			add(s.Then, true, Segment{Key: KeywordThen})
+			add(s.Else, true, Segment{Key: KeywordElse})
+
+		case "unevaluatedProperties":
+			add(s.UnevaluatedProperties, false, Segment{Key: KeywordUnevaluatedProperties})
+
+		case "unevaluatedItems":
+			add(s.UnevaluatedItems, false, Segment{Key: KeywordUnevaluatedItems})
+
+		case "type", "enum", "const", "numeric", "string", "array.length",
+			"object.count", "dependentRequired", "content":
+			// Assertions only: no subschema.
+
+		default:
+			return nil, fmt.Errorf("custom keyword %q", e.name)
+		}
+	}
+
+	return out, nil
+}
+
+// findCycles marks the nodes that can reach themselves through in-place
+// edges alone. Only those can revisit a node at the same instance location,
+// the cycle the walk breaks by treating the revisit as passing, so only their
+// functions carry the visit check. It is Tarjan's strongly connected
+// components algorithm over the in-place edge graph.
+func (g *goFuncGen) findCycles() {
+	index := map[*Schema]int{}
+	low := map[*Schema]int{}
+	onStack := map[*Schema]bool{}
+
+	var (
+		stack []*Schema
+		next  int
+	)
+
+	var strongConnect func(s *Schema)
+
+	strongConnect = func(s *Schema) {
+		index[s] = next
+		low[s] = next
+		next++
+
+		stack = append(stack, s)
+		onStack[s] = true
+
+		edges, _ := g.edges(s) //nolint:errcheck // collect already returned every edge error.
+		for _, edge := range edges {
+			to := edge.to
+			if !edge.inPlace || !g.hasNode(to) {
+				continue
+			}
+
+			if to == s {
+				g.cyclic[s] = true
+			}
+
+			if _, visited := index[to]; !visited {
+				strongConnect(to)
+				low[s] = min(low[s], low[to])
+			} else if onStack[to] {
+				low[s] = min(low[s], index[to])
+			}
+		}
+
+		if low[s] != index[s] {
+			return
+		}
+
+		var scc []*Schema
+
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+
+			scc = append(scc, top)
+			if top == s {
+				break
+			}
+		}
+
+		if len(scc) > 1 {
+			for _, n := range scc {
+				g.cyclic[n] = true
+			}
+		}
+	}
+
+	for _, s := range g.nodes {
+		if _, visited := index[s]; !visited {
+			strongConnect(s)
+		}
+	}
+}
+
+// hasNode reports whether s has a generated function.
+func (g *goFuncGen) hasNode(s *Schema) bool {
+	_, ok := g.ids[s]
+
+	return ok
+}
+
+// emitNode writes the function validating against node s.
+func (g *goFuncGen) emitNode(s *Schema) error {
+	id := g.ids[s]
+
+	g.p("// %s validates against the schema at %s.", g.fn(s), g.where[s])
+	g.p("func %s(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {",
+		g.fn(s))
+
+	if g.cyclic[s] {
+		g.p("if !st.Enter(%d, ip) {\nreturn nil\n}\n\ndefer st.Leave(%d, ip)\n", id, id)
+	}
+
+	if s.UnevaluatedProperties != nil || s.UnevaluatedItems != nil {
+		g.p("if ann == nil {\nann = genrt.NewAnnotations()\n}\n")
+	}
+
+	g.p("var errs []*jsonschema.ValidationError\n")
+
+	for _, e := range g.rows(s) {
+		if !rowPresent(e, s, g.v) {
+			continue
+		}
+
+		err := g.emitRow(e, s)
+		if err != nil {
+			return err
+		}
+	}
+
+	g.p("return errs\n}\n")
+
+	return nil
+}
+
+// emitRow writes the statements evaluating dispatch row e of node s.
+//
+//nolint:cyclop // One case per dispatch row.
+func (g *goFuncGen) emitRow(e *keywordEntry, s *Schema) error {
+	switch e.name {
+	case "$ref":
+		g.emitRef(s)
+	case "type":
+		g.emitType(s)
+	case "enum":
+		lit, err := g.e.literal(s.Enum)
+		if err != nil {
+			return err
+		}
+
+		enum := g.e.newVar(fmt.Sprintf("genrt.NewEnum(%s)", lit))
+		g.p("if !%s.Contains(x) {", enum)
+		g.leaf(KeywordEnum, strconv.Quote("value does not match any enum member"))
+		g.p("}\n")
+
+	case "const":
+		lit, err := g.e.literal(*s.Const)
+		if err != nil {
+			return err
+		}
+
+		c := g.e.newVar(fmt.Sprintf("genrt.NewConst(%s)", lit))
+		g.p("if !%s.Equal(x) {", c)
+		g.leaf(KeywordConst, strconv.Quote("value does not match const"))
+		g.p("}\n")
+
+	case "numeric":
+		n := g.e.newVar(fmt.Sprintf("genrt.NewNumeric(%s, %s, %s, %s, %s)",
+			goFloatPtr(g.e, s.MultipleOf), goFloatPtr(g.e, s.Minimum), goFloatPtr(g.e, s.Maximum),
+			goFloatPtr(g.e, s.ExclusiveMinimum), goFloatPtr(g.e, s.ExclusiveMaximum)))
+		g.p("errs = %s.Check(errs, x, ip, sp)\n", n)
+
+	case "string":
+		g.emitString(s)
+	case "format":
+		g.emitFormat(s)
+	case "array.items":
+		g.emitItems(s)
+	case "contains":
+		g.emitContains(s)
+	case "array.length":
+		g.emitArrayLength(s)
+	case "object.applicators":
+		g.emitObjectApplicators(s)
+	case "dependentSchemas":
+		g.emitSchemaDependencies(s.DependentSchemas, KeywordDependentSchemas)
+	case "object.count":
+		g.emitObjectCount(s)
+	case "dependentRequired":
+		g.emitRequiredDependencies(s.DependentRequired, KeywordDependentRequired)
+	case "dependencies.legacy":
+		g.emitSchemaDependencies(s.DependencySchemas, KeywordDependencies)
+		g.emitRequiredDependencies(s.DependencyStrings, KeywordDependencies)
+	case "allOf":
+		g.emitAllOf(s)
+	case "anyOf":
+		g.emitAnyOf(s)
+	case "oneOf":
+		g.emitOneOf(s)
+	case "not":
+		g.emitNot(s)
+	case "ifThenElse":
+		g.emitIfThenElse(s)
+	case "content":
+		g.p("if s, ok := x.(string); ok {")
+		g.p("errs = genrt.Content(errs, s, ip, sp, %s, %s, %t)",
+			strconv.Quote(s.ContentEncoding), strconv.Quote(s.ContentMediaType), g.v.draft == Draft2020)
+		g.p("}\n")
+
+	case "unevaluatedProperties":
+		g.emitUnevaluatedProperties(s)
+	case "unevaluatedItems":
+		g.emitUnevaluatedItems(s)
+	}
+
+	return nil
+}
+
+// leaf writes the statement appending a leaf error for keyword kw with the
+// message expression msg.
+func (g *goFuncGen) leaf(kw, msg string) {
+	g.p("errs = append(errs, genrt.Leaf(ip, sp, %s, %s))", strconv.Quote(kw), msg)
+}
+
+// sprintf returns the expression formatting a message whose leading verbs are
+// fixed at generation time: static holds the values of format's first verbs,
+// rendered now, and args holds the expressions filling the remaining verbs at
+// run time. A message with no runtime verbs is a plain string literal.
+func (g *goFuncGen) sprintf(format string, static []any, args ...string) string {
+	cut := len(format)
+
+	for i, verbs := 0, 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		if i+1 < len(format) && format[i+1] == '%' {
+			i++
+
+			continue
+		}
+
+		if verbs == len(static) {
+			cut = i
+
+			break
+		}
+
+		verbs++
+	}
+
+	head := fmt.Sprintf(format[:cut], static...)
+	if len(args) == 0 {
+		return strconv.Quote(head)
+	}
+
+	return fmt.Sprintf("%s.Sprintf(%s, %s)", g.e.use("fmt"),
+		strconv.Quote(strings.ReplaceAll(head, "%", "%%")+format[cut:]), strings.Join(args, ", "))
+}
+
+func (g *goFuncGen) emitType(s *Schema) {
+	types := s.Types
+	if s.Type != "" {
+		types = []string{s.Type}
+	}
+
+	conds := make([]string, len(types))
+	for i, t := range types {
+		conds[i] = goTypeCheck(t)
+	}
+
+	g.p("if !(%s) {", strings.Join(conds, " || "))
+	g.leaf(KeywordType, g.sprintf("expected %s, got %q", []any{formatTypes(types)}, "genrt.TypeName(x)"))
+	g.p("}\n")
+}
+
+// goTypeCheck returns the expression testing x against the JSON type name t.
+func goTypeCheck(t string) string {
+	switch t {
+	case typename.Null:
+		return "x == nil"
+	case typename.Boolean:
+		return "genrt.IsBoolean(x)"
+	case typename.String:
+		return "genrt.IsString(x)"
+	case typename.Integer:
+		return "genrt.IsInteger(x)"
+	case typename.Number:
+		return "genrt.IsNumber(x)"
+	case typename.Object:
+		return "genrt.IsObject(x)"
+	case typename.Array:
+		return "genrt.IsArray(x)"
+	default:
+		return "false"
+	}
+}
+
+func (g *goFuncGen) emitString(s *Schema) {
+	var body bytes.Buffer
+
+	outer := g.w
+	g.w = &body
+	usesS := false
+
+	if s.MinLength != nil || s.MaxLength != nil {
+		usesS = true
+
+		g.p("n := %s.RuneCountInString(s)", g.e.use("unicode/utf8"))
+
+		if s.MinLength != nil {
+			g.p("if n < %d {", *s.MinLength)
+			g.leaf(KeywordMinLength, g.sprintf("string length %d is less than %d", nil, "n", strconv.Itoa(*s.MinLength)))
+			g.p("}")
+		}
+
+		if s.MaxLength != nil {
+			g.p("if n > %d {", *s.MaxLength)
+			g.leaf(KeywordMaxLength, g.sprintf("string length %d is greater than %d", nil, "n", strconv.Itoa(*s.MaxLength)))
+			g.p("}")
+		}
+	}
+
+	if s.Pattern != "" {
+		if _, err := regexcache.Compile(s.Pattern); err != nil {
+			g.leaf(KeywordPattern, g.sprintf("pattern %q cannot be compiled", []any{s.Pattern}))
+		} else {
+			usesS = true
+
+			g.p("if !%s.MatchString(s) {", g.e.patternVar(s.Pattern))
+			g.leaf(KeywordPattern, g.sprintf("string does not match pattern %q", []any{s.Pattern}))
+			g.p("}")
+		}
+	}
+
+	g.w = outer
+
+	if usesS {
+		g.p("if s, ok := x.(string); ok {")
+	} else {
+		g.p("if _, ok := x.(string); ok {")
+	}
+
+	g.w.Write(body.Bytes())
+	g.p("}\n")
+}
+
+func (g *goFuncGen) emitFormat(s *Schema) {
+	if _, ok := g.v.formatCheckers[s.Format]; !ok {
+		// An unknown format asserts only when the format-assertion
+		// vocabulary drives assertion.
+		if g.v.formatsVocabDriven {
+			g.p("if _, ok := x.(string); ok {")
+			g.leaf(KeywordFormat, g.sprintf("format %q has no registered checker", []any{s.Format}))
+			g.p("}\n")
+		}
+
+		return
+	}
+
+	g.p("if s, ok := x.(string); ok {")
+	g.p("if err := %s(s); err != nil {", g.e.formatVar(s.Format))
+	g.p("errs = append(errs, genrt.FormatError(ip, sp, %s, err))", strconv.Quote(s.Format))
+	g.p("}\n}\n")
+}
+
+func (g *goFuncGen) emitItems(s *Schema) {
+	plan := computeItemsPlan(g.v, s)
+	n := len(plan.tuple)
+
+	if n == 0 && g.call(plan.rest, "", "", "", "", "") == "" {
+		// Only the annotation remains.
+		g.p("if _, ok := x.([]any); ok {")
+	} else {
+		g.p("if arr, ok := x.([]any); ok {")
+	}
+
+	for i, ps := range plan.tuple {
+		seg := g.e.segVar(Segment{Key: plan.tupleLabel}, Segment{Index: i, IsIndex: true})
+
+		call := g.call(ps, fmt.Sprintf("arr[%d]", i), fmt.Sprintf("ip.Index(%d)", i),
+			"sp.Append("+seg+")", "nil", plan.tupleLabel)
+		if call != "" {
+			g.p("if len(arr) > %d {\nerrs = append(errs, %s...)\n}", i, call)
+		}
+	}
+
+	if n > 0 {
+		g.p("ann.ExtendItems(min(%d, len(arr)))", n)
+	}
+
+	if plan.rest != nil {
+		rsp := g.tmpName("rsp")
+
+		call := g.call(plan.rest, "arr[i]", "ip.Index(i)", rsp, "nil", plan.restLabel)
+		if call != "" {
+			g.p("%s := sp.Append(%s)", rsp, g.e.segVar(Segment{Key: plan.restLabel}))
+			g.p("for i := %d; i < len(arr); i++ {\nerrs = append(errs, %s...)\n}", n, call)
+		}
+
+		if plan.restMarksAllItems {
+			if n == 0 {
+				g.p("ann.SetAllItems()")
+			} else {
+				g.p("if len(arr) > %d {\nann.SetAllItems()\n}", n)
+			}
+		}
+	}
+
+	g.p("}\n")
+}
+
+func (g *goFuncGen) emitContains(s *Schema) {
+	counts := g.v.vocabActive(vocabValidation) && g.v.profile.containsCounts
+
+	minContains, minKeyword := 1, KeywordContains
+	if counts && s.MinContains != nil {
+		minContains, minKeyword = *s.MinContains, KeywordMinContains
+	}
+
+	maxContains := -1
+	if counts && s.MaxContains != nil {
+		maxContains = *s.MaxContains
+	}
+
+	needCount := minContains > 0 || maxContains >= 0
+
+	if IsFalseSchema(s.Contains) {
+		// Nothing matches, so only the minimum can fail.
+		if minContains > 0 {
+			g.p("if _, ok := x.([]any); ok {")
+			g.leaf(minKeyword, g.sprintf("array has %d matching items, minimum is %d", []any{0, minContains}))
+			g.p("}\n")
+		}
+
+		return
+	}
+
+	g.p("if arr, ok := x.([]any); ok {")
+
+	call := g.call(s.Contains, "item", "ip.Index(i)", "csp", "nil", "")
+	if call == "" {
+		if needCount {
+			g.p("n := len(arr)")
+		}
+
+		g.p("for i := range arr {\nann.RecordItem(i)\n}")
+	} else {
+		g.p("csp := sp.Append(%s)", g.e.segVar(Segment{Key: KeywordContains}))
+
+		if needCount {
+			g.p("n := 0")
+		}
+
+		g.p("for i, item := range arr {")
+		g.p("if len(%s) == 0 {", call)
+
+		if needCount {
+			g.p("n++")
+		}
+
+		g.p("ann.RecordItem(i)\n}\n}")
+	}
+
+	if minContains > 0 {
+		g.p("if n < %d {", minContains)
+		g.leaf(minKeyword, g.sprintf("array has %d matching items, minimum is %d", nil, "n", strconv.Itoa(minContains)))
+		g.p("}")
+	}
+
+	if maxContains >= 0 {
+		g.p("if n > %d {", maxContains)
+		g.leaf(KeywordMaxContains,
+			g.sprintf("array has %d matching items, maximum is %d", nil, "n", strconv.Itoa(maxContains)))
+		g.p("}")
+	}
+
+	g.p("}\n")
+}
+
+func (g *goFuncGen) emitArrayLength(s *Schema) {
+	g.p("if arr, ok := x.([]any); ok {")
+
+	if s.MinItems != nil {
+		g.p("if len(arr) < %d {", *s.MinItems)
+		g.leaf(KeywordMinItems, g.sprintf("array has %d items, minimum is %d", nil, "len(arr)", strconv.Itoa(*s.MinItems)))
+		g.p("}")
+	}
+
+	if s.MaxItems != nil {
+		g.p("if len(arr) > %d {", *s.MaxItems)
+		g.leaf(KeywordMaxItems, g.sprintf("array has %d items, maximum is %d", nil, "len(arr)", strconv.Itoa(*s.MaxItems)))
+		g.p("}")
+	}
+
+	if s.UniqueItems {
+		g.p("if genrt.HasDuplicates(arr) {")
+		g.leaf(KeywordUniqueItems, strconv.Quote("array contains duplicate items"))
+		g.p("}")
+	}
+
+	g.p("}\n")
+}
+
+//nolint:cyclop,gocognit,funlen // One section per object applicator keyword, mirroring evalObjectApplicators.
+func (g *goFuncGen) emitObjectApplicators(s *Schema) {
+	var body bytes.Buffer
+
+	outer := g.w
+	g.w = &body
+	usesObj, usesKeys := false, false
+
+	props := slices.Sorted(maps.Keys(s.Properties))
+	for _, name := range props {
+		q := strconv.Quote(name)
+		seg := g.e.segVar(Segment{Key: KeywordProperties}, Segment{Key: name})
+
+		usesObj = true
+
+		call := g.call(s.Properties[name], "v", "ip.Key("+q+")", "sp.Append("+seg+")", "nil", KeywordProperties)
+		if call == "" {
+			g.p("if _, ok := obj[%s]; ok {\nann.RecordProperty(%s)\n}", q, q)
+		} else {
+			v := "v"
+			if IsFalseSchema(s.Properties[name]) {
+				v = "_"
+			}
+
+			g.p("if %s, ok := obj[%s]; ok {\nann.RecordProperty(%s)\nerrs = append(errs, %s...)\n}", v, q, q, call)
+		}
+	}
+
+	var matchers []string
+
+	for _, pattern := range slices.Sorted(maps.Keys(s.PatternProperties)) {
+		seg := g.e.segVar(Segment{Key: KeywordPatternProperties}, Segment{Key: pattern})
+
+		if _, err := regexcache.Compile(pattern); err != nil {
+			g.p("errs = append(errs, genrt.NewError(ip, sp.Append(%s), %s, %s, nil))", seg,
+				strconv.Quote(KeywordPatternProperties), g.sprintf("pattern %q cannot be compiled", []any{pattern}))
+
+			continue
+		}
+
+		re := g.e.patternVar(pattern)
+		matchers = append(matchers, re+".MatchString(k)")
+		usesKeys = true
+
+		psp := g.tmpName("psp")
+
+		call := g.call(s.PatternProperties[pattern], "obj[k]", "ip.Key(k)", psp, "nil", KeywordPatternProperties)
+		if call != "" {
+			g.p("%s := sp.Append(%s)", psp, seg)
+		}
+
+		g.p("for _, k := range keys {\nif !%s.MatchString(k) {\ncontinue\n}\n\nann.RecordProperty(k)", re)
+
+		if call != "" {
+			g.p("errs = append(errs, %s...)", call)
+		}
+
+		g.p("}")
+	}
+
+	if s.AdditionalProperties != nil {
+		usesKeys = true
+
+		call := g.call(s.AdditionalProperties, "obj[k]", "ip.Key(k)", "asp", "nil", KeywordAdditionalProperties)
+		if call != "" {
+			g.p("asp := sp.Append(%s)", g.e.segVar(Segment{Key: KeywordAdditionalProperties}))
+		}
+
+		g.p("for _, k := range keys {")
+
+		if len(props) > 0 {
+			quoted := make([]string, len(props))
+			for i, name := range props {
+				quoted[i] = strconv.Quote(name)
+			}
+
+			g.p("switch k {\ncase %s:\ncontinue\n}\n", strings.Join(quoted, ", "))
+		}
+
+		if len(matchers) > 0 {
+			g.p("if %s {\ncontinue\n}\n", strings.Join(matchers, " || "))
+		}
+
+		g.p("ann.RecordProperty(k)")
+
+		if call != "" {
+			g.p("errs = append(errs, %s...)", call)
+		}
+
+		g.p("}\n\nann.SetAllProperties()")
+	}
+
+	if s.PropertyNames != nil {
+		call := g.call(s.PropertyNames, "k", "cip", "nsp", "nil", "")
+		if call != "" {
+			usesKeys = true
+
+			g.p("nsp := sp.Append(%s)", g.e.segVar(Segment{Key: KeywordPropertyNames}))
+			g.p("for _, k := range keys {\ncip := ip.Key(k)\nif ce := %s; len(ce) > 0 {", call)
+			g.p("errs = append(errs, genrt.NewError(cip, nsp, %s, %s, ce))",
+				strconv.Quote(KeywordPropertyNames), g.sprintf("property name %q is invalid", nil, "k"))
+			g.p("}\n}")
+		}
+	}
+
+	g.w = outer
+
+	if body.Len() == 0 {
+		return
+	}
+
+	if usesObj || usesKeys {
+		g.p("if obj, ok := x.(map[string]any); ok {")
+	} else {
+		g.p("if _, ok := x.(map[string]any); ok {")
+	}
+
+	if usesKeys {
+		g.p("keys := genrt.SortedKeys(obj)\n")
+	}
+
+	g.w.Write(body.Bytes())
+	g.p("}\n")
+}
+
+// emitInPlace writes the statements validating x in place against sub at
+// schema location sp, appending its errors to errs and merging its
+// annotations on success. A false sub is labeled with label.
+func (g *goFuncGen) emitInPlace(sub *Schema, sp, label string) {
+	if IsFalseSchema(sub) {
+		g.p("errs = append(errs, %s...)", g.call(sub, "x", "ip", sp, "nil", label))
+
+		return
+	}
+
+	ca := g.tmpName("ann")
+
+	call := g.call(sub, "x", "ip", sp, ca, label)
+	if call == "" {
+		return
+	}
+
+	g.p("%s := ann.Child()", ca)
+	g.p("if ce := %s; len(ce) > 0 {\nerrs = append(errs, ce...)\n} else {\nann.Merge(%s)\n}", call, ca)
+}
+
+func (g *goFuncGen) emitSchemaDependencies(deps map[string]*Schema, kw string) {
+	var body bytes.Buffer
+
+	outer := g.w
+	g.w = &body
+
+	for _, prop := range slices.Sorted(maps.Keys(deps)) {
+		sub := deps[prop]
+		if !g.hasWork(sub) {
+			continue
+		}
+
+		seg := g.e.segVar(Segment{Key: kw}, Segment{Key: prop})
+
+		g.p("if _, ok := obj[%s]; ok {", strconv.Quote(prop))
+		g.emitInPlace(sub, "sp.Append("+seg+")", kw)
+		g.p("}")
+	}
+
+	g.w = outer
+
+	if body.Len() == 0 {
+		return
+	}
+
+	g.p("if obj, ok := x.(map[string]any); ok {")
+	g.w.Write(body.Bytes())
+	g.p("}\n")
+}
+
+func (g *goFuncGen) emitRequiredDependencies(deps map[string][]string, kw string) {
+	var body bytes.Buffer
+
+	outer := g.w
+	g.w = &body
+
+	for _, prop := range slices.Sorted(maps.Keys(deps)) {
+		if len(deps[prop]) == 0 {
+			continue
+		}
+
+		seg := g.e.segVar(Segment{Key: kw}, Segment{Key: prop})
+
+		g.p("if _, ok := obj[%s]; ok {", strconv.Quote(prop))
+
+		for _, dep := range deps[prop] {
+			g.p("if _, ok := obj[%s]; !ok {", strconv.Quote(dep))
+			g.p("errs = append(errs, genrt.NewError(ip, sp.Append(%s), %s, %s, nil))", seg, strconv.Quote(kw),
+				g.sprintf("property %q requires property %q", []any{prop, dep}))
+			g.p("}")
+		}
+
+		g.p("}")
+	}
+
+	g.w = outer
+
+	if body.Len() == 0 {
+		return
+	}
+
+	g.p("if obj, ok := x.(map[string]any); ok {")
+	g.w.Write(body.Bytes())
+	g.p("}\n")
+}
+
+func (g *goFuncGen) emitObjectCount(s *Schema) {
+	g.p("if obj, ok := x.(map[string]any); ok {")
+
+	for _, req := range s.Required {
+		g.p("if _, ok := obj[%s]; !ok {", strconv.Quote(req))
+		g.leaf(KeywordRequired, g.sprintf("missing required property %q", []any{req}))
+		g.p("}")
+	}
+
+	if s.MinProperties != nil {
+		g.p("if len(obj) < %d {", *s.MinProperties)
+		g.leaf(KeywordMinProperties,
+			g.sprintf("object has %d properties, minimum is %d", nil, "len(obj)", strconv.Itoa(*s.MinProperties)))
+		g.p("}")
+	}
+
+	if s.MaxProperties != nil {
+		g.p("if len(obj) > %d {", *s.MaxProperties)
+		g.leaf(KeywordMaxProperties,
+			g.sprintf("object has %d properties, maximum is %d", nil, "len(obj)", strconv.Itoa(*s.MaxProperties)))
+		g.p("}")
+	}
+
+	g.p("}\n")
+}
+
+func (g *goFuncGen) emitAllOf(s *Schema) {
+	var anns []string
+
+	first := true
+
+	for i, sub := range s.AllOf {
+		sp := "sp.Append(" + g.e.segVar(Segment{Key: KeywordAllOf}, Segment{Index: i, IsIndex: true}) + ")"
+		if !g.hasWork(sub) {
+			continue
+		}
+
+		if first {
+			g.p("var allOfErrs []*jsonschema.ValidationError")
+
+			first = false
+		}
+
+		if IsFalseSchema(sub) {
+			g.p("allOfErrs = append(allOfErrs, %s...)", g.call(sub, "x", "ip", sp, "nil", ""))
+
+			continue
+		}
+
+		ca := g.tmpName("ann")
+		anns = append(anns, ca)
+
+		g.p("%s := ann.Child()", ca)
+		g.p("if ce := %s; len(ce) > 0 {\nallOfErrs = append(allOfErrs, ce...)\n}", g.call(sub, "x", "ip", sp, ca, ""))
+	}
+
+	if first {
+		return
+	}
+
+	g.p("if len(allOfErrs) > 0 {")
+	g.p("errs = append(errs, genrt.Wrap(ip, sp, %s, %s, allOfErrs))", strconv.Quote(KeywordAllOf),
+		strconv.Quote("did not validate against all subschemas"))
+
+	if len(anns) > 0 {
+		g.p("} else {")
+
+		for _, a := range anns {
+			g.p("ann.Merge(%s)", a)
+		}
+	}
+
+	g.p("}\n")
+}
+
+func (g *goFuncGen) emitAnyOf(s *Schema) {
+	// A trivially true branch always matches, so the keyword cannot fail
+	// and the other branches run only for their annotations.
+	alwaysMatches := slices.ContainsFunc(s.AnyOf, func(sub *Schema) bool { return !g.hasWork(sub) })
+
+	if !alwaysMatches {
+		g.p("anyOfMatched := false\n\nvar anyOfErrs []*jsonschema.ValidationError")
+	}
+
+	for i, sub := range s.AnyOf {
+		sp := "sp.Append(" + g.e.segVar(Segment{Key: KeywordAnyOf}, Segment{Index: i, IsIndex: true}) + ")"
+
+		switch {
+		case !g.hasWork(sub):
+		case IsFalseSchema(sub):
+			if !alwaysMatches {
+				g.p("anyOfErrs = append(anyOfErrs, %s...)", g.call(sub, "x", "ip", sp, "nil", ""))
+			}
+
+		default:
+			ca := g.tmpName("ann")
+			g.p("%s := ann.Child()", ca)
+
+			if alwaysMatches {
+				g.p("if len(%s) == 0 {\nann.Merge(%s)\n}", g.call(sub, "x", "ip", sp, ca, ""), ca)
+			} else {
+				g.p("if ce := %s; len(ce) == 0 {\nanyOfMatched = true\n\nann.Merge(%s)\n} else {\n"+
+					"anyOfErrs = append(anyOfErrs, ce...)\n}", g.call(sub, "x", "ip", sp, ca, ""), ca)
+			}
+		}
+	}
+
+	if !alwaysMatches {
+		g.p("if !anyOfMatched {")
+		g.p("errs = append(errs, genrt.Wrap(ip, sp, %s, %s, anyOfErrs))", strconv.Quote(KeywordAnyOf),
+			strconv.Quote("did not validate against any subschema"))
+		g.p("}")
+	}
+
+	g.p("")
+}
+
+func (g *goFuncGen) emitOneOf(s *Schema) {
+	trivial := 0
+
+	for _, sub := range s.OneOf {
+		if !g.hasWork(sub) {
+			trivial++
+		}
+	}
+
+	g.p("oneOfMatches := %d\n", trivial)
+	g.p("var (\noneOfErrs []*jsonschema.ValidationError\noneOfAnn *genrt.Annotations\n)")
+
+	for i, sub := range s.OneOf {
+		sp := "sp.Append(" + g.e.segVar(Segment{Key: KeywordOneOf}, Segment{Index: i, IsIndex: true}) + ")"
+
+		switch {
+		case !g.hasWork(sub):
+		case IsFalseSchema(sub):
+			g.p("oneOfErrs = append(oneOfErrs, %s...)", g.call(sub, "x", "ip", sp, "nil", ""))
+		default:
+			ca := g.tmpName("ann")
+			g.p("%s := ann.Child()", ca)
+			g.p("if ce := %s; len(ce) == 0 {\noneOfMatches++\noneOfAnn = %s\n} else {\n"+
+				"oneOfErrs = append(oneOfErrs, ce...)\n}", g.call(sub, "x", "ip", sp, ca, ""), ca)
+		}
+	}
+
+	g.p("switch {\ncase oneOfMatches == 0:")
+	g.p("errs = append(errs, genrt.Wrap(ip, sp, %s, %s, oneOfErrs))", strconv.Quote(KeywordOneOf),
+		strconv.Quote("did not validate against any subschema"))
+	g.p("case oneOfMatches > 1:")
+	g.leaf(KeywordOneOf, g.sprintf("validated against %d subschemas, expected exactly one", nil, "oneOfMatches"))
+	g.p("default:\nann.Merge(oneOfAnn)\n}\n")
+}
+
+func (g *goFuncGen) emitNot(s *Schema) {
+	msg := strconv.Quote("should not validate against the schema")
+
+	switch {
+	case IsFalseSchema(s.Not):
+		// The subschema never validates, so not never fails.
+	case !g.hasWork(s.Not):
+		g.leaf(KeywordNot, msg)
+		g.p("")
+	default:
+		sp := "sp.Append(" + g.e.segVar(Segment{Key: KeywordNot}) + ")"
+		g.p("if len(%s) == 0 {", g.call(s.Not, "x", "ip", sp, "nil", ""))
+		g.leaf(KeywordNot, msg)
+		g.p("}\n")
+	}
+}
+
+func (g *goFuncGen) emitIfThenElse(s *Schema) {
+	branch := func(sub *Schema, kw, msg string) {
+		if sub == nil || !g.hasWork(sub) {
+			return
+		}
+
+		sp := "sp.Append(" + g.e.segVar(Segment{Key: kw}) + ")"
+
+		if IsFalseSchema(sub) {
+			g.p("errs = append(errs, genrt.Wrap(ip, sp, %s, %s, %s))", strconv.Quote(kw), strconv.Quote(msg),
+				g.call(sub, "x", "ip", sp, "nil", ""))
+
+			return
+		}
+
+		ca := g.tmpName("ann")
+		g.p("%s := ann.Child()", ca)
+		g.p("if ce := %s; len(ce) > 0 {", g.call(sub, "x", "ip", sp, ca, ""))
+		g.p("errs = append(errs, genrt.Wrap(ip, sp, %s, %s, ce))", strconv.Quote(kw), strconv.Quote(msg))
+		g.p("} else {\nann.Merge(%s)\n}", ca)
+	}
+
+	thenBranch := func() { branch(s.Then, KeywordThen, "if condition was true but then validation failed") }
+	elseBranch := func() { branch(s.Else, KeywordElse, "if condition was false but else validation failed") }
+
+	switch {
+	case IsFalseSchema(s.If):
+		elseBranch()
+	case !g.hasWork(s.If):
+		thenBranch()
+	default:
+		sp := "sp.Append(" + g.e.segVar(Segment{Key: KeywordIf}) + ")"
+		ca := g.tmpName("ann")
+
+		g.p("%s := ann.Child()", ca)
+		g.p("if len(%s) == 0 {\nann.Merge(%s)\n", g.call(s.If, "x", "ip", sp, ca, ""), ca)
+		thenBranch()
+		g.p("} else {")
+		elseBranch()
+		g.p("}")
+	}
+
+	g.p("")
+}
+
+func (g *goFuncGen) emitRef(s *Schema) {
+	res := g.refs[s]
+
+	if res.Target == nil {
+		// An unresolvable ref fails unless it is a local fragment of a
+		// compile-vetted document, which the walk skips.
+		if !uriref.IsFragmentOnly(s.Ref) || !g.v.refReg.KnownSchema(s) {
+			g.leaf(KeywordRef, g.sprintf("cannot resolve %s %q", []any{KeywordRef, s.Ref}))
+			g.p("")
+		}
+
+		return
+	}
+
+	sp := "sp.Append(" + g.e.segVar(Segment{Key: KeywordRef}) + ")"
+
+	switch {
+	case IsFalseSchema(res.Target):
+		g.p("errs = append(errs, genrt.Wrap(ip, sp, %s, \"\", %s))\n", strconv.Quote(KeywordRef),
+			g.call(res.Target, "x", "ip", sp, "nil", ""))
+
+	case g.hasWork(res.Target):
+		ca := g.tmpName("ann")
+		g.p("%s := ann.Child()", ca)
+		g.p("if ce := %s; len(ce) > 0 {", g.call(res.Target, "x", "ip", sp, ca, ""))
+		g.p("errs = append(errs, genrt.Wrap(ip, sp, %s, \"\", ce))", strconv.Quote(KeywordRef))
+		g.p("} else {\nann.Merge(%s)\n}\n", ca)
+	}
+}
+
+func (g *goFuncGen) emitUnevaluatedProperties(s *Schema) {
+	sub := s.UnevaluatedProperties
+
+	if schemashape.IsEmpty(sub) {
+		g.p("if _, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {\nann.SetAllProperties()\n}\n")
+
+		return
+	}
+
+	g.p("if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {")
+
+	call := g.call(sub, "obj[k]", "cip", "usp", "nil", "")
+	if call != "" {
+		g.p("usp := sp.Append(%s)", g.e.segVar(Segment{Key: KeywordUnevaluatedProperties}))
+	}
+
+	g.p("for _, k := range genrt.SortedKeys(obj) {\nif ann.Evaluated(k) {\ncontinue\n}\n")
+
+	if call == "" {
+		g.p("ann.RecordProperty(k)")
+	} else {
+		g.p("cip := ip.Key(k)\nif ce := %s; len(ce) == 0 {\nann.RecordProperty(k)\n} else {", call)
+		g.p("errs = append(errs, genrt.NewError(cip, usp, %s, %s, ce))", strconv.Quote(KeywordUnevaluatedProperties),
+			g.sprintf("property %q is not allowed by unevaluatedProperties", nil, "k"))
+		g.p("}")
+	}
+
+	g.p("}\n}\n")
+}
+
+func (g *goFuncGen) emitUnevaluatedItems(s *Schema) {
+	sub := s.UnevaluatedItems
+
+	if schemashape.IsEmpty(sub) {
+		g.p("if _, ok := x.([]any); ok && !ann.AllItemsSet() {\nann.SetAllItems()\n}\n")
+
+		return
+	}
+
+	g.p("if arr, ok := x.([]any); ok && !ann.AllItemsSet() {")
+
+	call := g.call(sub, "arr[i]", "cip", "usp", "nil", "")
+	if call != "" {
+		g.p("usp := sp.Append(%s)", g.e.segVar(Segment{Key: KeywordUnevaluatedItems}))
+	}
+
+	g.p("for i := range arr {\nif ann.ItemEvaluated(i) {\ncontinue\n}\n")
+
+	if call == "" {
+		g.p("ann.RecordItem(i)")
+	} else {
+		g.p("cip := ip.Index(i)\nif ce := %s; len(ce) == 0 {\nann.RecordItem(i)\n} else {", call)
+		g.p("errs = append(errs, genrt.NewError(cip, usp, %s, %s, ce))", strconv.Quote(KeywordUnevaluatedItems),
+			g.sprintf("item %d is not allowed by unevaluatedItems", nil, "i"))
+		g.p("}")
+	}
+
+	g.p("}\n}\n")
+}
+
+// goFloatPtr returns the expression for a *float64 keyword value.
+func goFloatPtr(e *goEmitter, f *float64) string {
+	if f == nil {
+		return "nil"
+	}
+
+	return "genrt.Float(" + goFloat(e, *f) + ")"
+}
+
+// goFloat returns a Go expression evaluating to exactly f.
+func goFloat(e *goEmitter, f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return e.use("math") + ".NaN()"
+	case math.IsInf(f, 1):
+		return e.use("math") + ".Inf(1)"
+	case math.IsInf(f, -1):
+		return e.use("math") + ".Inf(-1)"
+	case f == 0 && math.Signbit(f):
+		return e.use("math") + ".Copysign(0, -1)"
+	}
+
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+
+	return s
+}
+
+// literal returns a Go expression for the JSON value v, as the upstream
+// decoder or a hand-built schema holds it. Integers and floats keep their Go
+// type, so the generated value compares exactly as the schema's does.
+//
+//nolint:cyclop // One case per JSON value representation.
+func (e *goEmitter) literal(v any) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "nil", nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	case string:
+		return strconv.Quote(x), nil
+	case float64:
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return "", fmt.Errorf("non-finite value %v", x)
+		}
+
+		return "float64(" + goFloat(e, x) + ")", nil
+
+	case float32:
+		return "float32(" + strconv.FormatFloat(float64(x), 'g', -1, 32) + ")", nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%T(%d)", x, x), nil
+	case json.Number:
+		return fmt.Sprintf("%s.Number(%s)", e.use("encoding/json"), strconv.Quote(string(x))), nil
+
+	case []any:
+		parts := make([]string, len(x))
+		for i, item := range x {
+			lit, err := e.literal(item)
+			if err != nil {
+				return "", err
+			}
+
+			parts[i] = lit
+		}
+
+		return "[]any{" + strings.Join(parts, ", ") + "}", nil
+
+	case map[string]any:
+		parts := make([]string, 0, len(x))
+		for _, k := range slices.Sorted(maps.Keys(x)) {
+			lit, err := e.literal(x[k])
+			if err != nil {
+				return "", err
+			}
+
+			parts = append(parts, strconv.Quote(k)+": "+lit)
+		}
+
+		return "map[string]any{" + strings.Join(parts, ", ") + "}", nil
+
+	default:
+		return "", fmt.Errorf("value of type %T", v)
+	}
+}
+
+// goIdentFragment returns s with every character that cannot appear in a Go
+// identifier removed.
+func goIdentFragment(s string) string {
+	var b strings.Builder
+
+	for _, r := range s {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	if b.Len() == 0 {
+		return "pkg"
+	}
+
+	return b.String()
+}
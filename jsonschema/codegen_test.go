@@ -0,0 +1,129 @@
+package jsonschema_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+func TestGenerateGo_Config(t *testing.T) {
+	t.Parallel()
+
+	v := jsonschema.MustCompile(&jsonschema.Schema{Type: "string"})
+
+	tests := map[string]jsonschema.GoConfig{
+		"invalid package": {
+			Package: "my-pkg",
+			Funcs:   []jsonschema.GoFunc{{Name: "Validate", Validator: v}},
+		},
+		"no funcs": {
+			Package: "pkg",
+		},
+		"invalid name": {
+			Package: "pkg",
+			Funcs:   []jsonschema.GoFunc{{Name: "Validate Me", Validator: v}},
+		},
+		"nil validator": {
+			Package: "pkg",
+			Funcs:   []jsonschema.GoFunc{{Name: "Validate"}},
+		},
+		"colliding helpers": {
+			Package: "pkg",
+			Funcs: []jsonschema.GoFunc{
+				{Name: "Validate", Validator: v},
+				{Name: "validate", Validator: v},
+			},
+		},
+		"typed entry point collides": {
+			Package: "pkg",
+			Funcs: []jsonschema.GoFunc{
+				{Name: "Check", Validator: v, Type: reflect.TypeFor[string]()},
+				{Name: "CheckValue", Validator: v},
+			},
+		},
+	}
+
+	for name, cfg := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := jsonschema.GenerateGo(t.Context(), cfg)
+			require.ErrorIs(t, err, jsonschema.ErrCodeGen)
+		})
+	}
+}
+
+func TestGenerateGo_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts   []jsonschema.ValidateOption
+		schema string
+	}{
+		"dynamicRef": {
+			schema: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"$dynamicAnchor": "node",
+				"items": {"$dynamicRef": "#node"}
+			}`,
+		},
+		"custom keyword": {
+			schema: `{"x-unique-by": "id"}`,
+			opts:   []jsonschema.ValidateOption{jsonschema.WithKeyword(uniqueByVocab, "x-unique-by", uniqueBy)},
+		},
+		"fail fast": {
+			schema: `{"type": "string"}`,
+			opts:   []jsonschema.ValidateOption{jsonschema.WithFailFast(true)},
+		},
+		"custom format": {
+			schema: `{"format": "even"}`,
+			opts: []jsonschema.ValidateOption{
+				jsonschema.WithFormats(true),
+				jsonschema.WithFormatValidator("even", jsonschema.FormatValidatorFunc(func(context.Context, string, string) error { return nil })),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := jsonschema.Compile(t.Context(), mustSchema(t, tc.schema), tc.opts...)
+			require.NoError(t, err)
+
+			_, err = jsonschema.GenerateGo(t.Context(), jsonschema.GoConfig{
+				Package: "pkg",
+				Funcs:   []jsonschema.GoFunc{{Name: "Validate", Validator: v}},
+			})
+			require.ErrorIs(t, err, jsonschema.ErrCodeGen)
+		})
+	}
+}
+
+func TestGenerateGo_Output(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		ID string `json:"id" jsonschema:"minLength=1"`
+	}
+
+	v, err := jsonschema.Compile(t.Context(), jsonschema.MustGenerateFor[item]())
+	require.NoError(t, err)
+
+	src, err := jsonschema.GenerateGo(t.Context(), jsonschema.GoConfig{
+		Package: "pkg",
+		Funcs:   []jsonschema.GoFunc{{Name: "ValidateItem", Validator: v}},
+	})
+	require.NoError(t, err)
+
+	out := string(src)
+	assert.True(t, strings.HasPrefix(out, "// Code generated by jsonschema.GenerateGo. DO NOT EDIT.\n\npackage pkg\n"))
+	assert.Contains(t, out, "func ValidateItem(instance any) error {")
+	assert.NotContains(t, out, "ValidateItemValue")
+}
@@ -0,0 +1,445 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/jsontag"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+	isZeroerType      = reflect.TypeFor[interface{ IsZero() bool }]()
+)
+
+// maxGoValueNesting bounds how deeply a conversion is inlined into its enclosing function; a deeper part falls back to
+// encoding/json.
+const maxGoValueNesting = 8
+
+// goValueGen generates the functions converting a typed value to its JSON
+// form, as [Validator.ValidateValue] would see it after marshaling and
+// decoding: objects as map[string]any, arrays as []any, numbers as
+// [json.Number]. Each named struct type gets one function, shared by every
+// entry point of the file; any other type is converted inline. A part of the
+// value whose JSON form encoding/json computes by means the generated code
+// does not mirror (a marshaler method, an embedded field, the ",string"
+// option) is converted by marshaling and decoding that part alone, so the
+// result is always exactly encoding/json's.
+type goValueGen struct {
+	e       *goEmitter
+	w       *bytes.Buffer
+	pending []reflect.Type
+	tmp     int
+}
+
+// emitTyped writes the typed entry point of f and the conversion functions
+// it needs.
+func (g *goFuncGen) emitTyped(f GoFunc, root *Schema) error {
+	c := &goValueGen{e: g.e, w: g.w}
+
+	tname, ok := c.typeName(f.Type)
+	if !ok {
+		return fmt.Errorf("type %v cannot be named in package %q", f.Type, g.e.cfg.ImportPath)
+	}
+
+	conv := goHelperPrefix(f.Name) + "Value"
+
+	call := g.call(root, "x", "nil", "nil", "nil", "")
+
+	g.p("// %sValue validates *v against the schema it was generated from, reporting\n"+
+		"// the errors [jsonschema.Validator.ValidateValue] reports for v.", f.Name)
+	g.p("func %sValue(v *%s) error {", f.Name, tname)
+
+	if call == "" || IsFalseSchema(root) {
+		g.p("_, err := %s(v)", conv)
+	} else {
+		g.p("x, err := %s(v)", conv)
+	}
+
+	g.p("if err != nil {\nreturn err\n}\n")
+	g.emitRootResult(root, call)
+	g.p("}\n")
+
+	g.p("// %s returns the JSON form of *v, as encoding/json marshals it.", conv)
+	g.p("func %s(v *%s) (any, error) {\nvar x any\n", conv, tname)
+	c.convert(reflect.PointerTo(f.Type), "v", "x", "0", false, 0)
+	g.p("return x, nil\n}\n")
+
+	c.drain()
+
+	return nil
+}
+
+// p writes one formatted line.
+func (c *goValueGen) p(format string, args ...any) {
+	fmt.Fprintf(c.w, format, args...)
+	c.w.WriteByte('\n')
+}
+
+// tmpName returns a fresh local variable name with the given stem.
+func (c *goValueGen) tmpName(stem string) string {
+	c.tmp++
+
+	return fmt.Sprintf("%s%d", stem, c.tmp)
+}
+
+// typeName returns the expression naming t in the generated file, or false
+// when t cannot be named there: an unnamed or generic type, a type local to
+// package main, or an unexported type of another package.
+func (c *goValueGen) typeName(t reflect.Type) (string, bool) {
+	name, pkg := t.Name(), t.PkgPath()
+	if name == "" || pkg == "" || strings.Contains(name, "[") {
+		return "", false
+	}
+
+	if pkg == c.e.cfg.ImportPath {
+		return name, true
+	}
+
+	if pkg == "main" || !token.IsExported(name) {
+		return "", false
+	}
+
+	return c.e.use(pkg) + "." + name, true
+}
+
+// structFunc returns the name of the conversion function for the named
+// struct type t, queueing it for generation on first use, or false when t
+// has no function and converts through encoding/json.
+func (c *goValueGen) structFunc(t reflect.Type) (string, bool) {
+	if name, ok := c.e.valueFuncs[t]; ok {
+		return name, name != ""
+	}
+
+	if _, ok := c.typeName(t); !ok || !structConvertible(t) {
+		c.e.valueFuncs[t] = ""
+
+		return "", false
+	}
+
+	base := c.e.prefix + "ValueOf" + t.Name()
+	name := base
+
+	for n := 2; slices.Contains(slices.Collect(maps.Values(c.e.valueFuncs)), name); n++ {
+		name = fmt.Sprintf("%s%d", base, n)
+	}
+
+	c.e.valueFuncs[t] = name
+	c.pending = append(c.pending, t)
+
+	return name, true
+}
+
+// structConvertible reports whether the generated code can build the JSON
+// object for struct type t field by field: encoding/json's field set for it
+// follows from the tags alone, with no embedded struct promoting fields, no
+// name collision to resolve, no ",string" re-encoding, and no IsZero method
+// deciding an omitzero field.
+func structConvertible(t reflect.Type) bool {
+	names := map[string]bool{}
+
+	for f := range t.Fields() {
+		if f.Anonymous {
+			return false
+		}
+
+		info := jsontag.Parse(f)
+		if info.JSONName == "" {
+			continue
+		}
+
+		if info.JSONString || names[info.JSONName] {
+			return false
+		}
+
+		if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" && name != "-" && !jsontag.ValidName(name) {
+			return false
+		}
+
+		if info.Omitzero && (f.Type.Implements(isZeroerType) || reflect.PointerTo(f.Type).Implements(isZeroerType)) {
+			return false
+		}
+
+		names[info.JSONName] = true
+	}
+
+	return true
+}
+
+// drain generates the queued struct conversion functions, including those
+// they queue in turn.
+func (c *goValueGen) drain() {
+	for len(c.pending) > 0 {
+		t := c.pending[0]
+		c.pending = c.pending[1:]
+		c.emitStruct(t)
+	}
+}
+
+// emitStruct writes the conversion function of struct type t.
+func (c *goValueGen) emitStruct(t reflect.Type) {
+	name := c.e.valueFuncs[t]
+	tname, _ := c.typeName(t)
+
+	c.p("// %s returns the JSON form of *v, as encoding/json marshals it.", name)
+	c.p("func %s(v *%s, depth int) (any, error) {", name, tname)
+	c.p("if v == nil {\nreturn nil, nil\n}\n")
+	c.p("if depth > %d {\nreturn nil, genrt.CycleError(v)\n}\n", maxGoValueDepth)
+	c.p("obj := map[string]any{}")
+
+	for f := range t.Fields() {
+		info := jsontag.Parse(f)
+		if info.JSONName == "" {
+			continue
+		}
+
+		src := "v." + f.Name
+		dst := "obj[" + strconv.Quote(info.JSONName) + "]"
+
+		var omit []string
+
+		if info.Omitempty {
+			if cond := goEmptyCheck(f.Type, src); cond != "" {
+				omit = append(omit, cond)
+			}
+		}
+
+		if info.Omitzero {
+			omit = append(omit, "genrt.IsZero(&"+src+")")
+		}
+
+		if len(omit) > 0 {
+			c.p("if !(%s) {", strings.Join(omit, " || "))
+		}
+
+		c.convert(f.Type, src, dst, "depth", true, 0)
+
+		if len(omit) > 0 {
+			c.p("}")
+		}
+	}
+
+	c.p("return obj, nil\n}\n")
+}
+
+// maxGoValueDepth is the struct nesting depth past which a conversion reports
+// a pointer cycle, mirroring encoding/json's cycle detection threshold.
+const maxGoValueDepth = 1000
+
+// goEmptyCheck returns the condition under which encoding/json's omitempty
+// drops the value src of type t, or "" when it never does.
+func goEmptyCheck(t reflect.Type, src string) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "!" + src
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return src + " == 0"
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return "len(" + src + ") == 0"
+	case reflect.Pointer, reflect.Interface:
+		return src + " == nil"
+	default:
+		return ""
+	}
+}
+
+// marshalsItself reports whether encoding/json encodes a value of type t
+// through a MarshalJSON or MarshalText method. A pointer-receiver method
+// counts only for an addressable value.
+func marshalsItself(t reflect.Type, addressable bool) bool {
+	if t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) {
+		return true
+	}
+
+	if !addressable || t.Kind() == reflect.Pointer {
+		return false
+	}
+
+	pt := reflect.PointerTo(t)
+
+	return pt.Implements(jsonMarshalerType) || pt.Implements(textMarshalerType)
+}
+
+// fallback writes the statements assigning dst the JSON form of src through
+// encoding/json. An addressable src is marshaled through its address, so
+// pointer-receiver marshalers apply as encoding/json applies them.
+func (c *goValueGen) fallback(src, dst string, addressable bool) {
+	if addressable {
+		src = "&" + src
+	}
+
+	c.assignErr(dst, "genrt.JSONValue("+src+")")
+}
+
+// assignErr writes the statements assigning dst the value of the
+// (any, error) call expression, returning its error.
+func (c *goValueGen) assignErr(dst, call string) {
+	t := c.tmpName("val")
+
+	c.p("%s, err := %s\nif err != nil {\nreturn nil, err\n}\n\n%s = %s", t, call, dst, t)
+}
+
+// convert writes the statements assigning dst the JSON form of the value src
+// of type t. The depth is the expression holding the current struct depth,
+// and nest the inline nesting of src within the function.
+//
+//nolint:cyclop,funlen // One case per reflect.Kind.
+func (c *goValueGen) convert(t reflect.Type, src, dst, depth string, addressable bool, nest int) {
+	if marshalsItself(t, addressable) || nest > maxGoValueNesting {
+		c.fallback(src, dst, addressable)
+
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		c.p("%s = bool(%s)", dst, src)
+
+	case reflect.String:
+		c.p("%s = genrt.String(string(%s))", dst, src)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		c.p("%s = genrt.Int(int64(%s))", dst, src)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		c.p("%s = genrt.Uint(uint64(%s))", dst, src)
+
+	case reflect.Float32:
+		c.assignErr(dst, fmt.Sprintf("genrt.Number(float64(%s), 32)", src))
+
+	case reflect.Float64:
+		c.assignErr(dst, fmt.Sprintf("genrt.Number(float64(%s), 64)", src))
+
+	case reflect.Interface:
+		// Encoding/json encodes the dynamic value, which is never
+		// addressable.
+		c.fallback(src, dst, false)
+
+	case reflect.Pointer:
+		if fn, ok := c.structElem(t.Elem()); ok {
+			c.assignErr(dst, fmt.Sprintf("%s(%s, %s+1)", fn, src, depth))
+
+			return
+		}
+
+		c.p("if %s == nil {\n%s = nil\n} else {", src, dst)
+		c.convert(t.Elem(), "(*"+src+")", dst, depth, true, nest+1)
+		c.p("}")
+
+	case reflect.Struct:
+		fn, ok := c.structElem(t)
+		if !ok || !addressable {
+			c.fallback(src, dst, addressable)
+
+			return
+		}
+
+		c.assignErr(dst, fmt.Sprintf("%s(&%s, %s+1)", fn, src, depth))
+
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// A byte slice encodes as base64.
+			c.fallback(src, dst, addressable)
+
+			return
+		}
+
+		c.p("if %s == nil {\n%s = nil\n} else {", src, dst)
+		c.convertElems(t, src, dst, depth, true, nest)
+		c.p("}")
+
+	case reflect.Array:
+		c.convertElems(t, src, dst, depth, addressable, nest)
+
+	case reflect.Map:
+		c.convertMap(t, src, dst, depth, addressable, nest)
+
+	default:
+		// Channels, functions, and complex numbers: encoding/json reports
+		// its own error.
+		c.fallback(src, dst, addressable)
+	}
+}
+
+// structElem returns the conversion function of the struct type t when it
+// has one.
+func (c *goValueGen) structElem(t reflect.Type) (string, bool) {
+	if t.Kind() != reflect.Struct || marshalsItself(t, true) {
+		return "", false
+	}
+
+	return c.structFunc(t)
+}
+
+// convertElems writes the conversion of the slice or array src to []any.
+func (c *goValueGen) convertElems(t reflect.Type, src, dst, depth string, addressable bool, nest int) {
+	arr, i := c.tmpName("arr"), c.tmpName("i")
+
+	c.p("%s := make([]any, len(%s))", arr, src)
+	c.p("for %s := range %s {", i, src)
+	c.convert(t.Elem(), src+"["+i+"]", arr+"["+i+"]", depth, addressable, nest+1)
+	c.p("}\n\n%s = %s", dst, arr)
+}
+
+// convertMap writes the conversion of the map src to map[string]any. Map
+// values are not addressable, so a struct or array value, whose encoding
+// could depend on a pointer-receiver marshaler further down, converts
+// through encoding/json.
+func (c *goValueGen) convertMap(t reflect.Type, src, dst, depth string, addressable bool, nest int) {
+	key := t.Key()
+
+	var keyExpr func(k string) string
+
+	switch key.Kind() {
+	case reflect.String:
+		keyExpr = func(k string) string { return "string(" + k + ")" }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !key.Implements(textMarshalerType) {
+			keyExpr = func(k string) string { return c.e.use("strconv") + ".FormatInt(int64(" + k + "), 10)" }
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if !key.Implements(textMarshalerType) {
+			keyExpr = func(k string) string { return c.e.use("strconv") + ".FormatUint(uint64(" + k + "), 10)" }
+		}
+	}
+
+	if keyExpr == nil {
+		c.fallback(src, dst, addressable)
+
+		return
+	}
+
+	c.p("if %s == nil {\n%s = nil\n} else", src, dst)
+
+	if key.Kind() == reflect.String {
+		// A key that is not valid UTF-8 may collide with another once
+		// encoding/json replaces its invalid bytes.
+		c.p("if !genrt.ValidKeys(%s) {", src)
+		c.fallback(src, dst, addressable)
+		c.p("} else")
+	}
+
+	obj, k, v := c.tmpName("obj"), c.tmpName("k"), c.tmpName("v")
+
+	c.p("{\n%s := make(map[string]any, len(%s))", obj, src)
+	c.p("for %s, %s := range %s {", k, v, src)
+
+	if elem := t.Elem(); elem.Kind() == reflect.Struct || elem.Kind() == reflect.Array {
+		c.fallback(v, obj+"["+keyExpr(k)+"]", false)
+	} else {
+		c.convert(elem, v, obj+"["+keyExpr(k)+"]", depth, false, nest+1)
+	}
+
+	c.p("}\n\n%s = %s\n}", dst, obj)
+}
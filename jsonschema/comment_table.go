@@ -0,0 +1,233 @@
+package jsonschema
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/format"
+	"go/token"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/goast"
+)
+
+// CommentTable is a [DescriptionProvider] serving Go doc comments extracted
+// ahead of time, so a binary deployed without source files generates the
+// descriptions [GoCommentProvider] would, with no package loading at run time.
+// [GoCommentProvider.ExtractTable] builds one at build time and
+// [CommentTable.GoSource] renders it as a Go file to compile into the binary;
+// jsonschemagen's -comment-table flag does both from a go:generate line.
+//
+// The table maps an import path to the package's documented types, keyed by
+// unqualified type name without type arguments, and is matched the way
+// [GoCommentProvider] matches: by package path and name. A nil table
+// describes nothing.
+type CommentTable map[string]map[string]TypeComments
+
+// TypeComments is one type's entry in a [CommentTable]: the type's doc
+// comment and the doc comments of its struct fields, keyed by Go field name.
+// Both are stored as the extracting [GoCommentProvider] serves them, without
+// marker lines and rendered under its doc options, and a defined type
+// (type Foo Bar) carries the fields of the struct it resolves to. Only
+// descriptions are recorded: the keywords a [GoDocInterpreter] derives
+// (deprecated, title, markdownDescription) need the sources.
+type TypeComments struct {
+	Doc    string
+	Fields map[string]string
+}
+
+// TypeDescription returns the recorded doc comment for a named type.
+func (ct CommentTable) TypeDescription(_ context.Context, tc TypeContext) (string, error) {
+	t := tc.Type
+	if t.Name() == "" || t.PkgPath() == "" {
+		return "", nil
+	}
+
+	return ct[t.PkgPath()][goast.BaseTypeName(t.Name())].Doc, nil
+}
+
+// FieldDescription returns the recorded doc comment for a struct field,
+// located via the declaring type ([FieldContext.Owner]) and the Go field name.
+func (ct CommentTable) FieldDescription(_ context.Context, fc FieldContext) (string, error) {
+	owner := fc.Owner
+	if owner.Name() == "" || owner.PkgPath() == "" {
+		return "", nil
+	}
+
+	return ct[owner.PkgPath()][goast.BaseTypeName(owner.Name())].Fields[fc.StructField.Name], nil
+}
+
+// GoSource returns a formatted Go source file in package pkg declaring the
+// table as the variable name, for compiling extracted comments into a binary:
+//
+//	var Comments = jsonschema.CommentTable{
+//		"example.com/app": {
+//			"Config": {
+//				Doc:    "Config is the application configuration.",
+//				Fields: map[string]string{"Port": "Port is the listen port."},
+//			},
+//		},
+//	}
+//
+// Entries are sorted, so the output is deterministic. GoSource returns an
+// error wrapping [ErrCodeGen] when pkg or name is not a Go identifier.
+func (ct CommentTable) GoSource(pkg, name string) ([]byte, error) {
+	if !token.IsIdentifier(pkg) {
+		return nil, fmt.Errorf("%w: invalid package name %q", ErrCodeGen, pkg)
+	}
+
+	if !token.IsIdentifier(name) {
+		return nil, fmt.Errorf("%w: invalid variable name %q", ErrCodeGen, name)
+	}
+
+	var b bytes.Buffer
+
+	b.WriteString("// Code generated by jsonschema.CommentTable.GoSource. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\nimport %q\n\n", pkg, "go.jacobcolvin.com/x/jsonschema")
+	fmt.Fprintf(&b, "// %s holds Go doc comments extracted at build time; it is a\n", name)
+	b.WriteString("// jsonschema.DescriptionProvider.\n")
+	fmt.Fprintf(&b, "var %s = jsonschema.CommentTable{\n", name)
+
+	for _, path := range slices.Sorted(maps.Keys(ct)) {
+		fmt.Fprintf(&b, "%s: {\n", strconv.Quote(path))
+
+		types := ct[path]
+		for _, typeName := range slices.Sorted(maps.Keys(types)) {
+			tc := types[typeName]
+
+			fmt.Fprintf(&b, "%s: {\n", strconv.Quote(typeName))
+
+			if tc.Doc != "" {
+				fmt.Fprintf(&b, "Doc: %s,\n", strconv.Quote(tc.Doc))
+			}
+
+			if len(tc.Fields) > 0 {
+				b.WriteString("Fields: map[string]string{\n")
+
+				for _, field := range slices.Sorted(maps.Keys(tc.Fields)) {
+					fmt.Fprintf(&b, "%s: %s,\n", strconv.Quote(field), strconv.Quote(tc.Fields[field]))
+				}
+
+				b.WriteString("},\n")
+			}
+
+			b.WriteString("},\n")
+		}
+
+		b.WriteString("},\n")
+	}
+
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%w: format generated source: %w", ErrCodeGen, err)
+	}
+
+	return src, nil
+}
+
+// ExtractTable returns a [CommentTable] holding the doc comments of every
+// package the schemas of types reach. It generates each type's schema with
+// opts, recording the packages of the types and field owners generation asks
+// to describe, and then tabulates every type those packages declare, so a
+// later generation of the same types (or of other types from the same
+// packages) finds each description the provider itself would serve. Packages
+// whose sources cannot be loaded are left out, as the provider supplies no
+// comments for them.
+//
+// Any description provider among opts is replaced for the recording pass.
+// A generation error, or a canceled or expired context, is returned.
+func (ce *GoCommentProvider) ExtractTable(
+	ctx context.Context, types []reflect.Type, opts ...GenerateOption,
+) (CommentTable, error) {
+	rec := &packageRecorder{paths: map[string]bool{}}
+	opts = append(slices.Clone(opts), WithDescriptionProvider(rec))
+
+	for _, t := range types {
+		_, err := Generate(ctx, t, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	table := CommentTable{}
+
+	for _, path := range slices.Sorted(maps.Keys(rec.paths)) {
+		pkg, err := ce.sourcePackage(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if pkg == nil {
+			continue
+		}
+
+		if types := ce.packageComments(pkg); len(types) > 0 {
+			table[path] = types
+		}
+	}
+
+	return table, nil
+}
+
+// packageComments tabulates the documented types and fields declared in pkg,
+// as the provider serves them.
+func (ce *GoCommentProvider) packageComments(pkg *goast.Package) map[string]TypeComments {
+	types := map[string]TypeComments{}
+
+	for _, name := range goast.TypeNames(pkg.Files) {
+		doc, _ := goast.TypeDoc(pkg.Files, name)
+		tc := TypeComments{Doc: ce.renderDoc(pkg, doc).description}
+
+		for field, doc := range goast.StructFieldDocsThroughAliases(pkg.Files, name) {
+			if doc = ce.renderDoc(pkg, doc).description; doc != "" {
+				if tc.Fields == nil {
+					tc.Fields = map[string]string{}
+				}
+
+				tc.Fields[field] = doc
+			}
+		}
+
+		if tc.Doc != "" || len(tc.Fields) > 0 {
+			types[name] = tc
+		}
+	}
+
+	return types
+}
+
+// packageRecorder is the [DescriptionProvider] [GoCommentProvider.ExtractTable]
+// generates with: it describes nothing and records the import path of every
+// type and field owner it is asked about.
+type packageRecorder struct {
+	paths map[string]bool
+	mu    sync.Mutex
+}
+
+func (r *packageRecorder) TypeDescription(_ context.Context, tc TypeContext) (string, error) {
+	r.record(tc.Type.PkgPath())
+
+	return "", nil
+}
+
+func (r *packageRecorder) FieldDescription(_ context.Context, fc FieldContext) (string, error) {
+	r.record(fc.Owner.PkgPath())
+
+	return "", nil
+}
+
+func (r *packageRecorder) record(path string) {
+	if path == "" {
+		return
+	}
+
+	r.mu.Lock()
+	r.paths[path] = true
+	r.mu.Unlock()
+}
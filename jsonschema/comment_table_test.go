@@ -0,0 +1,167 @@
+package jsonschema_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha"
+)
+
+// TestCommentTableMatchesGoCommentProvider pins the table's contract: a
+// schema generated from the extracted table is identical to one generated
+// with the provider the table was extracted from, across the lookups the
+// provider resolves specially (embedded fields, generic instantiations,
+// defined types following their underlying struct).
+func TestCommentTableMatchesGoCommentProvider(t *testing.T) {
+	t.Parallel()
+
+	types := []reflect.Type{
+		reflect.TypeFor[alpha.Widget](),
+		reflect.TypeFor[alpha.Envelope](),
+		reflect.TypeFor[alpha.GenericEnvelope](),
+		reflect.TypeFor[alpha.DefinedFromStruct](),
+		reflect.TypeFor[alpha.Box[string]](),
+		reflect.TypeFor[alpha.KnotRoot](),
+	}
+
+	provider := jsonschema.NewGoCommentProvider()
+
+	table, err := provider.ExtractTable(t.Context(), types)
+	require.NoError(t, err)
+	require.Contains(t, table, "go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha")
+	require.Contains(t, table, "go.jacobcolvin.com/x/jsonschema/internal/testtypes/beta")
+
+	for _, typ := range types {
+		t.Run(typ.String(), func(t *testing.T) {
+			t.Parallel()
+
+			want, err := jsonschema.Generate(t.Context(), typ, jsonschema.WithDescriptionProvider(provider))
+			require.NoError(t, err)
+
+			got, err := jsonschema.Generate(t.Context(), typ, jsonschema.WithDescriptionProvider(table))
+			require.NoError(t, err)
+
+			wantJSON, err := json.Marshal(want)
+			require.NoError(t, err)
+
+			gotJSON, err := json.Marshal(got)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(wantJSON), string(gotJSON))
+		})
+	}
+}
+
+// TestCommentTableExtractTable covers what the table records: marker lines
+// are stripped, as the provider serves them, and a defined type carries the
+// fields of the struct it resolves to.
+func TestCommentTableExtractTable(t *testing.T) {
+	t.Parallel()
+
+	// A provider reading markers serves descriptions without them.
+	comments := jsonschema.NewGoCommentProvider()
+	comments.Markers()
+
+	table, err := comments.ExtractTable(t.Context(),
+		[]reflect.Type{reflect.TypeFor[alpha.DefinedFromStruct]()})
+	require.NoError(t, err)
+
+	pkg := table["go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha"]
+	require.NotNil(t, pkg)
+
+	assert.Contains(t, pkg["DefinedFromStruct"].Doc, "is a defined type")
+	assert.Equal(t, pkg["Underlying"].Fields, pkg["DefinedFromStruct"].Fields)
+	assert.Equal(t, "Quantity documents the underlying quantity.", pkg["DefinedFromStruct"].Fields["Quantity"])
+
+	for name, tc := range pkg {
+		assert.NotContains(t, tc.Doc, "\n+", name)
+	}
+}
+
+// TestCommentTableExtractTableCanceledContext covers the one failure
+// extraction reports: a context that is already done.
+func TestCommentTableExtractTableCanceledContext(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err := jsonschema.NewGoCommentProvider().ExtractTable(ctx,
+		[]reflect.Type{reflect.TypeFor[alpha.Widget]()})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestCommentTableNil(t *testing.T) {
+	t.Parallel()
+
+	var table jsonschema.CommentTable
+
+	s, err := jsonschema.GenerateFor[alpha.Widget](t.Context(), jsonschema.WithDescriptionProvider(table))
+	require.NoError(t, err)
+	require.Contains(t, s.Properties, "size")
+	assert.Empty(t, s.Properties["size"].Description)
+	assert.Empty(t, s.Description)
+}
+
+func TestCommentTableGoSource(t *testing.T) {
+	t.Parallel()
+
+	table := jsonschema.CommentTable{
+		"example.com/app": {
+			"Config": {
+				Doc:    "Config is the application configuration.",
+				Fields: map[string]string{"Port": "Port is the listen port.", "Host": `Host is the "bind" host.`},
+			},
+			"Mode": {Doc: "Mode selects a mode."},
+		},
+	}
+
+	src, err := table.GoSource("app", "Comments")
+	require.NoError(t, err)
+
+	assert.Equal(t, `// Code generated by jsonschema.CommentTable.GoSource. DO NOT EDIT.
+
+package app
+
+import "go.jacobcolvin.com/x/jsonschema"
+
+// Comments holds Go doc comments extracted at build time; it is a
+// jsonschema.DescriptionProvider.
+var Comments = jsonschema.CommentTable{
+	"example.com/app": {
+		"Config": {
+			Doc: "Config is the application configuration.",
+			Fields: map[string]string{
+				"Host": "Host is the \"bind\" host.",
+				"Port": "Port is the listen port.",
+			},
+		},
+		"Mode": {
+			Doc: "Mode selects a mode.",
+		},
+	},
+}
+`, string(src))
+
+	tests := map[string]struct {
+		pkg, name string
+	}{
+		"invalid package":  {pkg: "my-app", name: "Comments"},
+		"invalid variable": {pkg: "app", name: "1Comments"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := table.GoSource(tc.pkg, tc.name)
+			require.ErrorIs(t, err, jsonschema.ErrCodeGen)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"go/ast"
 	"sync"
+	"sync/atomic"
 
 	"go.jacobcolvin.com/x/jsonschema/internal/goast"
 )
@@ -32,9 +33,13 @@ import (
 // equivalent results. The mutex still makes the provider safe for concurrent
 // use.
 type GoCommentProvider struct {
-	cache   map[string][]*ast.File
+	cache   map[string]*goast.Package
 	loadDir string
+	docs    docOptions
 	mu      sync.Mutex
+	// The markers field is set once [GoCommentProvider.Markers] is called, so
+	// the descriptions served omit the marker lines the interpreter reads.
+	markers atomic.Bool
 }
 
 // GoCommentProviderOption configures a [GoCommentProvider] at construction.
@@ -65,7 +70,7 @@ func WithLoadDir(dir string) GoCommentProviderOption {
 // unconditionally.
 func NewGoCommentProvider(opts ...GoCommentProviderOption) *GoCommentProvider {
 	p := &GoCommentProvider{
-		cache: map[string][]*ast.File{},
+		cache: map[string]*goast.Package{},
 	}
 
 	for _, opt := range opts {
@@ -77,7 +82,9 @@ func NewGoCommentProvider(opts ...GoCommentProviderOption) *GoCommentProvider {
 	return p
 }
 
-// TypeDescription returns the doc comment for a named type.
+// TypeDescription returns the doc comment for a named type, without its
+// "+name" marker lines (see [GoMarkerInterpreter]), rendered under the
+// provider's doc options ([WithDeprecation] and its siblings).
 //
 // Matching is by package path and unqualified type name, since reflection does
 // not expose source positions. A non-package-scope type (for example one
@@ -91,14 +98,14 @@ func (ce *GoCommentProvider) TypeDescription(ctx context.Context, tc TypeContext
 
 	name := goast.BaseTypeName(t.Name())
 
-	files, err := ce.sourceFiles(ctx, t.PkgPath())
-	if err != nil {
+	pkg, err := ce.sourcePackage(ctx, t.PkgPath())
+	if err != nil || pkg == nil {
 		return "", err
 	}
 
-	doc, _ := goast.TypeDoc(files, name)
+	doc, _ := goast.TypeDoc(pkg.Files, name)
 
-	return doc, nil
+	return ce.renderDoc(pkg, doc).description, nil
 }
 
 // FieldDescription returns the doc comment for a struct field, located via
@@ -114,23 +121,35 @@ func (ce *GoCommentProvider) FieldDescription(ctx context.Context, fc FieldConte
 		return "", nil
 	}
 
-	files, err := ce.sourceFiles(ctx, structType.PkgPath())
-	if err != nil {
+	pkg, err := ce.sourcePackage(ctx, structType.PkgPath())
+	if err != nil || pkg == nil {
 		return "", err
 	}
 
-	doc, _ := goast.StructFieldDocThroughAliases(files, structType.Name(), fieldName)
+	doc, _ := goast.StructFieldDocThroughAliases(pkg.Files, structType.Name(), fieldName)
 
-	return doc, nil
+	return ce.renderDoc(pkg, doc).description, nil
 }
 
-// sourceFiles returns parsed AST files for the package at the given import path.
-// It uses go/packages for source resolution, which handles module cache and
-// standard library packages. A successful load is cached per package path,
-// including a package that legitimately has no source files; a load cut short by
-// context cancellation or a transient load failure is not cached, so a later
-// call under a live context retries it instead of permanently serving nil.
+// sourceFiles returns parsed AST files for the package at the given import
+// path, or none when its sources cannot be loaded.
 func (ce *GoCommentProvider) sourceFiles(ctx context.Context, pkgPath string) ([]*ast.File, error) {
+	pkg, err := ce.sourcePackage(ctx, pkgPath)
+	if err != nil || pkg == nil {
+		return nil, err
+	}
+
+	return pkg.Files, nil
+}
+
+// sourcePackage returns the loaded package at the given import path, or nil
+// when its sources cannot be loaded. It uses go/packages for source
+// resolution, which handles module cache and standard library packages. A
+// successful load is cached per package path, including a package that
+// legitimately has no source files; a load cut short by context cancellation
+// or a transient load failure is not cached, so a later call under a live
+// context retries it instead of permanently serving nil.
+func (ce *GoCommentProvider) sourcePackage(ctx context.Context, pkgPath string) (*goast.Package, error) {
 	if pkgPath == "" {
 		return nil, nil
 	}
@@ -138,21 +157,21 @@ func (ce *GoCommentProvider) sourceFiles(ctx context.Context, pkgPath string) ([
 	// Fast path: serve a cached result under a short lock.
 	ce.mu.Lock()
 
-	files, ok := ce.cache[pkgPath]
+	pkg, ok := ce.cache[pkgPath]
 
 	ce.mu.Unlock()
 
 	if ok {
-		return files, nil
+		return pkg, nil
 	}
 
 	// Load outside the lock so distinct packages load in parallel and a cache
 	// hit never blocks behind an unrelated (possibly slow or hung) load. Two
 	// goroutines racing on the same uncached path may both load it; the result
 	// is equivalent and the second store overwrites the first.
-	files, loaded, err := goast.LoadPackageFiles(ctx, ce.loadDir, pkgPath)
+	pkg, loaded, err := goast.LoadPackage(ctx, ce.loadDir, pkgPath)
 	if err != nil {
-		return nil, err //nolint:wrapcheck // LoadPackageFiles already wraps the context error with "load package".
+		return nil, err //nolint:wrapcheck // LoadPackage already wraps the context error with "load package".
 	}
 
 	// Only cache a definitive result. A transient failure returns loaded=false
@@ -165,13 +184,13 @@ func (ce *GoCommentProvider) sourceFiles(ctx context.Context, pkgPath string) ([
 		// Lazily allocate so a zero-value &GoCommentProvider{} (the exported type
 		// with a usable empty literal) does not panic on the first store.
 		if ce.cache == nil {
-			ce.cache = map[string][]*ast.File{}
+			ce.cache = map[string]*goast.Package{}
 		}
 
-		ce.cache[pkgPath] = files
+		ce.cache[pkgPath] = pkg
 
 		ce.mu.Unlock()
 	}
 
-	return files, nil
+	return pkg, nil
 }
@@ -58,12 +58,15 @@ type draftRange = keywordmeta.DraftRange
 // order, but phase makes the one ordering guarantee that matters -- the
 // unevaluated* keywords must run after every other applicator so annotations
 // are fully merged -- a checked invariant (see the init in keyword_table.go)
-// rather than a convention an edit could silently break.
+// rather than a convention an edit could silently break. The phaseCustom rows
+// never appear in the static table: [validator.buildActiveRows] splices the
+// run's [WithKeyword] rows in between the assertion and unevaluated stages.
 type phase uint8
 
 const (
 	phaseRef phase = iota
 	phaseAssert
+	phaseCustom
 	phaseUnevaluated
 )
 
@@ -181,11 +184,24 @@ func (v *validator) gatePasses(e *keywordEntry) bool {
 // run-fixed state, this runs once at Compile; the per-node walk then iterates
 // [validator.activeRows] directly, skipping the gate checks entirely and never
 // visiting rows that a draft or disabled vocabulary rules out (e.g. the 2020-12
-// rows under Draft-07, or format/content when their assertion is off).
+// rows under Draft-07, or format/content when their assertion is off). The
+// run's active custom keyword rows are spliced in ahead of the first
+// phaseUnevaluated row, keeping the phase order the table init enforces.
 func (v *validator) buildActiveRows() {
-	rows := make([]*keywordEntry, 0, len(keywordTable))
+	v.customRows = v.buildCustomRows()
+
+	rows := make([]*keywordEntry, 0, len(keywordTable)+len(v.customRows))
+	spliced := false
 
 	for i := range keywordTable {
+		if !spliced && keywordTable[i].phase >= phaseCustom {
+			for j := range v.customRows {
+				rows = append(rows, &v.customRows[j])
+			}
+
+			spliced = true
+		}
+
 		if v.gatePasses(&keywordTable[i]) {
 			rows = append(rows, &keywordTable[i])
 		}
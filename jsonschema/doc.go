@@ -46,6 +46,11 @@
 //	gen := jsonschema.NewGenerator(opts...)
 //	schema, err := jsonschema.GenerateWith[MyType](ctx, gen)
 //
+// [GenerateFiles] writes a set of documents in place of one root with $defs:
+// one per Go package, or one per type under [SplitByType], each carrying an
+// $id built from the [FileLayout] template and referring to the others by
+// relative URI. A [FileResolver] over the output directory serves them back.
+//
 // # Errors
 //
 // Sentinel errors are defined for error matching with [errors.Is]:
@@ -63,6 +68,22 @@
 //   - [ErrInvalidDefaultsInstance]: returned when the [WithDefaultsFrom]
 //     instance does not match the generated root type or does not marshal to
 //     a JSON object.
+//   - [ErrInvalidGenericBase]: returned when the [WithGenericDefinitions]
+//     base is not an absolute URI without a fragment.
+//   - [ErrInvalidFileLayout]: returned by [GenerateFiles] when the
+//     [FileLayout] template is not an absolute URI with known placeholders,
+//     or gives two documents one $id or file path.
+//   - [ErrLimitExceeded]: matched by the [*LimitError] validation returns
+//     when it stops at a [WithMaxErrors], [WithMaxDepth], [WithMaxNodes], or
+//     [WithMaxSteps] limit.
+//   - [ErrInvalidUnion]: returned by [NewUnion] when its interface,
+//     discriminator property, or variants cannot form a discriminated union.
+//   - [ErrUnknownVariant]: returned by [Union.Unmarshal] when a document's
+//     discriminator is missing, not a string, or names no variant.
+//   - [ErrUnknownMarker]: returned by the [GoMarkerInterpreter] for a comment
+//     marker it does not understand.
+//   - [ErrInvalidFieldNaming]: returned when a [WithFieldNaming] dialect
+//     cannot map a struct to an object, such as two fields claiming one key.
 //
 // Errors are wrapped with context so callers see the full path
 // (e.g., "field \"data\": unsupported type").
@@ -77,6 +98,13 @@
 //     it overrides $schema draft detection (see Draft Support below).
 //   - [WithTagInterpreter] registers a [TagInterpreter] under the struct tag
 //     key it reads, for mapping struct tags to schema constraints.
+//   - [WithFieldInterpreter] registers a [FieldInterpreter], which constrains
+//     every field after the tag interpreters, whatever tags it carries.
+//   - [WithFieldNaming] selects the struct field naming dialect ([NamingYAML],
+//     [NamingMapstructure], [NamingJSONv2], or a [NamingFunc]) in place of
+//     encoding/json's. The
+//     returned [FieldNamingOption] also serves validation, where
+//     [Validator.ValidateValue] encodes Go values under the same dialect.
 //   - [WithDescriptionProvider] sets the [DescriptionProvider] used as the source of
 //     type and field descriptions; [NewGoCommentProvider] constructs the
 //     AST-backed provider that extracts Go doc comments.
@@ -125,6 +153,19 @@
 //     definitions, where a sibling title would be ignored; the title is set
 //     on the definitions entry instead, shared by every occurrence of the
 //     type.
+//   - [WithGenericDefinitions] emits one $dynamicRef template per generic
+//     struct type, with a thin definition per instantiation binding the type
+//     arguments through $dynamicAnchor, instead of expanding every
+//     instantiation. The template and instantiations are embedded resources
+//     identified relative to the option's base URI, which becomes the root's
+//     $id. A generic type whose instantiations do not reduce to one template,
+//     or reach one another, is expanded as before, as is every generic type
+//     under [Draft7].
+//   - [WithUnion] generates an interface type as a discriminated oneOf over
+//     the implementations a [Union] registers, each branch pinning the
+//     discriminator property with const, plus the OpenAPI discriminator
+//     mapping as an extension keyword. [Union.Unmarshal] decodes with the
+//     same registration.
 //
 // Across every entry point, an option given a nil interface or pointer value
 // restores the default behavior: [WithNamer] the built-in namer,
@@ -156,7 +197,10 @@
 //   - Arrays: [N]T produces a fixed-size array with minItems/maxItems = N.
 //   - Maps: map[K]V produces a nullable object with additionalProperties.
 //     K must be string, an integer type, or implement [encoding.TextMarshaler];
-//     other key types return [ErrUnsupportedMapKey].
+//     other key types return [ErrUnsupportedMapKey]. A key that is not a
+//     string kind adds propertyNames stating what its encoded name can be: an
+//     integer's digits, or a TextMarshaler's own schema when a type override,
+//     provider, extender, or built-in says more than that it is a string.
 //   - Interfaces: any interface type produces an unrestricted schema ({}).
 //     A nil interface marshals as null, so an interface whose schema an
 //     earlier resolution step intercepts (a registered override, or a
@@ -189,6 +233,13 @@
 // Types implementing [encoding.TextMarshaler] map to {"type": "string"},
 // checked before struct reflection.
 //
+// [WellKnownTypes] is an opt-in [TypeSchemaProvider] for more standard
+// library types: the [net/netip] address types and [net.IP] get formats or
+// patterns for their text, [net/mail.Address] an email format on its
+// Address field, [os.FileMode] its integer bits, and TextMarshaler wrappers
+// around [net/url.URL] and [time.Location] a uri-reference format and an
+// IANA zone name pattern.
+//
 // Unsupported types (func, chan, complex, [unsafe.Pointer]) return
 // [ErrUnsupportedType].
 //
@@ -611,14 +662,44 @@
 // expired Generate context is reported as an error. Package loading runs in the
 // process working directory unless [WithLoadDir] points it at another module's
 // directory. Any other implementation substitutes another source: comments
-// pre-extracted at build time for a binary that deploys without source files,
-// or fixed descriptions in tests. [ChainDescriptionProviders] composes
+// pre-extracted at build time for a binary that deploys without source files
+// (a [CommentTable]), or fixed descriptions in tests. [ChainDescriptionProviders] composes
 // providers, first non-empty description or first error wins, such as overrides
 // for specific types backed by AST extraction. Field lookups receive the
 // [FieldContext] tag interpreters get; for a field promoted from an embedded
 // struct, its Owner is the embedded type, where the field's doc comment lives.
 // The jsonschema struct tag description overrides a provider-supplied comment.
 //
+// [GoCommentProvider.Enums] returns a [GoEnumExtender], a [TypeSchemaExtender]
+// sharing the provider's package loading. It sets a named string or integer
+// type's enum from the typed constants its package declares for it, in their
+// encoding/json form, with each value's doc comment in an index-aligned
+// x-enum-descriptions extension. Standard library types, whose constants are
+// units and flags rather than enumerations, are left alone.
+//
+// [GoCommentProvider.Markers] returns a [GoMarkerInterpreter], reading the
+// kubebuilder-style "+name=value" markers in field and type comments through
+// the same [Constraints] facade tag interpreters use; register it with both
+// [WithFieldInterpreter] and [WithTypeSchemaExtender]. A marker it recognizes
+// but JSON Schema cannot state, such as a CEL XValidation rule, is recorded
+// for [GoMarkerInterpreter.Skipped]; one it does not understand is
+// [ErrUnknownMarker]. Once it is obtained, the provider's descriptions omit
+// marker lines.
+//
+// Comments are served verbatim unless [WithDeprecation], [WithDocTitles], or
+// [WithMarkdownDescriptions] is given to the provider. Those parse each
+// comment with [go/doc/comment] and render the description as text, without a
+// "Deprecated: " paragraph and with doc links reduced to their text;
+// [GoCommentProvider.Docs] returns the [GoDocInterpreter] setting the keywords
+// they derive: deprecated, title from the first sentence, and a
+// markdownDescription with doc links to pkg.go.dev (or [WithDocLinkBaseURL]).
+//
+// [GoCommentProvider.ExtractTable] collects, at build time, the comments of
+// every package the given types' schemas reach into a [CommentTable], and
+// [CommentTable.GoSource] renders it as a Go file to compile into a binary
+// deployed without sources. The table is a [DescriptionProvider] serving the
+// descriptions the provider would, with no package loading at run time.
+//
 // # Draft Support
 //
 // [Draft7] and [Draft2020] (the default) are supported. The draft affects the
@@ -703,13 +784,15 @@
 //   - [Validator.ValidateJSON] unmarshals raw JSON bytes with
 //     [encoding/json.Decoder] using UseNumber() to preserve integer vs
 //     number distinction, then validates.
-//   - [Validator.ValidateValue] marshals a Go value with encoding/json and
-//     validates its JSON form, closing the loop with generation: an instance
-//     of the very type a schema was generated for validates in one call,
-//     with json tags, omitempty and omitzero, and MarshalJSON
-//     implementations all applying exactly as a JSON consumer of the value
-//     would see them. A non-pointer value is marshaled through a pointer to
-//     a copy, so pointer-receiver MarshalJSON/MarshalText implementations
+//   - [Validator.ValidateValue] validates the JSON form of a Go value,
+//     closing the loop with generation: an instance of the very type a schema
+//     was generated for validates in one call, with json tags, ",string",
+//     omitempty and omitzero, and MarshalJSON/MarshalText implementations all
+//     applying exactly as a JSON consumer of the value would see them. The
+//     value is walked by reflection with encoding/json's rules (cached per
+//     type) instead of marshaled and decoded; only a marshaler method's
+//     output is parsed. A non-pointer value is walked through a pointer to a
+//     copy, so pointer-receiver MarshalJSON/MarshalText implementations
 //     (big.Int's, for example) apply as they would for &v, and a value
 //     instance validates identically to a pointer instance.
 //
@@ -750,7 +833,7 @@
 // return ordinary wrapped errors that do not unwrap to [*ValidationError];
 // these cover JSON decoding, an unaccepted instance type, an invalid schema
 // document ([ErrInvalidSchemaDocument]), Schema.Resolve errors,
-// [ErrInvalidType], and [ErrUnknownVocabulary].
+// [ErrInvalidType], [ErrUnknownVocabulary], and [ErrInvalidKeyword].
 //
 // Compile rejects a type keyword naming anything other than the seven JSON
 // Schema types ("null", "boolean", "string", "integer", "number", "object",
@@ -834,6 +917,13 @@
 //     [FormatValidator]) under the format name it checks, with
 //     [FormatValidatorFunc] adapting a bare function. The checker receives
 //     the validation run's context and the name each check runs under.
+//   - [WithKeyword] registers a custom keyword (a [CustomKeyword]) under the
+//     keyword name it reads and the URI of the vocabulary that owns it. Its
+//     compile step runs once per schema node carrying the keyword and
+//     returns the [KeywordEvaluator] the walk runs against each instance,
+//     through a [KeywordContext] exposing the instance, the paths, and the
+//     sibling annotations unevaluatedProperties and unevaluatedItems read.
+//     [CustomKeywordFunc] and [KeywordEvaluatorFunc] adapt bare functions.
 //   - [WithFormats] forces built-in format assertion on or off. By default
 //     format is asserted under Draft-07 and is annotation-only under Draft
 //     2020-12 unless the format-assertion vocabulary is active.
@@ -841,6 +931,9 @@
 //     (application/json) for string instances. Annotation-only by default.
 //     Base64 follows the draft's citation: RFC 4648 under 2020-12 (line
 //     breaks rejected), MIME base64 under Draft-07 (line breaks ignored).
+//   - [WithMarshaler] makes [Validator.ValidateValue] marshal values with the
+//     given function, such as encoding/json/v2's Marshal, and validate its
+//     output rather than the naming dialect's model of it.
 //   - [WithResolveOptions] passes [ResolveOptions] (an alias for the upstream
 //     options type, so no second import is needed) for structural
 //     pre-validation.
@@ -851,6 +944,15 @@
 //     schema's $schema URI to look up the metaschema whose $vocabulary map
 //     controls which keyword groups are active: a [SchemaMap] serves fixed
 //     metaschemas by exact $id, and [ChainResolvers] composes resolvers.
+//   - [WithFailFast] stops at the first definite failure, for callers that
+//     need only a verdict; anyOf stops at its first matching branch and oneOf
+//     at its second.
+//   - [WithMaxErrors], [WithMaxDepth], [WithMaxNodes], and [WithMaxSteps]
+//     bound the failures reported, the instance's nesting and size, and the
+//     schema-node evaluations of one run. A run stopped by one returns a
+//     [*LimitError] matching [ErrLimitExceeded], whose Partial field holds
+//     the failures found so far. Failures inside anyOf and oneOf branches, not,
+//     if, and contains item tests are provisional and never count.
 //
 // The draft is detected from the root schema's $schema field; a [WithDraft]
 // option overrides the detection. [Draft7] and [Draft2020] semantics are
@@ -885,6 +987,17 @@
 // resolve both, so source-mapping consumers need not re-parse the pointers
 // and guess. Hand-constructed errors return nil from both.
 //
+// A [Validator] compiled with [WithGoFieldPaths] also maps each error from
+// [Validator.ValidateValue] back to the Go value it walked:
+// [ValidationError.GoSegments] returns one [GoSegment] per instance segment,
+// carrying the [reflect.StructField], map key, or element index the member
+// came from, and [ValidationError.GoFieldPath] renders them as a Go
+// expression such as Config.Server.TLS.CertFile. Field resolution is
+// encoding/json's, so embedded and promoted fields map back through the
+// embedded fields that promote them; a location inside a MarshalJSON or
+// MarshalText method's output maps no further than the value whose method
+// produced it.
+//
 // The keyword names validation reports are exported as Keyword* constants
 // ([KeywordRequired], [KeywordRef], ...), so code branching on
 // [ValidationError.Keyword] needs no raw keyword strings.
@@ -1014,6 +1127,11 @@
 //  3. Default: a built-in standard vocabulary set, every group active except
 //     format-assertion, so format is annotation-only by default.
 //
+// A vocabulary URI named by a [WithKeyword] registration is one the run
+// recognizes, gated like a standard one: active when the override or the
+// metaschema lists it, inactive when a vocabulary set omits it, and active by
+// default when no set is supplied (and always under Draft 7).
+//
 // If a schema requires (marks true) a vocabulary URI that this implementation
 // does not recognize, [Validate] returns [ErrUnknownVocabulary]. The same error
 // is returned when a $vocabulary map marks the 2020-12 core vocabulary as
@@ -1181,4 +1299,35 @@
 // recursively, its refs resolving in the context of the document containing
 // the failing ref; a cycle introduced by the substitute is an ordinary
 // [ErrRefCycle].
+//
+// # Code Generation
+//
+// [GenerateGo] compiles resolved schemas into Go source for specialized
+// validators. Each [GoFunc] names a compiled [Validator] and yields a
+// func(instance any) error that validates like [Validator.Validate], with
+// the keyword logic unrolled into one function per schema node: $ref
+// targets, patterns, enum and const values, and constant messages are fixed
+// at generation time, so validation walks no schema. A [GoFunc] with a Type
+// also yields a typed entry point, Name+"Value", that converts a pointer to
+// that type into its JSON form field by field instead of marshaling and
+// decoding it, and validates like [Validator.ValidateValue]:
+//
+//	v, err := jsonschema.Compile(ctx, schema)
+//	src, err := jsonschema.GenerateGo(ctx, jsonschema.GoConfig{
+//		Package:    "config",
+//		ImportPath: "example.com/app/config",
+//		Funcs: []jsonschema.GoFunc{
+//			{Name: "ValidateConfig", Validator: v, Type: reflect.TypeFor[Config]()},
+//		},
+//	})
+//
+// The generated code reports the same [*ValidationError] tree as the
+// [Validator] it was generated from: the same instance and schema paths,
+// keywords, messages, and causes. It imports the genrt package, the small
+// runtime it shares with the validator. A schema whose validation the
+// generated code cannot reproduce (a $dynamicRef, a [WithKeyword] keyword,
+// a [WithFormatValidator] checker, or an unresolvable $ref), and a
+// [Validator] compiled with fail-fast or a limit, fails with [ErrCodeGen].
+// The jsonschemagen command's -go-validator flag writes a generated
+// validator beside the schema it generates.
 package jsonschema
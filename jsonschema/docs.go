@@ -0,0 +1,236 @@
+package jsonschema
+
+import (
+	"cmp"
+	"context"
+	"go/doc"
+	"go/doc/comment"
+	"slices"
+	"strings"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/goast"
+)
+
+// markdownDescriptionKeyword is the extension keyword carrying a Markdown
+// rendering of the description, as the VS Code JSON language service reads
+// it in place of description.
+const markdownDescriptionKeyword = "markdownDescription"
+
+// defaultDocLinkBaseURL is the site doc links point at in Markdown
+// descriptions unless [WithDocLinkBaseURL] sets another.
+const defaultDocLinkBaseURL = "https://pkg.go.dev"
+
+// deprecatedPrefix opens the paragraph Go tooling treats as a deprecation
+// notice.
+const deprecatedPrefix = "Deprecated: "
+
+// docOptions configures how a [GoCommentProvider] renders doc comments. The
+// zero value serves comments verbatim.
+type docOptions struct {
+	linkBase    string
+	deprecation bool
+	titles      bool
+	markdown    bool
+}
+
+// rendered reports whether comments are rendered through go/doc/comment
+// rather than served verbatim.
+func (o docOptions) rendered() bool {
+	return o.deprecation || o.titles || o.markdown
+}
+
+// WithDeprecation returns a [GoCommentProvider] option treating a
+// "Deprecated: " paragraph the way Go tooling does: it is removed from the
+// description, and the schema the provider's [GoDocInterpreter] sees it on is
+// marked deprecated.
+func WithDeprecation() GoCommentProviderOption {
+	return goCommentProviderOptionFunc(func(p *GoCommentProvider) { p.docs.deprecation = true })
+}
+
+// WithDocTitles returns a [GoCommentProvider] option making the first
+// sentence of a doc comment, as go doc's synopsis, the title its
+// [GoDocInterpreter] sets. The description keeps the whole comment.
+func WithDocTitles() GoCommentProviderOption {
+	return goCommentProviderOptionFunc(func(p *GoCommentProvider) { p.docs.titles = true })
+}
+
+// WithMarkdownDescriptions returns a [GoCommentProvider] option rendering
+// each doc comment as Markdown, the way pkg.go.dev formats it (headings,
+// lists, code blocks, and doc links as links), into the markdownDescription
+// keyword its [GoDocInterpreter] sets, which the VS Code JSON language
+// service shows in place of description.
+func WithMarkdownDescriptions() GoCommentProviderOption {
+	return goCommentProviderOptionFunc(func(p *GoCommentProvider) { p.docs.markdown = true })
+}
+
+// WithDocLinkBaseURL returns a [GoCommentProvider] option setting the site a
+// doc link ([pkg.Type], [Type.Method]) in a Markdown description points at,
+// as import path and fragment below base. The default is
+// https://pkg.go.dev.
+func WithDocLinkBaseURL(base string) GoCommentProviderOption {
+	return goCommentProviderOptionFunc(func(p *GoCommentProvider) { p.docs.linkBase = base })
+}
+
+// renderedDoc is a doc comment as a [GoCommentProvider] serves it.
+type renderedDoc struct {
+	description string
+	title       string
+	markdown    string
+	deprecated  bool
+}
+
+// renderDoc renders a doc comment of the package pkg (nil when its sources
+// did not load) under the provider's options. Marker lines are removed once
+// the provider's [GoMarkerInterpreter] is obtained. With none of the rendering
+// options set the rest is served verbatim; otherwise it is parsed with
+// go/doc/comment and printed as text with unwrapped paragraphs, doc links
+// reduced to their text.
+func (ce *GoCommentProvider) renderDoc(pkg *goast.Package, raw string) renderedDoc {
+	text := raw
+	if ce.markers.Load() {
+		text = stripMarkers(raw)
+	}
+
+	if text == "" || !ce.docs.rendered() {
+		return renderedDoc{description: text}
+	}
+
+	d := pkg.DocParser().Parse(text)
+
+	var out renderedDoc
+
+	if ce.docs.deprecation {
+		out.deprecated = removeDeprecated(d)
+	}
+
+	pr := &comment.Printer{
+		TextWidth: -1,
+		DocLinkURL: func(link *comment.DocLink) string {
+			l := *link
+			if l.ImportPath == "" && pkg != nil {
+				l.ImportPath = pkg.Path
+			}
+
+			return l.DefaultURL(cmp.Or(ce.docs.linkBase, defaultDocLinkBaseURL))
+		},
+	}
+
+	out.description = strings.TrimSpace(string(pr.Text(d)))
+
+	if ce.docs.titles {
+		out.title = new(doc.Package).Synopsis(out.description)
+	}
+
+	if ce.docs.markdown && out.description != "" {
+		out.markdown = strings.TrimSpace(string(pr.Markdown(d)))
+	}
+
+	return out
+}
+
+// removeDeprecated removes the "Deprecated: " paragraphs from d and reports
+// whether there were any.
+func removeDeprecated(d *comment.Doc) bool {
+	n := len(d.Content)
+
+	d.Content = slices.DeleteFunc(d.Content, func(b comment.Block) bool {
+		p, ok := b.(*comment.Paragraph)
+		if !ok || len(p.Text) == 0 {
+			return false
+		}
+
+		plain, ok := p.Text[0].(comment.Plain)
+
+		return ok && strings.HasPrefix(string(plain), deprecatedPrefix)
+	})
+
+	return len(d.Content) != n
+}
+
+// GoDocInterpreter sets the keywords a [GoCommentProvider]'s rendering
+// options derive from Go doc comments: deprecated for a "Deprecated: "
+// paragraph ([WithDeprecation]), title from the first sentence
+// ([WithDocTitles]), and markdownDescription ([WithMarkdownDescriptions]).
+// It is both a [FieldInterpreter] reading a field's doc comment and a
+// [TypeSchemaExtender] reading a named type's; register it with
+// [WithFieldInterpreter] and [WithTypeSchemaExtender], alongside the
+// provider itself as the [DescriptionProvider]. Obtain one from
+// [GoCommentProvider.Docs]: it loads packages through the provider, sharing
+// its package cache and its failure modes.
+//
+// The title and markdownDescription are set only while the schema's
+// description is still the comment's, so a description from a tag is not
+// shadowed by the Markdown of the comment it replaced, and a title already
+// set (by the jsonschema tag or a provider) is kept.
+type GoDocInterpreter struct {
+	comments *GoCommentProvider
+}
+
+// Docs returns a [GoDocInterpreter] loading packages through ce and
+// rendering comments under its options.
+func (ce *GoCommentProvider) Docs() *GoDocInterpreter {
+	return &GoDocInterpreter{comments: ce}
+}
+
+// InterpretField applies the field's doc comment.
+func (di *GoDocInterpreter) InterpretField(ctx context.Context, fc FieldContext) error {
+	owner := fc.Owner
+	if owner == nil || owner.Name() == "" || owner.PkgPath() == "" || !di.comments.docs.rendered() {
+		return nil
+	}
+
+	pkg, err := di.comments.sourcePackage(ctx, owner.PkgPath())
+	if err != nil || pkg == nil {
+		return err
+	}
+
+	doc, _ := goast.StructFieldDocThroughAliases(pkg.Files, owner.Name(), fc.StructField.Name)
+
+	applyDoc(fc.Canvas, di.comments.renderDoc(pkg, doc))
+
+	return nil
+}
+
+// ExtendSchemaForType applies a named type's doc comment to its schema.
+func (di *GoDocInterpreter) ExtendSchemaForType(ctx context.Context, tc TypeContext, ts *TypeSchema) error {
+	t := tc.Type
+	if t.Name() == "" || t.PkgPath() == "" || ts.Value == nil || !di.comments.docs.rendered() {
+		return nil
+	}
+
+	pkg, err := di.comments.sourcePackage(ctx, t.PkgPath())
+	if err != nil || pkg == nil {
+		return err
+	}
+
+	doc, _ := goast.TypeDoc(pkg.Files, goast.BaseTypeName(t.Name()))
+
+	applyDoc(ts.Value, di.comments.renderDoc(pkg, doc))
+
+	return nil
+}
+
+// applyDoc sets the keywords a rendered comment carries on s.
+func applyDoc(s *Schema, r renderedDoc) {
+	if r.deprecated {
+		s.Deprecated = true
+	}
+
+	// The title and Markdown restate the comment, so they follow it only
+	// while it is still the schema's description.
+	if s.Description != r.description {
+		return
+	}
+
+	if r.title != "" && s.Title == "" {
+		s.Title = r.title
+	}
+
+	if r.markdown != "" {
+		if s.Extra == nil {
+			s.Extra = map[string]any{}
+		}
+
+		s.Extra[markdownDescriptionKeyword] = r.markdown
+	}
+}
@@ -0,0 +1,153 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha"
+)
+
+const alphaDocs = "https://pkg.go.dev/go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha"
+
+func TestGoDocInterpreter(t *testing.T) {
+	t.Parallel()
+
+	comments := jsonschema.NewGoCommentProvider(
+		jsonschema.WithDeprecation(),
+		jsonschema.WithDocTitles(),
+		jsonschema.WithMarkdownDescriptions(),
+	)
+	docs := comments.Docs()
+
+	s, err := jsonschema.GenerateFor[alpha.Legacy](t.Context(),
+		jsonschema.WithDescriptionProvider(comments),
+		jsonschema.WithFieldInterpreter(docs),
+		jsonschema.WithTypeSchemaExtender(docs),
+	)
+	require.NoError(t, err)
+
+	assert.True(t, s.Deprecated)
+	assert.Equal(t, "Legacy is a documented type for the structured doc-comment tests.", s.Title)
+	assert.Equal(t, "Legacy is a documented type for the structured doc-comment tests. "+
+		"It links to Widget and beta.Knot, and lists:\n\n  - one\n  - two", s.Description)
+	assert.Equal(t, "Legacy is a documented type for the structured doc-comment tests. "+
+		"It links to [Widget]("+alphaDocs+"#Widget) and "+
+		"[beta.Knot](https://pkg.go.dev/go.jacobcolvin.com/x/jsonschema/internal/testtypes/beta#Knot), "+
+		"and lists:\n\n  - one\n  - two", s.Extra["markdownDescription"])
+
+	tests := map[string]string{
+		"mode": `{
+			"type": "string",
+			"title": "Mode selects the legacy mode.",
+			"description": "Mode selects the legacy mode.",
+			"markdownDescription": "Mode selects the legacy mode.",
+			"deprecated": true
+		}`,
+		"name": `{
+			"type": "string",
+			"description": "tag wins"
+		}`,
+	}
+
+	for name, want := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Contains(t, s.Properties, name)
+
+			got, err := json.Marshal(s.Properties[name])
+			require.NoError(t, err)
+			assert.JSONEq(t, want, string(got))
+		})
+	}
+}
+
+func TestGoDocInterpreterOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts            []jsonschema.GoCommentProviderOption
+		wantDescription string
+		wantMarkdown    any
+		wantTitle       string
+		wantDeprecated  bool
+	}{
+		"verbatim by default": {
+			wantDescription: "Mode selects the legacy mode.\n\nDeprecated: Mode is ignored.",
+		},
+		"deprecation only": {
+			opts:            []jsonschema.GoCommentProviderOption{jsonschema.WithDeprecation()},
+			wantDescription: "Mode selects the legacy mode.",
+			wantDeprecated:  true,
+		},
+		"titles keep the deprecation paragraph": {
+			opts:            []jsonschema.GoCommentProviderOption{jsonschema.WithDocTitles()},
+			wantDescription: "Mode selects the legacy mode.\n\nDeprecated: Mode is ignored.",
+			wantTitle:       "Mode selects the legacy mode.",
+		},
+		"markdown only": {
+			opts:            []jsonschema.GoCommentProviderOption{jsonschema.WithMarkdownDescriptions()},
+			wantDescription: "Mode selects the legacy mode.\n\nDeprecated: Mode is ignored.",
+			wantMarkdown:    "Mode selects the legacy mode.\n\nDeprecated: Mode is ignored.",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			comments := jsonschema.NewGoCommentProvider(tc.opts...)
+
+			s, err := jsonschema.GenerateFor[alpha.Legacy](t.Context(),
+				jsonschema.WithDescriptionProvider(comments),
+				jsonschema.WithFieldInterpreter(comments.Docs()),
+			)
+			require.NoError(t, err)
+			require.Contains(t, s.Properties, "mode")
+
+			mode := s.Properties["mode"]
+			assert.Equal(t, tc.wantDescription, mode.Description)
+			assert.Equal(t, tc.wantTitle, mode.Title)
+			assert.Equal(t, tc.wantDeprecated, mode.Deprecated)
+			assert.Equal(t, tc.wantMarkdown, mode.Extra["markdownDescription"])
+		})
+	}
+}
+
+func TestWithDocLinkBaseURL(t *testing.T) {
+	t.Parallel()
+
+	comments := jsonschema.NewGoCommentProvider(
+		jsonschema.WithMarkdownDescriptions(),
+		jsonschema.WithDocLinkBaseURL("https://docs.example.com/go"),
+	)
+
+	s, err := jsonschema.GenerateFor[alpha.Legacy](t.Context(),
+		jsonschema.WithDescriptionProvider(comments),
+		jsonschema.WithTypeSchemaExtender(comments.Docs()),
+	)
+	require.NoError(t, err)
+
+	assert.Contains(t, s.Extra["markdownDescription"],
+		"[Widget](https://docs.example.com/go/go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha#Widget)")
+}
+
+// TestCommentTableRendersDocs covers a table extracted from a provider with
+// doc options: it records the descriptions that provider serves.
+func TestCommentTableRendersDocs(t *testing.T) {
+	t.Parallel()
+
+	comments := jsonschema.NewGoCommentProvider(jsonschema.WithDeprecation())
+
+	table, err := comments.ExtractTable(t.Context(), []reflect.Type{reflect.TypeFor[alpha.Legacy]()})
+	require.NoError(t, err)
+
+	legacy := table["go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha"]["Legacy"]
+	assert.Equal(t, "Mode selects the legacy mode.", legacy.Fields["Mode"])
+	assert.NotContains(t, legacy.Doc, "Deprecated")
+}
@@ -0,0 +1,198 @@
+package jsonschema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"go/constant"
+	"reflect"
+	"strconv"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/goast"
+)
+
+// enumDescriptionsKeyword is the extension keyword carrying one description
+// per enum value, index-aligned with enum, as OpenAPI tooling reads it.
+const enumDescriptionsKeyword = "x-enum-descriptions"
+
+// GoEnumExtender is the AST-backed [TypeSchemaExtender] that turns a named
+// string or integer type's typed constants into its enum: for
+//
+//	type Phase string
+//
+//	const (
+//		// PhasePending is waiting to be scheduled.
+//		PhasePending Phase = "Pending"
+//		PhaseRunning Phase = "Running" // running on a node
+//	)
+//
+// the Phase schema gains enum ["Pending", "Running"] and, when any value is
+// documented, an x-enum-descriptions array holding each value's doc comment
+// (or its trailing line comment) at the same index, empty for an undocumented
+// value. Obtain one from [GoCommentProvider.Enums] and register it with
+// [WithTypeSchemaExtender]; it loads packages through the provider, sharing
+// its package cache and its failure modes: a type whose sources cannot be
+// located is left unchanged, and a canceled context aborts generation.
+//
+// Constants are found by type-checking the declaring package's sources, so
+// iota blocks, implicit repetition, and constant expressions take the values
+// the compiler gives them; a constant whose value depends on another package
+// is not resolved and is left out. Each value is listed in its JSON form,
+// found by marshaling it with encoding/json, so a type implementing
+// [encoding.TextMarshaler] lists its marshaled strings, and a constant that
+// fails to marshal is left out. Values marshaling identically (an alias
+// constant such as PhaseDefault = PhasePending) are listed once, with the
+// first one's doc comment.
+//
+// Not every constant block is an enumeration: bit flags combine and units
+// scale, so their constants do not bound the type's values. Types declared
+// in the standard library (time.Duration, os.FileMode) are therefore left
+// alone, as is a type whose schema already carries an enum or const. To
+// exclude a type of your own, wrap the extender in a [TypeSchemaExtenderFunc]
+// that skips it before delegating.
+type GoEnumExtender struct {
+	comments *GoCommentProvider
+}
+
+// Enums returns a [GoEnumExtender] loading packages through ce.
+func (ce *GoCommentProvider) Enums() *GoEnumExtender {
+	return &GoEnumExtender{comments: ce}
+}
+
+// ExtendSchemaForType sets the enum of a named string or integer type from its
+// typed constants, as described on [GoEnumExtender].
+func (e *GoEnumExtender) ExtendSchemaForType(ctx context.Context, tc TypeContext, ts *TypeSchema) error {
+	t := tc.Type
+	if t.Name() == "" || t.PkgPath() == "" || !isEnumKind(t.Kind()) {
+		return nil
+	}
+
+	s := ts.Value
+	if s == nil || s.Enum != nil || s.Const != nil {
+		return nil
+	}
+
+	pkg, err := e.comments.sourcePackage(ctx, t.PkgPath())
+	if err != nil || pkg == nil || pkg.Std {
+		return err
+	}
+
+	var (
+		values []any
+		docs   []string
+		seen   = map[string]bool{}
+	)
+
+	for _, c := range pkg.Constants(goast.BaseTypeName(t.Name())) {
+		raw, v, ok := enumValue(t, c.Value)
+		if !ok || seen[string(raw)] {
+			continue
+		}
+
+		seen[string(raw)] = true
+
+		values = append(values, v)
+		docs = append(docs, c.Doc)
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	s.Enum = values
+
+	for _, doc := range docs {
+		if doc != "" {
+			if s.Extra == nil {
+				s.Extra = map[string]any{}
+			}
+
+			s.Extra[enumDescriptionsKeyword] = docs
+
+			break
+		}
+	}
+
+	return nil
+}
+
+// isEnumKind reports whether a constant of kind k can populate an enum.
+func isEnumKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+// enumValue converts constant c to a value of t and returns its JSON encoding
+// and the decoded instance value, reporting false when c does not fit t or the
+// value fails to marshal. The value is marshaled through a pointer, so a
+// pointer-receiver MarshalText or MarshalJSON is honored too. A JSON number
+// decodes to an int64 or uint64 when it is integral, so a large integer keeps
+// its exact value.
+func enumValue(t reflect.Type, c constant.Value) ([]byte, any, bool) {
+	ptr := reflect.New(t)
+	v := ptr.Elem()
+
+	switch {
+	case t.Kind() == reflect.String && c.Kind() == constant.String:
+		v.SetString(constant.StringVal(c))
+	case v.CanInt() && c.Kind() == constant.Int:
+		i, exact := constant.Int64Val(c)
+		if !exact || v.OverflowInt(i) {
+			return nil, nil, false
+		}
+
+		v.SetInt(i)
+	case v.CanUint() && c.Kind() == constant.Int:
+		u, exact := constant.Uint64Val(c)
+		if !exact || v.OverflowUint(u) {
+			return nil, nil, false
+		}
+
+		v.SetUint(u)
+	default:
+		return nil, nil, false
+	}
+
+	raw, err := json.Marshal(ptr.Interface())
+	if err != nil {
+		return nil, nil, false
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var out any
+
+	err = dec.Decode(&out)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	if n, ok := out.(json.Number); ok {
+		return raw, enumNumber(n), true
+	}
+
+	return raw, out, true
+}
+
+// enumNumber converts a decoded JSON number to int64, uint64, or float64, the
+// narrowest that holds it exactly.
+func enumNumber(n json.Number) any {
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+
+	if u, err := strconv.ParseUint(n.String(), 10, 64); err == nil {
+		return u
+	}
+
+	f, _ := n.Float64() //nolint:errcheck // A number encoding/json produced always parses.
+
+	return f
+}
@@ -0,0 +1,78 @@
+package jsonschema_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha"
+)
+
+func TestGoEnumExtender(t *testing.T) {
+	t.Parallel()
+
+	s, err := jsonschema.GenerateFor[alpha.Palette](t.Context(),
+		jsonschema.WithTypeSchemaExtender(jsonschema.NewGoCommentProvider().Enums()),
+		jsonschema.WithDefinitions(false),
+	)
+	require.NoError(t, err)
+
+	tests := map[string]string{
+		"phase": `{
+			"type": "string",
+			"enum": ["Pending", "Running", "Done"],
+			"x-enum-descriptions": ["PhasePending documents the pending phase.", "documents the running phase", ""]
+		}`,
+		"priority": `{"type": "integer", "enum": [1, 3]}`,
+		"shade":    `{"type": "string", "enum": ["light", "dark"]}`,
+		"size":     `{"type": "integer"}`,
+	}
+
+	for name, want := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := json.Marshal(s.Properties[name])
+			require.NoError(t, err)
+			assert.JSONEq(t, want, string(got))
+		})
+	}
+
+	require.NoError(t, validateJSON(t.Context(), s, []byte(`{"phase": "Done", "priority": 3, "shade": "dark", "size": 7}`)))
+	require.Error(t, validateJSON(t.Context(), s, []byte(`{"phase": "Later", "priority": 3, "shade": "dark", "size": 7}`)))
+	require.Error(t, validateJSON(t.Context(), s, []byte(`{"phase": "Done", "priority": 2, "shade": "dark", "size": 7}`)))
+}
+
+func TestGoEnumExtender_LeavesAlone(t *testing.T) {
+	t.Parallel()
+
+	type durations struct {
+		Timeout time.Duration `json:"timeout"`
+	}
+
+	enums := jsonschema.NewGoCommentProvider().Enums()
+
+	s, err := jsonschema.GenerateFor[durations](t.Context(), jsonschema.WithTypeSchemaExtender(enums))
+	require.NoError(t, err)
+	assert.Nil(t, s.Properties["timeout"].Enum, "standard library constants are not an enumeration")
+
+	// A wrapping extender excludes a type of the caller's own.
+	skip := jsonschema.TypeSchemaExtenderFunc(func(ctx context.Context, tc jsonschema.TypeContext, ts *jsonschema.TypeSchema) error {
+		if tc.Type == reflect.TypeFor[alpha.Priority]() {
+			return nil
+		}
+
+		return enums.ExtendSchemaForType(ctx, tc, ts)
+	})
+
+	p, err := jsonschema.GenerateFor[alpha.Palette](t.Context(), jsonschema.WithTypeSchemaExtender(skip))
+	require.NoError(t, err)
+	assert.Nil(t, p.Properties["priority"].Enum)
+	assert.NotNil(t, p.Properties["phase"].Enum)
+}
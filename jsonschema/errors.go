@@ -2,8 +2,10 @@ package jsonschema
 
 import (
 	"errors"
+	"strconv"
 	"strings"
 
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonptr"
 	"go.jacobcolvin.com/x/jsonschema/internal/refresolve"
 )
 
@@ -83,6 +85,15 @@ var (
 	// it required (which the spec does not permit).
 	ErrUnknownVocabulary = errors.New("unknown required vocabulary")
 
+	// ErrInvalidKeyword is returned by [Compile] when a custom keyword
+	// registered with [WithKeyword] cannot apply: its name is one the [Schema]
+	// type models as a typed field (so it never reaches Extra, where custom
+	// keywords are read), or its [CustomKeyword.CompileKeyword] step rejected
+	// the value a schema node carries. A node first reached during a
+	// validation run reports the compile failure as a [*ValidationError] at
+	// the keyword instead, wrapping the same sentinel.
+	ErrInvalidKeyword = errors.New("invalid custom keyword")
+
 	// ErrNotResolved is returned by a [RefResolver] to report a URI it does
 	// not serve. The not-resolved answer passes the URI along to the next
 	// [ChainResolvers] link, and ultimately to the unresolvable-ref handling of
@@ -148,6 +159,53 @@ var (
 	// alias chain cycles back to itself (a self-Ref, or a mutual A -> B -> A
 	// chain, which no finite reference graph can satisfy).
 	ErrConflictingTypeSchema = errors.New("conflicting type schema")
+
+	// ErrInvalidGenericBase is returned by [Generate] when the base given to
+	// [WithGenericDefinitions] is not an absolute URI without a fragment, so
+	// the template and instantiation resources it identifies could not be
+	// resolved.
+	ErrInvalidGenericBase = errors.New("invalid generic definitions base")
+
+	// ErrInvalidFileLayout is returned by [GenerateFiles] when the
+	// [FileLayout] template is not an absolute URI, has no placeholder or an
+	// unknown one, or expands to an $id or file path that is unusable or
+	// shared by two documents.
+	ErrInvalidFileLayout = errors.New("invalid file layout")
+
+	// ErrCodeGen is returned by [GenerateGo] for a configuration it cannot
+	// generate from, or a schema whose validation the generated code cannot
+	// reproduce (a $dynamicRef, a custom keyword, a registered format checker,
+	// a validation limit, or a $ref that fails to resolve), and by
+	// [CommentTable.GoSource] for a package or variable name that is not a Go
+	// identifier.
+	ErrCodeGen = errors.New("cannot generate Go code")
+
+	// ErrLimitExceeded is matched by the [*LimitError] a validation run
+	// returns when it stops at a limit set by [WithMaxErrors],
+	// [WithMaxDepth], [WithMaxNodes], or [WithMaxSteps].
+	ErrLimitExceeded = errors.New("validation limit exceeded")
+
+	// ErrInvalidUnion is returned by [NewUnion] when its interface, its
+	// discriminator property, or one of its variants cannot form a
+	// discriminated union.
+	ErrInvalidUnion = errors.New("invalid union")
+
+	// ErrUnknownVariant is returned by [Union.Unmarshal] when a document's
+	// discriminator is missing, is not a string, or names no registered
+	// variant.
+	ErrUnknownVariant = errors.New("unknown union variant")
+
+	// ErrUnknownMarker is returned by the [GoMarkerInterpreter] for a
+	// "+name" comment marker it does not understand, or one placed where it
+	// does not apply.
+	ErrUnknownMarker = errors.New("unknown comment marker")
+
+	// ErrStaticUnsupported is returned by [GenerateStatic] for a type whose
+	// schema depends on running its code (a [JSONSchemaProvider] or
+	// [JSONSchemaExtender], or a MarshalJSON or MarshalText method), and for
+	// a generation option that consults a Go value. Such a type needs the
+	// reflection path ([Generate]).
+	ErrStaticUnsupported = errors.New("not supported by static generation")
 )
 
 // ValidationError represents a JSON Schema validation failure.
@@ -173,6 +231,12 @@ type ValidationError struct {
 	// see [ValidationError.SchemaSegments].
 	schemaSegs []Segment
 
+	// The Go origin of the instance location and the name of the walked
+	// value's type, attached by [Validator.ValidateValue] under
+	// [WithGoFieldPaths]; see [ValidationError.GoSegments].
+	goSegs []GoSegment
+	goRoot string
+
 	// InstancePath is the JSON Pointer path to the failing location in the
 	// input data (e.g., "/address/city").
 	InstancePath string
@@ -221,8 +285,8 @@ type Segment struct {
 // input data, one Segment per reference token of [ValidationError.InstancePath],
 // outermost first. Unlike re-parsing InstancePath, it distinguishes an array
 // index from an object key that happens to look numeric. It is populated for
-// errors produced by [Validate] and the [Validator] methods;
-// hand-constructed errors return nil.
+// errors produced by [Validate], the [Validator] methods, and
+// [NewValidationError]; hand-constructed errors return nil.
 func (e *ValidationError) InstanceSegments() []Segment {
 	return e.segments
 }
@@ -234,12 +298,87 @@ func (e *ValidationError) InstanceSegments() []Segment {
 // [Location.Segments]. Unlike re-parsing SchemaPath, it carries member keys
 // verbatim (no ~0/~1 escaping to undo) and distinguishes a list index (an allOf
 // branch) from a property named like a number. It is populated for errors
-// produced by [Validate] and the [Validator] methods; hand-constructed errors
-// return nil.
+// produced by [Validate], the [Validator] methods, and [NewValidationError];
+// hand-constructed errors return nil.
 func (e *ValidationError) SchemaSegments() []Segment {
 	return e.schemaSegs
 }
 
+// GoSegments returns the Go origin of the failing location, one [GoSegment]
+// per segment of [ValidationError.InstanceSegments], outermost first. It is
+// populated only for errors returned by [Validator.ValidateValue] on a
+// [Validator] compiled with [WithGoFieldPaths], and nil otherwise. A
+// location inside the output of a MarshalJSON or MarshalText method has no
+// Go origin finer than the value whose method produced it, so the result
+// stops there and is shorter than InstanceSegments.
+func (e *ValidationError) GoSegments() []GoSegment {
+	return e.goSegs
+}
+
+// GoFieldPath renders [ValidationError.GoSegments] as a Go expression rooted
+// at the walked value's type name, such as Config.Server.TLS.CertFile or
+// Config.Listeners[0].Labels["env"]. A promoted field is spelled through the
+// embedded fields it is reached by. It returns "" when GoSegments is nil.
+func (e *ValidationError) GoFieldPath() string {
+	if e.goSegs == nil {
+		return ""
+	}
+
+	var b strings.Builder
+
+	b.WriteString(e.goRoot)
+
+	for _, seg := range e.goSegs {
+		b.WriteString(seg.String())
+	}
+
+	return b.String()
+}
+
+// NewValidationError returns a ValidationError at the given typed instance
+// and schema locations, rendering [ValidationError.InstancePath] and
+// [ValidationError.SchemaPath] from them so the two forms of each path cannot
+// disagree. A nil location is the root. It is the constructor for validators
+// that run outside the [Validator] walk, such as the code [GenerateGo] emits,
+// so their errors carry the segments hand-constructed errors lack. A non-nil
+// err is attached for [errors.Is] and [errors.As], as the walk attaches a
+// format checker's failure.
+func NewValidationError(
+	instance, schema []Segment,
+	keyword, msg string,
+	err error,
+	causes []*ValidationError,
+) *ValidationError {
+	return &ValidationError{
+		err:          err,
+		segments:     instance,
+		schemaSegs:   schema,
+		InstancePath: segmentsPointer(instance),
+		SchemaPath:   segmentsPointer(schema),
+		Keyword:      keyword,
+		Message:      msg,
+		Causes:       causes,
+	}
+}
+
+// segmentsPointer renders segs as an RFC 6901 JSON Pointer, escaping member
+// keys the way the walk's locations do.
+func segmentsPointer(segs []Segment) string {
+	var b strings.Builder
+
+	for _, seg := range segs {
+		b.WriteByte('/')
+
+		if seg.IsIndex {
+			b.WriteString(strconv.Itoa(seg.Index))
+		} else {
+			b.WriteString(jsonptr.Escape(seg.Key))
+		}
+	}
+
+	return b.String()
+}
+
 // Error returns a multi-line string representation. The top-level message is
 // on the first line; each Causes entry is indented and rendered recursively.
 // For a single-error case the output is one line.
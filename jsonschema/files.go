@@ -0,0 +1,404 @@
+package jsonschema
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonptr"
+	"go.jacobcolvin.com/x/jsonschema/internal/numkind"
+	"go.jacobcolvin.com/x/jsonschema/internal/schemafield"
+	"go.jacobcolvin.com/x/jsonschema/internal/uriref"
+)
+
+// FileSplit selects how [GenerateFiles] distributes definitions across
+// documents.
+type FileSplit uint8
+
+const (
+	// SplitByPackage writes one document per Go package, holding the
+	// package's definitions under $defs.
+	SplitByPackage FileSplit = iota
+	// SplitByType writes one document per definition, whose top-level schema
+	// is the type's.
+	SplitByType
+)
+
+// FileLayout configures [GenerateFiles].
+type FileLayout struct {
+	// IDTemplate builds each document's $id. It is an absolute URI carrying
+	// the placeholders {name}, the document's name, and {package}, the import
+	// path of its Go package, as in
+	// "https://example.com/schemas/{name}.json". Under [SplitByPackage] a
+	// document's name is its package's base name (the full import path when
+	// two packages share one); under [SplitByType] it is the definition's
+	// name, from the [Namer]. A document's file path is its $id relative to
+	// the template's directory: the template up to the last slash before its
+	// first placeholder.
+	IDTemplate string
+	// Split selects one document per package or per type.
+	Split FileSplit
+}
+
+// SchemaFiles is the set of documents [GenerateFiles] produces, keyed by
+// slash-separated file path relative to the output directory.
+type SchemaFiles struct {
+	Files map[string]*Schema
+	// Root is the path of the document whose top-level schema is the
+	// generated type's.
+	Root string
+}
+
+// GenerateFiles is [Generate] writing a set of documents rather than one
+// root with $defs: each definition lives in the document of its Go package,
+// or in a document of its own under [SplitByType], and references between
+// documents are relative to the $id each carries, built from
+// layout.IDTemplate. The layout on disk mirrors the $ids, so [Inline] and
+// validation serve the documents with a [FileResolver] over the output
+// directory, wrapped in [StripPrefix] when the $ids are remote:
+//
+//	files, err := jsonschema.GenerateFiles(ctx, t, jsonschema.FileLayout{
+//		IDTemplate: "https://example.com/schemas/{name}.json",
+//	})
+//
+// The root document's top-level schema is the type's: the document of the
+// root type's package, or of the root type itself under [SplitByType]. A
+// root that is not a definition of its own under [SplitByType], and an
+// unnamed root under [SplitByPackage], gets a document named for the type,
+// or "root". [WithGenericDefinitions] does not apply; every instantiation
+// is expanded. An unusable template, or one that gives two documents the
+// same $id, is [ErrInvalidFileLayout]. A "file:///{name}.json" template
+// keeps the documents local: a FileResolver serves its $ids as they are.
+func GenerateFiles(ctx context.Context, t reflect.Type, layout FileLayout, opts ...GenerateOption) (*SchemaFiles, error) {
+	return NewGenerator(opts...).GenerateFiles(ctx, t, layout)
+}
+
+// GenerateFiles is [GenerateFiles] under the Generator's options.
+func (gn *Generator) GenerateFiles(ctx context.Context, t reflect.Type, layout FileLayout) (*SchemaFiles, error) {
+	return gn.proto.forRun(ctx).generateFiles(t, layout)
+}
+
+// idPlaceholder matches one placeholder of an [FileLayout.IDTemplate].
+var idPlaceholder = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// fileDoc is one document of a multi-file generation.
+type fileDoc struct {
+	// Body is the document's top-level schema; nil for a package document
+	// holding only definitions.
+	body *Schema
+	defs map[string]*Schema
+	pkg  string
+	name string
+	id   string
+	path string
+}
+
+// generateFiles produces the documents of a multi-file generation: it
+// renders the root and its definitions as [generator.generate] does, places
+// each definition in a document, and rewrites every reference into one
+// relative to the referring document's $id.
+func (g *generator) generateFiles(t reflect.Type, layout FileLayout) (*SchemaFiles, error) {
+	err := checkIDTemplate(layout.IDTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	byType := layout.Split == SplitByType
+
+	// A root document holds the root type's body at its top level, so the
+	// root is inlined as in a single document; under SplitByType the root's
+	// own document is that top level already.
+	schema, root, reached, err := g.renderRoot(t, !byType)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []*fileDoc
+
+	docOf := make(map[*defEntry]*fileDoc, len(reached))
+	byPkg := map[string]*fileDoc{}
+
+	pkgDoc := func(pkg string) *fileDoc {
+		d, ok := byPkg[pkg]
+		if !ok {
+			d = &fileDoc{pkg: pkg, defs: map[string]*Schema{}}
+			byPkg[pkg] = d
+			docs = append(docs, d)
+		}
+
+		return d
+	}
+
+	for _, e := range reached {
+		if byType {
+			d := &fileDoc{pkg: e.typ.PkgPath(), name: e.name, body: e.rendered}
+			docs = append(docs, d)
+			docOf[e] = d
+
+			continue
+		}
+
+		d := pkgDoc(e.typ.PkgPath())
+		d.defs[e.name] = e.rendered
+		docOf[e] = d
+	}
+
+	rootType := numkind.DerefType(t)
+
+	var rootDoc *fileDoc
+
+	switch {
+	case byType && root.kind == kindRef && !schemafield.HasSiblingsBesides(schema, "Ref"):
+		rootDoc = docOf[root.def]
+	case !byType && rootType.PkgPath() != "":
+		rootDoc = pkgDoc(rootType.PkgPath())
+		rootDoc.body = schema
+	default:
+		rootDoc = &fileDoc{pkg: rootType.PkgPath(), body: schema}
+		docs = append(docs, rootDoc)
+	}
+
+	g.nameFileDocs(docs, rootDoc, rootType, byType)
+
+	files := &SchemaFiles{Files: make(map[string]*Schema, len(docs))}
+	byID := make(map[string]*fileDoc, len(docs))
+
+	for _, d := range docs {
+		err := d.place(layout.IDTemplate)
+		if err != nil {
+			return nil, err
+		}
+
+		if other, ok := byID[d.id]; ok {
+			return nil, fmt.Errorf("%w: documents %q and %q share the $id %q",
+				ErrInvalidFileLayout, other.name, d.name, d.id)
+		}
+
+		byID[d.id] = d
+	}
+
+	targets := g.payloadRefTargets()
+
+	for _, d := range docs {
+		s, err := g.fileDocSchema(d, docOf, targets, byType)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := files.Files[d.path]; ok {
+			return nil, fmt.Errorf("%w: two documents share the path %q", ErrInvalidFileLayout, d.path)
+		}
+
+		files.Files[d.path] = s
+	}
+
+	files.Root = rootDoc.path
+
+	return files, nil
+}
+
+// nameFileDocs names every document. Under [SplitByType] a definition's
+// document already carries the definition's name; a package document takes
+// the package's base name, escalating to the sanitized import path when two
+// packages share one. A document named for neither, the root's own, takes
+// the root type's name, or "root" for an unnamed root. Every name is unique.
+func (g *generator) nameFileDocs(docs []*fileDoc, rootDoc *fileDoc, rootType reflect.Type, byType bool) {
+	used := make(map[string]bool, len(docs))
+
+	if byType {
+		for _, d := range docs {
+			if d != rootDoc || d.name != "" {
+				used[d.name] = true
+			}
+		}
+	} else {
+		bases := map[string]int{}
+		for _, d := range docs {
+			if d.pkg != "" {
+				bases[jsonptr.SafeToken(path.Base(d.pkg))]++
+			}
+		}
+
+		// Docs holds every package document in build order; sort a copy by
+		// import path so escalation and suffixing are deterministic.
+		pkgDocs := make([]*fileDoc, 0, len(docs))
+		for _, d := range docs {
+			if d.pkg != "" {
+				pkgDocs = append(pkgDocs, d)
+			}
+		}
+
+		sort.Slice(pkgDocs, func(i, j int) bool { return pkgDocs[i].pkg < pkgDocs[j].pkg })
+
+		for _, d := range pkgDocs {
+			name := jsonptr.SafeToken(path.Base(d.pkg))
+			if bases[name] > 1 {
+				name = jsonptr.SafeToken(d.pkg)
+			}
+
+			d.name = uniqueName(name, used)
+			used[d.name] = true
+		}
+	}
+
+	if rootDoc.name == "" {
+		name := "root"
+		if rootType.Name() != "" {
+			name = g.schemaName(rootType)
+		}
+
+		rootDoc.name = uniqueName(name, used)
+	}
+}
+
+// place expands tmpl for the document, setting its $id and its file path.
+func (d *fileDoc) place(tmpl string) error {
+	d.id = expandIDTemplate(tmpl, d, true)
+
+	u, err := url.Parse(d.id)
+	if err != nil || u.Fragment != "" {
+		return fmt.Errorf("%w: document %q has the $id %q", ErrInvalidFileLayout, d.name, d.id)
+	}
+
+	raw := expandIDTemplate(tmpl, d, false)
+	d.path = raw[len(idTemplateDir(tmpl)):]
+
+	if !fs.ValidPath(d.path) {
+		return fmt.Errorf("%w: document %q has the file path %q", ErrInvalidFileLayout, d.name, d.path)
+	}
+
+	return nil
+}
+
+// fileDocSchema returns the document d as a schema of its own: a deep copy
+// of its body and definitions carrying $schema and $id, with each reference
+// to a definition rewritten for the document it lands in. Under [SplitByType]
+// a definition is its document's top level, so a reference to it is the
+// document's relative URI, or "#" from within; otherwise it keeps its $defs
+// fragment, behind the relative URI of another package's document.
+func (g *generator) fileDocSchema(
+	d *fileDoc,
+	docOf map[*defEntry]*fileDoc,
+	targets map[string]*defEntry,
+	byType bool,
+) (*Schema, error) {
+	doc := &Schema{}
+	if d.body != nil {
+		c := *d.body
+		doc = &c
+	}
+
+	if len(d.defs) > 0 {
+		if g.profile.definitionsKeyword {
+			doc.Definitions = d.defs
+		} else {
+			doc.Defs = d.defs
+		}
+	}
+
+	doc, err := cloneSchema(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Draft-07 readers ignore every keyword beside $ref, $id included, so a
+	// top-level $ref moves into allOf to keep the document's base URI.
+	if !g.profile.honorRefSiblings && doc.Ref != "" {
+		doc.AllOf = append(doc.AllOf, &Schema{Ref: doc.Ref})
+		doc.Ref = ""
+	}
+
+	doc.Schema = g.draft.schemaURI()
+	doc.ID = d.id
+
+	var rewrite func(s *Schema)
+
+	rewrite = func(s *Schema) {
+		if e, ok := targets[s.Ref]; ok && docOf[e] != nil {
+			to := docOf[e]
+
+			frag := g.profile.refPrefix() + e.name
+			if byType {
+				frag = "#"
+			}
+
+			switch {
+			case to == d:
+				s.Ref = frag
+			case byType:
+				s.Ref = uriref.Relative(d.id, to.id)
+			default:
+				s.Ref = uriref.Relative(d.id, to.id) + frag
+			}
+		}
+
+		for _, c := range schemafield.Children(s) {
+			rewrite(c)
+		}
+	}
+
+	rewrite(doc)
+
+	return doc, nil
+}
+
+// checkIDTemplate reports an [FileLayout.IDTemplate] that is not an
+// absolute URI, that has no placeholder, or that has one other than {name}
+// and {package}. A relative $id would leave a document's references nothing
+// to resolve against.
+func checkIDTemplate(tmpl string) error {
+	matches := idPlaceholder.FindAllStringSubmatch(tmpl, -1)
+	if len(matches) == 0 {
+		return fmt.Errorf("%w: template %q has no {name} or {package} placeholder", ErrInvalidFileLayout, tmpl)
+	}
+
+	for _, m := range matches {
+		if m[1] != "name" && m[1] != "package" {
+			return fmt.Errorf("%w: template %q has the unknown placeholder %q", ErrInvalidFileLayout, tmpl, m[0])
+		}
+	}
+
+	u, err := url.Parse(idPlaceholder.ReplaceAllString(tmpl, "x"))
+	if err != nil || !u.IsAbs() {
+		return fmt.Errorf("%w: template %q is not an absolute URI", ErrInvalidFileLayout, tmpl)
+	}
+
+	return nil
+}
+
+// idTemplateDir returns the directory of an [FileLayout.IDTemplate]: the
+// template up to the last slash before its first placeholder.
+func idTemplateDir(tmpl string) string {
+	first := idPlaceholder.FindStringIndex(tmpl)[0]
+
+	return tmpl[:strings.LastIndex(tmpl[:first], "/")+1]
+}
+
+// expandIDTemplate fills in the placeholders of tmpl for d, path-escaping
+// each value for a URI when escape is set.
+func expandIDTemplate(tmpl string, d *fileDoc, escape bool) string {
+	return idPlaceholder.ReplaceAllStringFunc(tmpl, func(m string) string {
+		// A document of no package, an unnamed root's, stands in its name.
+		value := d.name
+		if m == "{package}" && d.pkg != "" {
+			value = d.pkg
+		}
+
+		if !escape {
+			return value
+		}
+
+		segs := strings.Split(value, "/")
+		for i, seg := range segs {
+			segs[i] = url.PathEscape(seg)
+		}
+
+		return strings.Join(segs, "/")
+	})
+}
@@ -0,0 +1,272 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha"
+	"go.jacobcolvin.com/x/jsonschema/internal/testtypes/beta"
+)
+
+type filesOrder struct {
+	ID    string        `json:"id"`
+	Item  beta.Widget   `json:"item"`
+	Other *alpha.Widget `json:"other"`
+	Lines []filesLine   `json:"lines"`
+}
+
+type filesLine struct {
+	Knot beta.Knot `json:"knot"`
+	Qty  int       `json:"qty"`
+}
+
+type filesTree struct {
+	Knot     beta.Knot   `json:"knot"`
+	Children []filesTree `json:"children"`
+}
+
+// filesFS serves the generated documents as their marshaled JSON.
+func filesFS(t *testing.T, files *jsonschema.SchemaFiles) fstest.MapFS {
+	t.Helper()
+
+	fsys := fstest.MapFS{}
+
+	for name, s := range files.Files {
+		data, err := json.Marshal(s)
+		require.NoError(t, err)
+
+		fsys[name] = &fstest.MapFile{Data: data}
+	}
+
+	return fsys
+}
+
+// collectRefs returns every $ref in s.
+func collectRefs(s *jsonschema.Schema) []string {
+	var refs []string
+
+	for _, sub := range jsonschema.Schemas(s) {
+		if sub.Ref != "" {
+			refs = append(refs, sub.Ref)
+		}
+	}
+
+	return refs
+}
+
+func TestGenerateFiles(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		layout jsonschema.FileLayout
+		typ    reflect.Type
+		opts   []jsonschema.GenerateOption
+		root   string
+		refs   map[string][]string
+	}{
+		"by package": {
+			layout: jsonschema.FileLayout{IDTemplate: "https://example.com/schemas/{name}.json"},
+			typ:    reflect.TypeFor[filesOrder](),
+			root:   "jsonschema_test.json",
+			refs: map[string][]string{
+				"jsonschema_test.json": {"beta.json#/$defs/beta_Widget", "alpha.json#/$defs/alpha_Widget", "#/$defs/filesLine", "beta.json#/$defs/Knot"},
+				"alpha.json":           nil,
+				"beta.json":            nil,
+			},
+		},
+		"by type": {
+			layout: jsonschema.FileLayout{IDTemplate: "https://example.com/schemas/{name}.json", Split: jsonschema.SplitByType},
+			typ:    reflect.TypeFor[filesOrder](),
+			root:   "filesOrder.json",
+			refs: map[string][]string{
+				"filesOrder.json":   {"beta_Widget.json", "alpha_Widget.json", "filesLine.json"},
+				"filesLine.json":    {"Knot.json"},
+				"alpha_Widget.json": nil,
+				"beta_Widget.json":  nil,
+				"Knot.json":         nil,
+			},
+		},
+		"package directories": {
+			layout: jsonschema.FileLayout{IDTemplate: "https://example.com/{package}/schema.json"},
+			typ:    reflect.TypeFor[filesLine](),
+			root:   "go.jacobcolvin.com/x/jsonschema_test/schema.json",
+			refs: map[string][]string{
+				"go.jacobcolvin.com/x/jsonschema_test/schema.json":                    {"../jsonschema/internal/testtypes/beta/schema.json#/$defs/Knot"},
+				"go.jacobcolvin.com/x/jsonschema/internal/testtypes/beta/schema.json": nil,
+			},
+		},
+		"recursive root by package": {
+			layout: jsonschema.FileLayout{IDTemplate: "https://example.com/schemas/{name}.json"},
+			typ:    reflect.TypeFor[filesTree](),
+			root:   "jsonschema_test.json",
+			refs: map[string][]string{
+				"jsonschema_test.json": {"#/$defs/filesTree", "beta.json#/$defs/Knot", "#/$defs/filesTree"},
+				"beta.json":            nil,
+			},
+		},
+		"recursive root by type": {
+			layout: jsonschema.FileLayout{IDTemplate: "https://example.com/schemas/{name}.json", Split: jsonschema.SplitByType},
+			typ:    reflect.TypeFor[filesTree](),
+			root:   "filesTree.json",
+			refs: map[string][]string{
+				"filesTree.json": {"Knot.json", "#"},
+				"Knot.json":      nil,
+			},
+		},
+		"unnamed root by type": {
+			layout: jsonschema.FileLayout{IDTemplate: "https://example.com/schemas/{name}.json", Split: jsonschema.SplitByType},
+			typ:    reflect.TypeFor[[]filesLine](),
+			root:   "root.json",
+			refs: map[string][]string{
+				"root.json":      {"filesLine.json"},
+				"filesLine.json": {"Knot.json"},
+				"Knot.json":      nil,
+			},
+		},
+		"draft 7": {
+			layout: jsonschema.FileLayout{IDTemplate: "https://example.com/schemas/{name}.json"},
+			typ:    reflect.TypeFor[filesTree](),
+			opts:   []jsonschema.GenerateOption{jsonschema.WithDraft(jsonschema.Draft7)},
+			root:   "jsonschema_test.json",
+			refs: map[string][]string{
+				"jsonschema_test.json": {"#/definitions/filesTree", "beta.json#/definitions/Knot", "#/definitions/filesTree"},
+				"beta.json":            nil,
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := jsonschema.GenerateFiles(t.Context(), tc.typ, tc.layout, tc.opts...)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.root, files.Root)
+			assert.ElementsMatch(t, slices.Collect(maps.Keys(tc.refs)), slices.Collect(maps.Keys(files.Files)))
+
+			for path, want := range tc.refs {
+				s := files.Files[path]
+				require.NotNil(t, s, path)
+
+				prefix := strings.TrimSuffix(tc.layout.IDTemplate, "{name}.json")
+				prefix = strings.TrimSuffix(prefix, "{package}/schema.json")
+				assert.Equal(t, prefix+path, s.ID)
+				assert.NotEmpty(t, s.Schema)
+				assert.ElementsMatch(t, want, collectRefs(s), path)
+			}
+		})
+	}
+}
+
+// TestGenerateFiles_RoundTrip reads the documents back through a
+// FileResolver: inlining the root document yields the single-document
+// schema's inlined form, and validation follows the cross-document refs.
+func TestGenerateFiles_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	single, err := jsonschema.GenerateFor[filesOrder](t.Context())
+	require.NoError(t, err)
+
+	want, err := jsonschema.Inline(t.Context(), single)
+	require.NoError(t, err)
+
+	// Inlining leaves the now-unreferenced $defs in place.
+	want.Schema, want.Defs = "", nil
+
+	wantJSON, err := json.Marshal(want)
+	require.NoError(t, err)
+
+	tcs := map[string]jsonschema.FileLayout{
+		"by package":          {IDTemplate: "https://example.com/schemas/{name}.json"},
+		"by type":             {IDTemplate: "https://example.com/schemas/{name}.json", Split: jsonschema.SplitByType},
+		"package directories": {IDTemplate: "https://example.com/schemas/{package}/{name}.json", Split: jsonschema.SplitByType},
+		"local":               {IDTemplate: "file:///{name}.json"},
+	}
+
+	for name, layout := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := jsonschema.GenerateFiles(t.Context(), reflect.TypeFor[filesOrder](), layout)
+			require.NoError(t, err)
+
+			resolver := jsonschema.WithRefResolver(jsonschema.StripPrefix("https://example.com/schemas/",
+				jsonschema.NewFileResolver(filesFS(t, files))))
+
+			root := files.Files[files.Root]
+
+			got, err := jsonschema.Inline(t.Context(), root, resolver)
+			require.NoError(t, err)
+
+			got.Schema, got.ID, got.Defs = "", "", nil
+
+			gotJSON, err := json.Marshal(got)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(wantJSON), string(gotJSON))
+
+			v, err := jsonschema.Compile(t.Context(), root, resolver)
+			require.NoError(t, err)
+
+			valid := filesOrder{
+				ID:    "o",
+				Item:  beta.Widget{Color: "red"},
+				Lines: []filesLine{{Knot: beta.Knot{N: 1}, Qty: 2}},
+			}
+			require.NoError(t, v.ValidateValue(t.Context(), valid))
+			require.Error(t, v.Validate(t.Context(), map[string]any{
+				"id": "o", "item": map[string]any{"color": "red"}, "other": nil,
+				"lines": []any{map[string]any{"knot": map[string]any{"n": "one"}, "qty": 2}},
+			}))
+		})
+	}
+}
+
+func TestGenerateFiles_Errors(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		layout jsonschema.FileLayout
+		want   string
+	}{
+		"no placeholder": {
+			layout: jsonschema.FileLayout{IDTemplate: "https://example.com/schema.json"},
+			want:   "no {name} or {package} placeholder",
+		},
+		"unknown placeholder": {
+			layout: jsonschema.FileLayout{IDTemplate: "https://example.com/{type}.json"},
+			want:   `unknown placeholder "{type}"`,
+		},
+		"relative": {
+			layout: jsonschema.FileLayout{IDTemplate: "schemas/{name}.json"},
+			want:   "not an absolute URI",
+		},
+		"shared id": {
+			layout: jsonschema.FileLayout{IDTemplate: "https://example.com/{package}.json", Split: jsonschema.SplitByType},
+			want:   "share the $id",
+		},
+		"fragment": {
+			layout: jsonschema.FileLayout{IDTemplate: "https://example.com/schemas.json#{name}"},
+			want:   "has the $id",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := jsonschema.GenerateFiles(t.Context(), reflect.TypeFor[filesOrder](), tc.layout)
+			require.ErrorIs(t, err, jsonschema.ErrInvalidFileLayout)
+			assert.ErrorContains(t, err, tc.want)
+		})
+	}
+}
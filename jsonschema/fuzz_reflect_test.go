@@ -5,6 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"math/big"
+	"net"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"os"
 	"reflect"
 	"strconv"
 	"testing"
@@ -311,6 +316,81 @@ type deepEmbedChain struct {
 	Shadowed string `json:"shadowed"`
 }
 
+// wellKnownWrapper carries every type the [jsonschema.WellKnownTypes] pack
+// maps, so each pack entry is checked against what encoding/json emits for
+// it. Fuzzfill's defaults fill the netip types and net.IP; wellKnownFill
+// supplies the rest.
+type wellKnownWrapper struct {
+	Addr     netip.Addr     `json:"addr"`
+	OptAddr  *netip.Addr    `json:"optAddr"`
+	Prefix   netip.Prefix   `json:"prefix"`
+	AddrPort netip.AddrPort `json:"addrPort"`
+	IP       net.IP         `json:"ip"`
+	Mail     mail.Address   `json:"mail"`
+	Mode     os.FileMode    `json:"mode"`
+	URL      textURL        `json:"url"`
+	Zone     textLocation   `json:"zone"`
+}
+
+// textURL is a TextMarshaler wrapper marshaling a URL as its String form.
+type textURL struct{ *url.URL }
+
+func (u textURL) MarshalText() ([]byte, error) {
+	if u.URL == nil {
+		return nil, nil
+	}
+
+	return []byte(u.String()), nil
+}
+
+// textLocation is a TextMarshaler wrapper marshaling a Location as its name;
+// a nil Location is UTC.
+type textLocation struct{ *time.Location }
+
+func (l textLocation) MarshalText() ([]byte, error) { return []byte(l.String()), nil }
+
+// wellKnownFill registers constructors for the wellKnownWrapper fields whose
+// valid values generic reflection cannot draw: an email address, a URL, and
+// a zone name.
+var wellKnownFill = []fuzzfill.Option{
+	fuzzfill.WithConstructor(reflect.TypeFor[mail.Address](), func(c *fuzzfill.Cursor) any {
+		return mail.Address{Name: c.String(8), Address: fuzzLabel(c) + "@" + fuzzLabel(c) + ".example"}
+	}),
+	fuzzfill.WithConstructor(reflect.TypeFor[textURL](), func(c *fuzzfill.Cursor) any {
+		if c.Intn(4) == 0 {
+			return textURL{}
+		}
+
+		u := &url.URL{Path: "/" + c.String(8), RawQuery: url.Values{fuzzLabel(c): {c.String(4)}}.Encode()}
+		if c.Bool() {
+			u.Scheme, u.Host = "https", fuzzLabel(c)+".example"
+		}
+
+		return textURL{u}
+	}),
+	fuzzfill.WithConstructor(reflect.TypeFor[textLocation](), func(c *fuzzfill.Cursor) any {
+		zones := []*time.Location{
+			nil,
+			time.UTC,
+			time.Local,
+			time.FixedZone("America/Argentina/Buenos_Aires", -3*60*60),
+			time.FixedZone("Etc/GMT+5", -5*60*60),
+		}
+
+		return textLocation{zones[c.Intn(len(zones))]}
+	}),
+}
+
+// fuzzLabel draws a non-empty lowercase DNS label.
+func fuzzLabel(c *fuzzfill.Cursor) string {
+	b := make([]byte, 1+c.Intn(8))
+	for i := range b {
+		b[i] = byte('a' + c.Intn(26))
+	}
+
+	return string(b)
+}
+
 func FuzzReflectAcceptsPlainStruct(f *testing.F) {
 	fuzzReflectAccepts[plainScalarsContainersPointers](f)
 }
@@ -386,6 +466,17 @@ func FuzzReflectAcceptsDeepEmbedChain(f *testing.F) {
 	fuzzReflectAccepts[deepEmbedChain](f)
 }
 
+// FuzzReflectAcceptsWellKnownTypes generates with the well-known types pack
+// and asserts formats, so every entry's formats and patterns must accept the
+// text encoding/json emits for the type.
+func FuzzReflectAcceptsWellKnownTypes(f *testing.F) {
+	fuzzReflectAcceptsRig[wellKnownWrapper](f, reflectRig{
+		generate: []jsonschema.GenerateOption{jsonschema.WithTypeSchemaProvider(jsonschema.WellKnownTypes())},
+		compile:  []jsonschema.ValidateOption{jsonschema.WithFormats(true)},
+		fill:     wellKnownFill,
+	})
+}
+
 // fuzzReflectAccepts is the shared body for every rig-1 target. It generates
 // and compiles T's schema once, seeds the corpus, then for each blob fills a T,
 // marshals it, and asserts the schema accepts the marshaled bytes. The f.Fuzz
@@ -394,12 +485,27 @@ func FuzzReflectAcceptsDeepEmbedChain(f *testing.F) {
 func fuzzReflectAccepts[T any](f *testing.F, compileOpts ...jsonschema.ValidateOption) {
 	f.Helper()
 
+	fuzzReflectAcceptsRig[T](f, reflectRig{compile: compileOpts})
+}
+
+// reflectRig is the options a rig-1 target passes to generation, compilation,
+// and filling.
+type reflectRig struct {
+	generate []jsonschema.GenerateOption
+	compile  []jsonschema.ValidateOption
+	fill     []fuzzfill.Option
+}
+
+// fuzzReflectAcceptsRig is [fuzzReflectAccepts] with options for every stage.
+func fuzzReflectAcceptsRig[T any](f *testing.F, rig reflectRig) {
+	f.Helper()
+
 	ctx := context.Background()
 
-	schema, err := jsonschema.GenerateFor[T](ctx)
+	schema, err := jsonschema.GenerateFor[T](ctx, rig.generate...)
 	require.NoError(f, err, "generate schema for %T", *new(T))
 
-	validator, err := jsonschema.Compile(ctx, schema, compileOpts...)
+	validator, err := jsonschema.Compile(ctx, schema, rig.compile...)
 	require.NoError(f, err, "compile schema for %T", *new(T))
 
 	schemaJSON, err := json.MarshalIndent(schema, "", "  ")
@@ -410,7 +516,7 @@ func fuzzReflectAccepts[T any](f *testing.F, compileOpts ...jsonschema.ValidateO
 	f.Fuzz(func(t *testing.T, data []byte) {
 		var val T
 
-		fuzzfill.Fill(reflect.ValueOf(&val), data)
+		fuzzfill.Fill(reflect.ValueOf(&val), data, rig.fill...)
 
 		instance, err := json.Marshal(val)
 		if err != nil {
@@ -0,0 +1,143 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/fuzzfill"
+	"go.jacobcolvin.com/x/jsonschema/internal/fuzzshape"
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonvalue"
+	"go.jacobcolvin.com/x/jsonschema/internal/normalize"
+)
+
+// Rig 3 -- ValidateValue's reflection walk vs encoding/json. ValidateValue
+// builds a value's JSON form directly rather than marshaling and decoding it,
+// so internal/jsonvalue hand-reimplements the marshaling rules a second time.
+// The property is differential: for any Go value v, the walked instance is the
+// one the old round trip would have produced,
+//
+//	jsonvalue.Of(&v) == normalize.DecodeJSONInstance(json.Marshal(&v))
+//
+// and a value encoding/json refuses fails the walk with the same message.
+// Comparing instances rather than verdicts keeps the rig independent of any
+// schema: a difference that a particular schema happens not to notice is
+// still a difference. The roster target reuses rig 1's hand-written types and
+// the shape target rig 2's synthesized ones.
+
+func FuzzValueMatchesMarshalRoster(f *testing.F) {
+	roster := []func(data []byte) any{
+		fillRoster[plainScalarsContainersPointers],
+		fillRoster[embeddedPromoted],
+		fillRoster[embeddedNamed],
+		fillRoster[tagTieBreakAmbiguous],
+		fillRoster[tagTieBreakTagWins],
+		fillRoster[providerEmbed],
+		fillRoster[promotedTextMarshaler],
+		fillRoster[promotedJSONMarshaler],
+		fillRoster[stringCoercion],
+		fillRoster[omitFields],
+		fillRoster[intKeyMap],
+		fillRoster[timeWrapper],
+		fillRoster[rawWrapper],
+		fillRoster[bigWrapper],
+		fillRoster[embeddedGeneric],
+		fillRoster[embeddedInterface],
+		fillRoster[deepEmbedChain],
+	}
+
+	addReflectSeeds(f)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, fill := range roster {
+			requireSameInstance(t, fill(data))
+		}
+	})
+}
+
+func FuzzValueMatchesMarshalShape(f *testing.F) {
+	addShapeSeeds(f)
+
+	f.Fuzz(func(t *testing.T, shape, values []byte) {
+		val := reflect.New(fuzzshape.Type(shape))
+		fuzzfill.Fill(val, values, fuzzshape.FillOptions()...)
+
+		requireSameInstance(t, val.Interface())
+	})
+}
+
+// TestValueMatchesMarshalVerdict closes the loop through the validator itself
+// on a type whose schema constrains the values: the walked and round-tripped
+// forms must draw the same verdict and the same error.
+func TestValueMatchesMarshalVerdict(t *testing.T) {
+	t.Parallel()
+
+	type server struct {
+		Host  string `json:"host"           jsonschema:"minLength=3"`
+		Port  int    `json:"port"           jsonschema:"minimum=1"`
+		Debug bool   `json:"debug,string"`
+		Tags  []byte `json:"tags,omitempty"`
+	}
+
+	validator := jsonschema.MustCompile(jsonschema.MustGenerateFor[server]())
+
+	tests := map[string]server{
+		"valid":    {Host: "example", Port: 80},
+		"invalid":  {Host: "x", Port: 0, Tags: []byte("t")},
+		"zero set": {},
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := json.Marshal(&v)
+			require.NoError(t, err)
+
+			want := validator.ValidateJSON(t.Context(), data)
+			got := validator.ValidateValue(t.Context(), v)
+
+			if want == nil {
+				require.NoError(t, got)
+
+				return
+			}
+
+			require.Error(t, got)
+			require.Equal(t, want.Error(), got.Error())
+		})
+	}
+}
+
+// fillRoster fills a T from the entropy blob and returns a pointer to it,
+// the form ValidateValue walks a non-pointer value through.
+func fillRoster[T any](data []byte) any {
+	val := new(T)
+	fuzzfill.Fill(reflect.ValueOf(val), data)
+
+	return val
+}
+
+// requireSameInstance asserts the rig-3 property for one value.
+func requireSameInstance(t *testing.T, v any) {
+	t.Helper()
+
+	got, gotErr := jsonvalue.Of(v)
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		require.Errorf(t, gotErr, "encoding/json refused a %T the walk accepted", v)
+		require.Equal(t, err.Error(), gotErr.Error())
+
+		return
+	}
+
+	require.NoErrorf(t, gotErr, "the walk refused a %T encoding/json accepted: %s", v, data)
+
+	want, err := normalize.DecodeJSONInstance(data)
+	require.NoError(t, err)
+	require.Equalf(t, want, got, "type: %T\nmarshaled: %s", v, data)
+}
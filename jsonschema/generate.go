@@ -36,6 +36,17 @@ func WithTagInterpreter(key string, t TagInterpreter) GenerateOption {
 	})
 }
 
+// WithFieldInterpreter registers a [FieldInterpreter], consulted for every
+// struct field after the tag interpreters. Multiple interpreters can be
+// registered and are applied in order. A nil f is ignored.
+func WithFieldInterpreter(f FieldInterpreter) GenerateOption {
+	return generateOptionFunc(func(g *generator) {
+		if f != nil {
+			g.fieldInterpreters = append(g.fieldInterpreters, f)
+		}
+	})
+}
+
 // tagInterpreterRegistration pairs a [TagInterpreter] with the struct tag
 // key it was registered under.
 type tagInterpreterRegistration struct {
@@ -207,6 +218,7 @@ func (f NamerFunc) SchemaName(tc TypeContext) string { return f(tc) }
 // A nil n restores the default namer.
 func WithNamer(n Namer) GenerateOption {
 	return generateOptionFunc(func(g *generator) {
+		g.customNamer = n != nil
 		if n == nil {
 			n = defaultNamerFunc()
 		}
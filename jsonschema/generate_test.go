@@ -986,11 +986,28 @@ func TestGenerateFor_IntegerMapKeys(t *testing.T) {
 
 	got, err := json.Marshal(s)
 	require.NoError(t, err)
+	// Encoding/json writes an integer key as its decimal digits.
 	assert.JSONEq(t, `{
 		"$schema":"https://json-schema.org/draft/2020-12/schema",
 		"type":["null","object"],
+		"propertyNames":{"type":"string","pattern":"^-?[0-9]+$"},
 		"additionalProperties":{"type":"string"}
 	}`, string(got))
+
+	v, err := jsonschema.Compile(t.Context(), s)
+	require.NoError(t, err)
+	require.NoError(t, v.ValidateJSON(t.Context(), []byte(`{"-3":"a","10":"b"}`)))
+	require.Error(t, v.ValidateJSON(t.Context(), []byte(`{"x":"a"}`)))
+}
+
+func TestGenerateFor_UnsignedMapKeys(t *testing.T) {
+	t.Parallel()
+
+	s, err := jsonschema.GenerateFor[map[uint8]string](t.Context())
+	require.NoError(t, err)
+
+	require.NotNil(t, s.PropertyNames)
+	assert.Equal(t, "^[0-9]+$", s.PropertyNames.Pattern)
 }
 
 func TestGenerateFor_PointerToUnrestricted(t *testing.T) {
@@ -2182,6 +2199,67 @@ type TextMarshalerKey struct{ ID int }
 
 func (k TextMarshalerKey) MarshalText() ([]byte, error) { return nil, nil }
 
+// PatternKey is a TextMarshaler key whose provider states its text.
+type PatternKey struct{ ID int }
+
+func (k PatternKey) MarshalText() ([]byte, error) { return nil, nil }
+
+func (PatternKey) JSONSchema(context.Context, jsonschema.TypeContext) (jsonschema.TypeSchema, error) {
+	return jsonschema.TypeSchema{Value: &jsonschema.Schema{Type: "string", Pattern: "^k-[0-9]+$"}}, nil
+}
+
+func TestGenerateFor_DescribedTextMarshalerMapKey(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		typ  reflect.Type
+		want string
+	}{
+		"provider": {
+			typ: reflect.TypeFor[map[PatternKey]int](),
+			want: `{
+				"$schema":"https://json-schema.org/draft/2020-12/schema",
+				"$defs":{"PatternKey":{"type":"string","pattern":"^k-[0-9]+$"}},
+				"type":["null","object"],
+				"propertyNames":{"$ref":"#/$defs/PatternKey"},
+				"additionalProperties":{"type":"integer"}
+			}`,
+		},
+		"built-in": {
+			typ: reflect.TypeFor[map[time.Time]int](),
+			want: `{
+				"$schema":"https://json-schema.org/draft/2020-12/schema",
+				"$defs":{"Time":{"type":"string","format":"date-time"}},
+				"type":["null","object"],
+				"propertyNames":{"$ref":"#/$defs/Time"},
+				"additionalProperties":{"type":"integer"}
+			}`,
+		},
+		"non-string built-in": {
+			// Big.Int's schema is its JSON number, not its text.
+			typ: reflect.TypeFor[map[*big.Int]int](),
+			want: `{
+				"$schema":"https://json-schema.org/draft/2020-12/schema",
+				"type":["null","object"],
+				"additionalProperties":{"type":"integer"}
+			}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := jsonschema.Generate(t.Context(), tc.typ)
+			require.NoError(t, err)
+
+			got, err := json.Marshal(s)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(got))
+		})
+	}
+}
+
 func TestGenerateFor_TextMarshalerMapKey(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,651 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/fieldname"
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonptr"
+	"go.jacobcolvin.com/x/jsonschema/internal/jsontag"
+	"go.jacobcolvin.com/x/jsonschema/internal/uriref"
+)
+
+// WithGenericDefinitions emits the $defs entries of a generic struct type's
+// instantiations (Page[User], Page[Order]) as one shared template
+// parameterized with $dynamicAnchor and $dynamicRef, plus one thin
+// definition per instantiation that binds the type arguments and refers to
+// the template, in place of one fully expanded definition each. Validation
+// semantics are unchanged.
+//
+// Dynamic scope is a property of schema resources, so the template and each
+// instantiation become embedded resources with their own $id, resolved as
+// siblings of base. Base must be an absolute URI; it becomes the root
+// schema's $id, and references from inside those resources back to the
+// document's other definitions are written against it. A relative base is
+// reported as [ErrInvalidGenericBase]. An empty base restores the default,
+// full expansion.
+//
+// Reflection does not record which fields a generic type declares with a
+// type parameter, so a type argument is recognized by matching the type
+// argument names Go records in the instantiation's name against the struct's
+// field types, element types included. A field whose type matches in every
+// instantiation is parameterized; a template is emitted only when every
+// instantiation of the generic type reduces to the same template, so a
+// coincidental match (a Count int beside a T instantiated as int) at worst
+// costs the sharing, never the meaning. A generic type whose instantiations
+// reach one another (Page[Page[User]], or a User holding a Page[Order]) is
+// expanded instead, since a binding of the outer instantiation would shadow
+// the inner one's for the whole dynamic scope.
+//
+// $dynamicRef is a Draft 2020-12 keyword, so under [Draft7] the option is
+// ignored and every instantiation is expanded, as it is with
+// [WithDefinitions] disabled.
+func WithGenericDefinitions(base string) GenerateOption {
+	return generateOptionFunc(func(g *generator) { g.genericBase = base })
+}
+
+// checkGenericBase reports a [WithGenericDefinitions] base that is not an
+// absolute URI without a fragment.
+func (g *generator) checkGenericBase() error {
+	if g.genericBase == "" {
+		return nil
+	}
+
+	if u, err := url.Parse(g.genericBase); err != nil || !u.IsAbs() || u.Fragment != "" {
+		return fmt.Errorf("%w: %q", ErrInvalidGenericBase, g.genericBase)
+	}
+
+	return nil
+}
+
+// genericInstance is one reached $defs entry of an instantiated generic
+// struct type.
+type genericInstance struct {
+	entry *defEntry
+	// Args are the type argument names the instantiation's reflect name
+	// carries, as written there (package paths in full).
+	args []string
+	// Params maps each property a type argument appears in to the indexes of
+	// the arguments it holds.
+	params map[string][]int
+	// ArgTypes holds, per argument index, the field-side type whose name
+	// matched it; nil for an argument no field holds directly.
+	argTypes []reflect.Type
+	// Template is the instance's body with its parameter positions replaced.
+	template *Schema
+	bindings map[int]*Schema
+}
+
+// templateGenerics rewrites defs (the rendered $defs map, keyed by final
+// name, not yet attached to root) for [WithGenericDefinitions]: each generic
+// type whose instantiations share a template gains the template definition,
+// and each instantiation's entry becomes a thin binding resource. It reports
+// whether any generic type was templated, which is when the root takes base
+// as its $id.
+func (g *generator) templateGenerics(root *Schema, defs map[string]*Schema, reached []*defEntry) (bool, error) {
+	if g.genericBase == "" || !g.profile.dynamicRef {
+		return false, nil
+	}
+
+	groups, origins := g.genericGroups(reached)
+	if len(origins) == 0 {
+		return false, nil
+	}
+
+	edges := g.defEdges(defs)
+	used := make(map[string]bool, len(defs))
+	for name := range defs {
+		used[name] = true
+	}
+
+	// Instance names ($defs keys) of the templated instantiations, mapped to
+	// their resource $id, so every $ref to one can be rewritten to the $id.
+	instanceIDs := map[string]string{}
+	resources := map[string]bool{}
+
+	for _, origin := range origins {
+		insts := groups[origin]
+		if g.reentrant(insts, edges) {
+			continue
+		}
+
+		name := uniqueName(jsonptr.SafeToken(origin[strings.LastIndex(origin, ".")+1:]), used)
+
+		ok, err := g.deriveTemplates(insts, name)
+		if err != nil {
+			return false, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		used[name] = true
+		templateID := uriref.ResolveURI(g.genericBase, name)
+
+		tmpl := insts[0].template
+		tmpl.ID = templateID
+		tmpl.Defs = maps.Clone(tmpl.Defs)
+
+		if tmpl.Defs == nil {
+			tmpl.Defs = map[string]*Schema{}
+		}
+
+		for _, i := range slices.Sorted(maps.Keys(insts[0].bindings)) {
+			tmpl.Defs[paramDefName(i)] = &Schema{DynamicAnchor: paramAnchor(name, i)}
+		}
+
+		defs[name] = tmpl
+		resources[name] = true
+
+		for _, inst := range insts {
+			id := uriref.ResolveURI(g.genericBase, inst.entry.name)
+			thin := &Schema{ID: id, Ref: templateID, Defs: map[string]*Schema{}}
+
+			for i, b := range inst.bindings {
+				thin.Defs[paramDefName(i)] = b
+			}
+
+			defs[inst.entry.name] = thin
+			instanceIDs[inst.entry.name] = id
+			resources[inst.entry.name] = true
+		}
+	}
+
+	if len(resources) == 0 {
+		return false, nil
+	}
+
+	g.absolutizeRefs(root, defs, resources, instanceIDs)
+
+	return true, nil
+}
+
+// genericGroups groups the reached generic struct instantiations by generic
+// type, returning the groups and their keys (package path and type name
+// without arguments) in a deterministic order.
+func (g *generator) genericGroups(reached []*defEntry) (map[string][]*genericInstance, []string) {
+	groups := map[string][]*genericInstance{}
+
+	for _, e := range reached {
+		t := e.typ
+		if t == nil || t.Kind() != reflect.Struct || e.body == nil || e.body.kind != kindObject || e.rendered == nil {
+			continue
+		}
+
+		open := strings.IndexByte(t.Name(), '[')
+		if open < 0 {
+			continue
+		}
+
+		args := splitTypeArgs(t.Name()[open:])
+		if len(args) == 0 {
+			continue
+		}
+
+		origin := t.PkgPath() + "." + t.Name()[:open]
+		groups[origin] = append(groups[origin], &genericInstance{
+			entry:    e,
+			args:     args,
+			params:   map[string][]int{},
+			argTypes: make([]reflect.Type, len(args)),
+		})
+	}
+
+	origins := slices.Sorted(maps.Keys(groups))
+	for _, origin := range origins {
+		slices.SortFunc(groups[origin], func(a, b *genericInstance) int {
+			return strings.Compare(a.entry.name, b.entry.name)
+		})
+	}
+
+	return groups, origins
+}
+
+// splitTypeArgs splits the bracketed type argument list of an instantiated
+// type's reflect name ("[int,[]pkg.User]") at its top-level commas. Struct
+// type arguments carry quoted tags, so commas inside quotes are skipped too.
+func splitTypeArgs(list string) []string {
+	if len(list) < 2 || list[0] != '[' || list[len(list)-1] != ']' {
+		return nil
+	}
+
+	list = list[1 : len(list)-1]
+
+	var (
+		args   []string
+		depth  int
+		quoted bool
+		start  int
+	)
+
+	for i := 0; i < len(list); i++ {
+		switch c := list[i]; {
+		case quoted && c == '\\':
+			i++
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '[' || c == '{' || c == '(':
+			depth++
+		case c == ']' || c == '}' || c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			args = append(args, list[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(args, list[start:])
+}
+
+// typeArgName spells t the way an instantiated type's reflect name spells a
+// type argument, or returns "" for a type that cannot stand as one here (an
+// unnamed struct, a non-empty interface, a func, or a chan).
+func typeArgName(t reflect.Type) string {
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return t.Name()
+		}
+
+		return t.PkgPath() + "." + t.Name()
+	}
+
+	var elem string
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		elem = typeArgName(t.Elem())
+	case reflect.Map:
+		key := typeArgName(t.Key())
+		if key == "" {
+			return ""
+		}
+
+		elem = typeArgName(t.Elem())
+		if elem == "" {
+			return ""
+		}
+
+		return "map[" + key + "]" + elem
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return "interface {}"
+		}
+
+		return ""
+	default:
+		return ""
+	}
+
+	if elem == "" {
+		return ""
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return "*" + elem
+	case reflect.Slice:
+		return "[]" + elem
+	default:
+		return "[" + strconv.Itoa(t.Len()) + "]" + elem
+	}
+}
+
+// matchParams records, for each JSON property of the instantiation's struct,
+// the type arguments its field type holds: the field type itself or, through
+// unnamed pointer, slice, array, and map element types, a type spelled like
+// an argument. A named type is matched whole and never entered, since only
+// the instantiation's own fields can hold its parameters. Names are read under
+// naming, the [WithFieldNaming] dialect, when it is set.
+func (inst *genericInstance) matchParams(naming *fieldname.Dialect) {
+	t := inst.entry.typ
+
+	var names map[int]string
+
+	if naming != nil {
+		names = map[int]string{}
+
+		if fields, err := naming.Fields(t); err == nil {
+			for _, f := range fields.List {
+				if len(f.Field.Index) == 1 {
+					names[f.Field.Index[0]] = f.Name
+				}
+			}
+		}
+	}
+
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if f.Anonymous {
+			continue
+		}
+
+		name := jsontag.Parse(f).JSONName
+		if names != nil {
+			name = names[i]
+		}
+
+		if name == "" {
+			continue
+		}
+
+		for ft := f.Type; ; {
+			if argName := typeArgName(ft); argName != "" {
+				if j := slices.Index(inst.args, argName); j >= 0 {
+					inst.params[name] = append(inst.params[name], j)
+					inst.argTypes[j] = ft
+				}
+			}
+
+			if ft.Name() != "" {
+				break
+			}
+
+			switch ft.Kind() {
+			case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+				ft = ft.Elem()
+
+				continue
+			}
+
+			break
+		}
+	}
+}
+
+// deriveTemplates computes every instantiation's template and bindings and
+// reports whether they share one template: the parameter positions are the
+// properties whose field type holds the same argument in every
+// instantiation, and within them each sub-schema equal to the argument's
+// own schema, annotations aside.
+func (g *generator) deriveTemplates(insts []*genericInstance, name string) (bool, error) {
+	for _, inst := range insts {
+		inst.matchParams(g.naming)
+	}
+
+	// Keep only the (property, argument) pairs every instantiation agrees on.
+	shared := maps.Clone(insts[0].params)
+	for prop, idx := range shared {
+		for _, inst := range insts[1:] {
+			idx = slices.DeleteFunc(slices.Clone(idx), func(i int) bool { return !slices.Contains(inst.params[prop], i) })
+		}
+
+		if len(idx) == 0 {
+			delete(shared, prop)
+		} else {
+			shared[prop] = idx
+		}
+	}
+
+	if len(shared) == 0 {
+		return false, nil
+	}
+
+	var want []byte
+
+	for _, inst := range insts {
+		body, err := cloneSchema(inst.entry.rendered)
+		if err != nil {
+			return false, nil //nolint:nilerr // A body that cannot be cloned is kept expanded.
+		}
+
+		inst.template = body
+		inst.bindings = map[int]*Schema{}
+
+		for _, prop := range slices.Sorted(maps.Keys(shared)) {
+			sub := body.Properties[prop]
+			if sub == nil {
+				continue
+			}
+
+			for _, i := range shared[prop] {
+				arg, err := g.argSchema(inst, i)
+				if err != nil {
+					return false, err
+				}
+
+				if arg == nil {
+					continue
+				}
+
+				if substituteParam(sub, arg, "#"+paramAnchor(name, i)) {
+					inst.bindings[i] = arg
+				}
+			}
+		}
+
+		if len(inst.bindings) == 0 {
+			return false, nil
+		}
+
+		got, err := json.Marshal(body)
+		if err != nil {
+			return false, nil //nolint:nilerr // A body that cannot be marshaled is kept expanded.
+		}
+
+		if want == nil {
+			want = got
+		} else if !bytes.Equal(want, got) {
+			return false, nil
+		}
+	}
+
+	// Every instantiation binds the same parameters: the template is shared.
+	for _, inst := range insts[1:] {
+		if !slices.Equal(slices.Sorted(maps.Keys(inst.bindings)), slices.Sorted(maps.Keys(insts[0].bindings))) {
+			return false, nil
+		}
+	}
+
+	for _, inst := range insts {
+		for i, b := range inst.bindings {
+			b.DynamicAnchor = paramAnchor(name, i)
+		}
+	}
+
+	return true, nil
+}
+
+// argSchema renders the schema of instantiation argument i as an occurrence
+// of its type with no field-level facts, or returns nil when no field holds
+// the argument directly or rendering it would need a definition the
+// generation run did not already produce.
+func (g *generator) argSchema(inst *genericInstance, i int) (*Schema, error) {
+	t := inst.argTypes[i]
+	if t == nil {
+		return nil, nil
+	}
+
+	defs := len(g.defs)
+
+	n, err := g.schemaForType(t, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(g.defs) != defs {
+		return nil, nil
+	}
+
+	s, err := cloneSchema(g.render(n))
+	if err != nil {
+		return nil, nil //nolint:nilerr // An argument schema that cannot be cloned keeps the instance expanded.
+	}
+
+	return s, nil
+}
+
+// substituteParam replaces in place every sub-schema of s (s included) that
+// equals arg once its annotations are set aside with a $dynamicRef to ref
+// carrying those annotations, reporting whether any was replaced.
+func substituteParam(s, arg *Schema, ref string) bool {
+	want, err := json.Marshal(arg)
+	if err != nil {
+		return false
+	}
+
+	replaced := false
+
+	//nolint:errcheck // The callback returns only SkipChildren.
+	_ = Walk(s, func(_ Location, sub *Schema) error {
+		bare := withoutAnnotations(sub)
+
+		got, err := json.Marshal(bare)
+		if err != nil || !bytes.Equal(got, want) {
+			return nil
+		}
+
+		*sub = Schema{
+			DynamicRef:  ref,
+			Title:       sub.Title,
+			Description: sub.Description,
+			Comment:     sub.Comment,
+			Default:     sub.Default,
+			Examples:    sub.Examples,
+			Deprecated:  sub.Deprecated,
+			ReadOnly:    sub.ReadOnly,
+			WriteOnly:   sub.WriteOnly,
+		}
+		replaced = true
+
+		return SkipChildren
+	})
+
+	return replaced
+}
+
+// withoutAnnotations returns a shallow copy of s with its annotation
+// keywords cleared.
+func withoutAnnotations(s *Schema) *Schema {
+	bare := *s
+	bare.Title = ""
+	bare.Description = ""
+	bare.Comment = ""
+	bare.Default = nil
+	bare.Examples = nil
+	bare.Deprecated = false
+	bare.ReadOnly = false
+	bare.WriteOnly = false
+
+	return &bare
+}
+
+// paramDefName is the $defs key of argument i's $dynamicAnchor schema in a
+// template or instantiation resource.
+func paramDefName(i int) string {
+	return "T" + strconv.Itoa(i)
+}
+
+// paramAnchor is the $dynamicAnchor name of argument i of the named
+// template. Dynamic scope is searched by anchor name alone, so the name is
+// qualified by its template: two generic types nested in one another then
+// never capture each other's bindings.
+func paramAnchor(template string, i int) string {
+	b := []byte(template)
+	for j, c := range b {
+		if !(c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c == '_' ||
+			j > 0 && (c >= '0' && c <= '9' || c == '-' || c == '.')) {
+			b[j] = '_'
+		}
+	}
+
+	return string(b) + "." + paramDefName(i)
+}
+
+// defEdges maps each definition name to the names its rendered body refers
+// to through the run's own $ref prefix.
+func (g *generator) defEdges(defs map[string]*Schema) map[string][]string {
+	prefix := g.profile.refPrefix()
+	edges := make(map[string][]string, len(defs))
+
+	for name, s := range defs {
+		for _, sub := range Schemas(s) {
+			if target, ok := strings.CutPrefix(sub.Ref, prefix); ok {
+				edges[name] = append(edges[name], target)
+			}
+		}
+	}
+
+	return edges
+}
+
+// reentrant reports whether any instantiation reaches another instantiation
+// of the same generic type through the definitions graph. The inner one's
+// binding would then be evaluated inside the outer one's dynamic scope,
+// where $dynamicRef resolves to the outermost binding, the wrong one.
+func (g *generator) reentrant(insts []*genericInstance, edges map[string][]string) bool {
+	names := make(map[string]bool, len(insts))
+	for _, inst := range insts {
+		names[inst.entry.name] = true
+	}
+
+	for _, inst := range insts {
+		seen := map[string]bool{inst.entry.name: true}
+		queue := slices.Clone(edges[inst.entry.name])
+
+		for len(queue) > 0 {
+			name := queue[0]
+			queue = queue[1:]
+
+			if seen[name] {
+				continue
+			}
+
+			if names[name] {
+				return true
+			}
+
+			seen[name] = true
+			queue = append(queue, edges[name]...)
+		}
+	}
+
+	return false
+}
+
+// absolutizeRefs rewrites $ref strings for the embedded resources: a $ref
+// to a templated instantiation targets its $id everywhere, root included,
+// and any other fragment-only $ref inside a resource, which would otherwise
+// resolve against the resource's own $id, targets the root through base.
+func (g *generator) absolutizeRefs(
+	root *Schema,
+	defs map[string]*Schema,
+	resources map[string]bool,
+	instanceIDs map[string]string,
+) {
+	prefix := g.profile.refPrefix()
+
+	rewrite := func(s *Schema, resource bool) {
+		for _, sub := range Schemas(s) {
+			if sub.Ref == "" || !uriref.IsFragmentOnly(sub.Ref) {
+				continue
+			}
+
+			if target, ok := strings.CutPrefix(sub.Ref, prefix); ok {
+				if id, ok := instanceIDs[target]; ok {
+					sub.Ref = id
+
+					continue
+				}
+			}
+
+			if resource {
+				sub.Ref = g.genericBase + sub.Ref
+			}
+		}
+	}
+
+	rewrite(root, false)
+
+	for name, s := range defs {
+		rewrite(s, resources[name])
+	}
+}
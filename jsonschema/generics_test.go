@@ -0,0 +1,205 @@
+package jsonschema_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+const genericBase = "https://example.com/schemas/api.json"
+
+type genUser struct {
+	Name string `json:"name"`
+}
+
+type genOrder struct {
+	ID int `json:"id"`
+}
+
+type GenPage[T any] struct {
+	Next  *T  `json:"next,omitempty"`
+	Items []T `json:"items"`
+	Total int `json:"total"`
+}
+
+type genAPI struct {
+	Users  GenPage[genUser]  `json:"users"`
+	Orders GenPage[genOrder] `json:"orders"`
+	Counts GenPage[int]      `json:"counts"`
+}
+
+type GenTree[T any] struct {
+	Value    T            `json:"value"`
+	Children []GenTree[T] `json:"children"`
+}
+
+type genForest struct {
+	Ints GenTree[int] `json:"ints"`
+}
+
+type genNested struct {
+	Pages GenPage[GenPage[genUser]] `json:"pages"`
+}
+
+type genReentrantUser struct {
+	Orders GenPage[genOrder] `json:"orders"`
+}
+
+type genReentrant struct {
+	Users GenPage[genReentrantUser] `json:"users"`
+}
+
+func TestWithGenericDefinitions(t *testing.T) {
+	t.Parallel()
+
+	s, err := jsonschema.GenerateFor[genAPI](t.Context(), jsonschema.WithGenericDefinitions(genericBase))
+	require.NoError(t, err)
+
+	assert.Equal(t, genericBase, s.ID)
+
+	tmpl := s.Defs["GenPage"]
+	require.NotNil(t, tmpl)
+	assert.Equal(t, "https://example.com/schemas/GenPage", tmpl.ID)
+	assert.Equal(t, "GenPage.T0", tmpl.Defs["T0"].DynamicAnchor)
+	assert.Equal(t, "#GenPage.T0", tmpl.Properties["items"].Items.DynamicRef)
+	assert.Equal(t, "#GenPage.T0", tmpl.Properties["next"].AnyOf[0].DynamicRef)
+	// Total is an int in every instantiation, but only in GenPage[int] does it
+	// match the argument, so it stays concrete.
+	assert.Equal(t, "integer", tmpl.Properties["total"].Type)
+
+	ints := s.Defs["GenPage_int_"]
+	require.NotNil(t, ints)
+
+	got, err := json.Marshal(ints)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$id": "https://example.com/schemas/GenPage_int_",
+		"$ref": "https://example.com/schemas/GenPage",
+		"$defs": {"T0": {"$dynamicAnchor": "GenPage.T0", "type": "integer"}}
+	}`, string(got))
+
+	assert.Equal(t, "https://example.com/schemas/GenPage_int_", s.Properties["counts"].Ref)
+
+	var users *jsonschema.Schema
+
+	for name, def := range s.Defs {
+		if def.Ref == tmpl.ID && name != "GenPage_int_" && def.Defs["T0"].Ref == genericBase+"#/$defs/genUser" {
+			users = def
+		}
+	}
+
+	require.NotNil(t, users, "no GenPage[genUser] binding to the genUser definition")
+}
+
+func TestWithGenericDefinitions_Verdicts(t *testing.T) {
+	t.Parallel()
+
+	instances := map[string]string{
+		"valid":           `{"users": {"items": [{"name": "a"}], "total": 1}, "orders": {"items": null, "total": 0}, "counts": {"items": [1], "next": 2, "total": 1}}`,
+		"user item":       `{"users": {"items": [{"name": 1}], "total": 1}, "orders": {"items": [], "total": 0}, "counts": {"items": [], "total": 0}}`,
+		"order item":      `{"users": {"items": [], "total": 0}, "orders": {"items": [{"id": "x"}], "total": 1}, "counts": {"items": [], "total": 0}}`,
+		"count next":      `{"users": {"items": [], "total": 0}, "orders": {"items": [], "total": 0}, "counts": {"items": [], "next": "x", "total": 0}}`,
+		"swapped binding": `{"users": {"items": [{"id": 1}], "total": 1}, "orders": {"items": [], "total": 0}, "counts": {"items": [], "total": 0}}`,
+		"null next":       `{"users": {"items": [], "next": null, "total": 0}, "orders": {"items": [], "total": 0}, "counts": {"items": [], "total": 0}}`,
+	}
+
+	assertSameVerdicts[genAPI](t, instances)
+
+	// A self-referential instantiation keeps its own binding throughout.
+	forest := jsonschema.MustGenerateFor[genForest](jsonschema.WithGenericDefinitions(genericBase))
+	require.Contains(t, forest.Defs, "GenTree")
+	assert.Equal(t, "https://example.com/schemas/GenTree_int_",
+		forest.Defs["GenTree"].Properties["children"].Items.Ref)
+
+	assertSameVerdicts[genForest](t, map[string]string{
+		"valid":        `{"ints": {"value": 1, "children": [{"value": 2, "children": null}]}}`,
+		"nested value": `{"ints": {"value": 1, "children": [{"value": "x", "children": null}]}}`,
+	})
+}
+
+// assertSameVerdicts generates T with and without generic definitions and
+// checks that both schemas agree on every instance.
+func assertSameVerdicts[T any](t *testing.T, instances map[string]string) {
+	t.Helper()
+
+	expanded := jsonschema.MustGenerateFor[T]()
+	templated := jsonschema.MustGenerateFor[T](jsonschema.WithGenericDefinitions(genericBase))
+
+	for name, instance := range instances {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			want := validateJSON(t.Context(), expanded, []byte(instance))
+			got := validateJSON(t.Context(), templated, []byte(instance))
+			assert.Equal(t, want == nil, got == nil, "expanded: %v\ntemplated: %v", want, got)
+		})
+	}
+}
+
+func TestWithGenericDefinitions_Expanded(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		generate func(ctx context.Context, opts ...jsonschema.GenerateOption) (*jsonschema.Schema, error)
+		opts     []jsonschema.GenerateOption
+	}{
+		"draft 7": {
+			generate: jsonschema.GenerateFor[genAPI],
+			opts:     []jsonschema.GenerateOption{jsonschema.WithDraft(jsonschema.Draft7)},
+		},
+		"nested instantiation": {
+			generate: jsonschema.GenerateFor[genNested],
+		},
+		"argument reaching another instantiation": {
+			generate: jsonschema.GenerateFor[genReentrant],
+		},
+		"instantiations disagree": {
+			generate: jsonschema.GenerateFor[genAPI],
+			opts: []jsonschema.GenerateOption{
+				jsonschema.WithTypeSchemaExtenderFor[GenPage[int]](
+					func(_ context.Context, _ jsonschema.TypeContext, ts *jsonschema.TypeSchema) error {
+						ts.Value.MinProperties = new(1)
+
+						return nil
+					}),
+			},
+		},
+		"no generic types": {
+			generate: jsonschema.GenerateFor[genUser],
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			want, err := tc.generate(t.Context(), tc.opts...)
+			require.NoError(t, err)
+
+			got, err := tc.generate(t.Context(), append(tc.opts, jsonschema.WithGenericDefinitions(genericBase))...)
+			require.NoError(t, err)
+
+			wantJSON, err := json.Marshal(want)
+			require.NoError(t, err)
+
+			gotJSON, err := json.Marshal(got)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(wantJSON), string(gotJSON))
+		})
+	}
+}
+
+func TestWithGenericDefinitions_InvalidBase(t *testing.T) {
+	t.Parallel()
+
+	for _, base := range []string{"api.json", "https://example.com/api.json#frag"} {
+		_, err := jsonschema.GenerateFor[genAPI](t.Context(), jsonschema.WithGenericDefinitions(base))
+		require.ErrorIs(t, err, jsonschema.ErrInvalidGenericBase, base)
+	}
+}
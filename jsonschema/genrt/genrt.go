@@ -0,0 +1,437 @@
+// Package genrt is the runtime support library for the validators
+// [jsonschema.GenerateGo] emits. Generated code calls it for the parts of
+// keyword evaluation that are shared with the [jsonschema.Validator] walk
+// (numeric bounds, enum and const equality, formats, content, regular
+// expressions, annotation tracking) so both report the same errors, and for
+// the lazily built instance and schema locations those errors carry.
+//
+// It is not intended for direct use: its API serves the generator and changes
+// with it, so generated code should be regenerated when this module is
+// upgraded.
+package genrt
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math/big"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/annotations"
+	"go.jacobcolvin.com/x/jsonschema/internal/content"
+	"go.jacobcolvin.com/x/jsonschema/internal/format"
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonequal"
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonvalue"
+	"go.jacobcolvin.com/x/jsonschema/internal/keyword"
+	"go.jacobcolvin.com/x/jsonschema/internal/normalize"
+	"go.jacobcolvin.com/x/jsonschema/internal/numcheck"
+	"go.jacobcolvin.com/x/jsonschema/internal/numrat"
+	"go.jacobcolvin.com/x/jsonschema/internal/regexcache"
+	"go.jacobcolvin.com/x/jsonschema/internal/typename"
+)
+
+// Path is a location in the instance or the schema, built as a chain of
+// parent links so a descent allocates one link and the segments are only
+// materialized when an error is reported. A nil *Path is the root.
+type Path struct {
+	parent *Path
+	// A static run of segments appended at once (a schema-side keyword and
+	// member), or nil when the link carries the single segment seg.
+	run []jsonschema.Segment
+	seg jsonschema.Segment
+}
+
+// Key returns the location of the object member named name under p.
+func (p *Path) Key(name string) *Path {
+	return &Path{parent: p, seg: jsonschema.Segment{Key: name}}
+}
+
+// Index returns the location of the array element at index i under p.
+func (p *Path) Index(i int) *Path {
+	return &Path{parent: p, seg: jsonschema.Segment{Index: i, IsIndex: true}}
+}
+
+// Append returns the location of the static segment run segs under p. The
+// run is shared, never copied or mutated.
+func (p *Path) Append(segs []jsonschema.Segment) *Path {
+	return &Path{parent: p, run: segs}
+}
+
+// Segments returns the location's segments outermost first, or nil for the
+// root.
+func (p *Path) Segments() []jsonschema.Segment {
+	n := 0
+	for q := p; q != nil; q = q.parent {
+		n += q.len()
+	}
+
+	if n == 0 {
+		return nil
+	}
+
+	segs := make([]jsonschema.Segment, n)
+	for q := p; q != nil; q = q.parent {
+		if q.run != nil {
+			n -= len(q.run)
+			copy(segs[n:], q.run)
+		} else {
+			n--
+			segs[n] = q.seg
+		}
+	}
+
+	return segs
+}
+
+func (p *Path) len() int {
+	if p.run != nil {
+		return len(p.run)
+	}
+
+	return 1
+}
+
+// NewError returns a validation error at instance location ip and the
+// fully formed schema location sp.
+func NewError(ip, sp *Path, kw, msg string, causes []*jsonschema.ValidationError) *jsonschema.ValidationError {
+	return jsonschema.NewValidationError(ip.Segments(), sp.Segments(), kw, msg, nil, causes)
+}
+
+// Leaf returns a cause-free validation error at the keyword token kw under
+// sp.
+func Leaf(ip, sp *Path, kw, msg string) *jsonschema.ValidationError {
+	return NewError(ip, sp.Key(kw), kw, msg, nil)
+}
+
+// Wrap returns a validation error carrying causes at the keyword token kw
+// under sp.
+func Wrap(ip, sp *Path, kw, msg string, causes []*jsonschema.ValidationError) *jsonschema.ValidationError {
+	return NewError(ip, sp.Key(kw), kw, msg, causes)
+}
+
+// False returns the failure of a boolean false schema at sp, labeled with
+// the applicator keyword that reached it (empty when there is none).
+func False(ip, sp *Path, kw string) []*jsonschema.ValidationError {
+	return []*jsonschema.ValidationError{NewError(ip, sp, kw, "value is not allowed", nil)}
+}
+
+// Result assembles a generated walk's errors into the value
+// [jsonschema.Validator.Validate] returns: nil, the single error, or a
+// header-less error grouping several.
+func Result(errs []*jsonschema.ValidationError) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		return &jsonschema.ValidationError{Causes: errs}
+	}
+}
+
+// Normalize normalizes instance for a generated walk, rejecting the values
+// [jsonschema.Validator.Validate] rejects with the same message.
+func Normalize(instance any) (any, error) {
+	instance, ok := normalize.ValueChecked(instance)
+	if !ok {
+		return nil, fmt.Errorf(
+			"instance of type %T is not accepted: instances must contain only map[string]any, "+
+				"[]any, string, bool, nil, and numeric values, with no self-referential containers; "+
+				"marshal to JSON or use Validator.ValidateJSON",
+			instance,
+		)
+	}
+
+	return instance, nil
+}
+
+type visit struct {
+	node int
+	ip   *Path
+}
+
+// State is the per-run state of a generated walk: the nodes entered at each
+// instance location, which breaks cycles of in-place applicators the way the
+// [jsonschema.Validator] walk does. The zero value is ready to use.
+type State struct {
+	visiting map[visit]bool
+}
+
+// Enter marks node as entered at ip, reporting false when it already is (a
+// cycle, which the walk treats as passing).
+func (s *State) Enter(node int, ip *Path) bool {
+	k := visit{node, ip}
+	if s.visiting[k] {
+		return false
+	}
+
+	if s.visiting == nil {
+		s.visiting = map[visit]bool{}
+	}
+
+	s.visiting[k] = true
+
+	return true
+}
+
+// Leave undoes the matching [State.Enter].
+func (s *State) Leave(node int, ip *Path) {
+	delete(s.visiting, visit{node, ip})
+}
+
+// Annotations records the properties and items a schema node evaluated, for
+// unevaluatedProperties and unevaluatedItems. A nil *Annotations tracks
+// nothing; every method is nil-safe.
+type Annotations annotations.Set
+
+// NewAnnotations returns an empty annotation set.
+func NewAnnotations() *Annotations { return (*Annotations)(annotations.New()) }
+
+func (a *Annotations) set() *annotations.Set { return (*annotations.Set)(a) }
+
+// Child returns a fresh set when a tracks annotations, or nil when it does
+// not.
+func (a *Annotations) Child() *Annotations { return (*Annotations)(a.set().Child()) }
+
+// Merge folds other's evaluations into a.
+func (a *Annotations) Merge(other *Annotations) { a.set().Merge(other.set()) }
+
+// RecordProperty marks the named property evaluated.
+func (a *Annotations) RecordProperty(name string) { a.set().RecordProperty(name) }
+
+// SetAllProperties marks every property evaluated.
+func (a *Annotations) SetAllProperties() { a.set().SetAllProperties() }
+
+// RecordItem marks the item at index i evaluated.
+func (a *Annotations) RecordItem(i int) { a.set().RecordItem(i) }
+
+// SetAllItems marks every item evaluated.
+func (a *Annotations) SetAllItems() { a.set().SetAllItems() }
+
+// ExtendItems marks the items below index end evaluated.
+func (a *Annotations) ExtendItems(end int) { a.set().ExtendItems(end) }
+
+// Evaluated reports whether the named property was evaluated.
+func (a *Annotations) Evaluated(name string) bool { return a.set().Evaluated(name) }
+
+// ItemEvaluated reports whether the item at index i was evaluated.
+func (a *Annotations) ItemEvaluated(i int) bool { return a.set().ItemEvaluated(i) }
+
+// AllPropertiesSet reports whether every property was marked evaluated.
+func (a *Annotations) AllPropertiesSet() bool { return a.set().AllPropertiesSet() }
+
+// AllItemsSet reports whether every item was marked evaluated.
+func (a *Annotations) AllItemsSet() bool { return a.set().AllItemsSet() }
+
+// IsNull reports whether x has JSON type null.
+func IsNull(x any) bool { return x == nil }
+
+// IsBoolean reports whether x has JSON type boolean.
+func IsBoolean(x any) bool { return normalize.MatchesType(x, typename.Boolean) }
+
+// IsString reports whether x has JSON type string.
+func IsString(x any) bool { return normalize.MatchesType(x, typename.String) }
+
+// IsInteger reports whether x has JSON type integer.
+func IsInteger(x any) bool { return normalize.MatchesType(x, typename.Integer) }
+
+// IsNumber reports whether x has JSON type number.
+func IsNumber(x any) bool { return normalize.MatchesType(x, typename.Number) }
+
+// IsObject reports whether x has JSON type object.
+func IsObject(x any) bool { return normalize.MatchesType(x, typename.Object) }
+
+// IsArray reports whether x has JSON type array.
+func IsArray(x any) bool { return normalize.MatchesType(x, typename.Array) }
+
+// TypeName returns the JSON type name of x for a type error message.
+func TypeName(x any) string { return normalize.TypeName(x) }
+
+// Enum is a compiled enum keyword.
+type Enum struct {
+	members []any
+	rats    []*big.Rat
+}
+
+// NewEnum compiles the members of an enum keyword.
+func NewEnum(members []any) *Enum {
+	return &Enum{members: members, rats: numrat.EnumMemberRats(members)}
+}
+
+// Contains reports whether x equals a member.
+func (e *Enum) Contains(x any) bool {
+	for i, m := range e.members {
+		var r *big.Rat
+		if e.rats != nil {
+			r = e.rats[i]
+		}
+
+		if jsonequal.EqualWithRat(m, r, x) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Const is a compiled const keyword.
+type Const struct {
+	value any
+	rat   *big.Rat
+}
+
+// NewConst compiles the value of a const keyword.
+func NewConst(value any) *Const {
+	c := &Const{value: value}
+	if r, ok := numrat.SchemaNumberRat(value); ok {
+		c.rat = r
+	}
+
+	return c
+}
+
+// Equal reports whether x equals the const value.
+func (c *Const) Equal(x any) bool { return jsonequal.EqualWithRat(c.value, c.rat, x) }
+
+// Numeric is a compiled set of numeric bound keywords.
+type Numeric struct {
+	bounds *numcheck.Bounds
+}
+
+// NewNumeric compiles the numeric bound keywords; a nil argument is an absent
+// keyword.
+func NewNumeric(multipleOf, minimum, maximum, exclusiveMinimum, exclusiveMaximum *float64) *Numeric {
+	return &Numeric{bounds: numcheck.New(multipleOf, minimum, maximum, exclusiveMinimum, exclusiveMaximum)}
+}
+
+// Check appends to errs one error per bound x violates.
+func (n *Numeric) Check(
+	errs []*jsonschema.ValidationError, x any, ip, sp *Path,
+) []*jsonschema.ValidationError {
+	n.bounds.Check(x, func(kw, msg string) {
+		errs = append(errs, Leaf(ip, sp, kw, msg))
+	})
+
+	return errs
+}
+
+// Float returns a pointer to f, for the [NewNumeric] arguments.
+func Float(f float64) *float64 { return &f }
+
+// Pattern compiles a regular expression the validator accepted at
+// generation time, panicking if it no longer compiles.
+func Pattern(expr string) *regexp.Regexp {
+	re, err := regexcache.Compile(expr)
+	if err != nil {
+		panic(fmt.Sprintf("genrt: pattern %q: %v", expr, err))
+	}
+
+	return re
+}
+
+// Format returns the built-in checker for the named format, panicking when
+// there is none.
+func Format(name string) func(string) error {
+	f, ok := format.Validators()[name]
+	if !ok {
+		panic(fmt.Sprintf("genrt: no built-in format %q", name))
+	}
+
+	return f
+}
+
+// FormatError returns the error for a string failing the named format's
+// checker with err, attaching err as the walk does.
+func FormatError(ip, sp *Path, name string, err error) *jsonschema.ValidationError {
+	return jsonschema.NewValidationError(ip.Segments(), sp.Key(keyword.Format).Segments(), keyword.Format,
+		fmt.Sprintf("string does not match format %q: %v", name, err), err, nil)
+}
+
+// Content appends to errs the contentEncoding or contentMediaType failure of
+// s, if any. The strictBase64 flag selects the Draft 2020-12 reading of
+// base64, which rejects line breaks.
+func Content(
+	errs []*jsonschema.ValidationError, s string, ip, sp *Path, encoding, mediaType string, strictBase64 bool,
+) []*jsonschema.ValidationError {
+	switch kw, err := content.Assert(encoding, mediaType, s, strictBase64); kw {
+	case keyword.ContentEncoding:
+		return append(errs, Leaf(ip, sp, keyword.ContentEncoding,
+			fmt.Sprintf("string is not valid base64: %v", err)))
+
+	case keyword.ContentMediaType:
+		return append(errs, Leaf(ip, sp, keyword.ContentMediaType,
+			"string is not a valid application/json document"))
+	}
+
+	return errs
+}
+
+// HasDuplicates reports whether arr holds two equal items.
+func HasDuplicates(arr []any) bool { return jsonequal.HasDuplicates(arr) }
+
+// SortedKeys returns obj's keys in sorted order.
+func SortedKeys(obj map[string]any) []string { return slices.Sorted(maps.Keys(obj)) }
+
+// JSONValue returns the JSON form of v as [jsonschema.Validator.ValidateValue]
+// computes it, for the parts of a typed value whose JSON form generated code
+// does not build directly (marshalers, interfaces, embedded fields).
+func JSONValue(v any) (any, error) {
+	x, err := jsonvalue.Of(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal instance: %w", err)
+	}
+
+	return x, nil
+}
+
+// String returns s as encoding/json would round-trip it: each byte of an
+// invalid UTF-8 sequence becomes U+FFFD.
+func String(s string) string { return jsonvalue.String(s) }
+
+// Int returns the JSON number form of i.
+func Int(i int64) any { return json.Number(strconv.FormatInt(i, 10)) }
+
+// Uint returns the JSON number form of u.
+func Uint(u uint64) any { return json.Number(strconv.FormatUint(u, 10)) }
+
+// Number returns the JSON number form of f, formatted as encoding/json
+// formats a float of the given bit size. A NaN or infinity has no JSON form
+// and is reported as encoding/json reports it.
+func Number(f float64, bits int) (any, error) {
+	n, err := jsonvalue.Number(f, bits)
+	if err != nil {
+		return nil, fmt.Errorf("marshal instance: %w", err)
+	}
+
+	return n, nil
+}
+
+// IsZero reports whether the value p points to is its type's zero value, for
+// an omitzero field without an IsZero method.
+func IsZero(p any) bool { return reflect.ValueOf(p).Elem().IsZero() }
+
+// CycleError reports a typed value too deeply nested to convert, which for
+// a finite type means a pointer cycle; encoding/json rejects the same value.
+func CycleError(v any) error {
+	return fmt.Errorf("marshal instance: %w", &json.UnsupportedValueError{
+		Value: reflect.ValueOf(v),
+		Str:   fmt.Sprintf("encountered a cycle via %T", v),
+	})
+}
+
+// ValidKeys reports whether every key of m is valid UTF-8, so encoding/json
+// writes each key unchanged.
+func ValidKeys[M ~map[K]V, K ~string, V any](m M) bool {
+	for k := range m {
+		if !utf8.ValidString(string(k)) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,167 @@
+package jsonschema
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/fieldname"
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonvalue"
+)
+
+// WithGoFieldPaths makes [Validator.ValidateValue] attach to every
+// [*ValidationError] in a failure the Go origin of its instance location: the
+// struct field, map entry, or slice or array element each instance segment
+// was produced from, readable through [ValidationError.GoSegments] and
+// rendered by [ValidationError.GoFieldPath] (for example
+// Config.Server.TLS.CertFile). Field resolution is encoding/json's, so json
+// tags, embedded and promoted fields, and map key conversion map back to the
+// fields that produced them, or the [WithFieldNaming] dialect's when one is
+// set, so inlined fields and maps resolve the same way. The other entry
+// points validate JSON rather than Go values and are unaffected.
+func WithGoFieldPaths(enabled bool) ValidateOption {
+	return validateOptionFunc(func(v *validator) { v.goFieldPaths = enabled })
+}
+
+// GoSegment is the Go origin of one instance [Segment]: the struct field,
+// map entry, or slice or array element whose JSON is the member the segment
+// addresses. See [WithGoFieldPaths].
+type GoSegment struct {
+	// Value is the Go value the member was produced from.
+	Value reflect.Value
+
+	// Key is the map key of a map member; the zero Value otherwise.
+	Key reflect.Value
+
+	// Field is the struct field of a struct member; the zero StructField
+	// for a map or element member. For a field promoted from an embedded
+	// struct, Field.Index is the full index sequence from the enclosing
+	// struct, as [reflect.Type.FieldByName] reports it.
+	Field reflect.StructField
+
+	// Embedded holds the embedded fields a promoted Field is reached
+	// through, outermost first; nil for a field declared directly.
+	Embedded []reflect.StructField
+
+	// Segment is the instance segment this is the origin of.
+	Segment Segment
+}
+
+// String renders the segment as a Go selector or index expression: .Name
+// for a field (.Embed.Name when promoted), [i] for an element, and [key]
+// with the key in Go syntax for a map entry.
+func (s GoSegment) String() string {
+	var b strings.Builder
+
+	switch {
+	case s.Field.Name != "":
+		for _, e := range s.Embedded {
+			b.WriteString(".")
+			b.WriteString(e.Name)
+		}
+
+		b.WriteString(".")
+		b.WriteString(s.Field.Name)
+	case s.Key.IsValid():
+		b.WriteString("[")
+
+		if s.Key.Kind() == reflect.String {
+			b.WriteString(strconv.Quote(s.Key.String()))
+		} else {
+			fmt.Fprintf(&b, "%v", s.Key)
+		}
+
+		b.WriteString("]")
+	default:
+		b.WriteString("[")
+		b.WriteString(strconv.Itoa(s.Segment.Index))
+		b.WriteString("]")
+	}
+
+	return b.String()
+}
+
+// goPathResolver maps instance locations in a failure back to the Go value
+// [Validator.ValidateValue] walked, memoizing by instance path since sibling
+// errors share their prefixes.
+type goPathResolver struct {
+	root   reflect.Value
+	naming *fieldname.Dialect
+	name   string
+	memo   map[string][]GoSegment
+}
+
+func newGoPathResolver(v any, naming *fieldname.Dialect) *goPathResolver {
+	root := reflect.ValueOf(v)
+
+	var name string
+
+	if root.IsValid() {
+		t := root.Type()
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+
+		name = t.Name()
+		if name == "" {
+			name = t.String()
+		}
+	}
+
+	return &goPathResolver{root: root, naming: naming, name: name, memo: map[string][]GoSegment{}}
+}
+
+// annotate attaches the Go origin to e and every error beneath it. The seen
+// set mirrors [ValidationError.Error]'s guard against shared nodes.
+func (r *goPathResolver) annotate(e *ValidationError, seen map[*ValidationError]bool) {
+	if e == nil || seen[e] {
+		return
+	}
+
+	seen[e] = true
+	e.goRoot = r.name
+	e.goSegs = r.resolve(e.segments)
+
+	for _, c := range e.Causes {
+		r.annotate(c, seen)
+	}
+}
+
+// resolve returns the Go origins of segs, stopping at the first segment
+// with none: one inside a MarshalJSON or MarshalText method's output.
+func (r *goPathResolver) resolve(segs []Segment) []GoSegment {
+	if len(segs) == 0 {
+		return []GoSegment{}
+	}
+
+	path := segmentsPointer(segs)
+	if out, ok := r.memo[path]; ok {
+		return out
+	}
+
+	parent := r.resolve(segs[:len(segs)-1])
+	out := parent
+
+	if len(parent) == len(segs)-1 {
+		v := r.root
+		if len(parent) > 0 {
+			v = parent[len(parent)-1].Value
+		}
+
+		seg := segs[len(segs)-1]
+		if m, ok := jsonvalue.Lookup(r.naming, v, seg.Key, seg.Index, seg.IsIndex); ok {
+			out = append(parent[:len(parent):len(parent)], GoSegment{
+				Value:    m.Value,
+				Key:      m.Key,
+				Field:    m.Field,
+				Embedded: m.Embedded,
+				Segment:  seg,
+			})
+		}
+	}
+
+	r.memo[path] = out
+
+	return out
+}
@@ -0,0 +1,177 @@
+package jsonschema_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+type goPathTLS struct {
+	CertFile string `json:"certFile" jsonschema:"minLength=1"`
+}
+
+type goPathServer struct {
+	TLS  *goPathTLS `json:"tls"`
+	Host string     `json:"host" jsonschema:"minLength=1"`
+}
+
+type GoPathBase struct {
+	Name string `json:"name" jsonschema:"minLength=1"`
+}
+
+type goPathListener struct {
+	Labels map[string]string `json:"labels"`
+	Ports  map[int]int       `json:"ports"`
+}
+
+type goPathConfig struct {
+	*GoPathBase
+
+	Server    goPathServer     `json:"server"`
+	Listeners []goPathListener `json:"listeners"`
+}
+
+func TestWithGoFieldPaths(t *testing.T) {
+	t.Parallel()
+
+	schema := jsonschema.MustGenerateFor[goPathConfig]()
+	schema.Properties["listeners"] = &jsonschema.Schema{
+		Type: "array",
+		Items: &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"labels": {AdditionalProperties: &jsonschema.Schema{MinLength: new(2)}},
+				"ports":  {AdditionalProperties: &jsonschema.Schema{Minimum: new(1.0)}},
+			},
+		},
+	}
+
+	v := jsonschema.MustCompile(schema, jsonschema.WithGoFieldPaths(true))
+
+	cfg := goPathConfig{
+		GoPathBase: &GoPathBase{},
+		Server:     goPathServer{TLS: &goPathTLS{}},
+		Listeners: []goPathListener{
+			{},
+			{Labels: map[string]string{"env": "x"}, Ports: map[int]int{8080: 0}},
+		},
+	}
+
+	for name, val := range map[string]any{"value": cfg, "pointer": &cfg} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := v.ValidateValue(t.Context(), val)
+			require.Error(t, err)
+
+			ve, ok := errors.AsType[*jsonschema.ValidationError](err)
+			require.True(t, ok)
+
+			var paths []string
+			for _, leaf := range ve.Leaves() {
+				assert.Len(t, leaf.GoSegments(), len(leaf.InstanceSegments()), leaf.InstancePath)
+
+				paths = append(paths, leaf.GoFieldPath())
+			}
+
+			slices.Sort(paths)
+			assert.Equal(t, []string{
+				"goPathConfig.GoPathBase.Name",
+				`goPathConfig.Listeners[1].Labels["env"]`,
+				"goPathConfig.Listeners[1].Ports[8080]",
+				"goPathConfig.Server.Host",
+				"goPathConfig.Server.TLS", // the nullable pointer's null branch
+				"goPathConfig.Server.TLS.CertFile",
+			}, paths)
+		})
+	}
+}
+
+func TestWithGoFieldPaths_Segments(t *testing.T) {
+	t.Parallel()
+
+	v := jsonschema.MustCompile(jsonschema.MustGenerateFor[goPathConfig](), jsonschema.WithGoFieldPaths(true))
+
+	err := v.ValidateValue(t.Context(), goPathConfig{GoPathBase: &GoPathBase{Name: "n"}, Server: goPathServer{Host: "h", TLS: &goPathTLS{}}})
+	ve, ok := errors.AsType[*jsonschema.ValidationError](err)
+	require.True(t, ok)
+
+	leaves := ve.Leaves()
+	i := slices.IndexFunc(leaves, func(e *jsonschema.ValidationError) bool { return e.Keyword == "minLength" })
+	require.GreaterOrEqual(t, i, 0)
+
+	segs := leaves[i].GoSegments()
+	require.Len(t, segs, 3)
+	assert.Equal(t, "Server", segs[0].Field.Name)
+	assert.Equal(t, "TLS", segs[1].Field.Name)
+	assert.Equal(t, "CertFile", segs[2].Field.Name)
+	assert.Equal(t, `json:"certFile" jsonschema:"minLength=1"`, string(segs[2].Field.Tag))
+	assert.Equal(t, "certFile", segs[2].Segment.Key)
+	assert.Empty(t, segs[2].Value.String())
+}
+
+func TestWithGoFieldPaths_Promoted(t *testing.T) {
+	t.Parallel()
+
+	v := jsonschema.MustCompile(jsonschema.MustGenerateFor[goPathConfig](), jsonschema.WithGoFieldPaths(true))
+
+	err := v.ValidateValue(t.Context(), &goPathConfig{GoPathBase: &GoPathBase{}, Server: goPathServer{Host: "h", TLS: &goPathTLS{CertFile: "c"}}})
+	ve, ok := errors.AsType[*jsonschema.ValidationError](err)
+	require.True(t, ok)
+
+	leaves := ve.Leaves()
+	require.Len(t, leaves, 1)
+
+	segs := leaves[0].GoSegments()
+	require.Len(t, segs, 1)
+	assert.Equal(t, "Name", segs[0].Field.Name)
+	assert.Equal(t, []int{0, 0}, segs[0].Field.Index)
+	require.Len(t, segs[0].Embedded, 1)
+	assert.Equal(t, "GoPathBase", segs[0].Embedded[0].Name)
+}
+
+// goPathMarshaled marshals to an object whose members have no Go field.
+type goPathMarshaled struct{}
+
+func (goPathMarshaled) MarshalJSON() ([]byte, error) { return []byte(`{"n":0}`), nil }
+
+func TestWithGoFieldPaths_StopsAtMarshaler(t *testing.T) {
+	t.Parallel()
+
+	type wrapper struct {
+		M goPathMarshaled `json:"m"`
+	}
+
+	v := jsonschema.MustCompileJSON([]byte(`{"properties":{"m":{"properties":{"n":{"minimum":1}}}}}`),
+		jsonschema.WithGoFieldPaths(true))
+
+	err := v.ValidateValue(t.Context(), wrapper{})
+	ve, ok := errors.AsType[*jsonschema.ValidationError](err)
+	require.True(t, ok)
+
+	leaves := ve.Leaves()
+	require.Len(t, leaves, 1)
+	assert.Equal(t, "/m/n", leaves[0].InstancePath)
+	assert.Len(t, leaves[0].GoSegments(), 1)
+	assert.Equal(t, "wrapper.M", leaves[0].GoFieldPath())
+}
+
+func TestWithGoFieldPaths_Disabled(t *testing.T) {
+	t.Parallel()
+
+	v := jsonschema.MustCompile(jsonschema.MustGenerateFor[goPathTLS]())
+
+	err := v.ValidateValue(t.Context(), goPathTLS{})
+	ve, ok := errors.AsType[*jsonschema.ValidationError](err)
+	require.True(t, ok)
+
+	for _, leaf := range ve.Leaves() {
+		assert.Nil(t, leaf.GoSegments())
+		assert.Empty(t, leaf.GoFieldPath())
+	}
+}
@@ -2,11 +2,13 @@ package jsonschema
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
 	"strings"
 
+	"go.jacobcolvin.com/x/jsonschema/internal/fieldname"
 	"go.jacobcolvin.com/x/jsonschema/internal/jsontag"
 	"go.jacobcolvin.com/x/jsonschema/internal/numkind"
 	"go.jacobcolvin.com/x/jsonschema/internal/reflectkind"
@@ -207,9 +209,10 @@ func (f TypeSchemaExtenderFunc) ExtendSchemaForType(ctx context.Context, tc Type
 // constructs the built-in provider, which extracts Go doc comments by
 // loading and parsing package sources at generation time. Any other
 // implementation substitutes another source, such as comments pre-extracted
-// at build time and shipped with a binary that deploys without source files,
-// or fixed descriptions in tests. [ChainDescriptionProviders] composes
-// providers; the first non-empty description wins.
+// at build time and shipped with a binary that deploys without source files
+// (a [CommentTable]), or fixed descriptions in tests.
+// [ChainDescriptionProviders] composes providers; the first non-empty
+// description wins.
 //
 // An empty result leaves the description unset, letting later field-level
 // processing (the jsonschema struct tag, tag interpreters) supply one. A
@@ -382,6 +385,29 @@ func (f TagInterpreterFunc) Interpret(ctx context.Context, field FieldContext, t
 	return f(ctx, field, tag)
 }
 
+// FieldInterpreter declares constraints for every struct field, from a source
+// other than a struct tag: doc comment markers, an external annotation file,
+// a naming convention. It is the tag-independent counterpart of
+// [TagInterpreter], registered with [WithFieldInterpreter], and contributes
+// the same way, through [FieldContext.Constraints] and the field's authored
+// canvas, so its bounds intersect with the Go kind's and with every tag's.
+// Field interpreters run after the tag interpreters, in registration order.
+type FieldInterpreter interface {
+	// InterpretField declares the field's constraints. An interpreter with
+	// nothing to say about the field returns nil. The context follows the
+	// [TypeSchemaProvider.SchemaForType] contract.
+	InterpretField(ctx context.Context, field FieldContext) error
+}
+
+// FieldInterpreterFunc adapts a bare interpreting function to a
+// [FieldInterpreter], following [net/http.HandlerFunc].
+type FieldInterpreterFunc func(ctx context.Context, field FieldContext) error
+
+// InterpretField calls f.
+func (f FieldInterpreterFunc) InterpretField(ctx context.Context, field FieldContext) error {
+	return f(ctx, field)
+}
+
 // FormatValidator checks string instances against one format during
 // validation. It is registered with [WithFormatValidator] under the format
 // name it checks, following [net/http.Handle]'s name-at-registration shape,
@@ -412,6 +438,80 @@ func (f FormatValidatorFunc) ValidateFormat(ctx context.Context, name, value str
 	return f(ctx, name, value)
 }
 
+// CustomKeyword implements a keyword outside the built-in set. It is
+// registered with [WithKeyword] under the keyword name and the URI of the
+// vocabulary that owns it, so the same $vocabulary rules that gate the
+// standard keyword groups decide whether it applies. [CustomKeywordFunc]
+// adapts a bare function.
+//
+// Compilation and evaluation are split the way the built-in keywords split
+// them: CompileKeyword runs once per schema node carrying the keyword, and
+// the [KeywordEvaluator] it returns runs for every instance that node
+// validates. Implementations must be safe for concurrent use when the
+// compiled [Validator] is shared.
+type CustomKeyword interface {
+	// CompileKeyword prepares the keyword for one schema node, given the
+	// keyword's raw JSON value (read from the node's Extra map). It returns
+	// the evaluator that asserts the keyword, or a nil evaluator when the
+	// value asserts nothing. An error rejects the value and fails [Compile]
+	// with an error wrapping [ErrInvalidKeyword].
+	//
+	// The context comes from [Compile] (the Must* forms pass
+	// [context.Background]), or from the validation run for a node reached
+	// only at validation time, such as a fetched remote document.
+	CompileKeyword(ctx context.Context, kc KeywordCompileContext) (KeywordEvaluator, error)
+}
+
+// CustomKeywordFunc adapts a bare compile function to a [CustomKeyword],
+// following [net/http.HandlerFunc].
+type CustomKeywordFunc func(ctx context.Context, kc KeywordCompileContext) (KeywordEvaluator, error)
+
+// CompileKeyword calls f.
+func (f CustomKeywordFunc) CompileKeyword(ctx context.Context, kc KeywordCompileContext) (KeywordEvaluator, error) {
+	return f(ctx, kc)
+}
+
+// KeywordCompileContext describes one schema node carrying a custom keyword
+// to [CustomKeyword.CompileKeyword].
+type KeywordCompileContext struct {
+	// Schema is the node carrying the keyword. Treat it as read-only.
+	Schema *Schema
+
+	// Name is the keyword name the implementation was registered under, so
+	// one implementation can serve several names.
+	Name string
+
+	// Value is the keyword's raw JSON value.
+	Value json.RawMessage
+
+	// Draft is the draft the schema is compiled under.
+	Draft Draft
+}
+
+// KeywordEvaluator asserts a compiled custom keyword against one instance
+// node. [KeywordEvaluatorFunc] adapts a bare function.
+type KeywordEvaluator interface {
+	// EvaluateKeyword returns nil when the instance satisfies the keyword.
+	// A failure is reported as a [*ValidationError] built with
+	// [KeywordContext.Error], which carries the instance and schema paths the
+	// built-in keywords report; any other error becomes such an error, with
+	// the returned error's text as the message and the error itself
+	// reachable through [errors.Is] and [errors.As] on the validation result.
+	//
+	// The context comes from the validation entry point in effect, as for
+	// [FormatValidator.ValidateFormat].
+	EvaluateKeyword(ctx context.Context, kc *KeywordContext) error
+}
+
+// KeywordEvaluatorFunc adapts a bare evaluating function to a
+// [KeywordEvaluator], following [net/http.HandlerFunc].
+type KeywordEvaluatorFunc func(ctx context.Context, kc *KeywordContext) error
+
+// EvaluateKeyword calls f.
+func (f KeywordEvaluatorFunc) EvaluateKeyword(ctx context.Context, kc *KeywordContext) error {
+	return f(ctx, kc)
+}
+
 // RefResolver resolves remote schema URIs during validation. The resolver
 // is called only when local resolution fails to find a target. Every
 // outcome is cached for the duration of the validation run: a resolved
@@ -651,6 +751,12 @@ type FieldContext struct {
 	// non-nil for a context the generator builds and nil for one a caller
 	// constructs. It backs the element accessor ([FieldContext.ElementContexts]).
 	node *node
+	// The naming field is the [WithFieldNaming] dialect the field was resolved
+	// under; nil for encoding/json's.
+	naming *fieldname.Dialect
+	// The siblings field backs [FieldContext.Sibling]; nil outside the
+	// interpreter pass.
+	siblings func(name string) (FieldContext, bool)
 	// Name is the JSON property name for the field.
 	Name string
 	// StructField is the full reflect.StructField, so an interpreter can read
@@ -661,6 +767,62 @@ type FieldContext struct {
 	// emit draft-appropriate keywords (for example dependentRequired under
 	// [Draft2020] versus dependencies under [Draft7]).
 	Draft Draft
+	// The omittable and omitsZero fields back [FieldContext.Omittable] and
+	// [FieldContext.OmitsZero].
+	omittable, omitsZero bool
+}
+
+// Sibling returns the context of another field of the same struct, found by
+// its Go field name (a promoted field under its own name), and reports whether
+// there is one. It is what a cross-field rule reads to name the other
+// property and classify its shape; contribute to the sibling only through a
+// [FieldContext.Detached] copy, since its real canvas belongs to its own
+// interpreters. A field encoding/json drops, an allOf-composed embed,
+// and every field of an element context or a caller-built context are not
+// found; so are all fields while a [DescriptionProvider] runs, before every
+// sibling exists.
+func (fc FieldContext) Sibling(name string) (FieldContext, bool) {
+	if fc.siblings == nil {
+		return FieldContext{}, false
+	}
+
+	return fc.siblings(name)
+}
+
+// OmitsZero reports whether the marshaled object leaves the field out exactly
+// when its Go value is the zero value, so whether the property is present
+// alone says whether the field is set: omitzero on a field without an IsZero
+// method, or omitempty on a field whose empty value is its zero value (a
+// string, number, bool, pointer, or interface, but not a slice, map, array,
+// or struct). It is false for a field promoted through a pointer embed, which
+// is also left out while the embed is nil.
+func (fc FieldContext) OmitsZero() bool {
+	return fc.omitsZero
+}
+
+// Omittable reports whether the marshaled object can leave the field out at
+// all: through omitempty or omitzero, or a nil pointer embed it is promoted
+// through. A field that is not omittable is always present, and one that is
+// but does not [FieldContext.OmitsZero] is absent for some values that are not
+// zero, or present for some that are.
+func (fc FieldContext) Omittable() bool {
+	return fc.omittable
+}
+
+// Detached returns a copy of the context writing to a fresh, empty
+// [FieldContext.Canvas], for building a schema an interpreter places somewhere
+// other than the field's own canvas: a branch of a disjunction, or a test on a
+// sibling inside a condition. The copy classifies and checks conflicts exactly
+// as the field does, but has no Parent, no siblings, and no element contexts,
+// so a rule applied through it reaches nothing but the new canvas, and a rule
+// that would retarget onto elements reports instead.
+func (fc FieldContext) Detached() FieldContext {
+	fc.Canvas = &Schema{}
+	fc.Parent = nil
+	fc.node = nil
+	fc.siblings = nil
+
+	return fc
 }
 
 // ElementContexts returns a FieldContext for each element schema of a sequence
@@ -719,6 +881,32 @@ func (fc FieldContext) ElementContexts() []FieldContext {
 	}
 }
 
+// KeyContext returns a FieldContext for the keys of a map field, the schema
+// rendered as the map's propertyNames: what a key validator constrains. The
+// context carries the key's Go [FieldContext.Type], its own authored canvas,
+// and its type-derived [FieldContext.Base], which is the property name's
+// schema rather than the key's value schema: an integer key's base is a
+// string of digits, so it classifies as [FormCoercedNumber], and a string
+// key's base is empty. Like an element, it has an empty Name and a nil Parent.
+//
+// It reports false for a field that is not a map, and for a context a caller
+// builds directly, which has no backing node.
+func (fc FieldContext) KeyContext() (FieldContext, bool) {
+	if fc.node == nil || fc.node.kind != kindMap || fc.node.keys == nil {
+		return FieldContext{}, false
+	}
+
+	keys := fc.node.keys
+
+	return FieldContext{
+		Type:   numkind.DerefType(fc.Type).Key(),
+		Canvas: keys.authored,
+		Base:   keys.payload,
+		Draft:  fc.Draft,
+		node:   keys,
+	}, true
+}
+
 // Constraints returns the intersect-only contribution facade for the field: the
 // surface a tag interpreter uses to add numeric bounds, string length, container
 // counts, multipleOf, and const/enum/forbidden values through the shared
@@ -752,7 +940,7 @@ func (fc FieldContext) Shape() Shape {
 // string-override test, and tolerates the zero StructField of a caller-built
 // context.
 func (fc FieldContext) quotedString() bool {
-	if fc.Type == nil || !jsontag.Parse(fc.StructField).JSONString {
+	if fc.Type == nil || fc.naming != nil || !jsontag.Parse(fc.StructField).JSONString {
 		return false
 	}
 
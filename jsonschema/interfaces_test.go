@@ -263,3 +263,145 @@ func TestTagInterpreterFieldContextOwner(t *testing.T) {
 	assert.Equal(t, reflect.TypeFor[ownerOuter](), owners["outer"])
 	assert.Equal(t, reflect.TypeFor[ownerEmbedded](), owners["inner"])
 }
+
+type siblingEmbedded struct {
+	Inner string `json:"inner,omitempty"`
+}
+
+type siblingOuter struct {
+	*siblingEmbedded
+
+	Name  string         `json:"name,omitempty" units:"x"`
+	Items []int          `json:"items,omitempty"`
+	Set   []int          `json:"set,omitzero"`
+	Ptr   *int           `json:"ptr"`
+	Plain int            `json:"plain"`
+	Skip  string         `json:"-"`
+	Meta  map[string]int `json:"meta,omitempty"`
+}
+
+func TestFieldContextSibling(t *testing.T) {
+	t.Parallel()
+
+	type facts struct {
+		name                 string
+		omittable, omitsZero bool
+	}
+
+	got := map[string]facts{}
+
+	var missing []string
+
+	interp := jsonschema.TagInterpreterFunc(
+		func(_ context.Context, field jsonschema.FieldContext, _ jsonschema.Tag) error {
+			for _, goName := range []string{"Name", "Items", "Set", "Ptr", "Plain", "Inner", "Meta"} {
+				sib, ok := field.Sibling(goName)
+				if !ok {
+					return fmt.Errorf("no sibling %s", goName)
+				}
+
+				got[goName] = facts{sib.Name, sib.Omittable(), sib.OmitsZero()}
+			}
+
+			for _, goName := range []string{"Skip", "siblingEmbedded", "Missing"} {
+				if _, ok := field.Sibling(goName); !ok {
+					missing = append(missing, goName)
+				}
+			}
+
+			return nil
+		},
+	)
+
+	_, err := jsonschema.GenerateFor[siblingOuter](t.Context(), jsonschema.WithTagInterpreter("units", interp))
+	require.NoError(t, err)
+
+	// Omitempty omits a nil and an empty slice or map alike, and a promoted
+	// field is also omitted while its pointer embed is nil, so neither says
+	// exactly whether the value is zero.
+	assert.Equal(t, map[string]facts{
+		"Name":  {"name", true, true},
+		"Items": {"items", true, false},
+		"Set":   {"set", true, true},
+		"Ptr":   {"ptr", false, false},
+		"Plain": {"plain", false, false},
+		"Inner": {"inner", true, false},
+		"Meta":  {"meta", true, false},
+	}, got)
+	assert.Equal(t, []string{"Skip", "siblingEmbedded", "Missing"}, missing)
+
+	// A context outside the interpreter pass has no siblings.
+	_, ok := jsonschema.FieldContext{}.Sibling("Name")
+	assert.False(t, ok)
+}
+
+func TestFieldContextDetached(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Tags []string `json:"tags" units:"x"`
+	}
+
+	interp := jsonschema.TagInterpreterFunc(
+		func(_ context.Context, field jsonschema.FieldContext, _ jsonschema.Tag) error {
+			detached := field.Detached()
+
+			// The copy classifies as the field does but reaches only its own
+			// canvas: no parent, no elements, so an element rule reports.
+			assert.Equal(t, field.Shape(), detached.Shape())
+			assert.NotSame(t, field.Canvas, detached.Canvas)
+			assert.Nil(t, detached.Parent)
+			assert.Empty(t, detached.ElementContexts())
+			require.NoError(t, detached.Constraints().Apply(jsonschema.OpFloorIncl, jsonschema.AxisAuto, "2"))
+			require.Error(t, detached.Constraints().Apply(jsonschema.OpOneOf, jsonschema.AxisAuto, "a", "b"))
+
+			assert.Equal(t, new(2), detached.Canvas.MinItems)
+			assert.Nil(t, field.Canvas.MinItems)
+
+			return nil
+		},
+	)
+
+	s, err := jsonschema.GenerateFor[config](t.Context(), jsonschema.WithTagInterpreter("units", interp))
+	require.NoError(t, err)
+	assert.Nil(t, s.Properties["tags"].Items.Enum)
+}
+
+func TestFieldContextKeyContext(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Ports map[uint16]string `json:"ports" units:"x"`
+		Tags  []string          `json:"tags"  units:"x"`
+	}
+
+	interp := jsonschema.TagInterpreterFunc(
+		func(_ context.Context, field jsonschema.FieldContext, _ jsonschema.Tag) error {
+			keys, ok := field.KeyContext()
+			if field.Name == "tags" {
+				assert.False(t, ok)
+
+				return nil
+			}
+
+			// The key's base is its property name, a string of digits, so
+			// the context classifies as a coerced number.
+			require.True(t, ok)
+			assert.Equal(t, reflect.TypeFor[uint16](), keys.Type)
+			assert.Equal(t, jsonschema.FormCoercedNumber, keys.Shape().Form)
+
+			return keys.Constraints().Apply(jsonschema.OpOneOf, jsonschema.AxisAuto, "80", "443")
+		},
+	)
+
+	s, err := jsonschema.GenerateFor[config](t.Context(), jsonschema.WithTagInterpreter("units", interp))
+	require.NoError(t, err)
+
+	names := s.Properties["ports"].PropertyNames
+	require.NotNil(t, names)
+	assert.Equal(t, "^[0-9]+$", names.Pattern)
+	assert.Equal(t, []any{"80", "443"}, names.Enum)
+
+	_, ok := jsonschema.FieldContext{}.KeyContext()
+	assert.False(t, ok)
+}
@@ -4,9 +4,10 @@
 //
 // A Set is the JSON Schema 2020-12 annotation collection (core section 10.x):
 // the set of evaluated property names, the set of matched item indexes, the
-// prefix/items watermark, and the two "all evaluated" saturation flags. The
-// merge rule (union the sets, OR the flags, take the larger watermark) lives
-// here; the policy of WHEN a subschema's annotations roll up into its parent --
+// prefix/items watermark, the two "all evaluated" saturation flags, and the
+// values custom keywords record under their own names. The merge rule (union
+// the sets, OR the flags, take the larger watermark, the merged-in value wins
+// per keyword) lives here; the policy of WHEN a subschema's annotations roll up into its parent --
 // allOf only on whole-allOf success, anyOf per matching branch, oneOf the
 // single match, not and a failed if/then/else contributing nothing -- stays
 // with the validator that orchestrates the walk.
@@ -22,6 +23,7 @@ package annotations
 type Set struct {
 	properties    map[string]bool
 	itemIndexes   map[int]bool
+	values        map[string]any
 	itemsEnd      int
 	allProperties bool
 	allItems      bool
@@ -49,8 +51,8 @@ func (s *Set) Child() *Set {
 }
 
 // Merge folds other's evaluations into s: the union of evaluated properties and
-// matched item indexes, the larger items watermark, and the OR of the
-// saturation flags. A nil s or nil other is a no-op, so an untracked parent or
+// matched item indexes, the larger items watermark, the OR of the saturation
+// flags, and other's keyword values over s's. A nil s or nil other is a no-op, so an untracked parent or
 // an un-collected child contributes nothing.
 func (s *Set) Merge(other *Set) {
 	if s == nil || other == nil {
@@ -76,6 +78,10 @@ func (s *Set) Merge(other *Set) {
 	if other.allItems {
 		s.allItems = true
 	}
+
+	for k, v := range other.values {
+		s.SetValue(k, v)
+	}
 }
 
 // RecordProperty marks the property name as evaluated.
@@ -164,3 +170,31 @@ func (s *Set) AllItemsSet() bool {
 
 	return s.allItems
 }
+
+// SetValue records value as the annotation the keyword named kw produced,
+// replacing any earlier value for kw. The built-in keywords record only the
+// evaluation bookkeeping above; values are the channel custom keywords use to
+// expose results to their siblings.
+func (s *Set) SetValue(kw string, value any) {
+	if s == nil {
+		return
+	}
+
+	if s.values == nil {
+		s.values = map[string]any{}
+	}
+
+	s.values[kw] = value
+}
+
+// Value returns the annotation recorded for the keyword named kw and whether
+// one was recorded.
+func (s *Set) Value(kw string) (any, bool) {
+	if s == nil {
+		return nil, false
+	}
+
+	v, ok := s.values[kw]
+
+	return v, ok
+}
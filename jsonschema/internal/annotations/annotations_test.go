@@ -111,3 +111,34 @@ func TestNilSetReadsEmptyIgnoresWrites(t *testing.T) {
 		s.ExtendItems(3)
 	})
 }
+
+func TestValues(t *testing.T) {
+	t.Parallel()
+
+	dst := annotations.New()
+	dst.SetValue("x-a", 1)
+	dst.SetValue("x-b", "old")
+
+	src := annotations.New()
+	src.SetValue("x-b", "new")
+
+	dst.Merge(src)
+
+	got, ok := dst.Value("x-a")
+	require.True(t, ok)
+	assert.Equal(t, 1, got)
+
+	got, ok = dst.Value("x-b")
+	require.True(t, ok)
+	assert.Equal(t, "new", got, "the merged-in value wins")
+
+	_, ok = dst.Value("x-missing")
+	assert.False(t, ok)
+
+	var nilSet *annotations.Set
+
+	nilSet.SetValue("x-a", 1) // nil receiver ignores writes
+
+	_, ok = nilSet.Value("x-a")
+	assert.False(t, ok)
+}
@@ -0,0 +1,236 @@
+package codegentest_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/codegentest"
+	"go.jacobcolvin.com/x/jsonschema/internal/codegentest/rigs"
+	"go.jacobcolvin.com/x/jsonschema/internal/codegentest/spec"
+	"go.jacobcolvin.com/x/jsonschema/internal/fuzzfill"
+)
+
+const testdata = "../../testdata"
+
+// TestGeneratedFilesFresh fails when the checked-in generated files differ
+// from what the current generator writes; run go generate to refresh them.
+func TestGeneratedFilesFresh(t *testing.T) {
+	t.Parallel()
+
+	files, err := spec.Files(t.Context(), testdata)
+	require.NoError(t, err)
+
+	for name, want := range files {
+		got, err := os.ReadFile(name)
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(want, got), "%s is stale; run go generate", name)
+	}
+}
+
+// suiteGroup is a suite group with its generated and runtime validators.
+type suiteGroup struct {
+	validator *jsonschema.Validator
+	generated func(any) error
+	spec.Group
+}
+
+// loadSuite returns every suite group that has a generated validator, keyed
+// by group key.
+func loadSuite(tb testing.TB) map[string]suiteGroup {
+	tb.Helper()
+
+	suite, err := spec.Load(testdata)
+	require.NoError(tb, err)
+
+	out := map[string]suiteGroup{}
+
+	for _, set := range spec.Sets {
+		groups, err := suite.Groups(set)
+		require.NoError(tb, err)
+
+		for _, g := range groups {
+			fn, ok := codegentest.Suite[g.Key]
+			if !ok {
+				continue
+			}
+
+			v, err := jsonschema.Compile(context.Background(), g.Schema, g.Opts...)
+			require.NoError(tb, err, g.Key)
+
+			out[g.Key] = suiteGroup{Group: g, validator: v, generated: fn}
+		}
+	}
+
+	require.NotEmpty(tb, out)
+
+	return out
+}
+
+// decode decodes a JSON instance as [jsonschema.Validator.ValidateJSON]
+// does, numbers as [json.Number].
+func decode(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var x any
+
+	err := dec.Decode(&x)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Test helper.
+	}
+
+	return x, nil
+}
+
+func TestSuiteDifferential(t *testing.T) {
+	t.Parallel()
+
+	for key, g := range loadSuite(t) {
+		t.Run(key, func(t *testing.T) {
+			t.Parallel()
+
+			for _, tc := range g.Tests {
+				x, err := decode(tc.Data)
+				require.NoError(t, err, tc.Description)
+
+				want := g.validator.Validate(t.Context(), x)
+				got := g.generated(x)
+				assertSameError(t, want, got, tc.Description)
+			}
+		})
+	}
+}
+
+func FuzzSuiteDifferential(f *testing.F) {
+	groups := loadSuite(f)
+
+	for key, g := range groups {
+		for _, tc := range g.Tests {
+			f.Add(key, []byte(tc.Data))
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, key string, data []byte) {
+		g, ok := groups[key]
+		if !ok {
+			return
+		}
+
+		x, err := decode(data)
+		if err != nil {
+			return
+		}
+
+		assertSameError(t, g.validator.Validate(t.Context(), x), g.generated(x), string(data))
+	})
+}
+
+func FuzzRigPlain(f *testing.F) { fuzzRig(f, "RigPlain", codegentest.RigPlainValue) }
+
+func FuzzRigOmit(f *testing.F) { fuzzRig(f, "RigOmit", codegentest.RigOmitValue) }
+
+func FuzzRigOmitTime(f *testing.F) { fuzzRig(f, "RigOmitTime", codegentest.RigOmitTimeValue) }
+
+func FuzzRigTree(f *testing.F) { fuzzRig(f, "RigTree", codegentest.RigTreeValue) }
+
+func FuzzRigMarshalers(f *testing.F) { fuzzRig(f, "RigMarshalers", codegentest.RigMarshalersValue) }
+
+func FuzzRigMarshalersFormats(f *testing.F) {
+	fuzzRig(f, "RigMarshalersFormats", codegentest.RigMarshalersFormatsValue)
+}
+
+func FuzzRigEmbedded(f *testing.F) { fuzzRig(f, "RigEmbedded", codegentest.RigEmbeddedValue) }
+
+func FuzzRigMaps(f *testing.F) { fuzzRig(f, "RigMaps", codegentest.RigMapsValue) }
+
+// TestRigCycle checks that a pointer cycle fails conversion as it fails
+// marshaling, rather than recursing without bound.
+func TestRigCycle(t *testing.T) {
+	t.Parallel()
+
+	tree := &rigs.Tree{Label: "a"}
+	tree.Children = []*rigs.Tree{tree}
+
+	require.Error(t, codegentest.RigTreeValue(tree))
+}
+
+// fuzzRig is the shared body of the typed rig targets: for each blob it fills
+// a T and compares the generated typed entry point with
+// [jsonschema.Validator.ValidateValue] on the same value.
+func fuzzRig[T any](f *testing.F, name string, generated func(*T) error) {
+	f.Helper()
+
+	var rig spec.Rig
+
+	for _, r := range spec.Rigs {
+		if r.Name == name {
+			rig = r
+		}
+	}
+
+	v, err := spec.RigValidator(context.Background(), rig)
+	require.NoError(f, err)
+
+	f.Add([]byte{})
+	f.Add(make([]byte, 64))
+	f.Add(bytes.Repeat([]byte{0x01}, 64))
+	f.Add(bytes.Repeat([]byte{0x7f}, 128))
+	f.Add(bytes.Repeat([]byte{0xff}, 64))
+	f.Add([]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0xfe, 0xdc, 0xba, 0x98, 0x76, 0x54, 0x32, 0x10})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var val T
+
+		fuzzfill.Fill(reflect.ValueOf(&val), data)
+
+		assertSameError(t, v.ValidateValue(t.Context(), &val), generated(&val), val)
+	})
+}
+
+// assertSameError asserts the generated validator reported the same result
+// as the runtime one: the same validation error tree, or a non-validation
+// error with the same message.
+func assertSameError(t *testing.T, want, got error, instance any) {
+	t.Helper()
+
+	if want == nil || got == nil {
+		require.Equal(t, want == nil, got == nil, "instance %v:\nwant %v\ngot  %v", instance, want, got)
+
+		return
+	}
+
+	wantVE, wantOK := errors.AsType[*jsonschema.ValidationError](want)
+	gotVE, gotOK := errors.AsType[*jsonschema.ValidationError](got)
+
+	require.Equal(t, wantOK, gotOK, "instance %v:\nwant %v\ngot  %v", instance, want, got)
+	require.Equal(t, want.Error(), got.Error(), "instance %v", instance)
+
+	if wantOK {
+		assertSameTree(t, wantVE, gotVE, instance)
+	}
+}
+
+func assertSameTree(t *testing.T, want, got *jsonschema.ValidationError, instance any) {
+	t.Helper()
+
+	require.Equal(t, want.InstancePath, got.InstancePath, "instance %v", instance)
+	require.Equal(t, want.SchemaPath, got.SchemaPath, "instance %v", instance)
+	require.Equal(t, want.Keyword, got.Keyword, "instance %v at %s", instance, want.SchemaPath)
+	require.Equal(t, want.Message, got.Message, "instance %v at %s", instance, want.SchemaPath)
+	require.Equal(t, want.InstanceSegments(), got.InstanceSegments(), "instance %v at %s", instance, want.SchemaPath)
+	require.Equal(t, want.SchemaSegments(), got.SchemaSegments(), "instance %v at %s", instance, want.SchemaPath)
+	require.Len(t, got.Causes, len(want.Causes), "instance %v at %s", instance, want.SchemaPath)
+
+	for i := range want.Causes {
+		assertSameTree(t, want.Causes[i], got.Causes[i], instance)
+	}
+}
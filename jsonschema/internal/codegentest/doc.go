@@ -0,0 +1,12 @@
+// Package codegentest houses the differential rig for [jsonschema.GenerateGo]:
+// validators generated from the JSON Schema Test Suite and from the typed
+// rig values in package rigs, checked in, and compared against the
+// [jsonschema.Validator] each was generated from. The comparison covers the
+// whole error tree (paths, keywords, messages, and causes), over the suite's
+// test instances and over fuzzed instances and typed values.
+//
+// The generated files are regenerated with go generate; a test fails when
+// they are stale.
+package codegentest
+
+//go:generate go run ./gen
@@ -0,0 +1,18134 @@
+// Code generated by jsonschema.GenerateGo. DO NOT EDIT.
+
+package codegentest
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/genrt"
+)
+
+var (
+	draft2020Group0Var0   = []jsonschema.Segment{{Key: "properties"}, {Key: "bar"}}
+	draft2020Group0Var1   = []jsonschema.Segment{{Key: "properties"}, {Key: "foo"}}
+	draft2020Group0Var2   = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "^v"}}
+	draft2020Group0Var3   = genrt.Pattern("^v")
+	draft2020Group0Var4   = []jsonschema.Segment{{Key: "additionalProperties"}}
+	draft2020Group0Var5   = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "^á"}}
+	draft2020Group0Var6   = genrt.Pattern("^á")
+	draft2020Group0Var7   = []jsonschema.Segment{{Key: "allOf"}, {Index: 0, IsIndex: true}}
+	draft2020Group0Var8   = []jsonschema.Segment{{Key: "propertyNames"}}
+	draft2020Group0Var9   = []jsonschema.Segment{{Key: "properties"}, {Key: "foo2"}}
+	draft2020Group0Var10  = []jsonschema.Segment{{Key: "dependentSchemas"}, {Key: "foo2"}}
+	draft2020Group0Var11  = []jsonschema.Segment{{Key: "allOf"}, {Index: 1, IsIndex: true}}
+	draft2020Group0Var12  = []jsonschema.Segment{{Key: "properties"}, {Key: "baz"}}
+	draft2020Group0Var13  = genrt.NewNumeric(nil, nil, genrt.Float(30.0), nil, nil)
+	draft2020Group0Var14  = genrt.NewNumeric(nil, genrt.Float(20.0), nil, nil, nil)
+	draft2020Group0Var15  = []jsonschema.Segment{{Key: "anyOf"}, {Index: 0, IsIndex: true}}
+	draft2020Group0Var16  = []jsonschema.Segment{{Key: "oneOf"}, {Index: 0, IsIndex: true}}
+	draft2020Group0Var17  = genrt.NewNumeric(genrt.Float(2.0), nil, nil, nil, nil)
+	draft2020Group0Var18  = genrt.NewNumeric(genrt.Float(3.0), nil, nil, nil, nil)
+	draft2020Group0Var19  = genrt.NewNumeric(genrt.Float(5.0), nil, nil, nil, nil)
+	draft2020Group0Var20  = []jsonschema.Segment{{Key: "$ref"}}
+	draft2020Group0Var21  = []jsonschema.Segment{{Key: "anyOf"}, {Index: 1, IsIndex: true}}
+	draft2020Group0Var22  = genrt.NewNumeric(nil, genrt.Float(2.0), nil, nil, nil)
+	draft2020Group0Var23  = genrt.NewConst(float64(2.0))
+	draft2020Group0Var24  = genrt.NewConst(map[string]any{"baz": "bax", "foo": "bar"})
+	draft2020Group0Var25  = genrt.NewConst([]any{map[string]any{"foo": "bar"}})
+	draft2020Group0Var26  = genrt.NewConst(nil)
+	draft2020Group0Var27  = genrt.NewConst(false)
+	draft2020Group0Var28  = genrt.NewConst(true)
+	draft2020Group0Var29  = genrt.NewConst([]any{false})
+	draft2020Group0Var30  = genrt.NewConst([]any{true})
+	draft2020Group0Var31  = genrt.NewConst(map[string]any{"a": false})
+	draft2020Group0Var32  = genrt.NewConst(map[string]any{"a": true})
+	draft2020Group0Var33  = genrt.NewConst(float64(0.0))
+	draft2020Group0Var34  = genrt.NewConst(float64(1.0))
+	draft2020Group0Var35  = genrt.NewConst(float64(-2.0))
+	draft2020Group0Var36  = genrt.NewConst(float64(9.007199254740992e+15))
+	draft2020Group0Var37  = genrt.NewConst("hello\x00there")
+	draft2020Group0Var38  = genrt.NewConst("μ")
+	draft2020Group0Var39  = genrt.NewConst("ä")
+	draft2020Group0Var40  = []jsonschema.Segment{{Key: "contains"}}
+	draft2020Group0Var41  = genrt.NewNumeric(nil, genrt.Float(5.0), nil, nil, nil)
+	draft2020Group0Var42  = genrt.NewConst(float64(5.0))
+	draft2020Group0Var43  = []jsonschema.Segment{{Key: "items"}}
+	draft2020Group0Var44  = genrt.NewNumeric(genrt.Float(2.0), nil, nil, nil, nil)
+	draft2020Group0Var45  = genrt.NewNumeric(genrt.Float(3.0), nil, nil, nil, nil)
+	draft2020Group0Var46  = []jsonschema.Segment{{Key: "properties"}, {Key: "alpha"}}
+	draft2020Group0Var47  = genrt.NewNumeric(nil, nil, genrt.Float(3.0), nil, nil)
+	draft2020Group0Var48  = []jsonschema.Segment{{Key: "dependentRequired"}, {Key: "bar"}}
+	draft2020Group0Var49  = []jsonschema.Segment{{Key: "dependentRequired"}, {Key: "quux"}}
+	draft2020Group0Var50  = []jsonschema.Segment{{Key: "dependentRequired"}, {Key: "foo\nbar"}}
+	draft2020Group0Var51  = []jsonschema.Segment{{Key: "dependentRequired"}, {Key: "foo\"bar"}}
+	draft2020Group0Var52  = []jsonschema.Segment{{Key: "dependentSchemas"}, {Key: "bar"}}
+	draft2020Group0Var53  = []jsonschema.Segment{{Key: "dependentSchemas"}, {Key: "foo\tbar"}}
+	draft2020Group0Var54  = []jsonschema.Segment{{Key: "dependentSchemas"}, {Key: "foo'bar"}}
+	draft2020Group0Var55  = []jsonschema.Segment{{Key: "dependentSchemas"}, {Key: "foo"}}
+	draft2020Group0Var56  = genrt.NewEnum([]any{float64(1.0), float64(2.0), float64(3.0)})
+	draft2020Group0Var57  = genrt.NewEnum([]any{float64(6.0), "foo", []any{}, true, map[string]any{"foo": float64(12.0)}})
+	draft2020Group0Var58  = genrt.NewEnum([]any{float64(6.0), nil})
+	draft2020Group0Var59  = genrt.NewEnum([]any{"bar"})
+	draft2020Group0Var60  = genrt.NewEnum([]any{"foo"})
+	draft2020Group0Var61  = genrt.NewEnum([]any{"foo\nbar", "foo\rbar"})
+	draft2020Group0Var62  = genrt.NewEnum([]any{false})
+	draft2020Group0Var63  = genrt.NewEnum([]any{[]any{false}})
+	draft2020Group0Var64  = genrt.NewEnum([]any{true})
+	draft2020Group0Var65  = genrt.NewEnum([]any{[]any{true}})
+	draft2020Group0Var66  = genrt.NewEnum([]any{float64(0.0)})
+	draft2020Group0Var67  = genrt.NewEnum([]any{[]any{float64(0.0)}})
+	draft2020Group0Var68  = genrt.NewEnum([]any{float64(1.0)})
+	draft2020Group0Var69  = genrt.NewEnum([]any{[]any{float64(1.0)}})
+	draft2020Group0Var70  = genrt.NewEnum([]any{"hello\x00there"})
+	draft2020Group0Var71  = genrt.NewEnum([]any{})
+	draft2020Group0Var72  = genrt.NewNumeric(nil, nil, nil, nil, genrt.Float(3.0))
+	draft2020Group0Var73  = genrt.NewNumeric(nil, nil, nil, genrt.Float(1.1), nil)
+	draft2020Group0Var74  = []jsonschema.Segment{{Key: "if"}}
+	draft2020Group0Var75  = genrt.NewConst(float64(0.0))
+	draft2020Group0Var76  = []jsonschema.Segment{{Key: "then"}}
+	draft2020Group0Var77  = genrt.NewNumeric(nil, nil, nil, nil, genrt.Float(0.0))
+	draft2020Group0Var78  = genrt.NewNumeric(nil, genrt.Float(-10.0), nil, nil, nil)
+	draft2020Group0Var79  = []jsonschema.Segment{{Key: "else"}}
+	draft2020Group0Var80  = genrt.NewNumeric(nil, nil, nil, nil, genrt.Float(0.0))
+	draft2020Group0Var81  = genrt.NewNumeric(genrt.Float(2.0), nil, nil, nil, nil)
+	draft2020Group0Var82  = genrt.NewNumeric(nil, nil, nil, nil, genrt.Float(0.0))
+	draft2020Group0Var83  = genrt.NewNumeric(nil, genrt.Float(-10.0), nil, nil, nil)
+	draft2020Group0Var84  = genrt.NewNumeric(genrt.Float(2.0), nil, nil, nil, nil)
+	draft2020Group0Var85  = []jsonschema.Segment{{Key: "allOf"}, {Index: 2, IsIndex: true}}
+	draft2020Group0Var86  = genrt.NewNumeric(nil, nil, nil, nil, genrt.Float(0.0))
+	draft2020Group0Var87  = genrt.NewConst("then")
+	draft2020Group0Var88  = genrt.NewConst("else")
+	draft2020Group0Var89  = genrt.NewConst("then")
+	draft2020Group0Var90  = genrt.NewConst("else")
+	draft2020Group0Var91  = genrt.NewConst("yes")
+	draft2020Group0Var92  = genrt.NewConst("other")
+	draft2020Group0Var93  = genrt.NewConst(float64(1.0))
+	draft2020Group0Var94  = genrt.NewConst(float64(1.0))
+	draft2020Group0Var95  = []jsonschema.Segment{{Key: "prefixItems"}, {Index: 0, IsIndex: true}}
+	draft2020Group0Var96  = []jsonschema.Segment{{Key: "prefixItems"}, {Index: 1, IsIndex: true}}
+	draft2020Group0Var97  = []jsonschema.Segment{{Key: "prefixItems"}, {Index: 2, IsIndex: true}}
+	draft2020Group0Var98  = genrt.NewNumeric(nil, genrt.Float(5.0), nil, nil, nil)
+	draft2020Group0Var99  = genrt.NewNumeric(nil, genrt.Float(3.0), nil, nil, nil)
+	draft2020Group0Var100 = genrt.NewConst(float64(1.0))
+	draft2020Group0Var101 = genrt.NewConst(float64(1.0))
+	draft2020Group0Var102 = genrt.NewConst(float64(1.0))
+	draft2020Group0Var103 = genrt.NewConst(float64(1.0))
+	draft2020Group0Var104 = genrt.NewNumeric(nil, nil, genrt.Float(3.0), nil, nil)
+	draft2020Group0Var105 = genrt.NewNumeric(nil, nil, genrt.Float(300.0), nil, nil)
+	draft2020Group0Var106 = genrt.NewConst(float64(1.0))
+	draft2020Group0Var107 = genrt.NewConst(float64(1.0))
+	draft2020Group0Var108 = genrt.NewConst(float64(1.0))
+	draft2020Group0Var109 = genrt.NewConst(float64(1.0))
+	draft2020Group0Var110 = genrt.NewConst(float64(1.0))
+	draft2020Group0Var111 = genrt.NewConst(float64(1.0))
+	draft2020Group0Var112 = genrt.NewConst(float64(1.0))
+	draft2020Group0Var113 = genrt.NewNumeric(nil, genrt.Float(1.1), nil, nil, nil)
+	draft2020Group0Var114 = genrt.NewNumeric(nil, genrt.Float(-2.0), nil, nil, nil)
+	draft2020Group0Var115 = genrt.NewNumeric(genrt.Float(2.0), nil, nil, nil, nil)
+	draft2020Group0Var116 = genrt.NewNumeric(genrt.Float(1.5), nil, nil, nil, nil)
+	draft2020Group0Var117 = genrt.NewNumeric(genrt.Float(0.0001), nil, nil, nil, nil)
+	draft2020Group0Var118 = genrt.NewNumeric(genrt.Float(0.123456789), nil, nil, nil, nil)
+	draft2020Group0Var119 = genrt.NewNumeric(genrt.Float(1e-08), nil, nil, nil, nil)
+	draft2020Group0Var120 = []jsonschema.Segment{{Key: "not"}}
+	draft2020Group0Var121 = []jsonschema.Segment{{Key: "unevaluatedProperties"}}
+	draft2020Group0Var122 = []jsonschema.Segment{{Key: "oneOf"}, {Index: 1, IsIndex: true}}
+	draft2020Group0Var123 = genrt.NewNumeric(nil, genrt.Float(2.0), nil, nil, nil)
+	draft2020Group0Var124 = []jsonschema.Segment{{Key: "oneOf"}, {Index: 2, IsIndex: true}}
+	draft2020Group0Var125 = genrt.Pattern("^a*$")
+	draft2020Group0Var126 = genrt.Pattern("a+")
+	draft2020Group0Var127 = genrt.Pattern("^\\p{Letter}+$")
+	draft2020Group0Var128 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "f.*o"}}
+	draft2020Group0Var129 = genrt.Pattern("f.*o")
+	draft2020Group0Var130 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "a*"}}
+	draft2020Group0Var131 = genrt.Pattern("a*")
+	draft2020Group0Var132 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "aaa*"}}
+	draft2020Group0Var133 = genrt.Pattern("aaa*")
+	draft2020Group0Var134 = genrt.NewNumeric(nil, nil, genrt.Float(20.0), nil, nil)
+	draft2020Group0Var135 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "X_"}}
+	draft2020Group0Var136 = genrt.Pattern("X_")
+	draft2020Group0Var137 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "[0-9]{2,}"}}
+	draft2020Group0Var138 = genrt.Pattern("[0-9]{2,}")
+	draft2020Group0Var139 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "b.*"}}
+	draft2020Group0Var140 = genrt.Pattern("b.*")
+	draft2020Group0Var141 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "f.*"}}
+	draft2020Group0Var142 = genrt.Pattern("f.*")
+	draft2020Group0Var143 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "^.*bar$"}}
+	draft2020Group0Var144 = genrt.Pattern("^.*bar$")
+	draft2020Group0Var145 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "^\\p{Letter}+$"}}
+	draft2020Group0Var146 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "f.o"}}
+	draft2020Group0Var147 = genrt.Pattern("f.o")
+	draft2020Group0Var148 = []jsonschema.Segment{{Key: "properties"}, {Key: "__proto__"}}
+	draft2020Group0Var149 = []jsonschema.Segment{{Key: "properties"}, {Key: "constructor"}}
+	draft2020Group0Var150 = []jsonschema.Segment{{Key: "properties"}, {Key: "toString"}}
+	draft2020Group0Var151 = []jsonschema.Segment{{Key: "properties"}, {Key: "length"}}
+	draft2020Group0Var152 = genrt.Pattern("^a+$")
+	draft2020Group0Var153 = genrt.NewConst("foo")
+	draft2020Group0Var154 = genrt.NewEnum([]any{"foo", "bar"})
+	draft2020Group0Var155 = []jsonschema.Segment{{Key: "properties"}, {Key: "percent"}}
+	draft2020Group0Var156 = []jsonschema.Segment{{Key: "properties"}, {Key: "slash"}}
+	draft2020Group0Var157 = []jsonschema.Segment{{Key: "properties"}, {Key: "tilde"}}
+	draft2020Group0Var158 = []jsonschema.Segment{{Key: "properties"}, {Key: "$ref"}}
+	draft2020Group0Var159 = []jsonschema.Segment{{Key: "properties"}, {Key: "meta"}}
+	draft2020Group0Var160 = []jsonschema.Segment{{Key: "properties"}, {Key: "nodes"}}
+	draft2020Group0Var161 = []jsonschema.Segment{{Key: "properties"}, {Key: "subtree"}}
+	draft2020Group0Var162 = []jsonschema.Segment{{Key: "properties"}, {Key: "value"}}
+	draft2020Group0Var163 = []jsonschema.Segment{{Key: "properties"}, {Key: "foo\"bar"}}
+	draft2020Group0Var164 = []jsonschema.Segment{{Key: "properties"}, {Key: "prop1"}}
+	draft2020Group0Var165 = genrt.NewEnum([]any{map[string]any{"$ref": "#/$defs/a_string"}})
+	draft2020Group0Var166 = genrt.NewNumeric(nil, nil, genrt.Float(10.0), nil, nil)
+	draft2020Group0Var167 = genrt.NewNumeric(nil, nil, genrt.Float(10.0), nil, nil)
+	draft2020Group0Var168 = genrt.NewNumeric(nil, genrt.Float(30.0), nil, nil, nil)
+	draft2020Group0Var169 = []jsonschema.Segment{{Key: "properties"}, {Key: "list"}}
+	draft2020Group0Var170 = []jsonschema.Segment{{Key: "properties"}, {Key: "name"}}
+	draft2020Group0Var171 = []jsonschema.Segment{{Key: "unevaluatedItems"}}
+	draft2020Group0Var172 = genrt.NewConst("foo")
+	draft2020Group0Var173 = genrt.NewConst("bar")
+	draft2020Group0Var174 = genrt.NewConst("baz")
+	draft2020Group0Var175 = genrt.NewConst("foo")
+	draft2020Group0Var176 = genrt.NewConst("bar")
+	draft2020Group0Var177 = genrt.NewConst("baz")
+	draft2020Group0Var178 = genrt.NewConst("foo")
+	draft2020Group0Var179 = genrt.NewConst("bar")
+	draft2020Group0Var180 = genrt.NewConst("foo")
+	draft2020Group0Var181 = genrt.NewConst("bar")
+	draft2020Group0Var182 = genrt.NewConst("then")
+	draft2020Group0Var183 = []jsonschema.Segment{{Key: "prefixItems"}, {Index: 3, IsIndex: true}}
+	draft2020Group0Var184 = genrt.NewConst("else")
+	draft2020Group0Var185 = genrt.NewNumeric(genrt.Float(2.0), nil, nil, nil, nil)
+	draft2020Group0Var186 = genrt.NewNumeric(genrt.Float(3.0), nil, nil, nil, nil)
+	draft2020Group0Var187 = genrt.NewNumeric(genrt.Float(5.0), nil, nil, nil, nil)
+	draft2020Group0Var188 = genrt.NewConst("a")
+	draft2020Group0Var189 = genrt.NewConst("b")
+	draft2020Group0Var190 = genrt.NewConst("c")
+	draft2020Group0Var191 = genrt.NewConst("a")
+	draft2020Group0Var192 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "^foo"}}
+	draft2020Group0Var193 = genrt.Pattern("^foo")
+	draft2020Group0Var194 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "^bar"}}
+	draft2020Group0Var195 = genrt.Pattern("^bar")
+	draft2020Group0Var196 = []jsonschema.Segment{{Key: "anyOf"}, {Index: 2, IsIndex: true}}
+	draft2020Group0Var197 = genrt.NewConst("bar")
+	draft2020Group0Var198 = genrt.NewConst("baz")
+	draft2020Group0Var199 = []jsonschema.Segment{{Key: "properties"}, {Key: "quux"}}
+	draft2020Group0Var200 = genrt.NewConst("quux")
+	draft2020Group0Var201 = genrt.NewConst("bar")
+	draft2020Group0Var202 = genrt.NewConst("baz")
+	draft2020Group0Var203 = genrt.NewConst("bar")
+	draft2020Group0Var204 = genrt.NewConst("then")
+	draft2020Group0Var205 = genrt.NewConst("then")
+	draft2020Group0Var206 = genrt.NewConst("then")
+	draft2020Group0Var207 = genrt.NewConst("bar")
+	draft2020Group0Var208 = []jsonschema.Segment{{Key: "properties"}, {Key: "faz"}}
+	draft2020Group0Var209 = []jsonschema.Segment{{Key: "properties"}, {Key: "x"}}
+	draft2020Group0Var210 = []jsonschema.Segment{{Key: "properties"}, {Key: "a"}}
+	draft2020Group0Var211 = []jsonschema.Segment{{Key: "properties"}, {Key: "b"}}
+	draft2020Group0Var212 = []jsonschema.Segment{{Key: "properties"}, {Key: "y"}}
+	draft2020Group0Var213 = []jsonschema.Segment{{Key: "oneOf"}, {Index: 3, IsIndex: true}}
+	draft2020Group0Var214 = []jsonschema.Segment{{Key: "properties"}, {Key: "c"}}
+	draft2020Group0Var215 = []jsonschema.Segment{{Key: "properties"}, {Key: "d"}}
+	draft2020Group0Var216 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "x"}}
+	draft2020Group0Var217 = genrt.Pattern("x")
+	draft2020Group0Var218 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "foo"}}
+	draft2020Group0Var219 = genrt.Pattern("foo")
+	draft2020Group0Var220 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "^bar$"}}
+	draft2020Group0Var221 = genrt.Pattern("^bar$")
+	draft2020Group0Var222 = []jsonschema.Segment{{Key: "properties"}, {Key: "badProperty"}}
+	draft2020Group0Var223 = []jsonschema.Segment{{Key: "properties"}, {Key: "numberProperty"}}
+)
+
+// Draft2020Group0 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group0(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group0Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group0Node0 validates against the schema at #.
+func draft2020Group0Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+		for _, k := range keys {
+			if !draft2020Group0Var3.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+		}
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			switch k {
+			case "bar", "foo":
+				continue
+			}
+
+			if draft2020Group0Var3.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// Draft2020Group1 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group1(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group1Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group1Node0 validates against the schema at #.
+func draft2020Group1Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		for _, k := range keys {
+			if !draft2020Group0Var6.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+		}
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			if draft2020Group0Var6.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// Draft2020Group2 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group2(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group2Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group2Node0 validates against the schema at #.
+func draft2020Group2Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			switch k {
+			case "bar", "foo":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group2Node1(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group2Node1 validates against the schema at #/additionalProperties.
+func draft2020Group2Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group3 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group3(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group3Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group3Node0 validates against the schema at #.
+func draft2020Group3Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group3Node1(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group3Node1 validates against the schema at #/additionalProperties.
+func draft2020Group3Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group4 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group4(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group4Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group4Node0 validates against the schema at #.
+func draft2020Group4Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group5 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group5(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group5Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group5Node0 validates against the schema at #.
+func draft2020Group5Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group5Node1(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group5Node2(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group5Node1 validates against the schema at #/additionalProperties.
+func draft2020Group5Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group5Node2 validates against the schema at #/allOf/0.
+func draft2020Group5Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group6 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group6(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group6Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group6Node0 validates against the schema at #.
+func draft2020Group6Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group6Node1(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group6Node1 validates against the schema at #/additionalProperties.
+func draft2020Group6Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group7 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group7(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group7Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group7Node0 validates against the schema at #.
+func draft2020Group7Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group7Node1(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+		nsp := sp.Append(draft2020Group0Var8)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := draft2020Group7Node2(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group7Node1 validates against the schema at #/additionalProperties.
+func draft2020Group7Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group7Node2 validates against the schema at #/propertyNames.
+func draft2020Group7Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 5 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 5)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group8 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group8(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group8Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group8Node0 validates against the schema at #.
+func draft2020Group8Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if _, ok := obj["foo2"]; ok {
+			ann.RecordProperty("foo2")
+		}
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			switch k {
+			case "foo2":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo2"]; ok {
+			ann1 := ann.Child()
+			if ce := draft2020Group8Node1(st, x, ip, sp.Append(draft2020Group0Var10), ann1); len(ce) > 0 {
+				errs = append(errs, ce...)
+			} else {
+				ann.Merge(ann1)
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group8Node1 validates against the schema at #/dependentSchemas/foo2.
+func draft2020Group8Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group9 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group9(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group9Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group9Node0 validates against the schema at #.
+func draft2020Group9Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group9Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group9Node3(st, x, ip, sp.Append(draft2020Group0Var11), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group9Node1 validates against the schema at #/allOf/0.
+func draft2020Group9Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group9Node2(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group9Node2 validates against the schema at #/allOf/0/properties/bar.
+func draft2020Group9Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group9Node3 validates against the schema at #/allOf/1.
+func draft2020Group9Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group9Node4(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group9Node4 validates against the schema at #/allOf/1/properties/foo.
+func draft2020Group9Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group10 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group10(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group10Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group10Node0 validates against the schema at #.
+func draft2020Group10Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group10Node1(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group10Node2(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group10Node4(st, x, ip, sp.Append(draft2020Group0Var11), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group10Node1 validates against the schema at #/properties/bar.
+func draft2020Group10Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group10Node2 validates against the schema at #/allOf/0.
+func draft2020Group10Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group10Node3(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group10Node3 validates against the schema at #/allOf/0/properties/foo.
+func draft2020Group10Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group10Node4 validates against the schema at #/allOf/1.
+func draft2020Group10Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["baz"]; ok {
+			ann.RecordProperty("baz")
+			errs = append(errs, draft2020Group10Node5(st, v, ip.Key("baz"), sp.Append(draft2020Group0Var12), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["baz"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"baz\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group10Node5 validates against the schema at #/allOf/1/properties/baz.
+func draft2020Group10Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group11 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group11(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group11Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group11Node0 validates against the schema at #.
+func draft2020Group11Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group11Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group11Node2(st, x, ip, sp.Append(draft2020Group0Var11), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group11Node1 validates against the schema at #/allOf/0.
+func draft2020Group11Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var13.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft2020Group11Node2 validates against the schema at #/allOf/1.
+func draft2020Group11Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var14.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group12 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group12(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group12Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group12Node0 validates against the schema at #.
+func draft2020Group12Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft2020Group13 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group13(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group13Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group13Node0 validates against the schema at #.
+func draft2020Group13Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	allOfErrs = append(allOfErrs, genrt.False(ip, sp.Append(draft2020Group0Var11), "")...)
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	}
+
+	return errs
+}
+
+// Draft2020Group14 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group14(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group14Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group14Node0 validates against the schema at #.
+func draft2020Group14Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	allOfErrs = append(allOfErrs, genrt.False(ip, sp.Append(draft2020Group0Var7), "")...)
+	allOfErrs = append(allOfErrs, genrt.False(ip, sp.Append(draft2020Group0Var11), "")...)
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	}
+
+	return errs
+}
+
+// Draft2020Group15 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group15(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group15Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group15Node0 validates against the schema at #.
+func draft2020Group15Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft2020Group16 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group16(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group16Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group16Node0 validates against the schema at #.
+func draft2020Group16Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft2020Group17 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group17(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group17Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group17Node0 validates against the schema at #.
+func draft2020Group17Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group17Node1(st, x, ip, sp.Append(draft2020Group0Var11), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group17Node1 validates against the schema at #/allOf/1.
+func draft2020Group17Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group18 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group18(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group18Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group18Node0 validates against the schema at #.
+func draft2020Group18Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group18Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group18Node1 validates against the schema at #/allOf/0.
+func draft2020Group18Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group19 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group19(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group19Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group19Node0 validates against the schema at #.
+func draft2020Group19Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group19Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group19Node1 validates against the schema at #/allOf/0.
+func draft2020Group19Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft2020Group19Node2(st, x, ip, sp.Append(draft2020Group0Var7), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group19Node2 validates against the schema at #/allOf/0/allOf/0.
+func draft2020Group19Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group20 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group20(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group20Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group20Node0 validates against the schema at #.
+func draft2020Group20Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group20Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft2020Group20Node2(st, x, ip, sp.Append(draft2020Group0Var15), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann3 := ann.Child()
+	if ce := draft2020Group20Node3(st, x, ip, sp.Append(draft2020Group0Var16), ann3); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann3
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft2020Group20Node1 validates against the schema at #/allOf/0.
+func draft2020Group20Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var17.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft2020Group20Node2 validates against the schema at #/anyOf/0.
+func draft2020Group20Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var18.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft2020Group20Node3 validates against the schema at #/oneOf/0.
+func draft2020Group20Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var19.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group21 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group21(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group21Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group21Node0 validates against the schema at #.
+func draft2020Group21Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group21Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group21Node1 validates against the schema at #/$ref.
+func draft2020Group21Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group22 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group22(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group22Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group22Node0 validates against the schema at #.
+func draft2020Group22Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group22Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group22Node1 validates against the schema at #/$ref.
+func draft2020Group22Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group23 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group23(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group23Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group23Node0 validates against the schema at #.
+func draft2020Group23Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group23Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group23Node1 validates against the schema at #/$ref.
+func draft2020Group23Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group24 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group24(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group24Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group24Node0 validates against the schema at #.
+func draft2020Group24Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group24Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group24Node1 validates against the schema at #/$ref.
+func draft2020Group24Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group25 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group25(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group25Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group25Node0 validates against the schema at #.
+func draft2020Group25Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group25Node1(st, x, ip, sp.Append(draft2020Group0Var15), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group25Node2(st, x, ip, sp.Append(draft2020Group0Var21), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft2020Group25Node1 validates against the schema at #/anyOf/0.
+func draft2020Group25Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group25Node2 validates against the schema at #/anyOf/1.
+func draft2020Group25Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var22.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group26 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group26(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group26Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group26Node0 validates against the schema at #.
+func draft2020Group26Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group26Node1(st, x, ip, sp.Append(draft2020Group0Var15), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group26Node2(st, x, ip, sp.Append(draft2020Group0Var21), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft2020Group26Node1 validates against the schema at #/anyOf/0.
+func draft2020Group26Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group26Node2 validates against the schema at #/anyOf/1.
+func draft2020Group26Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n < 4 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minLength", fmt.Sprintf("string length %d is less than %d", n, 4)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group27 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group27(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group27Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group27Node0 validates against the schema at #.
+func draft2020Group27Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft2020Group28 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group28(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group28Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group28Node0 validates against the schema at #.
+func draft2020Group28Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft2020Group29 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group29(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group29Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group29Node0 validates against the schema at #.
+func draft2020Group29Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	anyOfErrs = append(anyOfErrs, genrt.False(ip, sp.Append(draft2020Group0Var15), "")...)
+	anyOfErrs = append(anyOfErrs, genrt.False(ip, sp.Append(draft2020Group0Var21), "")...)
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// Draft2020Group30 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group30(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group30Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group30Node0 validates against the schema at #.
+func draft2020Group30Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group30Node1(st, x, ip, sp.Append(draft2020Group0Var15), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group30Node3(st, x, ip, sp.Append(draft2020Group0Var21), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft2020Group30Node1 validates against the schema at #/anyOf/0.
+func draft2020Group30Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group30Node2(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group30Node2 validates against the schema at #/anyOf/0/properties/bar.
+func draft2020Group30Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group30Node3 validates against the schema at #/anyOf/1.
+func draft2020Group30Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group30Node4(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group30Node4 validates against the schema at #/anyOf/1/properties/foo.
+func draft2020Group30Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group31 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group31(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group31Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group31Node0 validates against the schema at #.
+func draft2020Group31Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group31Node1(st, x, ip, sp.Append(draft2020Group0Var15), ann1)) == 0 {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group31Node1 validates against the schema at #/anyOf/0.
+func draft2020Group31Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group32 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group32(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group32Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group32Node0 validates against the schema at #.
+func draft2020Group32Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group32Node1(st, x, ip, sp.Append(draft2020Group0Var15), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft2020Group32Node1 validates against the schema at #/anyOf/0.
+func draft2020Group32Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft2020Group32Node2(st, x, ip, sp.Append(draft2020Group0Var15), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft2020Group32Node2 validates against the schema at #/anyOf/0/anyOf/0.
+func draft2020Group32Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group33 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group33(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group34 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group34(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group34Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group34Node0 validates against the schema at #.
+func draft2020Group34Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+
+	return errs
+}
+
+// Draft2020Group35 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group35(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group35Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group35Node0 validates against the schema at #.
+func draft2020Group35Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var23.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group36 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group36(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group36Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group36Node0 validates against the schema at #.
+func draft2020Group36Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var24.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group37 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group37(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group37Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group37Node0 validates against the schema at #.
+func draft2020Group37Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var25.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group38 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group38(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group38Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group38Node0 validates against the schema at #.
+func draft2020Group38Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var26.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group39 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group39(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group39Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group39Node0 validates against the schema at #.
+func draft2020Group39Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var27.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group40 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group40(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group40Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group40Node0 validates against the schema at #.
+func draft2020Group40Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var28.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group41 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group41(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group41Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group41Node0 validates against the schema at #.
+func draft2020Group41Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var29.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group42 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group42(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group42Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group42Node0 validates against the schema at #.
+func draft2020Group42Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var30.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group43 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group43(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group43Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group43Node0 validates against the schema at #.
+func draft2020Group43Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var31.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group44 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group44(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group44Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group44Node0 validates against the schema at #.
+func draft2020Group44Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var32.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group45 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group45(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group45Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group45Node0 validates against the schema at #.
+func draft2020Group45Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var33.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group46 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group46(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group46Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group46Node0 validates against the schema at #.
+func draft2020Group46Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var34.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group47 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group47(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group47Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group47Node0 validates against the schema at #.
+func draft2020Group47Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var35.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group48 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group48(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group48Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group48Node0 validates against the schema at #.
+func draft2020Group48Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var36.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group49 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group49(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group49Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group49Node0 validates against the schema at #.
+func draft2020Group49Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var37.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group50 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group50(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group50Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group50Node0 validates against the schema at #.
+func draft2020Group50Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var38.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group51 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group51(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group51Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group51Node0 validates against the schema at #.
+func draft2020Group51Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var39.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group52 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group52(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group52Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group52Node0 validates against the schema at #.
+func draft2020Group52Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group52Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group52Node1 validates against the schema at #/contains.
+func draft2020Group52Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var41.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group53 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group53(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group53Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group53Node0 validates against the schema at #.
+func draft2020Group53Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group53Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group53Node1 validates against the schema at #/contains.
+func draft2020Group53Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var42.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group54 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group54(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group54Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group54Node0 validates against the schema at #.
+func draft2020Group54Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		n := len(arr)
+		for i := range arr {
+			ann.RecordItem(i)
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group55 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group55(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group55Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group55Node0 validates against the schema at #.
+func draft2020Group55Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if _, ok := x.([]any); ok {
+		errs = append(errs, genrt.Leaf(ip, sp, "contains", "array has 0 matching items, minimum is 1"))
+	}
+
+	return errs
+}
+
+// Draft2020Group56 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group56(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group56Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group56Node0 validates against the schema at #.
+func draft2020Group56Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group56Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group56Node2(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group56Node1 validates against the schema at #/items.
+func draft2020Group56Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var44.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft2020Group56Node2 validates against the schema at #/contains.
+func draft2020Group56Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var45.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group57 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group57(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group57Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group57Node0 validates against the schema at #.
+func draft2020Group57Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group57Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group57Node1 validates against the schema at #/contains.
+func draft2020Group57Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft2020Group58 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group58(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group58Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group58Node0 validates against the schema at #.
+func draft2020Group58Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group58Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group58Node1 validates against the schema at #/contains.
+func draft2020Group58Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group59 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group59(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group60 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group60(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group61 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group61(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group62 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group62(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group63 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group63(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group63Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group63Node0 validates against the schema at #.
+func draft2020Group63Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group63Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group63Node1 validates against the schema at #/properties/foo.
+func draft2020Group63Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group64 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group64(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group64Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group64Node0 validates against the schema at #.
+func draft2020Group64Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group64Node1(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group64Node1 validates against the schema at #/properties/bar.
+func draft2020Group64Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n < 4 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minLength", fmt.Sprintf("string length %d is less than %d", n, 4)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group65 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group65(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group65Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group65Node0 validates against the schema at #.
+func draft2020Group65Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["alpha"]; ok {
+			ann.RecordProperty("alpha")
+			errs = append(errs, draft2020Group65Node1(st, v, ip.Key("alpha"), sp.Append(draft2020Group0Var46), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group65Node1 validates against the schema at #/properties/alpha.
+func draft2020Group65Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = draft2020Group0Var47.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group67 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group67(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group67Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group67Node0 validates against the schema at #.
+func draft2020Group67Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			if _, ok := obj["foo"]; !ok {
+				errs = append(errs, genrt.NewError(ip, sp.Append(draft2020Group0Var48), "dependentRequired", "property \"bar\" requires property \"foo\"", nil))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group68 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group68(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group68Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group68Node0 validates against the schema at #.
+func draft2020Group68Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft2020Group69 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group69(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group69Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group69Node0 validates against the schema at #.
+func draft2020Group69Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["quux"]; ok {
+			if _, ok := obj["foo"]; !ok {
+				errs = append(errs, genrt.NewError(ip, sp.Append(draft2020Group0Var49), "dependentRequired", "property \"quux\" requires property \"foo\"", nil))
+			}
+			if _, ok := obj["bar"]; !ok {
+				errs = append(errs, genrt.NewError(ip, sp.Append(draft2020Group0Var49), "dependentRequired", "property \"quux\" requires property \"bar\"", nil))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group70 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group70(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group70Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group70Node0 validates against the schema at #.
+func draft2020Group70Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo\nbar"]; ok {
+			if _, ok := obj["foo\rbar"]; !ok {
+				errs = append(errs, genrt.NewError(ip, sp.Append(draft2020Group0Var50), "dependentRequired", "property \"foo\\nbar\" requires property \"foo\\rbar\"", nil))
+			}
+		}
+		if _, ok := obj["foo\"bar"]; ok {
+			if _, ok := obj["foo'bar"]; !ok {
+				errs = append(errs, genrt.NewError(ip, sp.Append(draft2020Group0Var51), "dependentRequired", "property \"foo\\\"bar\" requires property \"foo'bar\"", nil))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group71 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group71(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group71Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group71Node0 validates against the schema at #.
+func draft2020Group71Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann1 := ann.Child()
+			if ce := draft2020Group71Node1(st, x, ip, sp.Append(draft2020Group0Var52), ann1); len(ce) > 0 {
+				errs = append(errs, ce...)
+			} else {
+				ann.Merge(ann1)
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group71Node1 validates against the schema at #/dependentSchemas/bar.
+func draft2020Group71Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group71Node2(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group71Node3(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group71Node2 validates against the schema at #/dependentSchemas/bar/properties/bar.
+func draft2020Group71Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group71Node3 validates against the schema at #/dependentSchemas/bar/properties/foo.
+func draft2020Group71Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group72 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group72(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group72Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group72Node0 validates against the schema at #.
+func draft2020Group72Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			errs = append(errs, genrt.False(ip, sp.Append(draft2020Group0Var52), "dependentSchemas")...)
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group73 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group73(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group73Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group73Node0 validates against the schema at #.
+func draft2020Group73Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo\tbar"]; ok {
+			ann1 := ann.Child()
+			if ce := draft2020Group73Node1(st, x, ip, sp.Append(draft2020Group0Var53), ann1); len(ce) > 0 {
+				errs = append(errs, ce...)
+			} else {
+				ann.Merge(ann1)
+			}
+		}
+		if _, ok := obj["foo'bar"]; ok {
+			ann2 := ann.Child()
+			if ce := draft2020Group73Node2(st, x, ip, sp.Append(draft2020Group0Var54), ann2); len(ce) > 0 {
+				errs = append(errs, ce...)
+			} else {
+				ann.Merge(ann2)
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group73Node1 validates against the schema at #/dependentSchemas/foo	bar.
+func draft2020Group73Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) < 4 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minProperties", fmt.Sprintf("object has %d properties, minimum is %d", len(obj), 4)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group73Node2 validates against the schema at #/dependentSchemas/foo'bar.
+func draft2020Group73Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo\"bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\\"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group74 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group74(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group74Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group74Node0 validates against the schema at #.
+func draft2020Group74Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann1 := ann.Child()
+			if ce := draft2020Group74Node1(st, x, ip, sp.Append(draft2020Group0Var55), ann1); len(ce) > 0 {
+				errs = append(errs, ce...)
+			} else {
+				ann.Merge(ann1)
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group74Node1 validates against the schema at #/dependentSchemas/foo.
+func draft2020Group74Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			switch k {
+			case "bar":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// Draft2020Group77 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group77(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group77Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group77Node0 validates against the schema at #.
+func draft2020Group77Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group77Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft2020Group77Node1 validates against the schema at #/items.
+func draft2020Group77Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group77Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group77Node2 validates against the schema at #/items/$ref.
+func draft2020Group77Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group96 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group96(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group96Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group96Node0 validates against the schema at #.
+func draft2020Group96Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var56.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group97 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group97(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group97Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group97Node0 validates against the schema at #.
+func draft2020Group97Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var57.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group98 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group98(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group98Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group98Node0 validates against the schema at #.
+func draft2020Group98Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var58.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group99 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group99(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group99Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group99Node0 validates against the schema at #.
+func draft2020Group99Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group99Node1(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group99Node2(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group99Node1 validates against the schema at #/properties/bar.
+func draft2020Group99Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var59.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// draft2020Group99Node2 validates against the schema at #/properties/foo.
+func draft2020Group99Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var60.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group100 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group100(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group100Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group100Node0 validates against the schema at #.
+func draft2020Group100Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var61.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group101 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group101(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group101Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group101Node0 validates against the schema at #.
+func draft2020Group101Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var62.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group102 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group102(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group102Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group102Node0 validates against the schema at #.
+func draft2020Group102Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var63.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group103 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group103(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group103Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group103Node0 validates against the schema at #.
+func draft2020Group103Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var64.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group104 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group104(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group104Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group104Node0 validates against the schema at #.
+func draft2020Group104Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var65.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group105 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group105(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group105Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group105Node0 validates against the schema at #.
+func draft2020Group105Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var66.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group106 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group106(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group106Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group106Node0 validates against the schema at #.
+func draft2020Group106Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var67.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group107 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group107(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group107Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group107Node0 validates against the schema at #.
+func draft2020Group107Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var68.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group108 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group108(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group108Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group108Node0 validates against the schema at #.
+func draft2020Group108Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var69.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group109 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group109(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group109Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group109Node0 validates against the schema at #.
+func draft2020Group109Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var70.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group110 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group110(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group110Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group110Node0 validates against the schema at #.
+func draft2020Group110Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var71.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group111 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group111(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group111Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group111Node0 validates against the schema at #.
+func draft2020Group111Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var72.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group112 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group112(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group112Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group112Node0 validates against the schema at #.
+func draft2020Group112Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var73.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group113 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group113(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group114 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group114(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group115 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group115(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group116 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group116(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group117 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group117(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group118 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group118(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group119 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group119(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group120 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group120(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group121 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group121(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group122 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group122(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group123 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group123(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group124 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group124(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group125 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group125(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group126 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group126(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group127 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group127(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group128 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group128(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group129 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group129(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group130 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group130(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group131 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group131(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group132 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group132(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group132Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group132Node0 validates against the schema at #.
+func draft2020Group132Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group132Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+	} else {
+	}
+
+	return errs
+}
+
+// draft2020Group132Node1 validates against the schema at #/if.
+func draft2020Group132Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var75.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group133 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group133(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group134 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group134(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group135 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group135(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group135Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group135Node0 validates against the schema at #.
+func draft2020Group135Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group135Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+		ann2 := ann.Child()
+		if ce := draft2020Group135Node2(st, x, ip, sp.Append(draft2020Group0Var76), ann2); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", ce))
+		} else {
+			ann.Merge(ann2)
+		}
+	} else {
+	}
+
+	return errs
+}
+
+// draft2020Group135Node1 validates against the schema at #/if.
+func draft2020Group135Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var77.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft2020Group135Node2 validates against the schema at #/then.
+func draft2020Group135Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var78.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group136 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group136(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group136Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group136Node0 validates against the schema at #.
+func draft2020Group136Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group136Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+	} else {
+		ann2 := ann.Child()
+		if ce := draft2020Group136Node2(st, x, ip, sp.Append(draft2020Group0Var79), ann2); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "else", "if condition was false but else validation failed", ce))
+		} else {
+			ann.Merge(ann2)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group136Node1 validates against the schema at #/if.
+func draft2020Group136Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var80.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft2020Group136Node2 validates against the schema at #/else.
+func draft2020Group136Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var81.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group137 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group137(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group137Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group137Node0 validates against the schema at #.
+func draft2020Group137Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group137Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+		ann2 := ann.Child()
+		if ce := draft2020Group137Node2(st, x, ip, sp.Append(draft2020Group0Var76), ann2); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", ce))
+		} else {
+			ann.Merge(ann2)
+		}
+	} else {
+		ann3 := ann.Child()
+		if ce := draft2020Group137Node3(st, x, ip, sp.Append(draft2020Group0Var79), ann3); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "else", "if condition was false but else validation failed", ce))
+		} else {
+			ann.Merge(ann3)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group137Node1 validates against the schema at #/if.
+func draft2020Group137Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var82.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft2020Group137Node2 validates against the schema at #/then.
+func draft2020Group137Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var83.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft2020Group137Node3 validates against the schema at #/else.
+func draft2020Group137Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var84.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group138 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group138(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group138Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group138Node0 validates against the schema at #.
+func draft2020Group138Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group138Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group138Node1 validates against the schema at #/allOf/0.
+func draft2020Group138Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if len(draft2020Group138Node2(st, x, ip, sp.Append(draft2020Group0Var74), ann2)) == 0 {
+		ann.Merge(ann2)
+
+	} else {
+	}
+
+	return errs
+}
+
+// draft2020Group138Node2 validates against the schema at #/allOf/0/if.
+func draft2020Group138Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var86.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group139 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group139(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group139Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group139Node0 validates against the schema at #.
+func draft2020Group139Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group139Node1(st, x, ip, sp.Append(draft2020Group0Var76), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group139Node1 validates against the schema at #/then.
+func draft2020Group139Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var87.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group139Node2 validates against the schema at #/else.
+func draft2020Group139Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var88.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group140 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group140(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group140Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group140Node0 validates against the schema at #.
+func draft2020Group140Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group140Node2(st, x, ip, sp.Append(draft2020Group0Var79), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "else", "if condition was false but else validation failed", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group140Node1 validates against the schema at #/then.
+func draft2020Group140Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var89.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group140Node2 validates against the schema at #/else.
+func draft2020Group140Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var90.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group141 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group141(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group141Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group141Node0 validates against the schema at #.
+func draft2020Group141Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group141Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+		ann2 := ann.Child()
+		if ce := draft2020Group141Node2(st, x, ip, sp.Append(draft2020Group0Var76), ann2); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", ce))
+		} else {
+			ann.Merge(ann2)
+		}
+	} else {
+		ann3 := ann.Child()
+		if ce := draft2020Group141Node3(st, x, ip, sp.Append(draft2020Group0Var79), ann3); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "else", "if condition was false but else validation failed", ce))
+		} else {
+			ann.Merge(ann3)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group141Node1 validates against the schema at #/if.
+func draft2020Group141Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 4 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 4)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group141Node2 validates against the schema at #/then.
+func draft2020Group141Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var91.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group141Node3 validates against the schema at #/else.
+func draft2020Group141Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var92.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group142 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group142(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group142Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group142Node0 validates against the schema at #.
+func draft2020Group142Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group142Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+		errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", genrt.False(ip, sp.Append(draft2020Group0Var76), "")))
+	} else {
+	}
+
+	return errs
+}
+
+// draft2020Group142Node1 validates against the schema at #/if.
+func draft2020Group142Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var93.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group143 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group143(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group143Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group143Node0 validates against the schema at #.
+func draft2020Group143Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group143Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+	} else {
+		errs = append(errs, genrt.Wrap(ip, sp, "else", "if condition was false but else validation failed", genrt.False(ip, sp.Append(draft2020Group0Var79), "")))
+	}
+
+	return errs
+}
+
+// draft2020Group143Node1 validates against the schema at #/if.
+func draft2020Group143Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var94.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group144 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group144(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group144Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group144Node0 validates against the schema at #.
+func draft2020Group144Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group144Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group144Node4(st, x, ip, sp.Append(draft2020Group0Var11), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group144Node1 validates against the schema at #/allOf/0.
+func draft2020Group144Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group144Node2(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group144Node2 validates against the schema at #/allOf/0/properties/foo.
+func draft2020Group144Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft2020Group144Node3(st, x, ip, sp.Append(draft2020Group0Var20), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft2020Group144Node3 validates against the schema at #/allOf/0/properties/foo/$ref.
+func draft2020Group144Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group144Node4 validates against the schema at #/allOf/1.
+func draft2020Group144Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group144Node5(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group144Node5 validates against the schema at #/allOf/1/additionalProperties.
+func draft2020Group144Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := draft2020Group144Node3(st, x, ip, sp.Append(draft2020Group0Var20), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// Draft2020Group145 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group145(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group145Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group145Node0 validates against the schema at #.
+func draft2020Group145Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group145Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft2020Group145Node1 validates against the schema at #/items.
+func draft2020Group145Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group146 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group146(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group146Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group146Node0 validates against the schema at #.
+func draft2020Group146Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if _, ok := x.([]any); ok {
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// Draft2020Group147 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group147(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group147Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group147Node0 validates against the schema at #.
+func draft2020Group147Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp1, "items")...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// Draft2020Group148 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group148(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group148Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group148Node0 validates against the schema at #.
+func draft2020Group148Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group148Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group148Node6(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		if len(arr) > 2 {
+			errs = append(errs, draft2020Group148Node7(st, arr[2], ip.Index(2), sp.Append(draft2020Group0Var97), nil)...)
+		}
+		ann.ExtendItems(min(3, len(arr)))
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 3; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp1, "items")...)
+		}
+		if len(arr) > 3 {
+			ann.SetAllItems()
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group148Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group148Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group148Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group148Node2 validates against the schema at #/prefixItems/0/$ref.
+func draft2020Group148Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group148Node3(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group148Node5(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+		rsp3 := sp.Append(draft2020Group0Var43)
+		for i := 2; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp3, "items")...)
+		}
+		if len(arr) > 2 {
+			ann.SetAllItems()
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group148Node3 validates against the schema at #/prefixItems/0/$ref/prefixItems/0.
+func draft2020Group148Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := draft2020Group148Node4(st, x, ip, sp.Append(draft2020Group0Var20), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// draft2020Group148Node4 validates against the schema at #/prefixItems/0/$ref/prefixItems/0/$ref.
+func draft2020Group148Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group148Node5 validates against the schema at #/prefixItems/0/$ref/prefixItems/1.
+func draft2020Group148Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann5 := ann.Child()
+	if ce := draft2020Group148Node4(st, x, ip, sp.Append(draft2020Group0Var20), ann5); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann5)
+	}
+
+	return errs
+}
+
+// draft2020Group148Node6 validates against the schema at #/prefixItems/1.
+func draft2020Group148Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann6 := ann.Child()
+	if ce := draft2020Group148Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann6); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann6)
+	}
+
+	return errs
+}
+
+// draft2020Group148Node7 validates against the schema at #/prefixItems/2.
+func draft2020Group148Node7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann7 := ann.Child()
+	if ce := draft2020Group148Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann7); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann7)
+	}
+
+	return errs
+}
+
+// Draft2020Group149 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group149(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group149Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group149Node0 validates against the schema at #.
+func draft2020Group149Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group149Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft2020Group149Node1 validates against the schema at #/items.
+func draft2020Group149Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp2 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group149Node2(st, arr[i], ip.Index(i), rsp2, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft2020Group149Node2 validates against the schema at #/items/items.
+func draft2020Group149Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp3 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group149Node3(st, arr[i], ip.Index(i), rsp3, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft2020Group149Node3 validates against the schema at #/items/items/items.
+func draft2020Group149Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp4 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group149Node4(st, arr[i], ip.Index(i), rsp4, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft2020Group149Node4 validates against the schema at #/items/items/items/items.
+func draft2020Group149Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group150 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group150(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group150Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group150Node0 validates against the schema at #.
+func draft2020Group150Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		ann.ExtendItems(min(3, len(arr)))
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 3; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp1, "items")...)
+		}
+		if len(arr) > 3 {
+			ann.SetAllItems()
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group151 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group151(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group151Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group151Node0 validates against the schema at #.
+func draft2020Group151Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group151Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft2020Group151Node2(st, x, ip, sp.Append(draft2020Group0Var7), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group151Node1 validates against the schema at #/items.
+func draft2020Group151Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var98.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft2020Group151Node2 validates against the schema at #/allOf/0.
+func draft2020Group151Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group151Node3(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group151Node3 validates against the schema at #/allOf/0/prefixItems/0.
+func draft2020Group151Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var99.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group152 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group152(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group152Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group152Node0 validates against the schema at #.
+func draft2020Group152Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group152Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 1; i < len(arr); i++ {
+			errs = append(errs, draft2020Group152Node2(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		if len(arr) > 1 {
+			ann.SetAllItems()
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group152Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group152Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group152Node2 validates against the schema at #/items.
+func draft2020Group152Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group153 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group153(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group153Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group153Node0 validates against the schema at #.
+func draft2020Group153Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		ann.ExtendItems(min(1, len(arr)))
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 1; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp1, "items")...)
+		}
+		if len(arr) > 1 {
+			ann.SetAllItems()
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group154 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group154(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group154Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group154Node0 validates against the schema at #.
+func draft2020Group154Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group154Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft2020Group154Node1 validates against the schema at #/items.
+func draft2020Group154Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group155 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group155(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group156 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group156(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group156Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group156Node0 validates against the schema at #.
+func draft2020Group156Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group156Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+		if n > 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxContains", fmt.Sprintf("array has %d matching items, maximum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group156Node1 validates against the schema at #/contains.
+func draft2020Group156Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var100.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group157 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group157(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group157Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group157Node0 validates against the schema at #.
+func draft2020Group157Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group157Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+		if n > 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxContains", fmt.Sprintf("array has %d matching items, maximum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group157Node1 validates against the schema at #/contains.
+func draft2020Group157Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var101.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group158 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group158(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group158Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group158Node0 validates against the schema at #.
+func draft2020Group158Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group158Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minContains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+		if n > 3 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxContains", fmt.Sprintf("array has %d matching items, maximum is %d", n, 3)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group158Node1 validates against the schema at #/contains.
+func draft2020Group158Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var102.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group159 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group159(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group159Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group159Node0 validates against the schema at #.
+func draft2020Group159Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group159Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n > 0 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxContains", fmt.Sprintf("array has %d matching items, maximum is %d", n, 0)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group159Node1 validates against the schema at #/contains.
+func draft2020Group159Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var103.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group160 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group160(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group160Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group160Node0 validates against the schema at #.
+func draft2020Group160Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxItems", fmt.Sprintf("array has %d items, maximum is %d", len(arr), 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group161 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group161(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group161Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group161Node0 validates against the schema at #.
+func draft2020Group161Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxItems", fmt.Sprintf("array has %d items, maximum is %d", len(arr), 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group162 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group162(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group162Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group162Node0 validates against the schema at #.
+func draft2020Group162Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group163 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group163(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group163Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group163Node0 validates against the schema at #.
+func draft2020Group163Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group164 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group164(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group164Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group164Node0 validates against the schema at #.
+func draft2020Group164Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxProperties", fmt.Sprintf("object has %d properties, maximum is %d", len(obj), 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group165 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group165(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group165Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group165Node0 validates against the schema at #.
+func draft2020Group165Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxProperties", fmt.Sprintf("object has %d properties, maximum is %d", len(obj), 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group166 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group166(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group166Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group166Node0 validates against the schema at #.
+func draft2020Group166Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) > 0 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxProperties", fmt.Sprintf("object has %d properties, maximum is %d", len(obj), 0)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group167 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group167(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group167Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group167Node0 validates against the schema at #.
+func draft2020Group167Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var104.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group168 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group168(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group168Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group168Node0 validates against the schema at #.
+func draft2020Group168Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var105.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group169 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group169(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group170 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group170(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group170Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group170Node0 validates against the schema at #.
+func draft2020Group170Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group170Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minContains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group170Node1 validates against the schema at #/contains.
+func draft2020Group170Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var106.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group171 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group171(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group171Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group171Node0 validates against the schema at #.
+func draft2020Group171Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group171Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minContains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group171Node1 validates against the schema at #/contains.
+func draft2020Group171Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var107.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group172 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group172(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group172Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group172Node0 validates against the schema at #.
+func draft2020Group172Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group172Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minContains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group172Node1 validates against the schema at #/contains.
+func draft2020Group172Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var108.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group173 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group173(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group173Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group173Node0 validates against the schema at #.
+func draft2020Group173Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group173Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minContains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 2)))
+		}
+		if n > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxContains", fmt.Sprintf("array has %d matching items, maximum is %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group173Node1 validates against the schema at #/contains.
+func draft2020Group173Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var109.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group174 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group174(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group174Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group174Node0 validates against the schema at #.
+func draft2020Group174Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group174Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 3 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minContains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 3)))
+		}
+		if n > 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxContains", fmt.Sprintf("array has %d matching items, maximum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group174Node1 validates against the schema at #/contains.
+func draft2020Group174Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var110.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group175 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group175(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group175Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group175Node0 validates against the schema at #.
+func draft2020Group175Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		for i, item := range arr {
+			if len(draft2020Group175Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				ann.RecordItem(i)
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group175Node1 validates against the schema at #/contains.
+func draft2020Group175Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var111.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group176 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group176(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group176Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group176Node0 validates against the schema at #.
+func draft2020Group176Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group176Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n > 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxContains", fmt.Sprintf("array has %d matching items, maximum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group176Node1 validates against the schema at #/contains.
+func draft2020Group176Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var112.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group177 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group177(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group177Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group177Node0 validates against the schema at #.
+func draft2020Group177Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 1)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group178 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group178(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group178Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group178Node0 validates against the schema at #.
+func draft2020Group178Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 1)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group179 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group179(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group179Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group179Node0 validates against the schema at #.
+func draft2020Group179Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minLength", fmt.Sprintf("string length %d is less than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group180 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group180(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group180Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group180Node0 validates against the schema at #.
+func draft2020Group180Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minLength", fmt.Sprintf("string length %d is less than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group181 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group181(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group181Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group181Node0 validates against the schema at #.
+func draft2020Group181Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minProperties", fmt.Sprintf("object has %d properties, minimum is %d", len(obj), 1)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group182 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group182(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group182Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group182Node0 validates against the schema at #.
+func draft2020Group182Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minProperties", fmt.Sprintf("object has %d properties, minimum is %d", len(obj), 1)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group183 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group183(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group183Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group183Node0 validates against the schema at #.
+func draft2020Group183Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var113.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group184 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group184(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group184Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group184Node0 validates against the schema at #.
+func draft2020Group184Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var114.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group185 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group185(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group185Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group185Node0 validates against the schema at #.
+func draft2020Group185Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var115.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group186 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group186(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group186Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group186Node0 validates against the schema at #.
+func draft2020Group186Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var116.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group187 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group187(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group187Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group187Node0 validates against the schema at #.
+func draft2020Group187Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var117.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group188 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group188(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group188Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group188Node0 validates against the schema at #.
+func draft2020Group188Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = draft2020Group0Var118.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group189 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group189(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group189Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group189Node0 validates against the schema at #.
+func draft2020Group189Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = draft2020Group0Var119.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group190 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group190(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group190Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group190Node0 validates against the schema at #.
+func draft2020Group190Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if len(draft2020Group190Node1(st, x, ip, sp.Append(draft2020Group0Var120), nil)) == 0 {
+		errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+	}
+
+	return errs
+}
+
+// draft2020Group190Node1 validates against the schema at #/not.
+func draft2020Group190Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group191 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group191(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group191Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group191Node0 validates against the schema at #.
+func draft2020Group191Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if len(draft2020Group191Node1(st, x, ip, sp.Append(draft2020Group0Var120), nil)) == 0 {
+		errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+	}
+
+	return errs
+}
+
+// draft2020Group191Node1 validates against the schema at #/not.
+func draft2020Group191Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x) || genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"integer\", \"boolean\"], got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group192 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group192(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group192Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group192Node0 validates against the schema at #.
+func draft2020Group192Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if len(draft2020Group192Node1(st, x, ip, sp.Append(draft2020Group0Var120), nil)) == 0 {
+		errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+	}
+
+	return errs
+}
+
+// draft2020Group192Node1 validates against the schema at #/not.
+func draft2020Group192Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group192Node2(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group192Node2 validates against the schema at #/not/properties/foo.
+func draft2020Group192Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group193 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group193(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group193Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group193Node0 validates against the schema at #.
+func draft2020Group193Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, genrt.False(ip.Key("foo"), sp.Append(draft2020Group0Var1), "properties")...)
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group194 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group194(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group194Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group194Node0 validates against the schema at #.
+func draft2020Group194Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+
+	return errs
+}
+
+// Draft2020Group195 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group195(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group195Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group195Node0 validates against the schema at #.
+func draft2020Group195Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+
+	return errs
+}
+
+// Draft2020Group196 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group196(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group196Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group196Node0 validates against the schema at #.
+func draft2020Group196Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft2020Group197 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group197(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group197Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group197Node0 validates against the schema at #.
+func draft2020Group197Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft2020Group198 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group198(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group198Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group198Node0 validates against the schema at #.
+func draft2020Group198Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if len(draft2020Group198Node1(st, x, ip, sp.Append(draft2020Group0Var120), nil)) == 0 {
+		errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+	}
+
+	return errs
+}
+
+// draft2020Group198Node1 validates against the schema at #/not.
+func draft2020Group198Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group198Node2(st, x, ip, sp.Append(draft2020Group0Var21), ann1)) == 0 {
+		ann.Merge(ann1)
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group198Node2 validates against the schema at #/not/anyOf/1.
+func draft2020Group198Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group199 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group199(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group199Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group199Node0 validates against the schema at #.
+func draft2020Group199Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft2020Group199Node1(st, x, ip, sp.Append(draft2020Group0Var16), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group199Node2(st, x, ip, sp.Append(draft2020Group0Var122), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft2020Group199Node1 validates against the schema at #/oneOf/0.
+func draft2020Group199Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group199Node2 validates against the schema at #/oneOf/1.
+func draft2020Group199Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var123.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group200 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group200(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group200Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group200Node0 validates against the schema at #.
+func draft2020Group200Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft2020Group200Node1(st, x, ip, sp.Append(draft2020Group0Var16), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group200Node2(st, x, ip, sp.Append(draft2020Group0Var122), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft2020Group200Node1 validates against the schema at #/oneOf/0.
+func draft2020Group200Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minLength", fmt.Sprintf("string length %d is less than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group200Node2 validates against the schema at #/oneOf/1.
+func draft2020Group200Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 4 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 4)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group201 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group201(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group201Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group201Node0 validates against the schema at #.
+func draft2020Group201Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 3
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// Draft2020Group202 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group202(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group202Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group202Node0 validates against the schema at #.
+func draft2020Group202Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 1
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	oneOfErrs = append(oneOfErrs, genrt.False(ip, sp.Append(draft2020Group0Var122), "")...)
+	oneOfErrs = append(oneOfErrs, genrt.False(ip, sp.Append(draft2020Group0Var124), "")...)
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// Draft2020Group203 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group203(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group203Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group203Node0 validates against the schema at #.
+func draft2020Group203Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 2
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	oneOfErrs = append(oneOfErrs, genrt.False(ip, sp.Append(draft2020Group0Var124), "")...)
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// Draft2020Group204 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group204(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group204Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group204Node0 validates against the schema at #.
+func draft2020Group204Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	oneOfErrs = append(oneOfErrs, genrt.False(ip, sp.Append(draft2020Group0Var16), "")...)
+	oneOfErrs = append(oneOfErrs, genrt.False(ip, sp.Append(draft2020Group0Var122), "")...)
+	oneOfErrs = append(oneOfErrs, genrt.False(ip, sp.Append(draft2020Group0Var124), "")...)
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// Draft2020Group205 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group205(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group205Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group205Node0 validates against the schema at #.
+func draft2020Group205Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft2020Group205Node1(st, x, ip, sp.Append(draft2020Group0Var16), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group205Node3(st, x, ip, sp.Append(draft2020Group0Var122), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft2020Group205Node1 validates against the schema at #/oneOf/0.
+func draft2020Group205Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group205Node2(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group205Node2 validates against the schema at #/oneOf/0/properties/bar.
+func draft2020Group205Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group205Node3 validates against the schema at #/oneOf/1.
+func draft2020Group205Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group205Node4(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group205Node4 validates against the schema at #/oneOf/1/properties/foo.
+func draft2020Group205Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group206 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group206(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group206Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group206Node0 validates against the schema at #.
+func draft2020Group206Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 1
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft2020Group206Node1(st, x, ip, sp.Append(draft2020Group0Var16), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft2020Group206Node1 validates against the schema at #/oneOf/0.
+func draft2020Group206Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group207 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group207(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group207Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group207Node0 validates against the schema at #.
+func draft2020Group207Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft2020Group207Node1(st, x, ip, sp.Append(draft2020Group0Var16), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group207Node2(st, x, ip, sp.Append(draft2020Group0Var122), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft2020Group207Node1 validates against the schema at #/oneOf/0.
+func draft2020Group207Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group207Node2 validates against the schema at #/oneOf/1.
+func draft2020Group207Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+		if _, ok := obj["baz"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"baz\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group208 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group208(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group208Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group208Node0 validates against the schema at #.
+func draft2020Group208Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft2020Group208Node1(st, x, ip, sp.Append(draft2020Group0Var16), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group208Node2(st, x, ip, sp.Append(draft2020Group0Var122), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft2020Group208Node1 validates against the schema at #/oneOf/0.
+func draft2020Group208Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+		if _, ok := obj["baz"]; ok {
+			ann.RecordProperty("baz")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group208Node2 validates against the schema at #/oneOf/1.
+func draft2020Group208Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group209 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group209(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group209Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group209Node0 validates against the schema at #.
+func draft2020Group209Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft2020Group209Node1(st, x, ip, sp.Append(draft2020Group0Var16), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft2020Group209Node1 validates against the schema at #/oneOf/0.
+func draft2020Group209Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann2 := ann.Child()
+	if ce := draft2020Group209Node2(st, x, ip, sp.Append(draft2020Group0Var16), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft2020Group209Node2 validates against the schema at #/oneOf/0/oneOf/0.
+func draft2020Group209Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group210 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group210(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group210Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group210Node0 validates against the schema at #.
+func draft2020Group210Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if !draft2020Group0Var125.MatchString(s) {
+			errs = append(errs, genrt.Leaf(ip, sp, "pattern", "string does not match pattern \"^a*$\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group211 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group211(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group211Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group211Node0 validates against the schema at #.
+func draft2020Group211Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if !draft2020Group0Var126.MatchString(s) {
+			errs = append(errs, genrt.Leaf(ip, sp, "pattern", "string does not match pattern \"a+\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group212 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group212(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group212Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group212Node0 validates against the schema at #.
+func draft2020Group212Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		if !draft2020Group0Var127.MatchString(s) {
+			errs = append(errs, genrt.Leaf(ip, sp, "pattern", "string does not match pattern \"^\\\\p{Letter}+$\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group213 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group213(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group213Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group213Node0 validates against the schema at #.
+func draft2020Group213Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp1 := sp.Append(draft2020Group0Var128)
+		for _, k := range keys {
+			if !draft2020Group0Var129.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group213Node1(st, obj[k], ip.Key(k), psp1, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group213Node1 validates against the schema at #/patternProperties/f.*o.
+func draft2020Group213Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group214 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group214(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group214Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group214Node0 validates against the schema at #.
+func draft2020Group214Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp1 := sp.Append(draft2020Group0Var130)
+		for _, k := range keys {
+			if !draft2020Group0Var131.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group214Node1(st, obj[k], ip.Key(k), psp1, nil)...)
+		}
+		psp2 := sp.Append(draft2020Group0Var132)
+		for _, k := range keys {
+			if !draft2020Group0Var133.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group214Node2(st, obj[k], ip.Key(k), psp2, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group214Node1 validates against the schema at #/patternProperties/a*.
+func draft2020Group214Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group214Node2 validates against the schema at #/patternProperties/aaa*.
+func draft2020Group214Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var134.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group215 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group215(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group215Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group215Node0 validates against the schema at #.
+func draft2020Group215Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp1 := sp.Append(draft2020Group0Var135)
+		for _, k := range keys {
+			if !draft2020Group0Var136.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group215Node1(st, obj[k], ip.Key(k), psp1, nil)...)
+		}
+		psp2 := sp.Append(draft2020Group0Var137)
+		for _, k := range keys {
+			if !draft2020Group0Var138.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group215Node2(st, obj[k], ip.Key(k), psp2, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group215Node1 validates against the schema at #/patternProperties/X_.
+func draft2020Group215Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group215Node2 validates against the schema at #/patternProperties/[0-9]{2,}.
+func draft2020Group215Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group216 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group216(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group216Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group216Node0 validates against the schema at #.
+func draft2020Group216Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp1 := sp.Append(draft2020Group0Var139)
+		for _, k := range keys {
+			if !draft2020Group0Var140.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), psp1, "patternProperties")...)
+		}
+		for _, k := range keys {
+			if !draft2020Group0Var142.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group217 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group217(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group217Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group217Node0 validates against the schema at #.
+func draft2020Group217Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp1 := sp.Append(draft2020Group0Var143)
+		for _, k := range keys {
+			if !draft2020Group0Var144.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group217Node1(st, obj[k], ip.Key(k), psp1, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group217Node1 validates against the schema at #/patternProperties/^.*bar$.
+func draft2020Group217Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group218 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group218(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group218Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group218Node0 validates against the schema at #.
+func draft2020Group218Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp1 := sp.Append(draft2020Group0Var145)
+		for _, k := range keys {
+			if !draft2020Group0Var127.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group218Node1(st, obj[k], ip.Key(k), psp1, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group218Node1 validates against the schema at #/patternProperties/^\p{Letter}+$.
+func draft2020Group218Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group219 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group219(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group219Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group219Node0 validates against the schema at #.
+func draft2020Group219Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group219Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group219Node2(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group219Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group219Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group219Node2 validates against the schema at #/prefixItems/1.
+func draft2020Group219Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group220 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group220(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group220Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group220Node0 validates against the schema at #.
+func draft2020Group220Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 1 {
+			errs = append(errs, genrt.False(ip.Index(1), sp.Append(draft2020Group0Var96), "prefixItems")...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// Draft2020Group221 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group221(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group221Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group221Node0 validates against the schema at #.
+func draft2020Group221Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group221Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group221Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group221Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group222 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group222(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group222Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group222Node0 validates against the schema at #.
+func draft2020Group222Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group222Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group222Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group222Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group223 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group223(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group223Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group223Node0 validates against the schema at #.
+func draft2020Group223Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group223Node1(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group223Node2(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group223Node1 validates against the schema at #/properties/bar.
+func draft2020Group223Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group223Node2 validates against the schema at #/properties/foo.
+func draft2020Group223Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group224 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group224(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group224Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group224Node0 validates against the schema at #.
+func draft2020Group224Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group224Node1(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group224Node2(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+		psp1 := sp.Append(draft2020Group0Var146)
+		for _, k := range keys {
+			if !draft2020Group0Var147.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group224Node3(st, obj[k], ip.Key(k), psp1, nil)...)
+		}
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			switch k {
+			case "bar", "foo":
+				continue
+			}
+
+			if draft2020Group0Var147.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group224Node4(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group224Node1 validates against the schema at #/properties/bar.
+func draft2020Group224Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group224Node2 validates against the schema at #/properties/foo.
+func draft2020Group224Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 3 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxItems", fmt.Sprintf("array has %d items, maximum is %d", len(arr), 3)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group224Node3 validates against the schema at #/patternProperties/f.o.
+func draft2020Group224Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 2)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group224Node4 validates against the schema at #/additionalProperties.
+func draft2020Group224Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group225 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group225(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group225Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group225Node0 validates against the schema at #.
+func draft2020Group225Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, genrt.False(ip.Key("bar"), sp.Append(draft2020Group0Var0), "properties")...)
+		}
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group227 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group227(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group227Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group227Node0 validates against the schema at #.
+func draft2020Group227Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group227Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group227Node1 validates against the schema at #/properties/foo.
+func draft2020Group227Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group228 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group228(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group228Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group228Node0 validates against the schema at #.
+func draft2020Group228Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["__proto__"]; ok {
+			ann.RecordProperty("__proto__")
+			errs = append(errs, draft2020Group228Node1(st, v, ip.Key("__proto__"), sp.Append(draft2020Group0Var148), nil)...)
+		}
+		if v, ok := obj["constructor"]; ok {
+			ann.RecordProperty("constructor")
+			errs = append(errs, draft2020Group228Node2(st, v, ip.Key("constructor"), sp.Append(draft2020Group0Var149), nil)...)
+		}
+		if v, ok := obj["toString"]; ok {
+			ann.RecordProperty("toString")
+			errs = append(errs, draft2020Group228Node3(st, v, ip.Key("toString"), sp.Append(draft2020Group0Var150), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group228Node1 validates against the schema at #/properties/__proto__.
+func draft2020Group228Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group228Node2 validates against the schema at #/properties/constructor.
+func draft2020Group228Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group228Node3 validates against the schema at #/properties/toString.
+func draft2020Group228Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["length"]; ok {
+			ann.RecordProperty("length")
+			errs = append(errs, draft2020Group228Node4(st, v, ip.Key("length"), sp.Append(draft2020Group0Var151), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group228Node4 validates against the schema at #/properties/toString/properties/length.
+func draft2020Group228Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group229 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group229(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group229Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group229Node0 validates against the schema at #.
+func draft2020Group229Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		nsp := sp.Append(draft2020Group0Var8)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := draft2020Group229Node1(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group229Node1 validates against the schema at #/propertyNames.
+func draft2020Group229Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 3 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 3)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group230 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group230(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group230Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group230Node0 validates against the schema at #.
+func draft2020Group230Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		nsp := sp.Append(draft2020Group0Var8)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := draft2020Group230Node1(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group230Node1 validates against the schema at #/propertyNames.
+func draft2020Group230Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if !draft2020Group0Var152.MatchString(s) {
+			errs = append(errs, genrt.Leaf(ip, sp, "pattern", "string does not match pattern \"^a+$\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group231 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group231(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group231Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group231Node0 validates against the schema at #.
+func draft2020Group231Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft2020Group232 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group232(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group232Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group232Node0 validates against the schema at #.
+func draft2020Group232Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		nsp := sp.Append(draft2020Group0Var8)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, nsp, ""); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group233 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group233(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group233Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group233Node0 validates against the schema at #.
+func draft2020Group233Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		nsp := sp.Append(draft2020Group0Var8)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := draft2020Group233Node1(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group233Node1 validates against the schema at #/propertyNames.
+func draft2020Group233Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var153.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group234 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group234(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group234Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group234Node0 validates against the schema at #.
+func draft2020Group234Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		nsp := sp.Append(draft2020Group0Var8)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := draft2020Group234Node1(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group234Node1 validates against the schema at #/propertyNames.
+func draft2020Group234Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var154.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group235 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group235(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group235Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group235Node0 validates against the schema at #.
+func draft2020Group235Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group235Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			switch k {
+			case "foo":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group235Node1 validates against the schema at #/properties/foo.
+func draft2020Group235Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group235Node0(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// Draft2020Group236 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group236(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group236Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group236Node0 validates against the schema at #.
+func draft2020Group236Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group236Node1(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group236Node2(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group236Node1 validates against the schema at #/properties/bar.
+func draft2020Group236Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group236Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group236Node2 validates against the schema at #/properties/bar/$ref.
+func draft2020Group236Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group237 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group237(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group237Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group237Node0 validates against the schema at #.
+func draft2020Group237Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group237Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group237Node2(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group237Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group237Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group237Node2 validates against the schema at #/prefixItems/1.
+func draft2020Group237Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group237Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// Draft2020Group238 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group238(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group238Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group238Node0 validates against the schema at #.
+func draft2020Group238Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["percent"]; ok {
+			ann.RecordProperty("percent")
+			errs = append(errs, draft2020Group238Node1(st, v, ip.Key("percent"), sp.Append(draft2020Group0Var155), nil)...)
+		}
+		if v, ok := obj["slash"]; ok {
+			ann.RecordProperty("slash")
+			errs = append(errs, draft2020Group238Node3(st, v, ip.Key("slash"), sp.Append(draft2020Group0Var156), nil)...)
+		}
+		if v, ok := obj["tilde"]; ok {
+			ann.RecordProperty("tilde")
+			errs = append(errs, draft2020Group238Node5(st, v, ip.Key("tilde"), sp.Append(draft2020Group0Var157), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group238Node1 validates against the schema at #/properties/percent.
+func draft2020Group238Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group238Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group238Node2 validates against the schema at #/properties/percent/$ref.
+func draft2020Group238Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group238Node3 validates against the schema at #/properties/slash.
+func draft2020Group238Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group238Node4(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group238Node4 validates against the schema at #/properties/slash/$ref.
+func draft2020Group238Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group238Node5 validates against the schema at #/properties/tilde.
+func draft2020Group238Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft2020Group238Node6(st, x, ip, sp.Append(draft2020Group0Var20), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft2020Group238Node6 validates against the schema at #/properties/tilde/$ref.
+func draft2020Group238Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group239 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group239(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group239Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group239Node0 validates against the schema at #.
+func draft2020Group239Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group239Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group239Node1 validates against the schema at #/$ref.
+func draft2020Group239Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group239Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group239Node2 validates against the schema at #/$ref/$ref.
+func draft2020Group239Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft2020Group239Node3(st, x, ip, sp.Append(draft2020Group0Var20), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft2020Group239Node3 validates against the schema at #/$ref/$ref/$ref.
+func draft2020Group239Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group240 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group240(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group240Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group240Node0 validates against the schema at #.
+func draft2020Group240Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group240Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group240Node1 validates against the schema at #/properties/foo.
+func draft2020Group240Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group240Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxItems", fmt.Sprintf("array has %d items, maximum is %d", len(arr), 2)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group240Node2 validates against the schema at #/properties/foo/$ref.
+func draft2020Group240Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group242 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group242(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group242Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group242Node0 validates against the schema at #.
+func draft2020Group242Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["$ref"]; ok {
+			ann.RecordProperty("$ref")
+			errs = append(errs, draft2020Group242Node1(st, v, ip.Key("$ref"), sp.Append(draft2020Group0Var158), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group242Node1 validates against the schema at #/properties/$ref.
+func draft2020Group242Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group243 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group243(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group243Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group243Node0 validates against the schema at #.
+func draft2020Group243Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["$ref"]; ok {
+			ann.RecordProperty("$ref")
+			errs = append(errs, draft2020Group243Node1(st, v, ip.Key("$ref"), sp.Append(draft2020Group0Var158), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group243Node1 validates against the schema at #/properties/$ref.
+func draft2020Group243Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group243Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group243Node2 validates against the schema at #/properties/$ref/$ref.
+func draft2020Group243Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group244 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group244(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group244Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group244Node0 validates against the schema at #.
+func draft2020Group244Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft2020Group245 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group245(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group245Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group245Node0 validates against the schema at #.
+func draft2020Group245Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", genrt.False(ip, sp.Append(draft2020Group0Var20), "")))
+
+	return errs
+}
+
+// Draft2020Group246 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group246(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group246Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group246Node0 validates against the schema at #.
+func draft2020Group246Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["meta"]; ok {
+			ann.RecordProperty("meta")
+			errs = append(errs, draft2020Group246Node1(st, v, ip.Key("meta"), sp.Append(draft2020Group0Var159), nil)...)
+		}
+		if v, ok := obj["nodes"]; ok {
+			ann.RecordProperty("nodes")
+			errs = append(errs, draft2020Group246Node2(st, v, ip.Key("nodes"), sp.Append(draft2020Group0Var160), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["meta"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"meta\""))
+		}
+		if _, ok := obj["nodes"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"nodes\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group246Node1 validates against the schema at #/properties/meta.
+func draft2020Group246Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group246Node2 validates against the schema at #/properties/nodes.
+func draft2020Group246Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group246Node3(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft2020Group246Node3 validates against the schema at #/properties/nodes/items.
+func draft2020Group246Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group246Node4(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group246Node4 validates against the schema at #/properties/nodes/items/$ref.
+func draft2020Group246Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["subtree"]; ok {
+			ann.RecordProperty("subtree")
+			errs = append(errs, draft2020Group246Node5(st, v, ip.Key("subtree"), sp.Append(draft2020Group0Var161), nil)...)
+		}
+		if v, ok := obj["value"]; ok {
+			ann.RecordProperty("value")
+			errs = append(errs, draft2020Group246Node6(st, v, ip.Key("value"), sp.Append(draft2020Group0Var162), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["value"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"value\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group246Node5 validates against the schema at #/properties/nodes/items/$ref/properties/subtree.
+func draft2020Group246Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft2020Group246Node0(st, x, ip, sp.Append(draft2020Group0Var20), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft2020Group246Node6 validates against the schema at #/properties/nodes/items/$ref/properties/value.
+func draft2020Group246Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group247 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group247(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group247Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group247Node0 validates against the schema at #.
+func draft2020Group247Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo\"bar"]; ok {
+			ann.RecordProperty("foo\"bar")
+			errs = append(errs, draft2020Group247Node1(st, v, ip.Key("foo\"bar"), sp.Append(draft2020Group0Var163), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group247Node1 validates against the schema at #/properties/foo"bar.
+func draft2020Group247Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group247Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group247Node2 validates against the schema at #/properties/foo"bar/$ref.
+func draft2020Group247Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group248 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group248(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group248Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group248Node0 validates against the schema at #.
+func draft2020Group248Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group248Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["prop1"]; ok {
+			ann.RecordProperty("prop1")
+			errs = append(errs, draft2020Group248Node2(st, v, ip.Key("prop1"), sp.Append(draft2020Group0Var164), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group248Node1 validates against the schema at #/$ref.
+func draft2020Group248Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group248Node2 validates against the schema at #/properties/prop1.
+func draft2020Group248Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group249 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group249(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group249Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group249Node0 validates against the schema at #.
+func draft2020Group249Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var165.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft2020Group250 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group250(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group250Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group250Node0 validates against the schema at #.
+func draft2020Group250Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group250Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group250Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group250Node1 validates against the schema at #/$ref.
+func draft2020Group250Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group250Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group250Node2 validates against the schema at #/$ref/$ref.
+func draft2020Group250Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group250Node3(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group250Node3 validates against the schema at #/$ref/$ref/properties/bar.
+func draft2020Group250Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group251 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group251(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group251Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group251Node0 validates against the schema at #.
+func draft2020Group251Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group251Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group251Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group251Node1 validates against the schema at #/$ref.
+func draft2020Group251Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group251Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group251Node2 validates against the schema at #/$ref/$ref.
+func draft2020Group251Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group251Node3(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group251Node3 validates against the schema at #/$ref/$ref/properties/bar.
+func draft2020Group251Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group252 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group252(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group252Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group252Node0 validates against the schema at #.
+func draft2020Group252Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group252Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group252Node1 validates against the schema at #/allOf/0.
+func draft2020Group252Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group252Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group252Node2 validates against the schema at #/allOf/0/$ref.
+func draft2020Group252Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group253 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group253(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group253Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group253Node0 validates against the schema at #.
+func draft2020Group253Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group253Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group253Node1 validates against the schema at #/$ref.
+func draft2020Group253Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var166.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group254 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group254(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group254Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group254Node0 validates against the schema at #.
+func draft2020Group254Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group254Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group254Node1 validates against the schema at #/$ref.
+func draft2020Group254Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var167.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group255 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group255(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group255Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group255Node0 validates against the schema at #.
+func draft2020Group255Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group255Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group255Node1 validates against the schema at #/$ref.
+func draft2020Group255Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group255Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group255Node2 validates against the schema at #/$ref/$ref.
+func draft2020Group255Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group256 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group256(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group256Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group256Node0 validates against the schema at #.
+func draft2020Group256Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var168.Check(errs, x, ip, sp)
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group256Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group256Node1 validates against the schema at #/properties/foo.
+func draft2020Group256Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group256Node0(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// Draft2020Group257 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group257(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group257Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group257Node0 validates against the schema at #.
+func draft2020Group257Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group257Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group257Node1 validates against the schema at #/properties/foo.
+func draft2020Group257Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group257Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group257Node2 validates against the schema at #/properties/foo/$ref.
+func draft2020Group257Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group258 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group258(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group258Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group258Node0 validates against the schema at #.
+func draft2020Group258Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group258Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group258Node1 validates against the schema at #/properties/foo.
+func draft2020Group258Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group258Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group258Node2 validates against the schema at #/properties/foo/$ref.
+func draft2020Group258Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group259 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group259(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group259Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group259Node0 validates against the schema at #.
+func draft2020Group259Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group259Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group259Node1 validates against the schema at #/properties/foo.
+func draft2020Group259Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group259Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group259Node2 validates against the schema at #/properties/foo/$ref.
+func draft2020Group259Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group260 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group260(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group260Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group260Node0 validates against the schema at #.
+func draft2020Group260Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group260Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group260Node1 validates against the schema at #/properties/foo.
+func draft2020Group260Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group260Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group260Node2 validates against the schema at #/properties/foo/$ref.
+func draft2020Group260Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group261 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group261(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group261Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group261Node0 validates against the schema at #.
+func draft2020Group261Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group261Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group261Node1 validates against the schema at #/properties/foo.
+func draft2020Group261Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group261Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group261Node2 validates against the schema at #/properties/foo/$ref.
+func draft2020Group261Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group262 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group262(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group262Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group262Node0 validates against the schema at #.
+func draft2020Group262Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group262Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group262Node1 validates against the schema at #/properties/foo.
+func draft2020Group262Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group262Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group262Node2 validates against the schema at #/properties/foo/$ref.
+func draft2020Group262Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group263 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group263(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group263Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group263Node0 validates against the schema at #.
+func draft2020Group263Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group263Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group263Node1 validates against the schema at #/$ref.
+func draft2020Group263Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group263Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group263Node2 validates against the schema at #/$ref/$ref.
+func draft2020Group263Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group264 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group264(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group264Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group264Node0 validates against the schema at #.
+func draft2020Group264Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group264Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	ann2 := ann.Child()
+	if len(draft2020Group264Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann2)) == 0 {
+		ann.Merge(ann2)
+
+	} else {
+	}
+
+	return errs
+}
+
+// draft2020Group264Node1 validates against the schema at #/$ref.
+func draft2020Group264Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group265 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group265(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group265Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group265Node0 validates against the schema at #.
+func draft2020Group265Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group265Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group265Node1 validates against the schema at #/$ref.
+func draft2020Group265Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group266 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group266(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group266Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group266Node0 validates against the schema at #.
+func draft2020Group266Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group266Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group266Node1 validates against the schema at #/$ref.
+func draft2020Group266Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group267 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group267(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group267Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group267Node0 validates against the schema at #.
+func draft2020Group267Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group267Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group267Node1 validates against the schema at #/$ref.
+func draft2020Group267Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group268 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group268(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group268Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group268Node0 validates against the schema at #.
+func draft2020Group268Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group268Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group268Node1 validates against the schema at #/$ref.
+func draft2020Group268Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group269 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group269(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group269Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group269Node0 validates against the schema at #.
+func draft2020Group269Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group269Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group269Node1 validates against the schema at #/$ref.
+func draft2020Group269Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group270 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group270(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group270Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group270Node0 validates against the schema at #.
+func draft2020Group270Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group270Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group270Node1 validates against the schema at #/allOf/0.
+func draft2020Group270Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group270Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group270Node2 validates against the schema at #/allOf/0/$ref.
+func draft2020Group270Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group271 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group271(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group271Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group271Node0 validates against the schema at #.
+func draft2020Group271Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group271Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group271Node1 validates against the schema at #/$ref.
+func draft2020Group271Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group272 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group272(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group272Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group272Node0 validates against the schema at #.
+func draft2020Group272Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group272Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group272Node1 validates against the schema at #/$ref.
+func draft2020Group272Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group273 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group273(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group273Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group273Node0 validates against the schema at #.
+func draft2020Group273Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group273Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group273Node1 validates against the schema at #/$ref.
+func draft2020Group273Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group274 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group274(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group274Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group274Node0 validates against the schema at #.
+func draft2020Group274Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group274Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group274Node1 validates against the schema at #/$ref.
+func draft2020Group274Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group274Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group274Node2 validates against the schema at #/$ref/$ref.
+func draft2020Group274Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group275 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group275(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group275Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group275Node0 validates against the schema at #.
+func draft2020Group275Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group275Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft2020Group275Node1 validates against the schema at #/items.
+func draft2020Group275Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp2 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group275Node2(st, arr[i], ip.Index(i), rsp2, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft2020Group275Node2 validates against the schema at #/items/items.
+func draft2020Group275Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft2020Group275Node3(st, x, ip, sp.Append(draft2020Group0Var20), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft2020Group275Node3 validates against the schema at #/items/items/$ref.
+func draft2020Group275Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group276 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group276(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group276Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group276Node0 validates against the schema at #.
+func draft2020Group276Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["list"]; ok {
+			ann.RecordProperty("list")
+			errs = append(errs, draft2020Group276Node1(st, v, ip.Key("list"), sp.Append(draft2020Group0Var169), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group276Node1 validates against the schema at #/properties/list.
+func draft2020Group276Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group276Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group276Node2 validates against the schema at #/properties/list/$ref.
+func draft2020Group276Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp2 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group276Node3(st, arr[i], ip.Index(i), rsp2, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft2020Group276Node3 validates against the schema at #/properties/list/$ref/items.
+func draft2020Group276Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft2020Group276Node4(st, x, ip, sp.Append(draft2020Group0Var20), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft2020Group276Node4 validates against the schema at #/properties/list/$ref/items/$ref.
+func draft2020Group276Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group277 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group277(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group277Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group277Node0 validates against the schema at #.
+func draft2020Group277Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["list"]; ok {
+			ann.RecordProperty("list")
+			errs = append(errs, draft2020Group277Node1(st, v, ip.Key("list"), sp.Append(draft2020Group0Var169), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group277Node1 validates against the schema at #/properties/list.
+func draft2020Group277Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group277Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group277Node2 validates against the schema at #/properties/list/$ref.
+func draft2020Group277Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp2 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group277Node3(st, arr[i], ip.Index(i), rsp2, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft2020Group277Node3 validates against the schema at #/properties/list/$ref/items.
+func draft2020Group277Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft2020Group277Node4(st, x, ip, sp.Append(draft2020Group0Var20), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft2020Group277Node4 validates against the schema at #/properties/list/$ref/items/$ref.
+func draft2020Group277Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group278 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group278(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group278Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group278Node0 validates against the schema at #.
+func draft2020Group278Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["name"]; ok {
+			ann.RecordProperty("name")
+			errs = append(errs, draft2020Group278Node1(st, v, ip.Key("name"), sp.Append(draft2020Group0Var170), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group278Node1 validates against the schema at #/properties/name.
+func draft2020Group278Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group278Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group278Node2 validates against the schema at #/properties/name/$ref.
+func draft2020Group278Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft2020Group278Node3(st, x, ip, sp.Append(draft2020Group0Var15), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann3 := ann.Child()
+	if ce := draft2020Group278Node4(st, x, ip, sp.Append(draft2020Group0Var21), ann3); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann3)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft2020Group278Node3 validates against the schema at #/properties/name/$ref/anyOf/0.
+func draft2020Group278Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group278Node4 validates against the schema at #/properties/name/$ref/anyOf/1.
+func draft2020Group278Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := draft2020Group278Node5(st, x, ip, sp.Append(draft2020Group0Var20), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// draft2020Group278Node5 validates against the schema at #/properties/name/$ref/anyOf/1/$ref.
+func draft2020Group278Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group279 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group279(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group279Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group279Node0 validates against the schema at #.
+func draft2020Group279Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group279Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group279Node1 validates against the schema at #/$ref.
+func draft2020Group279Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group279Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group279Node2 validates against the schema at #/$ref/$ref.
+func draft2020Group279Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group279Node3(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group279Node3 validates against the schema at #/$ref/$ref/properties/bar.
+func draft2020Group279Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group280 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group280(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group280Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group280Node0 validates against the schema at #.
+func draft2020Group280Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group280Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group280Node1 validates against the schema at #/$ref.
+func draft2020Group280Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group280Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group280Node2 validates against the schema at #/$ref/$ref.
+func draft2020Group280Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group281 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group281(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group281Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group281Node0 validates against the schema at #.
+func draft2020Group281Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["name"]; ok {
+			ann.RecordProperty("name")
+			errs = append(errs, draft2020Group281Node1(st, v, ip.Key("name"), sp.Append(draft2020Group0Var170), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group281Node1 validates against the schema at #/properties/name.
+func draft2020Group281Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group281Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group281Node2 validates against the schema at #/properties/name/$ref.
+func draft2020Group281Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group281Node3(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group281Node3 validates against the schema at #/properties/name/$ref/properties/foo.
+func draft2020Group281Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group281Node4(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group281Node4 validates against the schema at #/properties/name/$ref/properties/foo/$ref.
+func draft2020Group281Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group282 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group282(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group282Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group282Node0 validates against the schema at #.
+func draft2020Group282Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group282Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group282Node1 validates against the schema at #/$ref.
+func draft2020Group282Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group282Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group282Node2 validates against the schema at #/$ref/$ref.
+func draft2020Group282Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group283 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group283(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group283Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group283Node0 validates against the schema at #.
+func draft2020Group283Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group283Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group283Node1 validates against the schema at #/$ref.
+func draft2020Group283Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group283Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group283Node2 validates against the schema at #/$ref/$ref.
+func draft2020Group283Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group284 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group284(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group284Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group284Node0 validates against the schema at #.
+func draft2020Group284Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group284Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group284Node1 validates against the schema at #/$ref.
+func draft2020Group284Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group284Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group284Node2 validates against the schema at #/$ref/$ref.
+func draft2020Group284Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group285 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group285(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group285Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group285Node0 validates against the schema at #.
+func draft2020Group285Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group285Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft2020Group285Node1 validates against the schema at #/$ref.
+func draft2020Group285Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft2020Group285Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group285Node2 validates against the schema at #/$ref/$ref.
+func draft2020Group285Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group286 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group286(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group286Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group286Node0 validates against the schema at #.
+func draft2020Group286Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group287 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group287(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group287Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group287Node0 validates against the schema at #.
+func draft2020Group287Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group288 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group288(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group288Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group288Node0 validates against the schema at #.
+func draft2020Group288Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group289 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group289(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group289Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group289Node0 validates against the schema at #.
+func draft2020Group289Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo\nbar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\nbar\""))
+		}
+		if _, ok := obj["foo\"bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\\"bar\""))
+		}
+		if _, ok := obj["foo\\bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\\\bar\""))
+		}
+		if _, ok := obj["foo\rbar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\rbar\""))
+		}
+		if _, ok := obj["foo\tbar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\tbar\""))
+		}
+		if _, ok := obj["foo\fbar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\fbar\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group290 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group290(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group290Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group290Node0 validates against the schema at #.
+func draft2020Group290Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["__proto__"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"__proto__\""))
+		}
+		if _, ok := obj["toString"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"toString\""))
+		}
+		if _, ok := obj["constructor"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"constructor\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group291 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group291(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group291Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group291Node0 validates against the schema at #.
+func draft2020Group291Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group292 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group292(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group292Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group292Node0 validates against the schema at #.
+func draft2020Group292Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group293 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group293(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group293Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group293Node0 validates against the schema at #.
+func draft2020Group293Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group294 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group294(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group294Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group294Node0 validates against the schema at #.
+func draft2020Group294Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group295 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group295(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group295Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group295Node0 validates against the schema at #.
+func draft2020Group295Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group296 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group296(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group296Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group296Node0 validates against the schema at #.
+func draft2020Group296Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group297 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group297(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group297Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group297Node0 validates against the schema at #.
+func draft2020Group297Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group298 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group298(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group298Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group298Node0 validates against the schema at #.
+func draft2020Group298Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x) || genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"integer\", \"string\"], got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group299 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group299(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group299Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group299Node0 validates against the schema at #.
+func draft2020Group299Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group300 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group300(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group300Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group300Node0 validates against the schema at #.
+func draft2020Group300Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x) || genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"array\", \"object\"], got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group301 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group301(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group301Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group301Node0 validates against the schema at #.
+func draft2020Group301Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x) || genrt.IsObject(x) || x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"array\", \"object\", \"null\"], got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group302 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group302(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group302Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group302Node0 validates against the schema at #.
+func draft2020Group302Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if _, ok := x.([]any); ok && !ann.AllItemsSet() {
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// Draft2020Group303 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group303(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group303Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group303Node0 validates against the schema at #.
+func draft2020Group303Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group304 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group304(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group304Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group304Node0 validates against the schema at #.
+func draft2020Group304Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := draft2020Group304Node1(st, arr[i], cip, usp, nil); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group304Node1 validates against the schema at #/unevaluatedItems.
+func draft2020Group304Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group305 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group305(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group305Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group305Node0 validates against the schema at #.
+func draft2020Group305Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group305Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group305Node1 validates against the schema at #/items.
+func draft2020Group305Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group306 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group306(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group306Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group306Node0 validates against the schema at #.
+func draft2020Group306Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group306Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group306Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group306Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group307 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group307(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group307Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group307Node0 validates against the schema at #.
+func draft2020Group307Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group307Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+		if len(arr) > 1 {
+			ann.SetAllItems()
+		}
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group307Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group307Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group308 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group308(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group308Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group308Node0 validates against the schema at #.
+func draft2020Group308Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group308Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := draft2020Group308Node2(st, arr[i], cip, usp, nil); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group308Node1 validates against the schema at #/items.
+func draft2020Group308Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group308Node2 validates against the schema at #/unevaluatedItems.
+func draft2020Group308Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group309 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group309(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group309Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group309Node0 validates against the schema at #.
+func draft2020Group309Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group309Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group309Node2(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group309Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group309Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group309Node2 validates against the schema at #/allOf/0.
+func draft2020Group309Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group309Node3(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group309Node3 validates against the schema at #/allOf/0/prefixItems/1.
+func draft2020Group309Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group310 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group310(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group310Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group310Node0 validates against the schema at #.
+func draft2020Group310Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group310Node1(st, x, ip, sp.Append(draft2020Group0Var15), ann1)) == 0 {
+		ann.Merge(ann1)
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := draft2020Group310Node3(st, arr[i], cip, usp, nil); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group310Node1 validates against the schema at #/anyOf/0.
+func draft2020Group310Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp2 := sp.Append(draft2020Group0Var43)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft2020Group310Node2(st, arr[i], ip.Index(i), rsp2, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft2020Group310Node2 validates against the schema at #/anyOf/0/items.
+func draft2020Group310Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group310Node3 validates against the schema at #/unevaluatedItems.
+func draft2020Group310Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group311 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group311(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group311Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group311Node0 validates against the schema at #.
+func draft2020Group311Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group311Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group311Node1 validates against the schema at #/allOf/0.
+func draft2020Group311Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group311Node2(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+		if len(arr) > 1 {
+			ann.SetAllItems()
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group311Node2 validates against the schema at #/allOf/0/prefixItems/0.
+func draft2020Group311Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group312 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group312(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group312Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group312Node0 validates against the schema at #.
+func draft2020Group312Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group312Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group312Node3(st, x, ip, sp.Append(draft2020Group0Var11), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group312Node1 validates against the schema at #/allOf/0.
+func draft2020Group312Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group312Node2(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group312Node2 validates against the schema at #/allOf/0/prefixItems/0.
+func draft2020Group312Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group312Node3 validates against the schema at #/allOf/1.
+func draft2020Group312Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if _, ok := x.([]any); ok && !ann.AllItemsSet() {
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// Draft2020Group313 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group313(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group313Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group313Node0 validates against the schema at #.
+func draft2020Group313Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group313Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group313Node2(st, x, ip, sp.Append(draft2020Group0Var15), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group313Node4(st, x, ip, sp.Append(draft2020Group0Var21), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group313Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group313Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var172.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group313Node2 validates against the schema at #/anyOf/0.
+func draft2020Group313Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group313Node3(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group313Node3 validates against the schema at #/anyOf/0/prefixItems/1.
+func draft2020Group313Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var173.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group313Node4 validates against the schema at #/anyOf/1.
+func draft2020Group313Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 2 {
+			errs = append(errs, draft2020Group313Node5(st, arr[2], ip.Index(2), sp.Append(draft2020Group0Var97), nil)...)
+		}
+		ann.ExtendItems(min(3, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group313Node5 validates against the schema at #/anyOf/1/prefixItems/2.
+func draft2020Group313Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var174.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group314 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group314(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group314Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group314Node0 validates against the schema at #.
+func draft2020Group314Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group314Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft2020Group314Node2(st, x, ip, sp.Append(draft2020Group0Var16), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group314Node4(st, x, ip, sp.Append(draft2020Group0Var122), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group314Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group314Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var175.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group314Node2 validates against the schema at #/oneOf/0.
+func draft2020Group314Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group314Node3(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group314Node3 validates against the schema at #/oneOf/0/prefixItems/1.
+func draft2020Group314Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var176.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group314Node4 validates against the schema at #/oneOf/1.
+func draft2020Group314Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group314Node5(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group314Node5 validates against the schema at #/oneOf/1/prefixItems/1.
+func draft2020Group314Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var177.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group315 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group315(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group315Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group315Node0 validates against the schema at #.
+func draft2020Group315Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group315Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	if len(draft2020Group315Node2(st, x, ip, sp.Append(draft2020Group0Var120), nil)) == 0 {
+		errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group315Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group315Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var178.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group315Node2 validates against the schema at #/not.
+func draft2020Group315Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if len(draft2020Group315Node3(st, x, ip, sp.Append(draft2020Group0Var120), nil)) == 0 {
+		errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+	}
+
+	return errs
+}
+
+// draft2020Group315Node3 validates against the schema at #/not/not.
+func draft2020Group315Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group315Node4(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group315Node4 validates against the schema at #/not/not/prefixItems/1.
+func draft2020Group315Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var179.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group316 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group316(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group316Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group316Node0 validates against the schema at #.
+func draft2020Group316Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group316Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	ann1 := ann.Child()
+	if len(draft2020Group316Node2(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+		ann2 := ann.Child()
+		if ce := draft2020Group316Node4(st, x, ip, sp.Append(draft2020Group0Var76), ann2); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", ce))
+		} else {
+			ann.Merge(ann2)
+		}
+	} else {
+		ann3 := ann.Child()
+		if ce := draft2020Group316Node6(st, x, ip, sp.Append(draft2020Group0Var79), ann3); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "else", "if condition was false but else validation failed", ce))
+		} else {
+			ann.Merge(ann3)
+		}
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group316Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group316Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var180.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group316Node2 validates against the schema at #/if.
+func draft2020Group316Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group316Node3(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group316Node3 validates against the schema at #/if/prefixItems/1.
+func draft2020Group316Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var181.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group316Node4 validates against the schema at #/then.
+func draft2020Group316Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 2 {
+			errs = append(errs, draft2020Group316Node5(st, arr[2], ip.Index(2), sp.Append(draft2020Group0Var97), nil)...)
+		}
+		ann.ExtendItems(min(3, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group316Node5 validates against the schema at #/then/prefixItems/2.
+func draft2020Group316Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var182.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group316Node6 validates against the schema at #/else.
+func draft2020Group316Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 3 {
+			errs = append(errs, draft2020Group316Node7(st, arr[3], ip.Index(3), sp.Append(draft2020Group0Var183), nil)...)
+		}
+		ann.ExtendItems(min(4, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group316Node7 validates against the schema at #/else/prefixItems/3.
+func draft2020Group316Node7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var184.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group317 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group317(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group317Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group317Node0 validates against the schema at #.
+func draft2020Group317Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group318 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group318(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group318Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group318Node0 validates against the schema at #.
+func draft2020Group318Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group318Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group318Node3(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group318Node1 validates against the schema at #/$ref.
+func draft2020Group318Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group318Node2(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group318Node2 validates against the schema at #/$ref/prefixItems/1.
+func draft2020Group318Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group318Node3 validates against the schema at #/prefixItems/0.
+func draft2020Group318Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group319 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group319(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group319Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group319Node0 validates against the schema at #.
+func draft2020Group319Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group319Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group319Node3(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group319Node1 validates against the schema at #/$ref.
+func draft2020Group319Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group319Node2(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group319Node2 validates against the schema at #/$ref/prefixItems/1.
+func draft2020Group319Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group319Node3 validates against the schema at #/prefixItems/0.
+func draft2020Group319Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group321 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group321(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group321Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group321Node0 validates against the schema at #.
+func draft2020Group321Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group321Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group321Node2(st, x, ip, sp.Append(draft2020Group0Var11), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group321Node1 validates against the schema at #/allOf/0.
+func draft2020Group321Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group321Node2 validates against the schema at #/allOf/1.
+func draft2020Group321Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group322 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group322(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group322Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group322Node0 validates against the schema at #.
+func draft2020Group322Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group322Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group322Node3(st, x, ip, sp.Append(draft2020Group0Var15), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft2020Group322Node1 validates against the schema at #/properties/foo.
+func draft2020Group322Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group322Node2(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group322Node2 validates against the schema at #/properties/foo/prefixItems/0.
+func draft2020Group322Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group322Node3 validates against the schema at #/anyOf/0.
+func draft2020Group322Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group322Node4(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group322Node4 validates against the schema at #/anyOf/0/properties/foo.
+func draft2020Group322Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group322Node5(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group322Node5 validates against the schema at #/anyOf/0/properties/foo/prefixItems/1.
+func draft2020Group322Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group323 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group323(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group323Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group323Node0 validates against the schema at #.
+func draft2020Group323Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group323Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group323Node1 validates against the schema at #/contains.
+func draft2020Group323Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group324 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group324(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group324Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group324Node0 validates against the schema at #.
+func draft2020Group324Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group324Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group324Node3(st, x, ip, sp.Append(draft2020Group0Var11), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := draft2020Group324Node5(st, arr[i], cip, usp, nil); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group324Node1 validates against the schema at #/allOf/0.
+func draft2020Group324Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group324Node2(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group324Node2 validates against the schema at #/allOf/0/contains.
+func draft2020Group324Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var185.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft2020Group324Node3 validates against the schema at #/allOf/1.
+func draft2020Group324Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group324Node4(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group324Node4 validates against the schema at #/allOf/1/contains.
+func draft2020Group324Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var186.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft2020Group324Node5 validates against the schema at #/unevaluatedItems.
+func draft2020Group324Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft2020Group0Var187.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft2020Group325 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group325(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group325Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group325Node0 validates against the schema at #.
+func draft2020Group325Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group325Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+		ann2 := ann.Child()
+		if ce := draft2020Group325Node3(st, x, ip, sp.Append(draft2020Group0Var76), ann2); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", ce))
+		} else {
+			ann.Merge(ann2)
+		}
+	} else {
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group325Node1 validates against the schema at #/if.
+func draft2020Group325Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group325Node2(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group325Node2 validates against the schema at #/if/contains.
+func draft2020Group325Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var188.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group325Node3 validates against the schema at #/then.
+func draft2020Group325Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if len(draft2020Group325Node4(st, x, ip, sp.Append(draft2020Group0Var74), ann3)) == 0 {
+		ann.Merge(ann3)
+
+		ann4 := ann.Child()
+		if ce := draft2020Group325Node6(st, x, ip, sp.Append(draft2020Group0Var76), ann4); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", ce))
+		} else {
+			ann.Merge(ann4)
+		}
+	} else {
+	}
+
+	return errs
+}
+
+// draft2020Group325Node4 validates against the schema at #/then/if.
+func draft2020Group325Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group325Node5(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group325Node5 validates against the schema at #/then/if/contains.
+func draft2020Group325Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var189.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group325Node6 validates against the schema at #/then/then.
+func draft2020Group325Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann5 := ann.Child()
+	if len(draft2020Group325Node7(st, x, ip, sp.Append(draft2020Group0Var74), ann5)) == 0 {
+		ann.Merge(ann5)
+
+	} else {
+	}
+
+	return errs
+}
+
+// draft2020Group325Node7 validates against the schema at #/then/then/if.
+func draft2020Group325Node7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		n := 0
+		for i, item := range arr {
+			if len(draft2020Group325Node8(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group325Node8 validates against the schema at #/then/then/if/contains.
+func draft2020Group325Node8(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var190.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group326 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group326(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group326Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group326Node0 validates against the schema at #.
+func draft2020Group326Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft2020Group0Var40)
+		for i, item := range arr {
+			if len(draft2020Group326Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				ann.RecordItem(i)
+			}
+		}
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group326Node1 validates against the schema at #/contains.
+func draft2020Group326Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group327 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group327(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group327Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group327Node0 validates against the schema at #.
+func draft2020Group327Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group328 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group328(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group328Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group328Node0 validates against the schema at #.
+func draft2020Group328Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := draft2020Group328Node1(st, arr[i], cip, usp, nil); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group328Node1 validates against the schema at #/unevaluatedItems.
+func draft2020Group328Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group329 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group329(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group329Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group329Node0 validates against the schema at #.
+func draft2020Group329Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group329Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+	} else {
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group329Node1 validates against the schema at #/if.
+func draft2020Group329Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group329Node2(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group329Node2 validates against the schema at #/if/prefixItems/0.
+func draft2020Group329Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var191.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group330 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group330(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group330Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group330Node0 validates against the schema at #.
+func draft2020Group330Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group330Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	if arr, ok := x.([]any); ok && !ann.AllItemsSet() {
+		usp := sp.Append(draft2020Group0Var171)
+		for i := range arr {
+			if ann.ItemEvaluated(i) {
+				continue
+			}
+
+			cip := ip.Index(i)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordItem(i)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedItems", fmt.Sprintf("item %d is not allowed by unevaluatedItems", i), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group330Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group330Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group330Node2(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group330Node2 validates against the schema at #/prefixItems/0/prefixItems/1.
+func draft2020Group330Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group331 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group331(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group331Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group331Node0 validates against the schema at #.
+func draft2020Group331Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if _, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// Draft2020Group332 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group332(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group332Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group332Node0 validates against the schema at #.
+func draft2020Group332Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := draft2020Group332Node1(st, obj[k], cip, usp, nil); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group332Node1 validates against the schema at #/unevaluatedProperties.
+func draft2020Group332Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n < 3 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minLength", fmt.Sprintf("string length %d is less than %d", n, 3)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group333 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group333(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group333Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group333Node0 validates against the schema at #.
+func draft2020Group333Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group334 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group334(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group334Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group334Node0 validates against the schema at #.
+func draft2020Group334Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group334Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group334Node1 validates against the schema at #/properties/foo.
+func draft2020Group334Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group335 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group335(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group335Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group335Node0 validates against the schema at #.
+func draft2020Group335Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp1 := sp.Append(draft2020Group0Var192)
+		for _, k := range keys {
+			if !draft2020Group0Var193.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group335Node1(st, obj[k], ip.Key(k), psp1, nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group335Node1 validates against the schema at #/patternProperties/^foo.
+func draft2020Group335Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group336 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group336(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group336Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group336Node0 validates against the schema at #.
+func draft2020Group336Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group336Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+		for _, k := range keys {
+			switch k {
+			case "foo":
+				continue
+			}
+
+			ann.RecordProperty(k)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group336Node1 validates against the schema at #/properties/foo.
+func draft2020Group336Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group337 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group337(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group337Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group337Node0 validates against the schema at #.
+func draft2020Group337Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group337Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			switch k {
+			case "foo":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group337Node2(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group337Node1 validates against the schema at #/properties/foo.
+func draft2020Group337Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group337Node2 validates against the schema at #/additionalProperties.
+func draft2020Group337Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group338 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group338(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group338Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group338Node0 validates against the schema at #.
+func draft2020Group338Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group338Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group338Node2(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group338Node1 validates against the schema at #/properties/foo.
+func draft2020Group338Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group338Node2 validates against the schema at #/allOf/0.
+func draft2020Group338Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group338Node3(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group338Node3 validates against the schema at #/allOf/0/properties/bar.
+func draft2020Group338Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group339 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group339(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group339Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group339Node0 validates against the schema at #.
+func draft2020Group339Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group339Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group339Node2(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group339Node1 validates against the schema at #/properties/foo.
+func draft2020Group339Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group339Node2 validates against the schema at #/allOf/0.
+func draft2020Group339Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp2 := sp.Append(draft2020Group0Var194)
+		for _, k := range keys {
+			if !draft2020Group0Var195.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group339Node3(st, obj[k], ip.Key(k), psp2, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group339Node3 validates against the schema at #/allOf/0/patternProperties/^bar.
+func draft2020Group339Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group340 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group340(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group340Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group340Node0 validates against the schema at #.
+func draft2020Group340Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group340Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group340Node2(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group340Node1 validates against the schema at #/properties/foo.
+func draft2020Group340Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group340Node2 validates against the schema at #/allOf/0.
+func draft2020Group340Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		for _, k := range keys {
+			ann.RecordProperty(k)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// Draft2020Group341 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group341(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group341Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group341Node0 validates against the schema at #.
+func draft2020Group341Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group341Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group341Node2(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := draft2020Group341Node3(st, obj[k], cip, usp, nil); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group341Node1 validates against the schema at #/properties/foo.
+func draft2020Group341Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group341Node2 validates against the schema at #/allOf/0.
+func draft2020Group341Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if _, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group341Node3 validates against the schema at #/unevaluatedProperties.
+func draft2020Group341Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group342 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group342(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group342Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group342Node0 validates against the schema at #.
+func draft2020Group342Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group342Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group342Node2(st, x, ip, sp.Append(draft2020Group0Var15), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group342Node4(st, x, ip, sp.Append(draft2020Group0Var21), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann3 := ann.Child()
+	if ce := draft2020Group342Node6(st, x, ip, sp.Append(draft2020Group0Var196), ann3); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann3)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group342Node1 validates against the schema at #/properties/foo.
+func draft2020Group342Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group342Node2 validates against the schema at #/anyOf/0.
+func draft2020Group342Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group342Node3(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group342Node3 validates against the schema at #/anyOf/0/properties/bar.
+func draft2020Group342Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var197.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group342Node4 validates against the schema at #/anyOf/1.
+func draft2020Group342Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["baz"]; ok {
+			ann.RecordProperty("baz")
+			errs = append(errs, draft2020Group342Node5(st, v, ip.Key("baz"), sp.Append(draft2020Group0Var12), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["baz"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"baz\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group342Node5 validates against the schema at #/anyOf/1/properties/baz.
+func draft2020Group342Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var198.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group342Node6 validates against the schema at #/anyOf/2.
+func draft2020Group342Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["quux"]; ok {
+			ann.RecordProperty("quux")
+			errs = append(errs, draft2020Group342Node7(st, v, ip.Key("quux"), sp.Append(draft2020Group0Var199), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["quux"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"quux\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group342Node7 validates against the schema at #/anyOf/2/properties/quux.
+func draft2020Group342Node7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var200.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group343 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group343(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group343Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group343Node0 validates against the schema at #.
+func draft2020Group343Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group343Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft2020Group343Node2(st, x, ip, sp.Append(draft2020Group0Var16), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group343Node4(st, x, ip, sp.Append(draft2020Group0Var122), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group343Node1 validates against the schema at #/properties/foo.
+func draft2020Group343Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group343Node2 validates against the schema at #/oneOf/0.
+func draft2020Group343Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group343Node3(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group343Node3 validates against the schema at #/oneOf/0/properties/bar.
+func draft2020Group343Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var201.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group343Node4 validates against the schema at #/oneOf/1.
+func draft2020Group343Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["baz"]; ok {
+			ann.RecordProperty("baz")
+			errs = append(errs, draft2020Group343Node5(st, v, ip.Key("baz"), sp.Append(draft2020Group0Var12), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["baz"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"baz\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group343Node5 validates against the schema at #/oneOf/1/properties/baz.
+func draft2020Group343Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var202.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group344 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group344(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group344Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group344Node0 validates against the schema at #.
+func draft2020Group344Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group344Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if len(draft2020Group344Node2(st, x, ip, sp.Append(draft2020Group0Var120), nil)) == 0 {
+		errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group344Node1 validates against the schema at #/properties/foo.
+func draft2020Group344Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group344Node2 validates against the schema at #/not.
+func draft2020Group344Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if len(draft2020Group344Node3(st, x, ip, sp.Append(draft2020Group0Var120), nil)) == 0 {
+		errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+	}
+
+	return errs
+}
+
+// draft2020Group344Node3 validates against the schema at #/not/not.
+func draft2020Group344Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group344Node4(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group344Node4 validates against the schema at #/not/not/properties/bar.
+func draft2020Group344Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var203.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group345 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group345(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group345Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group345Node0 validates against the schema at #.
+func draft2020Group345Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group345Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+		ann2 := ann.Child()
+		if ce := draft2020Group345Node3(st, x, ip, sp.Append(draft2020Group0Var76), ann2); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", ce))
+		} else {
+			ann.Merge(ann2)
+		}
+	} else {
+		ann3 := ann.Child()
+		if ce := draft2020Group345Node5(st, x, ip, sp.Append(draft2020Group0Var79), ann3); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "else", "if condition was false but else validation failed", ce))
+		} else {
+			ann.Merge(ann3)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group345Node1 validates against the schema at #/if.
+func draft2020Group345Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group345Node2(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group345Node2 validates against the schema at #/if/properties/foo.
+func draft2020Group345Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var204.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group345Node3 validates against the schema at #/then.
+func draft2020Group345Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group345Node4(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group345Node4 validates against the schema at #/then/properties/bar.
+func draft2020Group345Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group345Node5 validates against the schema at #/else.
+func draft2020Group345Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["baz"]; ok {
+			ann.RecordProperty("baz")
+			errs = append(errs, draft2020Group345Node6(st, v, ip.Key("baz"), sp.Append(draft2020Group0Var12), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["baz"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"baz\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group345Node6 validates against the schema at #/else/properties/baz.
+func draft2020Group345Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group346 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group346(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group346Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group346Node0 validates against the schema at #.
+func draft2020Group346Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group346Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+	} else {
+		ann2 := ann.Child()
+		if ce := draft2020Group346Node3(st, x, ip, sp.Append(draft2020Group0Var79), ann2); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "else", "if condition was false but else validation failed", ce))
+		} else {
+			ann.Merge(ann2)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group346Node1 validates against the schema at #/if.
+func draft2020Group346Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group346Node2(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group346Node2 validates against the schema at #/if/properties/foo.
+func draft2020Group346Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var205.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group346Node3 validates against the schema at #/else.
+func draft2020Group346Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["baz"]; ok {
+			ann.RecordProperty("baz")
+			errs = append(errs, draft2020Group346Node4(st, v, ip.Key("baz"), sp.Append(draft2020Group0Var12), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["baz"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"baz\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group346Node4 validates against the schema at #/else/properties/baz.
+func draft2020Group346Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group347 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group347(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group347Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group347Node0 validates against the schema at #.
+func draft2020Group347Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group347Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+		ann2 := ann.Child()
+		if ce := draft2020Group347Node3(st, x, ip, sp.Append(draft2020Group0Var76), ann2); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", ce))
+		} else {
+			ann.Merge(ann2)
+		}
+	} else {
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group347Node1 validates against the schema at #/if.
+func draft2020Group347Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group347Node2(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group347Node2 validates against the schema at #/if/properties/foo.
+func draft2020Group347Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var206.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft2020Group347Node3 validates against the schema at #/then.
+func draft2020Group347Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group347Node4(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group347Node4 validates against the schema at #/then/properties/bar.
+func draft2020Group347Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group348 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group348(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group348Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group348Node0 validates against the schema at #.
+func draft2020Group348Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group348Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann1 := ann.Child()
+			if ce := draft2020Group348Node2(st, x, ip, sp.Append(draft2020Group0Var55), ann1); len(ce) > 0 {
+				errs = append(errs, ce...)
+			} else {
+				ann.Merge(ann1)
+			}
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group348Node1 validates against the schema at #/properties/foo.
+func draft2020Group348Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group348Node2 validates against the schema at #/dependentSchemas/foo.
+func draft2020Group348Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group348Node3(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group348Node3 validates against the schema at #/dependentSchemas/foo/properties/bar.
+func draft2020Group348Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft2020Group0Var207.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft2020Group349 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group349(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group349Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group349Node0 validates against the schema at #.
+func draft2020Group349Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group349Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group349Node1 validates against the schema at #/properties/foo.
+func draft2020Group349Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group350 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group350(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group350Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group350Node0 validates against the schema at #.
+func draft2020Group350Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group350Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group350Node3(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group350Node1 validates against the schema at #/$ref.
+func draft2020Group350Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group350Node2(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group350Node2 validates against the schema at #/$ref/properties/bar.
+func draft2020Group350Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group350Node3 validates against the schema at #/properties/foo.
+func draft2020Group350Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group351 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group351(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group351Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group351Node0 validates against the schema at #.
+func draft2020Group351Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group351Node1(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group351Node3(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group351Node1 validates against the schema at #/$ref.
+func draft2020Group351Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group351Node2(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group351Node2 validates against the schema at #/$ref/properties/bar.
+func draft2020Group351Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group351Node3 validates against the schema at #/properties/foo.
+func draft2020Group351Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group353 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group353(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group353Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group353Node0 validates against the schema at #.
+func draft2020Group353Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group353Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group353Node2(st, x, ip, sp.Append(draft2020Group0Var11), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group353Node1 validates against the schema at #/allOf/0.
+func draft2020Group353Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group353Node2 validates against the schema at #/allOf/1.
+func draft2020Group353Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group354 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group354(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group354Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group354Node0 validates against the schema at #.
+func draft2020Group354Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group354Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group354Node2(st, x, ip, sp.Append(draft2020Group0Var11), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group354Node1 validates against the schema at #/allOf/0.
+func draft2020Group354Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group354Node2 validates against the schema at #/allOf/1.
+func draft2020Group354Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group355 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group355(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group355Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group355Node0 validates against the schema at #.
+func draft2020Group355Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group355Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group355Node2(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group355Node1 validates against the schema at #/properties/foo.
+func draft2020Group355Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group355Node2 validates against the schema at #/allOf/0.
+func draft2020Group355Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if _, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// Draft2020Group356 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group356(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group356Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group356Node0 validates against the schema at #.
+func draft2020Group356Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group356Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group356Node1 validates against the schema at #/allOf/0.
+func draft2020Group356Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group356Node2(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if _, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group356Node2 validates against the schema at #/allOf/0/properties/foo.
+func draft2020Group356Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group357 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group357(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group357Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group357Node0 validates against the schema at #.
+func draft2020Group357Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group357Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group357Node2(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if _, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group357Node1 validates against the schema at #/properties/foo.
+func draft2020Group357Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group357Node2 validates against the schema at #/allOf/0.
+func draft2020Group357Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group358 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group358(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group358Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group358Node0 validates against the schema at #.
+func draft2020Group358Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group358Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	if _, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group358Node1 validates against the schema at #/allOf/0.
+func draft2020Group358Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group358Node2(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group358Node2 validates against the schema at #/allOf/0/properties/foo.
+func draft2020Group358Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group359 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group359(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group359Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group359Node0 validates against the schema at #.
+func draft2020Group359Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group359Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group359Node3(st, x, ip, sp.Append(draft2020Group0Var11), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group359Node1 validates against the schema at #/allOf/0.
+func draft2020Group359Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group359Node2(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if _, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group359Node2 validates against the schema at #/allOf/0/properties/foo.
+func draft2020Group359Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group359Node3 validates against the schema at #/allOf/1.
+func draft2020Group359Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group360 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group360(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group360Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group360Node0 validates against the schema at #.
+func draft2020Group360Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group360Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group360Node2(st, x, ip, sp.Append(draft2020Group0Var11), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft2020Group360Node1 validates against the schema at #/allOf/0.
+func draft2020Group360Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if _, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group360Node2 validates against the schema at #/allOf/1.
+func draft2020Group360Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group360Node3(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group360Node3 validates against the schema at #/allOf/1/properties/foo.
+func draft2020Group360Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group361 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group361(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group361Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group361Node0 validates against the schema at #.
+func draft2020Group361Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group361Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group361Node3(st, x, ip, sp.Append(draft2020Group0Var15), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft2020Group361Node1 validates against the schema at #/properties/foo.
+func draft2020Group361Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group361Node2(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group361Node2 validates against the schema at #/properties/foo/properties/bar.
+func draft2020Group361Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group361Node3 validates against the schema at #/anyOf/0.
+func draft2020Group361Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group361Node4(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group361Node4 validates against the schema at #/anyOf/0/properties/foo.
+func draft2020Group361Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["faz"]; ok {
+			ann.RecordProperty("faz")
+			errs = append(errs, draft2020Group361Node5(st, v, ip.Key("faz"), sp.Append(draft2020Group0Var208), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group361Node5 validates against the schema at #/anyOf/0/properties/foo/properties/faz.
+func draft2020Group361Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group362 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group362(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group362Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group362Node0 validates against the schema at #.
+func draft2020Group362Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group362Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft2020Group362Node2(st, x, ip, sp.Append(draft2020Group0Var15), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft2020Group362Node1 validates against the schema at #/allOf/0.
+func draft2020Group362Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group362Node2 validates against the schema at #/anyOf/0.
+func draft2020Group362Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group363 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group363(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group363Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group363Node0 validates against the schema at #.
+func draft2020Group363Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group363Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft2020Group363Node2(st, x, ip, sp.Append(draft2020Group0Var15), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft2020Group363Node1 validates against the schema at #/allOf/0.
+func draft2020Group363Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group363Node2 validates against the schema at #/anyOf/0.
+func draft2020Group363Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group364 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group364(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group364Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group364Node0 validates against the schema at #.
+func draft2020Group364Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["x"]; ok {
+			ann.RecordProperty("x")
+			errs = append(errs, draft2020Group364Node1(st, v, ip.Key("x"), sp.Append(draft2020Group0Var209), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group364Node1 validates against the schema at #/properties/x.
+func draft2020Group364Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft2020Group364Node0(st, x, ip, sp.Append(draft2020Group0Var20), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// Draft2020Group365 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group365(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group365Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group365Node0 validates against the schema at #.
+func draft2020Group365Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft2020Group365Node1(st, x, ip, sp.Append(draft2020Group0Var7), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group365Node3(st, x, ip, sp.Append(draft2020Group0Var11), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann3 := ann.Child()
+	if ce := draft2020Group365Node4(st, x, ip, sp.Append(draft2020Group0Var85), ann3); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+		ann.Merge(ann3)
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group365Node1 validates against the schema at #/allOf/0.
+func draft2020Group365Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := draft2020Group365Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// draft2020Group365Node2 validates against the schema at #/allOf/0/$ref.
+func draft2020Group365Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["a"]; ok {
+			ann.RecordProperty("a")
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group365Node3 validates against the schema at #/allOf/1.
+func draft2020Group365Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["b"]; ok {
+			ann.RecordProperty("b")
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group365Node4 validates against the schema at #/allOf/2.
+func draft2020Group365Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann5 := ann.Child()
+	if ce := draft2020Group365Node5(st, x, ip, sp.Append(draft2020Group0Var16), ann5); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann5
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann6 := ann.Child()
+	if ce := draft2020Group365Node7(st, x, ip, sp.Append(draft2020Group0Var122), ann6); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann6
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft2020Group365Node5 validates against the schema at #/allOf/2/oneOf/0.
+func draft2020Group365Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann7 := ann.Child()
+	if ce := draft2020Group365Node6(st, x, ip, sp.Append(draft2020Group0Var20), ann7); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann7)
+	}
+
+	return errs
+}
+
+// draft2020Group365Node6 validates against the schema at #/allOf/2/oneOf/0/$ref.
+func draft2020Group365Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["x"]; ok {
+			ann.RecordProperty("x")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["x"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"x\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group365Node7 validates against the schema at #/allOf/2/oneOf/1.
+func draft2020Group365Node7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["y"]; ok {
+			ann.RecordProperty("y")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["y"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"y\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group366 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group366(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group366Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group366Node0 validates against the schema at #.
+func draft2020Group366Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft2020Group366Node1(st, x, ip, sp.Append(draft2020Group0Var16), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft2020Group366Node10(st, x, ip, sp.Append(draft2020Group0Var122), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group366Node1 validates against the schema at #/oneOf/0.
+func draft2020Group366Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft2020Group366Node2(st, x, ip, sp.Append(draft2020Group0Var20), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft2020Group366Node2 validates against the schema at #/oneOf/0/$ref.
+func draft2020Group366Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann4 := ann.Child()
+	if ce := draft2020Group366Node3(st, x, ip, sp.Append(draft2020Group0Var16), ann4); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann4
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann5 := ann.Child()
+	if ce := draft2020Group366Node7(st, x, ip, sp.Append(draft2020Group0Var122), ann5); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann5
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann6 := ann.Child()
+	if ce := draft2020Group366Node8(st, x, ip, sp.Append(draft2020Group0Var124), ann6); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann6
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann7 := ann.Child()
+	if ce := draft2020Group366Node9(st, x, ip, sp.Append(draft2020Group0Var213), ann7); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann7
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft2020Group366Node3 validates against the schema at #/oneOf/0/$ref/oneOf/0.
+func draft2020Group366Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann8 := ann.Child()
+	if ce := draft2020Group366Node4(st, x, ip, sp.Append(draft2020Group0Var20), ann8); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann8)
+	}
+
+	return errs
+}
+
+// draft2020Group366Node4 validates against the schema at #/oneOf/0/$ref/oneOf/0/$ref.
+func draft2020Group366Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann9 := ann.Child()
+	if ce := draft2020Group366Node5(st, x, ip, sp.Append(draft2020Group0Var16), ann9); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann9
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann10 := ann.Child()
+	if ce := draft2020Group366Node6(st, x, ip, sp.Append(draft2020Group0Var122), ann10); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann10
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft2020Group366Node5 validates against the schema at #/oneOf/0/$ref/oneOf/0/$ref/oneOf/0.
+func draft2020Group366Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["c"]; ok {
+			ann.RecordProperty("c")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["c"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"c\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group366Node6 validates against the schema at #/oneOf/0/$ref/oneOf/0/$ref/oneOf/1.
+func draft2020Group366Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["d"]; ok {
+			ann.RecordProperty("d")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["d"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"d\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group366Node7 validates against the schema at #/oneOf/0/$ref/oneOf/1.
+func draft2020Group366Node7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["b"]; ok {
+			ann.RecordProperty("b")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["b"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"b\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group366Node8 validates against the schema at #/oneOf/0/$ref/oneOf/2.
+func draft2020Group366Node8(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		for _, k := range keys {
+			if !draft2020Group0Var217.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["xx"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"xx\""))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group366Node9 validates against the schema at #/oneOf/0/$ref/oneOf/3.
+func draft2020Group366Node9(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["all"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"all\""))
+		}
+	}
+
+	if _, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group366Node10 validates against the schema at #/oneOf/1.
+func draft2020Group366Node10(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["a"]; ok {
+			ann.RecordProperty("a")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["a"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"a\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group367 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group367(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group367Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group367Node0 validates against the schema at #.
+func draft2020Group367Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group368 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group368(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group368Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group368Node0 validates against the schema at #.
+func draft2020Group368Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := draft2020Group368Node1(st, obj[k], cip, usp, nil); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group368Node1 validates against the schema at #/unevaluatedProperties.
+func draft2020Group368Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group369 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group369(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group369Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group369Node0 validates against the schema at #.
+func draft2020Group369Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		nsp := sp.Append(draft2020Group0Var8)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := draft2020Group369Node1(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := draft2020Group369Node2(st, obj[k], cip, usp, nil); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group369Node1 validates against the schema at #/propertyNames.
+func draft2020Group369Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group369Node2 validates against the schema at #/unevaluatedProperties.
+func draft2020Group369Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group370 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group370(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group370Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group370Node0 validates against the schema at #.
+func draft2020Group370Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft2020Group370Node1(st, x, ip, sp.Append(draft2020Group0Var74), ann1)) == 0 {
+		ann.Merge(ann1)
+
+	} else {
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group370Node1 validates against the schema at #/if.
+func draft2020Group370Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp2 := sp.Append(draft2020Group0Var218)
+		for _, k := range keys {
+			if !draft2020Group0Var219.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group370Node2(st, obj[k], ip.Key(k), psp2, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group370Node2 validates against the schema at #/if/patternProperties/foo.
+func draft2020Group370Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group371 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group371(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group371Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group371Node0 validates against the schema at #.
+func draft2020Group371Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo2"]; ok {
+			ann.RecordProperty("foo2")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo2"]; ok {
+			ann1 := ann.Child()
+			if ce := draft2020Group371Node1(st, x, ip, sp.Append(draft2020Group0Var10), ann1); len(ce) > 0 {
+				errs = append(errs, ce...)
+			} else {
+				ann.Merge(ann1)
+			}
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group371Node1 validates against the schema at #/dependentSchemas/foo2.
+func draft2020Group371Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group372 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group372(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group372Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group372Node0 validates against the schema at #.
+func draft2020Group372Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group372Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group372Node1 validates against the schema at #/properties/foo.
+func draft2020Group372Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft2020Group372Node2(st, v, ip.Key("bar"), sp.Append(draft2020Group0Var0), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group372Node2 validates against the schema at #/properties/foo/properties/bar.
+func draft2020Group372Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group373 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group373(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group373Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group373Node0 validates against the schema at #.
+func draft2020Group373Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group373Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group373Node1 validates against the schema at #/properties/foo.
+func draft2020Group373Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp1 := sp.Append(draft2020Group0Var220)
+		for _, k := range keys {
+			if !draft2020Group0Var221.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group373Node2(st, obj[k], ip.Key(k), psp1, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group373Node2 validates against the schema at #/properties/foo/patternProperties/^bar$.
+func draft2020Group373Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group374 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group374(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group374Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group374Node0 validates against the schema at #.
+func draft2020Group374Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	if ann == nil {
+		ann = genrt.NewAnnotations()
+	}
+
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft2020Group374Node1(st, v, ip.Key("foo"), sp.Append(draft2020Group0Var1), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok && !ann.AllPropertiesSet() {
+		usp := sp.Append(draft2020Group0Var121)
+		for _, k := range genrt.SortedKeys(obj) {
+			if ann.Evaluated(k) {
+				continue
+			}
+
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, usp, ""); len(ce) == 0 {
+				ann.RecordProperty(k)
+			} else {
+				errs = append(errs, genrt.NewError(cip, usp, "unevaluatedProperties", fmt.Sprintf("property %q is not allowed by unevaluatedProperties", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group374Node1 validates against the schema at #/properties/foo.
+func draft2020Group374Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft2020Group0Var4)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft2020Group374Node2(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft2020Group374Node2 validates against the schema at #/properties/foo/additionalProperties.
+func draft2020Group374Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group375 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group375(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group375Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group375Node0 validates against the schema at #.
+func draft2020Group375Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if genrt.HasDuplicates(arr) {
+			errs = append(errs, genrt.Leaf(ip, sp, "uniqueItems", "array contains duplicate items"))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group376 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group376(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group376Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group376Node0 validates against the schema at #.
+func draft2020Group376Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group376Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group376Node2(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if genrt.HasDuplicates(arr) {
+			errs = append(errs, genrt.Leaf(ip, sp, "uniqueItems", "array contains duplicate items"))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group376Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group376Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group376Node2 validates against the schema at #/prefixItems/1.
+func draft2020Group376Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group377 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group377(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group377Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group377Node0 validates against the schema at #.
+func draft2020Group377Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group377Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group377Node2(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 2; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp1, "items")...)
+		}
+		if len(arr) > 2 {
+			ann.SetAllItems()
+		}
+	}
+
+	if arr, ok := x.([]any); ok {
+		if genrt.HasDuplicates(arr) {
+			errs = append(errs, genrt.Leaf(ip, sp, "uniqueItems", "array contains duplicate items"))
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group377Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group377Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group377Node2 validates against the schema at #/prefixItems/1.
+func draft2020Group377Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group378 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group378(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft2020Group379 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group379(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group379Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group379Node0 validates against the schema at #.
+func draft2020Group379Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group379Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group379Node2(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft2020Group379Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group379Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group379Node2 validates against the schema at #/prefixItems/1.
+func draft2020Group379Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group380 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group380(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group380Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group380Node0 validates against the schema at #.
+func draft2020Group380Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft2020Group380Node1(st, arr[0], ip.Index(0), sp.Append(draft2020Group0Var95), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft2020Group380Node2(st, arr[1], ip.Index(1), sp.Append(draft2020Group0Var96), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+		rsp1 := sp.Append(draft2020Group0Var43)
+		for i := 2; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp1, "items")...)
+		}
+		if len(arr) > 2 {
+			ann.SetAllItems()
+		}
+	}
+
+	return errs
+}
+
+// draft2020Group380Node1 validates against the schema at #/prefixItems/0.
+func draft2020Group380Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft2020Group380Node2 validates against the schema at #/prefixItems/1.
+func draft2020Group380Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft2020Group381 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group381(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group381Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group381Node0 validates against the schema at #.
+func draft2020Group381Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["badProperty"]; ok {
+			ann.RecordProperty("badProperty")
+			errs = append(errs, genrt.False(ip.Key("badProperty"), sp.Append(draft2020Group0Var222), "properties")...)
+		}
+		if _, ok := obj["numberProperty"]; ok {
+			ann.RecordProperty("numberProperty")
+		}
+	}
+
+	return errs
+}
+
+// Draft2020Group382 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020Group382(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020Group382Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020Group382Node0 validates against the schema at #.
+func draft2020Group382Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
@@ -0,0 +1,622 @@
+// Code generated by jsonschema.GenerateGo. DO NOT EDIT.
+
+package codegentest
+
+import (
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/genrt"
+)
+
+var (
+	draft2020FormatGroup0Var0  = genrt.Format("date-time")
+	draft2020FormatGroup0Var1  = genrt.Format("date")
+	draft2020FormatGroup0Var2  = genrt.Format("duration")
+	draft2020FormatGroup0Var3  = genrt.Format("regex")
+	draft2020FormatGroup0Var4  = genrt.Format("email")
+	draft2020FormatGroup0Var5  = genrt.Format("hostname")
+	draft2020FormatGroup0Var6  = genrt.Format("idn-email")
+	draft2020FormatGroup0Var7  = genrt.Format("idn-hostname")
+	draft2020FormatGroup0Var8  = genrt.Format("ipv4")
+	draft2020FormatGroup0Var9  = genrt.Format("ipv6")
+	draft2020FormatGroup0Var10 = genrt.Format("iri-reference")
+	draft2020FormatGroup0Var11 = genrt.Format("iri")
+	draft2020FormatGroup0Var12 = genrt.Format("json-pointer")
+	draft2020FormatGroup0Var13 = genrt.Format("relative-json-pointer")
+	draft2020FormatGroup0Var14 = genrt.Format("time")
+	draft2020FormatGroup0Var15 = genrt.Format("uri-reference")
+	draft2020FormatGroup0Var16 = genrt.Format("uri-template")
+	draft2020FormatGroup0Var17 = genrt.Format("uri")
+	draft2020FormatGroup0Var18 = genrt.Format("uuid")
+)
+
+// Draft2020FormatGroup0 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup0(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup0Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup0Node0 validates against the schema at #.
+func draft2020FormatGroup0Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var0(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "date-time", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup1 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup1(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup1Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup1Node0 validates against the schema at #.
+func draft2020FormatGroup1Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var1(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "date", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup2 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup2(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup2Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup2Node0 validates against the schema at #.
+func draft2020FormatGroup2Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var2(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "duration", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup3 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup3(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup3Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup3Node0 validates against the schema at #.
+func draft2020FormatGroup3Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var3(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "regex", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup4 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup4(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup4Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup4Node0 validates against the schema at #.
+func draft2020FormatGroup4Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var4(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "email", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup5 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup5(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup5Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup5Node0 validates against the schema at #.
+func draft2020FormatGroup5Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var5(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "hostname", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup6 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup6(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup6Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup6Node0 validates against the schema at #.
+func draft2020FormatGroup6Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var5(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "hostname", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup7 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup7(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup7Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup7Node0 validates against the schema at #.
+func draft2020FormatGroup7Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var6(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "idn-email", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup8 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup8(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup8Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup8Node0 validates against the schema at #.
+func draft2020FormatGroup8Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var7(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "idn-hostname", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup9 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup9(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup9Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup9Node0 validates against the schema at #.
+func draft2020FormatGroup9Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var7(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "idn-hostname", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup10 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup10(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup10Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup10Node0 validates against the schema at #.
+func draft2020FormatGroup10Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var8(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "ipv4", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup11 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup11(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup11Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup11Node0 validates against the schema at #.
+func draft2020FormatGroup11Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var9(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "ipv6", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup12 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup12(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup12Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup12Node0 validates against the schema at #.
+func draft2020FormatGroup12Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var10(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "iri-reference", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup13 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup13(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup13Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup13Node0 validates against the schema at #.
+func draft2020FormatGroup13Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var11(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "iri", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup14 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup14(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup14Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup14Node0 validates against the schema at #.
+func draft2020FormatGroup14Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var12(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "json-pointer", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup15 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup15(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup15Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup15Node0 validates against the schema at #.
+func draft2020FormatGroup15Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var3(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "regex", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup16 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup16(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup16Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup16Node0 validates against the schema at #.
+func draft2020FormatGroup16Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var13(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "relative-json-pointer", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup17 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup17(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup17Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup17Node0 validates against the schema at #.
+func draft2020FormatGroup17Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var14(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "time", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup18 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup18(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup18Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup18Node0 validates against the schema at #.
+func draft2020FormatGroup18Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft2020FormatGroup19 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup19(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup19Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup19Node0 validates against the schema at #.
+func draft2020FormatGroup19Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var15(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "uri-reference", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup20 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup20(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup20Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup20Node0 validates against the schema at #.
+func draft2020FormatGroup20Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var16(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "uri-template", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup21 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup21(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup21Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup21Node0 validates against the schema at #.
+func draft2020FormatGroup21Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var17(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "uri", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft2020FormatGroup22 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft2020FormatGroup22(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft2020FormatGroup22Node0(&st, x, nil, nil, nil))
+}
+
+// draft2020FormatGroup22Node0 validates against the schema at #.
+func draft2020FormatGroup22Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft2020FormatGroup0Var18(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "uuid", err))
+		}
+	}
+
+	return errs
+}
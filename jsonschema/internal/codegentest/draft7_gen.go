@@ -0,0 +1,13214 @@
+// Code generated by jsonschema.GenerateGo. DO NOT EDIT.
+
+package codegentest
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/genrt"
+)
+
+var (
+	draft7Group0Var0   = []jsonschema.Segment{{Key: "items"}, {Index: 0, IsIndex: true}}
+	draft7Group0Var1   = []jsonschema.Segment{{Key: "additionalItems"}}
+	draft7Group0Var2   = []jsonschema.Segment{{Key: "items"}}
+	draft7Group0Var3   = []jsonschema.Segment{{Key: "items"}, {Index: 1, IsIndex: true}}
+	draft7Group0Var4   = []jsonschema.Segment{{Key: "items"}, {Index: 2, IsIndex: true}}
+	draft7Group0Var5   = []jsonschema.Segment{{Key: "allOf"}, {Index: 0, IsIndex: true}}
+	draft7Group0Var6   = []jsonschema.Segment{{Key: "properties"}, {Key: "bar"}}
+	draft7Group0Var7   = []jsonschema.Segment{{Key: "properties"}, {Key: "foo"}}
+	draft7Group0Var8   = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "^v"}}
+	draft7Group0Var9   = genrt.Pattern("^v")
+	draft7Group0Var10  = []jsonschema.Segment{{Key: "additionalProperties"}}
+	draft7Group0Var11  = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "^á"}}
+	draft7Group0Var12  = genrt.Pattern("^á")
+	draft7Group0Var13  = []jsonschema.Segment{{Key: "allOf"}, {Index: 1, IsIndex: true}}
+	draft7Group0Var14  = []jsonschema.Segment{{Key: "properties"}, {Key: "baz"}}
+	draft7Group0Var15  = genrt.NewNumeric(nil, nil, genrt.Float(30.0), nil, nil)
+	draft7Group0Var16  = genrt.NewNumeric(nil, genrt.Float(20.0), nil, nil, nil)
+	draft7Group0Var17  = []jsonschema.Segment{{Key: "anyOf"}, {Index: 0, IsIndex: true}}
+	draft7Group0Var18  = []jsonschema.Segment{{Key: "oneOf"}, {Index: 0, IsIndex: true}}
+	draft7Group0Var19  = genrt.NewNumeric(genrt.Float(2.0), nil, nil, nil, nil)
+	draft7Group0Var20  = genrt.NewNumeric(genrt.Float(3.0), nil, nil, nil, nil)
+	draft7Group0Var21  = genrt.NewNumeric(genrt.Float(5.0), nil, nil, nil, nil)
+	draft7Group0Var22  = []jsonschema.Segment{{Key: "anyOf"}, {Index: 1, IsIndex: true}}
+	draft7Group0Var23  = genrt.NewNumeric(nil, genrt.Float(2.0), nil, nil, nil)
+	draft7Group0Var24  = genrt.NewConst(float64(2.0))
+	draft7Group0Var25  = genrt.NewConst(map[string]any{"baz": "bax", "foo": "bar"})
+	draft7Group0Var26  = genrt.NewConst([]any{map[string]any{"foo": "bar"}})
+	draft7Group0Var27  = genrt.NewConst(nil)
+	draft7Group0Var28  = genrt.NewConst(false)
+	draft7Group0Var29  = genrt.NewConst(true)
+	draft7Group0Var30  = genrt.NewConst([]any{false})
+	draft7Group0Var31  = genrt.NewConst([]any{true})
+	draft7Group0Var32  = genrt.NewConst(map[string]any{"a": false})
+	draft7Group0Var33  = genrt.NewConst(map[string]any{"a": true})
+	draft7Group0Var34  = genrt.NewConst(float64(0.0))
+	draft7Group0Var35  = genrt.NewConst(float64(1.0))
+	draft7Group0Var36  = genrt.NewConst(float64(-2.0))
+	draft7Group0Var37  = genrt.NewConst(float64(9.007199254740992e+15))
+	draft7Group0Var38  = genrt.NewConst("hello\x00there")
+	draft7Group0Var39  = genrt.NewConst("μ")
+	draft7Group0Var40  = genrt.NewConst("ä")
+	draft7Group0Var41  = []jsonschema.Segment{{Key: "contains"}}
+	draft7Group0Var42  = genrt.NewNumeric(nil, genrt.Float(5.0), nil, nil, nil)
+	draft7Group0Var43  = genrt.NewConst(float64(5.0))
+	draft7Group0Var44  = genrt.NewNumeric(genrt.Float(2.0), nil, nil, nil, nil)
+	draft7Group0Var45  = genrt.NewNumeric(genrt.Float(3.0), nil, nil, nil, nil)
+	draft7Group0Var46  = []jsonschema.Segment{{Key: "properties"}, {Key: "alpha"}}
+	draft7Group0Var47  = genrt.NewNumeric(nil, nil, genrt.Float(3.0), nil, nil)
+	draft7Group0Var48  = []jsonschema.Segment{{Key: "$ref"}}
+	draft7Group0Var49  = []jsonschema.Segment{{Key: "properties"}, {Key: "$comment"}}
+	draft7Group0Var50  = []jsonschema.Segment{{Key: "properties"}, {Key: "$id"}}
+	draft7Group0Var51  = []jsonschema.Segment{{Key: "properties"}, {Key: "$ref"}}
+	draft7Group0Var52  = []jsonschema.Segment{{Key: "properties"}, {Key: "$schema"}}
+	draft7Group0Var53  = []jsonschema.Segment{{Key: "properties"}, {Key: "additionalItems"}}
+	draft7Group0Var54  = []jsonschema.Segment{{Key: "properties"}, {Key: "additionalProperties"}}
+	draft7Group0Var55  = []jsonschema.Segment{{Key: "properties"}, {Key: "allOf"}}
+	draft7Group0Var56  = []jsonschema.Segment{{Key: "properties"}, {Key: "anyOf"}}
+	draft7Group0Var57  = []jsonschema.Segment{{Key: "properties"}, {Key: "const"}}
+	draft7Group0Var58  = []jsonschema.Segment{{Key: "properties"}, {Key: "contains"}}
+	draft7Group0Var59  = []jsonschema.Segment{{Key: "properties"}, {Key: "contentEncoding"}}
+	draft7Group0Var60  = []jsonschema.Segment{{Key: "properties"}, {Key: "contentMediaType"}}
+	draft7Group0Var61  = []jsonschema.Segment{{Key: "properties"}, {Key: "default"}}
+	draft7Group0Var62  = []jsonschema.Segment{{Key: "properties"}, {Key: "definitions"}}
+	draft7Group0Var63  = []jsonschema.Segment{{Key: "properties"}, {Key: "dependencies"}}
+	draft7Group0Var64  = []jsonschema.Segment{{Key: "properties"}, {Key: "description"}}
+	draft7Group0Var65  = []jsonschema.Segment{{Key: "properties"}, {Key: "else"}}
+	draft7Group0Var66  = []jsonschema.Segment{{Key: "properties"}, {Key: "enum"}}
+	draft7Group0Var67  = []jsonschema.Segment{{Key: "properties"}, {Key: "examples"}}
+	draft7Group0Var68  = []jsonschema.Segment{{Key: "properties"}, {Key: "exclusiveMaximum"}}
+	draft7Group0Var69  = []jsonschema.Segment{{Key: "properties"}, {Key: "exclusiveMinimum"}}
+	draft7Group0Var70  = []jsonschema.Segment{{Key: "properties"}, {Key: "format"}}
+	draft7Group0Var71  = []jsonschema.Segment{{Key: "properties"}, {Key: "if"}}
+	draft7Group0Var72  = []jsonschema.Segment{{Key: "properties"}, {Key: "items"}}
+	draft7Group0Var73  = []jsonschema.Segment{{Key: "properties"}, {Key: "maxItems"}}
+	draft7Group0Var74  = []jsonschema.Segment{{Key: "properties"}, {Key: "maxLength"}}
+	draft7Group0Var75  = []jsonschema.Segment{{Key: "properties"}, {Key: "maxProperties"}}
+	draft7Group0Var76  = []jsonschema.Segment{{Key: "properties"}, {Key: "maximum"}}
+	draft7Group0Var77  = []jsonschema.Segment{{Key: "properties"}, {Key: "minItems"}}
+	draft7Group0Var78  = []jsonschema.Segment{{Key: "properties"}, {Key: "minLength"}}
+	draft7Group0Var79  = []jsonschema.Segment{{Key: "properties"}, {Key: "minProperties"}}
+	draft7Group0Var80  = []jsonschema.Segment{{Key: "properties"}, {Key: "minimum"}}
+	draft7Group0Var81  = []jsonschema.Segment{{Key: "properties"}, {Key: "multipleOf"}}
+	draft7Group0Var82  = []jsonschema.Segment{{Key: "properties"}, {Key: "not"}}
+	draft7Group0Var83  = []jsonschema.Segment{{Key: "properties"}, {Key: "oneOf"}}
+	draft7Group0Var84  = []jsonschema.Segment{{Key: "properties"}, {Key: "pattern"}}
+	draft7Group0Var85  = []jsonschema.Segment{{Key: "properties"}, {Key: "patternProperties"}}
+	draft7Group0Var86  = []jsonschema.Segment{{Key: "properties"}, {Key: "properties"}}
+	draft7Group0Var87  = []jsonschema.Segment{{Key: "properties"}, {Key: "propertyNames"}}
+	draft7Group0Var88  = []jsonschema.Segment{{Key: "properties"}, {Key: "readOnly"}}
+	draft7Group0Var89  = []jsonschema.Segment{{Key: "properties"}, {Key: "required"}}
+	draft7Group0Var90  = []jsonschema.Segment{{Key: "properties"}, {Key: "then"}}
+	draft7Group0Var91  = []jsonschema.Segment{{Key: "properties"}, {Key: "title"}}
+	draft7Group0Var92  = []jsonschema.Segment{{Key: "properties"}, {Key: "type"}}
+	draft7Group0Var93  = []jsonschema.Segment{{Key: "properties"}, {Key: "uniqueItems"}}
+	draft7Group0Var94  = []jsonschema.Segment{{Key: "properties"}, {Key: "writeOnly"}}
+	draft7Group0Var95  = genrt.Format("uri-reference")
+	draft7Group0Var96  = genrt.Format("uri")
+	draft7Group0Var97  = genrt.NewNumeric(nil, genrt.Float(0.0), nil, nil, nil)
+	draft7Group0Var98  = genrt.NewNumeric(nil, nil, nil, genrt.Float(0.0), nil)
+	draft7Group0Var99  = genrt.Format("regex")
+	draft7Group0Var100 = []jsonschema.Segment{{Key: "propertyNames"}}
+	draft7Group0Var101 = genrt.NewEnum([]any{"array", "boolean", "integer", "null", "number", "object", "string"})
+	draft7Group0Var102 = []jsonschema.Segment{{Key: "dependencies"}, {Key: "bar"}}
+	draft7Group0Var103 = []jsonschema.Segment{{Key: "dependencies"}, {Key: "quux"}}
+	draft7Group0Var104 = []jsonschema.Segment{{Key: "dependencies"}, {Key: "foo\tbar"}}
+	draft7Group0Var105 = []jsonschema.Segment{{Key: "dependencies"}, {Key: "foo'bar"}}
+	draft7Group0Var106 = []jsonschema.Segment{{Key: "dependencies"}, {Key: "foo\nbar"}}
+	draft7Group0Var107 = []jsonschema.Segment{{Key: "dependencies"}, {Key: "foo\"bar"}}
+	draft7Group0Var108 = []jsonschema.Segment{{Key: "dependencies"}, {Key: "foo"}}
+	draft7Group0Var109 = genrt.NewEnum([]any{float64(1.0), float64(2.0), float64(3.0)})
+	draft7Group0Var110 = genrt.NewEnum([]any{float64(6.0), "foo", []any{}, true, map[string]any{"foo": float64(12.0)}})
+	draft7Group0Var111 = genrt.NewEnum([]any{float64(6.0), nil})
+	draft7Group0Var112 = genrt.NewEnum([]any{"bar"})
+	draft7Group0Var113 = genrt.NewEnum([]any{"foo"})
+	draft7Group0Var114 = genrt.NewEnum([]any{"foo\nbar", "foo\rbar"})
+	draft7Group0Var115 = genrt.NewEnum([]any{false})
+	draft7Group0Var116 = genrt.NewEnum([]any{[]any{false}})
+	draft7Group0Var117 = genrt.NewEnum([]any{true})
+	draft7Group0Var118 = genrt.NewEnum([]any{[]any{true}})
+	draft7Group0Var119 = genrt.NewEnum([]any{float64(0.0)})
+	draft7Group0Var120 = genrt.NewEnum([]any{[]any{float64(0.0)}})
+	draft7Group0Var121 = genrt.NewEnum([]any{float64(1.0)})
+	draft7Group0Var122 = genrt.NewEnum([]any{[]any{float64(1.0)}})
+	draft7Group0Var123 = genrt.NewEnum([]any{"hello\x00there"})
+	draft7Group0Var124 = genrt.NewNumeric(nil, nil, nil, nil, genrt.Float(3.0))
+	draft7Group0Var125 = genrt.NewNumeric(nil, nil, nil, genrt.Float(1.1), nil)
+	draft7Group0Var126 = []jsonschema.Segment{{Key: "if"}}
+	draft7Group0Var127 = genrt.NewConst(float64(0.0))
+	draft7Group0Var128 = []jsonschema.Segment{{Key: "then"}}
+	draft7Group0Var129 = genrt.NewNumeric(nil, nil, nil, nil, genrt.Float(0.0))
+	draft7Group0Var130 = genrt.NewNumeric(nil, genrt.Float(-10.0), nil, nil, nil)
+	draft7Group0Var131 = []jsonschema.Segment{{Key: "else"}}
+	draft7Group0Var132 = genrt.NewNumeric(nil, nil, nil, nil, genrt.Float(0.0))
+	draft7Group0Var133 = genrt.NewNumeric(genrt.Float(2.0), nil, nil, nil, nil)
+	draft7Group0Var134 = genrt.NewNumeric(nil, nil, nil, nil, genrt.Float(0.0))
+	draft7Group0Var135 = genrt.NewNumeric(nil, genrt.Float(-10.0), nil, nil, nil)
+	draft7Group0Var136 = genrt.NewNumeric(genrt.Float(2.0), nil, nil, nil, nil)
+	draft7Group0Var137 = []jsonschema.Segment{{Key: "allOf"}, {Index: 2, IsIndex: true}}
+	draft7Group0Var138 = genrt.NewNumeric(nil, nil, nil, nil, genrt.Float(0.0))
+	draft7Group0Var139 = genrt.NewConst("then")
+	draft7Group0Var140 = genrt.NewConst("else")
+	draft7Group0Var141 = genrt.NewConst("then")
+	draft7Group0Var142 = genrt.NewConst("else")
+	draft7Group0Var143 = genrt.NewConst("yes")
+	draft7Group0Var144 = genrt.NewConst("other")
+	draft7Group0Var145 = genrt.NewConst(float64(1.0))
+	draft7Group0Var146 = genrt.NewConst(float64(1.0))
+	draft7Group0Var147 = genrt.NewNumeric(nil, nil, genrt.Float(3.0), nil, nil)
+	draft7Group0Var148 = genrt.NewNumeric(nil, nil, genrt.Float(300.0), nil, nil)
+	draft7Group0Var149 = genrt.NewNumeric(nil, genrt.Float(1.1), nil, nil, nil)
+	draft7Group0Var150 = genrt.NewNumeric(nil, genrt.Float(-2.0), nil, nil, nil)
+	draft7Group0Var151 = genrt.NewNumeric(genrt.Float(2.0), nil, nil, nil, nil)
+	draft7Group0Var152 = genrt.NewNumeric(genrt.Float(1.5), nil, nil, nil, nil)
+	draft7Group0Var153 = genrt.NewNumeric(genrt.Float(0.0001), nil, nil, nil, nil)
+	draft7Group0Var154 = genrt.NewNumeric(genrt.Float(0.123456789), nil, nil, nil, nil)
+	draft7Group0Var155 = genrt.NewNumeric(genrt.Float(1e-08), nil, nil, nil, nil)
+	draft7Group0Var156 = []jsonschema.Segment{{Key: "not"}}
+	draft7Group0Var157 = []jsonschema.Segment{{Key: "oneOf"}, {Index: 1, IsIndex: true}}
+	draft7Group0Var158 = genrt.NewNumeric(nil, genrt.Float(2.0), nil, nil, nil)
+	draft7Group0Var159 = []jsonschema.Segment{{Key: "oneOf"}, {Index: 2, IsIndex: true}}
+	draft7Group0Var160 = genrt.Pattern("^a*$")
+	draft7Group0Var161 = genrt.Pattern("a+")
+	draft7Group0Var162 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "f.*o"}}
+	draft7Group0Var163 = genrt.Pattern("f.*o")
+	draft7Group0Var164 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "a*"}}
+	draft7Group0Var165 = genrt.Pattern("a*")
+	draft7Group0Var166 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "aaa*"}}
+	draft7Group0Var167 = genrt.Pattern("aaa*")
+	draft7Group0Var168 = genrt.NewNumeric(nil, nil, genrt.Float(20.0), nil, nil)
+	draft7Group0Var169 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "X_"}}
+	draft7Group0Var170 = genrt.Pattern("X_")
+	draft7Group0Var171 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "[0-9]{2,}"}}
+	draft7Group0Var172 = genrt.Pattern("[0-9]{2,}")
+	draft7Group0Var173 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "b.*"}}
+	draft7Group0Var174 = genrt.Pattern("b.*")
+	draft7Group0Var175 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "f.*"}}
+	draft7Group0Var176 = genrt.Pattern("f.*")
+	draft7Group0Var177 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "^.*bar$"}}
+	draft7Group0Var178 = genrt.Pattern("^.*bar$")
+	draft7Group0Var179 = []jsonschema.Segment{{Key: "patternProperties"}, {Key: "f.o"}}
+	draft7Group0Var180 = genrt.Pattern("f.o")
+	draft7Group0Var181 = []jsonschema.Segment{{Key: "properties"}, {Key: "__proto__"}}
+	draft7Group0Var182 = []jsonschema.Segment{{Key: "properties"}, {Key: "constructor"}}
+	draft7Group0Var183 = []jsonschema.Segment{{Key: "properties"}, {Key: "toString"}}
+	draft7Group0Var184 = []jsonschema.Segment{{Key: "properties"}, {Key: "length"}}
+	draft7Group0Var185 = genrt.Pattern("^a+$")
+	draft7Group0Var186 = genrt.NewConst("foo")
+	draft7Group0Var187 = genrt.NewEnum([]any{"foo", "bar"})
+	draft7Group0Var188 = []jsonschema.Segment{{Key: "properties"}, {Key: "percent"}}
+	draft7Group0Var189 = []jsonschema.Segment{{Key: "properties"}, {Key: "slash"}}
+	draft7Group0Var190 = []jsonschema.Segment{{Key: "properties"}, {Key: "tilde"}}
+	draft7Group0Var191 = genrt.NewNumeric(nil, genrt.Float(0.0), nil, nil, nil)
+	draft7Group0Var192 = genrt.NewNumeric(nil, nil, nil, genrt.Float(0.0), nil)
+	draft7Group0Var193 = genrt.NewEnum([]any{"array", "boolean", "integer", "null", "number", "object", "string"})
+	draft7Group0Var194 = []jsonschema.Segment{{Key: "properties"}, {Key: "meta"}}
+	draft7Group0Var195 = []jsonschema.Segment{{Key: "properties"}, {Key: "nodes"}}
+	draft7Group0Var196 = []jsonschema.Segment{{Key: "properties"}, {Key: "subtree"}}
+	draft7Group0Var197 = []jsonschema.Segment{{Key: "properties"}, {Key: "value"}}
+	draft7Group0Var198 = []jsonschema.Segment{{Key: "properties"}, {Key: "foo\"bar"}}
+	draft7Group0Var199 = genrt.NewEnum([]any{map[string]any{"$ref": "#/definitions/a_string"}})
+	draft7Group0Var200 = genrt.NewNumeric(nil, genrt.Float(30.0), nil, nil, nil)
+	draft7Group0Var201 = []jsonschema.Segment{{Key: "properties"}, {Key: "list"}}
+	draft7Group0Var202 = []jsonschema.Segment{{Key: "properties"}, {Key: "name"}}
+)
+
+// Draft7Group0 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group0(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group0Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group0Node0 validates against the schema at #.
+func draft7Group0Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		ann.ExtendItems(min(1, len(arr)))
+		rsp1 := sp.Append(draft7Group0Var1)
+		for i := 1; i < len(arr); i++ {
+			errs = append(errs, draft7Group0Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group0Node1 validates against the schema at #/additionalItems.
+func draft7Group0Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group1 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group1(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group1Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group1Node0 validates against the schema at #.
+func draft7Group1Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group1Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group1Node1 validates against the schema at #/items.
+func draft7Group1Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group2 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group2(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group2Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group2Node0 validates against the schema at #.
+func draft7Group2Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if _, ok := x.([]any); ok {
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// Draft7Group3 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group3(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group3Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group3Node0 validates against the schema at #.
+func draft7Group3Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		ann.ExtendItems(min(3, len(arr)))
+		rsp1 := sp.Append(draft7Group0Var1)
+		for i := 3; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp1, "additionalItems")...)
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group4 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group4(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group5 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group5(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group5Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group5Node0 validates against the schema at #.
+func draft7Group5Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft7Group5Node1(st, arr[0], ip.Index(0), sp.Append(draft7Group0Var0), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	return errs
+}
+
+// draft7Group5Node1 validates against the schema at #/items/0.
+func draft7Group5Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group6 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group6(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group6Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group6Node0 validates against the schema at #.
+func draft7Group6Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft7Group6Node1(st, arr[0], ip.Index(0), sp.Append(draft7Group0Var0), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+		rsp1 := sp.Append(draft7Group0Var1)
+		for i := 1; i < len(arr); i++ {
+			errs = append(errs, draft7Group6Node2(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft7Group6Node3(st, x, ip, sp.Append(draft7Group0Var5), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group6Node1 validates against the schema at #/items/0.
+func draft7Group6Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group6Node2 validates against the schema at #/additionalItems.
+func draft7Group6Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group6Node3 validates against the schema at #/allOf/0.
+func draft7Group6Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft7Group6Node4(st, arr[0], ip.Index(0), sp.Append(draft7Group0Var0), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft7Group6Node5(st, arr[1], ip.Index(1), sp.Append(draft7Group0Var3), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft7Group6Node4 validates against the schema at #/allOf/0/items/0.
+func draft7Group6Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group6Node5 validates against the schema at #/allOf/0/items/1.
+func draft7Group6Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group7 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group7(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group7Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group7Node0 validates against the schema at #.
+func draft7Group7Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft7Group7Node1(st, arr[0], ip.Index(0), sp.Append(draft7Group0Var0), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+		rsp1 := sp.Append(draft7Group0Var1)
+		for i := 1; i < len(arr); i++ {
+			errs = append(errs, draft7Group7Node2(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group7Node1 validates against the schema at #/items/0.
+func draft7Group7Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group7Node2 validates against the schema at #/additionalItems.
+func draft7Group7Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group8 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group8(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group8Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group8Node0 validates against the schema at #.
+func draft7Group8Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		ann.ExtendItems(min(1, len(arr)))
+		rsp1 := sp.Append(draft7Group0Var1)
+		for i := 1; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp1, "additionalItems")...)
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group9 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group9(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group10 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group10(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group10Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group10Node0 validates against the schema at #.
+func draft7Group10Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+		for _, k := range keys {
+			if !draft7Group0Var9.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+		}
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			switch k {
+			case "bar", "foo":
+				continue
+			}
+
+			if draft7Group0Var9.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// Draft7Group11 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group11(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group11Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group11Node0 validates against the schema at #.
+func draft7Group11Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		for _, k := range keys {
+			if !draft7Group0Var12.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+		}
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			if draft7Group0Var12.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// Draft7Group12 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group12(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group12Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group12Node0 validates against the schema at #.
+func draft7Group12Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			switch k {
+			case "bar", "foo":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group12Node1(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft7Group12Node1 validates against the schema at #/additionalProperties.
+func draft7Group12Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group13 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group13(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group13Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group13Node0 validates against the schema at #.
+func draft7Group13Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group13Node1(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft7Group13Node1 validates against the schema at #/additionalProperties.
+func draft7Group13Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group14 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group14(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group14Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group14Node0 validates against the schema at #.
+func draft7Group14Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group15 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group15(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group15Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group15Node0 validates against the schema at #.
+func draft7Group15Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group15Node1(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group15Node2(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group15Node1 validates against the schema at #/additionalProperties.
+func draft7Group15Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group15Node2 validates against the schema at #/allOf/0.
+func draft7Group15Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group16 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group16(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group16Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group16Node0 validates against the schema at #.
+func draft7Group16Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group16Node1(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft7Group16Node1 validates against the schema at #/additionalProperties.
+func draft7Group16Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group17 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group17(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group17Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group17Node0 validates against the schema at #.
+func draft7Group17Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group17Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft7Group17Node3(st, x, ip, sp.Append(draft7Group0Var13), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group17Node1 validates against the schema at #/allOf/0.
+func draft7Group17Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft7Group17Node2(st, v, ip.Key("bar"), sp.Append(draft7Group0Var6), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group17Node2 validates against the schema at #/allOf/0/properties/bar.
+func draft7Group17Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group17Node3 validates against the schema at #/allOf/1.
+func draft7Group17Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group17Node4(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group17Node4 validates against the schema at #/allOf/1/properties/foo.
+func draft7Group17Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group18 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group18(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group18Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group18Node0 validates against the schema at #.
+func draft7Group18Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft7Group18Node1(st, v, ip.Key("bar"), sp.Append(draft7Group0Var6), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group18Node2(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft7Group18Node4(st, x, ip, sp.Append(draft7Group0Var13), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group18Node1 validates against the schema at #/properties/bar.
+func draft7Group18Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group18Node2 validates against the schema at #/allOf/0.
+func draft7Group18Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group18Node3(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group18Node3 validates against the schema at #/allOf/0/properties/foo.
+func draft7Group18Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group18Node4 validates against the schema at #/allOf/1.
+func draft7Group18Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["baz"]; ok {
+			ann.RecordProperty("baz")
+			errs = append(errs, draft7Group18Node5(st, v, ip.Key("baz"), sp.Append(draft7Group0Var14), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["baz"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"baz\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group18Node5 validates against the schema at #/allOf/1/properties/baz.
+func draft7Group18Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group19 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group19(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group19Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group19Node0 validates against the schema at #.
+func draft7Group19Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group19Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft7Group19Node2(st, x, ip, sp.Append(draft7Group0Var13), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group19Node1 validates against the schema at #/allOf/0.
+func draft7Group19Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var15.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft7Group19Node2 validates against the schema at #/allOf/1.
+func draft7Group19Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var16.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group20 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group20(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group20Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group20Node0 validates against the schema at #.
+func draft7Group20Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft7Group21 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group21(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group21Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group21Node0 validates against the schema at #.
+func draft7Group21Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	allOfErrs = append(allOfErrs, genrt.False(ip, sp.Append(draft7Group0Var13), "")...)
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	}
+
+	return errs
+}
+
+// Draft7Group22 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group22(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group22Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group22Node0 validates against the schema at #.
+func draft7Group22Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	allOfErrs = append(allOfErrs, genrt.False(ip, sp.Append(draft7Group0Var5), "")...)
+	allOfErrs = append(allOfErrs, genrt.False(ip, sp.Append(draft7Group0Var13), "")...)
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	}
+
+	return errs
+}
+
+// Draft7Group23 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group23(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group23Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group23Node0 validates against the schema at #.
+func draft7Group23Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft7Group24 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group24(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group24Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group24Node0 validates against the schema at #.
+func draft7Group24Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft7Group25 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group25(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group25Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group25Node0 validates against the schema at #.
+func draft7Group25Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group25Node1(st, x, ip, sp.Append(draft7Group0Var13), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group25Node1 validates against the schema at #/allOf/1.
+func draft7Group25Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group26 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group26(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group26Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group26Node0 validates against the schema at #.
+func draft7Group26Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group26Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group26Node1 validates against the schema at #/allOf/0.
+func draft7Group26Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group27 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group27(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group27Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group27Node0 validates against the schema at #.
+func draft7Group27Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group27Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group27Node1 validates against the schema at #/allOf/0.
+func draft7Group27Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft7Group27Node2(st, x, ip, sp.Append(draft7Group0Var5), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group27Node2 validates against the schema at #/allOf/0/allOf/0.
+func draft7Group27Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group28 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group28(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group28Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group28Node0 validates against the schema at #.
+func draft7Group28Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group28Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft7Group28Node2(st, x, ip, sp.Append(draft7Group0Var17), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann3 := ann.Child()
+	if ce := draft7Group28Node3(st, x, ip, sp.Append(draft7Group0Var18), ann3); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann3
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft7Group28Node1 validates against the schema at #/allOf/0.
+func draft7Group28Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var19.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft7Group28Node2 validates against the schema at #/anyOf/0.
+func draft7Group28Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var20.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft7Group28Node3 validates against the schema at #/oneOf/0.
+func draft7Group28Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var21.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group29 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group29(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group29Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group29Node0 validates against the schema at #.
+func draft7Group29Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group29Node1(st, x, ip, sp.Append(draft7Group0Var17), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft7Group29Node2(st, x, ip, sp.Append(draft7Group0Var22), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft7Group29Node1 validates against the schema at #/anyOf/0.
+func draft7Group29Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group29Node2 validates against the schema at #/anyOf/1.
+func draft7Group29Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var23.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group30 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group30(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group30Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group30Node0 validates against the schema at #.
+func draft7Group30Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group30Node1(st, x, ip, sp.Append(draft7Group0Var17), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft7Group30Node2(st, x, ip, sp.Append(draft7Group0Var22), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft7Group30Node1 validates against the schema at #/anyOf/0.
+func draft7Group30Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group30Node2 validates against the schema at #/anyOf/1.
+func draft7Group30Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n < 4 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minLength", fmt.Sprintf("string length %d is less than %d", n, 4)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group31 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group31(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group31Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group31Node0 validates against the schema at #.
+func draft7Group31Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft7Group32 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group32(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group32Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group32Node0 validates against the schema at #.
+func draft7Group32Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft7Group33 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group33(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group33Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group33Node0 validates against the schema at #.
+func draft7Group33Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	anyOfErrs = append(anyOfErrs, genrt.False(ip, sp.Append(draft7Group0Var17), "")...)
+	anyOfErrs = append(anyOfErrs, genrt.False(ip, sp.Append(draft7Group0Var22), "")...)
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// Draft7Group34 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group34(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group34Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group34Node0 validates against the schema at #.
+func draft7Group34Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group34Node1(st, x, ip, sp.Append(draft7Group0Var17), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft7Group34Node3(st, x, ip, sp.Append(draft7Group0Var22), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft7Group34Node1 validates against the schema at #/anyOf/0.
+func draft7Group34Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft7Group34Node2(st, v, ip.Key("bar"), sp.Append(draft7Group0Var6), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group34Node2 validates against the schema at #/anyOf/0/properties/bar.
+func draft7Group34Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group34Node3 validates against the schema at #/anyOf/1.
+func draft7Group34Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group34Node4(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group34Node4 validates against the schema at #/anyOf/1/properties/foo.
+func draft7Group34Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group35 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group35(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group35Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group35Node0 validates against the schema at #.
+func draft7Group35Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft7Group35Node1(st, x, ip, sp.Append(draft7Group0Var17), ann1)) == 0 {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group35Node1 validates against the schema at #/anyOf/0.
+func draft7Group35Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group36 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group36(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group36Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group36Node0 validates against the schema at #.
+func draft7Group36Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group36Node1(st, x, ip, sp.Append(draft7Group0Var17), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft7Group36Node1 validates against the schema at #/anyOf/0.
+func draft7Group36Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft7Group36Node2(st, x, ip, sp.Append(draft7Group0Var17), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft7Group36Node2 validates against the schema at #/anyOf/0/anyOf/0.
+func draft7Group36Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group37 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group37(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group38 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group38(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group38Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group38Node0 validates against the schema at #.
+func draft7Group38Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+
+	return errs
+}
+
+// Draft7Group39 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group39(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group39Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group39Node0 validates against the schema at #.
+func draft7Group39Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var24.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group40 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group40(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group40Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group40Node0 validates against the schema at #.
+func draft7Group40Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var25.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group41 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group41(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group41Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group41Node0 validates against the schema at #.
+func draft7Group41Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var26.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group42 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group42(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group42Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group42Node0 validates against the schema at #.
+func draft7Group42Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var27.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group43 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group43(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group43Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group43Node0 validates against the schema at #.
+func draft7Group43Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var28.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group44 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group44(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group44Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group44Node0 validates against the schema at #.
+func draft7Group44Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var29.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group45 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group45(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group45Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group45Node0 validates against the schema at #.
+func draft7Group45Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var30.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group46 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group46(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group46Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group46Node0 validates against the schema at #.
+func draft7Group46Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var31.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group47 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group47(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group47Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group47Node0 validates against the schema at #.
+func draft7Group47Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var32.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group48 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group48(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group48Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group48Node0 validates against the schema at #.
+func draft7Group48Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var33.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group49 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group49(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group49Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group49Node0 validates against the schema at #.
+func draft7Group49Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var34.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group50 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group50(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group50Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group50Node0 validates against the schema at #.
+func draft7Group50Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var35.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group51 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group51(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group51Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group51Node0 validates against the schema at #.
+func draft7Group51Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var36.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group52 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group52(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group52Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group52Node0 validates against the schema at #.
+func draft7Group52Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var37.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group53 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group53(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group53Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group53Node0 validates against the schema at #.
+func draft7Group53Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var38.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group54 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group54(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group54Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group54Node0 validates against the schema at #.
+func draft7Group54Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var39.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group55 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group55(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group55Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group55Node0 validates against the schema at #.
+func draft7Group55Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var40.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group56 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group56(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group56Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group56Node0 validates against the schema at #.
+func draft7Group56Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft7Group0Var41)
+		n := 0
+		for i, item := range arr {
+			if len(draft7Group56Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group56Node1 validates against the schema at #/contains.
+func draft7Group56Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var42.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group57 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group57(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group57Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group57Node0 validates against the schema at #.
+func draft7Group57Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft7Group0Var41)
+		n := 0
+		for i, item := range arr {
+			if len(draft7Group57Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group57Node1 validates against the schema at #/contains.
+func draft7Group57Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var43.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group58 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group58(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group58Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group58Node0 validates against the schema at #.
+func draft7Group58Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		n := len(arr)
+		for i := range arr {
+			ann.RecordItem(i)
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group59 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group59(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group59Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group59Node0 validates against the schema at #.
+func draft7Group59Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if _, ok := x.([]any); ok {
+		errs = append(errs, genrt.Leaf(ip, sp, "contains", "array has 0 matching items, minimum is 1"))
+	}
+
+	return errs
+}
+
+// Draft7Group60 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group60(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group60Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group60Node0 validates against the schema at #.
+func draft7Group60Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group60Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft7Group0Var41)
+		n := 0
+		for i, item := range arr {
+			if len(draft7Group60Node2(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group60Node1 validates against the schema at #/items.
+func draft7Group60Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var44.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft7Group60Node2 validates against the schema at #/contains.
+func draft7Group60Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var45.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group61 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group61(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group61Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group61Node0 validates against the schema at #.
+func draft7Group61Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft7Group0Var41)
+		n := 0
+		for i, item := range arr {
+			if len(draft7Group61Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group61Node1 validates against the schema at #/contains.
+func draft7Group61Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft7Group62 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group62(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group62Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group62Node0 validates against the schema at #.
+func draft7Group62Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		csp := sp.Append(draft7Group0Var41)
+		n := 0
+		for i, item := range arr {
+			if len(draft7Group62Node1(st, item, ip.Index(i), csp, nil)) == 0 {
+				n++
+				ann.RecordItem(i)
+			}
+		}
+		if n < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "contains", fmt.Sprintf("array has %d matching items, minimum is %d", n, 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group62Node1 validates against the schema at #/contains.
+func draft7Group62Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group63 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group63(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group63Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group63Node0 validates against the schema at #.
+func draft7Group63Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group63Node1(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group63Node1 validates against the schema at #/properties/foo.
+func draft7Group63Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group64 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group64(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group64Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group64Node0 validates against the schema at #.
+func draft7Group64Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft7Group64Node1(st, v, ip.Key("bar"), sp.Append(draft7Group0Var6), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group64Node1 validates against the schema at #/properties/bar.
+func draft7Group64Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n < 4 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minLength", fmt.Sprintf("string length %d is less than %d", n, 4)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group65 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group65(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group65Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group65Node0 validates against the schema at #.
+func draft7Group65Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["alpha"]; ok {
+			ann.RecordProperty("alpha")
+			errs = append(errs, draft7Group65Node1(st, v, ip.Key("alpha"), sp.Append(draft7Group0Var46), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group65Node1 validates against the schema at #/properties/alpha.
+func draft7Group65Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = draft7Group0Var47.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group66 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group66(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group66Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group66Node0 validates against the schema at #.
+func draft7Group66Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group66Node1 validates against the schema at #/$ref.
+func draft7Group66Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x) || genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"object\", \"boolean\"], got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["$comment"]; ok {
+			ann.RecordProperty("$comment")
+			errs = append(errs, draft7Group66Node2(st, v, ip.Key("$comment"), sp.Append(draft7Group0Var49), nil)...)
+		}
+		if v, ok := obj["$id"]; ok {
+			ann.RecordProperty("$id")
+			errs = append(errs, draft7Group66Node3(st, v, ip.Key("$id"), sp.Append(draft7Group0Var50), nil)...)
+		}
+		if v, ok := obj["$ref"]; ok {
+			ann.RecordProperty("$ref")
+			errs = append(errs, draft7Group66Node4(st, v, ip.Key("$ref"), sp.Append(draft7Group0Var51), nil)...)
+		}
+		if v, ok := obj["$schema"]; ok {
+			ann.RecordProperty("$schema")
+			errs = append(errs, draft7Group66Node5(st, v, ip.Key("$schema"), sp.Append(draft7Group0Var52), nil)...)
+		}
+		if v, ok := obj["additionalItems"]; ok {
+			ann.RecordProperty("additionalItems")
+			errs = append(errs, draft7Group66Node6(st, v, ip.Key("additionalItems"), sp.Append(draft7Group0Var53), nil)...)
+		}
+		if v, ok := obj["additionalProperties"]; ok {
+			ann.RecordProperty("additionalProperties")
+			errs = append(errs, draft7Group66Node7(st, v, ip.Key("additionalProperties"), sp.Append(draft7Group0Var54), nil)...)
+		}
+		if v, ok := obj["allOf"]; ok {
+			ann.RecordProperty("allOf")
+			errs = append(errs, draft7Group66Node8(st, v, ip.Key("allOf"), sp.Append(draft7Group0Var55), nil)...)
+		}
+		if v, ok := obj["anyOf"]; ok {
+			ann.RecordProperty("anyOf")
+			errs = append(errs, draft7Group66Node11(st, v, ip.Key("anyOf"), sp.Append(draft7Group0Var56), nil)...)
+		}
+		if _, ok := obj["const"]; ok {
+			ann.RecordProperty("const")
+		}
+		if v, ok := obj["contains"]; ok {
+			ann.RecordProperty("contains")
+			errs = append(errs, draft7Group66Node12(st, v, ip.Key("contains"), sp.Append(draft7Group0Var58), nil)...)
+		}
+		if v, ok := obj["contentEncoding"]; ok {
+			ann.RecordProperty("contentEncoding")
+			errs = append(errs, draft7Group66Node13(st, v, ip.Key("contentEncoding"), sp.Append(draft7Group0Var59), nil)...)
+		}
+		if v, ok := obj["contentMediaType"]; ok {
+			ann.RecordProperty("contentMediaType")
+			errs = append(errs, draft7Group66Node14(st, v, ip.Key("contentMediaType"), sp.Append(draft7Group0Var60), nil)...)
+		}
+		if _, ok := obj["default"]; ok {
+			ann.RecordProperty("default")
+		}
+		if v, ok := obj["definitions"]; ok {
+			ann.RecordProperty("definitions")
+			errs = append(errs, draft7Group66Node15(st, v, ip.Key("definitions"), sp.Append(draft7Group0Var62), nil)...)
+		}
+		if v, ok := obj["dependencies"]; ok {
+			ann.RecordProperty("dependencies")
+			errs = append(errs, draft7Group66Node17(st, v, ip.Key("dependencies"), sp.Append(draft7Group0Var63), nil)...)
+		}
+		if v, ok := obj["description"]; ok {
+			ann.RecordProperty("description")
+			errs = append(errs, draft7Group66Node23(st, v, ip.Key("description"), sp.Append(draft7Group0Var64), nil)...)
+		}
+		if v, ok := obj["else"]; ok {
+			ann.RecordProperty("else")
+			errs = append(errs, draft7Group66Node24(st, v, ip.Key("else"), sp.Append(draft7Group0Var65), nil)...)
+		}
+		if v, ok := obj["enum"]; ok {
+			ann.RecordProperty("enum")
+			errs = append(errs, draft7Group66Node25(st, v, ip.Key("enum"), sp.Append(draft7Group0Var66), nil)...)
+		}
+		if v, ok := obj["examples"]; ok {
+			ann.RecordProperty("examples")
+			errs = append(errs, draft7Group66Node26(st, v, ip.Key("examples"), sp.Append(draft7Group0Var67), nil)...)
+		}
+		if v, ok := obj["exclusiveMaximum"]; ok {
+			ann.RecordProperty("exclusiveMaximum")
+			errs = append(errs, draft7Group66Node27(st, v, ip.Key("exclusiveMaximum"), sp.Append(draft7Group0Var68), nil)...)
+		}
+		if v, ok := obj["exclusiveMinimum"]; ok {
+			ann.RecordProperty("exclusiveMinimum")
+			errs = append(errs, draft7Group66Node28(st, v, ip.Key("exclusiveMinimum"), sp.Append(draft7Group0Var69), nil)...)
+		}
+		if v, ok := obj["format"]; ok {
+			ann.RecordProperty("format")
+			errs = append(errs, draft7Group66Node29(st, v, ip.Key("format"), sp.Append(draft7Group0Var70), nil)...)
+		}
+		if v, ok := obj["if"]; ok {
+			ann.RecordProperty("if")
+			errs = append(errs, draft7Group66Node30(st, v, ip.Key("if"), sp.Append(draft7Group0Var71), nil)...)
+		}
+		if v, ok := obj["items"]; ok {
+			ann.RecordProperty("items")
+			errs = append(errs, draft7Group66Node31(st, v, ip.Key("items"), sp.Append(draft7Group0Var72), nil)...)
+		}
+		if v, ok := obj["maxItems"]; ok {
+			ann.RecordProperty("maxItems")
+			errs = append(errs, draft7Group66Node34(st, v, ip.Key("maxItems"), sp.Append(draft7Group0Var73), nil)...)
+		}
+		if v, ok := obj["maxLength"]; ok {
+			ann.RecordProperty("maxLength")
+			errs = append(errs, draft7Group66Node36(st, v, ip.Key("maxLength"), sp.Append(draft7Group0Var74), nil)...)
+		}
+		if v, ok := obj["maxProperties"]; ok {
+			ann.RecordProperty("maxProperties")
+			errs = append(errs, draft7Group66Node37(st, v, ip.Key("maxProperties"), sp.Append(draft7Group0Var75), nil)...)
+		}
+		if v, ok := obj["maximum"]; ok {
+			ann.RecordProperty("maximum")
+			errs = append(errs, draft7Group66Node38(st, v, ip.Key("maximum"), sp.Append(draft7Group0Var76), nil)...)
+		}
+		if v, ok := obj["minItems"]; ok {
+			ann.RecordProperty("minItems")
+			errs = append(errs, draft7Group66Node39(st, v, ip.Key("minItems"), sp.Append(draft7Group0Var77), nil)...)
+		}
+		if v, ok := obj["minLength"]; ok {
+			ann.RecordProperty("minLength")
+			errs = append(errs, draft7Group66Node42(st, v, ip.Key("minLength"), sp.Append(draft7Group0Var78), nil)...)
+		}
+		if v, ok := obj["minProperties"]; ok {
+			ann.RecordProperty("minProperties")
+			errs = append(errs, draft7Group66Node43(st, v, ip.Key("minProperties"), sp.Append(draft7Group0Var79), nil)...)
+		}
+		if v, ok := obj["minimum"]; ok {
+			ann.RecordProperty("minimum")
+			errs = append(errs, draft7Group66Node44(st, v, ip.Key("minimum"), sp.Append(draft7Group0Var80), nil)...)
+		}
+		if v, ok := obj["multipleOf"]; ok {
+			ann.RecordProperty("multipleOf")
+			errs = append(errs, draft7Group66Node45(st, v, ip.Key("multipleOf"), sp.Append(draft7Group0Var81), nil)...)
+		}
+		if v, ok := obj["not"]; ok {
+			ann.RecordProperty("not")
+			errs = append(errs, draft7Group66Node46(st, v, ip.Key("not"), sp.Append(draft7Group0Var82), nil)...)
+		}
+		if v, ok := obj["oneOf"]; ok {
+			ann.RecordProperty("oneOf")
+			errs = append(errs, draft7Group66Node47(st, v, ip.Key("oneOf"), sp.Append(draft7Group0Var83), nil)...)
+		}
+		if v, ok := obj["pattern"]; ok {
+			ann.RecordProperty("pattern")
+			errs = append(errs, draft7Group66Node48(st, v, ip.Key("pattern"), sp.Append(draft7Group0Var84), nil)...)
+		}
+		if v, ok := obj["patternProperties"]; ok {
+			ann.RecordProperty("patternProperties")
+			errs = append(errs, draft7Group66Node49(st, v, ip.Key("patternProperties"), sp.Append(draft7Group0Var85), nil)...)
+		}
+		if v, ok := obj["properties"]; ok {
+			ann.RecordProperty("properties")
+			errs = append(errs, draft7Group66Node52(st, v, ip.Key("properties"), sp.Append(draft7Group0Var86), nil)...)
+		}
+		if v, ok := obj["propertyNames"]; ok {
+			ann.RecordProperty("propertyNames")
+			errs = append(errs, draft7Group66Node54(st, v, ip.Key("propertyNames"), sp.Append(draft7Group0Var87), nil)...)
+		}
+		if v, ok := obj["readOnly"]; ok {
+			ann.RecordProperty("readOnly")
+			errs = append(errs, draft7Group66Node55(st, v, ip.Key("readOnly"), sp.Append(draft7Group0Var88), nil)...)
+		}
+		if v, ok := obj["required"]; ok {
+			ann.RecordProperty("required")
+			errs = append(errs, draft7Group66Node56(st, v, ip.Key("required"), sp.Append(draft7Group0Var89), nil)...)
+		}
+		if v, ok := obj["then"]; ok {
+			ann.RecordProperty("then")
+			errs = append(errs, draft7Group66Node57(st, v, ip.Key("then"), sp.Append(draft7Group0Var90), nil)...)
+		}
+		if v, ok := obj["title"]; ok {
+			ann.RecordProperty("title")
+			errs = append(errs, draft7Group66Node58(st, v, ip.Key("title"), sp.Append(draft7Group0Var91), nil)...)
+		}
+		if v, ok := obj["type"]; ok {
+			ann.RecordProperty("type")
+			errs = append(errs, draft7Group66Node59(st, v, ip.Key("type"), sp.Append(draft7Group0Var92), nil)...)
+		}
+		if v, ok := obj["uniqueItems"]; ok {
+			ann.RecordProperty("uniqueItems")
+			errs = append(errs, draft7Group66Node64(st, v, ip.Key("uniqueItems"), sp.Append(draft7Group0Var93), nil)...)
+		}
+		if v, ok := obj["writeOnly"]; ok {
+			ann.RecordProperty("writeOnly")
+			errs = append(errs, draft7Group66Node65(st, v, ip.Key("writeOnly"), sp.Append(draft7Group0Var94), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group66Node2 validates against the schema at #/$ref/properties/$comment.
+func draft7Group66Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group66Node3 validates against the schema at #/$ref/properties/$id.
+func draft7Group66Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		if err := draft7Group0Var95(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "uri-reference", err))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group66Node4 validates against the schema at #/$ref/properties/$ref.
+func draft7Group66Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		if err := draft7Group0Var95(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "uri-reference", err))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group66Node5 validates against the schema at #/$ref/properties/$schema.
+func draft7Group66Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		if err := draft7Group0Var96(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "uri", err))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group66Node6 validates against the schema at #/$ref/properties/additionalItems.
+func draft7Group66Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group66Node7 validates against the schema at #/$ref/properties/additionalProperties.
+func draft7Group66Node7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft7Group66Node8 validates against the schema at #/$ref/properties/allOf.
+func draft7Group66Node8(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := draft7Group66Node9(st, x, ip, sp.Append(draft7Group0Var48), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// draft7Group66Node9 validates against the schema at #/$ref/properties/allOf/$ref.
+func draft7Group66Node9(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp5 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group66Node10(st, arr[i], ip.Index(i), rsp5, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group66Node10 validates against the schema at #/$ref/properties/allOf/$ref/items.
+func draft7Group66Node10(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann6 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann6); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann6)
+	}
+
+	return errs
+}
+
+// draft7Group66Node11 validates against the schema at #/$ref/properties/anyOf.
+func draft7Group66Node11(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann7 := ann.Child()
+	if ce := draft7Group66Node9(st, x, ip, sp.Append(draft7Group0Var48), ann7); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann7)
+	}
+
+	return errs
+}
+
+// draft7Group66Node12 validates against the schema at #/$ref/properties/contains.
+func draft7Group66Node12(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann8 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann8); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann8)
+	}
+
+	return errs
+}
+
+// draft7Group66Node13 validates against the schema at #/$ref/properties/contentEncoding.
+func draft7Group66Node13(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group66Node14 validates against the schema at #/$ref/properties/contentMediaType.
+func draft7Group66Node14(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group66Node15 validates against the schema at #/$ref/properties/definitions.
+func draft7Group66Node15(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group66Node16(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft7Group66Node16 validates against the schema at #/$ref/properties/definitions/additionalProperties.
+func draft7Group66Node16(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann9 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann9); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann9)
+	}
+
+	return errs
+}
+
+// draft7Group66Node17 validates against the schema at #/$ref/properties/dependencies.
+func draft7Group66Node17(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group66Node18(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft7Group66Node18 validates against the schema at #/$ref/properties/dependencies/additionalProperties.
+func draft7Group66Node18(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann10 := ann.Child()
+	if ce := draft7Group66Node19(st, x, ip, sp.Append(draft7Group0Var17), ann10); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann10)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann11 := ann.Child()
+	if ce := draft7Group66Node20(st, x, ip, sp.Append(draft7Group0Var22), ann11); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann11)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft7Group66Node19 validates against the schema at #/$ref/properties/dependencies/additionalProperties/anyOf/0.
+func draft7Group66Node19(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann12 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann12); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann12)
+	}
+
+	return errs
+}
+
+// draft7Group66Node20 validates against the schema at #/$ref/properties/dependencies/additionalProperties/anyOf/1.
+func draft7Group66Node20(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann13 := ann.Child()
+	if ce := draft7Group66Node21(st, x, ip, sp.Append(draft7Group0Var48), ann13); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann13)
+	}
+
+	return errs
+}
+
+// draft7Group66Node21 validates against the schema at #/$ref/properties/dependencies/additionalProperties/anyOf/1/$ref.
+func draft7Group66Node21(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp14 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group66Node22(st, arr[i], ip.Index(i), rsp14, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok {
+		if genrt.HasDuplicates(arr) {
+			errs = append(errs, genrt.Leaf(ip, sp, "uniqueItems", "array contains duplicate items"))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group66Node22 validates against the schema at #/$ref/properties/dependencies/additionalProperties/anyOf/1/$ref/items.
+func draft7Group66Node22(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group66Node23 validates against the schema at #/$ref/properties/description.
+func draft7Group66Node23(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group66Node24 validates against the schema at #/$ref/properties/else.
+func draft7Group66Node24(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann15 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann15); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann15)
+	}
+
+	return errs
+}
+
+// draft7Group66Node25 validates against the schema at #/$ref/properties/enum.
+func draft7Group66Node25(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if _, ok := x.([]any); ok {
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 1)))
+		}
+		if genrt.HasDuplicates(arr) {
+			errs = append(errs, genrt.Leaf(ip, sp, "uniqueItems", "array contains duplicate items"))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group66Node26 validates against the schema at #/$ref/properties/examples.
+func draft7Group66Node26(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if _, ok := x.([]any); ok {
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group66Node27 validates against the schema at #/$ref/properties/exclusiveMaximum.
+func draft7Group66Node27(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group66Node28 validates against the schema at #/$ref/properties/exclusiveMinimum.
+func draft7Group66Node28(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group66Node29 validates against the schema at #/$ref/properties/format.
+func draft7Group66Node29(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group66Node30 validates against the schema at #/$ref/properties/if.
+func draft7Group66Node30(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann18 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann18); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann18)
+	}
+
+	return errs
+}
+
+// draft7Group66Node31 validates against the schema at #/$ref/properties/items.
+func draft7Group66Node31(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann19 := ann.Child()
+	if ce := draft7Group66Node32(st, x, ip, sp.Append(draft7Group0Var17), ann19); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann19)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann20 := ann.Child()
+	if ce := draft7Group66Node33(st, x, ip, sp.Append(draft7Group0Var22), ann20); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann20)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft7Group66Node32 validates against the schema at #/$ref/properties/items/anyOf/0.
+func draft7Group66Node32(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann21 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann21); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann21)
+	}
+
+	return errs
+}
+
+// draft7Group66Node33 validates against the schema at #/$ref/properties/items/anyOf/1.
+func draft7Group66Node33(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann22 := ann.Child()
+	if ce := draft7Group66Node9(st, x, ip, sp.Append(draft7Group0Var48), ann22); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann22)
+	}
+
+	return errs
+}
+
+// draft7Group66Node34 validates against the schema at #/$ref/properties/maxItems.
+func draft7Group66Node34(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann23 := ann.Child()
+	if ce := draft7Group66Node35(st, x, ip, sp.Append(draft7Group0Var48), ann23); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann23)
+	}
+
+	return errs
+}
+
+// draft7Group66Node35 validates against the schema at #/$ref/properties/maxItems/$ref.
+func draft7Group66Node35(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = draft7Group0Var97.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft7Group66Node36 validates against the schema at #/$ref/properties/maxLength.
+func draft7Group66Node36(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann24 := ann.Child()
+	if ce := draft7Group66Node35(st, x, ip, sp.Append(draft7Group0Var48), ann24); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann24)
+	}
+
+	return errs
+}
+
+// draft7Group66Node37 validates against the schema at #/$ref/properties/maxProperties.
+func draft7Group66Node37(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann25 := ann.Child()
+	if ce := draft7Group66Node35(st, x, ip, sp.Append(draft7Group0Var48), ann25); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann25)
+	}
+
+	return errs
+}
+
+// draft7Group66Node38 validates against the schema at #/$ref/properties/maximum.
+func draft7Group66Node38(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group66Node39 validates against the schema at #/$ref/properties/minItems.
+func draft7Group66Node39(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann26 := ann.Child()
+	if ce := draft7Group66Node40(st, x, ip, sp.Append(draft7Group0Var48), ann26); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann26)
+	}
+
+	return errs
+}
+
+// draft7Group66Node40 validates against the schema at #/$ref/properties/minItems/$ref.
+func draft7Group66Node40(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann27 := ann.Child()
+	if ce := draft7Group66Node41(st, x, ip, sp.Append(draft7Group0Var5), ann27); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann27)
+	}
+
+	return errs
+}
+
+// draft7Group66Node41 validates against the schema at #/$ref/properties/minItems/$ref/allOf/0.
+func draft7Group66Node41(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann28 := ann.Child()
+	if ce := draft7Group66Node35(st, x, ip, sp.Append(draft7Group0Var48), ann28); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann28)
+	}
+
+	return errs
+}
+
+// draft7Group66Node42 validates against the schema at #/$ref/properties/minLength.
+func draft7Group66Node42(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann29 := ann.Child()
+	if ce := draft7Group66Node40(st, x, ip, sp.Append(draft7Group0Var48), ann29); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann29)
+	}
+
+	return errs
+}
+
+// draft7Group66Node43 validates against the schema at #/$ref/properties/minProperties.
+func draft7Group66Node43(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann30 := ann.Child()
+	if ce := draft7Group66Node40(st, x, ip, sp.Append(draft7Group0Var48), ann30); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann30)
+	}
+
+	return errs
+}
+
+// draft7Group66Node44 validates against the schema at #/$ref/properties/minimum.
+func draft7Group66Node44(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group66Node45 validates against the schema at #/$ref/properties/multipleOf.
+func draft7Group66Node45(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = draft7Group0Var98.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft7Group66Node46 validates against the schema at #/$ref/properties/not.
+func draft7Group66Node46(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann31 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann31); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann31)
+	}
+
+	return errs
+}
+
+// draft7Group66Node47 validates against the schema at #/$ref/properties/oneOf.
+func draft7Group66Node47(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann32 := ann.Child()
+	if ce := draft7Group66Node9(st, x, ip, sp.Append(draft7Group0Var48), ann32); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann32)
+	}
+
+	return errs
+}
+
+// draft7Group66Node48 validates against the schema at #/$ref/properties/pattern.
+func draft7Group66Node48(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		if err := draft7Group0Var99(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "regex", err))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group66Node49 validates against the schema at #/$ref/properties/patternProperties.
+func draft7Group66Node49(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group66Node50(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+		nsp := sp.Append(draft7Group0Var100)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := draft7Group66Node51(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft7Group66Node50 validates against the schema at #/$ref/properties/patternProperties/additionalProperties.
+func draft7Group66Node50(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann33 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann33); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann33)
+	}
+
+	return errs
+}
+
+// draft7Group66Node51 validates against the schema at #/$ref/properties/patternProperties/propertyNames.
+func draft7Group66Node51(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7Group0Var99(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "regex", err))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group66Node52 validates against the schema at #/$ref/properties/properties.
+func draft7Group66Node52(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group66Node53(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft7Group66Node53 validates against the schema at #/$ref/properties/properties/additionalProperties.
+func draft7Group66Node53(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann34 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann34); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann34)
+	}
+
+	return errs
+}
+
+// draft7Group66Node54 validates against the schema at #/$ref/properties/propertyNames.
+func draft7Group66Node54(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann35 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann35); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann35)
+	}
+
+	return errs
+}
+
+// draft7Group66Node55 validates against the schema at #/$ref/properties/readOnly.
+func draft7Group66Node55(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group66Node56 validates against the schema at #/$ref/properties/required.
+func draft7Group66Node56(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann36 := ann.Child()
+	if ce := draft7Group66Node21(st, x, ip, sp.Append(draft7Group0Var48), ann36); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann36)
+	}
+
+	return errs
+}
+
+// draft7Group66Node57 validates against the schema at #/$ref/properties/then.
+func draft7Group66Node57(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann37 := ann.Child()
+	if ce := draft7Group66Node1(st, x, ip, sp.Append(draft7Group0Var48), ann37); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann37)
+	}
+
+	return errs
+}
+
+// draft7Group66Node58 validates against the schema at #/$ref/properties/title.
+func draft7Group66Node58(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group66Node59 validates against the schema at #/$ref/properties/type.
+func draft7Group66Node59(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann38 := ann.Child()
+	if ce := draft7Group66Node60(st, x, ip, sp.Append(draft7Group0Var17), ann38); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann38)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann39 := ann.Child()
+	if ce := draft7Group66Node62(st, x, ip, sp.Append(draft7Group0Var22), ann39); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann39)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft7Group66Node60 validates against the schema at #/$ref/properties/type/anyOf/0.
+func draft7Group66Node60(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann40 := ann.Child()
+	if ce := draft7Group66Node61(st, x, ip, sp.Append(draft7Group0Var48), ann40); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann40)
+	}
+
+	return errs
+}
+
+// draft7Group66Node61 validates against the schema at #/$ref/properties/type/anyOf/0/$ref.
+func draft7Group66Node61(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var101.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// draft7Group66Node62 validates against the schema at #/$ref/properties/type/anyOf/1.
+func draft7Group66Node62(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp41 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group66Node63(st, arr[i], ip.Index(i), rsp41, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 1)))
+		}
+		if genrt.HasDuplicates(arr) {
+			errs = append(errs, genrt.Leaf(ip, sp, "uniqueItems", "array contains duplicate items"))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group66Node63 validates against the schema at #/$ref/properties/type/anyOf/1/items.
+func draft7Group66Node63(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann42 := ann.Child()
+	if ce := draft7Group66Node61(st, x, ip, sp.Append(draft7Group0Var48), ann42); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann42)
+	}
+
+	return errs
+}
+
+// draft7Group66Node64 validates against the schema at #/$ref/properties/uniqueItems.
+func draft7Group66Node64(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group66Node65 validates against the schema at #/$ref/properties/writeOnly.
+func draft7Group66Node65(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group67 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group67(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group67Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group67Node0 validates against the schema at #.
+func draft7Group67Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			if _, ok := obj["foo"]; !ok {
+				errs = append(errs, genrt.NewError(ip, sp.Append(draft7Group0Var102), "dependencies", "property \"bar\" requires property \"foo\"", nil))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group68 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group68(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group68Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group68Node0 validates against the schema at #.
+func draft7Group68Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft7Group69 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group69(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group69Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group69Node0 validates against the schema at #.
+func draft7Group69Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["quux"]; ok {
+			if _, ok := obj["foo"]; !ok {
+				errs = append(errs, genrt.NewError(ip, sp.Append(draft7Group0Var103), "dependencies", "property \"quux\" requires property \"foo\"", nil))
+			}
+			if _, ok := obj["bar"]; !ok {
+				errs = append(errs, genrt.NewError(ip, sp.Append(draft7Group0Var103), "dependencies", "property \"quux\" requires property \"bar\"", nil))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group70 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group70(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group70Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group70Node0 validates against the schema at #.
+func draft7Group70Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann1 := ann.Child()
+			if ce := draft7Group70Node1(st, x, ip, sp.Append(draft7Group0Var102), ann1); len(ce) > 0 {
+				errs = append(errs, ce...)
+			} else {
+				ann.Merge(ann1)
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft7Group70Node1 validates against the schema at #/dependencies/bar.
+func draft7Group70Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft7Group70Node2(st, v, ip.Key("bar"), sp.Append(draft7Group0Var6), nil)...)
+		}
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group70Node3(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group70Node2 validates against the schema at #/dependencies/bar/properties/bar.
+func draft7Group70Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group70Node3 validates against the schema at #/dependencies/bar/properties/foo.
+func draft7Group70Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group71 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group71(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group71Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group71Node0 validates against the schema at #.
+func draft7Group71Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			errs = append(errs, genrt.False(ip, sp.Append(draft7Group0Var102), "dependencies")...)
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group72 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group72(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group72Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group72Node0 validates against the schema at #.
+func draft7Group72Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo\tbar"]; ok {
+			ann1 := ann.Child()
+			if ce := draft7Group72Node1(st, x, ip, sp.Append(draft7Group0Var104), ann1); len(ce) > 0 {
+				errs = append(errs, ce...)
+			} else {
+				ann.Merge(ann1)
+			}
+		}
+		if _, ok := obj["foo'bar"]; ok {
+			ann2 := ann.Child()
+			if ce := draft7Group72Node2(st, x, ip, sp.Append(draft7Group0Var105), ann2); len(ce) > 0 {
+				errs = append(errs, ce...)
+			} else {
+				ann.Merge(ann2)
+			}
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo\nbar"]; ok {
+			if _, ok := obj["foo\rbar"]; !ok {
+				errs = append(errs, genrt.NewError(ip, sp.Append(draft7Group0Var106), "dependencies", "property \"foo\\nbar\" requires property \"foo\\rbar\"", nil))
+			}
+		}
+		if _, ok := obj["foo\"bar"]; ok {
+			if _, ok := obj["foo'bar"]; !ok {
+				errs = append(errs, genrt.NewError(ip, sp.Append(draft7Group0Var107), "dependencies", "property \"foo\\\"bar\" requires property \"foo'bar\"", nil))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft7Group72Node1 validates against the schema at #/dependencies/foo	bar.
+func draft7Group72Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) < 4 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minProperties", fmt.Sprintf("object has %d properties, minimum is %d", len(obj), 4)))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group72Node2 validates against the schema at #/dependencies/foo'bar.
+func draft7Group72Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo\"bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\\"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group73 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group73(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group73Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group73Node0 validates against the schema at #.
+func draft7Group73Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann1 := ann.Child()
+			if ce := draft7Group73Node1(st, x, ip, sp.Append(draft7Group0Var108), ann1); len(ce) > 0 {
+				errs = append(errs, ce...)
+			} else {
+				ann.Merge(ann1)
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft7Group73Node1 validates against the schema at #/dependencies/foo.
+func draft7Group73Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			switch k {
+			case "bar":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// Draft7Group74 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group74(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group74Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group74Node0 validates against the schema at #.
+func draft7Group74Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var109.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group75 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group75(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group75Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group75Node0 validates against the schema at #.
+func draft7Group75Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var110.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group76 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group76(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group76Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group76Node0 validates against the schema at #.
+func draft7Group76Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var111.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group77 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group77(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group77Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group77Node0 validates against the schema at #.
+func draft7Group77Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft7Group77Node1(st, v, ip.Key("bar"), sp.Append(draft7Group0Var6), nil)...)
+		}
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group77Node2(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group77Node1 validates against the schema at #/properties/bar.
+func draft7Group77Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var112.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// draft7Group77Node2 validates against the schema at #/properties/foo.
+func draft7Group77Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var113.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group78 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group78(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group78Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group78Node0 validates against the schema at #.
+func draft7Group78Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var114.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group79 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group79(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group79Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group79Node0 validates against the schema at #.
+func draft7Group79Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var115.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group80 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group80(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group80Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group80Node0 validates against the schema at #.
+func draft7Group80Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var116.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group81 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group81(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group81Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group81Node0 validates against the schema at #.
+func draft7Group81Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var117.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group82 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group82(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group82Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group82Node0 validates against the schema at #.
+func draft7Group82Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var118.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group83 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group83(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group83Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group83Node0 validates against the schema at #.
+func draft7Group83Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var119.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group84 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group84(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group84Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group84Node0 validates against the schema at #.
+func draft7Group84Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var120.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group85 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group85(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group85Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group85Node0 validates against the schema at #.
+func draft7Group85Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var121.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group86 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group86(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group86Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group86Node0 validates against the schema at #.
+func draft7Group86Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var122.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group87 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group87(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group87Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group87Node0 validates against the schema at #.
+func draft7Group87Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var123.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group88 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group88(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group88Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group88Node0 validates against the schema at #.
+func draft7Group88Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var124.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group89 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group89(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group89Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group89Node0 validates against the schema at #.
+func draft7Group89Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var125.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group90 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group90(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group91 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group91(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group92 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group92(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group93 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group93(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group94 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group94(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group95 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group95(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group96 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group96(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group97 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group97(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group98 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group98(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group99 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group99(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group100 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group100(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group101 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group101(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group102 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group102(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group103 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group103(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group104 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group104(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group105 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group105(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group106 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group106(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group107 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group107(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group107Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group107Node0 validates against the schema at #.
+func draft7Group107Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft7Group107Node1(st, x, ip, sp.Append(draft7Group0Var126), ann1)) == 0 {
+		ann.Merge(ann1)
+
+	} else {
+	}
+
+	return errs
+}
+
+// draft7Group107Node1 validates against the schema at #/if.
+func draft7Group107Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var127.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group108 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group108(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group109 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group109(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group110 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group110(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group110Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group110Node0 validates against the schema at #.
+func draft7Group110Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft7Group110Node1(st, x, ip, sp.Append(draft7Group0Var126), ann1)) == 0 {
+		ann.Merge(ann1)
+
+		ann2 := ann.Child()
+		if ce := draft7Group110Node2(st, x, ip, sp.Append(draft7Group0Var128), ann2); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", ce))
+		} else {
+			ann.Merge(ann2)
+		}
+	} else {
+	}
+
+	return errs
+}
+
+// draft7Group110Node1 validates against the schema at #/if.
+func draft7Group110Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var129.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft7Group110Node2 validates against the schema at #/then.
+func draft7Group110Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var130.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group111 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group111(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group111Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group111Node0 validates against the schema at #.
+func draft7Group111Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft7Group111Node1(st, x, ip, sp.Append(draft7Group0Var126), ann1)) == 0 {
+		ann.Merge(ann1)
+
+	} else {
+		ann2 := ann.Child()
+		if ce := draft7Group111Node2(st, x, ip, sp.Append(draft7Group0Var131), ann2); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "else", "if condition was false but else validation failed", ce))
+		} else {
+			ann.Merge(ann2)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group111Node1 validates against the schema at #/if.
+func draft7Group111Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var132.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft7Group111Node2 validates against the schema at #/else.
+func draft7Group111Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var133.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group112 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group112(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group112Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group112Node0 validates against the schema at #.
+func draft7Group112Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft7Group112Node1(st, x, ip, sp.Append(draft7Group0Var126), ann1)) == 0 {
+		ann.Merge(ann1)
+
+		ann2 := ann.Child()
+		if ce := draft7Group112Node2(st, x, ip, sp.Append(draft7Group0Var128), ann2); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", ce))
+		} else {
+			ann.Merge(ann2)
+		}
+	} else {
+		ann3 := ann.Child()
+		if ce := draft7Group112Node3(st, x, ip, sp.Append(draft7Group0Var131), ann3); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "else", "if condition was false but else validation failed", ce))
+		} else {
+			ann.Merge(ann3)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group112Node1 validates against the schema at #/if.
+func draft7Group112Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var134.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft7Group112Node2 validates against the schema at #/then.
+func draft7Group112Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var135.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft7Group112Node3 validates against the schema at #/else.
+func draft7Group112Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var136.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group113 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group113(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group113Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group113Node0 validates against the schema at #.
+func draft7Group113Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group113Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group113Node1 validates against the schema at #/allOf/0.
+func draft7Group113Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if len(draft7Group113Node2(st, x, ip, sp.Append(draft7Group0Var126), ann2)) == 0 {
+		ann.Merge(ann2)
+
+	} else {
+	}
+
+	return errs
+}
+
+// draft7Group113Node2 validates against the schema at #/allOf/0/if.
+func draft7Group113Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var138.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group114 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group114(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group114Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group114Node0 validates against the schema at #.
+func draft7Group114Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group114Node1(st, x, ip, sp.Append(draft7Group0Var128), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group114Node1 validates against the schema at #/then.
+func draft7Group114Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var139.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft7Group114Node2 validates against the schema at #/else.
+func draft7Group114Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var140.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group115 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group115(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group115Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group115Node0 validates against the schema at #.
+func draft7Group115Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group115Node2(st, x, ip, sp.Append(draft7Group0Var131), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "else", "if condition was false but else validation failed", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group115Node1 validates against the schema at #/then.
+func draft7Group115Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var141.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft7Group115Node2 validates against the schema at #/else.
+func draft7Group115Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var142.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group116 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group116(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group116Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group116Node0 validates against the schema at #.
+func draft7Group116Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft7Group116Node1(st, x, ip, sp.Append(draft7Group0Var126), ann1)) == 0 {
+		ann.Merge(ann1)
+
+		ann2 := ann.Child()
+		if ce := draft7Group116Node2(st, x, ip, sp.Append(draft7Group0Var128), ann2); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", ce))
+		} else {
+			ann.Merge(ann2)
+		}
+	} else {
+		ann3 := ann.Child()
+		if ce := draft7Group116Node3(st, x, ip, sp.Append(draft7Group0Var131), ann3); len(ce) > 0 {
+			errs = append(errs, genrt.Wrap(ip, sp, "else", "if condition was false but else validation failed", ce))
+		} else {
+			ann.Merge(ann3)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group116Node1 validates against the schema at #/if.
+func draft7Group116Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 4 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 4)))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group116Node2 validates against the schema at #/then.
+func draft7Group116Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var143.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// draft7Group116Node3 validates against the schema at #/else.
+func draft7Group116Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var144.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group117 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group117(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group117Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group117Node0 validates against the schema at #.
+func draft7Group117Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft7Group117Node1(st, x, ip, sp.Append(draft7Group0Var126), ann1)) == 0 {
+		ann.Merge(ann1)
+
+		errs = append(errs, genrt.Wrap(ip, sp, "then", "if condition was true but then validation failed", genrt.False(ip, sp.Append(draft7Group0Var128), "")))
+	} else {
+	}
+
+	return errs
+}
+
+// draft7Group117Node1 validates against the schema at #/if.
+func draft7Group117Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var145.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group118 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group118(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group118Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group118Node0 validates against the schema at #.
+func draft7Group118Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if len(draft7Group118Node1(st, x, ip, sp.Append(draft7Group0Var126), ann1)) == 0 {
+		ann.Merge(ann1)
+
+	} else {
+		errs = append(errs, genrt.Wrap(ip, sp, "else", "if condition was false but else validation failed", genrt.False(ip, sp.Append(draft7Group0Var131), "")))
+	}
+
+	return errs
+}
+
+// draft7Group118Node1 validates against the schema at #/if.
+func draft7Group118Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var146.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group119 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group119(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group119Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group119Node0 validates against the schema at #.
+func draft7Group119Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group119Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft7Group119Node4(st, x, ip, sp.Append(draft7Group0Var13), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group119Node1 validates against the schema at #/allOf/0.
+func draft7Group119Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group119Node2(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group119Node2 validates against the schema at #/allOf/0/properties/foo.
+func draft7Group119Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft7Group119Node3(st, x, ip, sp.Append(draft7Group0Var48), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft7Group119Node3 validates against the schema at #/allOf/0/properties/foo/$ref.
+func draft7Group119Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group119Node4 validates against the schema at #/allOf/1.
+func draft7Group119Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group119Node5(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft7Group119Node5 validates against the schema at #/allOf/1/additionalProperties.
+func draft7Group119Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := draft7Group119Node3(st, x, ip, sp.Append(draft7Group0Var48), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// Draft7Group120 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group120(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group120Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group120Node0 validates against the schema at #.
+func draft7Group120Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group120Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group120Node1 validates against the schema at #/items.
+func draft7Group120Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group121 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group121(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group121Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group121Node0 validates against the schema at #.
+func draft7Group121Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft7Group121Node1(st, arr[0], ip.Index(0), sp.Append(draft7Group0Var0), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft7Group121Node2(st, arr[1], ip.Index(1), sp.Append(draft7Group0Var3), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft7Group121Node1 validates against the schema at #/items/0.
+func draft7Group121Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group121Node2 validates against the schema at #/items/1.
+func draft7Group121Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group122 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group122(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group122Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group122Node0 validates against the schema at #.
+func draft7Group122Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if _, ok := x.([]any); ok {
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// Draft7Group123 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group123(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group123Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group123Node0 validates against the schema at #.
+func draft7Group123Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp1, "items")...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// Draft7Group124 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group124(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group124Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group124Node0 validates against the schema at #.
+func draft7Group124Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 1 {
+			errs = append(errs, genrt.False(ip.Index(1), sp.Append(draft7Group0Var3), "items")...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// Draft7Group125 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group125(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group125Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group125Node0 validates against the schema at #.
+func draft7Group125Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft7Group125Node1(st, arr[0], ip.Index(0), sp.Append(draft7Group0Var0), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft7Group125Node6(st, arr[1], ip.Index(1), sp.Append(draft7Group0Var3), nil)...)
+		}
+		if len(arr) > 2 {
+			errs = append(errs, draft7Group125Node7(st, arr[2], ip.Index(2), sp.Append(draft7Group0Var4), nil)...)
+		}
+		ann.ExtendItems(min(3, len(arr)))
+		rsp1 := sp.Append(draft7Group0Var1)
+		for i := 3; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp1, "additionalItems")...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group125Node1 validates against the schema at #/items/0.
+func draft7Group125Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group125Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group125Node2 validates against the schema at #/items/0/$ref.
+func draft7Group125Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft7Group125Node3(st, arr[0], ip.Index(0), sp.Append(draft7Group0Var0), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft7Group125Node5(st, arr[1], ip.Index(1), sp.Append(draft7Group0Var3), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+		rsp3 := sp.Append(draft7Group0Var1)
+		for i := 2; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp3, "additionalItems")...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group125Node3 validates against the schema at #/items/0/$ref/items/0.
+func draft7Group125Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := draft7Group125Node4(st, x, ip, sp.Append(draft7Group0Var48), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// draft7Group125Node4 validates against the schema at #/items/0/$ref/items/0/$ref.
+func draft7Group125Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group125Node5 validates against the schema at #/items/0/$ref/items/1.
+func draft7Group125Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann5 := ann.Child()
+	if ce := draft7Group125Node4(st, x, ip, sp.Append(draft7Group0Var48), ann5); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann5)
+	}
+
+	return errs
+}
+
+// draft7Group125Node6 validates against the schema at #/items/1.
+func draft7Group125Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann6 := ann.Child()
+	if ce := draft7Group125Node2(st, x, ip, sp.Append(draft7Group0Var48), ann6); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann6)
+	}
+
+	return errs
+}
+
+// draft7Group125Node7 validates against the schema at #/items/2.
+func draft7Group125Node7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann7 := ann.Child()
+	if ce := draft7Group125Node2(st, x, ip, sp.Append(draft7Group0Var48), ann7); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann7)
+	}
+
+	return errs
+}
+
+// Draft7Group126 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group126(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group126Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group126Node0 validates against the schema at #.
+func draft7Group126Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group126Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group126Node1 validates against the schema at #/items.
+func draft7Group126Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp2 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group126Node2(st, arr[i], ip.Index(i), rsp2, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group126Node2 validates against the schema at #/items/items.
+func draft7Group126Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp3 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group126Node3(st, arr[i], ip.Index(i), rsp3, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group126Node3 validates against the schema at #/items/items/items.
+func draft7Group126Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp4 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group126Node4(st, arr[i], ip.Index(i), rsp4, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group126Node4 validates against the schema at #/items/items/items/items.
+func draft7Group126Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group127 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group127(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group127Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group127Node0 validates against the schema at #.
+func draft7Group127Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group127Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group127Node1 validates against the schema at #/items.
+func draft7Group127Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group128 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group128(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group128Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group128Node0 validates against the schema at #.
+func draft7Group128Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft7Group128Node1(st, arr[0], ip.Index(0), sp.Append(draft7Group0Var0), nil)...)
+		}
+		ann.ExtendItems(min(1, len(arr)))
+	}
+
+	return errs
+}
+
+// draft7Group128Node1 validates against the schema at #/items/0.
+func draft7Group128Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group129 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group129(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group129Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group129Node0 validates against the schema at #.
+func draft7Group129Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxItems", fmt.Sprintf("array has %d items, maximum is %d", len(arr), 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group130 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group130(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group130Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group130Node0 validates against the schema at #.
+func draft7Group130Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxItems", fmt.Sprintf("array has %d items, maximum is %d", len(arr), 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group131 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group131(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group131Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group131Node0 validates against the schema at #.
+func draft7Group131Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group132 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group132(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group132Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group132Node0 validates against the schema at #.
+func draft7Group132Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group133 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group133(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group133Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group133Node0 validates against the schema at #.
+func draft7Group133Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxProperties", fmt.Sprintf("object has %d properties, maximum is %d", len(obj), 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group134 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group134(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group134Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group134Node0 validates against the schema at #.
+func draft7Group134Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxProperties", fmt.Sprintf("object has %d properties, maximum is %d", len(obj), 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group135 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group135(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group135Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group135Node0 validates against the schema at #.
+func draft7Group135Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) > 0 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxProperties", fmt.Sprintf("object has %d properties, maximum is %d", len(obj), 0)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group136 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group136(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group136Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group136Node0 validates against the schema at #.
+func draft7Group136Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var147.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group137 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group137(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group137Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group137Node0 validates against the schema at #.
+func draft7Group137Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var148.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group138 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group138(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group138Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group138Node0 validates against the schema at #.
+func draft7Group138Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 1)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group139 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group139(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group139Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group139Node0 validates against the schema at #.
+func draft7Group139Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 1)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group140 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group140(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group140Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group140Node0 validates against the schema at #.
+func draft7Group140Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minLength", fmt.Sprintf("string length %d is less than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group141 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group141(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group141Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group141Node0 validates against the schema at #.
+func draft7Group141Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minLength", fmt.Sprintf("string length %d is less than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group142 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group142(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group142Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group142Node0 validates against the schema at #.
+func draft7Group142Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minProperties", fmt.Sprintf("object has %d properties, minimum is %d", len(obj), 1)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group143 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group143(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group143Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group143Node0 validates against the schema at #.
+func draft7Group143Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minProperties", fmt.Sprintf("object has %d properties, minimum is %d", len(obj), 1)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group144 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group144(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group144Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group144Node0 validates against the schema at #.
+func draft7Group144Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var149.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group145 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group145(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group145Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group145Node0 validates against the schema at #.
+func draft7Group145Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var150.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group146 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group146(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group146Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group146Node0 validates against the schema at #.
+func draft7Group146Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var151.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group147 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group147(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group147Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group147Node0 validates against the schema at #.
+func draft7Group147Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var152.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group148 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group148(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group148Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group148Node0 validates against the schema at #.
+func draft7Group148Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var153.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group149 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group149(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group149Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group149Node0 validates against the schema at #.
+func draft7Group149Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = draft7Group0Var154.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group150 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group150(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group150Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group150Node0 validates against the schema at #.
+func draft7Group150Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = draft7Group0Var155.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group151 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group151(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group151Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group151Node0 validates against the schema at #.
+func draft7Group151Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if len(draft7Group151Node1(st, x, ip, sp.Append(draft7Group0Var156), nil)) == 0 {
+		errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+	}
+
+	return errs
+}
+
+// draft7Group151Node1 validates against the schema at #/not.
+func draft7Group151Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group152 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group152(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group152Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group152Node0 validates against the schema at #.
+func draft7Group152Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if len(draft7Group152Node1(st, x, ip, sp.Append(draft7Group0Var156), nil)) == 0 {
+		errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+	}
+
+	return errs
+}
+
+// draft7Group152Node1 validates against the schema at #/not.
+func draft7Group152Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x) || genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"integer\", \"boolean\"], got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group153 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group153(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group153Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group153Node0 validates against the schema at #.
+func draft7Group153Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if len(draft7Group153Node1(st, x, ip, sp.Append(draft7Group0Var156), nil)) == 0 {
+		errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+	}
+
+	return errs
+}
+
+// draft7Group153Node1 validates against the schema at #/not.
+func draft7Group153Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group153Node2(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group153Node2 validates against the schema at #/not/properties/foo.
+func draft7Group153Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group154 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group154(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group154Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group154Node0 validates against the schema at #.
+func draft7Group154Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, genrt.False(ip.Key("foo"), sp.Append(draft7Group0Var7), "properties")...)
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group155 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group155(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group155Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group155Node0 validates against the schema at #.
+func draft7Group155Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+
+	return errs
+}
+
+// Draft7Group156 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group156(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group156Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group156Node0 validates against the schema at #.
+func draft7Group156Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = append(errs, genrt.Leaf(ip, sp, "not", "should not validate against the schema"))
+
+	return errs
+}
+
+// Draft7Group157 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group157(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group157Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group157Node0 validates against the schema at #.
+func draft7Group157Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft7Group158 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group158(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group158Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group158Node0 validates against the schema at #.
+func draft7Group158Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft7Group159 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group159(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group159Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group159Node0 validates against the schema at #.
+func draft7Group159Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft7Group159Node1(st, x, ip, sp.Append(draft7Group0Var18), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft7Group159Node2(st, x, ip, sp.Append(draft7Group0Var157), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft7Group159Node1 validates against the schema at #/oneOf/0.
+func draft7Group159Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group159Node2 validates against the schema at #/oneOf/1.
+func draft7Group159Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var158.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group160 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group160(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group160Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group160Node0 validates against the schema at #.
+func draft7Group160Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft7Group160Node1(st, x, ip, sp.Append(draft7Group0Var18), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft7Group160Node2(st, x, ip, sp.Append(draft7Group0Var157), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft7Group160Node1 validates against the schema at #/oneOf/0.
+func draft7Group160Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minLength", fmt.Sprintf("string length %d is less than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group160Node2 validates against the schema at #/oneOf/1.
+func draft7Group160Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 4 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 4)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group161 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group161(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group161Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group161Node0 validates against the schema at #.
+func draft7Group161Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 3
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// Draft7Group162 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group162(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group162Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group162Node0 validates against the schema at #.
+func draft7Group162Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 1
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	oneOfErrs = append(oneOfErrs, genrt.False(ip, sp.Append(draft7Group0Var157), "")...)
+	oneOfErrs = append(oneOfErrs, genrt.False(ip, sp.Append(draft7Group0Var159), "")...)
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// Draft7Group163 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group163(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group163Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group163Node0 validates against the schema at #.
+func draft7Group163Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 2
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	oneOfErrs = append(oneOfErrs, genrt.False(ip, sp.Append(draft7Group0Var159), "")...)
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// Draft7Group164 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group164(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group164Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group164Node0 validates against the schema at #.
+func draft7Group164Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	oneOfErrs = append(oneOfErrs, genrt.False(ip, sp.Append(draft7Group0Var18), "")...)
+	oneOfErrs = append(oneOfErrs, genrt.False(ip, sp.Append(draft7Group0Var157), "")...)
+	oneOfErrs = append(oneOfErrs, genrt.False(ip, sp.Append(draft7Group0Var159), "")...)
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// Draft7Group165 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group165(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group165Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group165Node0 validates against the schema at #.
+func draft7Group165Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft7Group165Node1(st, x, ip, sp.Append(draft7Group0Var18), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft7Group165Node3(st, x, ip, sp.Append(draft7Group0Var157), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft7Group165Node1 validates against the schema at #/oneOf/0.
+func draft7Group165Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft7Group165Node2(st, v, ip.Key("bar"), sp.Append(draft7Group0Var6), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group165Node2 validates against the schema at #/oneOf/0/properties/bar.
+func draft7Group165Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group165Node3 validates against the schema at #/oneOf/1.
+func draft7Group165Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group165Node4(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group165Node4 validates against the schema at #/oneOf/1/properties/foo.
+func draft7Group165Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group166 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group166(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group166Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group166Node0 validates against the schema at #.
+func draft7Group166Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 1
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft7Group166Node1(st, x, ip, sp.Append(draft7Group0Var18), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft7Group166Node1 validates against the schema at #/oneOf/0.
+func draft7Group166Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group167 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group167(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group167Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group167Node0 validates against the schema at #.
+func draft7Group167Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft7Group167Node1(st, x, ip, sp.Append(draft7Group0Var18), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft7Group167Node2(st, x, ip, sp.Append(draft7Group0Var157), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft7Group167Node1 validates against the schema at #/oneOf/0.
+func draft7Group167Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group167Node2 validates against the schema at #/oneOf/1.
+func draft7Group167Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+		if _, ok := obj["baz"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"baz\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group168 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group168(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group168Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group168Node0 validates against the schema at #.
+func draft7Group168Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft7Group168Node1(st, x, ip, sp.Append(draft7Group0Var18), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft7Group168Node2(st, x, ip, sp.Append(draft7Group0Var157), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft7Group168Node1 validates against the schema at #/oneOf/0.
+func draft7Group168Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+		if _, ok := obj["baz"]; ok {
+			ann.RecordProperty("baz")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bar\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group168Node2 validates against the schema at #/oneOf/1.
+func draft7Group168Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group169 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group169(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group169Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group169Node0 validates against the schema at #.
+func draft7Group169Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann1 := ann.Child()
+	if ce := draft7Group169Node1(st, x, ip, sp.Append(draft7Group0Var18), ann1); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann1
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft7Group169Node1 validates against the schema at #/oneOf/0.
+func draft7Group169Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	oneOfMatches := 0
+
+	var (
+		oneOfErrs []*jsonschema.ValidationError
+		oneOfAnn  *genrt.Annotations
+	)
+	ann2 := ann.Child()
+	if ce := draft7Group169Node2(st, x, ip, sp.Append(draft7Group0Var18), ann2); len(ce) == 0 {
+		oneOfMatches++
+		oneOfAnn = ann2
+	} else {
+		oneOfErrs = append(oneOfErrs, ce...)
+	}
+	switch {
+	case oneOfMatches == 0:
+		errs = append(errs, genrt.Wrap(ip, sp, "oneOf", "did not validate against any subschema", oneOfErrs))
+	case oneOfMatches > 1:
+		errs = append(errs, genrt.Leaf(ip, sp, "oneOf", fmt.Sprintf("validated against %d subschemas, expected exactly one", oneOfMatches)))
+	default:
+		ann.Merge(oneOfAnn)
+	}
+
+	return errs
+}
+
+// draft7Group169Node2 validates against the schema at #/oneOf/0/oneOf/0.
+func draft7Group169Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group170 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group170(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group170Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group170Node0 validates against the schema at #.
+func draft7Group170Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if !draft7Group0Var160.MatchString(s) {
+			errs = append(errs, genrt.Leaf(ip, sp, "pattern", "string does not match pattern \"^a*$\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group171 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group171(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group171Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group171Node0 validates against the schema at #.
+func draft7Group171Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if !draft7Group0Var161.MatchString(s) {
+			errs = append(errs, genrt.Leaf(ip, sp, "pattern", "string does not match pattern \"a+\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group172 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group172(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group172Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group172Node0 validates against the schema at #.
+func draft7Group172Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp1 := sp.Append(draft7Group0Var162)
+		for _, k := range keys {
+			if !draft7Group0Var163.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group172Node1(st, obj[k], ip.Key(k), psp1, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group172Node1 validates against the schema at #/patternProperties/f.*o.
+func draft7Group172Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group173 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group173(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group173Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group173Node0 validates against the schema at #.
+func draft7Group173Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp1 := sp.Append(draft7Group0Var164)
+		for _, k := range keys {
+			if !draft7Group0Var165.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group173Node1(st, obj[k], ip.Key(k), psp1, nil)...)
+		}
+		psp2 := sp.Append(draft7Group0Var166)
+		for _, k := range keys {
+			if !draft7Group0Var167.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group173Node2(st, obj[k], ip.Key(k), psp2, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group173Node1 validates against the schema at #/patternProperties/a*.
+func draft7Group173Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group173Node2 validates against the schema at #/patternProperties/aaa*.
+func draft7Group173Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var168.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// Draft7Group174 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group174(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group174Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group174Node0 validates against the schema at #.
+func draft7Group174Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp1 := sp.Append(draft7Group0Var169)
+		for _, k := range keys {
+			if !draft7Group0Var170.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group174Node1(st, obj[k], ip.Key(k), psp1, nil)...)
+		}
+		psp2 := sp.Append(draft7Group0Var171)
+		for _, k := range keys {
+			if !draft7Group0Var172.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group174Node2(st, obj[k], ip.Key(k), psp2, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group174Node1 validates against the schema at #/patternProperties/X_.
+func draft7Group174Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group174Node2 validates against the schema at #/patternProperties/[0-9]{2,}.
+func draft7Group174Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group175 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group175(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group175Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group175Node0 validates against the schema at #.
+func draft7Group175Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp1 := sp.Append(draft7Group0Var173)
+		for _, k := range keys {
+			if !draft7Group0Var174.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), psp1, "patternProperties")...)
+		}
+		for _, k := range keys {
+			if !draft7Group0Var176.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group176 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group176(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group176Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group176Node0 validates against the schema at #.
+func draft7Group176Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		psp1 := sp.Append(draft7Group0Var177)
+		for _, k := range keys {
+			if !draft7Group0Var178.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group176Node1(st, obj[k], ip.Key(k), psp1, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group176Node1 validates against the schema at #/patternProperties/^.*bar$.
+func draft7Group176Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group177 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group177(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group177Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group177Node0 validates against the schema at #.
+func draft7Group177Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft7Group177Node1(st, v, ip.Key("bar"), sp.Append(draft7Group0Var6), nil)...)
+		}
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group177Node2(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group177Node1 validates against the schema at #/properties/bar.
+func draft7Group177Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group177Node2 validates against the schema at #/properties/foo.
+func draft7Group177Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group178 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group178(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group178Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group178Node0 validates against the schema at #.
+func draft7Group178Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft7Group178Node1(st, v, ip.Key("bar"), sp.Append(draft7Group0Var6), nil)...)
+		}
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group178Node2(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+		psp1 := sp.Append(draft7Group0Var179)
+		for _, k := range keys {
+			if !draft7Group0Var180.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group178Node3(st, obj[k], ip.Key(k), psp1, nil)...)
+		}
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			switch k {
+			case "bar", "foo":
+				continue
+			}
+
+			if draft7Group0Var180.MatchString(k) {
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group178Node4(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft7Group178Node1 validates against the schema at #/properties/bar.
+func draft7Group178Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group178Node2 validates against the schema at #/properties/foo.
+func draft7Group178Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 3 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxItems", fmt.Sprintf("array has %d items, maximum is %d", len(arr), 3)))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group178Node3 validates against the schema at #/patternProperties/f.o.
+func draft7Group178Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 2)))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group178Node4 validates against the schema at #/additionalProperties.
+func draft7Group178Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group179 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group179(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group179Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group179Node0 validates against the schema at #.
+func draft7Group179Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, genrt.False(ip.Key("bar"), sp.Append(draft7Group0Var6), "properties")...)
+		}
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group181 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group181(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group181Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group181Node0 validates against the schema at #.
+func draft7Group181Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group181Node1(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group181Node1 validates against the schema at #/properties/foo.
+func draft7Group181Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group182 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group182(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group182Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group182Node0 validates against the schema at #.
+func draft7Group182Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["__proto__"]; ok {
+			ann.RecordProperty("__proto__")
+			errs = append(errs, draft7Group182Node1(st, v, ip.Key("__proto__"), sp.Append(draft7Group0Var181), nil)...)
+		}
+		if v, ok := obj["constructor"]; ok {
+			ann.RecordProperty("constructor")
+			errs = append(errs, draft7Group182Node2(st, v, ip.Key("constructor"), sp.Append(draft7Group0Var182), nil)...)
+		}
+		if v, ok := obj["toString"]; ok {
+			ann.RecordProperty("toString")
+			errs = append(errs, draft7Group182Node3(st, v, ip.Key("toString"), sp.Append(draft7Group0Var183), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group182Node1 validates against the schema at #/properties/__proto__.
+func draft7Group182Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group182Node2 validates against the schema at #/properties/constructor.
+func draft7Group182Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group182Node3 validates against the schema at #/properties/toString.
+func draft7Group182Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["length"]; ok {
+			ann.RecordProperty("length")
+			errs = append(errs, draft7Group182Node4(st, v, ip.Key("length"), sp.Append(draft7Group0Var184), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group182Node4 validates against the schema at #/properties/toString/properties/length.
+func draft7Group182Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group183 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group183(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group183Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group183Node0 validates against the schema at #.
+func draft7Group183Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		nsp := sp.Append(draft7Group0Var100)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := draft7Group183Node1(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft7Group183Node1 validates against the schema at #/propertyNames.
+func draft7Group183Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 3 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 3)))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group184 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group184(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group184Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group184Node0 validates against the schema at #.
+func draft7Group184Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		nsp := sp.Append(draft7Group0Var100)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := draft7Group184Node1(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft7Group184Node1 validates against the schema at #/propertyNames.
+func draft7Group184Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if !draft7Group0Var185.MatchString(s) {
+			errs = append(errs, genrt.Leaf(ip, sp, "pattern", "string does not match pattern \"^a+$\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group185 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group185(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group185Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group185Node0 validates against the schema at #.
+func draft7Group185Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft7Group186 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group186(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group186Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group186Node0 validates against the schema at #.
+func draft7Group186Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		nsp := sp.Append(draft7Group0Var100)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := genrt.False(cip, nsp, ""); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group187 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group187(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group187Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group187Node0 validates against the schema at #.
+func draft7Group187Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		nsp := sp.Append(draft7Group0Var100)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := draft7Group187Node1(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft7Group187Node1 validates against the schema at #/propertyNames.
+func draft7Group187Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var186.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// Draft7Group188 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group188(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group188Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group188Node0 validates against the schema at #.
+func draft7Group188Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		nsp := sp.Append(draft7Group0Var100)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := draft7Group188Node1(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft7Group188Node1 validates against the schema at #/propertyNames.
+func draft7Group188Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var187.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group189 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group189(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group189Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group189Node0 validates against the schema at #.
+func draft7Group189Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group189Node1(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			switch k {
+			case "foo":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft7Group189Node1 validates against the schema at #/properties/foo.
+func draft7Group189Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group189Node0(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// Draft7Group190 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group190(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group190Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group190Node0 validates against the schema at #.
+func draft7Group190Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft7Group190Node1(st, v, ip.Key("bar"), sp.Append(draft7Group0Var6), nil)...)
+		}
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group190Node2(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group190Node1 validates against the schema at #/properties/bar.
+func draft7Group190Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group190Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group190Node2 validates against the schema at #/properties/bar/$ref.
+func draft7Group190Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group191 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group191(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group191Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group191Node0 validates against the schema at #.
+func draft7Group191Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft7Group191Node1(st, arr[0], ip.Index(0), sp.Append(draft7Group0Var0), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft7Group191Node2(st, arr[1], ip.Index(1), sp.Append(draft7Group0Var3), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft7Group191Node1 validates against the schema at #/items/0.
+func draft7Group191Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group191Node2 validates against the schema at #/items/1.
+func draft7Group191Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group191Node1(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// Draft7Group192 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group192(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group192Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group192Node0 validates against the schema at #.
+func draft7Group192Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["percent"]; ok {
+			ann.RecordProperty("percent")
+			errs = append(errs, draft7Group192Node1(st, v, ip.Key("percent"), sp.Append(draft7Group0Var188), nil)...)
+		}
+		if v, ok := obj["slash"]; ok {
+			ann.RecordProperty("slash")
+			errs = append(errs, draft7Group192Node3(st, v, ip.Key("slash"), sp.Append(draft7Group0Var189), nil)...)
+		}
+		if v, ok := obj["tilde"]; ok {
+			ann.RecordProperty("tilde")
+			errs = append(errs, draft7Group192Node5(st, v, ip.Key("tilde"), sp.Append(draft7Group0Var190), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group192Node1 validates against the schema at #/properties/percent.
+func draft7Group192Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group192Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group192Node2 validates against the schema at #/properties/percent/$ref.
+func draft7Group192Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group192Node3 validates against the schema at #/properties/slash.
+func draft7Group192Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group192Node4(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group192Node4 validates against the schema at #/properties/slash/$ref.
+func draft7Group192Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group192Node5 validates against the schema at #/properties/tilde.
+func draft7Group192Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft7Group192Node6(st, x, ip, sp.Append(draft7Group0Var48), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft7Group192Node6 validates against the schema at #/properties/tilde/$ref.
+func draft7Group192Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group193 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group193(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group193Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group193Node0 validates against the schema at #.
+func draft7Group193Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group193Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group193Node1 validates against the schema at #/allOf/0.
+func draft7Group193Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group193Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group193Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group193Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft7Group193Node3(st, x, ip, sp.Append(draft7Group0Var48), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft7Group193Node3 validates against the schema at #/allOf/0/$ref/$ref.
+func draft7Group193Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := draft7Group193Node4(st, x, ip, sp.Append(draft7Group0Var48), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// draft7Group193Node4 validates against the schema at #/allOf/0/$ref/$ref/$ref.
+func draft7Group193Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group194 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group194(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group194Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group194Node0 validates against the schema at #.
+func draft7Group194Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group194Node1(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group194Node1 validates against the schema at #/properties/foo.
+func draft7Group194Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group194Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group194Node2 validates against the schema at #/properties/foo/$ref.
+func draft7Group194Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group195 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group195(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group195Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group195Node0 validates against the schema at #.
+func draft7Group195Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group195Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group195Node1 validates against the schema at #/allOf/0.
+func draft7Group195Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group195Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group195Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group195Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group196 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group196(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group196Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group196Node0 validates against the schema at #.
+func draft7Group196Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group196Node1 validates against the schema at #/$ref.
+func draft7Group196Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x) || genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"object\", \"boolean\"], got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["$comment"]; ok {
+			ann.RecordProperty("$comment")
+			errs = append(errs, draft7Group196Node2(st, v, ip.Key("$comment"), sp.Append(draft7Group0Var49), nil)...)
+		}
+		if v, ok := obj["$id"]; ok {
+			ann.RecordProperty("$id")
+			errs = append(errs, draft7Group196Node3(st, v, ip.Key("$id"), sp.Append(draft7Group0Var50), nil)...)
+		}
+		if v, ok := obj["$ref"]; ok {
+			ann.RecordProperty("$ref")
+			errs = append(errs, draft7Group196Node4(st, v, ip.Key("$ref"), sp.Append(draft7Group0Var51), nil)...)
+		}
+		if v, ok := obj["$schema"]; ok {
+			ann.RecordProperty("$schema")
+			errs = append(errs, draft7Group196Node5(st, v, ip.Key("$schema"), sp.Append(draft7Group0Var52), nil)...)
+		}
+		if v, ok := obj["additionalItems"]; ok {
+			ann.RecordProperty("additionalItems")
+			errs = append(errs, draft7Group196Node6(st, v, ip.Key("additionalItems"), sp.Append(draft7Group0Var53), nil)...)
+		}
+		if v, ok := obj["additionalProperties"]; ok {
+			ann.RecordProperty("additionalProperties")
+			errs = append(errs, draft7Group196Node7(st, v, ip.Key("additionalProperties"), sp.Append(draft7Group0Var54), nil)...)
+		}
+		if v, ok := obj["allOf"]; ok {
+			ann.RecordProperty("allOf")
+			errs = append(errs, draft7Group196Node8(st, v, ip.Key("allOf"), sp.Append(draft7Group0Var55), nil)...)
+		}
+		if v, ok := obj["anyOf"]; ok {
+			ann.RecordProperty("anyOf")
+			errs = append(errs, draft7Group196Node11(st, v, ip.Key("anyOf"), sp.Append(draft7Group0Var56), nil)...)
+		}
+		if _, ok := obj["const"]; ok {
+			ann.RecordProperty("const")
+		}
+		if v, ok := obj["contains"]; ok {
+			ann.RecordProperty("contains")
+			errs = append(errs, draft7Group196Node12(st, v, ip.Key("contains"), sp.Append(draft7Group0Var58), nil)...)
+		}
+		if v, ok := obj["contentEncoding"]; ok {
+			ann.RecordProperty("contentEncoding")
+			errs = append(errs, draft7Group196Node13(st, v, ip.Key("contentEncoding"), sp.Append(draft7Group0Var59), nil)...)
+		}
+		if v, ok := obj["contentMediaType"]; ok {
+			ann.RecordProperty("contentMediaType")
+			errs = append(errs, draft7Group196Node14(st, v, ip.Key("contentMediaType"), sp.Append(draft7Group0Var60), nil)...)
+		}
+		if _, ok := obj["default"]; ok {
+			ann.RecordProperty("default")
+		}
+		if v, ok := obj["definitions"]; ok {
+			ann.RecordProperty("definitions")
+			errs = append(errs, draft7Group196Node15(st, v, ip.Key("definitions"), sp.Append(draft7Group0Var62), nil)...)
+		}
+		if v, ok := obj["dependencies"]; ok {
+			ann.RecordProperty("dependencies")
+			errs = append(errs, draft7Group196Node17(st, v, ip.Key("dependencies"), sp.Append(draft7Group0Var63), nil)...)
+		}
+		if v, ok := obj["description"]; ok {
+			ann.RecordProperty("description")
+			errs = append(errs, draft7Group196Node23(st, v, ip.Key("description"), sp.Append(draft7Group0Var64), nil)...)
+		}
+		if v, ok := obj["else"]; ok {
+			ann.RecordProperty("else")
+			errs = append(errs, draft7Group196Node24(st, v, ip.Key("else"), sp.Append(draft7Group0Var65), nil)...)
+		}
+		if v, ok := obj["enum"]; ok {
+			ann.RecordProperty("enum")
+			errs = append(errs, draft7Group196Node25(st, v, ip.Key("enum"), sp.Append(draft7Group0Var66), nil)...)
+		}
+		if v, ok := obj["examples"]; ok {
+			ann.RecordProperty("examples")
+			errs = append(errs, draft7Group196Node26(st, v, ip.Key("examples"), sp.Append(draft7Group0Var67), nil)...)
+		}
+		if v, ok := obj["exclusiveMaximum"]; ok {
+			ann.RecordProperty("exclusiveMaximum")
+			errs = append(errs, draft7Group196Node27(st, v, ip.Key("exclusiveMaximum"), sp.Append(draft7Group0Var68), nil)...)
+		}
+		if v, ok := obj["exclusiveMinimum"]; ok {
+			ann.RecordProperty("exclusiveMinimum")
+			errs = append(errs, draft7Group196Node28(st, v, ip.Key("exclusiveMinimum"), sp.Append(draft7Group0Var69), nil)...)
+		}
+		if v, ok := obj["format"]; ok {
+			ann.RecordProperty("format")
+			errs = append(errs, draft7Group196Node29(st, v, ip.Key("format"), sp.Append(draft7Group0Var70), nil)...)
+		}
+		if v, ok := obj["if"]; ok {
+			ann.RecordProperty("if")
+			errs = append(errs, draft7Group196Node30(st, v, ip.Key("if"), sp.Append(draft7Group0Var71), nil)...)
+		}
+		if v, ok := obj["items"]; ok {
+			ann.RecordProperty("items")
+			errs = append(errs, draft7Group196Node31(st, v, ip.Key("items"), sp.Append(draft7Group0Var72), nil)...)
+		}
+		if v, ok := obj["maxItems"]; ok {
+			ann.RecordProperty("maxItems")
+			errs = append(errs, draft7Group196Node34(st, v, ip.Key("maxItems"), sp.Append(draft7Group0Var73), nil)...)
+		}
+		if v, ok := obj["maxLength"]; ok {
+			ann.RecordProperty("maxLength")
+			errs = append(errs, draft7Group196Node36(st, v, ip.Key("maxLength"), sp.Append(draft7Group0Var74), nil)...)
+		}
+		if v, ok := obj["maxProperties"]; ok {
+			ann.RecordProperty("maxProperties")
+			errs = append(errs, draft7Group196Node37(st, v, ip.Key("maxProperties"), sp.Append(draft7Group0Var75), nil)...)
+		}
+		if v, ok := obj["maximum"]; ok {
+			ann.RecordProperty("maximum")
+			errs = append(errs, draft7Group196Node38(st, v, ip.Key("maximum"), sp.Append(draft7Group0Var76), nil)...)
+		}
+		if v, ok := obj["minItems"]; ok {
+			ann.RecordProperty("minItems")
+			errs = append(errs, draft7Group196Node39(st, v, ip.Key("minItems"), sp.Append(draft7Group0Var77), nil)...)
+		}
+		if v, ok := obj["minLength"]; ok {
+			ann.RecordProperty("minLength")
+			errs = append(errs, draft7Group196Node42(st, v, ip.Key("minLength"), sp.Append(draft7Group0Var78), nil)...)
+		}
+		if v, ok := obj["minProperties"]; ok {
+			ann.RecordProperty("minProperties")
+			errs = append(errs, draft7Group196Node43(st, v, ip.Key("minProperties"), sp.Append(draft7Group0Var79), nil)...)
+		}
+		if v, ok := obj["minimum"]; ok {
+			ann.RecordProperty("minimum")
+			errs = append(errs, draft7Group196Node44(st, v, ip.Key("minimum"), sp.Append(draft7Group0Var80), nil)...)
+		}
+		if v, ok := obj["multipleOf"]; ok {
+			ann.RecordProperty("multipleOf")
+			errs = append(errs, draft7Group196Node45(st, v, ip.Key("multipleOf"), sp.Append(draft7Group0Var81), nil)...)
+		}
+		if v, ok := obj["not"]; ok {
+			ann.RecordProperty("not")
+			errs = append(errs, draft7Group196Node46(st, v, ip.Key("not"), sp.Append(draft7Group0Var82), nil)...)
+		}
+		if v, ok := obj["oneOf"]; ok {
+			ann.RecordProperty("oneOf")
+			errs = append(errs, draft7Group196Node47(st, v, ip.Key("oneOf"), sp.Append(draft7Group0Var83), nil)...)
+		}
+		if v, ok := obj["pattern"]; ok {
+			ann.RecordProperty("pattern")
+			errs = append(errs, draft7Group196Node48(st, v, ip.Key("pattern"), sp.Append(draft7Group0Var84), nil)...)
+		}
+		if v, ok := obj["patternProperties"]; ok {
+			ann.RecordProperty("patternProperties")
+			errs = append(errs, draft7Group196Node49(st, v, ip.Key("patternProperties"), sp.Append(draft7Group0Var85), nil)...)
+		}
+		if v, ok := obj["properties"]; ok {
+			ann.RecordProperty("properties")
+			errs = append(errs, draft7Group196Node52(st, v, ip.Key("properties"), sp.Append(draft7Group0Var86), nil)...)
+		}
+		if v, ok := obj["propertyNames"]; ok {
+			ann.RecordProperty("propertyNames")
+			errs = append(errs, draft7Group196Node54(st, v, ip.Key("propertyNames"), sp.Append(draft7Group0Var87), nil)...)
+		}
+		if v, ok := obj["readOnly"]; ok {
+			ann.RecordProperty("readOnly")
+			errs = append(errs, draft7Group196Node55(st, v, ip.Key("readOnly"), sp.Append(draft7Group0Var88), nil)...)
+		}
+		if v, ok := obj["required"]; ok {
+			ann.RecordProperty("required")
+			errs = append(errs, draft7Group196Node56(st, v, ip.Key("required"), sp.Append(draft7Group0Var89), nil)...)
+		}
+		if v, ok := obj["then"]; ok {
+			ann.RecordProperty("then")
+			errs = append(errs, draft7Group196Node57(st, v, ip.Key("then"), sp.Append(draft7Group0Var90), nil)...)
+		}
+		if v, ok := obj["title"]; ok {
+			ann.RecordProperty("title")
+			errs = append(errs, draft7Group196Node58(st, v, ip.Key("title"), sp.Append(draft7Group0Var91), nil)...)
+		}
+		if v, ok := obj["type"]; ok {
+			ann.RecordProperty("type")
+			errs = append(errs, draft7Group196Node59(st, v, ip.Key("type"), sp.Append(draft7Group0Var92), nil)...)
+		}
+		if v, ok := obj["uniqueItems"]; ok {
+			ann.RecordProperty("uniqueItems")
+			errs = append(errs, draft7Group196Node64(st, v, ip.Key("uniqueItems"), sp.Append(draft7Group0Var93), nil)...)
+		}
+		if v, ok := obj["writeOnly"]; ok {
+			ann.RecordProperty("writeOnly")
+			errs = append(errs, draft7Group196Node65(st, v, ip.Key("writeOnly"), sp.Append(draft7Group0Var94), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group196Node2 validates against the schema at #/$ref/properties/$comment.
+func draft7Group196Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group196Node3 validates against the schema at #/$ref/properties/$id.
+func draft7Group196Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		if err := draft7Group0Var95(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "uri-reference", err))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group196Node4 validates against the schema at #/$ref/properties/$ref.
+func draft7Group196Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		if err := draft7Group0Var95(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "uri-reference", err))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group196Node5 validates against the schema at #/$ref/properties/$schema.
+func draft7Group196Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		if err := draft7Group0Var96(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "uri", err))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group196Node6 validates against the schema at #/$ref/properties/additionalItems.
+func draft7Group196Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group196Node7 validates against the schema at #/$ref/properties/additionalProperties.
+func draft7Group196Node7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft7Group196Node8 validates against the schema at #/$ref/properties/allOf.
+func draft7Group196Node8(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := draft7Group196Node9(st, x, ip, sp.Append(draft7Group0Var48), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// draft7Group196Node9 validates against the schema at #/$ref/properties/allOf/$ref.
+func draft7Group196Node9(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp5 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group196Node10(st, arr[i], ip.Index(i), rsp5, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 1)))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group196Node10 validates against the schema at #/$ref/properties/allOf/$ref/items.
+func draft7Group196Node10(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann6 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann6); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann6)
+	}
+
+	return errs
+}
+
+// draft7Group196Node11 validates against the schema at #/$ref/properties/anyOf.
+func draft7Group196Node11(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann7 := ann.Child()
+	if ce := draft7Group196Node9(st, x, ip, sp.Append(draft7Group0Var48), ann7); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann7)
+	}
+
+	return errs
+}
+
+// draft7Group196Node12 validates against the schema at #/$ref/properties/contains.
+func draft7Group196Node12(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann8 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann8); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann8)
+	}
+
+	return errs
+}
+
+// draft7Group196Node13 validates against the schema at #/$ref/properties/contentEncoding.
+func draft7Group196Node13(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group196Node14 validates against the schema at #/$ref/properties/contentMediaType.
+func draft7Group196Node14(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group196Node15 validates against the schema at #/$ref/properties/definitions.
+func draft7Group196Node15(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group196Node16(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft7Group196Node16 validates against the schema at #/$ref/properties/definitions/additionalProperties.
+func draft7Group196Node16(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann9 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann9); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann9)
+	}
+
+	return errs
+}
+
+// draft7Group196Node17 validates against the schema at #/$ref/properties/dependencies.
+func draft7Group196Node17(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group196Node18(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft7Group196Node18 validates against the schema at #/$ref/properties/dependencies/additionalProperties.
+func draft7Group196Node18(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann10 := ann.Child()
+	if ce := draft7Group196Node19(st, x, ip, sp.Append(draft7Group0Var17), ann10); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann10)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann11 := ann.Child()
+	if ce := draft7Group196Node20(st, x, ip, sp.Append(draft7Group0Var22), ann11); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann11)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft7Group196Node19 validates against the schema at #/$ref/properties/dependencies/additionalProperties/anyOf/0.
+func draft7Group196Node19(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann12 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann12); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann12)
+	}
+
+	return errs
+}
+
+// draft7Group196Node20 validates against the schema at #/$ref/properties/dependencies/additionalProperties/anyOf/1.
+func draft7Group196Node20(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann13 := ann.Child()
+	if ce := draft7Group196Node21(st, x, ip, sp.Append(draft7Group0Var48), ann13); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann13)
+	}
+
+	return errs
+}
+
+// draft7Group196Node21 validates against the schema at #/$ref/properties/dependencies/additionalProperties/anyOf/1/$ref.
+func draft7Group196Node21(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp14 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group196Node22(st, arr[i], ip.Index(i), rsp14, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok {
+		if genrt.HasDuplicates(arr) {
+			errs = append(errs, genrt.Leaf(ip, sp, "uniqueItems", "array contains duplicate items"))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group196Node22 validates against the schema at #/$ref/properties/dependencies/additionalProperties/anyOf/1/$ref/items.
+func draft7Group196Node22(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group196Node23 validates against the schema at #/$ref/properties/description.
+func draft7Group196Node23(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group196Node24 validates against the schema at #/$ref/properties/else.
+func draft7Group196Node24(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann15 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann15); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann15)
+	}
+
+	return errs
+}
+
+// draft7Group196Node25 validates against the schema at #/$ref/properties/enum.
+func draft7Group196Node25(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if _, ok := x.([]any); ok {
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 1)))
+		}
+		if genrt.HasDuplicates(arr) {
+			errs = append(errs, genrt.Leaf(ip, sp, "uniqueItems", "array contains duplicate items"))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group196Node26 validates against the schema at #/$ref/properties/examples.
+func draft7Group196Node26(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if _, ok := x.([]any); ok {
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group196Node27 validates against the schema at #/$ref/properties/exclusiveMaximum.
+func draft7Group196Node27(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group196Node28 validates against the schema at #/$ref/properties/exclusiveMinimum.
+func draft7Group196Node28(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group196Node29 validates against the schema at #/$ref/properties/format.
+func draft7Group196Node29(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group196Node30 validates against the schema at #/$ref/properties/if.
+func draft7Group196Node30(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann18 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann18); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann18)
+	}
+
+	return errs
+}
+
+// draft7Group196Node31 validates against the schema at #/$ref/properties/items.
+func draft7Group196Node31(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann19 := ann.Child()
+	if ce := draft7Group196Node32(st, x, ip, sp.Append(draft7Group0Var17), ann19); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann19)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann20 := ann.Child()
+	if ce := draft7Group196Node33(st, x, ip, sp.Append(draft7Group0Var22), ann20); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann20)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft7Group196Node32 validates against the schema at #/$ref/properties/items/anyOf/0.
+func draft7Group196Node32(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann21 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann21); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann21)
+	}
+
+	return errs
+}
+
+// draft7Group196Node33 validates against the schema at #/$ref/properties/items/anyOf/1.
+func draft7Group196Node33(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann22 := ann.Child()
+	if ce := draft7Group196Node9(st, x, ip, sp.Append(draft7Group0Var48), ann22); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann22)
+	}
+
+	return errs
+}
+
+// draft7Group196Node34 validates against the schema at #/$ref/properties/maxItems.
+func draft7Group196Node34(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann23 := ann.Child()
+	if ce := draft7Group196Node35(st, x, ip, sp.Append(draft7Group0Var48), ann23); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann23)
+	}
+
+	return errs
+}
+
+// draft7Group196Node35 validates against the schema at #/$ref/properties/maxItems/$ref.
+func draft7Group196Node35(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = draft7Group0Var191.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft7Group196Node36 validates against the schema at #/$ref/properties/maxLength.
+func draft7Group196Node36(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann24 := ann.Child()
+	if ce := draft7Group196Node35(st, x, ip, sp.Append(draft7Group0Var48), ann24); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann24)
+	}
+
+	return errs
+}
+
+// draft7Group196Node37 validates against the schema at #/$ref/properties/maxProperties.
+func draft7Group196Node37(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann25 := ann.Child()
+	if ce := draft7Group196Node35(st, x, ip, sp.Append(draft7Group0Var48), ann25); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann25)
+	}
+
+	return errs
+}
+
+// draft7Group196Node38 validates against the schema at #/$ref/properties/maximum.
+func draft7Group196Node38(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group196Node39 validates against the schema at #/$ref/properties/minItems.
+func draft7Group196Node39(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann26 := ann.Child()
+	if ce := draft7Group196Node40(st, x, ip, sp.Append(draft7Group0Var48), ann26); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann26)
+	}
+
+	return errs
+}
+
+// draft7Group196Node40 validates against the schema at #/$ref/properties/minItems/$ref.
+func draft7Group196Node40(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann27 := ann.Child()
+	if ce := draft7Group196Node41(st, x, ip, sp.Append(draft7Group0Var5), ann27); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann27)
+	}
+
+	return errs
+}
+
+// draft7Group196Node41 validates against the schema at #/$ref/properties/minItems/$ref/allOf/0.
+func draft7Group196Node41(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann28 := ann.Child()
+	if ce := draft7Group196Node35(st, x, ip, sp.Append(draft7Group0Var48), ann28); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann28)
+	}
+
+	return errs
+}
+
+// draft7Group196Node42 validates against the schema at #/$ref/properties/minLength.
+func draft7Group196Node42(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann29 := ann.Child()
+	if ce := draft7Group196Node40(st, x, ip, sp.Append(draft7Group0Var48), ann29); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann29)
+	}
+
+	return errs
+}
+
+// draft7Group196Node43 validates against the schema at #/$ref/properties/minProperties.
+func draft7Group196Node43(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann30 := ann.Child()
+	if ce := draft7Group196Node40(st, x, ip, sp.Append(draft7Group0Var48), ann30); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann30)
+	}
+
+	return errs
+}
+
+// draft7Group196Node44 validates against the schema at #/$ref/properties/minimum.
+func draft7Group196Node44(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group196Node45 validates against the schema at #/$ref/properties/multipleOf.
+func draft7Group196Node45(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = draft7Group0Var192.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// draft7Group196Node46 validates against the schema at #/$ref/properties/not.
+func draft7Group196Node46(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann31 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann31); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann31)
+	}
+
+	return errs
+}
+
+// draft7Group196Node47 validates against the schema at #/$ref/properties/oneOf.
+func draft7Group196Node47(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann32 := ann.Child()
+	if ce := draft7Group196Node9(st, x, ip, sp.Append(draft7Group0Var48), ann32); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann32)
+	}
+
+	return errs
+}
+
+// draft7Group196Node48 validates against the schema at #/$ref/properties/pattern.
+func draft7Group196Node48(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		if err := draft7Group0Var99(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "regex", err))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group196Node49 validates against the schema at #/$ref/properties/patternProperties.
+func draft7Group196Node49(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group196Node50(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+		nsp := sp.Append(draft7Group0Var100)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := draft7Group196Node51(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// draft7Group196Node50 validates against the schema at #/$ref/properties/patternProperties/additionalProperties.
+func draft7Group196Node50(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann33 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann33); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann33)
+	}
+
+	return errs
+}
+
+// draft7Group196Node51 validates against the schema at #/$ref/properties/patternProperties/propertyNames.
+func draft7Group196Node51(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7Group0Var99(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "regex", err))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group196Node52 validates against the schema at #/$ref/properties/properties.
+func draft7Group196Node52(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(draft7Group0Var10)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, draft7Group196Node53(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// draft7Group196Node53 validates against the schema at #/$ref/properties/properties/additionalProperties.
+func draft7Group196Node53(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann34 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann34); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann34)
+	}
+
+	return errs
+}
+
+// draft7Group196Node54 validates against the schema at #/$ref/properties/propertyNames.
+func draft7Group196Node54(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann35 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann35); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann35)
+	}
+
+	return errs
+}
+
+// draft7Group196Node55 validates against the schema at #/$ref/properties/readOnly.
+func draft7Group196Node55(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group196Node56 validates against the schema at #/$ref/properties/required.
+func draft7Group196Node56(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann36 := ann.Child()
+	if ce := draft7Group196Node21(st, x, ip, sp.Append(draft7Group0Var48), ann36); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann36)
+	}
+
+	return errs
+}
+
+// draft7Group196Node57 validates against the schema at #/$ref/properties/then.
+func draft7Group196Node57(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann37 := ann.Child()
+	if ce := draft7Group196Node1(st, x, ip, sp.Append(draft7Group0Var48), ann37); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann37)
+	}
+
+	return errs
+}
+
+// draft7Group196Node58 validates against the schema at #/$ref/properties/title.
+func draft7Group196Node58(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group196Node59 validates against the schema at #/$ref/properties/type.
+func draft7Group196Node59(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann38 := ann.Child()
+	if ce := draft7Group196Node60(st, x, ip, sp.Append(draft7Group0Var17), ann38); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann38)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann39 := ann.Child()
+	if ce := draft7Group196Node62(st, x, ip, sp.Append(draft7Group0Var22), ann39); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann39)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft7Group196Node60 validates against the schema at #/$ref/properties/type/anyOf/0.
+func draft7Group196Node60(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann40 := ann.Child()
+	if ce := draft7Group196Node61(st, x, ip, sp.Append(draft7Group0Var48), ann40); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann40)
+	}
+
+	return errs
+}
+
+// draft7Group196Node61 validates against the schema at #/$ref/properties/type/anyOf/0/$ref.
+func draft7Group196Node61(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var193.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// draft7Group196Node62 validates against the schema at #/$ref/properties/type/anyOf/1.
+func draft7Group196Node62(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp41 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group196Node63(st, arr[i], ip.Index(i), rsp41, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 1)))
+		}
+		if genrt.HasDuplicates(arr) {
+			errs = append(errs, genrt.Leaf(ip, sp, "uniqueItems", "array contains duplicate items"))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group196Node63 validates against the schema at #/$ref/properties/type/anyOf/1/items.
+func draft7Group196Node63(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann42 := ann.Child()
+	if ce := draft7Group196Node61(st, x, ip, sp.Append(draft7Group0Var48), ann42); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann42)
+	}
+
+	return errs
+}
+
+// draft7Group196Node64 validates against the schema at #/$ref/properties/uniqueItems.
+func draft7Group196Node64(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group196Node65 validates against the schema at #/$ref/properties/writeOnly.
+func draft7Group196Node65(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group197 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group197(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group197Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group197Node0 validates against the schema at #.
+func draft7Group197Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["$ref"]; ok {
+			ann.RecordProperty("$ref")
+			errs = append(errs, draft7Group197Node1(st, v, ip.Key("$ref"), sp.Append(draft7Group0Var51), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group197Node1 validates against the schema at #/properties/$ref.
+func draft7Group197Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group198 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group198(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group198Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group198Node0 validates against the schema at #.
+func draft7Group198Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["$ref"]; ok {
+			ann.RecordProperty("$ref")
+			errs = append(errs, draft7Group198Node1(st, v, ip.Key("$ref"), sp.Append(draft7Group0Var51), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group198Node1 validates against the schema at #/properties/$ref.
+func draft7Group198Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group198Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group198Node2 validates against the schema at #/properties/$ref/$ref.
+func draft7Group198Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group199 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group199(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group199Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group199Node0 validates against the schema at #.
+func draft7Group199Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group199Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group199Node1 validates against the schema at #/allOf/0.
+func draft7Group199Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft7Group200 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group200(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group200Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group200Node0 validates against the schema at #.
+func draft7Group200Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group200Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group200Node1 validates against the schema at #/allOf/0.
+func draft7Group200Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", genrt.False(ip, sp.Append(draft7Group0Var48), "")))
+
+	return errs
+}
+
+// Draft7Group201 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group201(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group201Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group201Node0 validates against the schema at #.
+func draft7Group201Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["meta"]; ok {
+			ann.RecordProperty("meta")
+			errs = append(errs, draft7Group201Node1(st, v, ip.Key("meta"), sp.Append(draft7Group0Var194), nil)...)
+		}
+		if v, ok := obj["nodes"]; ok {
+			ann.RecordProperty("nodes")
+			errs = append(errs, draft7Group201Node2(st, v, ip.Key("nodes"), sp.Append(draft7Group0Var195), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["meta"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"meta\""))
+		}
+		if _, ok := obj["nodes"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"nodes\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group201Node1 validates against the schema at #/properties/meta.
+func draft7Group201Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group201Node2 validates against the schema at #/properties/nodes.
+func draft7Group201Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group201Node3(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group201Node3 validates against the schema at #/properties/nodes/items.
+func draft7Group201Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group201Node4(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group201Node4 validates against the schema at #/properties/nodes/items/$ref.
+func draft7Group201Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["subtree"]; ok {
+			ann.RecordProperty("subtree")
+			errs = append(errs, draft7Group201Node5(st, v, ip.Key("subtree"), sp.Append(draft7Group0Var196), nil)...)
+		}
+		if v, ok := obj["value"]; ok {
+			ann.RecordProperty("value")
+			errs = append(errs, draft7Group201Node6(st, v, ip.Key("value"), sp.Append(draft7Group0Var197), nil)...)
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["value"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"value\""))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group201Node5 validates against the schema at #/properties/nodes/items/$ref/properties/subtree.
+func draft7Group201Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft7Group201Node0(st, x, ip, sp.Append(draft7Group0Var48), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft7Group201Node6 validates against the schema at #/properties/nodes/items/$ref/properties/value.
+func draft7Group201Node6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group202 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group202(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group202Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group202Node0 validates against the schema at #.
+func draft7Group202Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo\"bar"]; ok {
+			ann.RecordProperty("foo\"bar")
+			errs = append(errs, draft7Group202Node1(st, v, ip.Key("foo\"bar"), sp.Append(draft7Group0Var198), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group202Node1 validates against the schema at #/properties/foo"bar.
+func draft7Group202Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group202Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group202Node2 validates against the schema at #/properties/foo"bar/$ref.
+func draft7Group202Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group203 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group203(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group203Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group203Node0 validates against the schema at #.
+func draft7Group203Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group203Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group203Node1 validates against the schema at #/allOf/0.
+func draft7Group203Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group203Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group203Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group203Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group204 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group204(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group204Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group204Node0 validates against the schema at #.
+func draft7Group204Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group204Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group204Node1 validates against the schema at #/allOf/0.
+func draft7Group204Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group204Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group204Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group204Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group205 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group205(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group205Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group205Node0 validates against the schema at #.
+func draft7Group205Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group205Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group205Node1 validates against the schema at #/allOf/0.
+func draft7Group205Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group205Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group205Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group205Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group206 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group206(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group206Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group206Node0 validates against the schema at #.
+func draft7Group206Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !draft7Group0Var199.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// Draft7Group207 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group207(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group207Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group207Node0 validates against the schema at #.
+func draft7Group207Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group207Node1(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group207Node5(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group207Node1 validates against the schema at #/properties/foo.
+func draft7Group207Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft7Group207Node2(st, x, ip, sp.Append(draft7Group0Var5), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group207Node2 validates against the schema at #/properties/foo/allOf/0.
+func draft7Group207Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft7Group207Node3(st, x, ip, sp.Append(draft7Group0Var48), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft7Group207Node3 validates against the schema at #/properties/foo/allOf/0/$ref.
+func draft7Group207Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft7Group207Node4(st, v, ip.Key("bar"), sp.Append(draft7Group0Var6), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group207Node4 validates against the schema at #/properties/foo/allOf/0/$ref/properties/bar.
+func draft7Group207Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group207Node5 validates against the schema at #/allOf/0.
+func draft7Group207Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := draft7Group207Node1(st, x, ip, sp.Append(draft7Group0Var48), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// Draft7Group208 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group208(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group208Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group208Node0 validates against the schema at #.
+func draft7Group208Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group208Node1(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group208Node5(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group208Node1 validates against the schema at #/properties/foo.
+func draft7Group208Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft7Group208Node2(st, x, ip, sp.Append(draft7Group0Var5), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group208Node2 validates against the schema at #/properties/foo/allOf/0.
+func draft7Group208Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft7Group208Node3(st, x, ip, sp.Append(draft7Group0Var48), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft7Group208Node3 validates against the schema at #/properties/foo/allOf/0/$ref.
+func draft7Group208Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft7Group208Node4(st, v, ip.Key("bar"), sp.Append(draft7Group0Var6), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group208Node4 validates against the schema at #/properties/foo/allOf/0/$ref/properties/bar.
+func draft7Group208Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group208Node5 validates against the schema at #/allOf/0.
+func draft7Group208Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := draft7Group208Node1(st, x, ip, sp.Append(draft7Group0Var48), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// Draft7Group209 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group209(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group209Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group209Node0 validates against the schema at #.
+func draft7Group209Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group209Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group209Node1 validates against the schema at #/allOf/0.
+func draft7Group209Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group209Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group209Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group209Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group210 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group210(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group210Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group210Node0 validates against the schema at #.
+func draft7Group210Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	errs = draft7Group0Var200.Check(errs, x, ip, sp)
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group210Node1(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group210Node1 validates against the schema at #/properties/foo.
+func draft7Group210Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group210Node0(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// Draft7Group211 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group211(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group211Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group211Node0 validates against the schema at #.
+func draft7Group211Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group211Node1(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group211Node1 validates against the schema at #/properties/foo.
+func draft7Group211Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group211Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group211Node2 validates against the schema at #/properties/foo/$ref.
+func draft7Group211Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group212 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group212(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group212Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group212Node0 validates against the schema at #.
+func draft7Group212Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group212Node1(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group212Node1 validates against the schema at #/properties/foo.
+func draft7Group212Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group212Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group212Node2 validates against the schema at #/properties/foo/$ref.
+func draft7Group212Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group213 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group213(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group213Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group213Node0 validates against the schema at #.
+func draft7Group213Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group213Node1(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group213Node1 validates against the schema at #/properties/foo.
+func draft7Group213Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group213Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group213Node2 validates against the schema at #/properties/foo/$ref.
+func draft7Group213Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group214 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group214(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group214Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group214Node0 validates against the schema at #.
+func draft7Group214Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group214Node1(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group214Node1 validates against the schema at #/properties/foo.
+func draft7Group214Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group214Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group214Node2 validates against the schema at #/properties/foo/$ref.
+func draft7Group214Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group215 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group215(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group215Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group215Node0 validates against the schema at #.
+func draft7Group215Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group215Node1(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group215Node1 validates against the schema at #/properties/foo.
+func draft7Group215Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group215Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group215Node2 validates against the schema at #/properties/foo/$ref.
+func draft7Group215Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group216 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group216(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group216Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group216Node0 validates against the schema at #.
+func draft7Group216Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group216Node1(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group216Node1 validates against the schema at #/properties/foo.
+func draft7Group216Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group216Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group216Node2 validates against the schema at #/properties/foo/$ref.
+func draft7Group216Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group217 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group217(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group217Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group217Node0 validates against the schema at #.
+func draft7Group217Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group217Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := draft7Group217Node3(st, x, ip, sp.Append(draft7Group0Var13), ann2); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group217Node1 validates against the schema at #/allOf/0.
+func draft7Group217Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft7Group217Node2(st, x, ip, sp.Append(draft7Group0Var48), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft7Group217Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group217Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group217Node3 validates against the schema at #/allOf/1.
+func draft7Group217Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if len(draft7Group217Node2(st, x, ip, sp.Append(draft7Group0Var126), ann4)) == 0 {
+		ann.Merge(ann4)
+
+	} else {
+	}
+
+	return errs
+}
+
+// Draft7Group218 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group218(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group218Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group218Node0 validates against the schema at #.
+func draft7Group218Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group218Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group218Node1 validates against the schema at #/allOf/0.
+func draft7Group218Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group218Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group218Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group218Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group219 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group219(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group219Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group219Node0 validates against the schema at #.
+func draft7Group219Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group219Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group219Node1 validates against the schema at #/allOf/0.
+func draft7Group219Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group219Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group219Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group219Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group220 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group220(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group220Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group220Node0 validates against the schema at #.
+func draft7Group220Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group220Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group220Node1 validates against the schema at #/allOf/0.
+func draft7Group220Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group220Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group220Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group220Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group221 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group221(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group221Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group221Node0 validates against the schema at #.
+func draft7Group221Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group221Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group221Node1 validates against the schema at #/allOf/0.
+func draft7Group221Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group221Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group221Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group221Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group222 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group222(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group222Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group222Node0 validates against the schema at #.
+func draft7Group222Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group222Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group222Node1 validates against the schema at #/allOf/0.
+func draft7Group222Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group222Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group222Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group222Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group223 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group223(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group223Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group223Node0 validates against the schema at #.
+func draft7Group223Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group223Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group223Node1 validates against the schema at #/allOf/0.
+func draft7Group223Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group223Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group223Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group223Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group224 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group224(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group224Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group224Node0 validates against the schema at #.
+func draft7Group224Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group224Node1(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group224Node1 validates against the schema at #/$ref.
+func draft7Group224Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group225 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group225(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group225Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group225Node0 validates against the schema at #.
+func draft7Group225Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group225Node1(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group225Node1 validates against the schema at #/$ref.
+func draft7Group225Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group226 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group226(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group226Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group226Node0 validates against the schema at #.
+func draft7Group226Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group226Node1(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group226Node1 validates against the schema at #/$ref.
+func draft7Group226Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group226Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group226Node2 validates against the schema at #/$ref/$ref.
+func draft7Group226Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group227 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group227(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group227Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group227Node0 validates against the schema at #.
+func draft7Group227Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group227Node1(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group227Node1 validates against the schema at #/items.
+func draft7Group227Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		rsp2 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group227Node2(st, arr[i], ip.Index(i), rsp2, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group227Node2 validates against the schema at #/items/items.
+func draft7Group227Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft7Group227Node3(st, x, ip, sp.Append(draft7Group0Var48), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft7Group227Node3 validates against the schema at #/items/items/$ref.
+func draft7Group227Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group228 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group228(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group228Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group228Node0 validates against the schema at #.
+func draft7Group228Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["list"]; ok {
+			ann.RecordProperty("list")
+			errs = append(errs, draft7Group228Node1(st, v, ip.Key("list"), sp.Append(draft7Group0Var201), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group228Node1 validates against the schema at #/properties/list.
+func draft7Group228Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group228Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group228Node2 validates against the schema at #/properties/list/$ref.
+func draft7Group228Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp2 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group228Node3(st, arr[i], ip.Index(i), rsp2, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group228Node3 validates against the schema at #/properties/list/$ref/items.
+func draft7Group228Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft7Group228Node4(st, x, ip, sp.Append(draft7Group0Var48), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft7Group228Node4 validates against the schema at #/properties/list/$ref/items/$ref.
+func draft7Group228Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group229 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group229(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group229Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group229Node0 validates against the schema at #.
+func draft7Group229Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["list"]; ok {
+			ann.RecordProperty("list")
+			errs = append(errs, draft7Group229Node1(st, v, ip.Key("list"), sp.Append(draft7Group0Var201), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group229Node1 validates against the schema at #/properties/list.
+func draft7Group229Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group229Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group229Node2 validates against the schema at #/properties/list/$ref.
+func draft7Group229Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp2 := sp.Append(draft7Group0Var2)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, draft7Group229Node3(st, arr[i], ip.Index(i), rsp2, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// draft7Group229Node3 validates against the schema at #/properties/list/$ref/items.
+func draft7Group229Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := draft7Group229Node4(st, x, ip, sp.Append(draft7Group0Var48), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft7Group229Node4 validates against the schema at #/properties/list/$ref/items/$ref.
+func draft7Group229Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group230 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group230(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group230Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group230Node0 validates against the schema at #.
+func draft7Group230Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["name"]; ok {
+			ann.RecordProperty("name")
+			errs = append(errs, draft7Group230Node1(st, v, ip.Key("name"), sp.Append(draft7Group0Var202), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group230Node1 validates against the schema at #/properties/name.
+func draft7Group230Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group230Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group230Node2 validates against the schema at #/properties/name/$ref.
+func draft7Group230Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := draft7Group230Node3(st, x, ip, sp.Append(draft7Group0Var17), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann3 := ann.Child()
+	if ce := draft7Group230Node4(st, x, ip, sp.Append(draft7Group0Var22), ann3); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann3)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// draft7Group230Node3 validates against the schema at #/properties/name/$ref/anyOf/0.
+func draft7Group230Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group230Node4 validates against the schema at #/properties/name/$ref/anyOf/1.
+func draft7Group230Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := draft7Group230Node5(st, x, ip, sp.Append(draft7Group0Var48), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// draft7Group230Node5 validates against the schema at #/properties/name/$ref/anyOf/1/$ref.
+func draft7Group230Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group231 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group231(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group231Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group231Node0 validates against the schema at #.
+func draft7Group231Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := draft7Group231Node1(st, x, ip, sp.Append(draft7Group0Var5), ann1); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group231Node1 validates against the schema at #/allOf/0.
+func draft7Group231Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group231Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group231Node2 validates against the schema at #/allOf/0/$ref.
+func draft7Group231Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	var allOfErrs []*jsonschema.ValidationError
+	ann3 := ann.Child()
+	if ce := draft7Group231Node3(st, x, ip, sp.Append(draft7Group0Var5), ann3); len(ce) > 0 {
+		allOfErrs = append(allOfErrs, ce...)
+	}
+	if len(allOfErrs) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "allOf", "did not validate against all subschemas", allOfErrs))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// draft7Group231Node3 validates against the schema at #/allOf/0/$ref/allOf/0.
+func draft7Group231Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := draft7Group231Node4(st, x, ip, sp.Append(draft7Group0Var48), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// draft7Group231Node4 validates against the schema at #/allOf/0/$ref/allOf/0/$ref.
+func draft7Group231Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+			errs = append(errs, draft7Group231Node5(st, v, ip.Key("bar"), sp.Append(draft7Group0Var6), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group231Node5 validates against the schema at #/allOf/0/$ref/allOf/0/$ref/properties/bar.
+func draft7Group231Node5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group232 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group232(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group232Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group232Node0 validates against the schema at #.
+func draft7Group232Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group232Node1(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group232Node1 validates against the schema at #/$ref.
+func draft7Group232Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group232Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group232Node2 validates against the schema at #/$ref/$ref.
+func draft7Group232Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group233 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group233(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group233Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group233Node0 validates against the schema at #.
+func draft7Group233Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["name"]; ok {
+			ann.RecordProperty("name")
+			errs = append(errs, draft7Group233Node1(st, v, ip.Key("name"), sp.Append(draft7Group0Var202), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group233Node1 validates against the schema at #/properties/name.
+func draft7Group233Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group233Node2(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group233Node2 validates against the schema at #/properties/name/$ref.
+func draft7Group233Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if v, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+			errs = append(errs, draft7Group233Node3(st, v, ip.Key("foo"), sp.Append(draft7Group0Var7), nil)...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group233Node3 validates against the schema at #/properties/name/$ref/properties/foo.
+func draft7Group233Node3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group233Node4(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group233Node4 validates against the schema at #/properties/name/$ref/properties/foo/$ref.
+func draft7Group233Node4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group234 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group234(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group234Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group234Node0 validates against the schema at #.
+func draft7Group234Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := draft7Group234Node1(st, x, ip, sp.Append(draft7Group0Var48), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// draft7Group234Node1 validates against the schema at #/$ref.
+func draft7Group234Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann2 := ann.Child()
+	if ce := draft7Group234Node2(st, x, ip, sp.Append(draft7Group0Var48), ann2); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann2)
+	}
+
+	return errs
+}
+
+// draft7Group234Node2 validates against the schema at #/$ref/$ref.
+func draft7Group234Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group235 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group235(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group235Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group235Node0 validates against the schema at #.
+func draft7Group235Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["bar"]; ok {
+			ann.RecordProperty("bar")
+		}
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group236 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group236(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group236Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group236Node0 validates against the schema at #.
+func draft7Group236Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group237 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group237(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group237Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group237Node0 validates against the schema at #.
+func draft7Group237Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo"]; ok {
+			ann.RecordProperty("foo")
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group238 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group238(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group238Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group238Node0 validates against the schema at #.
+func draft7Group238Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["foo\nbar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\nbar\""))
+		}
+		if _, ok := obj["foo\"bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\\"bar\""))
+		}
+		if _, ok := obj["foo\\bar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\\\bar\""))
+		}
+		if _, ok := obj["foo\rbar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\rbar\""))
+		}
+		if _, ok := obj["foo\tbar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\tbar\""))
+		}
+		if _, ok := obj["foo\fbar"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"foo\\fbar\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group239 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group239(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group239Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group239Node0 validates against the schema at #.
+func draft7Group239Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["__proto__"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"__proto__\""))
+		}
+		if _, ok := obj["toString"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"toString\""))
+		}
+		if _, ok := obj["constructor"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"constructor\""))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group240 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group240(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group240Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group240Node0 validates against the schema at #.
+func draft7Group240Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group241 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group241(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group241Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group241Node0 validates against the schema at #.
+func draft7Group241Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group242 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group242(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group242Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group242Node0 validates against the schema at #.
+func draft7Group242Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group243 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group243(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group243Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group243Node0 validates against the schema at #.
+func draft7Group243Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group244 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group244(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group244Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group244Node0 validates against the schema at #.
+func draft7Group244Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group245 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group245(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group245Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group245Node0 validates against the schema at #.
+func draft7Group245Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group246 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group246(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group246Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group246Node0 validates against the schema at #.
+func draft7Group246Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group247 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group247(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group247Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group247Node0 validates against the schema at #.
+func draft7Group247Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x) || genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"integer\", \"string\"], got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group248 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group248(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group248Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group248Node0 validates against the schema at #.
+func draft7Group248Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group249 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group249(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group249Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group249Node0 validates against the schema at #.
+func draft7Group249Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x) || genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"array\", \"object\"], got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group250 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group250(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group250Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group250Node0 validates against the schema at #.
+func draft7Group250Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x) || genrt.IsObject(x) || x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"array\", \"object\", \"null\"], got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group251 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group251(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group251Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group251Node0 validates against the schema at #.
+func draft7Group251Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if genrt.HasDuplicates(arr) {
+			errs = append(errs, genrt.Leaf(ip, sp, "uniqueItems", "array contains duplicate items"))
+		}
+	}
+
+	return errs
+}
+
+// Draft7Group252 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group252(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group252Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group252Node0 validates against the schema at #.
+func draft7Group252Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft7Group252Node1(st, arr[0], ip.Index(0), sp.Append(draft7Group0Var0), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft7Group252Node2(st, arr[1], ip.Index(1), sp.Append(draft7Group0Var3), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if genrt.HasDuplicates(arr) {
+			errs = append(errs, genrt.Leaf(ip, sp, "uniqueItems", "array contains duplicate items"))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group252Node1 validates against the schema at #/items/0.
+func draft7Group252Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group252Node2 validates against the schema at #/items/1.
+func draft7Group252Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group253 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group253(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group253Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group253Node0 validates against the schema at #.
+func draft7Group253Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft7Group253Node1(st, arr[0], ip.Index(0), sp.Append(draft7Group0Var0), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft7Group253Node2(st, arr[1], ip.Index(1), sp.Append(draft7Group0Var3), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+		rsp1 := sp.Append(draft7Group0Var1)
+		for i := 2; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp1, "additionalItems")...)
+		}
+	}
+
+	if arr, ok := x.([]any); ok {
+		if genrt.HasDuplicates(arr) {
+			errs = append(errs, genrt.Leaf(ip, sp, "uniqueItems", "array contains duplicate items"))
+		}
+	}
+
+	return errs
+}
+
+// draft7Group253Node1 validates against the schema at #/items/0.
+func draft7Group253Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group253Node2 validates against the schema at #/items/1.
+func draft7Group253Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group254 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group254(instance any) error {
+	_, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Draft7Group255 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group255(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group255Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group255Node0 validates against the schema at #.
+func draft7Group255Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft7Group255Node1(st, arr[0], ip.Index(0), sp.Append(draft7Group0Var0), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft7Group255Node2(st, arr[1], ip.Index(1), sp.Append(draft7Group0Var3), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	return errs
+}
+
+// draft7Group255Node1 validates against the schema at #/items/0.
+func draft7Group255Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group255Node2 validates against the schema at #/items/1.
+func draft7Group255Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// Draft7Group256 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7Group256(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7Group256Node0(&st, x, nil, nil, nil))
+}
+
+// draft7Group256Node0 validates against the schema at #.
+func draft7Group256Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, draft7Group256Node1(st, arr[0], ip.Index(0), sp.Append(draft7Group0Var0), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, draft7Group256Node2(st, arr[1], ip.Index(1), sp.Append(draft7Group0Var3), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+		rsp1 := sp.Append(draft7Group0Var1)
+		for i := 2; i < len(arr); i++ {
+			errs = append(errs, genrt.False(ip.Index(i), rsp1, "additionalItems")...)
+		}
+	}
+
+	return errs
+}
+
+// draft7Group256Node1 validates against the schema at #/items/0.
+func draft7Group256Node1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// draft7Group256Node2 validates against the schema at #/items/1.
+func draft7Group256Node2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
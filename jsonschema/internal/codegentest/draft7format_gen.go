@@ -0,0 +1,542 @@
+// Code generated by jsonschema.GenerateGo. DO NOT EDIT.
+
+package codegentest
+
+import (
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/genrt"
+)
+
+var (
+	draft7FormatGroup0Var0  = genrt.Format("date-time")
+	draft7FormatGroup0Var1  = genrt.Format("date")
+	draft7FormatGroup0Var2  = genrt.Format("email")
+	draft7FormatGroup0Var3  = genrt.Format("hostname")
+	draft7FormatGroup0Var4  = genrt.Format("idn-email")
+	draft7FormatGroup0Var5  = genrt.Format("idn-hostname")
+	draft7FormatGroup0Var6  = genrt.Format("ipv4")
+	draft7FormatGroup0Var7  = genrt.Format("ipv6")
+	draft7FormatGroup0Var8  = genrt.Format("iri-reference")
+	draft7FormatGroup0Var9  = genrt.Format("iri")
+	draft7FormatGroup0Var10 = genrt.Format("json-pointer")
+	draft7FormatGroup0Var11 = genrt.Format("regex")
+	draft7FormatGroup0Var12 = genrt.Format("relative-json-pointer")
+	draft7FormatGroup0Var13 = genrt.Format("time")
+	draft7FormatGroup0Var14 = genrt.Format("uri-reference")
+	draft7FormatGroup0Var15 = genrt.Format("uri-template")
+	draft7FormatGroup0Var16 = genrt.Format("uri")
+)
+
+// Draft7FormatGroup0 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup0(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup0Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup0Node0 validates against the schema at #.
+func draft7FormatGroup0Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var0(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "date-time", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup1 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup1(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup1Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup1Node0 validates against the schema at #.
+func draft7FormatGroup1Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var1(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "date", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup2 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup2(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup2Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup2Node0 validates against the schema at #.
+func draft7FormatGroup2Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var2(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "email", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup3 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup3(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup3Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup3Node0 validates against the schema at #.
+func draft7FormatGroup3Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var3(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "hostname", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup4 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup4(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup4Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup4Node0 validates against the schema at #.
+func draft7FormatGroup4Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var3(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "hostname", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup5 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup5(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup5Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup5Node0 validates against the schema at #.
+func draft7FormatGroup5Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var4(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "idn-email", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup6 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup6(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup6Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup6Node0 validates against the schema at #.
+func draft7FormatGroup6Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var5(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "idn-hostname", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup7 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup7(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup7Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup7Node0 validates against the schema at #.
+func draft7FormatGroup7Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var5(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "idn-hostname", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup8 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup8(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup8Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup8Node0 validates against the schema at #.
+func draft7FormatGroup8Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var6(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "ipv4", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup9 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup9(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup9Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup9Node0 validates against the schema at #.
+func draft7FormatGroup9Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var7(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "ipv6", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup10 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup10(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup10Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup10Node0 validates against the schema at #.
+func draft7FormatGroup10Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var8(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "iri-reference", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup11 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup11(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup11Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup11Node0 validates against the schema at #.
+func draft7FormatGroup11Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var9(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "iri", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup12 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup12(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup12Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup12Node0 validates against the schema at #.
+func draft7FormatGroup12Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var10(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "json-pointer", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup13 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup13(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup13Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup13Node0 validates against the schema at #.
+func draft7FormatGroup13Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var11(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "regex", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup14 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup14(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup14Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup14Node0 validates against the schema at #.
+func draft7FormatGroup14Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var12(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "relative-json-pointer", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup15 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup15(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup15Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup15Node0 validates against the schema at #.
+func draft7FormatGroup15Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var13(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "time", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup16 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup16(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup16Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup16Node0 validates against the schema at #.
+func draft7FormatGroup16Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	return errs
+}
+
+// Draft7FormatGroup17 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup17(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup17Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup17Node0 validates against the schema at #.
+func draft7FormatGroup17Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var14(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "uri-reference", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup18 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup18(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup18Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup18Node0 validates against the schema at #.
+func draft7FormatGroup18Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var15(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "uri-template", err))
+		}
+	}
+
+	return errs
+}
+
+// Draft7FormatGroup19 validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func Draft7FormatGroup19(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(draft7FormatGroup19Node0(&st, x, nil, nil, nil))
+}
+
+// draft7FormatGroup19Node0 validates against the schema at #.
+func draft7FormatGroup19Node0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if s, ok := x.(string); ok {
+		if err := draft7FormatGroup0Var16(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "uri", err))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,27 @@
+// Command gen writes the generated validators of package codegentest. It
+// runs from the package directory, as go generate runs it.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/codegentest/spec"
+)
+
+func main() {
+	files, err := spec.Files(context.Background(), "../../testdata")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	for name, src := range files {
+		err := os.WriteFile(name, src, 0o644) //nolint:gosec // Generated source is world-readable.
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
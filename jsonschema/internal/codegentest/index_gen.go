@@ -0,0 +1,664 @@
+// Code generated by go run ./gen. DO NOT EDIT.
+
+package codegentest
+
+// Suite maps each generated suite group's key to its validator.
+var Suite = map[string]func(any) error{
+	"Draft7/additionalItems.json/additionalItems as schema":                                             Draft7Group0,
+	"Draft7/additionalItems.json/when items is schema, additionalItems does nothing":                    Draft7Group1,
+	"Draft7/additionalItems.json/when items is schema, boolean additionalItems does nothing":            Draft7Group2,
+	"Draft7/additionalItems.json/array of items with no additionalItems permitted":                      Draft7Group3,
+	"Draft7/additionalItems.json/additionalItems as false without items":                                Draft7Group4,
+	"Draft7/additionalItems.json/additionalItems are allowed by default":                                Draft7Group5,
+	"Draft7/additionalItems.json/additionalItems does not look in applicators, invalid case":            Draft7Group6,
+	"Draft7/additionalItems.json/items validation adjusts the starting index for additionalItems":       Draft7Group7,
+	"Draft7/additionalItems.json/additionalItems with heterogeneous array":                              Draft7Group8,
+	"Draft7/additionalItems.json/additionalItems with null instance elements":                           Draft7Group9,
+	"Draft7/additionalProperties.json/additionalProperties being false does not allow other properties": Draft7Group10,
+	"Draft7/additionalProperties.json/non-ASCII pattern with additionalProperties":                      Draft7Group11,
+	"Draft7/additionalProperties.json/additionalProperties with schema":                                 Draft7Group12,
+	"Draft7/additionalProperties.json/additionalProperties can exist by itself":                         Draft7Group13,
+	"Draft7/additionalProperties.json/additionalProperties are allowed by default":                      Draft7Group14,
+	"Draft7/additionalProperties.json/additionalProperties does not look in applicators":                Draft7Group15,
+	"Draft7/additionalProperties.json/additionalProperties with null valued instance properties":        Draft7Group16,
+	"Draft7/allOf.json/allOf":                                                                              Draft7Group17,
+	"Draft7/allOf.json/allOf with base schema":                                                             Draft7Group18,
+	"Draft7/allOf.json/allOf simple types":                                                                 Draft7Group19,
+	"Draft7/allOf.json/allOf with boolean schemas, all true":                                               Draft7Group20,
+	"Draft7/allOf.json/allOf with boolean schemas, some false":                                             Draft7Group21,
+	"Draft7/allOf.json/allOf with boolean schemas, all false":                                              Draft7Group22,
+	"Draft7/allOf.json/allOf with one empty schema":                                                        Draft7Group23,
+	"Draft7/allOf.json/allOf with two empty schemas":                                                       Draft7Group24,
+	"Draft7/allOf.json/allOf with the first empty schema":                                                  Draft7Group25,
+	"Draft7/allOf.json/allOf with the last empty schema":                                                   Draft7Group26,
+	"Draft7/allOf.json/nested allOf, to check validation semantics":                                        Draft7Group27,
+	"Draft7/allOf.json/allOf combined with anyOf, oneOf":                                                   Draft7Group28,
+	"Draft7/anyOf.json/anyOf":                                                                              Draft7Group29,
+	"Draft7/anyOf.json/anyOf with base schema":                                                             Draft7Group30,
+	"Draft7/anyOf.json/anyOf with boolean schemas, all true":                                               Draft7Group31,
+	"Draft7/anyOf.json/anyOf with boolean schemas, some true":                                              Draft7Group32,
+	"Draft7/anyOf.json/anyOf with boolean schemas, all false":                                              Draft7Group33,
+	"Draft7/anyOf.json/anyOf complex types":                                                                Draft7Group34,
+	"Draft7/anyOf.json/anyOf with one empty schema":                                                        Draft7Group35,
+	"Draft7/anyOf.json/nested anyOf, to check validation semantics":                                        Draft7Group36,
+	"Draft7/boolean_schema.json/boolean schema 'true'":                                                     Draft7Group37,
+	"Draft7/boolean_schema.json/boolean schema 'false'":                                                    Draft7Group38,
+	"Draft7/const.json/const validation":                                                                   Draft7Group39,
+	"Draft7/const.json/const with object":                                                                  Draft7Group40,
+	"Draft7/const.json/const with array":                                                                   Draft7Group41,
+	"Draft7/const.json/const with null":                                                                    Draft7Group42,
+	"Draft7/const.json/const with false does not match 0":                                                  Draft7Group43,
+	"Draft7/const.json/const with true does not match 1":                                                   Draft7Group44,
+	"Draft7/const.json/const with [false] does not match [0]":                                              Draft7Group45,
+	"Draft7/const.json/const with [true] does not match [1]":                                               Draft7Group46,
+	"Draft7/const.json/const with {\"a\": false} does not match {\"a\": 0}":                                Draft7Group47,
+	"Draft7/const.json/const with {\"a\": true} does not match {\"a\": 1}":                                 Draft7Group48,
+	"Draft7/const.json/const with 0 does not match other zero-like types":                                  Draft7Group49,
+	"Draft7/const.json/const with 1 does not match true":                                                   Draft7Group50,
+	"Draft7/const.json/const with -2.0 matches integer and float types":                                    Draft7Group51,
+	"Draft7/const.json/float and integers are equal up to 64-bit representation limits":                    Draft7Group52,
+	"Draft7/const.json/nul characters in strings":                                                          Draft7Group53,
+	"Draft7/const.json/characters with the same visual representation but different codepoint":             Draft7Group54,
+	"Draft7/const.json/characters with the same visual representation, but different number of codepoints": Draft7Group55,
+	"Draft7/contains.json/contains keyword validation":                                                     Draft7Group56,
+	"Draft7/contains.json/contains keyword with const keyword":                                             Draft7Group57,
+	"Draft7/contains.json/contains keyword with boolean schema true":                                       Draft7Group58,
+	"Draft7/contains.json/contains keyword with boolean schema false":                                      Draft7Group59,
+	"Draft7/contains.json/items + contains":                                                                Draft7Group60,
+	"Draft7/contains.json/contains with false if subschema":                                                Draft7Group61,
+	"Draft7/contains.json/contains with null instance elements":                                            Draft7Group62,
+	"Draft7/default.json/invalid type for default":                                                         Draft7Group63,
+	"Draft7/default.json/invalid string value for default":                                                 Draft7Group64,
+	"Draft7/default.json/the default keyword does not do anything if the property is missing":              Draft7Group65,
+	"Draft7/definitions.json/validate definition against metaschema":                                       Draft7Group66,
+	"Draft7/dependencies.json/dependencies":                                                                Draft7Group67,
+	"Draft7/dependencies.json/dependencies with empty array":                                               Draft7Group68,
+	"Draft7/dependencies.json/multiple dependencies":                                                       Draft7Group69,
+	"Draft7/dependencies.json/multiple dependencies subschema":                                             Draft7Group70,
+	"Draft7/dependencies.json/dependencies with boolean subschemas":                                        Draft7Group71,
+	"Draft7/dependencies.json/dependencies with escaped characters":                                        Draft7Group72,
+	"Draft7/dependencies.json/dependent subschema incompatible with root":                                  Draft7Group73,
+	"Draft7/enum.json/simple enum validation":                                                              Draft7Group74,
+	"Draft7/enum.json/heterogeneous enum validation":                                                       Draft7Group75,
+	"Draft7/enum.json/heterogeneous enum-with-null validation":                                             Draft7Group76,
+	"Draft7/enum.json/enums in properties":                                                                 Draft7Group77,
+	"Draft7/enum.json/enum with escaped characters":                                                        Draft7Group78,
+	"Draft7/enum.json/enum with false does not match 0":                                                    Draft7Group79,
+	"Draft7/enum.json/enum with [false] does not match [0]":                                                Draft7Group80,
+	"Draft7/enum.json/enum with true does not match 1":                                                     Draft7Group81,
+	"Draft7/enum.json/enum with [true] does not match [1]":                                                 Draft7Group82,
+	"Draft7/enum.json/enum with 0 does not match false":                                                    Draft7Group83,
+	"Draft7/enum.json/enum with [0] does not match [false]":                                                Draft7Group84,
+	"Draft7/enum.json/enum with 1 does not match true":                                                     Draft7Group85,
+	"Draft7/enum.json/enum with [1] does not match [true]":                                                 Draft7Group86,
+	"Draft7/enum.json/nul characters in strings":                                                           Draft7Group87,
+	"Draft7/exclusiveMaximum.json/exclusiveMaximum validation":                                             Draft7Group88,
+	"Draft7/exclusiveMinimum.json/exclusiveMinimum validation":                                             Draft7Group89,
+	"Draft7/format.json/email format":                                                                      Draft7Group90,
+	"Draft7/format.json/idn-email format":                                                                  Draft7Group91,
+	"Draft7/format.json/regex format":                                                                      Draft7Group92,
+	"Draft7/format.json/ipv4 format":                                                                       Draft7Group93,
+	"Draft7/format.json/ipv6 format":                                                                       Draft7Group94,
+	"Draft7/format.json/idn-hostname format":                                                               Draft7Group95,
+	"Draft7/format.json/hostname format":                                                                   Draft7Group96,
+	"Draft7/format.json/date format":                                                                       Draft7Group97,
+	"Draft7/format.json/date-time format":                                                                  Draft7Group98,
+	"Draft7/format.json/time format":                                                                       Draft7Group99,
+	"Draft7/format.json/json-pointer format":                                                               Draft7Group100,
+	"Draft7/format.json/relative-json-pointer format":                                                      Draft7Group101,
+	"Draft7/format.json/iri format":                                                                        Draft7Group102,
+	"Draft7/format.json/iri-reference format":                                                              Draft7Group103,
+	"Draft7/format.json/uri format":                                                                        Draft7Group104,
+	"Draft7/format.json/uri-reference format":                                                              Draft7Group105,
+	"Draft7/format.json/uri-template format":                                                               Draft7Group106,
+	"Draft7/if-then-else.json/ignore if without then or else":                                              Draft7Group107,
+	"Draft7/if-then-else.json/ignore then without if":                                                      Draft7Group108,
+	"Draft7/if-then-else.json/ignore else without if":                                                      Draft7Group109,
+	"Draft7/if-then-else.json/if and then without else":                                                    Draft7Group110,
+	"Draft7/if-then-else.json/if and else without then":                                                    Draft7Group111,
+	"Draft7/if-then-else.json/validate against correct branch, then vs else":                               Draft7Group112,
+	"Draft7/if-then-else.json/non-interference across combined schemas":                                    Draft7Group113,
+	"Draft7/if-then-else.json/if with boolean schema true":                                                 Draft7Group114,
+	"Draft7/if-then-else.json/if with boolean schema false":                                                Draft7Group115,
+	"Draft7/if-then-else.json/if appears at the end when serialized (keyword processing sequence)":         Draft7Group116,
+	"Draft7/if-then-else.json/then: false fails when condition matches":                                    Draft7Group117,
+	"Draft7/if-then-else.json/else: false fails when condition does not match":                             Draft7Group118,
+	"Draft7/infinite-loop-detection.json/evaluating the same schema location against the same data location twice is not a sign of an infinite loop": Draft7Group119,
+	"Draft7/items.json/a schema given for items":                                                                                Draft7Group120,
+	"Draft7/items.json/an array of schemas for items":                                                                           Draft7Group121,
+	"Draft7/items.json/items with boolean schema (true)":                                                                        Draft7Group122,
+	"Draft7/items.json/items with boolean schema (false)":                                                                       Draft7Group123,
+	"Draft7/items.json/items with boolean schemas":                                                                              Draft7Group124,
+	"Draft7/items.json/items and subitems":                                                                                      Draft7Group125,
+	"Draft7/items.json/nested items":                                                                                            Draft7Group126,
+	"Draft7/items.json/single-form items with null instance elements":                                                           Draft7Group127,
+	"Draft7/items.json/array-form items with null instance elements":                                                            Draft7Group128,
+	"Draft7/maxItems.json/maxItems validation":                                                                                  Draft7Group129,
+	"Draft7/maxItems.json/maxItems validation with a decimal":                                                                   Draft7Group130,
+	"Draft7/maxLength.json/maxLength validation":                                                                                Draft7Group131,
+	"Draft7/maxLength.json/maxLength validation with a decimal":                                                                 Draft7Group132,
+	"Draft7/maxProperties.json/maxProperties validation":                                                                        Draft7Group133,
+	"Draft7/maxProperties.json/maxProperties validation with a decimal":                                                         Draft7Group134,
+	"Draft7/maxProperties.json/maxProperties = 0 means the object is empty":                                                     Draft7Group135,
+	"Draft7/maximum.json/maximum validation":                                                                                    Draft7Group136,
+	"Draft7/maximum.json/maximum validation with unsigned integer":                                                              Draft7Group137,
+	"Draft7/minItems.json/minItems validation":                                                                                  Draft7Group138,
+	"Draft7/minItems.json/minItems validation with a decimal":                                                                   Draft7Group139,
+	"Draft7/minLength.json/minLength validation":                                                                                Draft7Group140,
+	"Draft7/minLength.json/minLength validation with a decimal":                                                                 Draft7Group141,
+	"Draft7/minProperties.json/minProperties validation":                                                                        Draft7Group142,
+	"Draft7/minProperties.json/minProperties validation with a decimal":                                                         Draft7Group143,
+	"Draft7/minimum.json/minimum validation":                                                                                    Draft7Group144,
+	"Draft7/minimum.json/minimum validation with signed integer":                                                                Draft7Group145,
+	"Draft7/multipleOf.json/by int":                                                                                             Draft7Group146,
+	"Draft7/multipleOf.json/by number":                                                                                          Draft7Group147,
+	"Draft7/multipleOf.json/by small number":                                                                                    Draft7Group148,
+	"Draft7/multipleOf.json/float division = inf":                                                                               Draft7Group149,
+	"Draft7/multipleOf.json/small multiple of large integer":                                                                    Draft7Group150,
+	"Draft7/not.json/not":                                                                                                       Draft7Group151,
+	"Draft7/not.json/not multiple types":                                                                                        Draft7Group152,
+	"Draft7/not.json/not more complex schema":                                                                                   Draft7Group153,
+	"Draft7/not.json/forbidden property":                                                                                        Draft7Group154,
+	"Draft7/not.json/forbid everything with empty schema":                                                                       Draft7Group155,
+	"Draft7/not.json/forbid everything with boolean schema true":                                                                Draft7Group156,
+	"Draft7/not.json/allow everything with boolean schema false":                                                                Draft7Group157,
+	"Draft7/not.json/double negation":                                                                                           Draft7Group158,
+	"Draft7/oneOf.json/oneOf":                                                                                                   Draft7Group159,
+	"Draft7/oneOf.json/oneOf with base schema":                                                                                  Draft7Group160,
+	"Draft7/oneOf.json/oneOf with boolean schemas, all true":                                                                    Draft7Group161,
+	"Draft7/oneOf.json/oneOf with boolean schemas, one true":                                                                    Draft7Group162,
+	"Draft7/oneOf.json/oneOf with boolean schemas, more than one true":                                                          Draft7Group163,
+	"Draft7/oneOf.json/oneOf with boolean schemas, all false":                                                                   Draft7Group164,
+	"Draft7/oneOf.json/oneOf complex types":                                                                                     Draft7Group165,
+	"Draft7/oneOf.json/oneOf with empty schema":                                                                                 Draft7Group166,
+	"Draft7/oneOf.json/oneOf with required":                                                                                     Draft7Group167,
+	"Draft7/oneOf.json/oneOf with missing optional property":                                                                    Draft7Group168,
+	"Draft7/oneOf.json/nested oneOf, to check validation semantics":                                                             Draft7Group169,
+	"Draft7/pattern.json/pattern validation":                                                                                    Draft7Group170,
+	"Draft7/pattern.json/pattern is not anchored":                                                                               Draft7Group171,
+	"Draft7/patternProperties.json/patternProperties validates properties matching a regex":                                     Draft7Group172,
+	"Draft7/patternProperties.json/multiple simultaneous patternProperties are validated":                                       Draft7Group173,
+	"Draft7/patternProperties.json/regexes are not anchored by default and are case sensitive":                                  Draft7Group174,
+	"Draft7/patternProperties.json/patternProperties with boolean schemas":                                                      Draft7Group175,
+	"Draft7/patternProperties.json/patternProperties with null valued instance properties":                                      Draft7Group176,
+	"Draft7/properties.json/object properties validation":                                                                       Draft7Group177,
+	"Draft7/properties.json/properties, patternProperties, additionalProperties interaction":                                    Draft7Group178,
+	"Draft7/properties.json/properties with boolean schema":                                                                     Draft7Group179,
+	"Draft7/properties.json/properties with null valued instance properties":                                                    Draft7Group181,
+	"Draft7/properties.json/properties whose names are Javascript object property names":                                        Draft7Group182,
+	"Draft7/propertyNames.json/propertyNames validation":                                                                        Draft7Group183,
+	"Draft7/propertyNames.json/propertyNames validation with pattern":                                                           Draft7Group184,
+	"Draft7/propertyNames.json/propertyNames with boolean schema true":                                                          Draft7Group185,
+	"Draft7/propertyNames.json/propertyNames with boolean schema false":                                                         Draft7Group186,
+	"Draft7/propertyNames.json/propertyNames with const":                                                                        Draft7Group187,
+	"Draft7/propertyNames.json/propertyNames with enum":                                                                         Draft7Group188,
+	"Draft7/ref.json/root pointer ref":                                                                                          Draft7Group189,
+	"Draft7/ref.json/relative pointer ref to object":                                                                            Draft7Group190,
+	"Draft7/ref.json/relative pointer ref to array":                                                                             Draft7Group191,
+	"Draft7/ref.json/escaped pointer ref":                                                                                       Draft7Group192,
+	"Draft7/ref.json/nested refs":                                                                                               Draft7Group193,
+	"Draft7/ref.json/ref overrides any sibling keywords":                                                                        Draft7Group194,
+	"Draft7/ref.json/$ref prevents a sibling $id from changing the base uri":                                                    Draft7Group195,
+	"Draft7/ref.json/remote ref, containing refs itself":                                                                        Draft7Group196,
+	"Draft7/ref.json/property named $ref that is not a reference":                                                               Draft7Group197,
+	"Draft7/ref.json/property named $ref, containing an actual $ref":                                                            Draft7Group198,
+	"Draft7/ref.json/$ref to boolean schema true":                                                                               Draft7Group199,
+	"Draft7/ref.json/$ref to boolean schema false":                                                                              Draft7Group200,
+	"Draft7/ref.json/Recursive references between schemas":                                                                      Draft7Group201,
+	"Draft7/ref.json/refs with quote":                                                                                           Draft7Group202,
+	"Draft7/ref.json/Location-independent identifier":                                                                           Draft7Group203,
+	"Draft7/ref.json/Reference an anchor with a non-relative URI":                                                               Draft7Group204,
+	"Draft7/ref.json/Location-independent identifier with base URI change in subschema":                                         Draft7Group205,
+	"Draft7/ref.json/naive replacement of $ref with its destination is not correct":                                             Draft7Group206,
+	"Draft7/ref.json/refs with relative uris and defs":                                                                          Draft7Group207,
+	"Draft7/ref.json/relative refs with absolute uris and defs":                                                                 Draft7Group208,
+	"Draft7/ref.json/$id must be resolved against nearest parent, not just immediate parent":                                    Draft7Group209,
+	"Draft7/ref.json/simple URN base URI with $ref via the URN":                                                                 Draft7Group210,
+	"Draft7/ref.json/simple URN base URI with JSON pointer":                                                                     Draft7Group211,
+	"Draft7/ref.json/URN base URI with NSS":                                                                                     Draft7Group212,
+	"Draft7/ref.json/URN base URI with r-component":                                                                             Draft7Group213,
+	"Draft7/ref.json/URN base URI with q-component":                                                                             Draft7Group214,
+	"Draft7/ref.json/URN base URI with URN and JSON pointer ref":                                                                Draft7Group215,
+	"Draft7/ref.json/URN base URI with URN and anchor ref":                                                                      Draft7Group216,
+	"Draft7/ref.json/ref to if":                                                                                                 Draft7Group217,
+	"Draft7/ref.json/ref to then":                                                                                               Draft7Group218,
+	"Draft7/ref.json/ref to else":                                                                                               Draft7Group219,
+	"Draft7/ref.json/ref with absolute-path-reference":                                                                          Draft7Group220,
+	"Draft7/ref.json/$id with file URI still resolves pointers - *nix":                                                          Draft7Group221,
+	"Draft7/ref.json/$id with file URI still resolves pointers - windows":                                                       Draft7Group222,
+	"Draft7/ref.json/empty tokens in $ref json-pointer":                                                                         Draft7Group223,
+	"Draft7/refRemote.json/remote ref":                                                                                          Draft7Group224,
+	"Draft7/refRemote.json/fragment within remote ref":                                                                          Draft7Group225,
+	"Draft7/refRemote.json/ref within remote ref":                                                                               Draft7Group226,
+	"Draft7/refRemote.json/base URI change":                                                                                     Draft7Group227,
+	"Draft7/refRemote.json/base URI change - change folder":                                                                     Draft7Group228,
+	"Draft7/refRemote.json/base URI change - change folder in subschema":                                                        Draft7Group229,
+	"Draft7/refRemote.json/root ref in remote ref":                                                                              Draft7Group230,
+	"Draft7/refRemote.json/remote ref with ref to definitions":                                                                  Draft7Group231,
+	"Draft7/refRemote.json/Location-independent identifier in remote ref":                                                       Draft7Group232,
+	"Draft7/refRemote.json/retrieved nested refs resolve relative to their URI not $id":                                         Draft7Group233,
+	"Draft7/refRemote.json/$ref to $ref finds location-independent $id":                                                         Draft7Group234,
+	"Draft7/required.json/required validation":                                                                                  Draft7Group235,
+	"Draft7/required.json/required default validation":                                                                          Draft7Group236,
+	"Draft7/required.json/required with empty array":                                                                            Draft7Group237,
+	"Draft7/required.json/required with escaped characters":                                                                     Draft7Group238,
+	"Draft7/required.json/required properties whose names are Javascript object property names":                                 Draft7Group239,
+	"Draft7/type.json/integer type matches integers":                                                                            Draft7Group240,
+	"Draft7/type.json/number type matches numbers":                                                                              Draft7Group241,
+	"Draft7/type.json/string type matches strings":                                                                              Draft7Group242,
+	"Draft7/type.json/object type matches objects":                                                                              Draft7Group243,
+	"Draft7/type.json/array type matches arrays":                                                                                Draft7Group244,
+	"Draft7/type.json/boolean type matches booleans":                                                                            Draft7Group245,
+	"Draft7/type.json/null type matches only the null object":                                                                   Draft7Group246,
+	"Draft7/type.json/multiple types can be specified in an array":                                                              Draft7Group247,
+	"Draft7/type.json/type as array with one item":                                                                              Draft7Group248,
+	"Draft7/type.json/type: array or object":                                                                                    Draft7Group249,
+	"Draft7/type.json/type: array, object or null":                                                                              Draft7Group250,
+	"Draft7/uniqueItems.json/uniqueItems validation":                                                                            Draft7Group251,
+	"Draft7/uniqueItems.json/uniqueItems with an array of items":                                                                Draft7Group252,
+	"Draft7/uniqueItems.json/uniqueItems with an array of items and additionalItems=false":                                      Draft7Group253,
+	"Draft7/uniqueItems.json/uniqueItems=false validation":                                                                      Draft7Group254,
+	"Draft7/uniqueItems.json/uniqueItems=false with an array of items":                                                          Draft7Group255,
+	"Draft7/uniqueItems.json/uniqueItems=false with an array of items and additionalItems=false":                                Draft7Group256,
+	"Draft2020/additionalProperties.json/additionalProperties being false does not allow other properties":                      Draft2020Group0,
+	"Draft2020/additionalProperties.json/non-ASCII pattern with additionalProperties":                                           Draft2020Group1,
+	"Draft2020/additionalProperties.json/additionalProperties with schema":                                                      Draft2020Group2,
+	"Draft2020/additionalProperties.json/additionalProperties can exist by itself":                                              Draft2020Group3,
+	"Draft2020/additionalProperties.json/additionalProperties are allowed by default":                                           Draft2020Group4,
+	"Draft2020/additionalProperties.json/additionalProperties does not look in applicators":                                     Draft2020Group5,
+	"Draft2020/additionalProperties.json/additionalProperties with null valued instance properties":                             Draft2020Group6,
+	"Draft2020/additionalProperties.json/additionalProperties with propertyNames":                                               Draft2020Group7,
+	"Draft2020/additionalProperties.json/dependentSchemas with additionalProperties":                                            Draft2020Group8,
+	"Draft2020/allOf.json/allOf":                                                                                                Draft2020Group9,
+	"Draft2020/allOf.json/allOf with base schema":                                                                               Draft2020Group10,
+	"Draft2020/allOf.json/allOf simple types":                                                                                   Draft2020Group11,
+	"Draft2020/allOf.json/allOf with boolean schemas, all true":                                                                 Draft2020Group12,
+	"Draft2020/allOf.json/allOf with boolean schemas, some false":                                                               Draft2020Group13,
+	"Draft2020/allOf.json/allOf with boolean schemas, all false":                                                                Draft2020Group14,
+	"Draft2020/allOf.json/allOf with one empty schema":                                                                          Draft2020Group15,
+	"Draft2020/allOf.json/allOf with two empty schemas":                                                                         Draft2020Group16,
+	"Draft2020/allOf.json/allOf with the first empty schema":                                                                    Draft2020Group17,
+	"Draft2020/allOf.json/allOf with the last empty schema":                                                                     Draft2020Group18,
+	"Draft2020/allOf.json/nested allOf, to check validation semantics":                                                          Draft2020Group19,
+	"Draft2020/allOf.json/allOf combined with anyOf, oneOf":                                                                     Draft2020Group20,
+	"Draft2020/anchor.json/Location-independent identifier":                                                                     Draft2020Group21,
+	"Draft2020/anchor.json/Location-independent identifier with absolute URI":                                                   Draft2020Group22,
+	"Draft2020/anchor.json/Location-independent identifier with base URI change in subschema":                                   Draft2020Group23,
+	"Draft2020/anchor.json/same $anchor with different base uri":                                                                Draft2020Group24,
+	"Draft2020/anyOf.json/anyOf":                                                                                                Draft2020Group25,
+	"Draft2020/anyOf.json/anyOf with base schema":                                                                               Draft2020Group26,
+	"Draft2020/anyOf.json/anyOf with boolean schemas, all true":                                                                 Draft2020Group27,
+	"Draft2020/anyOf.json/anyOf with boolean schemas, some true":                                                                Draft2020Group28,
+	"Draft2020/anyOf.json/anyOf with boolean schemas, all false":                                                                Draft2020Group29,
+	"Draft2020/anyOf.json/anyOf complex types":                                                                                  Draft2020Group30,
+	"Draft2020/anyOf.json/anyOf with one empty schema":                                                                          Draft2020Group31,
+	"Draft2020/anyOf.json/nested anyOf, to check validation semantics":                                                          Draft2020Group32,
+	"Draft2020/boolean_schema.json/boolean schema 'true'":                                                                       Draft2020Group33,
+	"Draft2020/boolean_schema.json/boolean schema 'false'":                                                                      Draft2020Group34,
+	"Draft2020/const.json/const validation":                                                                                     Draft2020Group35,
+	"Draft2020/const.json/const with object":                                                                                    Draft2020Group36,
+	"Draft2020/const.json/const with array":                                                                                     Draft2020Group37,
+	"Draft2020/const.json/const with null":                                                                                      Draft2020Group38,
+	"Draft2020/const.json/const with false does not match 0":                                                                    Draft2020Group39,
+	"Draft2020/const.json/const with true does not match 1":                                                                     Draft2020Group40,
+	"Draft2020/const.json/const with [false] does not match [0]":                                                                Draft2020Group41,
+	"Draft2020/const.json/const with [true] does not match [1]":                                                                 Draft2020Group42,
+	"Draft2020/const.json/const with {\"a\": false} does not match {\"a\": 0}":                                                  Draft2020Group43,
+	"Draft2020/const.json/const with {\"a\": true} does not match {\"a\": 1}":                                                   Draft2020Group44,
+	"Draft2020/const.json/const with 0 does not match other zero-like types":                                                    Draft2020Group45,
+	"Draft2020/const.json/const with 1 does not match true":                                                                     Draft2020Group46,
+	"Draft2020/const.json/const with -2.0 matches integer and float types":                                                      Draft2020Group47,
+	"Draft2020/const.json/float and integers are equal up to 64-bit representation limits":                                      Draft2020Group48,
+	"Draft2020/const.json/nul characters in strings":                                                                            Draft2020Group49,
+	"Draft2020/const.json/characters with the same visual representation but different codepoint":                               Draft2020Group50,
+	"Draft2020/const.json/characters with the same visual representation, but different number of codepoints":                   Draft2020Group51,
+	"Draft2020/contains.json/contains keyword validation":                                                                       Draft2020Group52,
+	"Draft2020/contains.json/contains keyword with const keyword":                                                               Draft2020Group53,
+	"Draft2020/contains.json/contains keyword with boolean schema true":                                                         Draft2020Group54,
+	"Draft2020/contains.json/contains keyword with boolean schema false":                                                        Draft2020Group55,
+	"Draft2020/contains.json/items + contains":                                                                                  Draft2020Group56,
+	"Draft2020/contains.json/contains with false if subschema":                                                                  Draft2020Group57,
+	"Draft2020/contains.json/contains with null instance elements":                                                              Draft2020Group58,
+	"Draft2020/content.json/validation of string-encoded content based on media type":                                           Draft2020Group59,
+	"Draft2020/content.json/validation of binary string-encoding":                                                               Draft2020Group60,
+	"Draft2020/content.json/validation of binary-encoded media type documents":                                                  Draft2020Group61,
+	"Draft2020/content.json/validation of binary-encoded media type documents with schema":                                      Draft2020Group62,
+	"Draft2020/default.json/invalid type for default":                                                                           Draft2020Group63,
+	"Draft2020/default.json/invalid string value for default":                                                                   Draft2020Group64,
+	"Draft2020/default.json/the default keyword does not do anything if the property is missing":                                Draft2020Group65,
+	"Draft2020/dependentRequired.json/single dependency":                                                                        Draft2020Group67,
+	"Draft2020/dependentRequired.json/empty dependents":                                                                         Draft2020Group68,
+	"Draft2020/dependentRequired.json/multiple dependents required":                                                             Draft2020Group69,
+	"Draft2020/dependentRequired.json/dependencies with escaped characters":                                                     Draft2020Group70,
+	"Draft2020/dependentSchemas.json/single dependency":                                                                         Draft2020Group71,
+	"Draft2020/dependentSchemas.json/boolean subschemas":                                                                        Draft2020Group72,
+	"Draft2020/dependentSchemas.json/dependencies with escaped characters":                                                      Draft2020Group73,
+	"Draft2020/dependentSchemas.json/dependent subschema incompatible with root":                                                Draft2020Group74,
+	"Draft2020/dynamicRef.json/A $ref to a $dynamicAnchor in the same schema resource behaves like a normal $ref to an $anchor": Draft2020Group77,
+	"Draft2020/enum.json/simple enum validation":                                                                                Draft2020Group96,
+	"Draft2020/enum.json/heterogeneous enum validation":                                                                         Draft2020Group97,
+	"Draft2020/enum.json/heterogeneous enum-with-null validation":                                                               Draft2020Group98,
+	"Draft2020/enum.json/enums in properties":                                                                                   Draft2020Group99,
+	"Draft2020/enum.json/enum with escaped characters":                                                                          Draft2020Group100,
+	"Draft2020/enum.json/enum with false does not match 0":                                                                      Draft2020Group101,
+	"Draft2020/enum.json/enum with [false] does not match [0]":                                                                  Draft2020Group102,
+	"Draft2020/enum.json/enum with true does not match 1":                                                                       Draft2020Group103,
+	"Draft2020/enum.json/enum with [true] does not match [1]":                                                                   Draft2020Group104,
+	"Draft2020/enum.json/enum with 0 does not match false":                                                                      Draft2020Group105,
+	"Draft2020/enum.json/enum with [0] does not match [false]":                                                                  Draft2020Group106,
+	"Draft2020/enum.json/enum with 1 does not match true":                                                                       Draft2020Group107,
+	"Draft2020/enum.json/enum with [1] does not match [true]":                                                                   Draft2020Group108,
+	"Draft2020/enum.json/nul characters in strings":                                                                             Draft2020Group109,
+	"Draft2020/enum.json/empty enum":                                                                                            Draft2020Group110,
+	"Draft2020/exclusiveMaximum.json/exclusiveMaximum validation":                                                               Draft2020Group111,
+	"Draft2020/exclusiveMinimum.json/exclusiveMinimum validation":                                                               Draft2020Group112,
+	"Draft2020/format.json/email format":                                                                                        Draft2020Group113,
+	"Draft2020/format.json/idn-email format":                                                                                    Draft2020Group114,
+	"Draft2020/format.json/regex format":                                                                                        Draft2020Group115,
+	"Draft2020/format.json/ipv4 format":                                                                                         Draft2020Group116,
+	"Draft2020/format.json/ipv6 format":                                                                                         Draft2020Group117,
+	"Draft2020/format.json/idn-hostname format":                                                                                 Draft2020Group118,
+	"Draft2020/format.json/hostname format":                                                                                     Draft2020Group119,
+	"Draft2020/format.json/date format":                                                                                         Draft2020Group120,
+	"Draft2020/format.json/date-time format":                                                                                    Draft2020Group121,
+	"Draft2020/format.json/time format":                                                                                         Draft2020Group122,
+	"Draft2020/format.json/json-pointer format":                                                                                 Draft2020Group123,
+	"Draft2020/format.json/relative-json-pointer format":                                                                        Draft2020Group124,
+	"Draft2020/format.json/iri format":                                                                                          Draft2020Group125,
+	"Draft2020/format.json/iri-reference format":                                                                                Draft2020Group126,
+	"Draft2020/format.json/uri format":                                                                                          Draft2020Group127,
+	"Draft2020/format.json/uri-reference format":                                                                                Draft2020Group128,
+	"Draft2020/format.json/uri-template format":                                                                                 Draft2020Group129,
+	"Draft2020/format.json/uuid format":                                                                                         Draft2020Group130,
+	"Draft2020/format.json/duration format":                                                                                     Draft2020Group131,
+	"Draft2020/if-then-else.json/ignore if without then or else":                                                                Draft2020Group132,
+	"Draft2020/if-then-else.json/ignore then without if":                                                                        Draft2020Group133,
+	"Draft2020/if-then-else.json/ignore else without if":                                                                        Draft2020Group134,
+	"Draft2020/if-then-else.json/if and then without else":                                                                      Draft2020Group135,
+	"Draft2020/if-then-else.json/if and else without then":                                                                      Draft2020Group136,
+	"Draft2020/if-then-else.json/validate against correct branch, then vs else":                                                 Draft2020Group137,
+	"Draft2020/if-then-else.json/non-interference across combined schemas":                                                      Draft2020Group138,
+	"Draft2020/if-then-else.json/if with boolean schema true":                                                                   Draft2020Group139,
+	"Draft2020/if-then-else.json/if with boolean schema false":                                                                  Draft2020Group140,
+	"Draft2020/if-then-else.json/if appears at the end when serialized (keyword processing sequence)":                           Draft2020Group141,
+	"Draft2020/if-then-else.json/then: false fails when condition matches":                                                      Draft2020Group142,
+	"Draft2020/if-then-else.json/else: false fails when condition does not match":                                               Draft2020Group143,
+	"Draft2020/infinite-loop-detection.json/evaluating the same schema location against the same data location twice is not a sign of an infinite loop": Draft2020Group144,
+	"Draft2020/items.json/a schema given for items":                                                                                      Draft2020Group145,
+	"Draft2020/items.json/items with boolean schema (true)":                                                                              Draft2020Group146,
+	"Draft2020/items.json/items with boolean schema (false)":                                                                             Draft2020Group147,
+	"Draft2020/items.json/items and subitems":                                                                                            Draft2020Group148,
+	"Draft2020/items.json/nested items":                                                                                                  Draft2020Group149,
+	"Draft2020/items.json/prefixItems with no additional items allowed":                                                                  Draft2020Group150,
+	"Draft2020/items.json/items does not look in applicators, valid case":                                                                Draft2020Group151,
+	"Draft2020/items.json/prefixItems validation adjusts the starting index for items":                                                   Draft2020Group152,
+	"Draft2020/items.json/items with heterogeneous array":                                                                                Draft2020Group153,
+	"Draft2020/items.json/items with null instance elements":                                                                             Draft2020Group154,
+	"Draft2020/maxContains.json/maxContains without contains is ignored":                                                                 Draft2020Group155,
+	"Draft2020/maxContains.json/maxContains with contains":                                                                               Draft2020Group156,
+	"Draft2020/maxContains.json/maxContains with contains, value with a decimal":                                                         Draft2020Group157,
+	"Draft2020/maxContains.json/minContains < maxContains":                                                                               Draft2020Group158,
+	"Draft2020/maxContains.json/maxContains = 0 with minContains = 0":                                                                    Draft2020Group159,
+	"Draft2020/maxItems.json/maxItems validation":                                                                                        Draft2020Group160,
+	"Draft2020/maxItems.json/maxItems validation with a decimal":                                                                         Draft2020Group161,
+	"Draft2020/maxLength.json/maxLength validation":                                                                                      Draft2020Group162,
+	"Draft2020/maxLength.json/maxLength validation with a decimal":                                                                       Draft2020Group163,
+	"Draft2020/maxProperties.json/maxProperties validation":                                                                              Draft2020Group164,
+	"Draft2020/maxProperties.json/maxProperties validation with a decimal":                                                               Draft2020Group165,
+	"Draft2020/maxProperties.json/maxProperties = 0 means the object is empty":                                                           Draft2020Group166,
+	"Draft2020/maximum.json/maximum validation":                                                                                          Draft2020Group167,
+	"Draft2020/maximum.json/maximum validation with unsigned integer":                                                                    Draft2020Group168,
+	"Draft2020/minContains.json/minContains without contains is ignored":                                                                 Draft2020Group169,
+	"Draft2020/minContains.json/minContains=1 with contains":                                                                             Draft2020Group170,
+	"Draft2020/minContains.json/minContains=2 with contains":                                                                             Draft2020Group171,
+	"Draft2020/minContains.json/minContains=2 with contains with a decimal value":                                                        Draft2020Group172,
+	"Draft2020/minContains.json/maxContains = minContains":                                                                               Draft2020Group173,
+	"Draft2020/minContains.json/maxContains < minContains":                                                                               Draft2020Group174,
+	"Draft2020/minContains.json/minContains = 0":                                                                                         Draft2020Group175,
+	"Draft2020/minContains.json/minContains = 0 with maxContains":                                                                        Draft2020Group176,
+	"Draft2020/minItems.json/minItems validation":                                                                                        Draft2020Group177,
+	"Draft2020/minItems.json/minItems validation with a decimal":                                                                         Draft2020Group178,
+	"Draft2020/minLength.json/minLength validation":                                                                                      Draft2020Group179,
+	"Draft2020/minLength.json/minLength validation with a decimal":                                                                       Draft2020Group180,
+	"Draft2020/minProperties.json/minProperties validation":                                                                              Draft2020Group181,
+	"Draft2020/minProperties.json/minProperties validation with a decimal":                                                               Draft2020Group182,
+	"Draft2020/minimum.json/minimum validation":                                                                                          Draft2020Group183,
+	"Draft2020/minimum.json/minimum validation with signed integer":                                                                      Draft2020Group184,
+	"Draft2020/multipleOf.json/by int":                                                                                                   Draft2020Group185,
+	"Draft2020/multipleOf.json/by number":                                                                                                Draft2020Group186,
+	"Draft2020/multipleOf.json/by small number":                                                                                          Draft2020Group187,
+	"Draft2020/multipleOf.json/float division = inf":                                                                                     Draft2020Group188,
+	"Draft2020/multipleOf.json/small multiple of large integer":                                                                          Draft2020Group189,
+	"Draft2020/not.json/not":                                                                                                             Draft2020Group190,
+	"Draft2020/not.json/not multiple types":                                                                                              Draft2020Group191,
+	"Draft2020/not.json/not more complex schema":                                                                                         Draft2020Group192,
+	"Draft2020/not.json/forbidden property":                                                                                              Draft2020Group193,
+	"Draft2020/not.json/forbid everything with empty schema":                                                                             Draft2020Group194,
+	"Draft2020/not.json/forbid everything with boolean schema true":                                                                      Draft2020Group195,
+	"Draft2020/not.json/allow everything with boolean schema false":                                                                      Draft2020Group196,
+	"Draft2020/not.json/double negation":                                                                                                 Draft2020Group197,
+	"Draft2020/not.json/collect annotations inside a 'not', even if collection is disabled":                                              Draft2020Group198,
+	"Draft2020/oneOf.json/oneOf":                                                                                                         Draft2020Group199,
+	"Draft2020/oneOf.json/oneOf with base schema":                                                                                        Draft2020Group200,
+	"Draft2020/oneOf.json/oneOf with boolean schemas, all true":                                                                          Draft2020Group201,
+	"Draft2020/oneOf.json/oneOf with boolean schemas, one true":                                                                          Draft2020Group202,
+	"Draft2020/oneOf.json/oneOf with boolean schemas, more than one true":                                                                Draft2020Group203,
+	"Draft2020/oneOf.json/oneOf with boolean schemas, all false":                                                                         Draft2020Group204,
+	"Draft2020/oneOf.json/oneOf complex types":                                                                                           Draft2020Group205,
+	"Draft2020/oneOf.json/oneOf with empty schema":                                                                                       Draft2020Group206,
+	"Draft2020/oneOf.json/oneOf with required":                                                                                           Draft2020Group207,
+	"Draft2020/oneOf.json/oneOf with missing optional property":                                                                          Draft2020Group208,
+	"Draft2020/oneOf.json/nested oneOf, to check validation semantics":                                                                   Draft2020Group209,
+	"Draft2020/pattern.json/pattern validation":                                                                                          Draft2020Group210,
+	"Draft2020/pattern.json/pattern is not anchored":                                                                                     Draft2020Group211,
+	"Draft2020/pattern.json/pattern with Unicode property escape requires unicode mode":                                                  Draft2020Group212,
+	"Draft2020/patternProperties.json/patternProperties validates properties matching a regex":                                           Draft2020Group213,
+	"Draft2020/patternProperties.json/multiple simultaneous patternProperties are validated":                                             Draft2020Group214,
+	"Draft2020/patternProperties.json/regexes are not anchored by default and are case sensitive":                                        Draft2020Group215,
+	"Draft2020/patternProperties.json/patternProperties with boolean schemas":                                                            Draft2020Group216,
+	"Draft2020/patternProperties.json/patternProperties with null valued instance properties":                                            Draft2020Group217,
+	"Draft2020/patternProperties.json/patternProperties with Unicode property escape":                                                    Draft2020Group218,
+	"Draft2020/prefixItems.json/a schema given for prefixItems":                                                                          Draft2020Group219,
+	"Draft2020/prefixItems.json/prefixItems with boolean schemas":                                                                        Draft2020Group220,
+	"Draft2020/prefixItems.json/additional items are allowed by default":                                                                 Draft2020Group221,
+	"Draft2020/prefixItems.json/prefixItems with null instance elements":                                                                 Draft2020Group222,
+	"Draft2020/properties.json/object properties validation":                                                                             Draft2020Group223,
+	"Draft2020/properties.json/properties, patternProperties, additionalProperties interaction":                                          Draft2020Group224,
+	"Draft2020/properties.json/properties with boolean schema":                                                                           Draft2020Group225,
+	"Draft2020/properties.json/properties with null valued instance properties":                                                          Draft2020Group227,
+	"Draft2020/properties.json/properties whose names are Javascript object property names":                                              Draft2020Group228,
+	"Draft2020/propertyNames.json/propertyNames validation":                                                                              Draft2020Group229,
+	"Draft2020/propertyNames.json/propertyNames validation with pattern":                                                                 Draft2020Group230,
+	"Draft2020/propertyNames.json/propertyNames with boolean schema true":                                                                Draft2020Group231,
+	"Draft2020/propertyNames.json/propertyNames with boolean schema false":                                                               Draft2020Group232,
+	"Draft2020/propertyNames.json/propertyNames with const":                                                                              Draft2020Group233,
+	"Draft2020/propertyNames.json/propertyNames with enum":                                                                               Draft2020Group234,
+	"Draft2020/ref.json/root pointer ref":                                                                                                Draft2020Group235,
+	"Draft2020/ref.json/relative pointer ref to object":                                                                                  Draft2020Group236,
+	"Draft2020/ref.json/relative pointer ref to array":                                                                                   Draft2020Group237,
+	"Draft2020/ref.json/escaped pointer ref":                                                                                             Draft2020Group238,
+	"Draft2020/ref.json/nested refs":                                                                                                     Draft2020Group239,
+	"Draft2020/ref.json/ref applies alongside sibling keywords":                                                                          Draft2020Group240,
+	"Draft2020/ref.json/property named $ref that is not a reference":                                                                     Draft2020Group242,
+	"Draft2020/ref.json/property named $ref, containing an actual $ref":                                                                  Draft2020Group243,
+	"Draft2020/ref.json/$ref to boolean schema true":                                                                                     Draft2020Group244,
+	"Draft2020/ref.json/$ref to boolean schema false":                                                                                    Draft2020Group245,
+	"Draft2020/ref.json/Recursive references between schemas":                                                                            Draft2020Group246,
+	"Draft2020/ref.json/refs with quote":                                                                                                 Draft2020Group247,
+	"Draft2020/ref.json/ref creates new scope when adjacent to keywords":                                                                 Draft2020Group248,
+	"Draft2020/ref.json/naive replacement of $ref with its destination is not correct":                                                   Draft2020Group249,
+	"Draft2020/ref.json/refs with relative uris and defs":                                                                                Draft2020Group250,
+	"Draft2020/ref.json/relative refs with absolute uris and defs":                                                                       Draft2020Group251,
+	"Draft2020/ref.json/$id must be resolved against nearest parent, not just immediate parent":                                          Draft2020Group252,
+	"Draft2020/ref.json/order of evaluation: $id and $ref":                                                                               Draft2020Group253,
+	"Draft2020/ref.json/order of evaluation: $id and $anchor and $ref":                                                                   Draft2020Group254,
+	"Draft2020/ref.json/order of evaluation: $id and $ref on nested schema":                                                              Draft2020Group255,
+	"Draft2020/ref.json/simple URN base URI with $ref via the URN":                                                                       Draft2020Group256,
+	"Draft2020/ref.json/simple URN base URI with JSON pointer":                                                                           Draft2020Group257,
+	"Draft2020/ref.json/URN base URI with NSS":                                                                                           Draft2020Group258,
+	"Draft2020/ref.json/URN base URI with r-component":                                                                                   Draft2020Group259,
+	"Draft2020/ref.json/URN base URI with q-component":                                                                                   Draft2020Group260,
+	"Draft2020/ref.json/URN base URI with URN and JSON pointer ref":                                                                      Draft2020Group261,
+	"Draft2020/ref.json/URN base URI with URN and anchor ref":                                                                            Draft2020Group262,
+	"Draft2020/ref.json/URN ref with nested pointer ref":                                                                                 Draft2020Group263,
+	"Draft2020/ref.json/ref to if":                                                                                                       Draft2020Group264,
+	"Draft2020/ref.json/ref to then":                                                                                                     Draft2020Group265,
+	"Draft2020/ref.json/ref to else":                                                                                                     Draft2020Group266,
+	"Draft2020/ref.json/ref with absolute-path-reference":                                                                                Draft2020Group267,
+	"Draft2020/ref.json/$id with file URI still resolves pointers - *nix":                                                                Draft2020Group268,
+	"Draft2020/ref.json/$id with file URI still resolves pointers - windows":                                                             Draft2020Group269,
+	"Draft2020/ref.json/empty tokens in $ref json-pointer":                                                                               Draft2020Group270,
+	"Draft2020/refRemote.json/remote ref":                                                                                                Draft2020Group271,
+	"Draft2020/refRemote.json/fragment within remote ref":                                                                                Draft2020Group272,
+	"Draft2020/refRemote.json/anchor within remote ref":                                                                                  Draft2020Group273,
+	"Draft2020/refRemote.json/ref within remote ref":                                                                                     Draft2020Group274,
+	"Draft2020/refRemote.json/base URI change":                                                                                           Draft2020Group275,
+	"Draft2020/refRemote.json/base URI change - change folder":                                                                           Draft2020Group276,
+	"Draft2020/refRemote.json/base URI change - change folder in subschema":                                                              Draft2020Group277,
+	"Draft2020/refRemote.json/root ref in remote ref":                                                                                    Draft2020Group278,
+	"Draft2020/refRemote.json/remote ref with ref to defs":                                                                               Draft2020Group279,
+	"Draft2020/refRemote.json/Location-independent identifier in remote ref":                                                             Draft2020Group280,
+	"Draft2020/refRemote.json/retrieved nested refs resolve relative to their URI not $id":                                               Draft2020Group281,
+	"Draft2020/refRemote.json/remote HTTP ref with different $id":                                                                        Draft2020Group282,
+	"Draft2020/refRemote.json/remote HTTP ref with different URN $id":                                                                    Draft2020Group283,
+	"Draft2020/refRemote.json/remote HTTP ref with nested absolute ref":                                                                  Draft2020Group284,
+	"Draft2020/refRemote.json/$ref to $ref finds detached $anchor":                                                                       Draft2020Group285,
+	"Draft2020/required.json/required validation":                                                                                        Draft2020Group286,
+	"Draft2020/required.json/required default validation":                                                                                Draft2020Group287,
+	"Draft2020/required.json/required with empty array":                                                                                  Draft2020Group288,
+	"Draft2020/required.json/required with escaped characters":                                                                           Draft2020Group289,
+	"Draft2020/required.json/required properties whose names are Javascript object property names":                                       Draft2020Group290,
+	"Draft2020/type.json/integer type matches integers":                                                                                  Draft2020Group291,
+	"Draft2020/type.json/number type matches numbers":                                                                                    Draft2020Group292,
+	"Draft2020/type.json/string type matches strings":                                                                                    Draft2020Group293,
+	"Draft2020/type.json/object type matches objects":                                                                                    Draft2020Group294,
+	"Draft2020/type.json/array type matches arrays":                                                                                      Draft2020Group295,
+	"Draft2020/type.json/boolean type matches booleans":                                                                                  Draft2020Group296,
+	"Draft2020/type.json/null type matches only the null object":                                                                         Draft2020Group297,
+	"Draft2020/type.json/multiple types can be specified in an array":                                                                    Draft2020Group298,
+	"Draft2020/type.json/type as array with one item":                                                                                    Draft2020Group299,
+	"Draft2020/type.json/type: array or object":                                                                                          Draft2020Group300,
+	"Draft2020/type.json/type: array, object or null":                                                                                    Draft2020Group301,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems true":                                                                              Draft2020Group302,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems false":                                                                             Draft2020Group303,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems as schema":                                                                         Draft2020Group304,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with uniform items":                                                                Draft2020Group305,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with tuple":                                                                        Draft2020Group306,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with items and prefixItems":                                                        Draft2020Group307,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with items":                                                                        Draft2020Group308,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with nested tuple":                                                                 Draft2020Group309,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with nested items":                                                                 Draft2020Group310,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with nested prefixItems and items":                                                 Draft2020Group311,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with nested unevaluatedItems":                                                      Draft2020Group312,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with anyOf":                                                                        Draft2020Group313,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with oneOf":                                                                        Draft2020Group314,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with not":                                                                          Draft2020Group315,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with if/then/else":                                                                 Draft2020Group316,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with boolean schemas":                                                              Draft2020Group317,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with $ref":                                                                         Draft2020Group318,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems before $ref":                                                                       Draft2020Group319,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems can't see inside cousins":                                                          Draft2020Group321,
+	"Draft2020/unevaluatedItems.json/item is evaluated in an uncle schema to unevaluatedItems":                                           Draft2020Group322,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems depends on adjacent contains":                                                      Draft2020Group323,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems depends on multiple nested contains":                                               Draft2020Group324,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems and contains interact to control item dependency relationship":                     Draft2020Group325,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with minContains = 0":                                                              Draft2020Group326,
+	"Draft2020/unevaluatedItems.json/non-array instances are valid":                                                                      Draft2020Group327,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems with null instance elements":                                                       Draft2020Group328,
+	"Draft2020/unevaluatedItems.json/unevaluatedItems can see annotations from if without then and else":                                 Draft2020Group329,
+	"Draft2020/unevaluatedItems.json/Evaluated items collection needs to consider instance location":                                     Draft2020Group330,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties true":                                                                    Draft2020Group331,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties schema":                                                                  Draft2020Group332,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties false":                                                                   Draft2020Group333,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with adjacent properties":                                                Draft2020Group334,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with adjacent patternProperties":                                         Draft2020Group335,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with adjacent bool additionalProperties":                                 Draft2020Group336,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with adjacent non-bool additionalProperties":                             Draft2020Group337,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with nested properties":                                                  Draft2020Group338,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with nested patternProperties":                                           Draft2020Group339,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with nested additionalProperties":                                        Draft2020Group340,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with nested unevaluatedProperties":                                       Draft2020Group341,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with anyOf":                                                              Draft2020Group342,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with oneOf":                                                              Draft2020Group343,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with not":                                                                Draft2020Group344,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with if/then/else":                                                       Draft2020Group345,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with if/then/else, then not defined":                                     Draft2020Group346,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with if/then/else, else not defined":                                     Draft2020Group347,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with dependentSchemas":                                                   Draft2020Group348,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with boolean schemas":                                                    Draft2020Group349,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with $ref":                                                               Draft2020Group350,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties before $ref":                                                             Draft2020Group351,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties can't see inside cousins":                                                Draft2020Group353,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties can't see inside cousins (reverse order)":                                Draft2020Group354,
+	"Draft2020/unevaluatedProperties.json/nested unevaluatedProperties, outer false, inner true, properties outside":                     Draft2020Group355,
+	"Draft2020/unevaluatedProperties.json/nested unevaluatedProperties, outer false, inner true, properties inside":                      Draft2020Group356,
+	"Draft2020/unevaluatedProperties.json/nested unevaluatedProperties, outer true, inner false, properties outside":                     Draft2020Group357,
+	"Draft2020/unevaluatedProperties.json/nested unevaluatedProperties, outer true, inner false, properties inside":                      Draft2020Group358,
+	"Draft2020/unevaluatedProperties.json/cousin unevaluatedProperties, true and false, true with properties":                            Draft2020Group359,
+	"Draft2020/unevaluatedProperties.json/cousin unevaluatedProperties, true and false, false with properties":                           Draft2020Group360,
+	"Draft2020/unevaluatedProperties.json/property is evaluated in an uncle schema to unevaluatedProperties":                             Draft2020Group361,
+	"Draft2020/unevaluatedProperties.json/in-place applicator siblings, allOf has unevaluated":                                           Draft2020Group362,
+	"Draft2020/unevaluatedProperties.json/in-place applicator siblings, anyOf has unevaluated":                                           Draft2020Group363,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties + single cyclic ref":                                                     Draft2020Group364,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties + ref inside allOf / oneOf":                                              Draft2020Group365,
+	"Draft2020/unevaluatedProperties.json/dynamic evalation inside nested refs":                                                          Draft2020Group366,
+	"Draft2020/unevaluatedProperties.json/non-object instances are valid":                                                                Draft2020Group367,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties with null valued instance properties":                                    Draft2020Group368,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties not affected by propertyNames":                                           Draft2020Group369,
+	"Draft2020/unevaluatedProperties.json/unevaluatedProperties can see annotations from if without then and else":                       Draft2020Group370,
+	"Draft2020/unevaluatedProperties.json/dependentSchemas with unevaluatedProperties":                                                   Draft2020Group371,
+	"Draft2020/unevaluatedProperties.json/Evaluated properties collection needs to consider instance location":                           Draft2020Group372,
+	"Draft2020/unevaluatedProperties.json/Evaluated properties collection needs to consider instance location with patternProperties":    Draft2020Group373,
+	"Draft2020/unevaluatedProperties.json/Evaluated properties collection needs to consider instance location with additionalProperties": Draft2020Group374,
+	"Draft2020/uniqueItems.json/uniqueItems validation":                                                                                  Draft2020Group375,
+	"Draft2020/uniqueItems.json/uniqueItems with an array of items":                                                                      Draft2020Group376,
+	"Draft2020/uniqueItems.json/uniqueItems with an array of items and additionalItems=false":                                            Draft2020Group377,
+	"Draft2020/uniqueItems.json/uniqueItems=false validation":                                                                            Draft2020Group378,
+	"Draft2020/uniqueItems.json/uniqueItems=false with an array of items":                                                                Draft2020Group379,
+	"Draft2020/uniqueItems.json/uniqueItems=false with an array of items and additionalItems=false":                                      Draft2020Group380,
+	"Draft2020/vocabulary.json/schema that uses custom metaschema with with no validation vocabulary":                                    Draft2020Group381,
+	"Draft2020/vocabulary.json/ignore unrecognized optional vocabulary":                                                                  Draft2020Group382,
+	"Draft7Format/date-time.json/validation of date-time strings":                                                                        Draft7FormatGroup0,
+	"Draft7Format/date.json/validation of date strings":                                                                                  Draft7FormatGroup1,
+	"Draft7Format/email.json/validation of e-mail addresses":                                                                             Draft7FormatGroup2,
+	"Draft7Format/hostname.json/validation of host names":                                                                                Draft7FormatGroup3,
+	"Draft7Format/hostname.json/validation of A-label (punycode) host names":                                                             Draft7FormatGroup4,
+	"Draft7Format/idn-email.json/validation of an internationalized e-mail addresses":                                                    Draft7FormatGroup5,
+	"Draft7Format/idn-hostname.json/validation of internationalized host names":                                                          Draft7FormatGroup6,
+	"Draft7Format/idn-hostname.json/validation of separators in internationalized host names":                                            Draft7FormatGroup7,
+	"Draft7Format/ipv4.json/validation of IP addresses":                                                                                  Draft7FormatGroup8,
+	"Draft7Format/ipv6.json/validation of IPv6 addresses":                                                                                Draft7FormatGroup9,
+	"Draft7Format/iri-reference.json/validation of IRI References":                                                                       Draft7FormatGroup10,
+	"Draft7Format/iri.json/validation of IRIs":                                                                                           Draft7FormatGroup11,
+	"Draft7Format/json-pointer.json/validation of JSON-pointers (JSON String Representation)":                                            Draft7FormatGroup12,
+	"Draft7Format/regex.json/validation of regular expressions":                                                                          Draft7FormatGroup13,
+	"Draft7Format/relative-json-pointer.json/validation of Relative JSON Pointers (RJP)":                                                 Draft7FormatGroup14,
+	"Draft7Format/time.json/validation of time strings":                                                                                  Draft7FormatGroup15,
+	"Draft7Format/unknown.json/unknown format":                                                                                           Draft7FormatGroup16,
+	"Draft7Format/uri-reference.json/validation of URI References":                                                                       Draft7FormatGroup17,
+	"Draft7Format/uri-template.json/format: uri-template":                                                                                Draft7FormatGroup18,
+	"Draft7Format/uri.json/validation of URIs":                                                                                           Draft7FormatGroup19,
+	"Draft2020Format/date-time.json/validation of date-time strings":                                                                     Draft2020FormatGroup0,
+	"Draft2020Format/date.json/validation of date strings":                                                                               Draft2020FormatGroup1,
+	"Draft2020Format/duration.json/validation of duration strings":                                                                       Draft2020FormatGroup2,
+	"Draft2020Format/ecmascript-regex.json/\\a is not an ECMA 262 control escape":                                                        Draft2020FormatGroup3,
+	"Draft2020Format/email.json/validation of e-mail addresses":                                                                          Draft2020FormatGroup4,
+	"Draft2020Format/hostname.json/validation of host names":                                                                             Draft2020FormatGroup5,
+	"Draft2020Format/hostname.json/validation of A-label (punycode) host names":                                                          Draft2020FormatGroup6,
+	"Draft2020Format/idn-email.json/validation of an internationalized e-mail addresses":                                                 Draft2020FormatGroup7,
+	"Draft2020Format/idn-hostname.json/validation of internationalized host names":                                                       Draft2020FormatGroup8,
+	"Draft2020Format/idn-hostname.json/validation of separators in internationalized host names":                                         Draft2020FormatGroup9,
+	"Draft2020Format/ipv4.json/validation of IP addresses":                                                                               Draft2020FormatGroup10,
+	"Draft2020Format/ipv6.json/validation of IPv6 addresses":                                                                             Draft2020FormatGroup11,
+	"Draft2020Format/iri-reference.json/validation of IRI References":                                                                    Draft2020FormatGroup12,
+	"Draft2020Format/iri.json/validation of IRIs":                                                                                        Draft2020FormatGroup13,
+	"Draft2020Format/json-pointer.json/validation of JSON-pointers (JSON String Representation)":                                         Draft2020FormatGroup14,
+	"Draft2020Format/regex.json/validation of regular expressions":                                                                       Draft2020FormatGroup15,
+	"Draft2020Format/relative-json-pointer.json/validation of Relative JSON Pointers (RJP)":                                              Draft2020FormatGroup16,
+	"Draft2020Format/time.json/validation of time strings":                                                                               Draft2020FormatGroup17,
+	"Draft2020Format/unknown.json/unknown format":                                                                                        Draft2020FormatGroup18,
+	"Draft2020Format/uri-reference.json/validation of URI References":                                                                    Draft2020FormatGroup19,
+	"Draft2020Format/uri-template.json/format: uri-template":                                                                             Draft2020FormatGroup20,
+	"Draft2020Format/uri.json/validation of URIs":                                                                                        Draft2020FormatGroup21,
+	"Draft2020Format/uuid.json/uuid format":                                                                                              Draft2020FormatGroup22,
+}
@@ -0,0 +1,131 @@
+// Package rigs declares the typed values the generated-validator differential
+// rig converts and validates. The types live in their own package so the
+// generated code refers to them through an import, as generated code for a
+// real caller's types usually does, and so the generator program can load
+// them without depending on the code it writes.
+//
+// Each type targets one branch of the typed conversion: scalars and
+// containers built field by field, omitempty and omitzero, a pointer cycle
+// through a named struct, marshaler methods and embedded structs that fall
+// back to encoding/json, and map key kinds. The constraint tags make fuzzed
+// values fail often, so the rig compares error trees as well as acceptance.
+package rigs
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// Plain exercises the scalar kinds and the containers converted inline.
+type Plain struct {
+	Name   string         `json:"name"   jsonschema:"minLength=2,maxLength=8,pattern=^[a-m]+$"`
+	Count  int            `json:"count"  jsonschema:"minimum=0,maximum=100"`
+	Small  int8           `json:"small"  jsonschema:"multipleOf=3"`
+	Wide   uint64         `json:"wide"   jsonschema:"exclusiveMaximum=1000000"`
+	Ratio  float64        `json:"ratio"  jsonschema:"exclusiveMinimum=-1,maximum=1"`
+	Single float32        `json:"single" jsonschema:"minimum=0"`
+	Active bool           `json:"active" jsonschema:"const=true"`
+	Tags   []string       `json:"tags"   jsonschema:"maxItems=2,uniqueItems=true"`
+	Attrs  map[string]int `json:"attrs"  jsonschema:"maxProperties=1"`
+	Grid   [3]int8        `json:"grid"`
+	Next   *Child         `json:"next"`
+}
+
+// Child is reached through a pointer from [Plain].
+type Child struct {
+	Deep *bool  `json:"deep"`
+	Mode string `json:"mode" jsonschema:"enum=debug|release"`
+	ID   uint32 `json:"id"   jsonschema:"maximum=10"`
+}
+
+// Omit carries omitempty and omitzero on every kind the omission test
+// distinguishes, including a struct whose IsZero method decides omitzero.
+type Omit struct {
+	Ptr   *int           `json:"ptr,omitempty"`
+	Map   map[string]int `json:"map,omitempty"`
+	Any   any            `json:"any,omitempty"`
+	Str   string         `json:"str,omitempty"   jsonschema:"minLength=3"`
+	Slice []int          `json:"slice,omitempty" jsonschema:"minItems=2"`
+	Zero  Child          `json:"zero,omitzero"`
+	Int   int            `json:"int,omitempty"   jsonschema:"minimum=5"`
+	Float float64        `json:"float,omitzero"  jsonschema:"maximum=0"`
+	Arr   [2]bool        `json:"arr,omitempty"`
+	Bool  bool           `json:"bool,omitempty"`
+}
+
+// OmitTime has an omitzero field whose type has an IsZero method, which
+// encoding/json consults, so the struct converts through encoding/json.
+type OmitTime struct {
+	When time.Time `json:"when,omitzero"`
+	Note string    `json:"note"`
+}
+
+// Tree is recursive through a named struct, so its conversion function
+// calls itself.
+type Tree struct {
+	Label    string  `json:"label"    jsonschema:"maxLength=4"`
+	Children []*Tree `json:"children" jsonschema:"maxItems=2"`
+}
+
+// Celsius marshals as text.
+type Celsius float64
+
+// MarshalText renders the temperature as a decimal string.
+func (c Celsius) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatFloat(float64(c), 'f', -1, 64)), nil
+}
+
+// Cents marshals itself through a pointer receiver, which encoding/json
+// calls only for an addressable value.
+type Cents int64
+
+// MarshalJSON renders the amount as a string with two decimals.
+func (c *Cents) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(strconv.FormatFloat(float64(*c)/100, 'f', 2, 64))), nil
+}
+
+// Marshalers holds values whose JSON form a method computes.
+type Marshalers struct {
+	Big   *big.Int        `json:"big"`
+	Raw   json.RawMessage `json:"raw"`
+	When  time.Time       `json:"when"`
+	Temp  Celsius         `json:"temp"`
+	Price Cents           `json:"price"`
+	Bytes []byte          `json:"bytes" jsonschema:"maxLength=8"`
+	Any   any             `json:"any"`
+}
+
+// Base is embedded by [Embedded].
+type Base struct {
+	Kind  string `json:"kind" jsonschema:"enum=a|b"`
+	Level int8   `json:"level"`
+}
+
+// Embedded promotes the fields of an embedded struct, so it converts
+// through encoding/json.
+type Embedded struct {
+	Base
+
+	Extra string `json:"extra" jsonschema:"maxLength=2"`
+}
+
+// Key is a map key type with a MarshalText method.
+type Key int
+
+// MarshalText renders the key in hexadecimal.
+func (k Key) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(k), 16)), nil
+}
+
+// Maps exercises the map key kinds and value kinds.
+type Maps struct {
+	Ints    map[int]string      `json:"ints"    jsonschema:"maxProperties=2"`
+	Uints   map[uint8]float32   `json:"uints"`
+	Keys    map[Key]bool        `json:"keys"`
+	Structs map[string]Child    `json:"structs"`
+	Ptrs    map[string]*Child   `json:"ptrs"`
+	Arrays  map[string][2]int   `json:"arrays"`
+	Nested  map[string][]string `json:"nested"`
+}
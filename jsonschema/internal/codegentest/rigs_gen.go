@@ -0,0 +1,3184 @@
+// Code generated by jsonschema.GenerateGo. DO NOT EDIT.
+
+package codegentest
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/genrt"
+	"go.jacobcolvin.com/x/jsonschema/internal/codegentest/rigs"
+)
+
+var (
+	rigPlainVar0  = []jsonschema.Segment{{Key: "properties"}, {Key: "active"}}
+	rigPlainVar1  = []jsonschema.Segment{{Key: "properties"}, {Key: "attrs"}}
+	rigPlainVar2  = []jsonschema.Segment{{Key: "properties"}, {Key: "count"}}
+	rigPlainVar3  = []jsonschema.Segment{{Key: "properties"}, {Key: "grid"}}
+	rigPlainVar4  = []jsonschema.Segment{{Key: "properties"}, {Key: "name"}}
+	rigPlainVar5  = []jsonschema.Segment{{Key: "properties"}, {Key: "next"}}
+	rigPlainVar6  = []jsonschema.Segment{{Key: "properties"}, {Key: "ratio"}}
+	rigPlainVar7  = []jsonschema.Segment{{Key: "properties"}, {Key: "single"}}
+	rigPlainVar8  = []jsonschema.Segment{{Key: "properties"}, {Key: "small"}}
+	rigPlainVar9  = []jsonschema.Segment{{Key: "properties"}, {Key: "tags"}}
+	rigPlainVar10 = []jsonschema.Segment{{Key: "properties"}, {Key: "wide"}}
+	rigPlainVar11 = []jsonschema.Segment{{Key: "additionalProperties"}}
+	rigPlainVar12 = genrt.NewConst(true)
+	rigPlainVar13 = genrt.NewNumeric(nil, genrt.Float(0.0), genrt.Float(100.0), nil, nil)
+	rigPlainVar14 = []jsonschema.Segment{{Key: "prefixItems"}, {Index: 0, IsIndex: true}}
+	rigPlainVar15 = []jsonschema.Segment{{Key: "prefixItems"}, {Index: 1, IsIndex: true}}
+	rigPlainVar16 = []jsonschema.Segment{{Key: "prefixItems"}, {Index: 2, IsIndex: true}}
+	rigPlainVar17 = genrt.NewNumeric(nil, genrt.Float(-128.0), genrt.Float(127.0), nil, nil)
+	rigPlainVar18 = genrt.NewNumeric(nil, genrt.Float(-128.0), genrt.Float(127.0), nil, nil)
+	rigPlainVar19 = genrt.NewNumeric(nil, genrt.Float(-128.0), genrt.Float(127.0), nil, nil)
+	rigPlainVar20 = genrt.Pattern("^[a-m]+$")
+	rigPlainVar21 = []jsonschema.Segment{{Key: "anyOf"}, {Index: 0, IsIndex: true}}
+	rigPlainVar22 = []jsonschema.Segment{{Key: "anyOf"}, {Index: 1, IsIndex: true}}
+	rigPlainVar23 = []jsonschema.Segment{{Key: "$ref"}}
+	rigPlainVar24 = []jsonschema.Segment{{Key: "properties"}, {Key: "deep"}}
+	rigPlainVar25 = []jsonschema.Segment{{Key: "properties"}, {Key: "id"}}
+	rigPlainVar26 = []jsonschema.Segment{{Key: "properties"}, {Key: "mode"}}
+	rigPlainVar27 = genrt.NewNumeric(nil, genrt.Float(0.0), genrt.Float(10.0), nil, nil)
+	rigPlainVar28 = genrt.NewEnum([]any{"debug", "release"})
+	rigPlainVar29 = genrt.NewNumeric(nil, nil, genrt.Float(1.0), genrt.Float(-1.0), nil)
+	rigPlainVar30 = genrt.NewNumeric(nil, genrt.Float(0.0), nil, nil, nil)
+	rigPlainVar31 = genrt.NewNumeric(genrt.Float(3.0), genrt.Float(-128.0), genrt.Float(127.0), nil, nil)
+	rigPlainVar32 = []jsonschema.Segment{{Key: "items"}}
+	rigPlainVar33 = genrt.NewNumeric(nil, genrt.Float(0.0), nil, nil, genrt.Float(1e+06))
+	rigPlainVar34 = []jsonschema.Segment{{Key: "properties"}, {Key: "any"}}
+	rigPlainVar35 = []jsonschema.Segment{{Key: "properties"}, {Key: "arr"}}
+	rigPlainVar36 = []jsonschema.Segment{{Key: "properties"}, {Key: "bool"}}
+	rigPlainVar37 = []jsonschema.Segment{{Key: "properties"}, {Key: "float"}}
+	rigPlainVar38 = []jsonschema.Segment{{Key: "properties"}, {Key: "int"}}
+	rigPlainVar39 = []jsonschema.Segment{{Key: "properties"}, {Key: "map"}}
+	rigPlainVar40 = []jsonschema.Segment{{Key: "properties"}, {Key: "ptr"}}
+	rigPlainVar41 = []jsonschema.Segment{{Key: "properties"}, {Key: "slice"}}
+	rigPlainVar42 = []jsonschema.Segment{{Key: "properties"}, {Key: "str"}}
+	rigPlainVar43 = []jsonschema.Segment{{Key: "properties"}, {Key: "zero"}}
+	rigPlainVar44 = genrt.NewNumeric(nil, nil, genrt.Float(0.0), nil, nil)
+	rigPlainVar45 = genrt.NewNumeric(nil, genrt.Float(5.0), nil, nil, nil)
+	rigPlainVar46 = genrt.NewNumeric(nil, genrt.Float(0.0), genrt.Float(10.0), nil, nil)
+	rigPlainVar47 = genrt.NewEnum([]any{"debug", "release"})
+	rigPlainVar48 = []jsonschema.Segment{{Key: "properties"}, {Key: "note"}}
+	rigPlainVar49 = []jsonschema.Segment{{Key: "properties"}, {Key: "when"}}
+	rigPlainVar50 = []jsonschema.Segment{{Key: "properties"}, {Key: "children"}}
+	rigPlainVar51 = []jsonschema.Segment{{Key: "properties"}, {Key: "label"}}
+	rigPlainVar52 = []jsonschema.Segment{{Key: "properties"}, {Key: "big"}}
+	rigPlainVar53 = []jsonschema.Segment{{Key: "properties"}, {Key: "bytes"}}
+	rigPlainVar54 = []jsonschema.Segment{{Key: "properties"}, {Key: "price"}}
+	rigPlainVar55 = []jsonschema.Segment{{Key: "properties"}, {Key: "raw"}}
+	rigPlainVar56 = []jsonschema.Segment{{Key: "properties"}, {Key: "temp"}}
+	rigPlainVar57 = genrt.NewNumeric(nil, genrt.Float(-9.223372036854776e+18), nil, nil, genrt.Float(9.223372036854776e+18))
+	rigPlainVar58 = genrt.NewNumeric(nil, genrt.Float(-9.223372036854776e+18), nil, nil, genrt.Float(9.223372036854776e+18))
+	rigPlainVar59 = genrt.Format("date-time")
+	rigPlainVar60 = []jsonschema.Segment{{Key: "properties"}, {Key: "extra"}}
+	rigPlainVar61 = []jsonschema.Segment{{Key: "properties"}, {Key: "kind"}}
+	rigPlainVar62 = []jsonschema.Segment{{Key: "properties"}, {Key: "level"}}
+	rigPlainVar63 = genrt.NewEnum([]any{"a", "b"})
+	rigPlainVar64 = genrt.NewNumeric(nil, genrt.Float(-128.0), genrt.Float(127.0), nil, nil)
+	rigPlainVar65 = []jsonschema.Segment{{Key: "properties"}, {Key: "arrays"}}
+	rigPlainVar66 = []jsonschema.Segment{{Key: "properties"}, {Key: "ints"}}
+	rigPlainVar67 = []jsonschema.Segment{{Key: "properties"}, {Key: "keys"}}
+	rigPlainVar68 = []jsonschema.Segment{{Key: "properties"}, {Key: "nested"}}
+	rigPlainVar69 = []jsonschema.Segment{{Key: "properties"}, {Key: "ptrs"}}
+	rigPlainVar70 = []jsonschema.Segment{{Key: "properties"}, {Key: "structs"}}
+	rigPlainVar71 = []jsonschema.Segment{{Key: "properties"}, {Key: "uints"}}
+	rigPlainVar72 = []jsonschema.Segment{{Key: "propertyNames"}}
+	rigPlainVar73 = genrt.Pattern("^-?[0-9]+$")
+	rigPlainVar74 = genrt.NewNumeric(nil, genrt.Float(0.0), genrt.Float(10.0), nil, nil)
+	rigPlainVar75 = genrt.NewEnum([]any{"debug", "release"})
+	rigPlainVar76 = genrt.Pattern("^[0-9]+$")
+)
+
+// RigPlain validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func RigPlain(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigPlainNode0(&st, x, nil, nil, nil))
+}
+
+// RigPlainValue validates *v against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.ValidateValue] reports for v.
+func RigPlainValue(v *rigs.Plain) error {
+	x, err := rigPlainValue(v)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigPlainNode0(&st, x, nil, nil, nil))
+}
+
+// rigPlainValue returns the JSON form of *v, as encoding/json marshals it.
+func rigPlainValue(v *rigs.Plain) (any, error) {
+	var x any
+
+	val1, err := rigPlainValueOfPlain(v, 0+1)
+	if err != nil {
+		return nil, err
+	}
+
+	x = val1
+	return x, nil
+}
+
+// rigPlainValueOfPlain returns the JSON form of *v, as encoding/json marshals it.
+func rigPlainValueOfPlain(v *rigs.Plain, depth int) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	if depth > 1000 {
+		return nil, genrt.CycleError(v)
+	}
+
+	obj := map[string]any{}
+	obj["name"] = genrt.String(string(v.Name))
+	obj["count"] = genrt.Int(int64(v.Count))
+	obj["small"] = genrt.Int(int64(v.Small))
+	obj["wide"] = genrt.Uint(uint64(v.Wide))
+	val2, err := genrt.Number(float64(v.Ratio), 64)
+	if err != nil {
+		return nil, err
+	}
+
+	obj["ratio"] = val2
+	val3, err := genrt.Number(float64(v.Single), 32)
+	if err != nil {
+		return nil, err
+	}
+
+	obj["single"] = val3
+	obj["active"] = bool(v.Active)
+	if v.Tags == nil {
+		obj["tags"] = nil
+	} else {
+		arr4 := make([]any, len(v.Tags))
+		for i5 := range v.Tags {
+			arr4[i5] = genrt.String(string(v.Tags[i5]))
+		}
+
+		obj["tags"] = arr4
+	}
+	if v.Attrs == nil {
+		obj["attrs"] = nil
+	} else if !genrt.ValidKeys(v.Attrs) {
+		val6, err := genrt.JSONValue(&v.Attrs)
+		if err != nil {
+			return nil, err
+		}
+
+		obj["attrs"] = val6
+	} else {
+		obj7 := make(map[string]any, len(v.Attrs))
+		for k8, v9 := range v.Attrs {
+			obj7[string(k8)] = genrt.Int(int64(v9))
+		}
+
+		obj["attrs"] = obj7
+	}
+	arr10 := make([]any, len(v.Grid))
+	for i11 := range v.Grid {
+		arr10[i11] = genrt.Int(int64(v.Grid[i11]))
+	}
+
+	obj["grid"] = arr10
+	val12, err := rigPlainValueOfChild(v.Next, depth+1)
+	if err != nil {
+		return nil, err
+	}
+
+	obj["next"] = val12
+	return obj, nil
+}
+
+// rigPlainValueOfChild returns the JSON form of *v, as encoding/json marshals it.
+func rigPlainValueOfChild(v *rigs.Child, depth int) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	if depth > 1000 {
+		return nil, genrt.CycleError(v)
+	}
+
+	obj := map[string]any{}
+	if v.Deep == nil {
+		obj["deep"] = nil
+	} else {
+		obj["deep"] = bool((*v.Deep))
+	}
+	obj["mode"] = genrt.String(string(v.Mode))
+	obj["id"] = genrt.Uint(uint64(v.ID))
+	return obj, nil
+}
+
+// rigPlainNode0 validates against the schema at #.
+func rigPlainNode0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["active"]; ok {
+			ann.RecordProperty("active")
+			errs = append(errs, rigPlainNode1(st, v, ip.Key("active"), sp.Append(rigPlainVar0), nil)...)
+		}
+		if v, ok := obj["attrs"]; ok {
+			ann.RecordProperty("attrs")
+			errs = append(errs, rigPlainNode2(st, v, ip.Key("attrs"), sp.Append(rigPlainVar1), nil)...)
+		}
+		if v, ok := obj["count"]; ok {
+			ann.RecordProperty("count")
+			errs = append(errs, rigPlainNode4(st, v, ip.Key("count"), sp.Append(rigPlainVar2), nil)...)
+		}
+		if v, ok := obj["grid"]; ok {
+			ann.RecordProperty("grid")
+			errs = append(errs, rigPlainNode5(st, v, ip.Key("grid"), sp.Append(rigPlainVar3), nil)...)
+		}
+		if v, ok := obj["name"]; ok {
+			ann.RecordProperty("name")
+			errs = append(errs, rigPlainNode9(st, v, ip.Key("name"), sp.Append(rigPlainVar4), nil)...)
+		}
+		if v, ok := obj["next"]; ok {
+			ann.RecordProperty("next")
+			errs = append(errs, rigPlainNode10(st, v, ip.Key("next"), sp.Append(rigPlainVar5), nil)...)
+		}
+		if v, ok := obj["ratio"]; ok {
+			ann.RecordProperty("ratio")
+			errs = append(errs, rigPlainNode19(st, v, ip.Key("ratio"), sp.Append(rigPlainVar6), nil)...)
+		}
+		if v, ok := obj["single"]; ok {
+			ann.RecordProperty("single")
+			errs = append(errs, rigPlainNode20(st, v, ip.Key("single"), sp.Append(rigPlainVar7), nil)...)
+		}
+		if v, ok := obj["small"]; ok {
+			ann.RecordProperty("small")
+			errs = append(errs, rigPlainNode21(st, v, ip.Key("small"), sp.Append(rigPlainVar8), nil)...)
+		}
+		if v, ok := obj["tags"]; ok {
+			ann.RecordProperty("tags")
+			errs = append(errs, rigPlainNode22(st, v, ip.Key("tags"), sp.Append(rigPlainVar9), nil)...)
+		}
+		if v, ok := obj["wide"]; ok {
+			ann.RecordProperty("wide")
+			errs = append(errs, rigPlainNode24(st, v, ip.Key("wide"), sp.Append(rigPlainVar10), nil)...)
+		}
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			switch k {
+			case "active", "attrs", "count", "grid", "name", "next", "ratio", "single", "small", "tags", "wide":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["name"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"name\""))
+		}
+		if _, ok := obj["count"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"count\""))
+		}
+		if _, ok := obj["small"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"small\""))
+		}
+		if _, ok := obj["wide"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"wide\""))
+		}
+		if _, ok := obj["ratio"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"ratio\""))
+		}
+		if _, ok := obj["single"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"single\""))
+		}
+		if _, ok := obj["active"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"active\""))
+		}
+		if _, ok := obj["tags"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"tags\""))
+		}
+		if _, ok := obj["attrs"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"attrs\""))
+		}
+		if _, ok := obj["grid"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"grid\""))
+		}
+		if _, ok := obj["next"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"next\""))
+		}
+	}
+
+	return errs
+}
+
+// rigPlainNode1 validates against the schema at #/properties/active.
+func rigPlainNode1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	if !rigPlainVar12.Equal(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "const", "value does not match const"))
+	}
+
+	return errs
+}
+
+// rigPlainNode2 validates against the schema at #/properties/attrs.
+func rigPlainNode2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"object\"], got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, rigPlainNode3(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) > 1 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxProperties", fmt.Sprintf("object has %d properties, maximum is %d", len(obj), 1)))
+		}
+	}
+
+	return errs
+}
+
+// rigPlainNode3 validates against the schema at #/properties/attrs/additionalProperties.
+func rigPlainNode3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigPlainNode4 validates against the schema at #/properties/count.
+func rigPlainNode4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar13.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigPlainNode5 validates against the schema at #/properties/grid.
+func rigPlainNode5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, rigPlainNode6(st, arr[0], ip.Index(0), sp.Append(rigPlainVar14), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, rigPlainNode7(st, arr[1], ip.Index(1), sp.Append(rigPlainVar15), nil)...)
+		}
+		if len(arr) > 2 {
+			errs = append(errs, rigPlainNode8(st, arr[2], ip.Index(2), sp.Append(rigPlainVar16), nil)...)
+		}
+		ann.ExtendItems(min(3, len(arr)))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 3 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 3)))
+		}
+		if len(arr) > 3 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxItems", fmt.Sprintf("array has %d items, maximum is %d", len(arr), 3)))
+		}
+	}
+
+	return errs
+}
+
+// rigPlainNode6 validates against the schema at #/properties/grid/prefixItems/0.
+func rigPlainNode6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar17.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigPlainNode7 validates against the schema at #/properties/grid/prefixItems/1.
+func rigPlainNode7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar18.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigPlainNode8 validates against the schema at #/properties/grid/prefixItems/2.
+func rigPlainNode8(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar19.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigPlainNode9 validates against the schema at #/properties/name.
+func rigPlainNode9(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minLength", fmt.Sprintf("string length %d is less than %d", n, 2)))
+		}
+		if n > 8 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 8)))
+		}
+		if !rigPlainVar20.MatchString(s) {
+			errs = append(errs, genrt.Leaf(ip, sp, "pattern", "string does not match pattern \"^[a-m]+$\""))
+		}
+	}
+
+	return errs
+}
+
+// rigPlainNode10 validates against the schema at #/properties/next.
+func rigPlainNode10(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := rigPlainNode11(st, x, ip, sp.Append(rigPlainVar21), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := rigPlainNode18(st, x, ip, sp.Append(rigPlainVar22), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// rigPlainNode11 validates against the schema at #/properties/next/anyOf/0.
+func rigPlainNode11(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := rigPlainNode12(st, x, ip, sp.Append(rigPlainVar23), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// rigPlainNode12 validates against the schema at #/properties/next/anyOf/0/$ref.
+func rigPlainNode12(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["deep"]; ok {
+			ann.RecordProperty("deep")
+			errs = append(errs, rigPlainNode13(st, v, ip.Key("deep"), sp.Append(rigPlainVar24), nil)...)
+		}
+		if v, ok := obj["id"]; ok {
+			ann.RecordProperty("id")
+			errs = append(errs, rigPlainNode16(st, v, ip.Key("id"), sp.Append(rigPlainVar25), nil)...)
+		}
+		if v, ok := obj["mode"]; ok {
+			ann.RecordProperty("mode")
+			errs = append(errs, rigPlainNode17(st, v, ip.Key("mode"), sp.Append(rigPlainVar26), nil)...)
+		}
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			switch k {
+			case "deep", "id", "mode":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["deep"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"deep\""))
+		}
+		if _, ok := obj["mode"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"mode\""))
+		}
+		if _, ok := obj["id"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"id\""))
+		}
+	}
+
+	return errs
+}
+
+// rigPlainNode13 validates against the schema at #/properties/next/anyOf/0/$ref/properties/deep.
+func rigPlainNode13(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann4 := ann.Child()
+	if ce := rigPlainNode14(st, x, ip, sp.Append(rigPlainVar21), ann4); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann4)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann5 := ann.Child()
+	if ce := rigPlainNode15(st, x, ip, sp.Append(rigPlainVar22), ann5); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann5)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// rigPlainNode14 validates against the schema at #/properties/next/anyOf/0/$ref/properties/deep/anyOf/0.
+func rigPlainNode14(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigPlainNode15 validates against the schema at #/properties/next/anyOf/0/$ref/properties/deep/anyOf/1.
+func rigPlainNode15(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigPlainNode16 validates against the schema at #/properties/next/anyOf/0/$ref/properties/id.
+func rigPlainNode16(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar27.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigPlainNode17 validates against the schema at #/properties/next/anyOf/0/$ref/properties/mode.
+func rigPlainNode17(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if !rigPlainVar28.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// rigPlainNode18 validates against the schema at #/properties/next/anyOf/1.
+func rigPlainNode18(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigPlainNode19 validates against the schema at #/properties/ratio.
+func rigPlainNode19(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar29.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigPlainNode20 validates against the schema at #/properties/single.
+func rigPlainNode20(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar30.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigPlainNode21 validates against the schema at #/properties/small.
+func rigPlainNode21(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar31.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigPlainNode22 validates against the schema at #/properties/tags.
+func rigPlainNode22(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"array\"], got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp6 := sp.Append(rigPlainVar32)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, rigPlainNode23(st, arr[i], ip.Index(i), rsp6, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxItems", fmt.Sprintf("array has %d items, maximum is %d", len(arr), 2)))
+		}
+		if genrt.HasDuplicates(arr) {
+			errs = append(errs, genrt.Leaf(ip, sp, "uniqueItems", "array contains duplicate items"))
+		}
+	}
+
+	return errs
+}
+
+// rigPlainNode23 validates against the schema at #/properties/tags/items.
+func rigPlainNode23(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigPlainNode24 validates against the schema at #/properties/wide.
+func rigPlainNode24(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar33.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// RigOmit validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func RigOmit(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigOmitNode0(&st, x, nil, nil, nil))
+}
+
+// RigOmitValue validates *v against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.ValidateValue] reports for v.
+func RigOmitValue(v *rigs.Omit) error {
+	x, err := rigOmitValue(v)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigOmitNode0(&st, x, nil, nil, nil))
+}
+
+// rigOmitValue returns the JSON form of *v, as encoding/json marshals it.
+func rigOmitValue(v *rigs.Omit) (any, error) {
+	var x any
+
+	val1, err := rigPlainValueOfOmit(v, 0+1)
+	if err != nil {
+		return nil, err
+	}
+
+	x = val1
+	return x, nil
+}
+
+// rigPlainValueOfOmit returns the JSON form of *v, as encoding/json marshals it.
+func rigPlainValueOfOmit(v *rigs.Omit, depth int) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	if depth > 1000 {
+		return nil, genrt.CycleError(v)
+	}
+
+	obj := map[string]any{}
+	if !(v.Ptr == nil) {
+		if v.Ptr == nil {
+			obj["ptr"] = nil
+		} else {
+			obj["ptr"] = genrt.Int(int64((*v.Ptr)))
+		}
+	}
+	if !(len(v.Map) == 0) {
+		if v.Map == nil {
+			obj["map"] = nil
+		} else if !genrt.ValidKeys(v.Map) {
+			val2, err := genrt.JSONValue(&v.Map)
+			if err != nil {
+				return nil, err
+			}
+
+			obj["map"] = val2
+		} else {
+			obj3 := make(map[string]any, len(v.Map))
+			for k4, v5 := range v.Map {
+				obj3[string(k4)] = genrt.Int(int64(v5))
+			}
+
+			obj["map"] = obj3
+		}
+	}
+	if !(v.Any == nil) {
+		val6, err := genrt.JSONValue(v.Any)
+		if err != nil {
+			return nil, err
+		}
+
+		obj["any"] = val6
+	}
+	if !(len(v.Str) == 0) {
+		obj["str"] = genrt.String(string(v.Str))
+	}
+	if !(len(v.Slice) == 0) {
+		if v.Slice == nil {
+			obj["slice"] = nil
+		} else {
+			arr7 := make([]any, len(v.Slice))
+			for i8 := range v.Slice {
+				arr7[i8] = genrt.Int(int64(v.Slice[i8]))
+			}
+
+			obj["slice"] = arr7
+		}
+	}
+	if !(genrt.IsZero(&v.Zero)) {
+		val9, err := rigPlainValueOfChild(&v.Zero, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		obj["zero"] = val9
+	}
+	if !(v.Int == 0) {
+		obj["int"] = genrt.Int(int64(v.Int))
+	}
+	if !(genrt.IsZero(&v.Float)) {
+		val10, err := genrt.Number(float64(v.Float), 64)
+		if err != nil {
+			return nil, err
+		}
+
+		obj["float"] = val10
+	}
+	if !(len(v.Arr) == 0) {
+		arr11 := make([]any, len(v.Arr))
+		for i12 := range v.Arr {
+			arr11[i12] = bool(v.Arr[i12])
+		}
+
+		obj["arr"] = arr11
+	}
+	if !(!v.Bool) {
+		obj["bool"] = bool(v.Bool)
+	}
+	return obj, nil
+}
+
+// rigOmitNode0 validates against the schema at #.
+func rigOmitNode0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if _, ok := obj["any"]; ok {
+			ann.RecordProperty("any")
+		}
+		if v, ok := obj["arr"]; ok {
+			ann.RecordProperty("arr")
+			errs = append(errs, rigOmitNode1(st, v, ip.Key("arr"), sp.Append(rigPlainVar35), nil)...)
+		}
+		if v, ok := obj["bool"]; ok {
+			ann.RecordProperty("bool")
+			errs = append(errs, rigOmitNode4(st, v, ip.Key("bool"), sp.Append(rigPlainVar36), nil)...)
+		}
+		if v, ok := obj["float"]; ok {
+			ann.RecordProperty("float")
+			errs = append(errs, rigOmitNode5(st, v, ip.Key("float"), sp.Append(rigPlainVar37), nil)...)
+		}
+		if v, ok := obj["int"]; ok {
+			ann.RecordProperty("int")
+			errs = append(errs, rigOmitNode6(st, v, ip.Key("int"), sp.Append(rigPlainVar38), nil)...)
+		}
+		if v, ok := obj["map"]; ok {
+			ann.RecordProperty("map")
+			errs = append(errs, rigOmitNode7(st, v, ip.Key("map"), sp.Append(rigPlainVar39), nil)...)
+		}
+		if v, ok := obj["ptr"]; ok {
+			ann.RecordProperty("ptr")
+			errs = append(errs, rigOmitNode9(st, v, ip.Key("ptr"), sp.Append(rigPlainVar40), nil)...)
+		}
+		if v, ok := obj["slice"]; ok {
+			ann.RecordProperty("slice")
+			errs = append(errs, rigOmitNode12(st, v, ip.Key("slice"), sp.Append(rigPlainVar41), nil)...)
+		}
+		if v, ok := obj["str"]; ok {
+			ann.RecordProperty("str")
+			errs = append(errs, rigOmitNode14(st, v, ip.Key("str"), sp.Append(rigPlainVar42), nil)...)
+		}
+		if v, ok := obj["zero"]; ok {
+			ann.RecordProperty("zero")
+			errs = append(errs, rigOmitNode15(st, v, ip.Key("zero"), sp.Append(rigPlainVar43), nil)...)
+		}
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			switch k {
+			case "any", "arr", "bool", "float", "int", "map", "ptr", "slice", "str", "zero":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// rigOmitNode1 validates against the schema at #/properties/arr.
+func rigOmitNode1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, rigOmitNode2(st, arr[0], ip.Index(0), sp.Append(rigPlainVar14), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, rigOmitNode3(st, arr[1], ip.Index(1), sp.Append(rigPlainVar15), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 2)))
+		}
+		if len(arr) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxItems", fmt.Sprintf("array has %d items, maximum is %d", len(arr), 2)))
+		}
+	}
+
+	return errs
+}
+
+// rigOmitNode2 validates against the schema at #/properties/arr/prefixItems/0.
+func rigOmitNode2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigOmitNode3 validates against the schema at #/properties/arr/prefixItems/1.
+func rigOmitNode3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigOmitNode4 validates against the schema at #/properties/bool.
+func rigOmitNode4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigOmitNode5 validates against the schema at #/properties/float.
+func rigOmitNode5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar44.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigOmitNode6 validates against the schema at #/properties/int.
+func rigOmitNode6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar45.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigOmitNode7 validates against the schema at #/properties/map.
+func rigOmitNode7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"object\"], got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, rigOmitNode8(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// rigOmitNode8 validates against the schema at #/properties/map/additionalProperties.
+func rigOmitNode8(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigOmitNode9 validates against the schema at #/properties/ptr.
+func rigOmitNode9(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := rigOmitNode10(st, x, ip, sp.Append(rigPlainVar21), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := rigOmitNode11(st, x, ip, sp.Append(rigPlainVar22), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// rigOmitNode10 validates against the schema at #/properties/ptr/anyOf/0.
+func rigOmitNode10(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigOmitNode11 validates against the schema at #/properties/ptr/anyOf/1.
+func rigOmitNode11(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigOmitNode12 validates against the schema at #/properties/slice.
+func rigOmitNode12(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"array\"], got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp3 := sp.Append(rigPlainVar32)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, rigOmitNode13(st, arr[i], ip.Index(i), rsp3, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 2)))
+		}
+	}
+
+	return errs
+}
+
+// rigOmitNode13 validates against the schema at #/properties/slice/items.
+func rigOmitNode13(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigOmitNode14 validates against the schema at #/properties/str.
+func rigOmitNode14(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n < 3 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minLength", fmt.Sprintf("string length %d is less than %d", n, 3)))
+		}
+	}
+
+	return errs
+}
+
+// rigOmitNode15 validates against the schema at #/properties/zero.
+func rigOmitNode15(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := rigOmitNode16(st, x, ip, sp.Append(rigPlainVar23), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// rigOmitNode16 validates against the schema at #/properties/zero/$ref.
+func rigOmitNode16(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["deep"]; ok {
+			ann.RecordProperty("deep")
+			errs = append(errs, rigOmitNode17(st, v, ip.Key("deep"), sp.Append(rigPlainVar24), nil)...)
+		}
+		if v, ok := obj["id"]; ok {
+			ann.RecordProperty("id")
+			errs = append(errs, rigOmitNode20(st, v, ip.Key("id"), sp.Append(rigPlainVar25), nil)...)
+		}
+		if v, ok := obj["mode"]; ok {
+			ann.RecordProperty("mode")
+			errs = append(errs, rigOmitNode21(st, v, ip.Key("mode"), sp.Append(rigPlainVar26), nil)...)
+		}
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			switch k {
+			case "deep", "id", "mode":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["deep"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"deep\""))
+		}
+		if _, ok := obj["mode"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"mode\""))
+		}
+		if _, ok := obj["id"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"id\""))
+		}
+	}
+
+	return errs
+}
+
+// rigOmitNode17 validates against the schema at #/properties/zero/$ref/properties/deep.
+func rigOmitNode17(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann5 := ann.Child()
+	if ce := rigOmitNode18(st, x, ip, sp.Append(rigPlainVar21), ann5); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann5)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann6 := ann.Child()
+	if ce := rigOmitNode19(st, x, ip, sp.Append(rigPlainVar22), ann6); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann6)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// rigOmitNode18 validates against the schema at #/properties/zero/$ref/properties/deep/anyOf/0.
+func rigOmitNode18(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigOmitNode19 validates against the schema at #/properties/zero/$ref/properties/deep/anyOf/1.
+func rigOmitNode19(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigOmitNode20 validates against the schema at #/properties/zero/$ref/properties/id.
+func rigOmitNode20(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar46.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigOmitNode21 validates against the schema at #/properties/zero/$ref/properties/mode.
+func rigOmitNode21(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if !rigPlainVar47.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// RigOmitTime validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func RigOmitTime(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigOmitTimeNode0(&st, x, nil, nil, nil))
+}
+
+// RigOmitTimeValue validates *v against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.ValidateValue] reports for v.
+func RigOmitTimeValue(v *rigs.OmitTime) error {
+	x, err := rigOmitTimeValue(v)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigOmitTimeNode0(&st, x, nil, nil, nil))
+}
+
+// rigOmitTimeValue returns the JSON form of *v, as encoding/json marshals it.
+func rigOmitTimeValue(v *rigs.OmitTime) (any, error) {
+	var x any
+
+	if v == nil {
+		x = nil
+	} else {
+		val1, err := genrt.JSONValue(&(*v))
+		if err != nil {
+			return nil, err
+		}
+
+		x = val1
+	}
+	return x, nil
+}
+
+// rigOmitTimeNode0 validates against the schema at #.
+func rigOmitTimeNode0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["note"]; ok {
+			ann.RecordProperty("note")
+			errs = append(errs, rigOmitTimeNode1(st, v, ip.Key("note"), sp.Append(rigPlainVar48), nil)...)
+		}
+		if v, ok := obj["when"]; ok {
+			ann.RecordProperty("when")
+			errs = append(errs, rigOmitTimeNode2(st, v, ip.Key("when"), sp.Append(rigPlainVar49), nil)...)
+		}
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			switch k {
+			case "note", "when":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["note"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"note\""))
+		}
+	}
+
+	return errs
+}
+
+// rigOmitTimeNode1 validates against the schema at #/properties/note.
+func rigOmitTimeNode1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigOmitTimeNode2 validates against the schema at #/properties/when.
+func rigOmitTimeNode2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := rigOmitTimeNode3(st, x, ip, sp.Append(rigPlainVar23), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// rigOmitTimeNode3 validates against the schema at #/properties/when/$ref.
+func rigOmitTimeNode3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// RigTree validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func RigTree(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigTreeNode0(&st, x, nil, nil, nil))
+}
+
+// RigTreeValue validates *v against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.ValidateValue] reports for v.
+func RigTreeValue(v *rigs.Tree) error {
+	x, err := rigTreeValue(v)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigTreeNode0(&st, x, nil, nil, nil))
+}
+
+// rigTreeValue returns the JSON form of *v, as encoding/json marshals it.
+func rigTreeValue(v *rigs.Tree) (any, error) {
+	var x any
+
+	val1, err := rigPlainValueOfTree(v, 0+1)
+	if err != nil {
+		return nil, err
+	}
+
+	x = val1
+	return x, nil
+}
+
+// rigPlainValueOfTree returns the JSON form of *v, as encoding/json marshals it.
+func rigPlainValueOfTree(v *rigs.Tree, depth int) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	if depth > 1000 {
+		return nil, genrt.CycleError(v)
+	}
+
+	obj := map[string]any{}
+	obj["label"] = genrt.String(string(v.Label))
+	if v.Children == nil {
+		obj["children"] = nil
+	} else {
+		arr2 := make([]any, len(v.Children))
+		for i3 := range v.Children {
+			val4, err := rigPlainValueOfTree(v.Children[i3], depth+1)
+			if err != nil {
+				return nil, err
+			}
+
+			arr2[i3] = val4
+		}
+
+		obj["children"] = arr2
+	}
+	return obj, nil
+}
+
+// rigTreeNode0 validates against the schema at #.
+func rigTreeNode0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann1 := ann.Child()
+	if ce := rigTreeNode1(st, x, ip, sp.Append(rigPlainVar23), ann1); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann1)
+	}
+
+	return errs
+}
+
+// rigTreeNode1 validates against the schema at #/$ref.
+func rigTreeNode1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["children"]; ok {
+			ann.RecordProperty("children")
+			errs = append(errs, rigTreeNode2(st, v, ip.Key("children"), sp.Append(rigPlainVar50), nil)...)
+		}
+		if v, ok := obj["label"]; ok {
+			ann.RecordProperty("label")
+			errs = append(errs, rigTreeNode6(st, v, ip.Key("label"), sp.Append(rigPlainVar51), nil)...)
+		}
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			switch k {
+			case "children", "label":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["label"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"label\""))
+		}
+		if _, ok := obj["children"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"children\""))
+		}
+	}
+
+	return errs
+}
+
+// rigTreeNode2 validates against the schema at #/$ref/properties/children.
+func rigTreeNode2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"array\"], got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp2 := sp.Append(rigPlainVar32)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, rigTreeNode3(st, arr[i], ip.Index(i), rsp2, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxItems", fmt.Sprintf("array has %d items, maximum is %d", len(arr), 2)))
+		}
+	}
+
+	return errs
+}
+
+// rigTreeNode3 validates against the schema at #/$ref/properties/children/items.
+func rigTreeNode3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann3 := ann.Child()
+	if ce := rigTreeNode4(st, x, ip, sp.Append(rigPlainVar21), ann3); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann3)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann4 := ann.Child()
+	if ce := rigTreeNode5(st, x, ip, sp.Append(rigPlainVar22), ann4); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann4)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// rigTreeNode4 validates against the schema at #/$ref/properties/children/items/anyOf/0.
+func rigTreeNode4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann5 := ann.Child()
+	if ce := rigTreeNode1(st, x, ip, sp.Append(rigPlainVar23), ann5); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann5)
+	}
+
+	return errs
+}
+
+// rigTreeNode5 validates against the schema at #/$ref/properties/children/items/anyOf/1.
+func rigTreeNode5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigTreeNode6 validates against the schema at #/$ref/properties/label.
+func rigTreeNode6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 4 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 4)))
+		}
+	}
+
+	return errs
+}
+
+// RigMarshalers validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func RigMarshalers(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigMarshalersNode0(&st, x, nil, nil, nil))
+}
+
+// RigMarshalersValue validates *v against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.ValidateValue] reports for v.
+func RigMarshalersValue(v *rigs.Marshalers) error {
+	x, err := rigMarshalersValue(v)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigMarshalersNode0(&st, x, nil, nil, nil))
+}
+
+// rigMarshalersValue returns the JSON form of *v, as encoding/json marshals it.
+func rigMarshalersValue(v *rigs.Marshalers) (any, error) {
+	var x any
+
+	val1, err := rigPlainValueOfMarshalers(v, 0+1)
+	if err != nil {
+		return nil, err
+	}
+
+	x = val1
+	return x, nil
+}
+
+// rigPlainValueOfMarshalers returns the JSON form of *v, as encoding/json marshals it.
+func rigPlainValueOfMarshalers(v *rigs.Marshalers, depth int) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	if depth > 1000 {
+		return nil, genrt.CycleError(v)
+	}
+
+	obj := map[string]any{}
+	val2, err := genrt.JSONValue(&v.Big)
+	if err != nil {
+		return nil, err
+	}
+
+	obj["big"] = val2
+	val3, err := genrt.JSONValue(&v.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	obj["raw"] = val3
+	val4, err := genrt.JSONValue(&v.When)
+	if err != nil {
+		return nil, err
+	}
+
+	obj["when"] = val4
+	val5, err := genrt.JSONValue(&v.Temp)
+	if err != nil {
+		return nil, err
+	}
+
+	obj["temp"] = val5
+	val6, err := genrt.JSONValue(&v.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	obj["price"] = val6
+	val7, err := genrt.JSONValue(&v.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	obj["bytes"] = val7
+	val8, err := genrt.JSONValue(v.Any)
+	if err != nil {
+		return nil, err
+	}
+
+	obj["any"] = val8
+	return obj, nil
+}
+
+// rigMarshalersNode0 validates against the schema at #.
+func rigMarshalersNode0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if _, ok := obj["any"]; ok {
+			ann.RecordProperty("any")
+		}
+		if v, ok := obj["big"]; ok {
+			ann.RecordProperty("big")
+			errs = append(errs, rigMarshalersNode1(st, v, ip.Key("big"), sp.Append(rigPlainVar52), nil)...)
+		}
+		if v, ok := obj["bytes"]; ok {
+			ann.RecordProperty("bytes")
+			errs = append(errs, rigMarshalersNode5(st, v, ip.Key("bytes"), sp.Append(rigPlainVar53), nil)...)
+		}
+		if v, ok := obj["price"]; ok {
+			ann.RecordProperty("price")
+			errs = append(errs, rigMarshalersNode6(st, v, ip.Key("price"), sp.Append(rigPlainVar54), nil)...)
+		}
+		if _, ok := obj["raw"]; ok {
+			ann.RecordProperty("raw")
+		}
+		if v, ok := obj["temp"]; ok {
+			ann.RecordProperty("temp")
+			errs = append(errs, rigMarshalersNode7(st, v, ip.Key("temp"), sp.Append(rigPlainVar56), nil)...)
+		}
+		if v, ok := obj["when"]; ok {
+			ann.RecordProperty("when")
+			errs = append(errs, rigMarshalersNode8(st, v, ip.Key("when"), sp.Append(rigPlainVar49), nil)...)
+		}
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			switch k {
+			case "any", "big", "bytes", "price", "raw", "temp", "when":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["big"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"big\""))
+		}
+		if _, ok := obj["raw"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"raw\""))
+		}
+		if _, ok := obj["when"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"when\""))
+		}
+		if _, ok := obj["temp"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"temp\""))
+		}
+		if _, ok := obj["price"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"price\""))
+		}
+		if _, ok := obj["bytes"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bytes\""))
+		}
+		if _, ok := obj["any"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"any\""))
+		}
+	}
+
+	return errs
+}
+
+// rigMarshalersNode1 validates against the schema at #/properties/big.
+func rigMarshalersNode1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := rigMarshalersNode2(st, x, ip, sp.Append(rigPlainVar21), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := rigMarshalersNode4(st, x, ip, sp.Append(rigPlainVar22), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// rigMarshalersNode2 validates against the schema at #/properties/big/anyOf/0.
+func rigMarshalersNode2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := rigMarshalersNode3(st, x, ip, sp.Append(rigPlainVar23), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// rigMarshalersNode3 validates against the schema at #/properties/big/anyOf/0/$ref.
+func rigMarshalersNode3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMarshalersNode4 validates against the schema at #/properties/big/anyOf/1.
+func rigMarshalersNode4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMarshalersNode5 validates against the schema at #/properties/bytes.
+func rigMarshalersNode5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"string\"], got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 8 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 8)))
+		}
+	}
+
+	return errs
+}
+
+// rigMarshalersNode6 validates against the schema at #/properties/price.
+func rigMarshalersNode6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar57.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigMarshalersNode7 validates against the schema at #/properties/temp.
+func rigMarshalersNode7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMarshalersNode8 validates against the schema at #/properties/when.
+func rigMarshalersNode8(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := rigMarshalersNode9(st, x, ip, sp.Append(rigPlainVar23), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// rigMarshalersNode9 validates against the schema at #/properties/when/$ref.
+func rigMarshalersNode9(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// RigMarshalersFormats validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func RigMarshalersFormats(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigMarshalersFormatsNode0(&st, x, nil, nil, nil))
+}
+
+// RigMarshalersFormatsValue validates *v against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.ValidateValue] reports for v.
+func RigMarshalersFormatsValue(v *rigs.Marshalers) error {
+	x, err := rigMarshalersFormatsValue(v)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigMarshalersFormatsNode0(&st, x, nil, nil, nil))
+}
+
+// rigMarshalersFormatsValue returns the JSON form of *v, as encoding/json marshals it.
+func rigMarshalersFormatsValue(v *rigs.Marshalers) (any, error) {
+	var x any
+
+	val1, err := rigPlainValueOfMarshalers(v, 0+1)
+	if err != nil {
+		return nil, err
+	}
+
+	x = val1
+	return x, nil
+}
+
+// rigMarshalersFormatsNode0 validates against the schema at #.
+func rigMarshalersFormatsNode0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if _, ok := obj["any"]; ok {
+			ann.RecordProperty("any")
+		}
+		if v, ok := obj["big"]; ok {
+			ann.RecordProperty("big")
+			errs = append(errs, rigMarshalersFormatsNode1(st, v, ip.Key("big"), sp.Append(rigPlainVar52), nil)...)
+		}
+		if v, ok := obj["bytes"]; ok {
+			ann.RecordProperty("bytes")
+			errs = append(errs, rigMarshalersFormatsNode5(st, v, ip.Key("bytes"), sp.Append(rigPlainVar53), nil)...)
+		}
+		if v, ok := obj["price"]; ok {
+			ann.RecordProperty("price")
+			errs = append(errs, rigMarshalersFormatsNode6(st, v, ip.Key("price"), sp.Append(rigPlainVar54), nil)...)
+		}
+		if _, ok := obj["raw"]; ok {
+			ann.RecordProperty("raw")
+		}
+		if v, ok := obj["temp"]; ok {
+			ann.RecordProperty("temp")
+			errs = append(errs, rigMarshalersFormatsNode7(st, v, ip.Key("temp"), sp.Append(rigPlainVar56), nil)...)
+		}
+		if v, ok := obj["when"]; ok {
+			ann.RecordProperty("when")
+			errs = append(errs, rigMarshalersFormatsNode8(st, v, ip.Key("when"), sp.Append(rigPlainVar49), nil)...)
+		}
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			switch k {
+			case "any", "big", "bytes", "price", "raw", "temp", "when":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["big"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"big\""))
+		}
+		if _, ok := obj["raw"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"raw\""))
+		}
+		if _, ok := obj["when"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"when\""))
+		}
+		if _, ok := obj["temp"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"temp\""))
+		}
+		if _, ok := obj["price"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"price\""))
+		}
+		if _, ok := obj["bytes"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"bytes\""))
+		}
+		if _, ok := obj["any"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"any\""))
+		}
+	}
+
+	return errs
+}
+
+// rigMarshalersFormatsNode1 validates against the schema at #/properties/big.
+func rigMarshalersFormatsNode1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann1 := ann.Child()
+	if ce := rigMarshalersFormatsNode2(st, x, ip, sp.Append(rigPlainVar21), ann1); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann1)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann2 := ann.Child()
+	if ce := rigMarshalersFormatsNode4(st, x, ip, sp.Append(rigPlainVar22), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// rigMarshalersFormatsNode2 validates against the schema at #/properties/big/anyOf/0.
+func rigMarshalersFormatsNode2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann3 := ann.Child()
+	if ce := rigMarshalersFormatsNode3(st, x, ip, sp.Append(rigPlainVar23), ann3); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann3)
+	}
+
+	return errs
+}
+
+// rigMarshalersFormatsNode3 validates against the schema at #/properties/big/anyOf/0/$ref.
+func rigMarshalersFormatsNode3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMarshalersFormatsNode4 validates against the schema at #/properties/big/anyOf/1.
+func rigMarshalersFormatsNode4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMarshalersFormatsNode5 validates against the schema at #/properties/bytes.
+func rigMarshalersFormatsNode5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"string\"], got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 8 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 8)))
+		}
+	}
+
+	return errs
+}
+
+// rigMarshalersFormatsNode6 validates against the schema at #/properties/price.
+func rigMarshalersFormatsNode6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar58.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigMarshalersFormatsNode7 validates against the schema at #/properties/temp.
+func rigMarshalersFormatsNode7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMarshalersFormatsNode8 validates against the schema at #/properties/when.
+func rigMarshalersFormatsNode8(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := rigMarshalersFormatsNode9(st, x, ip, sp.Append(rigPlainVar23), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// rigMarshalersFormatsNode9 validates against the schema at #/properties/when/$ref.
+func rigMarshalersFormatsNode9(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		if err := rigPlainVar59(s); err != nil {
+			errs = append(errs, genrt.FormatError(ip, sp, "date-time", err))
+		}
+	}
+
+	return errs
+}
+
+// RigEmbedded validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func RigEmbedded(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigEmbeddedNode0(&st, x, nil, nil, nil))
+}
+
+// RigEmbeddedValue validates *v against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.ValidateValue] reports for v.
+func RigEmbeddedValue(v *rigs.Embedded) error {
+	x, err := rigEmbeddedValue(v)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigEmbeddedNode0(&st, x, nil, nil, nil))
+}
+
+// rigEmbeddedValue returns the JSON form of *v, as encoding/json marshals it.
+func rigEmbeddedValue(v *rigs.Embedded) (any, error) {
+	var x any
+
+	if v == nil {
+		x = nil
+	} else {
+		val1, err := genrt.JSONValue(&(*v))
+		if err != nil {
+			return nil, err
+		}
+
+		x = val1
+	}
+	return x, nil
+}
+
+// rigEmbeddedNode0 validates against the schema at #.
+func rigEmbeddedNode0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["extra"]; ok {
+			ann.RecordProperty("extra")
+			errs = append(errs, rigEmbeddedNode1(st, v, ip.Key("extra"), sp.Append(rigPlainVar60), nil)...)
+		}
+		if v, ok := obj["kind"]; ok {
+			ann.RecordProperty("kind")
+			errs = append(errs, rigEmbeddedNode2(st, v, ip.Key("kind"), sp.Append(rigPlainVar61), nil)...)
+		}
+		if v, ok := obj["level"]; ok {
+			ann.RecordProperty("level")
+			errs = append(errs, rigEmbeddedNode3(st, v, ip.Key("level"), sp.Append(rigPlainVar62), nil)...)
+		}
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			switch k {
+			case "extra", "kind", "level":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["kind"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"kind\""))
+		}
+		if _, ok := obj["level"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"level\""))
+		}
+		if _, ok := obj["extra"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"extra\""))
+		}
+	}
+
+	return errs
+}
+
+// rigEmbeddedNode1 validates against the schema at #/properties/extra.
+func rigEmbeddedNode1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		n := utf8.RuneCountInString(s)
+		if n > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxLength", fmt.Sprintf("string length %d is greater than %d", n, 2)))
+		}
+	}
+
+	return errs
+}
+
+// rigEmbeddedNode2 validates against the schema at #/properties/kind.
+func rigEmbeddedNode2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if !rigPlainVar63.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// rigEmbeddedNode3 validates against the schema at #/properties/level.
+func rigEmbeddedNode3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar64.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// RigMaps validates instance against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.Validate] reports.
+func RigMaps(instance any) error {
+	x, err := genrt.Normalize(instance)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigMapsNode0(&st, x, nil, nil, nil))
+}
+
+// RigMapsValue validates *v against the schema it was generated from, reporting
+// the errors [jsonschema.Validator.ValidateValue] reports for v.
+func RigMapsValue(v *rigs.Maps) error {
+	x, err := rigMapsValue(v)
+	if err != nil {
+		return err
+	}
+
+	var st genrt.State
+
+	return genrt.Result(rigMapsNode0(&st, x, nil, nil, nil))
+}
+
+// rigMapsValue returns the JSON form of *v, as encoding/json marshals it.
+func rigMapsValue(v *rigs.Maps) (any, error) {
+	var x any
+
+	val1, err := rigPlainValueOfMaps(v, 0+1)
+	if err != nil {
+		return nil, err
+	}
+
+	x = val1
+	return x, nil
+}
+
+// rigPlainValueOfMaps returns the JSON form of *v, as encoding/json marshals it.
+func rigPlainValueOfMaps(v *rigs.Maps, depth int) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	if depth > 1000 {
+		return nil, genrt.CycleError(v)
+	}
+
+	obj := map[string]any{}
+	if v.Ints == nil {
+		obj["ints"] = nil
+	} else {
+		obj2 := make(map[string]any, len(v.Ints))
+		for k3, v4 := range v.Ints {
+			obj2[strconv.FormatInt(int64(k3), 10)] = genrt.String(string(v4))
+		}
+
+		obj["ints"] = obj2
+	}
+	if v.Uints == nil {
+		obj["uints"] = nil
+	} else {
+		obj5 := make(map[string]any, len(v.Uints))
+		for k6, v7 := range v.Uints {
+			val8, err := genrt.Number(float64(v7), 32)
+			if err != nil {
+				return nil, err
+			}
+
+			obj5[strconv.FormatUint(uint64(k6), 10)] = val8
+		}
+
+		obj["uints"] = obj5
+	}
+	val9, err := genrt.JSONValue(&v.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	obj["keys"] = val9
+	if v.Structs == nil {
+		obj["structs"] = nil
+	} else if !genrt.ValidKeys(v.Structs) {
+		val10, err := genrt.JSONValue(&v.Structs)
+		if err != nil {
+			return nil, err
+		}
+
+		obj["structs"] = val10
+	} else {
+		obj11 := make(map[string]any, len(v.Structs))
+		for k12, v13 := range v.Structs {
+			val14, err := genrt.JSONValue(v13)
+			if err != nil {
+				return nil, err
+			}
+
+			obj11[string(k12)] = val14
+		}
+
+		obj["structs"] = obj11
+	}
+	if v.Ptrs == nil {
+		obj["ptrs"] = nil
+	} else if !genrt.ValidKeys(v.Ptrs) {
+		val15, err := genrt.JSONValue(&v.Ptrs)
+		if err != nil {
+			return nil, err
+		}
+
+		obj["ptrs"] = val15
+	} else {
+		obj16 := make(map[string]any, len(v.Ptrs))
+		for k17, v18 := range v.Ptrs {
+			val19, err := rigPlainValueOfChild(v18, depth+1)
+			if err != nil {
+				return nil, err
+			}
+
+			obj16[string(k17)] = val19
+		}
+
+		obj["ptrs"] = obj16
+	}
+	if v.Arrays == nil {
+		obj["arrays"] = nil
+	} else if !genrt.ValidKeys(v.Arrays) {
+		val20, err := genrt.JSONValue(&v.Arrays)
+		if err != nil {
+			return nil, err
+		}
+
+		obj["arrays"] = val20
+	} else {
+		obj21 := make(map[string]any, len(v.Arrays))
+		for k22, v23 := range v.Arrays {
+			val24, err := genrt.JSONValue(v23)
+			if err != nil {
+				return nil, err
+			}
+
+			obj21[string(k22)] = val24
+		}
+
+		obj["arrays"] = obj21
+	}
+	if v.Nested == nil {
+		obj["nested"] = nil
+	} else if !genrt.ValidKeys(v.Nested) {
+		val25, err := genrt.JSONValue(&v.Nested)
+		if err != nil {
+			return nil, err
+		}
+
+		obj["nested"] = val25
+	} else {
+		obj26 := make(map[string]any, len(v.Nested))
+		for k27, v28 := range v.Nested {
+			if v28 == nil {
+				obj26[string(k27)] = nil
+			} else {
+				arr29 := make([]any, len(v28))
+				for i30 := range v28 {
+					arr29[i30] = genrt.String(string(v28[i30]))
+				}
+
+				obj26[string(k27)] = arr29
+			}
+		}
+
+		obj["nested"] = obj26
+	}
+	return obj, nil
+}
+
+// rigMapsNode0 validates against the schema at #.
+func rigMapsNode0(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["arrays"]; ok {
+			ann.RecordProperty("arrays")
+			errs = append(errs, rigMapsNode1(st, v, ip.Key("arrays"), sp.Append(rigPlainVar65), nil)...)
+		}
+		if v, ok := obj["ints"]; ok {
+			ann.RecordProperty("ints")
+			errs = append(errs, rigMapsNode5(st, v, ip.Key("ints"), sp.Append(rigPlainVar66), nil)...)
+		}
+		if v, ok := obj["keys"]; ok {
+			ann.RecordProperty("keys")
+			errs = append(errs, rigMapsNode8(st, v, ip.Key("keys"), sp.Append(rigPlainVar67), nil)...)
+		}
+		if v, ok := obj["nested"]; ok {
+			ann.RecordProperty("nested")
+			errs = append(errs, rigMapsNode10(st, v, ip.Key("nested"), sp.Append(rigPlainVar68), nil)...)
+		}
+		if v, ok := obj["ptrs"]; ok {
+			ann.RecordProperty("ptrs")
+			errs = append(errs, rigMapsNode13(st, v, ip.Key("ptrs"), sp.Append(rigPlainVar69), nil)...)
+		}
+		if v, ok := obj["structs"]; ok {
+			ann.RecordProperty("structs")
+			errs = append(errs, rigMapsNode23(st, v, ip.Key("structs"), sp.Append(rigPlainVar70), nil)...)
+		}
+		if v, ok := obj["uints"]; ok {
+			ann.RecordProperty("uints")
+			errs = append(errs, rigMapsNode25(st, v, ip.Key("uints"), sp.Append(rigPlainVar71), nil)...)
+		}
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			switch k {
+			case "arrays", "ints", "keys", "nested", "ptrs", "structs", "uints":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["ints"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"ints\""))
+		}
+		if _, ok := obj["uints"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"uints\""))
+		}
+		if _, ok := obj["keys"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"keys\""))
+		}
+		if _, ok := obj["structs"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"structs\""))
+		}
+		if _, ok := obj["ptrs"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"ptrs\""))
+		}
+		if _, ok := obj["arrays"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"arrays\""))
+		}
+		if _, ok := obj["nested"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"nested\""))
+		}
+	}
+
+	return errs
+}
+
+// rigMapsNode1 validates against the schema at #/properties/arrays.
+func rigMapsNode1(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"object\"], got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, rigMapsNode2(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// rigMapsNode2 validates against the schema at #/properties/arrays/additionalProperties.
+func rigMapsNode2(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"array\", got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) > 0 {
+			errs = append(errs, rigMapsNode3(st, arr[0], ip.Index(0), sp.Append(rigPlainVar14), nil)...)
+		}
+		if len(arr) > 1 {
+			errs = append(errs, rigMapsNode4(st, arr[1], ip.Index(1), sp.Append(rigPlainVar15), nil)...)
+		}
+		ann.ExtendItems(min(2, len(arr)))
+	}
+
+	if arr, ok := x.([]any); ok {
+		if len(arr) < 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "minItems", fmt.Sprintf("array has %d items, minimum is %d", len(arr), 2)))
+		}
+		if len(arr) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxItems", fmt.Sprintf("array has %d items, maximum is %d", len(arr), 2)))
+		}
+	}
+
+	return errs
+}
+
+// rigMapsNode3 validates against the schema at #/properties/arrays/additionalProperties/prefixItems/0.
+func rigMapsNode3(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMapsNode4 validates against the schema at #/properties/arrays/additionalProperties/prefixItems/1.
+func rigMapsNode4(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMapsNode5 validates against the schema at #/properties/ints.
+func rigMapsNode5(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"object\"], got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, rigMapsNode6(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+		nsp := sp.Append(rigPlainVar72)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := rigMapsNode7(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if len(obj) > 2 {
+			errs = append(errs, genrt.Leaf(ip, sp, "maxProperties", fmt.Sprintf("object has %d properties, maximum is %d", len(obj), 2)))
+		}
+	}
+
+	return errs
+}
+
+// rigMapsNode6 validates against the schema at #/properties/ints/additionalProperties.
+func rigMapsNode6(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMapsNode7 validates against the schema at #/properties/ints/propertyNames.
+func rigMapsNode7(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		if !rigPlainVar73.MatchString(s) {
+			errs = append(errs, genrt.Leaf(ip, sp, "pattern", "string does not match pattern \"^-?[0-9]+$\""))
+		}
+	}
+
+	return errs
+}
+
+// rigMapsNode8 validates against the schema at #/properties/keys.
+func rigMapsNode8(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"object\"], got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, rigMapsNode9(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// rigMapsNode9 validates against the schema at #/properties/keys/additionalProperties.
+func rigMapsNode9(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMapsNode10 validates against the schema at #/properties/nested.
+func rigMapsNode10(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"object\"], got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, rigMapsNode11(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// rigMapsNode11 validates against the schema at #/properties/nested/additionalProperties.
+func rigMapsNode11(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsArray(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"array\"], got %q", genrt.TypeName(x))))
+	}
+
+	if arr, ok := x.([]any); ok {
+		rsp1 := sp.Append(rigPlainVar32)
+		for i := 0; i < len(arr); i++ {
+			errs = append(errs, rigMapsNode12(st, arr[i], ip.Index(i), rsp1, nil)...)
+		}
+		ann.SetAllItems()
+	}
+
+	return errs
+}
+
+// rigMapsNode12 validates against the schema at #/properties/nested/additionalProperties/items.
+func rigMapsNode12(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMapsNode13 validates against the schema at #/properties/ptrs.
+func rigMapsNode13(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"object\"], got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, rigMapsNode14(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// rigMapsNode14 validates against the schema at #/properties/ptrs/additionalProperties.
+func rigMapsNode14(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann2 := ann.Child()
+	if ce := rigMapsNode15(st, x, ip, sp.Append(rigPlainVar21), ann2); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann2)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann3 := ann.Child()
+	if ce := rigMapsNode22(st, x, ip, sp.Append(rigPlainVar22), ann3); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann3)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// rigMapsNode15 validates against the schema at #/properties/ptrs/additionalProperties/anyOf/0.
+func rigMapsNode15(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann4 := ann.Child()
+	if ce := rigMapsNode16(st, x, ip, sp.Append(rigPlainVar23), ann4); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann4)
+	}
+
+	return errs
+}
+
+// rigMapsNode16 validates against the schema at #/properties/ptrs/additionalProperties/anyOf/0/$ref.
+func rigMapsNode16(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"object\", got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		if v, ok := obj["deep"]; ok {
+			ann.RecordProperty("deep")
+			errs = append(errs, rigMapsNode17(st, v, ip.Key("deep"), sp.Append(rigPlainVar24), nil)...)
+		}
+		if v, ok := obj["id"]; ok {
+			ann.RecordProperty("id")
+			errs = append(errs, rigMapsNode20(st, v, ip.Key("id"), sp.Append(rigPlainVar25), nil)...)
+		}
+		if v, ok := obj["mode"]; ok {
+			ann.RecordProperty("mode")
+			errs = append(errs, rigMapsNode21(st, v, ip.Key("mode"), sp.Append(rigPlainVar26), nil)...)
+		}
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			switch k {
+			case "deep", "id", "mode":
+				continue
+			}
+
+			ann.RecordProperty(k)
+			errs = append(errs, genrt.False(ip.Key(k), asp, "additionalProperties")...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		if _, ok := obj["deep"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"deep\""))
+		}
+		if _, ok := obj["mode"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"mode\""))
+		}
+		if _, ok := obj["id"]; !ok {
+			errs = append(errs, genrt.Leaf(ip, sp, "required", "missing required property \"id\""))
+		}
+	}
+
+	return errs
+}
+
+// rigMapsNode17 validates against the schema at #/properties/ptrs/additionalProperties/anyOf/0/$ref/properties/deep.
+func rigMapsNode17(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	anyOfMatched := false
+
+	var anyOfErrs []*jsonschema.ValidationError
+	ann5 := ann.Child()
+	if ce := rigMapsNode18(st, x, ip, sp.Append(rigPlainVar21), ann5); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann5)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	ann6 := ann.Child()
+	if ce := rigMapsNode19(st, x, ip, sp.Append(rigPlainVar22), ann6); len(ce) == 0 {
+		anyOfMatched = true
+
+		ann.Merge(ann6)
+	} else {
+		anyOfErrs = append(anyOfErrs, ce...)
+	}
+	if !anyOfMatched {
+		errs = append(errs, genrt.Wrap(ip, sp, "anyOf", "did not validate against any subschema", anyOfErrs))
+	}
+
+	return errs
+}
+
+// rigMapsNode18 validates against the schema at #/properties/ptrs/additionalProperties/anyOf/0/$ref/properties/deep/anyOf/0.
+func rigMapsNode18(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsBoolean(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"boolean\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMapsNode19 validates against the schema at #/properties/ptrs/additionalProperties/anyOf/0/$ref/properties/deep/anyOf/1.
+func rigMapsNode19(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMapsNode20 validates against the schema at #/properties/ptrs/additionalProperties/anyOf/0/$ref/properties/id.
+func rigMapsNode20(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsInteger(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"integer\", got %q", genrt.TypeName(x))))
+	}
+
+	errs = rigPlainVar74.Check(errs, x, ip, sp)
+
+	return errs
+}
+
+// rigMapsNode21 validates against the schema at #/properties/ptrs/additionalProperties/anyOf/0/$ref/properties/mode.
+func rigMapsNode21(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if !rigPlainVar75.Contains(x) {
+		errs = append(errs, genrt.Leaf(ip, sp, "enum", "value does not match any enum member"))
+	}
+
+	return errs
+}
+
+// rigMapsNode22 validates against the schema at #/properties/ptrs/additionalProperties/anyOf/1.
+func rigMapsNode22(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"null\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMapsNode23 validates against the schema at #/properties/structs.
+func rigMapsNode23(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"object\"], got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, rigMapsNode24(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+	}
+
+	return errs
+}
+
+// rigMapsNode24 validates against the schema at #/properties/structs/additionalProperties.
+func rigMapsNode24(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	ann7 := ann.Child()
+	if ce := rigMapsNode16(st, x, ip, sp.Append(rigPlainVar23), ann7); len(ce) > 0 {
+		errs = append(errs, genrt.Wrap(ip, sp, "$ref", "", ce))
+	} else {
+		ann.Merge(ann7)
+	}
+
+	return errs
+}
+
+// rigMapsNode25 validates against the schema at #/properties/uints.
+func rigMapsNode25(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(x == nil || genrt.IsObject(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected [\"null\", \"object\"], got %q", genrt.TypeName(x))))
+	}
+
+	if obj, ok := x.(map[string]any); ok {
+		keys := genrt.SortedKeys(obj)
+
+		asp := sp.Append(rigPlainVar11)
+		for _, k := range keys {
+			ann.RecordProperty(k)
+			errs = append(errs, rigMapsNode26(st, obj[k], ip.Key(k), asp, nil)...)
+		}
+
+		ann.SetAllProperties()
+		nsp := sp.Append(rigPlainVar72)
+		for _, k := range keys {
+			cip := ip.Key(k)
+			if ce := rigMapsNode27(st, k, cip, nsp, nil); len(ce) > 0 {
+				errs = append(errs, genrt.NewError(cip, nsp, "propertyNames", fmt.Sprintf("property name %q is invalid", k), ce))
+			}
+		}
+	}
+
+	return errs
+}
+
+// rigMapsNode26 validates against the schema at #/properties/uints/additionalProperties.
+func rigMapsNode26(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsNumber(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"number\", got %q", genrt.TypeName(x))))
+	}
+
+	return errs
+}
+
+// rigMapsNode27 validates against the schema at #/properties/uints/propertyNames.
+func rigMapsNode27(st *genrt.State, x any, ip, sp *genrt.Path, ann *genrt.Annotations) []*jsonschema.ValidationError {
+	var errs []*jsonschema.ValidationError
+
+	if !(genrt.IsString(x)) {
+		errs = append(errs, genrt.Leaf(ip, sp, "type", fmt.Sprintf("expected \"string\", got %q", genrt.TypeName(x))))
+	}
+
+	if s, ok := x.(string); ok {
+		if !rigPlainVar76.MatchString(s) {
+			errs = append(errs, genrt.Leaf(ip, sp, "pattern", "string does not match pattern \"^[0-9]+$\""))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,394 @@
+// Package spec defines what the generated-validator differential rig
+// generates: one function per JSON Schema Test Suite group and one typed
+// function per rig type, each compiled with the options the runtime side of
+// the comparison uses. The generator program writes the files [Files]
+// returns, and the rig's freshness test regenerates them to catch a stale
+// checkout, so both read the same definition.
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/codegentest/rigs"
+)
+
+// Package and ImportPath identify the package the generated files belong to.
+const (
+	Package    = "codegentest"
+	ImportPath = "go.jacobcolvin.com/x/jsonschema/internal/codegentest"
+)
+
+// Set is one generated file's worth of suite groups: the suite files of one
+// directory, validated with the same options.
+type Set struct {
+	// Name is the file's base name and the prefix of its function names.
+	Name string
+	// Dir is the suite directory, relative to the testdata root.
+	Dir string
+	// SchemaURI is the $schema injected into a group schema without one.
+	SchemaURI string
+	// Opts returns the options for the suite file named file.
+	Opts func(file string) []jsonschema.ValidateOption
+}
+
+// Sets lists the suite directories the rig covers.
+var Sets = []Set{
+	{
+		Name:      "Draft7",
+		Dir:       "suite/draft7",
+		SchemaURI: "http://json-schema.org/draft-07/schema#",
+		Opts:      topLevelOpts,
+	},
+	{
+		Name:      "Draft2020",
+		Dir:       "suite/draft2020-12",
+		SchemaURI: "https://json-schema.org/draft/2020-12/schema",
+		Opts:      topLevelOpts,
+	},
+	{
+		Name:      "Draft7Format",
+		Dir:       "suite/draft7/optional/format",
+		SchemaURI: "http://json-schema.org/draft-07/schema#",
+		Opts:      formatOpts,
+	},
+	{
+		Name:      "Draft2020Format",
+		Dir:       "suite/draft2020-12/optional/format",
+		SchemaURI: "https://json-schema.org/draft/2020-12/schema",
+		Opts:      formatOpts,
+	},
+}
+
+// topLevelOpts mirrors TestSuite: format.json checks that format is an
+// annotation only.
+func topLevelOpts(file string) []jsonschema.ValidateOption {
+	if file == "format.json" {
+		return []jsonschema.ValidateOption{jsonschema.WithFormats(false)}
+	}
+
+	return nil
+}
+
+// formatOpts mirrors TestSuiteFormat, which asserts format.
+func formatOpts(string) []jsonschema.ValidateOption {
+	return []jsonschema.ValidateOption{jsonschema.WithFormats(true)}
+}
+
+// Group is one suite test group.
+type Group struct {
+	// Key identifies the group as set/file/description.
+	Key string
+	// Func is the name of the function generated for the group.
+	Func   string
+	Schema *jsonschema.Schema
+	Opts   []jsonschema.ValidateOption
+	Tests  []Test
+}
+
+// Test is one suite test case.
+type Test struct {
+	Description string          `json:"description"`
+	Data        json.RawMessage `json:"data"`
+	Valid       bool            `json:"valid"`
+}
+
+// Suite is the loaded suite and the remote schemas its refs reach.
+type Suite struct {
+	remotes     map[string]*jsonschema.Schema
+	metaSchemas jsonschema.SchemaMap
+	root        string
+}
+
+// Load loads the remote schemas and metaschemas under the testdata
+// directory root.
+func Load(root string) (*Suite, error) {
+	s := &Suite{
+		root:        root,
+		remotes:     map[string]*jsonschema.Schema{},
+		metaSchemas: jsonschema.SchemaMap{},
+	}
+
+	remotesDir := filepath.Join(root, "suite", "remotes")
+
+	err := walkSchemas(remotesDir, func(path string, sch *jsonschema.Schema) error {
+		rel, err := filepath.Rel(remotesDir, path)
+		if err != nil {
+			return fmt.Errorf("relativize %s: %w", path, err)
+		}
+
+		s.remotes["http://localhost:1234/"+filepath.ToSlash(rel)] = sch
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = walkSchemas(filepath.Join(root, "metaschemas"), func(_ string, sch *jsonschema.Schema) error {
+		if sch.ID != "" {
+			s.remotes[sch.ID] = sch
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sch := range s.remotes {
+		if len(sch.Vocabulary) > 0 {
+			s.metaSchemas[sch.ID] = sch
+		}
+	}
+
+	return s, nil
+}
+
+func walkSchemas(dir string, fn func(path string, s *jsonschema.Schema) error) error {
+	return filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error { //nolint:wrapcheck // Walk errors carry the path.
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".json") {
+			return err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", path, err)
+		}
+
+		var s jsonschema.Schema
+
+		err = json.Unmarshal(data, &s)
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", path, err)
+		}
+
+		return fn(path, &s)
+	})
+}
+
+// ResolveRef resolves the suite's remote refs.
+func (s *Suite) ResolveRef(_ context.Context, uri string) (*jsonschema.Schema, error) {
+	if sch, ok := s.remotes[uri]; ok {
+		return sch, nil
+	}
+
+	if sch, ok := s.remotes[uri+"#"]; ok {
+		return sch, nil
+	}
+
+	return nil, jsonschema.ErrNotResolved
+}
+
+// baseOpts returns the options every suite group compiles with.
+func (s *Suite) baseOpts() []jsonschema.ValidateOption {
+	return []jsonschema.ValidateOption{
+		jsonschema.WithRefResolver(s),
+		jsonschema.WithMetaSchemaResolver(s.metaSchemas),
+	}
+}
+
+// Groups returns the groups of set in file and group order.
+func (s *Suite) Groups(set Set) ([]Group, error) {
+	files, err := filepath.Glob(filepath.Join(s.root, set.Dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("glob %s: %w", set.Dir, err)
+	}
+
+	slices.Sort(files)
+
+	var out []Group
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", file, err)
+		}
+
+		var raw []struct {
+			Description string          `json:"description"`
+			Schema      json.RawMessage `json:"schema"`
+			Tests       []Test          `json:"tests"`
+		}
+
+		err = json.Unmarshal(data, &raw)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", file, err)
+		}
+
+		base := filepath.Base(file)
+
+		for _, g := range raw {
+			schema, err := suiteSchema(g.Schema, set.SchemaURI)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", file, g.Description, err)
+			}
+
+			out = append(out, Group{
+				Key:    set.Name + "/" + base + "/" + g.Description,
+				Func:   fmt.Sprintf("%sGroup%d", set.Name, len(out)),
+				Schema: schema,
+				Opts:   append(s.baseOpts(), set.Opts(base)...),
+				Tests:  g.Tests,
+			})
+		}
+	}
+
+	return out, nil
+}
+
+// suiteSchema decodes a group schema as the suite runner does, injecting
+// schemaURI when the schema declares no $schema.
+func suiteSchema(raw json.RawMessage, schemaURI string) (*jsonschema.Schema, error) {
+	switch strings.TrimSpace(string(raw)) {
+	case "true":
+		return &jsonschema.Schema{Schema: schemaURI}, nil
+	case "false":
+		return &jsonschema.Schema{Schema: schemaURI, Not: &jsonschema.Schema{}}, nil
+	}
+
+	var s jsonschema.Schema
+
+	err := json.Unmarshal(raw, &s)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // The caller adds the group.
+	}
+
+	if s.Schema == "" {
+		s.Schema = schemaURI
+	}
+
+	return &s, nil
+}
+
+// Rig is one typed differential target.
+type Rig struct {
+	Type reflect.Type
+	// Name is the generated function's name; the typed entry point is
+	// Name+"Value".
+	Name string
+	Opts []jsonschema.ValidateOption
+}
+
+// Rigs lists the typed targets.
+var Rigs = []Rig{
+	{Name: "RigPlain", Type: reflect.TypeFor[rigs.Plain]()},
+	{Name: "RigOmit", Type: reflect.TypeFor[rigs.Omit]()},
+	{Name: "RigOmitTime", Type: reflect.TypeFor[rigs.OmitTime]()},
+	{Name: "RigTree", Type: reflect.TypeFor[rigs.Tree]()},
+	{Name: "RigMarshalers", Type: reflect.TypeFor[rigs.Marshalers]()},
+	{
+		Name: "RigMarshalersFormats",
+		Type: reflect.TypeFor[rigs.Marshalers](),
+		Opts: []jsonschema.ValidateOption{jsonschema.WithFormats(true)},
+	},
+	{Name: "RigEmbedded", Type: reflect.TypeFor[rigs.Embedded]()},
+	{Name: "RigMaps", Type: reflect.TypeFor[rigs.Maps]()},
+}
+
+// RigValidator generates and compiles the schema of r.
+func RigValidator(ctx context.Context, r Rig) (*jsonschema.Validator, error) {
+	schema, err := jsonschema.Generate(ctx, r.Type)
+	if err != nil {
+		return nil, fmt.Errorf("generate %s: %w", r.Name, err)
+	}
+
+	v, err := jsonschema.Compile(ctx, schema, r.Opts...)
+	if err != nil {
+		return nil, fmt.Errorf("compile %s: %w", r.Name, err)
+	}
+
+	return v, nil
+}
+
+// Files returns the generated files by name, for the testdata directory root.
+// A group [jsonschema.GenerateGo] rejects is left out, and its key maps to
+// no function in the generated index.
+func Files(ctx context.Context, root string) (map[string][]byte, error) {
+	suite, err := Load(root)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+
+	var index strings.Builder
+
+	index.WriteString("// Code generated by go run ./gen. DO NOT EDIT.\n\npackage " + Package + "\n\n")
+	index.WriteString("// Suite maps each generated suite group's key to its validator.\n")
+	index.WriteString("var Suite = map[string]func(any) error{\n")
+
+	for _, set := range Sets {
+		groups, err := suite.Groups(set)
+		if err != nil {
+			return nil, err
+		}
+
+		var funcs []jsonschema.GoFunc
+
+		for _, g := range groups {
+			v, err := jsonschema.Compile(ctx, g.Schema, g.Opts...)
+			if err != nil {
+				continue
+			}
+
+			f := jsonschema.GoFunc{Name: g.Func, Validator: v}
+
+			_, err = jsonschema.GenerateGo(ctx, jsonschema.GoConfig{Package: Package, Funcs: []jsonschema.GoFunc{f}})
+			if errors.Is(err, jsonschema.ErrCodeGen) {
+				continue
+			} else if err != nil {
+				return nil, fmt.Errorf("%s: %w", g.Key, err)
+			}
+
+			funcs = append(funcs, f)
+
+			fmt.Fprintf(&index, "\t%q: %s,\n", g.Key, g.Func)
+		}
+
+		src, err := jsonschema.GenerateGo(ctx, jsonschema.GoConfig{Package: Package, ImportPath: ImportPath, Funcs: funcs})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", set.Name, err)
+		}
+
+		files[strings.ToLower(set.Name)+"_gen.go"] = src
+	}
+
+	index.WriteString("}\n")
+
+	src, err := format.Source([]byte(index.String()))
+	if err != nil {
+		return nil, fmt.Errorf("format index: %w", err)
+	}
+
+	files["index_gen.go"] = src
+
+	funcs := make([]jsonschema.GoFunc, 0, len(Rigs))
+
+	for _, r := range Rigs {
+		v, err := RigValidator(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+
+		funcs = append(funcs, jsonschema.GoFunc{Name: r.Name, Validator: v, Type: r.Type})
+	}
+
+	src, err = jsonschema.GenerateGo(ctx, jsonschema.GoConfig{Package: Package, ImportPath: ImportPath, Funcs: funcs})
+	if err != nil {
+		return nil, fmt.Errorf("rigs: %w", err)
+	}
+
+	files["rigs_gen.go"] = src
+
+	return files, nil
+}
@@ -0,0 +1,825 @@
+// Package fieldname models the struct tag dialects of serialization libraries
+// other than encoding/json: how each maps a Go struct field to an object key,
+// when it omits the field, and which fields it inlines into their parent.
+//
+// Resolution follows each library's own rules rather than encoding/json's
+// promotion and shadowing (which live in jsontag and the generator). An
+// embedded struct is an ordinary field unless its tag inlines it, fields are
+// taken in declaration order with an inlined struct's fields at its position,
+// and two fields claiming one key are an error, as gopkg.in/yaml.v3 reports
+// them, rather than a tie to break. Encoding/json/v2 is the exception: it
+// inlines an embedded struct without a name and breaks ties by depth, as
+// [Dialect.Dominance] describes.
+package fieldname
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalid reports a struct the dialect cannot map to an object: a repeated
+// key, an inlined field that is neither a struct nor a map with string keys,
+// a second inlined map, an inline cycle, or a tag option the library rejects.
+var ErrInvalid = errors.New("invalid field naming")
+
+// Tag is one struct field read under a dialect.
+type Tag struct {
+	// Name is the field's key. An empty Name excludes the field unless it is
+	// inlined.
+	Name string
+	// Format is the field's format option, which selects the encoding of a
+	// byte slice, a time, a duration, a float, or a nil slice or map; empty
+	// for the type's default.
+	Format string
+	// Named marks a Name given explicitly by the tag rather than derived
+	// from the field name; it breaks a tie between fields claiming one key
+	// under [Dialect.Dominance].
+	Named bool
+	// OmitEmpty omits the field when the dialect's [Dialect.Empty] holds.
+	OmitEmpty bool
+	// OmitZero omits the field when it is the zero value of its type.
+	OmitZero bool
+	// String encodes a number as a JSON string holding it.
+	String bool
+	// Inline merges the field into its parent: a struct's fields join the
+	// parent's, and a map with string keys collects every other key.
+	Inline bool
+}
+
+// Values selects how a dialect encodes the values below its struct fields.
+type Values uint8
+
+const (
+	// ValuesJSON encodes values as encoding/json does.
+	ValuesJSON Values = iota
+	// ValuesYAML encodes values as gopkg.in/yaml.v3 does: MarshalYAML before
+	// MarshalText, time.Duration as its String form, byte slices as
+	// sequences, and nil slices and maps as empty ones.
+	ValuesYAML
+	// ValuesJSONv2 encodes values as encoding/json/v2 does: nil slices and
+	// maps as empty ones, byte arrays as base64 like byte slices, the format
+	// option, and no default form for time.Duration.
+	ValuesJSONv2
+)
+
+// Dialect is one library's field naming rules.
+type Dialect struct {
+	// Parse reads a field's tag. It sees every exported field and every
+	// embedded one; an error is wrapped with the struct and field.
+	Parse func(f reflect.StructField) (Tag, error)
+	// Empty is the omitempty test; nil when omitempty is decided on the
+	// encoded value instead, as encoding/json/v2 decides it.
+	Empty func(v reflect.Value) bool
+
+	cache sync.Map // map[reflect.Type]result
+
+	// Key is the struct tag key the dialect reads, for messages.
+	Key string
+	// Values selects the value encoding below struct fields.
+	Values Values
+	// Dominance resolves fields claiming one key as encoding/json does: the
+	// shallowest field wins, then the one named by its tag, and a tie drops
+	// them all. Without it, any two fields claiming one key are an error.
+	Dominance bool
+}
+
+// Field is one key of a struct's object form.
+type Field struct {
+	// Field is the struct field, with Index holding the full index sequence
+	// from the resolved struct, as [reflect.Type.FieldByName] reports it.
+	Field reflect.StructField
+	// Embedded holds the inlined fields Field is reached through, outermost
+	// first; nil for a field declared directly.
+	Embedded []reflect.StructField
+	// Name is the key.
+	Name      string
+	Format    string
+	OmitEmpty bool
+	OmitZero  bool
+	String    bool
+	// Optional marks a field reached through an inlined pointer, which a nil
+	// pointer leaves out.
+	Optional bool
+
+	named bool
+}
+
+// Fields is the resolved object form of a struct type.
+type Fields struct {
+	// Rest is the inlined map collecting the keys no field claims; nil when
+	// the struct has none.
+	Rest *Field
+	// List holds the keyed fields in declaration order.
+	List []Field
+}
+
+type result struct {
+	err    error
+	fields *Fields
+}
+
+// Fields resolves struct type t under the dialect. The result is computed once
+// per type and shared; callers must not modify it.
+func (d *Dialect) Fields(t reflect.Type) (*Fields, error) {
+	if r, ok := d.cache.Load(t); ok {
+		return r.(result).fields, r.(result).err //nolint:forcetypeassert // The cache holds only results.
+	}
+
+	c := &collector{d: d, seen: map[string]bool{}, visiting: map[reflect.Type]bool{}}
+
+	err := c.collect(t, nil, nil, false)
+	if err == nil {
+		err = c.resolve()
+	}
+
+	r, _ := d.cache.LoadOrStore(t, result{fields: &c.out, err: err})
+
+	return r.(result).fields, r.(result).err //nolint:forcetypeassert // The cache holds only results.
+}
+
+// collector carries one [Dialect.Fields] resolution.
+type collector struct {
+	d        *Dialect
+	seen     map[string]bool
+	visiting map[reflect.Type]bool
+	// Rests holds every inlined map found, for [Dialect.Dominance] to pick
+	// the shallowest from.
+	rests []Field
+	out   Fields
+}
+
+// collect appends the keys of struct type t, reached through the inlined
+// fields embedded at index, to the result.
+func (c *collector) collect(t reflect.Type, index []int, embedded []reflect.StructField, optional bool) error {
+	d := c.d
+
+	c.visiting[t] = true
+	defer delete(c.visiting, t)
+
+	// Two fields of one struct claiming one key are an error under every
+	// dialect; across structs, only without dominance.
+	local := map[string]bool{}
+	restAt := ""
+
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if !sf.IsExported() && !sf.Anonymous {
+			continue
+		}
+
+		tag, err := d.Parse(sf)
+		if err != nil {
+			return fmt.Errorf("%w: %s.%s: %w", ErrInvalid, t, sf.Name, err)
+		}
+
+		// An unexported embed has no key of its own to encode; only its
+		// inlined fields can appear.
+		if !sf.IsExported() && !tag.Inline {
+			continue
+		}
+
+		sf.Index = append(slices.Clip(index), i)
+
+		if tag.Inline {
+			isRest, err := c.inline(t, sf, embedded, optional)
+			if err != nil {
+				return err
+			}
+
+			if isRest {
+				if restAt != "" {
+					return fmt.Errorf("%w: %s: more than one inlined map (%s and %s)", ErrInvalid, t, restAt, sf.Name)
+				}
+
+				restAt = sf.Name
+			}
+
+			continue
+		}
+
+		if tag.Name == "" {
+			continue
+		}
+
+		if local[tag.Name] || !d.Dominance && c.seen[tag.Name] {
+			return fmt.Errorf("%w: %s: duplicated %s key %q", ErrInvalid, t, d.Key, tag.Name)
+		}
+
+		local[tag.Name] = true
+		c.seen[tag.Name] = true
+
+		c.out.List = append(c.out.List, Field{
+			Field:     sf,
+			Embedded:  embedded,
+			Name:      tag.Name,
+			Format:    tag.Format,
+			OmitEmpty: tag.OmitEmpty,
+			OmitZero:  tag.OmitZero,
+			String:    tag.String,
+			Optional:  optional,
+			named:     tag.Named,
+		})
+	}
+
+	return nil
+}
+
+// inline merges inlined field sf of struct type t into the result, reporting
+// whether it is an inlined map.
+func (c *collector) inline(
+	t reflect.Type,
+	sf reflect.StructField,
+	embedded []reflect.StructField,
+	optional bool,
+) (bool, error) {
+	ft := sf.Type
+	for ft.Kind() == reflect.Pointer {
+		ft = ft.Elem()
+		optional = true
+	}
+
+	switch {
+	case ft.Kind() == reflect.Struct:
+		if c.visiting[ft] {
+			// Encoding/json expands a struct type once on any one path, so a
+			// cycle contributes nothing further under dominance.
+			if c.d.Dominance {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("%w: %s: %s inlines itself", ErrInvalid, t, ft)
+		}
+
+		return false, c.collect(ft, sf.Index, append(slices.Clip(embedded), sf), optional)
+
+	case sf.Type.Kind() == reflect.Map && sf.Type.Key().Kind() == reflect.String,
+		c.d.Values == ValuesJSONv2 && IsJSONTextValue(sf.Type):
+		rest := Field{Field: sf, Embedded: embedded, Optional: optional}
+
+		if !c.d.Dominance && c.out.Rest != nil {
+			return true, fmt.Errorf("%w: %s: more than one inlined map", ErrInvalid, t)
+		}
+
+		c.rests = append(c.rests, rest)
+		c.out.Rest = &c.rests[len(c.rests)-1]
+
+		return true, nil
+
+	default:
+		return false, fmt.Errorf("%w: %s.%s: an inlined field must be a struct or a map with string keys",
+			ErrInvalid, t, sf.Name)
+	}
+}
+
+// resolve applies [Dialect.Dominance] to the collected fields and inlined
+// maps.
+func (c *collector) resolve() error {
+	if !c.d.Dominance {
+		return nil
+	}
+
+	byName := map[string][]*Field{}
+	for i := range c.out.List {
+		f := &c.out.List[i]
+		byName[f.Name] = append(byName[f.Name], f)
+	}
+
+	c.out.List = slices.DeleteFunc(slices.Clone(c.out.List), func(f Field) bool {
+		return !dominates(f, byName[f.Name])
+	})
+
+	c.out.Rest = nil
+
+	if len(c.rests) > 0 {
+		minDepth := len(c.rests[0].Field.Index)
+		for _, r := range c.rests {
+			minDepth = min(minDepth, len(r.Field.Index))
+		}
+
+		var at []Field
+
+		for _, r := range c.rests {
+			if len(r.Field.Index) == minDepth {
+				at = append(at, r)
+			}
+		}
+
+		if len(at) == 1 {
+			c.out.Rest = &at[0]
+		}
+	}
+
+	return nil
+}
+
+// dominates reports whether f wins its key among group, the fields claiming
+// it: f is the only shallowest one, or the only shallowest one its tag names.
+func dominates(f Field, group []*Field) bool {
+	if len(group) == 1 {
+		return true
+	}
+
+	depth := len(f.Field.Index)
+
+	var shallowest, named int
+
+	for _, g := range group {
+		switch d := len(g.Field.Index); {
+		case d < depth:
+			return false
+		case d == depth:
+			shallowest++
+
+			if g.named {
+				named++
+			}
+		}
+	}
+
+	return shallowest == 1 || f.named && named == 1
+}
+
+// IsJSONTextValue reports whether t is jsontext.Value, the raw JSON type
+// encoding/json/v2 (and github.com/go-json-experiment/json) accepts as an
+// inlined fallback for unknown members.
+func IsJSONTextValue(t reflect.Type) bool {
+	return t.Name() == "Value" && t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 &&
+		(t.PkgPath() == "encoding/json/jsontext" || t.PkgPath() == "github.com/go-json-experiment/json/jsontext")
+}
+
+// YAML is gopkg.in/yaml.v3's dialect, which github.com/goccy/go-yaml shares:
+// the yaml tag (or, when the tag has no key, the whole tag), a lowercased field
+// name by default, and the omitempty, flow, and inline options.
+var YAML = &Dialect{
+	Key:    "yaml",
+	Parse:  parseYAML,
+	Empty:  yamlEmpty,
+	Values: ValuesYAML,
+}
+
+func parseYAML(sf reflect.StructField) (Tag, error) {
+	tag := sf.Tag.Get("yaml")
+	if tag == "" && !strings.Contains(string(sf.Tag), ":") {
+		tag = string(sf.Tag)
+	}
+
+	if tag == "-" {
+		return Tag{}, nil
+	}
+
+	name, opts, found := strings.Cut(tag, ",")
+
+	var t Tag
+
+	if found {
+		for opt := range strings.SplitSeq(opts, ",") {
+			switch opt {
+			case "omitempty":
+				t.OmitEmpty = true
+			case "flow":
+				// Layout only.
+			case "inline":
+				t.Inline = true
+			default:
+				return Tag{}, fmt.Errorf("unsupported flag %q in tag %q", opt, tag)
+			}
+		}
+	}
+
+	if t.Inline {
+		return t, nil
+	}
+
+	t.Name = name
+	if t.Name == "" {
+		t.Name = strings.ToLower(sf.Name)
+	}
+
+	return t, nil
+}
+
+// isZeroer is the IsZero method yaml's omitempty consults.
+type isZeroer interface {
+	IsZero() bool
+}
+
+// yamlEmpty is yaml.v3's omitempty test: an IsZero method when the value has
+// one, and otherwise the kind's zero, with a struct empty when every exported
+// field is.
+func yamlEmpty(v reflect.Value) bool {
+	k := v.Kind()
+
+	if v.CanInterface() {
+		if z, ok := v.Interface().(isZeroer); ok {
+			if (k == reflect.Pointer || k == reflect.Interface) && v.IsNil() {
+				return true
+			}
+
+			return z.IsZero()
+		}
+	}
+
+	switch k {
+	case reflect.String, reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() && !yamlEmpty(v.Field(i)) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
+
+// Mapstructure is github.com/go-viper/mapstructure's dialect (and its
+// github.com/mitchellh/mapstructure predecessor's): the mapstructure tag, the
+// field name as declared by default, squash to inline a struct, remain to
+// collect the other keys in a map, and omitempty and omitzero.
+var Mapstructure = &Dialect{
+	Key:   "mapstructure",
+	Parse: parseMapstructure,
+	Empty: Empty,
+}
+
+func parseMapstructure(sf reflect.StructField) (Tag, error) {
+	name, opts, _ := strings.Cut(sf.Tag.Get("mapstructure"), ",")
+	if name == "-" {
+		return Tag{}, nil
+	}
+
+	var t Tag
+
+	for opt := range strings.SplitSeq(opts, ",") {
+		switch opt {
+		case "squash", "remain":
+			t.Inline = true
+		case "omitempty":
+			t.OmitEmpty = true
+		case "omitzero":
+			t.OmitZero = true
+		}
+	}
+
+	if t.Inline {
+		return t, nil
+	}
+
+	t.Name = name
+	if t.Name == "" {
+		t.Name = sf.Name
+	}
+
+	return t, nil
+}
+
+// Empty is encoding/json's omitempty test, which mapstructure shares: an empty
+// string, slice, map, or array, a false, a zero number, and a nil pointer or
+// interface. A struct is never empty.
+func Empty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	default:
+		return false
+	}
+}
+
+// JSONv2 is encoding/json/v2's dialect (and github.com/go-json-experiment/json's):
+// the json tag, the field name as declared by default, the omitzero,
+// omitempty, string, case, and format options, and embed (or inline and
+// unknown, go-json-experiment's names for it) to inline a struct or collect
+// the other keys in a map. An embedded struct without a JSON name is inlined
+// implicitly, and fields claiming one key are resolved by depth as
+// encoding/json resolves them.
+var JSONv2 = &Dialect{
+	Key:       "json",
+	Parse:     parseJSONv2,
+	Values:    ValuesJSONv2,
+	Dominance: true,
+}
+
+// jsonv2Options are the options encoding/json/v2 knows. It ignores any other
+// option, but rejects one differing from these only in case as a likely typo.
+var jsonv2Options = []string{"omitzero", "omitempty", "string", "embed", "inline", "unknown", "case", "format"}
+
+func parseJSONv2(sf reflect.StructField) (Tag, error) {
+	tag := sf.Tag.Get("json")
+	if tag == "-" {
+		return Tag{}, nil
+	}
+
+	var t Tag
+
+	name, rest, err := jsonv2Token(tag)
+	if err != nil {
+		return Tag{}, err
+	}
+
+	t.Name, t.Named = name, name != ""
+
+	seen := map[string]bool{}
+	others := false
+
+	for rest != "" {
+		if rest[0] != ',' {
+			return Tag{}, fmt.Errorf("malformed tag %q", tag)
+		}
+
+		rest = rest[1:]
+
+		key := rest
+		if i := strings.IndexAny(rest, ",:"); i >= 0 {
+			key = rest[:i]
+		}
+
+		rest = rest[len(key):]
+
+		var value string
+
+		if strings.HasPrefix(rest, ":") {
+			value, rest, err = jsonv2Token(rest[1:])
+			if err != nil {
+				return Tag{}, err
+			}
+		}
+
+		if seen[key] {
+			return Tag{}, fmt.Errorf("duplicate option %q in tag %q", key, tag)
+		}
+
+		seen[key] = true
+
+		switch key {
+		case "omitzero":
+			t.OmitZero = true
+		case "omitempty":
+			t.OmitEmpty = true
+		case "string":
+			t.String = true
+		case "embed", "inline", "unknown":
+			t.Inline = true
+
+			continue
+		case "case":
+			if value != "ignore" && value != "strict" {
+				return Tag{}, fmt.Errorf("unknown case option value %q in tag %q", value, tag)
+			}
+		case "format":
+			if rest != "" {
+				return Tag{}, fmt.Errorf("format must be the last option in tag %q", tag)
+			}
+
+			t.Format = value
+		default:
+			for _, known := range jsonv2Options {
+				if strings.EqualFold(key, known) {
+					return Tag{}, fmt.Errorf("unknown option %q in tag %q; did you mean %q?", key, tag, known)
+				}
+			}
+
+			continue
+		}
+
+		others = true
+	}
+
+	if sf.Anonymous && !t.Named && !t.Inline {
+		ft := sf.Type
+		if ft.Kind() == reflect.Pointer && ft.Name() == "" {
+			ft = ft.Elem()
+		}
+
+		if ft.Kind() != reflect.Struct {
+			return Tag{}, errors.New("an embedded field of a non-struct type must be given a JSON name")
+		}
+
+		t.Inline = true
+	}
+
+	if t.Inline {
+		if others {
+			return Tag{}, errors.New("an inlined field cannot have options other than embed")
+		}
+
+		if hasArshalMethod(sf.Type) && !IsJSONTextValue(sf.Type) {
+			return Tag{}, fmt.Errorf("an inlined field cannot be of type %s, which has marshal or unmarshal methods",
+				sf.Type)
+		}
+
+		return Tag{Inline: true}, nil
+	}
+
+	if t.Name == "" {
+		t.Name = sf.Name
+	}
+
+	if t.Format != "" {
+		if err := checkJSONv2Format(sf.Type, t.Format); err != nil {
+			return Tag{}, err
+		}
+	}
+
+	if t.String && !jsonv2Numeric(sf.Type, t.Format) {
+		return Tag{}, fmt.Errorf("the string option does not apply to %s", sf.Type)
+	}
+
+	return t, nil
+}
+
+var (
+	typeTime     = reflect.TypeFor[time.Time]()
+	typeDuration = reflect.TypeFor[time.Duration]()
+)
+
+// IsJSONv2Bytes reports whether t is a byte slice or array encoding/json/v2
+// encodes as one base64 string by default: its element is the unnamed byte
+// type. A named element makes it an ordinary array.
+func IsJSONv2Bytes(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) &&
+		t.Elem().Kind() == reflect.Uint8 && t.Elem().PkgPath() == ""
+}
+
+// checkJSONv2Format reports whether format applies to type t (or the type it
+// points to) under encoding/json/v2's rules.
+func checkJSONv2Format(t reflect.Type, format string) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var ok bool
+
+	switch {
+	case t == typeTime:
+		ok = TimeLayout(format) != "" || slices.Contains([]string{"unix", "unixmilli", "unixmicro", "unixnano"}, format)
+	case t == typeDuration:
+		ok = slices.Contains([]string{"units", "sec", "milli", "micro", "nano", "iso8601"}, format)
+	case IsJSONv2Bytes(t):
+		ok = slices.Contains([]string{"base64", "base64url", "base32", "base32hex", "base16", "hex", "array"}, format)
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		ok = format == "nonfinite"
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Map:
+		ok = format == "emitnull" || format == "emitempty"
+	}
+
+	if !ok {
+		return fmt.Errorf("format %q does not apply to %s", format, t)
+	}
+
+	return nil
+}
+
+// TimeLayout returns the time layout a time.Time format option names: a time
+// package layout constant by name, RFC 3339 with nanoseconds for "", or a
+// custom layout, which cannot start with a letter. It returns "" for a format
+// that is not a layout: a unix form or an unknown name.
+func TimeLayout(format string) string {
+	switch format {
+	case "":
+		return time.RFC3339Nano
+	case "ANSIC":
+		return time.ANSIC
+	case "UnixDate":
+		return time.UnixDate
+	case "RubyDate":
+		return time.RubyDate
+	case "RFC822":
+		return time.RFC822
+	case "RFC822Z":
+		return time.RFC822Z
+	case "RFC850":
+		return time.RFC850
+	case "RFC1123":
+		return time.RFC1123
+	case "RFC1123Z":
+		return time.RFC1123Z
+	case "RFC3339":
+		return time.RFC3339
+	case "RFC3339Nano":
+		return time.RFC3339Nano
+	case "Kitchen":
+		return time.Kitchen
+	case "Stamp":
+		return time.Stamp
+	case "StampMilli":
+		return time.StampMilli
+	case "StampMicro":
+		return time.StampMicro
+	case "StampNano":
+		return time.StampNano
+	case "DateTime":
+		return time.DateTime
+	case "DateOnly":
+		return time.DateOnly
+	case "TimeOnly":
+		return time.TimeOnly
+	}
+
+	// Encoding/json/v2 takes any format starting with a letter to name a
+	// layout constant, and anything else as a layout itself.
+	if c := format[0]; 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' {
+		return ""
+	}
+
+	return format
+}
+
+// jsonv2Numeric reports whether type t (or the type it points to) encodes as
+// a JSON number under format, the values the string option quotes.
+func jsonv2Numeric(t reflect.Type, format string) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == typeTime:
+		return strings.HasPrefix(format, "unix")
+	case t == typeDuration:
+		return slices.Contains([]string{"sec", "milli", "micro", "nano"}, format)
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// jsonv2Token reads the name or option value at the start of s, up to the
+// next comma or, single-quoted, holding any characters, and returns it with
+// the rest of s.
+func jsonv2Token(s string) (string, string, error) {
+	if !strings.HasPrefix(s, "'") {
+		i := strings.IndexByte(s, ',')
+		if i < 0 {
+			return s, "", nil
+		}
+
+		return s[:i], s[i:], nil
+	}
+
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '\'':
+			inner := strings.ReplaceAll(strings.ReplaceAll(s[1:i], `\'`, `'`), `"`, `\"`)
+
+			v, err := strconv.Unquote(`"` + inner + `"`)
+			if err != nil {
+				return "", "", fmt.Errorf("malformed quoted string %s", s[:i+1])
+			}
+
+			return v, s[i+1:], nil
+		}
+	}
+
+	return "", "", fmt.Errorf("unterminated quoted string %s", s)
+}
+
+// hasArshalMethod reports whether t or *t has a method encoding/json/v2 would
+// encode or decode it with, which makes it unfit to inline.
+func hasArshalMethod(t reflect.Type) bool {
+	for _, name := range []string{
+		"MarshalJSONTo", "MarshalJSON", "AppendText", "MarshalText",
+		"UnmarshalJSONFrom", "UnmarshalJSON", "UnmarshalText",
+	} {
+		if _, ok := t.MethodByName(name); ok {
+			return true
+		}
+
+		if _, ok := reflect.PointerTo(t).MethodByName(name); ok && t.Kind() != reflect.Pointer {
+			return true
+		}
+	}
+
+	return false
+}
@@ -12,8 +12,9 @@
 // ([time.Time], [big.Int]): [reflect.Value.Set] panics on them. Nor can it produce
 // a valid [json.RawMessage], since arbitrary bytes make [json.Marshal] fail. Such
 // types are populated by a constructor registry consulted before the kind
-// switch; the defaults cover [time.Time], [big.Int], and [json.RawMessage], and
-// callers register more with [WithConstructor]. A rig type that has unexported
+// switch; the defaults cover [time.Time], [big.Int], [json.RawMessage], the
+// [net/netip] address types, and [net.IP], and callers register more with
+// [WithConstructor]. A rig type that has unexported
 // fields without a registered constructor fills as its zero value, so its
 // coverage is near-nil.
 //
@@ -25,6 +26,8 @@ import (
 	"maps"
 	"math"
 	"math/big"
+	"net"
+	"net/netip"
 	"reflect"
 	"strconv"
 	"strings"
@@ -56,13 +59,18 @@ var (
 	}
 
 	// Registry entries for the standard-library types generic reflection cannot
-	// fill: [time.Time] and [big.Int] have unexported fields, and
-	// [json.RawMessage] must hold valid JSON. It is never mutated after init;
-	// [WithConstructor] copies it before adding an override.
+	// fill: [time.Time], [big.Int], and the [net/netip] types have unexported
+	// fields, and [json.RawMessage] must hold valid JSON and [net.IP] a valid
+	// address length. It is never mutated after init; [WithConstructor] copies
+	// it before adding an override.
 	defaultConstructors = map[reflect.Type]Constructor{
 		reflect.TypeFor[time.Time]():       fillTime,
 		reflect.TypeFor[big.Int]():         fillBigInt,
 		reflect.TypeFor[json.RawMessage](): fillRawMessage,
+		reflect.TypeFor[netip.Addr]():      fillAddr,
+		reflect.TypeFor[netip.Prefix]():    fillPrefix,
+		reflect.TypeFor[netip.AddrPort]():  fillAddrPort,
+		reflect.TypeFor[net.IP]():          fillNetIP,
 	}
 )
 
@@ -477,3 +485,71 @@ func fillRawMessage(c *Cursor) any {
 		return json.RawMessage(`{}`)
 	}
 }
+
+// fillAddr draws a [netip.Addr]: the zero Addr, IPv4, IPv6, IPv4-mapped IPv6,
+// or zoned IPv6.
+func fillAddr(c *Cursor) any {
+	if c.Intn(5) == 0 {
+		return netip.Addr{}
+	}
+
+	return drawAddr(c)
+}
+
+// drawAddr draws a valid [netip.Addr] in one of the four non-zero forms
+// [netip.Addr.MarshalText] renders differently.
+func drawAddr(c *Cursor) netip.Addr {
+	var b16 [16]byte
+	for i := range b16 {
+		b16[i] = c.Byte()
+	}
+
+	switch c.Intn(4) {
+	case 0:
+		return netip.AddrFrom4([4]byte(b16[:4]))
+	case 1:
+		return netip.AddrFrom16(b16)
+	case 2:
+		return netip.AddrFrom16(netip.AddrFrom4([4]byte(b16[:4])).As16())
+	default:
+		return netip.AddrFrom16(b16).WithZone("eth" + strconv.Itoa(c.Intn(10)))
+	}
+}
+
+// fillPrefix draws a [netip.Prefix]: the zero Prefix or a valid prefix of
+// any length for its address.
+func fillPrefix(c *Cursor) any {
+	if c.Intn(5) == 0 {
+		return netip.Prefix{}
+	}
+
+	addr := drawAddr(c)
+
+	return netip.PrefixFrom(addr, c.Intn(addr.BitLen()+1))
+}
+
+// fillAddrPort draws a [netip.AddrPort] from [fillAddr]'s addresses and any
+// port.
+func fillAddrPort(c *Cursor) any {
+	addr, _ := fillAddr(c).(netip.Addr)
+
+	//nolint:gosec // the truncation to 16 bits is the intended port draw.
+	return netip.AddrPortFrom(addr, uint16(c.Uint64()))
+}
+
+// fillNetIP draws a [net.IP] of a length it marshals: nil, 4 bytes, or 16.
+func fillNetIP(c *Cursor) any {
+	switch c.Intn(3) {
+	case 0:
+		return net.IP(nil)
+	case 1:
+		return net.IPv4(c.Byte(), c.Byte(), c.Byte(), c.Byte()).To4()
+	default:
+		ip := make(net.IP, net.IPv6len)
+		for i := range ip {
+			ip[i] = c.Byte()
+		}
+
+		return ip
+	}
+}
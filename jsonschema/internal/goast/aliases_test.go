@@ -89,3 +89,69 @@ func TestStructFieldDocThroughAliases(t *testing.T) {
 		})
 	}
 }
+
+func TestStructFieldDocsThroughAliases(t *testing.T) {
+	t.Parallel()
+
+	files := parseFiles(t, aliasSrc+`
+type Mixed struct {
+	// A and B share a doc.
+	A, B int
+	Undocumented int
+	// Box embeds a generic struct.
+	*Box[int]
+}
+`)
+
+	tests := map[string]struct {
+		typeName string
+		want     map[string]string
+	}{
+		"direct struct":       {typeName: "Direct", want: map[string]string{"DF": "DirectDoc here."}},
+		"follows alias chain": {typeName: "Renamed", want: map[string]string{"Field": "FieldDoc is the doc."}},
+		"follows generic instantiation alias": {
+			typeName: "GenericAlias",
+			want:     map[string]string{"BoxField": "BoxFieldDoc is the doc."},
+		},
+		"grouped and embedded fields": {
+			typeName: "Mixed",
+			want: map[string]string{
+				"A":   "A and B share a doc.",
+				"B":   "A and B share a doc.",
+				"Box": "Box embeds a generic struct.",
+			},
+		},
+		"cyclic alias guarded":  {typeName: "CycleA"},
+		"non-struct underlying": {typeName: "NotStruct"},
+		"type not found":        {typeName: "Missing"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, goast.StructFieldDocsThroughAliases(files, tc.typeName))
+		})
+	}
+}
+
+func TestTypeNames(t *testing.T) {
+	t.Parallel()
+
+	files := parseFiles(t, `
+package p
+
+type A int
+
+type (
+	B struct{}
+	C = B
+)
+
+func f() {
+	type local int
+}
+`)
+
+	assert.Equal(t, []string{"A", "B", "C"}, goast.TypeNames(files))
+}
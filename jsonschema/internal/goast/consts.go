@@ -0,0 +1,106 @@
+package goast
+
+import (
+	"errors"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// Constant is one package-level constant declared with a named type.
+type Constant struct {
+	Value constant.Value
+	Name  string
+	Doc   string
+}
+
+// errNoImports is what the type check's importer answers for every import.
+var errNoImports = errors.New("imports are not loaded")
+
+// Constants returns the package-level constants declared with the named type
+// typeName, in declaration order, each with its doc comment: the comment on
+// its spec, the comment on a single-spec declaration, or else its trailing
+// line comment. Values come from type-checking the package's files, so iota
+// blocks, implicit repetition, and constant expressions resolve as the
+// compiler resolves them. The check does not load imports: a constant whose
+// value or type depends on another package has no known value and is left
+// out, as is a constant named _.
+func (p *Package) Constants(typeName string) []Constant {
+	p.once.Do(p.check)
+
+	var out []Constant
+
+	for _, f := range p.Files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range gd.Specs {
+				vs, ok := spec.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+
+				for _, name := range vs.Names {
+					c, ok := p.defs[name].(*types.Const)
+					if !ok || name.Name == "_" || c.Val().Kind() == constant.Unknown || !isNamed(c.Type(), typeName) {
+						continue
+					}
+
+					out = append(out, Constant{Name: name.Name, Value: c.Val(), Doc: valueDoc(gd, vs)})
+				}
+			}
+		}
+	}
+
+	return out
+}
+
+// check type-checks the package's files, tolerating every error (including
+// the unresolved imports), and records the defined objects.
+func (p *Package) check() {
+	p.defs = map[*ast.Ident]types.Object{}
+
+	conf := types.Config{
+		Importer: importerFunc(func(string) (*types.Package, error) { return nil, errNoImports }),
+		Error:    func(error) {},
+	}
+
+	// Best-effort: the objects that did check are recorded regardless of the
+	// errors the unresolved imports cause.
+	_, _ = conf.Check(p.Path, p.Fset, p.Files, &types.Info{Defs: p.defs})
+}
+
+// isNamed reports whether t is the package's own named type typeName.
+func isNamed(t types.Type, typeName string) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Name() == typeName && named.Obj().Parent() == named.Obj().Pkg().Scope()
+}
+
+// valueDoc returns a constant spec's doc comment, preferring the spec's own,
+// then a single-spec declaration's, then the spec's trailing line comment.
+func valueDoc(gd *ast.GenDecl, vs *ast.ValueSpec) string {
+	switch {
+	case vs.Doc != nil:
+		return strings.TrimSpace(vs.Doc.Text())
+	case gd.Doc != nil && len(gd.Specs) == 1:
+		return strings.TrimSpace(gd.Doc.Text())
+	case vs.Comment != nil:
+		return strings.TrimSpace(vs.Comment.Text())
+	default:
+		return ""
+	}
+}
+
+// importerFunc adapts a function to [types.Importer].
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
@@ -0,0 +1,71 @@
+package goast_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/goast"
+)
+
+func TestPackageConstants(t *testing.T) {
+	t.Parallel()
+
+	const src = `package p
+
+import "other"
+
+type Level int
+
+// Single documents the single-spec declaration.
+const Single Level = 9
+
+const (
+	// Low is documented on the spec.
+	Low Level = iota
+	_
+	High // documented on the line
+	Shifted = 1 << High
+
+	Untyped = 5
+	Foreign Level = other.Value
+)
+
+type Name string
+
+const Plain Name = "plain"
+
+func f() {
+	const Local Level = 100
+}
+`
+
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, "src.go", src, parser.ParseComments)
+	require.NoError(t, err)
+
+	pkg := &goast.Package{Path: "p", Fset: fset, Files: []*ast.File{f}}
+
+	var got []string
+
+	for _, c := range pkg.Constants("Level") {
+		got = append(got, c.Name+"="+c.Value.ExactString()+" "+c.Doc)
+	}
+
+	assert.Equal(t, []string{
+		"Single=9 Single documents the single-spec declaration.",
+		"Low=0 Low is documented on the spec.",
+		"High=2 documented on the line",
+	}, got)
+
+	names := pkg.Constants("Name")
+	require.Len(t, names, 1)
+	assert.Equal(t, `"plain"`, names[0].Value.ExactString())
+
+	assert.Empty(t, pkg.Constants("Missing"))
+}
@@ -0,0 +1,109 @@
+package goast
+
+import (
+	"go/ast"
+	"go/doc/comment"
+	"go/token"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// DocParser returns a [comment.Parser] resolving doc links the way go doc
+// does for the package: [Name] and [Recv.Name] against the declarations in
+// its files, and [pkg.Name] against the packages its files import, by
+// explicit import name or else by the import path's last element. A nil
+// package resolves only the standard library's single-element paths.
+func (p *Package) DocParser() *comment.Parser {
+	if p == nil {
+		return &comment.Parser{}
+	}
+
+	p.symsOnce.Do(p.collectSymbols)
+
+	return &comment.Parser{
+		LookupPackage: func(name string) (string, bool) {
+			importPath, ok := p.imports[name]
+
+			return importPath, ok
+		},
+		LookupSym: func(recv, name string) bool {
+			return p.syms[recv+"."+name]
+		},
+	}
+}
+
+// collectSymbols records the package's top-level declarations, keyed
+// ".Name" for a const, func, type, or var and "Recv.Name" for a method, and
+// the names its files import packages under.
+func (p *Package) collectSymbols() {
+	p.syms = map[string]bool{}
+	p.imports = map[string]string{}
+
+	for _, f := range p.Files {
+		for _, spec := range f.Imports {
+			importPath, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				continue
+			}
+
+			name := importName(importPath)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+
+			if name != "_" && name != "." {
+				p.imports[name] = importPath
+			}
+		}
+
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				p.syms[receiverName(d)+"."+d.Name.Name] = true
+
+			case *ast.GenDecl:
+				if d.Tok == token.IMPORT {
+					continue
+				}
+
+				for _, spec := range d.Specs {
+					switch s := spec.(type) {
+					case *ast.TypeSpec:
+						p.syms["."+s.Name.Name] = true
+
+					case *ast.ValueSpec:
+						for _, ident := range s.Names {
+							p.syms["."+ident.Name] = true
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// receiverName returns the base type name of a method's receiver, or "" for
+// a function.
+func receiverName(d *ast.FuncDecl) string {
+	if d.Recv == nil || len(d.Recv.List) == 0 {
+		return ""
+	}
+
+	return EmbeddedFieldName(d.Recv.List[0].Type)
+}
+
+// importName returns the package name an unnamed import of importPath is
+// conventionally referred to by: its last path element, skipping a major
+// version suffix.
+func importName(importPath string) string {
+	name := path.Base(importPath)
+
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		if parent := path.Dir(importPath); parent != "." {
+			name = path.Base(parent)
+		}
+	}
+
+	return name
+}
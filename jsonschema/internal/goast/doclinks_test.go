@@ -0,0 +1,91 @@
+package goast_test
+
+import (
+	"go/doc/comment"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/goast"
+)
+
+func TestPackageDocParser(t *testing.T) {
+	t.Parallel()
+
+	files := parseFiles(t, `package p
+
+import (
+	"example.com/mod/v2"
+	yml "example.com/yaml"
+	_ "example.com/side"
+)
+
+type Box[T any] struct{}
+
+func (b *Box[T]) Open() {}
+
+func Make() {}
+
+const Limit = 1
+
+var Default Box[int]
+`)
+
+	pkg := &goast.Package{Path: "example.com/p", Fset: token.NewFileSet(), Files: files}
+
+	tests := map[string]struct {
+		text string
+		want *comment.DocLink
+	}{
+		"type":               {text: "[Box]", want: &comment.DocLink{Name: "Box"}},
+		"method":             {text: "[Box.Open]", want: &comment.DocLink{Recv: "Box", Name: "Open"}},
+		"func":               {text: "[Make]", want: &comment.DocLink{Name: "Make"}},
+		"const":              {text: "[Limit]", want: &comment.DocLink{Name: "Limit"}},
+		"var":                {text: "[Default]", want: &comment.DocLink{Name: "Default"}},
+		"major version path": {text: "[mod.Thing]", want: &comment.DocLink{ImportPath: "example.com/mod/v2", Name: "Thing"}},
+		"named import":       {text: "[yml.Node]", want: &comment.DocLink{ImportPath: "example.com/yaml", Name: "Node"}},
+		"standard library":   {text: "[io.Reader]", want: &comment.DocLink{ImportPath: "io", Name: "Reader"}},
+		"unknown symbol":     {text: "[Missing]"},
+		"blank import":       {text: "[side.Thing]"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, firstDocLink(pkg.DocParser().Parse(tc.text)))
+		})
+	}
+}
+
+func TestNilPackageDocParser(t *testing.T) {
+	t.Parallel()
+
+	var pkg *goast.Package
+
+	assert.Nil(t, firstDocLink(pkg.DocParser().Parse("[Box]")))
+	assert.Equal(t, &comment.DocLink{ImportPath: "io", Name: "Reader"},
+		firstDocLink(pkg.DocParser().Parse("[io.Reader]")))
+}
+
+// firstDocLink returns the first doc link of d's first paragraph without its
+// text, or nil.
+func firstDocLink(d *comment.Doc) *comment.DocLink {
+	for _, b := range d.Content {
+		p, ok := b.(*comment.Paragraph)
+		if !ok {
+			continue
+		}
+
+		for _, text := range p.Text {
+			if link, ok := text.(*comment.DocLink); ok {
+				link.Text = nil
+
+				return link
+			}
+		}
+	}
+
+	return nil
+}
@@ -137,9 +137,79 @@ func EmbeddedFieldName(expr ast.Expr) string {
 // is reported under Foo. The type-argument list on typeName is stripped first
 // ([BaseTypeName]). It reports ok=false when no reachable type is a struct (a
 // cross-package alias or non-struct underlying type carries no locally
-// scannable fields) or the type is not found. A visited set guards against a
-// malformed cyclic alias chain.
+// scannable fields) or the type is not found.
 func StructFieldDocThroughAliases(files []*ast.File, typeName, fieldName string) (string, bool) {
+	st := structThroughAliases(files, typeName)
+	if st == nil {
+		return "", false
+	}
+
+	return StructFieldDoc(st, fieldName)
+}
+
+// StructFieldDocsThroughAliases returns the trimmed doc comment of every
+// documented field of the struct named typeName, keyed by Go field name,
+// following the same chain of named types as [StructFieldDocThroughAliases].
+// Each entry matches what StructFieldDocThroughAliases reports for that
+// field; a type that resolves to no struct yields nil.
+func StructFieldDocsThroughAliases(files []*ast.File, typeName string) map[string]string {
+	st := structThroughAliases(files, typeName)
+	if st == nil {
+		return nil
+	}
+
+	docs := map[string]string{}
+
+	for _, field := range st.Fields.List {
+		if field.Doc == nil {
+			continue
+		}
+
+		doc := strings.TrimSpace(field.Doc.Text())
+
+		if len(field.Names) == 0 {
+			if name := EmbeddedFieldName(field.Type); name != "" {
+				docs[name] = doc
+			}
+
+			continue
+		}
+
+		for _, ident := range field.Names {
+			docs[ident.Name] = doc
+		}
+	}
+
+	return docs
+}
+
+// TypeNames returns the names of the types declared at package scope in files,
+// in source order.
+func TypeNames(files []*ast.File) []string {
+	var names []string
+
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gd.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					names = append(names, ts.Name.Name)
+				}
+			}
+		}
+	}
+
+	return names
+}
+
+// structThroughAliases returns the struct type the type named typeName
+// resolves to through same-package named types, or nil. A visited set guards
+// against a malformed cyclic alias chain.
+func structThroughAliases(files []*ast.File, typeName string) *ast.StructType {
 	name := BaseTypeName(typeName)
 	seen := map[string]bool{}
 
@@ -148,12 +218,12 @@ func StructFieldDocThroughAliases(files []*ast.File, typeName, fieldName string)
 
 		ts := FindTypeSpec(files, name)
 		if ts == nil {
-			return "", false
+			return nil
 		}
 
 		switch underlying := ts.Type.(type) {
 		case *ast.StructType:
-			return StructFieldDoc(underlying, fieldName)
+			return underlying
 
 		case *ast.Ident:
 			// A same-package named type (type Foo Bar); follow to Bar.
@@ -165,7 +235,7 @@ func StructFieldDocThroughAliases(files []*ast.File, typeName, fieldName string)
 			// instantiation pkg.Bar[int]) carries no locally scannable fields.
 			id, ok := underlying.X.(*ast.Ident)
 			if !ok {
-				return "", false
+				return nil
 			}
 
 			name = id.Name
@@ -175,7 +245,7 @@ func StructFieldDocThroughAliases(files []*ast.File, typeName, fieldName string)
 			// (type Foo Bar[int, string]).
 			id, ok := underlying.X.(*ast.Ident)
 			if !ok {
-				return "", false
+				return nil
 			}
 
 			name = id.Name
@@ -183,9 +253,9 @@ func StructFieldDocThroughAliases(files []*ast.File, typeName, fieldName string)
 		default:
 			// A cross-package alias (an *ast.SelectorExpr) or a non-struct
 			// underlying type carries no locally scannable struct fields.
-			return "", false
+			return nil
 		}
 	}
 
-	return "", false
+	return nil
 }
@@ -6,32 +6,54 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"go/types"
+	"sync"
 
 	"golang.org/x/tools/go/packages"
 )
 
-// LoadPackageFiles uses go/packages to load and parse the source files for the
+// Package is one loaded package: its parsed source files and the file set
+// their positions belong to.
+type Package struct {
+	Fset *token.FileSet
+	// Defs is the lazily built identifier-to-object map [Package.Constants]
+	// reads, filled once by a best-effort type check of Files.
+	defs map[*ast.Ident]types.Object
+	// Syms and imports are the lazily built doc-link tables
+	// [Package.DocParser] resolves against, filled once from Files.
+	syms     map[string]bool
+	imports  map[string]string
+	Path     string
+	Files    []*ast.File
+	once     sync.Once
+	symsOnce sync.Once
+	// Std reports that the package belongs to no module: a standard library
+	// package (or one loaded in GOPATH mode).
+	Std bool
+}
+
+// LoadPackage uses go/packages to load and parse the source files for the
 // package at pkgPath, resolving paths against dir (empty means the process
 // working directory). The returned bool reports whether the load reached a
 // definitive result worth caching. A load attempted under a done context
-// reports the context's error (and false); any other load failure returns no
-// files and false (the documented silent skip), so a transient failure is
-// retried rather than cached. A successful load returns its parsed files and
+// reports the context's error (and false); any other load failure returns a
+// nil package and false (the documented silent skip), so a transient failure
+// is retried rather than cached. A successful load returns its package and
 // true, even when the package legitimately has no source files.
 //
-// The configured Mode (NeedName | NeedFiles | NeedSyntax) parses but does not
-// type-check, so the only per-file problems that arise are parse errors and
-// import resolution failures. Package-level errors (an unrelated sibling file
+// The configured Mode (NeedName | NeedFiles | NeedSyntax | NeedModule) parses
+// but does not type-check, so the only per-file problems that arise are parse
+// errors and import resolution failures. Package-level errors (an unrelated sibling file
 // with a parse problem, an unresolved import, and so on) do not discard the
 // successfully parsed files: go/packages populates Syntax with every AST that
 // parsed cleanly while aggregating per-file problems separately in Errors.
 // Best-effort comment extraction uses whatever parsed, so a single bad file in
 // the package does not drop doc comments for the types that did parse.
-func LoadPackageFiles(ctx context.Context, dir, pkgPath string) ([]*ast.File, bool, error) {
+func LoadPackage(ctx context.Context, dir, pkgPath string) (*Package, bool, error) {
 	cfg := &packages.Config{
 		Context: ctx,
 		Dir:     dir,
-		Mode:    packages.NeedName | packages.NeedFiles | packages.NeedSyntax,
+		Mode:    packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedModule,
 		ParseFile: func(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
 			return parser.ParseFile(fset, filename, src, parser.ParseComments)
 		},
@@ -49,5 +71,7 @@ func LoadPackageFiles(ctx context.Context, dir, pkgPath string) ([]*ast.File, bo
 		return nil, false, nil
 	}
 
-	return pkgs[0].Syntax, true, nil
+	pkg := pkgs[0]
+
+	return &Package{Path: pkgPath, Fset: pkg.Fset, Files: pkg.Syntax, Std: pkg.Module == nil}, true, nil
 }
@@ -0,0 +1,377 @@
+package jsonvalue
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/fieldname"
+)
+
+// yamlMarshaler is gopkg.in/yaml.v3's Marshaler, matched by method so the
+// walk needs no yaml dependency.
+type yamlMarshaler interface {
+	MarshalYAML() (any, error)
+}
+
+// OfDialect returns the instance v encodes to when its structs take the object
+// form dialect d gives them. Values below a struct field encode as
+// encoding/json encodes them, MarshalJSON and MarshalText included, unless d is
+// yaml's: yaml.v3 consults MarshalYAML and then MarshalText (resolved, as
+// encoding/json resolves marshalers, through an addressable value's pointer
+// too), encodes a time.Duration as its String form and a time.Time as
+// RFC 3339 text, and encodes a byte slice as a sequence and a nil slice or map
+// as an empty one. Under encoding/json/v2's value rules a nil slice or map is
+// an empty one too, a byte array is base64 like a byte slice, a
+// time.Duration needs a format option, pointer-receiver methods apply to any
+// value, and invalid UTF-8 is an error. A key of an inlined map that a struct
+// field also claims is an error, as yaml.v3 and v2 report it. A nil d is
+// [Of].
+func OfDialect(v any, d *fieldname.Dialect) (any, error) {
+	if d == nil {
+		return Of(v)
+	}
+
+	w := &dialectWalker{d: d}
+
+	return w.value(reflect.ValueOf(v))
+}
+
+// dialectWalker carries one [OfDialect] conversion.
+type dialectWalker struct {
+	d *fieldname.Dialect
+	e encodeState
+}
+
+func (w *dialectWalker) value(v reflect.Value) (any, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+
+	switch w.d.Values {
+	case fieldname.ValuesYAML:
+		if x, ok, err := w.yamlLeaf(v); ok {
+			return x, err
+		}
+	case fieldname.ValuesJSONv2:
+		if x, ok, err := w.jsonv2Leaf(v); ok {
+			return x, err
+		}
+	default:
+		if encodedByMethod(v) {
+			return w.e.reflectValue(v, encOpts{})
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		return w.value(v.Elem())
+
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		leave, err := w.e.enter(v, v.Interface)
+		if err != nil {
+			return nil, err
+		}
+
+		defer leave()
+
+		return w.value(v.Elem())
+
+	case reflect.Struct:
+		return w.object(v)
+
+	case reflect.Map:
+		return w.mapValue(v)
+
+	case reflect.Slice:
+		if w.d.Values == fieldname.ValuesJSON && isByteSlice(v.Type()) {
+			return w.e.reflectValue(v, encOpts{})
+		}
+
+		if v.IsNil() {
+			if w.d.Values != fieldname.ValuesJSON {
+				return []any{}, nil
+			}
+
+			return nil, nil
+		}
+
+		return w.elements(v)
+
+	case reflect.Array:
+		return w.elements(v)
+
+	default:
+		return w.e.reflectValue(v, encOpts{})
+	}
+}
+
+// yamlLeaf encodes the values yaml.v3 encodes by type or method rather than
+// by kind, reporting false for any other value.
+func (w *dialectWalker) yamlLeaf(v reflect.Value) (any, bool, error) {
+	switch x := yamlMethodValue(v).(type) {
+	case time.Time:
+		return x.Format(time.RFC3339Nano), true, nil
+	case time.Duration:
+		return x.String(), true, nil
+	case yamlMarshaler:
+		out, err := x.MarshalYAML()
+		if err != nil {
+			return nil, true, &json.MarshalerError{Type: v.Type(), Err: err}
+		}
+
+		out2, err := w.value(reflect.ValueOf(out))
+
+		return out2, true, err
+	case encoding.TextMarshaler:
+		text, err := x.MarshalText()
+		if err != nil {
+			return nil, true, &json.MarshalerError{Type: v.Type(), Err: err}
+		}
+
+		return String(string(text)), true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// yamlMethodValue returns the value whose type or method yaml.v3 encodes v
+// by: v itself, or its address when v is addressable and its pointer carries
+// the method, as encoding/json resolves marshalers. It returns nil for a
+// pointer or interface, which the walk dereferences first, and for any value
+// encoded by kind.
+func yamlMethodValue(v reflect.Value) any {
+	if !v.CanInterface() || v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		return nil
+	}
+
+	switch x := v.Interface().(type) {
+	case time.Time, time.Duration, yamlMarshaler, encoding.TextMarshaler:
+		return x
+	}
+
+	if v.CanAddr() {
+		switch x := v.Addr().Interface().(type) {
+		case yamlMarshaler, encoding.TextMarshaler:
+			return x
+		}
+	}
+
+	return nil
+}
+
+func (w *dialectWalker) object(v reflect.Value) (any, error) {
+	fields, err := w.d.Fields(v.Type())
+	if err != nil {
+		return nil, err //nolint:wrapcheck // The dialect names the struct and field.
+	}
+
+	obj := make(map[string]any, len(fields.List))
+
+	for i := range fields.List {
+		f := &fields.List[i]
+
+		fv, ok := fieldByIndex(v, f.Field.Index)
+		if !ok {
+			continue
+		}
+
+		if f.OmitEmpty && w.d.Empty != nil && w.d.Empty(fv) || f.OmitZero && w.isZero(fv) {
+			continue
+		}
+
+		var x any
+
+		if w.d.Values == fieldname.ValuesJSONv2 {
+			x, err = w.jsonv2Field(fv, f)
+		} else {
+			x, err = w.value(fv)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Encoding/json/v2 decides omitempty on the encoded value.
+		if f.OmitEmpty && w.d.Empty == nil && isEmptyJSON(x) {
+			continue
+		}
+
+		obj[f.Name] = x
+	}
+
+	if fields.Rest != nil {
+		rv, ok := fieldByIndex(v, fields.Rest.Field.Index)
+		if ok && !rv.IsNil() {
+			var rest any
+
+			if fieldname.IsJSONTextValue(rv.Type()) {
+				rest, err = jsonv2Rest(rv)
+			} else {
+				rest, err = w.mapValue(rv)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			for k, x := range rest.(map[string]any) { //nolint:forcetypeassert // A string-keyed map encodes to an object.
+				if slices.ContainsFunc(fields.List, func(f fieldname.Field) bool { return f.Name == k }) {
+					return nil, &json.UnsupportedValueError{
+						Value: rv,
+						Str:   fmt.Sprintf("inlined map key %q conflicts with a struct field of %s", k, v.Type()),
+					}
+				}
+
+				obj[k] = x
+			}
+		}
+	}
+
+	return obj, nil
+}
+
+func (w *dialectWalker) mapValue(v reflect.Value) (any, error) {
+	if v.IsNil() {
+		if w.d.Values != fieldname.ValuesJSON {
+			return map[string]any{}, nil
+		}
+
+		return nil, nil
+	}
+
+	leave, err := w.e.enter(v, func() any { return v.UnsafePointer() })
+	if err != nil {
+		return nil, err
+	}
+
+	defer leave()
+
+	obj := make(map[string]any, v.Len())
+
+	for iter := v.MapRange(); iter.Next(); {
+		ks, err := resolveKeyName(iter.Key())
+		if err != nil {
+			return nil, fmt.Errorf("encoding error for type %q: %w", v.Type().String(), err)
+		}
+
+		if w.d.Values == fieldname.ValuesJSONv2 && !utf8.ValidString(ks) {
+			return nil, &json.UnsupportedValueError{Value: iter.Key(), Str: fmt.Sprintf("invalid UTF-8 in %q", ks)}
+		}
+
+		x, err := w.value(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		obj[String(ks)] = x
+	}
+
+	return obj, nil
+}
+
+func (w *dialectWalker) elements(v reflect.Value) (any, error) {
+	arr := make([]any, v.Len())
+
+	for i := range arr {
+		x, err := w.value(v.Index(i))
+		if err != nil {
+			return nil, err
+		}
+
+		arr[i] = x
+	}
+
+	return arr, nil
+}
+
+// isZero is the omitzero test: encoding/json/v2 consults an IsZero method on
+// the value or its pointer, and the other dialects the kind's zero.
+func (w *dialectWalker) isZero(v reflect.Value) bool {
+	if w.d.Values == fieldname.ValuesJSONv2 {
+		if z := zeroFunc(true, v.Type()); z != nil {
+			return z(v)
+		}
+	}
+
+	return v.IsZero()
+}
+
+// fieldByIndex follows a resolved field's index from struct v, reporting
+// false when a nil inlined pointer leaves the field unreachable.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for _, j := range index {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(j)
+	}
+
+	return v, true
+}
+
+// encodedByMethod reports whether encoding/json encodes v through a
+// MarshalJSON or MarshalText method, on v or, when v is addressable, on its
+// pointer.
+func encodedByMethod(v reflect.Value) bool {
+	t := v.Type()
+	if t.Implements(marshalerType) || t.Implements(textMarshalerType) {
+		return true
+	}
+
+	return t.Kind() != reflect.Pointer && v.CanAddr() &&
+		(reflect.PointerTo(t).Implements(marshalerType) || reflect.PointerTo(t).Implements(textMarshalerType))
+}
+
+// dialectStructMember is structMember under dialect d, falling back to the
+// inlined map for a key no field claims.
+func dialectStructMember(d *fieldname.Dialect, v reflect.Value, key string) (Member, bool) {
+	fields, err := d.Fields(v.Type())
+	if err != nil {
+		return Member{}, false
+	}
+
+	f := fields.Rest
+
+	if i := slices.IndexFunc(fields.List, func(f fieldname.Field) bool { return f.Name == key }); i >= 0 {
+		f = &fields.List[i]
+	}
+
+	if f == nil {
+		return Member{}, false
+	}
+
+	fv, ok := fieldByIndex(v, f.Field.Index)
+	if !ok {
+		return Member{}, false
+	}
+
+	if f == fields.Rest {
+		return mapMember(fv, key)
+	}
+
+	return Member{Value: fv, Field: f.Field, Embedded: f.Embedded}, true
+}
+
+// yamlEncodedByMethod reports whether yaml.v3 encodes v by its type or a
+// method rather than by kind.
+func yamlEncodedByMethod(v reflect.Value) bool {
+	return yamlMethodValue(v) != nil
+}
@@ -0,0 +1,363 @@
+package jsonvalue
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/fieldname"
+	"go.jacobcolvin.com/x/jsonschema/internal/normalize"
+)
+
+var (
+	typeTime     = reflect.TypeFor[time.Time]()
+	typeDuration = reflect.TypeFor[time.Duration]()
+)
+
+// jsonv2Leaf encodes the values encoding/json/v2 encodes by type or method
+// rather than by kind, reporting false for any other value. Unlike
+// encoding/json, v2 calls a pointer-receiver method on a value that is not
+// addressable, and it rejects invalid UTF-8 rather than replacing it.
+func (w *dialectWalker) jsonv2Leaf(v reflect.Value) (any, bool, error) {
+	t := v.Type()
+
+	switch {
+	case t.Kind() == reflect.Pointer || t.Kind() == reflect.Interface:
+		return nil, false, nil
+
+	case t == typeDuration:
+		return nil, true, &json.UnsupportedValueError{Value: v, Str: "time.Duration has no default representation"}
+
+	case jsonv2EncodedByMethod(t):
+		if !v.CanAddr() {
+			c := reflect.New(t).Elem()
+			c.Set(v)
+			v = c
+		}
+
+		x, err := w.e.reflectValue(v, encOpts{})
+
+		return x, true, err
+
+	case fieldname.IsJSONv2Bytes(t):
+		return base64.StdEncoding.EncodeToString(byteValues(v)), true, nil
+
+	case t.Kind() == reflect.String && !utf8.ValidString(v.String()):
+		return nil, true, &json.UnsupportedValueError{Value: v, Str: fmt.Sprintf("invalid UTF-8 in %q", v.String())}
+
+	default:
+		return nil, false, nil
+	}
+}
+
+// jsonv2EncodedByMethod reports whether encoding/json/v2 encodes a value of
+// type t through a MarshalJSON or MarshalText method on it or its pointer.
+func jsonv2EncodedByMethod(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer || t.Kind() == reflect.Interface {
+		return false
+	}
+
+	pt := reflect.PointerTo(t)
+
+	return t.Implements(marshalerType) || t.Implements(textMarshalerType) ||
+		pt.Implements(marshalerType) || pt.Implements(textMarshalerType)
+}
+
+// jsonv2Field encodes the value of struct field f under its string and format
+// options, which apply to the field's type through any pointers.
+func (w *dialectWalker) jsonv2Field(v reflect.Value, f *fieldname.Field) (any, error) {
+	if f.Format == "" && !f.String {
+		return w.value(v)
+	}
+
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		v = v.Elem()
+	}
+
+	x, err := w.formatted(v, f.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	if n, ok := x.(json.Number); ok && f.String {
+		return string(n), nil
+	}
+
+	return x, nil
+}
+
+// formatted encodes v, a value of a type the format option applies to, under
+// format; an empty format is the type's default.
+func (w *dialectWalker) formatted(v reflect.Value, format string) (any, error) {
+	t := v.Type()
+
+	switch {
+	case format == "":
+		return w.value(v)
+
+	case t == typeTime:
+		return jsonv2Time(v, format)
+
+	case t == typeDuration:
+		return jsonv2Duration(time.Duration(v.Int()), format), nil
+
+	case fieldname.IsJSONv2Bytes(t):
+		b := byteValues(v)
+
+		switch format {
+		case "array":
+			arr := make([]any, len(b))
+			for i, c := range b {
+				arr[i] = json.Number(strconv.Itoa(int(c)))
+			}
+
+			return arr, nil
+		case "base64url":
+			return base64.URLEncoding.EncodeToString(b), nil
+		case "base32":
+			return base32.StdEncoding.EncodeToString(b), nil
+		case "base32hex":
+			return base32.HexEncoding.EncodeToString(b), nil
+		case "base16", "hex":
+			return hex.EncodeToString(b), nil
+		default:
+			return base64.StdEncoding.EncodeToString(b), nil
+		}
+
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		switch f := v.Float(); {
+		case math.IsNaN(f):
+			return "NaN", nil
+		case math.IsInf(f, 1):
+			return "Infinity", nil
+		case math.IsInf(f, -1):
+			return "-Infinity", nil
+		default:
+			n, _ := Number(f, t.Bits())
+
+			return n, nil
+		}
+
+	case format == "emitnull" && v.IsNil():
+		return nil, nil
+
+	default:
+		return w.value(v)
+	}
+}
+
+// jsonv2Rest decodes an inlined jsontext.Value, which holds the unknown
+// members as one raw JSON object.
+func jsonv2Rest(rv reflect.Value) (map[string]any, error) {
+	if rv.Len() == 0 {
+		return nil, nil
+	}
+
+	x, err := normalize.DecodeJSONInstance(rv.Bytes())
+	if err != nil {
+		return nil, &json.MarshalerError{Type: rv.Type(), Err: err}
+	}
+
+	obj, ok := x.(map[string]any)
+	if !ok {
+		return nil, &json.UnsupportedValueError{Value: rv, Str: "inlined raw value must be a JSON object"}
+	}
+
+	return obj, nil
+}
+
+// isEmptyJSON reports whether an encoded value is one encoding/json/v2's
+// omitempty omits: null, an empty string, an empty object, or an empty
+// array.
+func isEmptyJSON(x any) bool {
+	switch x := x.(type) {
+	case nil:
+		return true
+	case string:
+		return x == ""
+	case map[string]any:
+		return len(x) == 0
+	case []any:
+		return len(x) == 0
+	default:
+		return false
+	}
+}
+
+// byteValues returns the bytes of a byte slice or array, which need not be
+// addressable.
+func byteValues(v reflect.Value) []byte {
+	b := make([]byte, v.Len())
+	reflect.Copy(reflect.ValueOf(b), v)
+
+	return b
+}
+
+// jsonv2Time encodes time v under a format option, as encoding/json/v2 does.
+func jsonv2Time(v reflect.Value, format string) (any, error) {
+	t := v.Interface().(time.Time) //nolint:forcetypeassert // The caller checked the type.
+
+	switch format {
+	case "unix":
+		return json.Number(appendTimeUnix(nil, t, 1e0)), nil
+	case "unixmilli":
+		return json.Number(appendTimeUnix(nil, t, 1e3)), nil
+	case "unixmicro":
+		return json.Number(appendTimeUnix(nil, t, 1e6)), nil
+	case "unixnano":
+		return json.Number(appendTimeUnix(nil, t, 1e9)), nil
+	}
+
+	layout := fieldname.TimeLayout(format)
+	s := t.Format(layout)
+
+	// Not every time has an RFC 3339 form: the year must be four digits and
+	// the zone offset under a day.
+	if layout == time.RFC3339 || layout == time.RFC3339Nano {
+		var err error
+
+		switch {
+		case s[len("9999")] != '-':
+			err = errors.New("year outside of range [0,9999]")
+		case s[len(s)-1] != 'Z':
+			if c := s[len(s)-len("Z07:00")]; '0' <= c && c <= '9' || s[len(s)-len("07:00"):][:2] >= "24" {
+				err = errors.New("timezone hour outside of range [0,23]")
+			}
+		}
+
+		if err != nil {
+			return nil, &json.MarshalerError{Type: v.Type(), Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+// jsonv2Duration encodes d under a format option, as encoding/json/v2 does.
+func jsonv2Duration(d time.Duration, format string) any {
+	switch format {
+	case "sec":
+		return json.Number(appendDurationBase10(nil, d, 1e9))
+	case "milli":
+		return json.Number(appendDurationBase10(nil, d, 1e6))
+	case "micro":
+		return json.Number(appendDurationBase10(nil, d, 1e3))
+	case "nano":
+		return json.Number(appendDurationBase10(nil, d, 1e0))
+	case "iso8601":
+		return string(appendDurationISO8601(nil, d))
+	default:
+		return d.String()
+	}
+}
+
+// appendDurationBase10 appends d as a decimal number of the unit pow10
+// nanoseconds long.
+func appendDurationBase10(b []byte, d time.Duration, pow10 uint64) []byte {
+	b, n := mayAppendDurationSign(b, d)
+	whole, frac := bits.Div64(0, n, pow10)
+	b = strconv.AppendUint(b, whole, 10)
+
+	return appendFracBase10(b, frac, pow10)
+}
+
+// appendDurationISO8601 appends d as an ISO 8601 duration of hours, minutes,
+// and seconds, omitting the zero designators.
+func appendDurationISO8601(b []byte, d time.Duration) []byte {
+	if d == 0 {
+		return append(b, "PT0S"...)
+	}
+
+	b, n := mayAppendDurationSign(b, d)
+	b = append(b, "PT"...)
+	n, nsec := bits.Div64(0, n, 1e9)
+	n, sec := bits.Div64(0, n, 60)
+	hour, minute := bits.Div64(0, n, 60)
+
+	if hour > 0 {
+		b = append(strconv.AppendUint(b, hour, 10), 'H')
+	}
+
+	if minute > 0 {
+		b = append(strconv.AppendUint(b, minute, 10), 'M')
+	}
+
+	if sec > 0 || nsec > 0 {
+		b = append(appendFracBase10(strconv.AppendUint(b, sec, 10), nsec, 1e9), 'S')
+	}
+
+	return b
+}
+
+func mayAppendDurationSign(b []byte, d time.Duration) ([]byte, uint64) {
+	if d < 0 {
+		return append(b, '-'), uint64(-d) //nolint:gosec // The negation of MinInt64 wraps to its magnitude.
+	}
+
+	return b, uint64(d)
+}
+
+// appendTimeUnix appends t as a decimal number of the unit 1/pow10 seconds
+// long since the Unix epoch.
+func appendTimeUnix(b []byte, t time.Time, pow10 uint64) []byte {
+	sec, nsec := t.Unix(), int64(t.Nanosecond())
+	if sec < 0 {
+		b = append(b, '-')
+		sec, nsec = ^sec, -nsec+1e9
+		sec += nsec / 1e9
+		nsec %= 1e9
+	}
+
+	switch usec, unsec := uint64(sec), uint64(nsec); { //nolint:gosec // Both are non-negative here.
+	case pow10 == 1e0:
+		b = strconv.AppendUint(b, usec, 10)
+
+		return appendFracBase10(b, unsec, 1e9)
+	case usec < 1e9:
+		b = strconv.AppendUint(b, usec*pow10+unsec/(1e9/pow10), 10)
+
+		return appendFracBase10(b, (unsec*pow10)%1e9, 1e9)
+	default:
+		b = strconv.AppendUint(b, usec, 10)
+		b = appendPaddedBase10(b, unsec/(1e9/pow10), pow10)
+
+		return appendFracBase10(b, (unsec*pow10)%1e9, 1e9)
+	}
+}
+
+// appendFracBase10 appends the fraction n/max10 without trailing zeros,
+// where max10 is a power of ten larger than n.
+func appendFracBase10(b []byte, n, max10 uint64) []byte {
+	if n == 0 {
+		return b
+	}
+
+	return []byte(strings.TrimRight(string(appendPaddedBase10(append(b, '.'), n, max10)), "0"))
+}
+
+// appendPaddedBase10 appends n zero-padded to the digits of max10 - 1.
+func appendPaddedBase10(b []byte, n, max10 uint64) []byte {
+	if n < max10/10 {
+		i := len(b)
+		b = strconv.AppendUint(b, n+max10/10, 10)
+		b[i]--
+
+		return b
+	}
+
+	return strconv.AppendUint(b, n, 10)
+}
@@ -0,0 +1,131 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package jsonvalue_test
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/fieldname"
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonvalue"
+	"go.jacobcolvin.com/x/jsonschema/internal/normalize"
+)
+
+type v2Byte byte
+
+type v2Empty struct {
+	Str   string         `json:",omitempty"`
+	Num   int            `json:",omitempty"`
+	Slice []int          `json:",omitempty"`
+	Map   map[string]int `json:",omitempty"`
+	Ptr   *int           `json:",omitempty"`
+	Obj   struct{}       `json:",omitempty"`
+	Any   any            `json:",omitempty"`
+	Zero  zeroer         `json:",omitzero"`
+	PZero ptrZeroer      `json:",omitzero"`
+	Inner struct {
+		X []int
+	} `json:",omitzero"`
+}
+
+type v2Values struct {
+	Slice  []int
+	Map    map[string]int
+	Bytes  []byte
+	Array  [2]byte
+	Named  []v2Byte
+	Texts  map[string]ptrText
+	JSONs  []ptrJSON
+	Time   time.Time
+	Raw    json.RawMessage
+	Big    *big.Int
+	Any    any
+	Quoted int      `json:",string"`
+	PFloat *float64 `json:",string"`
+}
+
+type v2Base struct {
+	Name   string
+	Shared string `json:"shared"`
+}
+
+type v2Other struct {
+	Name string
+}
+
+type v2Embeds struct {
+	v2Base
+	*v2Other
+
+	Shared int               `json:"shared"`
+	Rest   map[string]string `json:",embed"`
+}
+
+type v2RawRest struct {
+	ID   string
+	Rest jsontext.Value `json:",embed"`
+}
+
+// TestOfDialect_JSONv2Std checks the walk under [fieldname.JSONv2] against
+// encoding/json/v2 itself, on values without the format option, which the
+// standard library rejects. Both must fail, or both produce the same
+// instance.
+func TestOfDialect_JSONv2Std(t *testing.T) {
+	t.Parallel()
+
+	f := 2.5
+	z := ptrZeroer(7)
+
+	tcs := map[string]any{
+		"empty":       v2Empty{Zero: 7, PZero: z},
+		"not empty":   v2Empty{Str: "s", Slice: []int{}, Any: 0, Zero: 1, Ptr: new(int)},
+		"zero values": v2Values{},
+		"values": v2Values{
+			Slice: []int{1}, Map: map[string]int{"a": 1}, Bytes: []byte("hi"), Array: [2]byte{1, 2},
+			Named: []v2Byte{3}, Texts: map[string]ptrText{"k": 4}, JSONs: []ptrJSON{5},
+			Time: time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC), Raw: json.RawMessage(`{"r": [1]}`),
+			Big: big.NewInt(-9), Any: []byte("x"), Quoted: -3, PFloat: &f,
+		},
+		"embeds":            v2Embeds{v2Base: v2Base{Name: "n", Shared: "lost"}, Shared: 1, Rest: map[string]string{"x": "y"}},
+		"embeds other":      v2Embeds{v2Other: &v2Other{Name: "o"}},
+		"raw rest":          v2RawRest{ID: "i", Rest: jsontext.Value(`{"a": 1, "b": [true]}`)},
+		"rest conflict":     v2Embeds{Rest: map[string]string{"shared": "x"}},
+		"raw rest conflict": v2RawRest{Rest: jsontext.Value(`{"ID": 1}`)},
+		"invalid utf8":      map[string]string{"k": "\xff"},
+		"invalid utf8 key":  map[string]int{"\xff": 1},
+		"nan":               math.NaN(),
+		"duration":          time.Second,
+		"nil":               nil,
+		"top bytes":         []byte(nil),
+		"top map":           map[string][]int{"a": nil},
+	}
+
+	for name, v := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := jsonvalue.OfDialect(v, fieldname.JSONv2)
+
+			data, wantErr := jsonv2.Marshal(v)
+			if wantErr != nil {
+				require.Error(t, gotErr, "encoding/json/v2 failed: %v", wantErr)
+
+				return
+			}
+
+			require.NoError(t, gotErr)
+
+			want, err := normalize.DecodeJSONInstance(data)
+			require.NoError(t, err)
+			assert.Equal(t, want, got, "encoding/json/v2 marshals %s", data)
+		})
+	}
+}
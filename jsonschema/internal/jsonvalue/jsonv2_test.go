@@ -0,0 +1,95 @@
+package jsonvalue_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/fieldname"
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonvalue"
+	"go.jacobcolvin.com/x/jsonschema/internal/normalize"
+)
+
+type v2Formats struct {
+	B64    []byte         `json:",format:base64"`
+	B64URL []byte         `json:",format:base64url"`
+	B32    []byte         `json:",format:base32"`
+	B32Hex []byte         `json:",format:base32hex"`
+	B16    []byte         `json:",format:base16"`
+	Arr    [3]byte        `json:",format:array"`
+	NilArr []byte         `json:",format:array"`
+	Units  time.Duration  `json:",format:units"`
+	Sec    time.Duration  `json:",format:sec"`
+	Milli  time.Duration  `json:",format:milli"`
+	Micro  time.Duration  `json:",format:micro"`
+	Nano   time.Duration  `json:",format:nano"`
+	ISO    time.Duration  `json:",format:iso8601"`
+	ISOZ   time.Duration  `json:",format:iso8601"`
+	SecQ   *time.Duration `json:",string,format:sec"`
+	Unix   time.Time      `json:",format:unix"`
+	UMilli time.Time      `json:",format:unixmilli"`
+	UMicro time.Time      `json:",format:unixmicro"`
+	UNano  time.Time      `json:",format:unixnano"`
+	RFC    time.Time      `json:",format:RFC3339"`
+	Date   time.Time      `json:",format:DateOnly"`
+	Custom time.Time      `json:",format:'2006/01/02_15h'"`
+	NaN    float64        `json:",format:nonfinite"`
+	Inf    float32        `json:",format:nonfinite"`
+	Null   []int          `json:",format:emitnull"`
+	NullM  map[string]int `json:",format:emitnull"`
+	Empty  []int          `json:",format:emitempty"`
+	Count  int            `json:",string"`
+}
+
+// TestOfDialect_JSONv2Formats checks the format option's encodings against
+// the output of encoding/json/v2 with format support enabled
+// (github.com/go-json-experiment/json's ExperimentalSupportFormatTag).
+func TestOfDialect_JSONv2Formats(t *testing.T) {
+	t.Parallel()
+
+	d := -1500 * time.Millisecond
+	tm := time.Date(1969, 12, 31, 23, 59, 58, 250_000_000, time.UTC)
+
+	got, err := jsonvalue.OfDialect(v2Formats{
+		B64:    []byte{0xfb, 0xff},
+		B64URL: []byte{0xfb, 0xff},
+		B32:    []byte("hello"),
+		B32Hex: []byte("hi"),
+		B16:    []byte{0x0a, 0xff},
+		Arr:    [3]byte{1, 2, 3},
+		Units:  90*time.Minute + 500*time.Millisecond,
+		Sec:    d,
+		Milli:  1234567 * time.Nanosecond,
+		Micro:  1500 * time.Nanosecond,
+		Nano:   -7,
+		ISO:    -(26*time.Hour + 3*time.Minute + 4500*time.Millisecond),
+		SecQ:   &d,
+		Unix:   tm,
+		UMilli: tm,
+		UMicro: tm,
+		UNano:  tm,
+		RFC:    tm,
+		Date:   tm,
+		Custom: tm,
+		NaN:    math.NaN(),
+		Inf:    float32(math.Inf(-1)),
+		Count:  42,
+	}, fieldname.JSONv2)
+	require.NoError(t, err)
+
+	want, err := normalize.DecodeJSONInstance([]byte(`{
+		"B64": "+/8=", "B64URL": "-_8=", "B32": "NBSWY3DP", "B32Hex": "D1KG====", "B16": "0aff",
+		"Arr": [1, 2, 3], "NilArr": [],
+		"Units": "1h30m0.5s", "Sec": -1.5, "Milli": 1.234567, "Micro": 1.5, "Nano": -7,
+		"ISO": "-PT26H3M4.5S", "ISOZ": "PT0S", "SecQ": "-1.5",
+		"Unix": -1.75, "UMilli": -1750, "UMicro": -1750000, "UNano": -1750000000,
+		"RFC": "1969-12-31T23:59:58Z", "Date": "1969-12-31", "Custom": "1969/12/31_23h",
+		"NaN": "NaN", "Inf": "-Infinity",
+		"Null": null, "NullM": null, "Empty": [], "Count": "42"
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,927 @@
+// Package jsonvalue computes the JSON instance a Go value marshals to without
+// writing and re-reading JSON text: the value [encoding/json.Marshal] followed
+// by a [encoding/json.Decoder] with UseNumber would produce, namely
+// map[string]any, []any, string, [json.Number], bool, and nil.
+//
+// The walk mirrors encoding/json's encoder rule for rule: the per-type encoder
+// choice (MarshalJSON and MarshalText, on the value or, for an addressable
+// value, its pointer), struct field resolution with embedding, shadowing, and
+// the tag tie-break, omitempty and omitzero including IsZero methods, the
+// ,string option, float formatting, invalid UTF-8 replacement, base64 byte
+// slices, map key resolution and ordering, and pointer-cycle detection past
+// encoding/json's depth threshold. Only a MarshalJSON or MarshalText method
+// produces bytes, which are validated as encoding/json validates them and then
+// decoded. An error is the one encoding/json would return for the same value.
+//
+// Encoders are built once per [reflect.Type] and cached, so repeated
+// conversions of one type pay for field resolution and method lookup once.
+package jsonvalue
+
+import (
+	"bytes"
+	"cmp"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/jsontag"
+	"go.jacobcolvin.com/x/jsonschema/internal/normalize"
+)
+
+// startDetectingCyclesAfter is encoding/json's nesting depth past which the
+// pointer, map, and slice encoders track the containers on the current path
+// and reject a revisit as a cycle.
+const startDetectingCyclesAfter = 1000
+
+var (
+	marshalerType     = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+	numberType        = reflect.TypeFor[json.Number]()
+	isZeroerType      = reflect.TypeFor[isZeroer]()
+)
+
+type isZeroer interface {
+	IsZero() bool
+}
+
+// Of returns the JSON instance v marshals to, or the error encoding/json
+// returns marshaling v.
+func Of(v any) (any, error) {
+	e := &encodeState{}
+
+	x, err := e.reflectValue(reflect.ValueOf(v), encOpts{})
+	if err != nil {
+		return nil, marshalError(v, err)
+	}
+
+	return x, nil
+}
+
+// encodeState carries encoding/json's cycle-detection state through one
+// conversion.
+type encodeState struct {
+	ptrSeen  map[any]struct{}
+	ptrLevel uint
+}
+
+// enter records a container on the current path once the nesting passes
+// the detection threshold, reporting a cycle when key is already on it. The
+// returned func undoes the record.
+func (e *encodeState) enter(v reflect.Value, key func() any) (func(), error) {
+	e.ptrLevel++
+	if e.ptrLevel <= startDetectingCyclesAfter {
+		return func() { e.ptrLevel-- }, nil
+	}
+
+	k := key()
+	if _, ok := e.ptrSeen[k]; ok {
+		return nil, &json.UnsupportedValueError{Value: v, Str: fmt.Sprintf("encountered a cycle via %s", v.Type())}
+	}
+
+	if e.ptrSeen == nil {
+		e.ptrSeen = map[any]struct{}{}
+	}
+
+	e.ptrSeen[k] = struct{}{}
+
+	return func() {
+		delete(e.ptrSeen, k)
+		e.ptrLevel--
+	}, nil
+}
+
+func (e *encodeState) reflectValue(v reflect.Value, opts encOpts) (any, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+
+	return typeEncoder(v.Type())(e, v, opts)
+}
+
+// encOpts are the per-value options encoding/json threads through its
+// encoders. Quoted is the ,string field option.
+type encOpts struct {
+	quoted bool
+}
+
+type encoderFunc func(e *encodeState, v reflect.Value, opts encOpts) (any, error)
+
+var encoderCache sync.Map // map[reflect.Type]encoderFunc
+
+// typeEncoder returns the cached encoder for t, building it on first use. A
+// recursive type finds an indirect placeholder in the cache while its own
+// encoder is being built.
+func typeEncoder(t reflect.Type) encoderFunc {
+	if fi, ok := encoderCache.Load(t); ok {
+		return fi.(encoderFunc) //nolint:forcetypeassert // The cache holds only encoderFuncs.
+	}
+
+	indirect := sync.OnceValue(func() encoderFunc {
+		return newTypeEncoder(t, true)
+	})
+
+	fi, loaded := encoderCache.LoadOrStore(t, encoderFunc(func(e *encodeState, v reflect.Value, opts encOpts) (any, error) {
+		return indirect()(e, v, opts)
+	}))
+	if loaded {
+		return fi.(encoderFunc) //nolint:forcetypeassert // The cache holds only encoderFuncs.
+	}
+
+	f := indirect()
+	encoderCache.Store(t, f)
+
+	return f
+}
+
+// newTypeEncoder builds the encoder for t in encoding/json's precedence
+// order. The returned encoder consults the pointer's methods only when
+// allowAddr is true and the value is addressable.
+func newTypeEncoder(t reflect.Type, allowAddr bool) encoderFunc {
+	if t.Kind() != reflect.Pointer && allowAddr && reflect.PointerTo(t).Implements(marshalerType) {
+		return condAddrEncoder(addrMarshalerEncoder, newTypeEncoder(t, false))
+	}
+
+	if t.Implements(marshalerType) {
+		return marshalerEncoder
+	}
+
+	if t.Kind() != reflect.Pointer && allowAddr && reflect.PointerTo(t).Implements(textMarshalerType) {
+		return condAddrEncoder(addrTextMarshalerEncoder, newTypeEncoder(t, false))
+	}
+
+	if t.Implements(textMarshalerType) {
+		return textMarshalerEncoder
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return boolEncoder
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return intEncoder
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return uintEncoder
+	case reflect.Float32:
+		return floatEncoder(32)
+	case reflect.Float64:
+		return floatEncoder(64)
+	case reflect.String:
+		return stringEncoder
+	case reflect.Interface:
+		return interfaceEncoder
+	case reflect.Struct:
+		return newStructEncoder(t)
+	case reflect.Map:
+		return newMapEncoder(t)
+	case reflect.Slice:
+		return newSliceEncoder(t)
+	case reflect.Array:
+		return newArrayEncoder(t)
+	case reflect.Pointer:
+		return newPtrEncoder(t)
+	default:
+		return unsupportedTypeEncoder
+	}
+}
+
+func condAddrEncoder(canAddrEnc, elseEnc encoderFunc) encoderFunc {
+	return func(e *encodeState, v reflect.Value, opts encOpts) (any, error) {
+		if v.CanAddr() {
+			return canAddrEnc(e, v, opts)
+		}
+
+		return elseEnc(e, v, opts)
+	}
+}
+
+func marshalerEncoder(_ *encodeState, v reflect.Value, _ encOpts) (any, error) {
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil, nil
+	}
+
+	m, ok := reflect.TypeAssert[json.Marshaler](v)
+	if !ok {
+		return nil, nil
+	}
+
+	return marshalJSON(m, v.Type())
+}
+
+func addrMarshalerEncoder(_ *encodeState, v reflect.Value, _ encOpts) (any, error) {
+	m, _ := reflect.TypeAssert[json.Marshaler](v.Addr())
+
+	return marshalJSON(m, v.Type())
+}
+
+// marshalJSON calls m and decodes its output, which encoding/json requires
+// to be one valid JSON value.
+func marshalJSON(m json.Marshaler, t reflect.Type) (any, error) {
+	b, err := m.MarshalJSON()
+	if err == nil {
+		var buf bytes.Buffer
+
+		err = json.Compact(&buf, b)
+	}
+
+	if err != nil {
+		return nil, &json.MarshalerError{Type: t, Err: err}
+	}
+
+	x, err := normalize.DecodeJSONInstance(b)
+	if err != nil {
+		return nil, &json.MarshalerError{Type: t, Err: err}
+	}
+
+	return x, nil
+}
+
+func textMarshalerEncoder(_ *encodeState, v reflect.Value, _ encOpts) (any, error) {
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil, nil
+	}
+
+	m, ok := reflect.TypeAssert[encoding.TextMarshaler](v)
+	if !ok {
+		return nil, nil
+	}
+
+	b, err := m.MarshalText()
+	if err != nil {
+		return nil, textMarshalerError(v, err)
+	}
+
+	return String(string(b)), nil
+}
+
+func addrTextMarshalerEncoder(_ *encodeState, v reflect.Value, _ encOpts) (any, error) {
+	m, _ := reflect.TypeAssert[encoding.TextMarshaler](v.Addr())
+
+	b, err := m.MarshalText()
+	if err != nil {
+		return nil, textMarshalerError(v, err)
+	}
+
+	return String(string(b)), nil
+}
+
+// textMarshalerError returns the error encoding/json reports when v's
+// MarshalText fails with err. [json.MarshalerError] records the failing
+// method in an unexported field, so the error is reproduced by having
+// encoding/json repeat the call on a one-field struct holding v, addressable
+// exactly when v is. Should the repeated call succeed, the error is built
+// directly, naming MarshalJSON.
+func textMarshalerError(v reflect.Value, err error) error {
+	if v.CanInterface() {
+		holder := reflect.New(reflect.StructOf([]reflect.StructField{{Name: "V", Type: v.Type()}}))
+		holder.Elem().Field(0).Set(v)
+
+		target := holder.Elem().Interface()
+		if v.CanAddr() {
+			target = holder.Interface()
+		}
+
+		_, merr := json.Marshal(target)
+		if merr != nil {
+			return merr //nolint:wrapcheck // The error is encoding/json's own.
+		}
+	}
+
+	return &json.MarshalerError{Type: v.Type(), Err: err}
+}
+
+func boolEncoder(_ *encodeState, v reflect.Value, opts encOpts) (any, error) {
+	if opts.quoted {
+		return strconv.FormatBool(v.Bool()), nil
+	}
+
+	return v.Bool(), nil
+}
+
+func intEncoder(_ *encodeState, v reflect.Value, opts encOpts) (any, error) {
+	s := strconv.FormatInt(v.Int(), 10)
+	if opts.quoted {
+		return s, nil
+	}
+
+	return json.Number(s), nil
+}
+
+func uintEncoder(_ *encodeState, v reflect.Value, opts encOpts) (any, error) {
+	s := strconv.FormatUint(v.Uint(), 10)
+	if opts.quoted {
+		return s, nil
+	}
+
+	return json.Number(s), nil
+}
+
+func floatEncoder(bits int) encoderFunc {
+	return func(_ *encodeState, v reflect.Value, opts encOpts) (any, error) {
+		n, err := Number(v.Float(), bits)
+		if err != nil {
+			return nil, &json.UnsupportedValueError{Value: v, Str: err.Str}
+		}
+
+		if opts.quoted {
+			return string(n), nil
+		}
+
+		return n, nil
+	}
+}
+
+// Number returns the JSON number f marshals to as a float of the given bit
+// size, formatted as encoding/json formats it. A NaN or infinity has no JSON
+// form; the error is the one encoding/json reports for it.
+func Number(f float64, bits int) (json.Number, *json.UnsupportedValueError) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return "", &json.UnsupportedValueError{
+			Value: reflect.ValueOf(f),
+			Str:   strconv.FormatFloat(f, 'g', -1, bits),
+		}
+	}
+
+	// Convert as ES6 does: the exponent form only for very small and very
+	// large magnitudes, with a single-digit negative exponent unpadded. A
+	// float32 is compared as a float32 so the cutoffs are exact.
+	abs := math.Abs(f)
+	fmtByte := byte('f')
+
+	if abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) ||
+			bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			fmtByte = 'e'
+		}
+	}
+
+	b := strconv.AppendFloat(nil, f, fmtByte, -1, bits)
+	if fmtByte == 'e' {
+		if n := len(b); n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+
+	return json.Number(b), nil
+}
+
+func stringEncoder(_ *encodeState, v reflect.Value, opts encOpts) (any, error) {
+	if v.Type() == numberType {
+		numStr := v.String()
+		// Encoding/json encodes the empty Number as 0 for compatibility.
+		if numStr == "" {
+			numStr = "0"
+		}
+
+		if !isValidNumber(numStr) {
+			return nil, fmt.Errorf("json: invalid number literal %q", numStr)
+		}
+
+		if opts.quoted {
+			return numStr, nil
+		}
+
+		return json.Number(numStr), nil
+	}
+
+	if opts.quoted {
+		// The quoted form is the string's own JSON encoding, escapes and
+		// quotes included; encoding a string cannot fail.
+		b, _ := json.Marshal(v.String()) //nolint:errchkjson // Marshaling a string cannot fail.
+
+		return string(b), nil
+	}
+
+	return String(v.String()), nil
+}
+
+// String returns s as encoding/json round-trips it: each byte of an invalid
+// UTF-8 sequence becomes U+FFFD.
+func String(s string) string {
+	if utf8.ValidString(s) {
+		return s
+	}
+
+	b := make([]rune, 0, len(s))
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		b = append(b, r)
+		i += size
+	}
+
+	return string(b)
+}
+
+// isValidNumber reports whether s is a JSON number literal.
+func isValidNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	if s[0] == '-' {
+		s = s[1:]
+		if s == "" {
+			return false
+		}
+	}
+
+	switch {
+	case s[0] == '0':
+		s = s[1:]
+	case '1' <= s[0] && s[0] <= '9':
+		s = strings.TrimLeft(s[1:], "0123456789")
+	default:
+		return false
+	}
+
+	if len(s) >= 2 && s[0] == '.' && '0' <= s[1] && s[1] <= '9' {
+		s = strings.TrimLeft(s[2:], "0123456789")
+	}
+
+	if len(s) >= 2 && (s[0] == 'e' || s[0] == 'E') {
+		s = s[1:]
+		if s[0] == '+' || s[0] == '-' {
+			s = s[1:]
+			if s == "" {
+				return false
+			}
+		}
+
+		s = strings.TrimLeft(s, "0123456789")
+	}
+
+	return s == ""
+}
+
+func interfaceEncoder(e *encodeState, v reflect.Value, opts encOpts) (any, error) {
+	if v.IsNil() {
+		return nil, nil
+	}
+
+	return e.reflectValue(v.Elem(), opts)
+}
+
+func unsupportedTypeEncoder(_ *encodeState, v reflect.Value, _ encOpts) (any, error) {
+	return nil, &json.UnsupportedTypeError{Type: v.Type()}
+}
+
+// field is one JSON object member of a struct type, after encoding/json's
+// field resolution.
+type field struct {
+	typ     reflect.Type
+	isZero  func(reflect.Value) bool
+	encoder encoderFunc
+	name    string
+	index   []int
+	// Tag reports a name taken from the json tag, the input to the
+	// same-depth tie-break.
+	tag       bool
+	omitEmpty bool
+	omitZero  bool
+	quoted    bool
+}
+
+func newStructEncoder(t reflect.Type) encoderFunc {
+	fields := cachedTypeFields(t)
+
+	return func(e *encodeState, v reflect.Value, _ encOpts) (any, error) {
+		obj := make(map[string]any, len(fields))
+
+	fieldLoop:
+		for i := range fields {
+			f := &fields[i]
+
+			// Follow the index, skipping the field when a nil embedded
+			// pointer leaves it unreachable.
+			fv := v
+			for _, j := range f.index {
+				if fv.Kind() == reflect.Pointer {
+					if fv.IsNil() {
+						continue fieldLoop
+					}
+
+					fv = fv.Elem()
+				}
+
+				fv = fv.Field(j)
+			}
+
+			if f.omitEmpty && isEmptyValue(fv) ||
+				f.omitZero && (f.isZero == nil && fv.IsZero() || f.isZero != nil && f.isZero(fv)) {
+				continue
+			}
+
+			x, err := f.encoder(e, fv, encOpts{quoted: f.quoted})
+			if err != nil {
+				return nil, err
+			}
+
+			obj[f.name] = x
+		}
+
+		return obj, nil
+	}
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	default:
+		return false
+	}
+}
+
+// typeFields returns the fields encoding/json marshals for struct type t, in
+// index order. Embedded structs are walked breadth first, each type once at
+// its shallowest depth; a name is kept by its shallowest field, a tagged
+// field winning a same-depth tie, and dropped when the tie stands.
+func typeFields(t reflect.Type) []field {
+	var (
+		fields           []field
+		next             = []field{{typ: t}}
+		count, nextCount map[reflect.Type]int
+		visited          = map[reflect.Type]bool{}
+	)
+
+	for len(next) > 0 {
+		current := next
+		next = nil
+		count, nextCount = nextCount, map[reflect.Type]int{}
+
+		for _, f := range current {
+			if visited[f.typ] {
+				continue
+			}
+
+			visited[f.typ] = true
+
+			for i := range f.typ.NumField() {
+				sf := f.typ.Field(i)
+
+				if sf.Anonymous {
+					et := sf.Type
+					if et.Kind() == reflect.Pointer {
+						et = et.Elem()
+					}
+
+					// An embedded unexported struct still promotes its
+					// exported fields; any other unexported embed is skipped.
+					if !sf.IsExported() && et.Kind() != reflect.Struct {
+						continue
+					}
+				}
+
+				info := salvageName(sf, jsontag.Parse(sf))
+				if info.JSONName == "" {
+					continue
+				}
+
+				index := append(slices.Clip(f.index), i)
+
+				ft := sf.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Pointer {
+					ft = ft.Elem()
+				}
+
+				if info.TaggedName || !sf.Anonymous || ft.Kind() != reflect.Struct {
+					fields = append(fields, field{
+						name:      info.JSONName,
+						tag:       info.TaggedName,
+						index:     index,
+						typ:       ft,
+						omitEmpty: info.Omitempty,
+						omitZero:  info.Omitzero,
+						isZero:    zeroFunc(info.Omitzero, sf.Type),
+						quoted:    info.JSONString && quotable(ft.Kind()),
+					})
+
+					// A type embedded more than once at this depth records
+					// each field twice so the tie below drops it.
+					if count[f.typ] > 1 {
+						fields = append(fields, fields[len(fields)-1])
+					}
+
+					continue
+				}
+
+				nextCount[ft]++
+				if nextCount[ft] == 1 {
+					next = append(next, field{name: ft.Name(), index: index, typ: ft})
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(fields, func(a, b field) int {
+		if c := strings.Compare(a.name, b.name); c != 0 {
+			return c
+		}
+
+		if c := cmp.Compare(len(a.index), len(b.index)); c != 0 {
+			return c
+		}
+
+		if a.tag != b.tag {
+			if a.tag {
+				return -1
+			}
+
+			return 1
+		}
+
+		return slices.Compare(a.index, b.index)
+	})
+
+	out := fields[:0]
+
+	for i := 0; i < len(fields); {
+		n := 1
+		for i+n < len(fields) && fields[i+n].name == fields[i].name {
+			n++
+		}
+
+		if n == 1 || len(fields[i].index) != len(fields[i+1].index) || fields[i].tag != fields[i+1].tag {
+			out = append(out, fields[i])
+		}
+
+		i += n
+	}
+
+	slices.SortFunc(out, func(a, b field) int { return slices.Compare(a.index, b.index) })
+
+	for i := range out {
+		out[i].encoder = typeEncoder(typeByIndex(t, out[i].index))
+	}
+
+	return out
+}
+
+// salvageName corrects info for a json tag name [jsontag.ValidName] rejects
+// but the running encoding/json keys the field by (see [salvagedName]); the
+// field then counts as tagged.
+func salvageName(sf reflect.StructField, info jsontag.Info) jsontag.Info {
+	name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+	if info.JSONName == "" || name == "" || jsontag.ValidName(name) {
+		return info
+	}
+
+	if salvaged := salvagedName(name); salvaged != "" {
+		info.JSONName, info.TaggedName = salvaged, true
+	}
+
+	return info
+}
+
+// quotable reports whether the ,string option applies to a field of kind k.
+func quotable(k reflect.Kind) bool {
+	switch k {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.String:
+		return true
+	default:
+		return false
+	}
+}
+
+// zeroFunc returns the omitzero test encoding/json uses for a field of type
+// t when the type has an IsZero method, or nil when reflect's zero test
+// applies.
+func zeroFunc(omitZero bool, t reflect.Type) func(reflect.Value) bool {
+	if !omitZero {
+		return nil
+	}
+
+	switch {
+	case t.Kind() == reflect.Interface && t.Implements(isZeroerType):
+		return func(v reflect.Value) bool {
+			return v.IsNil() ||
+				v.Elem().Kind() == reflect.Pointer && v.Elem().IsNil() ||
+				v.Interface().(isZeroer).IsZero() //nolint:forcetypeassert // t implements isZeroer.
+		}
+	case t.Kind() == reflect.Pointer && t.Implements(isZeroerType):
+		return func(v reflect.Value) bool {
+			return v.IsNil() || v.Interface().(isZeroer).IsZero() //nolint:forcetypeassert // t implements isZeroer.
+		}
+	case t.Implements(isZeroerType):
+		return func(v reflect.Value) bool {
+			return v.Interface().(isZeroer).IsZero() //nolint:forcetypeassert // t implements isZeroer.
+		}
+	case reflect.PointerTo(t).Implements(isZeroerType):
+		return func(v reflect.Value) bool {
+			if !v.CanAddr() {
+				v2 := reflect.New(v.Type()).Elem()
+				v2.Set(v)
+				v = v2
+			}
+
+			return v.Addr().Interface().(isZeroer).IsZero() //nolint:forcetypeassert // *t implements isZeroer.
+		}
+	default:
+		return nil
+	}
+}
+
+func typeByIndex(t reflect.Type, index []int) reflect.Type {
+	for _, i := range index {
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+
+		t = t.Field(i).Type
+	}
+
+	return t
+}
+
+func newMapEncoder(t reflect.Type) encoderFunc {
+	switch t.Key().Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+	default:
+		if !t.Key().Implements(textMarshalerType) {
+			return unsupportedKeyEncoder
+		}
+	}
+
+	elemEnc := typeEncoder(t.Elem())
+
+	return func(e *encodeState, v reflect.Value, opts encOpts) (any, error) {
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		leave, err := e.enter(v, func() any { return v.UnsafePointer() })
+		if err != nil {
+			return nil, err
+		}
+
+		defer leave()
+
+		// Resolve every key before encoding any value, then encode in key
+		// order, so the first error and a key collision's winner match
+		// encoding/json's sorted output.
+		type entry struct {
+			v  reflect.Value
+			ks string
+		}
+
+		entries := make([]entry, 0, v.Len())
+
+		for iter := v.MapRange(); iter.Next(); {
+			ks, err := resolveKeyName(iter.Key())
+			if err != nil {
+				return nil, fmt.Errorf("json: encoding error for type %q: %q", v.Type().String(), err.Error())
+			}
+
+			entries = append(entries, entry{ks: ks, v: iter.Value()})
+		}
+
+		slices.SortFunc(entries, func(a, b entry) int { return strings.Compare(a.ks, b.ks) })
+
+		obj := make(map[string]any, len(entries))
+
+		for _, kv := range entries {
+			x, err := elemEnc(e, kv.v, opts)
+			if err != nil {
+				return nil, err
+			}
+
+			obj[String(kv.ks)] = x
+		}
+
+		return obj, nil
+	}
+}
+
+// unsupportedKeyEncoder encodes a map whose key type encoding/json cannot
+// encode: an error, or where encoding/json rejects only a key it meets (see
+// [emptyMapsOfAnyKey]), null for a nil map and an empty object for an empty
+// one.
+func unsupportedKeyEncoder(e *encodeState, v reflect.Value, opts encOpts) (any, error) {
+	switch {
+	case !emptyMapsOfAnyKey || v.Len() > 0:
+		return unsupportedTypeEncoder(e, v, opts)
+	case v.IsNil():
+		return nil, nil
+	default:
+		return map[string]any{}, nil
+	}
+}
+
+func resolveKeyName(k reflect.Value) (string, error) {
+	if k.Kind() == reflect.String {
+		return k.String(), nil
+	}
+
+	if tm, ok := reflect.TypeAssert[encoding.TextMarshaler](k); ok {
+		if k.Kind() == reflect.Pointer && k.IsNil() {
+			return "", nil
+		}
+
+		buf, err := tm.MarshalText()
+
+		return string(buf), err //nolint:wrapcheck // Encoding/json quotes the message as is.
+	}
+
+	if k.CanInt() {
+		return strconv.FormatInt(k.Int(), 10), nil
+	}
+
+	return strconv.FormatUint(k.Uint(), 10), nil
+}
+
+func newSliceEncoder(t reflect.Type) encoderFunc {
+	// A byte slice is base64 text unless its element has marshal methods.
+	if t.Elem().Kind() == reflect.Uint8 {
+		p := reflect.PointerTo(t.Elem())
+		if !p.Implements(marshalerType) && !p.Implements(textMarshalerType) {
+			return encodeByteSlice
+		}
+	}
+
+	arrayEnc := newArrayEncoder(t)
+
+	return func(e *encodeState, v reflect.Value, opts encOpts) (any, error) {
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		leave, err := e.enter(v, func() any {
+			return struct {
+				ptr any
+				len int
+			}{v.UnsafePointer(), v.Len()}
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		defer leave()
+
+		return arrayEnc(e, v, opts)
+	}
+}
+
+func encodeByteSlice(_ *encodeState, v reflect.Value, _ encOpts) (any, error) {
+	if v.IsNil() {
+		return nil, nil
+	}
+
+	return base64.StdEncoding.EncodeToString(v.Bytes()), nil
+}
+
+func newArrayEncoder(t reflect.Type) encoderFunc {
+	elemEnc := typeEncoder(t.Elem())
+
+	return func(e *encodeState, v reflect.Value, opts encOpts) (any, error) {
+		arr := make([]any, v.Len())
+
+		for i := range arr {
+			x, err := elemEnc(e, v.Index(i), opts)
+			if err != nil {
+				return nil, err
+			}
+
+			arr[i] = x
+		}
+
+		return arr, nil
+	}
+}
+
+func newPtrEncoder(t reflect.Type) encoderFunc {
+	elemEnc := typeEncoder(t.Elem())
+
+	return func(e *encodeState, v reflect.Value, opts encOpts) (any, error) {
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		leave, err := e.enter(v, v.Interface)
+		if err != nil {
+			return nil, err
+		}
+
+		defer leave()
+
+		return elemEnc(e, v.Elem(), opts)
+	}
+}
@@ -0,0 +1,352 @@
+package jsonvalue_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonvalue"
+	"go.jacobcolvin.com/x/jsonschema/internal/normalize"
+)
+
+var errBroken = errors.New("broken")
+
+// ptrText marshals as text through a pointer receiver only.
+type ptrText int
+
+func (p *ptrText) MarshalText() ([]byte, error) {
+	return []byte("t" + strconv.Itoa(int(*p))), nil
+}
+
+// ptrJSON marshals itself through a pointer receiver only.
+type ptrJSON int
+
+func (p *ptrJSON) MarshalJSON() ([]byte, error) {
+	return []byte(`{"n":` + strconv.Itoa(int(*p)) + `}`), nil
+}
+
+// failText fails MarshalText.
+type failText struct{}
+
+func (failText) MarshalText() ([]byte, error) { return nil, errBroken }
+
+// failJSON fails MarshalJSON.
+type failJSON struct{}
+
+func (failJSON) MarshalJSON() ([]byte, error) { return nil, errBroken }
+
+// badJSON returns output that is not JSON.
+type badJSON struct{}
+
+func (badJSON) MarshalJSON() ([]byte, error) { return []byte(`{"a":`), nil }
+
+// textKey is a map key that marshals as text: its half, or an error when
+// negative.
+type textKey int
+
+func (k textKey) MarshalText() ([]byte, error) {
+	if k < 0 {
+		return nil, errBroken
+	}
+
+	return []byte(strconv.Itoa(int(k) / 2)), nil
+}
+
+// zeroer has a value-receiver IsZero that treats 7 as zero.
+type zeroer int
+
+func (z zeroer) IsZero() bool { return z == 7 }
+
+// ptrZeroer has a pointer-receiver IsZero that treats 7 as zero.
+type ptrZeroer int
+
+func (z *ptrZeroer) IsZero() bool { return *z == 7 }
+
+type inner struct {
+	A int `json:"a"`
+	B int
+}
+
+type hidden struct {
+	Promoted string `json:"promoted"`
+}
+
+type Left struct {
+	Same   string
+	Tagged string `json:"tagged"`
+}
+
+type Right struct {
+	Same   string
+	Tagged string
+}
+
+type embeds struct {
+	*inner
+	hidden
+	Left
+	Right
+
+	B string `json:"B"`
+}
+
+type options struct {
+	Int     int            `json:"int,string"`
+	Uint    uint8          `json:"uint,string"`
+	Float   float32        `json:"float,string"`
+	Bool    bool           `json:"bool,string"`
+	Str     string         `json:"str,string"`
+	Ptr     *int           `json:"ptr,string"`
+	Num     json.Number    `json:"num,string"`
+	Empty   string         `json:"empty,omitempty"`
+	Slice   []int          `json:"slice,omitempty"`
+	Map     map[string]int `json:"map,omitempty"`
+	Zero    zeroer         `json:"zero,omitzero"`
+	PtrZero ptrZeroer      `json:"ptrZero,omitzero"`
+	ZeroPtr *zeroer        `json:"zeroPtr,omitzero"`
+	Struct  inner          `json:"struct,omitzero"`
+	Skip    int            `json:"-"`
+	Dash    int            `json:"-,"`
+	Bad     int            `json:"a\\b"`
+}
+
+// badNames carries json tag names encoding/json's classic encoder discards,
+// which the v2-backed encoder of Go 1.27 keys some fields by.
+type badNames struct {
+	Symbol  int `json:"a😀"`
+	Control int `json:"c\x01"`
+	UTF8    int `json:"u\xff"`
+	Quote   int `json:"q'x_1,omitempty"`
+	Leading int `json:"'x"`
+	Digit   int `json:"9\\x"`
+}
+
+type marshalers struct {
+	Text     ptrText         `json:"text"`
+	JSON     ptrJSON         `json:"json"`
+	TextPtr  *ptrText        `json:"textPtr"`
+	Big      big.Int         `json:"big"`
+	Time     time.Time       `json:"time"`
+	Raw      json.RawMessage `json:"raw"`
+	Iface    any             `json:"iface"`
+	Marshal  json.Marshaler  `json:"marshal"`
+	Bytes    []byte          `json:"bytes"`
+	Array    [2]byte         `json:"array"`
+	TextKeys map[textKey]int `json:"textKeys"`
+	IntKeys  map[int8]string `json:"intKeys"`
+}
+
+type cycle struct {
+	Next *cycle `json:"next"`
+}
+
+type quotedFloat struct {
+	F float64 `json:"f,string"`
+}
+
+type withChan struct {
+	C chan int `json:"c,omitempty"`
+}
+
+func TestOf(t *testing.T) {
+	t.Parallel()
+
+	n := 5
+	pt := ptrText(3)
+	pj := ptrJSON(4)
+	z := zeroer(7)
+
+	tests := map[string]any{
+		"nil":              nil,
+		"scalars":          []any{true, 1, int8(-2), uint64(math.MaxUint64), 1.5, float32(0.1), 1e21, 1e-7, "s"},
+		"invalid utf8":     "a\xffb\xc0",
+		"html":             "<a&b> ",
+		"empty containers": map[string]any{"s": []int{}, "m": map[string]int{}, "n": []int(nil)},
+		"embeds":           &embeds{inner: &inner{A: 1, B: 2}, hidden: hidden{Promoted: "p"}, B: "outer"},
+		"nil embed":        &embeds{B: "outer"},
+		"options zero":     &options{},
+		"options set": &options{
+			Int: -1, Uint: 2, Float: 0.25, Bool: true, Str: `"<q>"`, Ptr: &n, Num: "12e3",
+			Empty: "e", Slice: []int{1}, Map: map[string]int{"k": 1},
+			Zero: 1, PtrZero: 7, ZeroPtr: &z, Struct: inner{A: 1}, Skip: 1, Dash: 2, Bad: 3,
+		},
+		"options by value": options{PtrZero: 7, Zero: 7},
+		"marshalers": &marshalers{
+			Text: 1, JSON: 2, TextPtr: &pt, Big: *big.NewInt(-9),
+			Raw: json.RawMessage(` [1, {"a":2}] `), Iface: &pt, Marshal: &pj,
+			Bytes: []byte("hi"), Array: [2]byte{1, 2},
+			TextKeys: map[textKey]int{1: 1, 2: 2, 4: 3}, IntKeys: map[int8]string{-1: "a", 3: "b"},
+		},
+		"marshalers by value": marshalers{Text: 1, JSON: 2, Iface: pt},
+		"map utf8 collision":  map[string]int{"a\xff": 1, "a\xfe": 2, "a�": 3},
+		"invalid tag names":   badNames{Symbol: 1, Control: 2, UTF8: 3, Quote: 4, Leading: 5, Digit: 6},
+		// Releases after Go 1.26 reject only a key they meet.
+		"empty map bad key type": map[[2]int]int{},
+		"nil map bad key type":   map[[2]int]int(nil),
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assertSame(t, v)
+		})
+	}
+}
+
+func TestOfErrors(t *testing.T) {
+	t.Parallel()
+
+	deep := &cycle{}
+	deep.Next = deep
+
+	nan := math.NaN()
+
+	tests := map[string]any{
+		"cycle":             deep,
+		"nan":               []float64{nan},
+		"float32 inf":       float32(math.Inf(-1)),
+		"quoted nan":        &quotedFloat{F: nan},
+		"chan":              &withChan{},
+		"func":              func() {},
+		"complex":           complex(1, 2),
+		"bad map key type":  map[[2]int]int{{1, 2}: 3},
+		"text error":        failText{},
+		"text error addr":   &struct{ T failText }{},
+		"json error":        &struct{ J failJSON }{},
+		"json invalid":      badJSON{},
+		"raw invalid":       json.RawMessage(`{`),
+		"number invalid":    json.Number("1x"),
+		"text key error":    map[textKey]int{-1: 1},
+		"error after value": &struct{ A, B any }{A: 1, B: make(chan int)},
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := json.Marshal(v)
+			require.Error(t, err)
+
+			assertSame(t, v)
+		})
+	}
+}
+
+func TestNumber(t *testing.T) {
+	t.Parallel()
+
+	for _, f := range []float64{0, math.Copysign(0, -1), 1e-6, 1e-7, 123456789, 1e20, 1e21, -3.25e-9} {
+		want, err := json.Marshal(f)
+		require.NoError(t, err)
+
+		got, uerr := jsonvalue.Number(f, 64)
+		require.Nil(t, uerr)
+		assert.Equal(t, string(want), string(got))
+
+		want, err = json.Marshal(float32(f))
+		require.NoError(t, err)
+
+		got, uerr = jsonvalue.Number(float64(float32(f)), 32)
+		require.Nil(t, uerr)
+		assert.Equal(t, string(want), string(got))
+	}
+}
+
+// assertSame asserts that Of(v) is what encoding/json makes of v under the
+// running release: the instance it marshals v to, decoded as the validator
+// decodes it, or the error it returns.
+func assertSame(t *testing.T, v any) {
+	t.Helper()
+
+	data, merr := json.Marshal(v)
+
+	got, err := jsonvalue.Of(v)
+	if merr != nil {
+		require.Error(t, err)
+		assert.Equal(t, merr.Error(), err.Error())
+
+		return
+	}
+
+	require.NoError(t, err)
+
+	want, err := normalize.DecodeJSONInstance(data)
+	require.NoError(t, err)
+	assert.Equal(t, want, got, "marshaled: %s", data)
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	pt := ptrText(1)
+	v := reflect.ValueOf(&struct {
+		embeds
+
+		Bytes []byte          `json:"bytes"`
+		Text  *ptrText        `json:"text"`
+		Keys  map[textKey]int `json:"keys"`
+		UTF8  map[string]int  `json:"utf8"`
+		Iface any             `json:"iface"`
+	}{
+		embeds: embeds{inner: &inner{A: 1}},
+		Bytes:  []byte("b"),
+		Text:   &pt,
+		Keys:   map[textKey]int{2: 1, 4: 2},
+		UTF8:   map[string]int{"a\xfe": 1, "a\xff": 2},
+		Iface:  []int{5},
+	})
+
+	m, ok := jsonvalue.Lookup(nil, v, "a", 0, false)
+	require.True(t, ok)
+	assert.Equal(t, "A", m.Field.Name)
+	assert.Equal(t, []int{0, 0, 0}, m.Field.Index)
+	require.Len(t, m.Embedded, 2)
+	assert.Equal(t, "embeds", m.Embedded[0].Name)
+	assert.Equal(t, "inner", m.Embedded[1].Name)
+
+	// Ambiguous at one depth: encoding/json drops the name.
+	_, ok = jsonvalue.Lookup(nil, v, "Same", 0, false)
+	assert.False(t, ok)
+
+	b, ok := jsonvalue.Lookup(nil, v, "bytes", 0, false)
+	require.True(t, ok)
+
+	_, ok = jsonvalue.Lookup(nil, b.Value, "", 0, true)
+	assert.False(t, ok, "a byte slice is base64 text")
+
+	text, ok := jsonvalue.Lookup(nil, v, "text", 0, false)
+	require.True(t, ok)
+
+	_, ok = jsonvalue.Lookup(nil, text.Value, "", 0, true)
+	assert.False(t, ok, "a marshaler's output has no Go members")
+
+	keys, ok := jsonvalue.Lookup(nil, v, "keys", 0, false)
+	require.True(t, ok)
+
+	k, ok := jsonvalue.Lookup(nil, keys.Value, "1", 0, false)
+	require.True(t, ok)
+	assert.Equal(t, textKey(2), k.Key.Interface())
+
+	utf8, ok := jsonvalue.Lookup(nil, v, "utf8", 0, false)
+	require.True(t, ok)
+
+	k, ok = jsonvalue.Lookup(nil, utf8.Value, "a\uFFFD", 0, false)
+	require.True(t, ok)
+	assert.Equal(t, "a\xff", k.Key.Interface(), "the last key in sorted order wins a collision")
+
+	iface, ok := jsonvalue.Lookup(nil, v, "iface", 0, false)
+	require.True(t, ok)
+
+	e, ok := jsonvalue.Lookup(nil, iface.Value, "", 0, true)
+	require.True(t, ok)
+	assert.Equal(t, 5, e.Value.Interface())
+}
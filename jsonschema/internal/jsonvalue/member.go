@@ -0,0 +1,215 @@
+package jsonvalue
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/fieldname"
+)
+
+// Member is the Go origin of one member of the instance [Of] returns: the
+// struct field, map entry, or slice or array element its JSON came from.
+type Member struct {
+	// Value is the Go value the member's JSON was produced from.
+	Value reflect.Value
+
+	// Key is the map key of a map member; the zero Value otherwise.
+	Key reflect.Value
+
+	// Field is the struct field of a struct member, with Index holding the
+	// full index sequence from the enclosing struct as
+	// [reflect.Type.FieldByName] reports it; the zero StructField otherwise.
+	Field reflect.StructField
+
+	// Embedded holds the embedded fields a promoted struct member is
+	// reached through, outermost first.
+	Embedded []reflect.StructField
+}
+
+var fieldCache sync.Map // map[reflect.Type][]field
+
+// cachedTypeFields returns typeFields(t), computed once per type.
+func cachedTypeFields(t reflect.Type) []field {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.([]field) //nolint:forcetypeassert // The cache holds only field slices.
+	}
+
+	f, _ := fieldCache.LoadOrStore(t, typeFields(t))
+
+	return f.([]field) //nolint:forcetypeassert // The cache holds only field slices.
+}
+
+// HasField reports whether struct type t encodes a member named name: a
+// field encoding/json would emit under that key, promoted fields included.
+func HasField(t reflect.Type, name string) bool {
+	return slices.ContainsFunc(cachedTypeFields(t), func(f field) bool { return f.name == name })
+}
+
+// Lookup returns the Go origin of the member of v's instance named by key,
+// or by index when isIndex is true. It reports false when the member does
+// not exist, or when v's instance comes from a MarshalJSON or MarshalText
+// method, whose output has no Go origin finer than v itself. Pointers and
+// interfaces are followed as the walk follows them; v must be a value the
+// walk reached, so addressability decides method choice the same way. A
+// non-nil d resolves members as [OfDialect] encodes them.
+func Lookup(d *fieldname.Dialect, v reflect.Value, key string, index int, isIndex bool) (Member, bool) {
+	v, ok := container(d, v)
+	if !ok {
+		return Member{}, false
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if isIndex {
+			return Member{}, false
+		}
+
+		if d != nil {
+			return dialectStructMember(d, v, key)
+		}
+
+		return structMember(v, key)
+	case reflect.Map:
+		if isIndex {
+			return Member{}, false
+		}
+
+		return mapMember(v, key)
+	case reflect.Slice, reflect.Array:
+		if !isIndex || index < 0 || index >= v.Len() || encodesAsBytes(d, v.Type()) {
+			return Member{}, false
+		}
+
+		return Member{Value: v.Index(index)}, true
+	default:
+		return Member{}, false
+	}
+}
+
+// container follows pointers and interfaces from v to the value whose own
+// encoder produces its instance, reporting false at a nil or at a value
+// encoded by a marshaler method.
+func container(d *fieldname.Dialect, v reflect.Value) (reflect.Value, bool) {
+	for v.IsValid() {
+		t := v.Type()
+
+		byMethod := encodedByMethod(v)
+
+		if d != nil {
+			switch d.Values {
+			case fieldname.ValuesYAML:
+				byMethod = yamlEncodedByMethod(v)
+			case fieldname.ValuesJSONv2:
+				byMethod = jsonv2EncodedByMethod(t)
+			}
+		}
+
+		if byMethod {
+			return reflect.Value{}, false
+		}
+
+		if t.Kind() != reflect.Pointer && t.Kind() != reflect.Interface {
+			return v, true
+		}
+
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+
+		v = v.Elem()
+	}
+
+	return reflect.Value{}, false
+}
+
+// isByteSlice reports whether the walk encodes slice type t as base64 text.
+func isByteSlice(t reflect.Type) bool {
+	if t.Kind() != reflect.Slice || t.Elem().Kind() != reflect.Uint8 {
+		return false
+	}
+
+	p := reflect.PointerTo(t.Elem())
+
+	return !p.Implements(marshalerType) && !p.Implements(textMarshalerType)
+}
+
+func structMember(v reflect.Value, key string) (Member, bool) {
+	fields := cachedTypeFields(v.Type())
+
+	i := slices.IndexFunc(fields, func(f field) bool { return f.name == key })
+	if i < 0 {
+		return Member{}, false
+	}
+
+	f := &fields[i]
+
+	var (
+		embedded []reflect.StructField
+		sf       reflect.StructField
+	)
+
+	fv := v
+	for n, j := range f.index {
+		if n > 0 {
+			embedded = append(embedded, sf)
+		}
+
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				return Member{}, false
+			}
+
+			fv = fv.Elem()
+		}
+
+		sf = fv.Type().Field(j)
+		fv = fv.Field(j)
+	}
+
+	sf.Index = slices.Clone(f.index)
+
+	return Member{Value: fv, Field: sf, Embedded: embedded}, true
+}
+
+// mapMember finds the entry whose resolved key name is key. When several
+// keys resolve to one name, the walk keeps the last in sorted order, and so
+// does mapMember.
+func mapMember(v reflect.Value, key string) (Member, bool) {
+	var (
+		found  Member
+		foundK string
+		ok     bool
+	)
+
+	for iter := v.MapRange(); iter.Next(); {
+		ks, err := resolveKeyName(iter.Key())
+		if err != nil || String(ks) != key {
+			continue
+		}
+
+		if ok && strings.Compare(ks, foundK) < 0 {
+			continue
+		}
+
+		found = Member{Value: iter.Value(), Key: iter.Key()}
+		foundK = ks
+		ok = true
+	}
+
+	return found, ok
+}
+
+// encodesAsBytes reports whether a slice or array of type t encodes as one
+// string under dialect d, leaving no element to address.
+func encodesAsBytes(d *fieldname.Dialect, t reflect.Type) bool {
+	switch {
+	case d == nil || d.Values == fieldname.ValuesJSON:
+		return isByteSlice(t)
+	case d.Values == fieldname.ValuesJSONv2:
+		return fieldname.IsJSONv2Bytes(t)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,21 @@
+//go:build !(go1.27 && goexperiment.jsonv2)
+
+package jsonvalue
+
+// emptyMapsOfAnyKey reports whether encoding/json marshals an empty map whose
+// key type it cannot encode. The classic encoder rejects the map type itself.
+const emptyMapsOfAnyKey = false
+
+// salvagedName returns the key encoding/json gives a field whose json tag
+// names it with name, which jsontag.ValidName rejects, or "" when the field
+// keeps its Go name. The classic encoder discards every such name.
+func salvagedName(string) string {
+	return ""
+}
+
+// marshalError returns the error encoding/json reports marshaling v, which
+// the walk found fails with err. The walk reproduces the classic encoder's
+// errors, so err is that error.
+func marshalError(_ any, err error) error {
+	return err
+}
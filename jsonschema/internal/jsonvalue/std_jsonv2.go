@@ -0,0 +1,53 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package jsonvalue
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// From Go 1.27 the jsonv2 experiment is on by default, and encoding/json is
+// implemented on top of encoding/json/v2. The rules below are where that
+// implementation departs from the classic encoder.
+
+// emptyMapsOfAnyKey reports whether encoding/json marshals an empty map whose
+// key type it cannot encode. The v2-backed encoder rejects only a key it
+// meets.
+const emptyMapsOfAnyKey = true
+
+// salvagedName returns the key encoding/json gives a field whose json tag
+// names it with name, which jsontag.ValidName rejects, or "" when the field
+// keeps its Go name. The v2-backed encoder keeps a name without a backslash,
+// quote or backtick whole (invalid UTF-8 replaced), and otherwise keys the
+// field by the name's leading Go identifier, if it starts with one.
+func salvagedName(name string) string {
+	if !strings.ContainsAny(name, "\\'\"`") {
+		return string([]rune(name))
+	}
+
+	r, _ := utf8.DecodeRuneInString(name)
+	if r != '_' && !unicode.IsLetter(r) {
+		return ""
+	}
+
+	return name[:len(name)-len(strings.TrimLeftFunc(name, func(r rune) bool {
+		return r == '_' || unicode.IsLetter(r) || unicode.IsNumber(r)
+	}))]
+}
+
+// marshalError returns the error encoding/json reports marshaling v, which
+// the walk found fails with err. The v2-backed encoder words its errors
+// differently, some with the output offset the failure occurred at, so on
+// this failure path alone encoding/json is asked; should it succeed after
+// all, err stands.
+func marshalError(v any, err error) error {
+	_, merr := json.Marshal(v)
+	if merr != nil {
+		return merr //nolint:wrapcheck // The error is encoding/json's own.
+	}
+
+	return err
+}
@@ -0,0 +1,264 @@
+// Package numcheck asserts the JSON Schema numeric bound keywords
+// (multipleOf, minimum, maximum, exclusiveMinimum, exclusiveMaximum) against a
+// numeric instance. The validation walk and the runtime of generated
+// validators share it, so both report the same keywords with the same
+// messages; the callers keep the error construction.
+package numcheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/keyword"
+	"go.jacobcolvin.com/x/jsonschema/internal/numrat"
+)
+
+// Bounds holds a schema's numeric bound keywords twice: as the float64 values
+// the messages print, and as rationals converted once up front so every check
+// reuses them instead of re-parsing the float64 bounds per instance. A nil
+// rational with a non-nil float64 denotes a NaN/Inf bound that has no rational
+// form (mirroring [numrat.Float64ToRat]). The rationals are operands only:
+// comparisons read them and never mutate them, so a Bounds is safe to share
+// across concurrent checks.
+type Bounds struct {
+	multipleOf       *float64
+	minimum          *float64
+	maximum          *float64
+	exclusiveMinimum *float64
+	exclusiveMaximum *float64
+
+	multipleOfRat       *big.Rat
+	minimumRat          *big.Rat
+	maximumRat          *big.Rat
+	exclusiveMinimumRat *big.Rat
+	exclusiveMaximumRat *big.Rat
+}
+
+// New returns the Bounds for the given keyword values, or nil when every
+// keyword is absent so a caller caches an entry only for schemas that
+// constrain numbers.
+func New(multipleOf, minimum, maximum, exclusiveMinimum, exclusiveMaximum *float64) *Bounds {
+	if multipleOf == nil && minimum == nil && maximum == nil &&
+		exclusiveMinimum == nil && exclusiveMaximum == nil {
+		return nil
+	}
+
+	return &Bounds{
+		multipleOf:          multipleOf,
+		minimum:             minimum,
+		maximum:             maximum,
+		exclusiveMinimum:    exclusiveMinimum,
+		exclusiveMaximum:    exclusiveMaximum,
+		multipleOfRat:       toRat(multipleOf),
+		minimumRat:          toRat(minimum),
+		maximumRat:          toRat(maximum),
+		exclusiveMinimumRat: toRat(exclusiveMinimum),
+		exclusiveMaximumRat: toRat(exclusiveMaximum),
+	}
+}
+
+func toRat(f *float64) *big.Rat {
+	if f == nil {
+		return nil
+	}
+
+	return numrat.Float64ToRat(*f)
+}
+
+// Check asserts every bound against instance, calling report once per
+// violated keyword in keyword order. A non-numeric instance (or a nil b)
+// asserts nothing.
+//
+// A JSON number is decomposed exactly once. An over-cap literal (the DoS
+// guard) takes the magnitude-class comparison without a second scan of the
+// literal; an unparseable one has no value to compare and fails every present
+// bound keyword closed; an exactly-comparable one yields the rational the
+// bounded checks use. A float64 converts through its shortest decimal, and a
+// non-finite float yields no rational and likewise fails closed.
+func (b *Bounds) Check(instance any, report func(keyword, msg string)) {
+	if b == nil || !numrat.IsNumeric(instance) {
+		return
+	}
+
+	var val *big.Rat
+
+	switch n := instance.(type) {
+	case json.Number:
+		d, ok := numrat.ParseDecNumber(string(n))
+		if !ok {
+			b.checkNonComparable(fmt.Sprintf("%q", string(n)), report)
+
+			return
+		}
+
+		if !d.ExactlyComparable() {
+			b.checkUnbounded(d, string(n), report)
+
+			return
+		}
+
+		val = d.Rat()
+
+	default:
+		var ok bool
+
+		val, ok = numrat.ToBigRat(instance)
+		if !ok {
+			b.checkNonComparable(fmt.Sprintf("%v", instance), report)
+
+			return
+		}
+	}
+
+	if b.multipleOf != nil {
+		switch {
+		case *b.multipleOf <= 0:
+			// MultipleOf MUST be strictly greater than 0; a non-positive
+			// divisor makes the schema invalid. Compile rejects it wherever
+			// its vetting reaches, so this is a backstop for a schema outside
+			// that coverage.
+			report(keyword.MultipleOf, fmt.Sprintf("multipleOf must be greater than 0, got %v", *b.multipleOf))
+
+		default:
+			// A NaN/Inf divisor has no rational form; the constraint cannot
+			// apply, so skip it rather than dividing by a nil *big.Rat. Quo
+			// writes its own receiver, so the shared divisor stays an operand
+			// and is never mutated.
+			if divisor := b.multipleOfRat; divisor != nil {
+				quotient := new(big.Rat).Quo(val, divisor)
+				if !quotient.IsInt() {
+					report(
+						keyword.MultipleOf,
+						fmt.Sprintf("%s is not a multiple of %v", numrat.RatString(val), *b.multipleOf),
+					)
+				}
+			}
+		}
+	}
+
+	// A nil bound denotes a NaN/Inf value with no rational form; such a bound
+	// cannot constrain a finite instance, so the comparison is skipped.
+	if b.minimum != nil {
+		if bound := b.minimumRat; bound != nil && val.Cmp(bound) < 0 {
+			report(keyword.Minimum, fmt.Sprintf("%s is less than %v", numrat.RatString(val), *b.minimum))
+		}
+	}
+
+	if b.maximum != nil {
+		if bound := b.maximumRat; bound != nil && val.Cmp(bound) > 0 {
+			report(keyword.Maximum, fmt.Sprintf("%s is greater than %v", numrat.RatString(val), *b.maximum))
+		}
+	}
+
+	if b.exclusiveMinimum != nil {
+		if bound := b.exclusiveMinimumRat; bound != nil && val.Cmp(bound) <= 0 {
+			report(
+				keyword.ExclusiveMinimum,
+				fmt.Sprintf("%s is less than or equal to %v", numrat.RatString(val), *b.exclusiveMinimum),
+			)
+		}
+	}
+
+	if b.exclusiveMaximum != nil {
+		if bound := b.exclusiveMaximumRat; bound != nil && val.Cmp(bound) >= 0 {
+			report(
+				keyword.ExclusiveMaximum,
+				fmt.Sprintf("%s is greater than or equal to %v", numrat.RatString(val), *b.exclusiveMaximum),
+			)
+		}
+	}
+}
+
+// checkUnbounded checks the bounds for a [json.Number] whose exact expansion
+// is too expensive (see [numrat.MaxNumberLen]): a huge magnitude (exponent
+// above the cap), a tiny magnitude (exponent below the negative cap), or a
+// significand longer than the cap. Every such value still orders
+// deterministically against any float64 bound via [numrat.DecNumber.CmpRat],
+// and equality with a bound is impossible, so the inclusive and exclusive
+// variants of each bound coincide. An over-cap integer still has its
+// multipleOf divisibility enforced through modular arithmetic (see
+// [numrat.IntegerMultipleOf]); only an over-cap non-integer skips it, since
+// expanding its fractional part is unbounded. The schema-validity check (a
+// non-positive divisor) fires regardless. A zero value is always
+// ExactlyComparable, so it never reaches this path.
+func (b *Bounds) checkUnbounded(d numrat.DecNumber, literal string, report func(keyword, msg string)) {
+	num := numrat.TruncateNumber(literal)
+
+	if b.multipleOf != nil {
+		switch {
+		case *b.multipleOf <= 0:
+			report(keyword.MultipleOf, fmt.Sprintf("multipleOf must be greater than 0, got %v", *b.multipleOf))
+		case b.multipleOfRat != nil && d.IsIntegral() &&
+			!numrat.IntegerMultipleOf(d, literal, b.multipleOfRat):
+			report(keyword.MultipleOf, fmt.Sprintf("%s is not a multiple of %v", num, *b.multipleOf))
+		}
+	}
+
+	if b.minimum != nil {
+		if r := b.minimumRat; r != nil && d.CmpRat(r) < 0 {
+			report(keyword.Minimum, fmt.Sprintf("%s is less than %v", num, *b.minimum))
+		}
+	}
+
+	if b.maximum != nil {
+		if r := b.maximumRat; r != nil && d.CmpRat(r) > 0 {
+			report(keyword.Maximum, fmt.Sprintf("%s is greater than %v", num, *b.maximum))
+		}
+	}
+
+	if b.exclusiveMinimum != nil {
+		// On the unbounded path CmpRat never reports equality (an over-cap value
+		// cannot equal the finite float64 bound), so the violation is always a
+		// strict inequality; the message omits the "or equal to" the bounded path
+		// uses, where equality is reachable.
+		if r := b.exclusiveMinimumRat; r != nil && d.CmpRat(r) < 0 {
+			report(keyword.ExclusiveMinimum, fmt.Sprintf("%s is less than %v", num, *b.exclusiveMinimum))
+		}
+	}
+
+	if b.exclusiveMaximum != nil {
+		if r := b.exclusiveMaximumRat; r != nil && d.CmpRat(r) > 0 {
+			report(keyword.ExclusiveMaximum, fmt.Sprintf("%s is greater than %v", num, *b.exclusiveMaximum))
+		}
+	}
+}
+
+// checkNonComparable reports every present bound keyword as violated by an
+// instance that carries no numeric value to compare: a non-finite float64
+// (NaN or an infinity, which JSON cannot represent) or a [json.Number] whose
+// literal is not a valid JSON number. Such a value still passes a bare type
+// assertion, but a bound written to constrain a number fails closed against
+// it rather than being silently skipped: +Inf under a maximum, or an
+// unparseable literal under a minimum, must not validate. A non-positive
+// multipleOf keeps its schema-validity message, which is independent of the
+// instance value.
+func (b *Bounds) checkNonComparable(desc string, report func(keyword, msg string)) {
+	noValue := func(kw string) {
+		report(kw, fmt.Sprintf("%s has no numeric value to compare with %s", desc, kw))
+	}
+
+	if b.multipleOf != nil {
+		if *b.multipleOf <= 0 {
+			report(keyword.MultipleOf, fmt.Sprintf("multipleOf must be greater than 0, got %v", *b.multipleOf))
+		} else {
+			noValue(keyword.MultipleOf)
+		}
+	}
+
+	if b.minimum != nil {
+		noValue(keyword.Minimum)
+	}
+
+	if b.maximum != nil {
+		noValue(keyword.Maximum)
+	}
+
+	if b.exclusiveMinimum != nil {
+		noValue(keyword.ExclusiveMinimum)
+	}
+
+	if b.exclusiveMaximum != nil {
+		noValue(keyword.ExclusiveMaximum)
+	}
+}
@@ -27,7 +27,7 @@ import (
 // schema bound or const: a float64's exact decimal expansion has at most ~767
 // significant digits and a decimal exponent within about ±324, far inside the
 // cap. Such numbers are compared by magnitude class and truncated significand
-// instead of being expanded (see validateNumericUnbounded).
+// instead of being expanded (see numcheck.Bounds.Check).
 const MaxNumberLen = 4096
 
 // decExpClamp caps the parsed decimal exponent so arithmetic on it cannot
@@ -454,7 +454,7 @@ func (d DecNumber) IsIntegral() bool {
 // ExactlyComparable reports whether the value can be expanded into a [big.Rat]
 // at bounded cost: at most MaxNumberLen significant digits scaled by at most
 // MaxNumberLen decimal places. Values outside these bounds are compared by
-// magnitude class instead (see validateNumericUnbounded) and can never equal a
+// magnitude class instead (see numcheck.Bounds.Check) and can never equal a
 // float64 or integer (see equalGuarded).
 func (d DecNumber) ExactlyComparable() bool {
 	return len(d.sig) <= MaxNumberLen && d.exp <= MaxNumberLen && d.exp >= -MaxNumberLen
@@ -699,7 +699,7 @@ func ToBigRat(v any) (*big.Rat, bool) {
 	case json.Number:
 		// DoS guard: decompose canonically (O(n), no exponent expansion) and
 		// expand into a rational only when that is provably cheap. Anything
-		// else is reported unparseable so validateNumeric falls back to the
+		// else is reported unparseable so the numeric check falls back to the
 		// magnitude-class comparison.
 		d, ok := ParseDecNumber(string(val))
 		if !ok || !d.ExactlyComparable() {
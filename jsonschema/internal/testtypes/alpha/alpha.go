@@ -96,3 +96,156 @@ type KnotRoot struct {
 	A Knot      `json:"a" jsonschema:"type=string"`
 	B beta.Knot `json:"b"`
 }
+
+// Phase is a string enumeration for the const-block enum tests.
+type Phase string
+
+const (
+	// PhasePending documents the pending phase.
+	PhasePending Phase = "Pending"
+	PhaseRunning Phase = "Running" // documents the running phase
+	PhaseDone    Phase = "Done"
+
+	// PhaseDefault aliases PhasePending, so the value is listed once.
+	PhaseDefault = PhasePending
+)
+
+// Priority is an iota integer enumeration for the const-block enum tests.
+type Priority int
+
+const (
+	PriorityLow Priority = iota + 1
+	_
+	PriorityHigh
+)
+
+// Shade is an integer enumeration that marshals as text, for the const-block
+// enum tests.
+type Shade uint8
+
+const (
+	ShadeLight Shade = iota
+	ShadeDark
+)
+
+// MarshalText spells the shade's name.
+func (s *Shade) MarshalText() ([]byte, error) {
+	if *s == ShadeDark {
+		return []byte("dark"), nil
+	}
+
+	return []byte("light"), nil
+}
+
+// Palette holds the const-block enum test types.
+type Palette struct {
+	Phase    Phase    `json:"phase"`
+	Priority Priority `json:"priority"`
+	Shade    Shade    `json:"shade"`
+	Size     int      `json:"size"`
+}
+
+// Replicas is a bounded count for the comment-marker tests.
+// +kubebuilder:validation:Minimum=1
+// +kubebuilder:validation:Maximum=10
+type Replicas int32
+
+// Workload holds the comment-marker test fields.
+// +kubebuilder:object:root=true
+type Workload struct {
+	// Name is the workload name.
+	// +kubebuilder:validation:Pattern=`^[a-z]+$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Mode selects the rollout mode.
+	// +kubebuilder:validation:Enum=Recreate;RollingUpdate
+	// +kubebuilder:default=RollingUpdate
+	// +optional
+	Mode string `json:"mode"`
+
+	// Ports lists the exposed ports.
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	Ports []uint16 `json:"ports"`
+
+	// Replicas is the desired count.
+	// +kubebuilder:validation:ExclusiveMaximum=true
+	// +kubebuilder:validation:Maximum=5
+	Replicas Replicas `json:"replicas,omitempty"`
+
+	// Timeout is in seconds.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=30
+	Timeout *uint8 `json:"timeout,omitempty"`
+}
+
+// Misspelled carries a marker the interpreter does not know.
+type Misspelled struct {
+	// +kubebuilder:validation:Minimun=1
+	Count int `json:"count"`
+}
+
+// Bounds carries prose lines that start with a plus sign beside a marker.
+type Bounds struct {
+	// Limit caps the value.
+	// +Inf is accepted as a value, and so is
+	// +e.g. the largest float.
+	// +kubebuilder:validation:Minimum=0
+	Limit float64 `json:"limit"`
+}
+
+// NegativeUnsigned carries a marker contradicting its field's Go kind.
+type NegativeUnsigned struct {
+	// +kubebuilder:validation:Maximum=-1
+	Count uint `json:"count"`
+}
+
+// Reenumerated carries two enum markers, which conflict.
+type Reenumerated struct {
+	// +kubebuilder:validation:Enum=a;b
+	// +kubebuilder:validation:Enum=c
+	Letter string `json:"letter"`
+}
+
+// Revision is a revision number that may be null.
+// +nullable
+type Revision int
+
+// Manifest carries the markers the interpreter maps to null or records as
+// skipped.
+type Manifest struct {
+	// Note may be null.
+	// +nullable
+	Note string `json:"note"`
+
+	// Revision is nullable through its type.
+	Revision Revision `json:"revision"`
+
+	// Spec is checked by CEL.
+	// +kubebuilder:validation:XValidation:rule="self.size() > 0",message="spec is empty"
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +protobuf.options.(gogoproto.goproto_stringer)=false
+	Spec map[string]string `json:"spec"`
+}
+
+// Legacy is a documented type for the structured doc-comment tests. It
+// links to [Widget] and [beta.Knot], and lists:
+//
+//   - one
+//   - two
+//
+// Deprecated: use [Widget] instead.
+type Legacy struct {
+	// Mode selects the legacy mode.
+	//
+	// Deprecated: Mode is ignored.
+	Mode string `json:"mode"`
+
+	// Name is the legacy name. A jsonschema tag also sets a description,
+	// which keeps the Markdown rendering of this comment off the schema.
+	Name string `json:"name" jsonschema:"description=tag wins"`
+}
@@ -0,0 +1,161 @@
+// Package plain provides plain data types for the jsonschema package's
+// differential test of static and reflection-based generation. It exists as a
+// real (non-test) source package so that the test can load its types via
+// go/packages as well as reflect on them.
+package plain
+
+import (
+	"encoding/json"
+	"log/slog"
+	"math/big"
+	"time"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/testtypes/beta"
+)
+
+// Scalars holds a field of every scalar kind.
+type Scalars struct {
+	Err     error
+	Any     any
+	S       string
+	I       int
+	I8      int8
+	I16     int16
+	I32     int32
+	I64     int64
+	U       uint
+	U8      uint8
+	U16     uint16
+	U32     uint32
+	U64     uint64
+	Uintptr uintptr
+	F32     float32
+	F64     float64
+	B       bool
+}
+
+// Mode is a named string.
+type Mode string
+
+// Level is a named fixed-width integer.
+type Level int8
+
+// Alias is an alias of a named type.
+type Alias = Mode
+
+// Collections holds slices, arrays, maps, and pointers.
+type Collections struct {
+	Counts  map[string]int  `json:"counts,omitempty"`
+	ByID    map[int64]*Item `json:"byID"`
+	ByIndex map[uint16]Mode
+	Ptr     *int
+	PtrPtr  **string
+	Strings []string `json:"strings"`
+	Bytes   []byte   `json:"bytes"`
+	Raw     json.RawMessage
+	Nested  [][]Level
+	Aliases []Alias
+	Pair    [2]float64
+}
+
+// Item exercises the json and jsonschema tags and the built-in overrides.
+type Item struct {
+	When    time.Time  `json:"when"`
+	Since   *time.Time `json:"since,omitempty"`
+	Big     *big.Int
+	Ratio   big.Rat
+	Count   *int   `json:"count,string,omitempty"`
+	Name    string `json:"name" jsonschema:"description=Item name,minLength=1"`
+	Hidden  string `json:"-"`
+	Dash    string `json:"-,"`
+	Mode    Mode   `json:"mode" jsonschema:"enum=a|b"`
+	Num     json.Number
+	secret  string   //nolint:unused // An unexported field is skipped.
+	Tags    []string `json:"tags,omitzero" jsonschema:"maxItems=5"`
+	Price   float64  `json:"price,string" jsonschema:"description=Unit price"`
+	Timeout time.Duration
+	Lvl     slog.Level
+	Small   int8 `json:"small" jsonschema:"maximum=100"`
+}
+
+// Base is embedded by Outer.
+type Base struct {
+	ID   string `json:"id"`
+	Kind string
+}
+
+type meta struct {
+	Version int `json:"version"`
+}
+
+// Labeled is embedded by Outer under an explicit name.
+type Labeled struct {
+	Label string
+}
+
+// Outer embeds structs directly, through a pointer, unexported, and under an
+// explicit json name, and shadows a promoted field.
+type Outer struct {
+	Base
+	*meta
+	Labeled `json:"labeled"`
+	Kind    int
+	Extra   string `json:"extra,omitempty"`
+}
+
+// Left collides with Right on its promoted names.
+type Left struct {
+	A string `json:"A"`
+	B string
+}
+
+// Right collides with Left on its promoted names.
+type Right struct {
+	A int
+	B int
+}
+
+// Ambiguous embeds Left and Right at one depth: the tagged A wins its tie
+// and B is dropped.
+type Ambiguous struct {
+	Left
+	Right
+}
+
+// Tree is a self-referential struct.
+type Tree struct {
+	Parent   *Tree  `json:"parent,omitempty"`
+	Children []Tree `json:"children"`
+	Value    int    `json:"value"`
+}
+
+// List is a self-referential named slice.
+type List []List
+
+// Graph reaches mutually recursive types and a recursive container.
+type Graph struct {
+	Nodes map[string]*Node
+	Root  List
+}
+
+// Node is reached from Graph and refers to itself.
+type Node struct {
+	Meta  map[string]any
+	Graph *Graph
+	Edges []*Node
+}
+
+// Matrix is a named array of arrays.
+type Matrix [3][2]int8
+
+// Anonymous holds unnamed struct types.
+type Anonymous struct {
+	Pointer *struct{ Z int }
+	Point   struct{ X, Y float64 } `json:"point"`
+}
+
+// Widget collides by name with beta.Widget, so both are disambiguated.
+type Widget struct {
+	Other beta.Widget `json:"other"`
+	Size  int         `json:"size"`
+}
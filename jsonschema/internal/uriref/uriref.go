@@ -189,6 +189,60 @@ func removeDotSegments(path string) string {
 	return string(out)
 }
 
+// Relative returns a reference that resolves against base to target, the
+// inverse of [ResolveURI]: the shortest dot-segment path between two
+// hierarchical URIs of one scheme and authority, with the target's query and
+// fragment. Any other pair, an opaque URN included, yields target itself.
+func Relative(base, target string) string {
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return target
+	}
+
+	targetURL, err := url.Parse(target)
+	if err != nil {
+		return target
+	}
+
+	basePath, targetPath := baseURL.EscapedPath(), targetURL.EscapedPath()
+
+	if baseURL.Opaque != "" || targetURL.Opaque != "" ||
+		baseURL.Scheme != targetURL.Scheme || baseURL.Host != targetURL.Host ||
+		baseURL.User.String() != targetURL.User.String() ||
+		strings.HasPrefix(basePath, "/") != strings.HasPrefix(targetPath, "/") {
+		return target
+	}
+
+	// The last base segment is the document itself; only its directories
+	// take part in the walk.
+	dirs := strings.Split(basePath, "/")
+	dirs = dirs[:len(dirs)-1]
+	segs := strings.Split(targetPath, "/")
+
+	common := 0
+	for common < len(dirs) && common < len(segs)-1 && dirs[common] == segs[common] {
+		common++
+	}
+
+	ref := strings.Repeat("../", len(dirs)-common) + strings.Join(segs[common:], "/")
+
+	// A colon in the first segment would read as a scheme.
+	first, _, _ := strings.Cut(ref, "/")
+	if ref == "" || strings.Contains(first, ":") {
+		ref = "./" + ref
+	}
+
+	if targetURL.RawQuery != "" {
+		ref += "?" + targetURL.RawQuery
+	}
+
+	if targetURL.Fragment != "" {
+		ref += "#" + targetURL.EscapedFragment()
+	}
+
+	return ref
+}
+
 // StripFragment removes the fragment component from a URI.
 func StripFragment(uri string) string {
 	parsed, err := url.Parse(uri)
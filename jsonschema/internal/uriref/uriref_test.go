@@ -215,6 +215,63 @@ func TestStripFragment(t *testing.T) {
 	}
 }
 
+func TestRelative(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		base   string
+		target string
+		want   string
+	}{
+		"sibling": {
+			base:   "https://example.com/schemas/api.json",
+			target: "https://example.com/schemas/types.json#/$defs/User",
+			want:   "types.json#/$defs/User",
+		},
+		"into a subdirectory": {
+			base:   "https://example.com/schemas/api.json",
+			target: "https://example.com/schemas/example.com/types/schema.json",
+			want:   "example.com/types/schema.json",
+		},
+		"across directories": {
+			base:   "https://example.com/schemas/a/b/schema.json",
+			target: "https://example.com/schemas/a/c/schema.json",
+			want:   "../c/schema.json",
+		},
+		"rooted paths": {
+			base:   "/schemas/api.json",
+			target: "/types.json",
+			want:   "../types.json",
+		},
+		"colon in the first segment": {
+			base:   "file:///schemas/api.json",
+			target: "file:///schemas/a:b.json",
+			want:   "./a:b.json",
+		},
+		"other host": {
+			base:   "https://example.com/api.json",
+			target: "https://example.org/types.json",
+			want:   "https://example.org/types.json",
+		},
+		"opaque urn": {
+			base:   "urn:example:api",
+			target: "urn:example:types",
+			want:   "urn:example:types",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := uriref.Relative(tc.base, tc.target)
+			assert.Equal(t, tc.want, got)
+
+			assert.Equal(t, tc.target, uriref.ResolveURI(tc.base, got), "resolving %q against %q", got, tc.base)
+		})
+	}
+}
+
 func TestIsFragmentOnly(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,127 @@
+// Package protovalidate provides a [jsonschema.TagInterpreter] that maps the
+// buf.validate (protovalidate) rules of generated protobuf messages to JSON
+// Schema constraints, so the schema of a message describes the protojson
+// payloads protovalidate accepts.
+//
+// The rules are read from the message's descriptors through protoreflect: the
+// interpreter runs on the protobuf struct tag protoc-gen-go writes on each
+// field, takes the field number from it, and reads the field's
+// (buf.validate.field) option. It does not depend on the protovalidate runtime
+// or evaluate CEL. Like the validate interpreter, it declares each constraint
+// through the field's [jsonschema.Constraints] facade, so a rule means the same
+// thing here as the validate and jsonschema tags' spelling of it.
+//
+// # Usage
+//
+// The schema describes protojson's encoding, which differs from encoding/json's
+// in its field names and in how it writes the well-known types, enums, and
+// 64-bit integers. Register the naming, the well-known types, and the
+// interpreter, both as a tag interpreter and as the type extender that adds
+// each oneof's members and the rules declared on a message:
+//
+//	pv := protovalidate.NewInterpreter()
+//
+//	schema, err := jsonschema.GenerateFor[userv1.User](ctx,
+//	    jsonschema.WithFieldNaming(protovalidate.Naming()),
+//	    jsonschema.WithTypeSchemaProvider(protovalidate.WellKnownTypes()),
+//	    jsonschema.WithTagInterpreter("protobuf", pv),
+//	    jsonschema.WithTypeSchemaExtender(pv),
+//	)
+//
+//	for _, r := range pv.Skipped() {
+//	    log.Printf("%s: %s not in schema: %s", r.Element, r.Rule, r.Reason)
+//	}
+//
+// # Supported Rules
+//
+// Field rules:
+//
+//   - required: adds the field to the parent's required array. A field without
+//     presence must also be non-zero (protojson leaves out only the zero
+//     value), and a repeated or map field non-empty.
+//   - ignore: IGNORE_ALWAYS drops the field's rules. Under
+//     IGNORE_IF_ZERO_VALUE, the rules of a field without presence become an
+//     anyOf of the zero value and the rules, since protojson input may carry
+//     the zero value; for a repeated or map field only min_items and min_pairs
+//     need it. A field with presence keeps its rules, as protovalidate does.
+//
+// String rules:
+//
+//   - const, in, not_in: const, enum, and a not forbidding each value
+//   - len, min_len, max_len: minLength and maxLength, which count code points
+//     as protovalidate does
+//   - pattern: pattern
+//   - prefix, suffix, contains: the escaped text as a pattern, anchored at the
+//     start, the end, or neither; not_contains under a not
+//   - email, hostname, ipv4, ipv6, uri (-> "uri"), uri_ref (-> "uri-reference"),
+//     uuid: format; ip is an anyOf of the ipv4 and ipv6 formats, and address
+//     of those and hostname
+//   - tuuid, ulid, protobuf_fqn, protobuf_dot_fqn: the grammar protovalidate
+//     checks, as a pattern
+//
+// A second pattern or format on one field is written as an allOf entry rather
+// than displacing the first.
+//
+// Numeric rules (every integer and floating-point type):
+//
+//   - gt, gte, lt, lte: exclusiveMinimum, minimum, exclusiveMaximum, maximum.
+//     A lower bound above the upper one is protovalidate's reversed range,
+//     which accepts a value outside it; it is an anyOf of the two bounds.
+//   - const, in, not_in: const, enum, and a not forbidding each value
+//   - finite: implied, since every JSON number is finite
+//
+// Protojson writes every 64-bit integer (int64, uint64, and their sint, fixed,
+// and sfixed forms) as a decimal string. Its const, in, and not_in compare
+// that text, while its bounds constrain no keyword and are skipped.
+//
+// Enum rules compare the names protojson writes: const, in, and not_in name the
+// values, and defined_only is implied by the names [WellKnownTypes] lists. Bool
+// rules map const to const. Bytes rules map const, in, and not_in to the base64
+// text of each value.
+//
+// Repeated and map rules:
+//
+//   - min_items, max_items, unique: minItems, maxItems, uniqueItems
+//   - min_pairs, max_pairs: minProperties and maxProperties
+//   - items and values: the element rules, applied to the item or value schema
+//   - keys: the key rules, applied to propertyNames
+//
+// A rule on a wrapper field (google.protobuf.StringValue and the rest)
+// constrains the wrapped value, which is what protojson writes.
+//
+// Message and oneof rules:
+//
+//   - A oneof's members are properties of the message, as protojson writes
+//     them, and at most one of them may be present; (buf.validate.oneof).required
+//     requires one. A member of message type is described as an object.
+//   - (buf.validate.message).oneof allows at most one of the listed fields, or
+//     exactly one when required. Protojson leaves out an unset field, so a
+//     field is set exactly when its property is present.
+//
+// # Skipped Rules
+//
+// A rule with no JSON Schema equivalent is not an error: the message type is
+// fixed by its .proto file, and a schema without the rule is still a faithful
+// superset. The rule is recorded instead and listed by [Interpreter.Skipped],
+// so nothing is silently dropped:
+//
+//   - cel and cel_expression, on a field or a message, and predefined rules,
+//     which are CEL expressions
+//   - the byte counts of a string (len_bytes, min_bytes, max_bytes)
+//   - bytes rules other than const, in, and not_in, which read the decoded
+//     bytes rather than the base64 text
+//   - the bounds of a 64-bit integer
+//   - Any, Duration, FieldMask, and Timestamp rules, which compare parsed
+//     messages
+//   - the string well-known rules no format or pattern states (the IP prefix
+//     forms, host_and_port, and well_known_regex)
+//
+// # Limitations
+//
+// The schema describes what protojson.Marshal writes with its default options.
+// The protojson parser is more lenient: it accepts the .proto field names, null
+// for an unset field, numbers in string form, and enum numbers, all of which
+// the schema rejects. A field without presence is not required even when its
+// rules reject the zero value protojson leaves out, since protovalidate then
+// fails the message rather than the property; add required to say so.
+package protovalidate
@@ -0,0 +1,6 @@
+// Package testpb holds the generated messages the protovalidate interpreter's
+// tests run against: one field per rule family, the well-known types, a oneof,
+// and the rules that have no JSON Schema equivalent.
+package testpb
+
+//go:generate buf generate
@@ -0,0 +1,741 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: testpb.proto
+
+package testpb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Status is an account state.
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED Status = 0
+	Status_STATUS_ACTIVE      Status = 1
+	Status_STATUS_DISABLED    Status = 2
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_ACTIVE",
+		2: "STATUS_DISABLED",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_ACTIVE":      1,
+		"STATUS_DISABLED":    2,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_testpb_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_testpb_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_testpb_proto_rawDescGZIP(), []int{0}
+}
+
+// User exercises the string, number, enum, repeated, and map rules, the
+// well-known types, and the rules that have no JSON Schema equivalent.
+type User struct {
+	state       protoimpl.MessageState  `protogen:"open.v1"`
+	DisplayName string                  `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Email       string                  `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Handle      string                  `protobuf:"bytes,3,opt,name=handle,proto3" json:"handle,omitempty"`
+	Age         int32                   `protobuf:"varint,4,opt,name=age,proto3" json:"age,omitempty"`
+	Quota       int64                   `protobuf:"varint,5,opt,name=quota,proto3" json:"quota,omitempty"`
+	Priority    uint32                  `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	Score       float64                 `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	Status      Status                  `protobuf:"varint,8,opt,name=status,proto3,enum=testpb.v1.Status" json:"status,omitempty"`
+	Tags        []string                `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Limits      map[string]int32        `protobuf:"bytes,10,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Avatar      []byte                  `protobuf:"bytes,11,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Home        *Address                `protobuf:"bytes,12,opt,name=home,proto3" json:"home,omitempty"`
+	CreatedAt   *timestamppb.Timestamp  `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Ttl         *durationpb.Duration    `protobuf:"bytes,14,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Nickname    *wrapperspb.StringValue `protobuf:"bytes,15,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Metadata    *structpb.Struct        `protobuf:"bytes,16,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Note        string                  `protobuf:"bytes,17,opt,name=note,proto3" json:"note,omitempty"`
+	Bio         *string                 `protobuf:"bytes,18,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	Code        string                  `protobuf:"bytes,19,opt,name=code,proto3" json:"code,omitempty"`
+	Level       int32                   `protobuf:"varint,20,opt,name=level,proto3" json:"level,omitempty"`
+	LegacyId    string                  `protobuf:"bytes,21,opt,name=legacy_id,json=legacyId,proto3" json:"legacy_id,omitempty"`
+	Zip         string                  `protobuf:"bytes,22,opt,name=zip,json=postcode,proto3" json:"zip,omitempty"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*User_Phone
+	//	*User_Mailing
+	//	*User_ReachableAt
+	Contact       isUser_Contact `protobuf_oneof:"contact"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_testpb_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_testpb_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_testpb_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *User) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *User) GetQuota() int64 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+func (x *User) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *User) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *User) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *User) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *User) GetLimits() map[string]int32 {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *User) GetAvatar() []byte {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *User) GetHome() *Address {
+	if x != nil {
+		return x.Home
+	}
+	return nil
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *User) GetNickname() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Nickname
+	}
+	return nil
+}
+
+func (x *User) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *User) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *User) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *User) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *User) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *User) GetLegacyId() string {
+	if x != nil {
+		return x.LegacyId
+	}
+	return ""
+}
+
+func (x *User) GetZip() string {
+	if x != nil {
+		return x.Zip
+	}
+	return ""
+}
+
+func (x *User) GetContact() isUser_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *User) GetPhone() string {
+	if x != nil {
+		if x, ok := x.Contact.(*User_Phone); ok {
+			return x.Phone
+		}
+	}
+	return ""
+}
+
+func (x *User) GetMailing() *Address {
+	if x != nil {
+		if x, ok := x.Contact.(*User_Mailing); ok {
+			return x.Mailing
+		}
+	}
+	return nil
+}
+
+func (x *User) GetReachableAt() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Contact.(*User_ReachableAt); ok {
+			return x.ReachableAt
+		}
+	}
+	return nil
+}
+
+type isUser_Contact interface {
+	isUser_Contact()
+}
+
+type User_Phone struct {
+	Phone string `protobuf:"bytes,23,opt,name=phone,proto3,oneof"`
+}
+
+type User_Mailing struct {
+	Mailing *Address `protobuf:"bytes,24,opt,name=mailing,proto3,oneof"`
+}
+
+type User_ReachableAt struct {
+	ReachableAt *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=reachable_at,json=reachableAt,proto3,oneof"`
+}
+
+func (*User_Phone) isUser_Contact() {}
+
+func (*User_Mailing) isUser_Contact() {}
+
+func (*User_ReachableAt) isUser_Contact() {}
+
+// Address is a nested message.
+type Address struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	City          string                   `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Codes         []*wrapperspb.Int64Value `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
+	Extra         *structpb.Value          `protobuf:"bytes,3,opt,name=extra,proto3" json:"extra,omitempty"`
+	History       *structpb.ListValue      `protobuf:"bytes,4,opt,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_testpb_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_testpb_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_testpb_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetCodes() []*wrapperspb.Int64Value {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *Address) GetExtra() *structpb.Value {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *Address) GetHistory() *structpb.ListValue {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// Contact exercises the message and oneof presence rules and the enum, bool,
+// and bytes value rules.
+type Contact struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Email    string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Phone    string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Verified bool                   `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	Token    []byte                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Mode     Status                 `protobuf:"varint,5,opt,name=mode,proto3,enum=testpb.v1.Status" json:"mode,omitempty"`
+	History  []Status               `protobuf:"varint,6,rep,packed,name=history,proto3,enum=testpb.v1.Status" json:"history,omitempty"`
+	Host     string                 `protobuf:"bytes,7,opt,name=host,proto3" json:"host,omitempty"`
+	// Types that are valid to be assigned to Channel:
+	//
+	//	*Contact_Count
+	//	*Contact_State
+	//	*Contact_Level
+	Channel       isContact_Channel `protobuf_oneof:"channel"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	mi := &file_testpb_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_testpb_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_testpb_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Contact) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Contact) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Contact) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *Contact) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *Contact) GetMode() Status {
+	if x != nil {
+		return x.Mode
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *Contact) GetHistory() []Status {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Contact) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Contact) GetChannel() isContact_Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *Contact) GetCount() int64 {
+	if x != nil {
+		if x, ok := x.Channel.(*Contact_Count); ok {
+			return x.Count
+		}
+	}
+	return 0
+}
+
+func (x *Contact) GetState() Status {
+	if x != nil {
+		if x, ok := x.Channel.(*Contact_State); ok {
+			return x.State
+		}
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *Contact) GetLevel() *wrapperspb.Int32Value {
+	if x != nil {
+		if x, ok := x.Channel.(*Contact_Level); ok {
+			return x.Level
+		}
+	}
+	return nil
+}
+
+type isContact_Channel interface {
+	isContact_Channel()
+}
+
+type Contact_Count struct {
+	Count int64 `protobuf:"zigzag64,8,opt,name=count,proto3,oneof"`
+}
+
+type Contact_State struct {
+	State Status `protobuf:"varint,9,opt,name=state,proto3,enum=testpb.v1.Status,oneof"`
+}
+
+type Contact_Level struct {
+	Level *wrapperspb.Int32Value `protobuf:"bytes,10,opt,name=level,proto3,oneof"`
+}
+
+func (*Contact_Count) isContact_Channel() {}
+
+func (*Contact_State) isContact_Channel() {}
+
+func (*Contact_Level) isContact_Channel() {}
+
+var File_testpb_proto protoreflect.FileDescriptor
+
+const file_testpb_proto_rawDesc = "" +
+	"\n" +
+	"\ftestpb.proto\x12\ttestpb.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xef\n" +
+	"\n" +
+	"\x04User\x12,\n" +
+	"\fdisplay_name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\vdisplayName\x12\x1d\n" +
+	"\x05email\x18\x02 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\x120\n" +
+	"\x06handle\x18\x03 \x01(\tB\x18\xbaH\x15r\x132\f^[a-z0-9_]+$\xba\x01\x02__R\x06handle\x12\x1c\n" +
+	"\x03age\x18\x04 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x10\x96\x01(\x00R\x03age\x12\x1f\n" +
+	"\x05quota\x18\x05 \x01(\x03B\t\xbaH\x06\"\x048\r \x00R\x05quota\x12'\n" +
+	"\bpriority\x18\x06 \x01(\rB\v\xbaH\b*\x060\x010\x020\x03R\bpriority\x12/\n" +
+	"\x05score\x18\a \x01(\x01B\x19\xbaH\x16\x12\x14@\x01\x19\x00\x00\x00\x00\x00\x00\xf0?!\x00\x00\x00\x00\x00\x00\x00\x00R\x05score\x125\n" +
+	"\x06status\x18\b \x01(\x0e2\x11.testpb.v1.StatusB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status\x12&\n" +
+	"\x04tags\x18\t \x03(\tB\x12\xbaH\x0f\x92\x01\f\b\x01\x10\x05\x18\x01\"\x04r\x02\x10\x02R\x04tags\x12K\n" +
+	"\x06limits\x18\n" +
+	" \x03(\v2\x1b.testpb.v1.User.LimitsEntryB\x16\xbaH\x13\x9a\x01\x10\x10\x03\"\x06r\x04:\x02x-*\x04\x1a\x02(\x00R\x06limits\x12 \n" +
+	"\x06avatar\x18\v \x01(\fB\b\xbaH\x05z\x03\x18\x80\bR\x06avatar\x12.\n" +
+	"\x04home\x18\f \x01(\v2\x12.testpb.v1.AddressB\x06\xbaH\x03\xc8\x01\x01R\x04home\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x125\n" +
+	"\x03ttl\x18\x0e \x01(\v2\x19.google.protobuf.DurationB\b\xbaH\x05\xaa\x01\x02*\x00R\x03ttl\x12A\n" +
+	"\bnickname\x18\x0f \x01(\v2\x1c.google.protobuf.StringValueB\a\xbaH\x04r\x02\x18\n" +
+	"R\bnickname\x123\n" +
+	"\bmetadata\x18\x10 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12d\n" +
+	"\x04note\x18\x11 \x01(\tBP\xbaHM\xba\x01J\n" +
+	"\fnote.trimmed\x12%note must not have surrounding spaces\x1a\x13this == this.trim()R\x04note\x12\x1f\n" +
+	"\x03bio\x18\x12 \x01(\tB\b\xbaH\x05r\x03\x18\x98\x02H\x01R\x03bio\x88\x01\x01\x12\x1f\n" +
+	"\x04code\x18\x13 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x98\x01\x06R\x04code\x12\x1f\n" +
+	"\x05level\x18\x14 \x01(\x05B\t\xbaH\x06\x1a\x04\x10\n" +
+	" \x14R\x05level\x12(\n" +
+	"\tlegacy_id\x18\x15 \x01(\tB\v\xbaH\b\xd8\x01\x03r\x03\xb0\x01\x01R\blegacyId\x12(\n" +
+	"\x03zip\x18\x16 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[0-9]{5}$R\bpostcode\x12)\n" +
+	"\x05phone\x18\x17 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^\\+[0-9]+$H\x00R\x05phone\x12.\n" +
+	"\amailing\x18\x18 \x01(\v2\x12.testpb.v1.AddressH\x00R\amailing\x12?\n" +
+	"\freachable_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vreachableAt\x1a9\n" +
+	"\vLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01:`\xbaH]\x1a[\n" +
+	"\x13user.distinct_names\x12#display name must differ from email\x1a\x1fthis.display_name != this.emailB\t\n" +
+	"\acontactB\x06\n" +
+	"\x04_bio\"\xbd\x01\n" +
+	"\aAddress\x12\x1b\n" +
+	"\x04city\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04city\x121\n" +
+	"\x05codes\x18\x02 \x03(\v2\x1b.google.protobuf.Int64ValueR\x05codes\x12,\n" +
+	"\x05extra\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x05extra\x124\n" +
+	"\ahistory\x18\x04 \x01(\v2\x1a.google.protobuf.ListValueR\ahistory\"\xc6\x03\n" +
+	"\aContact\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12#\n" +
+	"\bverified\x18\x03 \x01(\bB\a\xbaH\x04j\x02\b\x01R\bverified\x12\"\n" +
+	"\x05token\x18\x04 \x01(\fB\f\xbaH\tz\aB\x02\x01\x02B\x01\xffR\x05token\x121\n" +
+	"\x04mode\x18\x05 \x01(\x0e2\x11.testpb.v1.StatusB\n" +
+	"\xbaH\a\x82\x01\x04\x18\x01\x18\x02R\x04mode\x12:\n" +
+	"\ahistory\x18\x06 \x03(\x0e2\x11.testpb.v1.StatusB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\ahistory\x12\x1b\n" +
+	"\x04host\x18\a \x01(\tB\a\xbaH\x04r\x02p\x01R\x04host\x12\x1f\n" +
+	"\x05count\x18\b \x01(\x12B\a\xbaH\x04B\x02\b\n" +
+	"H\x00R\x05count\x12)\n" +
+	"\x05state\x18\t \x01(\x0e2\x11.testpb.v1.StatusH\x00R\x05state\x12<\n" +
+	"\x05level\x18\n" +
+	" \x01(\v2\x1b.google.protobuf.Int32ValueB\a\xbaH\x04\x1a\x02(\x01H\x00R\x05level:\x15\xbaH\x12\"\x10\n" +
+	"\x05email\n" +
+	"\x05phone\x10\x01B\x10\n" +
+	"\achannel\x12\x05\xbaH\x02\b\x01*H\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSTATUS_ACTIVE\x10\x01\x12\x13\n" +
+	"\x0fSTATUS_DISABLED\x10\x02BLZJgo.jacobcolvin.com/x/jsonschema/interpreters/protovalidate/internal/testpbb\x06proto3"
+
+var (
+	file_testpb_proto_rawDescOnce sync.Once
+	file_testpb_proto_rawDescData []byte
+)
+
+func file_testpb_proto_rawDescGZIP() []byte {
+	file_testpb_proto_rawDescOnce.Do(func() {
+		file_testpb_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testpb_proto_rawDesc), len(file_testpb_proto_rawDesc)))
+	})
+	return file_testpb_proto_rawDescData
+}
+
+var file_testpb_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_testpb_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_testpb_proto_goTypes = []any{
+	(Status)(0),                    // 0: testpb.v1.Status
+	(*User)(nil),                   // 1: testpb.v1.User
+	(*Address)(nil),                // 2: testpb.v1.Address
+	(*Contact)(nil),                // 3: testpb.v1.Contact
+	nil,                            // 4: testpb.v1.User.LimitsEntry
+	(*timestamppb.Timestamp)(nil),  // 5: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 6: google.protobuf.Duration
+	(*wrapperspb.StringValue)(nil), // 7: google.protobuf.StringValue
+	(*structpb.Struct)(nil),        // 8: google.protobuf.Struct
+	(*wrapperspb.Int64Value)(nil),  // 9: google.protobuf.Int64Value
+	(*structpb.Value)(nil),         // 10: google.protobuf.Value
+	(*structpb.ListValue)(nil),     // 11: google.protobuf.ListValue
+	(*wrapperspb.Int32Value)(nil),  // 12: google.protobuf.Int32Value
+}
+var file_testpb_proto_depIdxs = []int32{
+	0,  // 0: testpb.v1.User.status:type_name -> testpb.v1.Status
+	4,  // 1: testpb.v1.User.limits:type_name -> testpb.v1.User.LimitsEntry
+	2,  // 2: testpb.v1.User.home:type_name -> testpb.v1.Address
+	5,  // 3: testpb.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 4: testpb.v1.User.ttl:type_name -> google.protobuf.Duration
+	7,  // 5: testpb.v1.User.nickname:type_name -> google.protobuf.StringValue
+	8,  // 6: testpb.v1.User.metadata:type_name -> google.protobuf.Struct
+	2,  // 7: testpb.v1.User.mailing:type_name -> testpb.v1.Address
+	5,  // 8: testpb.v1.User.reachable_at:type_name -> google.protobuf.Timestamp
+	9,  // 9: testpb.v1.Address.codes:type_name -> google.protobuf.Int64Value
+	10, // 10: testpb.v1.Address.extra:type_name -> google.protobuf.Value
+	11, // 11: testpb.v1.Address.history:type_name -> google.protobuf.ListValue
+	0,  // 12: testpb.v1.Contact.mode:type_name -> testpb.v1.Status
+	0,  // 13: testpb.v1.Contact.history:type_name -> testpb.v1.Status
+	0,  // 14: testpb.v1.Contact.state:type_name -> testpb.v1.Status
+	12, // 15: testpb.v1.Contact.level:type_name -> google.protobuf.Int32Value
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_testpb_proto_init() }
+func file_testpb_proto_init() {
+	if File_testpb_proto != nil {
+		return
+	}
+	file_testpb_proto_msgTypes[0].OneofWrappers = []any{
+		(*User_Phone)(nil),
+		(*User_Mailing)(nil),
+		(*User_ReachableAt)(nil),
+	}
+	file_testpb_proto_msgTypes[2].OneofWrappers = []any{
+		(*Contact_Count)(nil),
+		(*Contact_State)(nil),
+		(*Contact_Level)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testpb_proto_rawDesc), len(file_testpb_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testpb_proto_goTypes,
+		DependencyIndexes: file_testpb_proto_depIdxs,
+		EnumInfos:         file_testpb_proto_enumTypes,
+		MessageInfos:      file_testpb_proto_msgTypes,
+	}.Build()
+	File_testpb_proto = out.File
+	file_testpb_proto_goTypes = nil
+	file_testpb_proto_depIdxs = nil
+}
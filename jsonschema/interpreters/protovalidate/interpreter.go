@@ -0,0 +1,362 @@
+package protovalidate
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/typename"
+)
+
+// SkippedRule is a protovalidate rule the interpreter read but could not state
+// in JSON Schema. The schema is then looser than protovalidate: it accepts
+// every value the rule accepts and some the rule rejects.
+type SkippedRule struct {
+	// Element is the full name of the message or field declaring the rule.
+	Element protoreflect.FullName
+	// Rule is the rule's path within the element's buf.validate options, such
+	// as "cel", "string.len_bytes", or "repeated.items.cel".
+	Rule string
+	// Reason says why the rule has no JSON Schema equivalent. For a CEL rule
+	// it is the expression.
+	Reason string
+}
+
+// Interpreter implements [jsonschema.TagInterpreter] for the buf.validate
+// rules of generated protobuf messages. Create one with [NewInterpreter] and
+// register it under the "protobuf" tag key, the key protoc-gen-go writes on
+// every message field, and as a type extender for the rules declared on a
+// message rather than a field:
+//
+//	pv := protovalidate.NewInterpreter()
+//
+//	schema, err := jsonschema.GenerateFor[userv1.User](ctx,
+//	    jsonschema.WithFieldNaming(protovalidate.Naming()),
+//	    jsonschema.WithTypeSchemaProvider(protovalidate.WellKnownTypes()),
+//	    jsonschema.WithTagInterpreter("protobuf", pv),
+//	    jsonschema.WithTypeSchemaExtender(pv),
+//	)
+//
+// The rules are read from the message's descriptor, not from the tag: the tag
+// only names the field's number. Every constraint is contributed through
+// [jsonschema.Constraints], as the validate interpreter's are. A rule with no
+// JSON Schema equivalent, such as a CEL expression, is recorded rather than
+// dropped; [Interpreter.Skipped] lists them once generation returns.
+//
+// An Interpreter may be shared by concurrent generation runs.
+type Interpreter struct {
+	mu      sync.Mutex
+	skipped map[SkippedRule]struct{}
+}
+
+// NewInterpreter returns a new protovalidate interpreter.
+func NewInterpreter() *Interpreter {
+	return &Interpreter{skipped: map[SkippedRule]struct{}{}}
+}
+
+// Skipped returns the rules the interpreter has skipped across every
+// generation run it took part in, sorted by element and rule.
+func (i *Interpreter) Skipped() []SkippedRule {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	out := make([]SkippedRule, 0, len(i.skipped))
+	for r := range i.skipped {
+		out = append(out, r)
+	}
+
+	slices.SortFunc(out, func(a, b SkippedRule) int {
+		return cmp.Or(cmp.Compare(a.Element, b.Element), cmp.Compare(a.Rule, b.Rule), cmp.Compare(a.Reason, b.Reason))
+	})
+
+	return out
+}
+
+// record adds a skipped rule. A rule is recorded once however many times its
+// message is generated.
+func (i *Interpreter) record(r SkippedRule) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.skipped[r] = struct{}{}
+}
+
+// Interpret finds the field's descriptor from the protobuf tag and applies
+// its buf.validate rules to the field's authored canvas. Interpretation reads
+// only descriptors, so the context is unused.
+func (i *Interpreter) Interpret(_ context.Context, field jsonschema.FieldContext, tag jsonschema.Tag) error {
+	md, err := messageDescriptor(field.Owner)
+	if err != nil {
+		return err
+	}
+
+	// The tag is protoc-gen-go's: the wire type, then the field number.
+	parts := strings.Split(tag.Value, ",")
+	if len(parts) < 2 {
+		return fmt.Errorf("protovalidate: %s: protobuf tag %q has no field number", field.Owner, tag.Value)
+	}
+
+	n, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return fmt.Errorf("protovalidate: %s: protobuf tag %q has no field number", field.Owner, tag.Value)
+	}
+
+	fd := md.Fields().ByNumber(protoreflect.FieldNumber(n))
+	if fd == nil {
+		return fmt.Errorf("protovalidate: %s has no field %d", md.FullName(), n)
+	}
+
+	rules, ok := fieldRules(fd)
+	if !ok {
+		return nil
+	}
+
+	return applyFieldRules(scope{i: i, element: fd.FullName()}, position{field: field, fd: fd}, rules)
+}
+
+// ExtendSchemaForType adds what a generated message's struct does not show
+// reflection: the members of each oneof, which protojson writes as properties
+// of the message although the struct holds them behind one interface field,
+// and the rules declared on the message and its oneofs. Any other type is left
+// untouched.
+//
+// A oneof member of message type is described as an object, without the
+// member message's own schema, unless it is a well-known type.
+func (i *Interpreter) ExtendSchemaForType(_ context.Context, tc jsonschema.TypeContext, ts *jsonschema.TypeSchema) error {
+	md, err := messageDescriptor(tc.Type)
+	if err != nil || ts.Value == nil || ts.Value.Type != typename.Object {
+		return nil //nolint:nilerr // A type that is not a message is not this extender's.
+	}
+
+	s := scope{i: i, element: md.FullName()}
+
+	oneofs := md.Oneofs()
+	for j := range oneofs.Len() {
+		od := oneofs.Get(j)
+		if od.IsSynthetic() {
+			continue
+		}
+
+		err := i.addOneof(tc, ts.Value, od)
+		if err != nil {
+			return err
+		}
+	}
+
+	rules, ok := proto.GetExtension(md.Options(), validate.E_Message).(*validate.MessageRules)
+	if !ok || rules == nil {
+		return nil
+	}
+
+	for _, expr := range rules.GetCelExpression() {
+		s.skip("cel_expression", expr)
+	}
+
+	for _, rule := range rules.GetCel() {
+		s.skip("cel", rule.GetExpression())
+	}
+
+	for _, rule := range rules.GetOneof() {
+		names := make([]string, 0, len(rule.GetFields()))
+
+		for _, name := range rule.GetFields() {
+			fd := md.Fields().ByName(protoreflect.Name(name))
+			if fd == nil {
+				return fmt.Errorf("protovalidate: %s: oneof: no field %q", md.FullName(), name)
+			}
+
+			names = append(names, fd.JSONName())
+		}
+
+		addExclusive(ts.Value, names, rule.GetRequired())
+	}
+
+	return nil
+}
+
+// addOneof adds the members of a oneof to the message's properties, each with
+// its rules, and allows at most one of them, or exactly one when the oneof is
+// required.
+func (i *Interpreter) addOneof(tc jsonschema.TypeContext, object *jsonschema.Schema, od protoreflect.OneofDescriptor) error {
+	if object.Properties == nil {
+		object.Properties = map[string]*jsonschema.Schema{}
+	}
+
+	fields := od.Fields()
+	names := make([]string, 0, fields.Len())
+
+	for k := range fields.Len() {
+		fd := fields.Get(k)
+		name := fd.JSONName()
+		names = append(names, name)
+
+		base := memberSchema(fd)
+		field := jsonschema.FieldContext{
+			Type:   memberType(fd),
+			Owner:  tc.Type,
+			Canvas: &jsonschema.Schema{},
+			Base:   base,
+			Parent: object,
+			Name:   name,
+			Draft:  tc.Draft,
+		}
+
+		rules, ok := fieldRules(fd)
+		if ok {
+			err := applyFieldRules(scope{i: i, element: fd.FullName()}, position{field: field, fd: fd}, rules)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !reflect.DeepEqual(*field.Canvas, jsonschema.Schema{}) {
+			base.AllOf = append(base.AllOf, field.Canvas)
+		}
+
+		object.Properties[name] = base
+	}
+
+	rules, _ := proto.GetExtension(od.Options(), validate.E_Oneof).(*validate.OneofRules)
+	addExclusive(object, names, rules.GetRequired())
+
+	return nil
+}
+
+// addExclusive allows at most one of the named properties on the object, and
+// requires one when required is set. Protojson leaves out an unset field, so
+// a property is present exactly when its field is set.
+func addExclusive(object *jsonschema.Schema, names []string, required bool) {
+	var pairs []*jsonschema.Schema
+
+	for a := range names {
+		for _, b := range names[a+1:] {
+			pairs = append(pairs, &jsonschema.Schema{Required: []string{names[a], b}})
+		}
+	}
+
+	if len(pairs) > 0 {
+		object.AllOf = append(object.AllOf, &jsonschema.Schema{Not: &jsonschema.Schema{AnyOf: pairs}})
+	}
+
+	if !required {
+		return
+	}
+
+	branches := make([]*jsonschema.Schema, 0, len(names))
+	for _, name := range names {
+		branches = append(branches, &jsonschema.Schema{Required: []string{name}})
+	}
+
+	object.AllOf = append(object.AllOf, &jsonschema.Schema{AnyOf: branches})
+}
+
+// messageDescriptor returns the descriptor of the generated message t (or *t)
+// is.
+func messageDescriptor(t reflect.Type) (protoreflect.MessageDescriptor, error) {
+	if t == nil {
+		return nil, fmt.Errorf("protovalidate: a protobuf tag outside a struct")
+	}
+
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	m, ok := reflect.New(t).Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("protovalidate: %s is not a generated protobuf message", t)
+	}
+
+	return m.ProtoReflect().Descriptor(), nil
+}
+
+// fieldRules returns the buf.validate rules declared on a field.
+func fieldRules(fd protoreflect.FieldDescriptor) (*validate.FieldRules, bool) {
+	rules, ok := proto.GetExtension(fd.Options(), validate.E_Field).(*validate.FieldRules)
+
+	return rules, ok && rules != nil
+}
+
+// memberSchema is the schema of a oneof member's value as protojson writes it.
+func memberSchema(fd protoreflect.FieldDescriptor) *jsonschema.Schema {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if t := memberType(fd); t.Kind() == reflect.Pointer {
+			if s := wellKnownSchema(t.Elem()); s != nil {
+				return s
+			}
+		}
+
+		return &jsonschema.Schema{Type: typename.Object}
+	case protoreflect.EnumKind:
+		if fd.Enum().FullName() == "google.protobuf.NullValue" {
+			return &jsonschema.Schema{Type: typename.Null}
+		}
+
+		return enumSchema(fd.Enum())
+	case protoreflect.StringKind:
+		return &jsonschema.Schema{Type: typename.String}
+	case protoreflect.BytesKind:
+		return &jsonschema.Schema{Type: typename.String, ContentEncoding: "base64"}
+	case protoreflect.BoolKind:
+		return &jsonschema.Schema{Type: typename.Boolean}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return &jsonschema.Schema{Type: typename.Number}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return boundedInteger(math.MinInt32, math.MaxInt32)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return boundedInteger(0, math.MaxUint32)
+	}
+
+	return wellKnownSchema(memberType(fd))
+}
+
+// memberType is the Go type of a oneof member's value: the scalar, the
+// generated message type, or an opaque struct pointer for a message type
+// that is not linked into the program.
+func memberType(fd protoreflect.FieldDescriptor) reflect.Type {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(fd.Message().FullName())
+		if err != nil {
+			return reflect.TypeFor[*struct{}]()
+		}
+
+		return reflect.TypeOf(mt.Zero().Interface())
+	case protoreflect.EnumKind:
+		return reflect.TypeFor[protoreflect.EnumNumber]()
+	}
+
+	return scalarTypes[fd.Kind()]
+}
+
+// scalarTypes maps each scalar kind to the Go type protoc-gen-go declares it
+// as.
+var scalarTypes = map[protoreflect.Kind]reflect.Type{
+	protoreflect.BoolKind:     reflect.TypeFor[bool](),
+	protoreflect.Int32Kind:    reflect.TypeFor[int32](),
+	protoreflect.Sint32Kind:   reflect.TypeFor[int32](),
+	protoreflect.Sfixed32Kind: reflect.TypeFor[int32](),
+	protoreflect.Uint32Kind:   reflect.TypeFor[uint32](),
+	protoreflect.Fixed32Kind:  reflect.TypeFor[uint32](),
+	protoreflect.Int64Kind:    typeInt64,
+	protoreflect.Sint64Kind:   typeInt64,
+	protoreflect.Sfixed64Kind: typeInt64,
+	protoreflect.Uint64Kind:   typeUint64,
+	protoreflect.Fixed64Kind:  typeUint64,
+	protoreflect.FloatKind:    reflect.TypeFor[float32](),
+	protoreflect.DoubleKind:   reflect.TypeFor[float64](),
+	protoreflect.StringKind:   reflect.TypeFor[string](),
+	protoreflect.BytesKind:    reflect.TypeFor[[]byte](),
+}
@@ -0,0 +1,322 @@
+package protovalidate_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/interpreters/protovalidate"
+	"go.jacobcolvin.com/x/jsonschema/interpreters/protovalidate/internal/testpb"
+)
+
+// generate builds the schema of typ with the interpreter, protojson naming,
+// and the well-known types registered, as the package documents.
+func generate(t *testing.T, typ reflect.Type, pv *protovalidate.Interpreter) *jsonschema.Schema {
+	t.Helper()
+
+	s, err := jsonschema.Generate(t.Context(), typ,
+		jsonschema.WithFieldNaming(protovalidate.Naming()),
+		jsonschema.WithTypeSchemaProvider(protovalidate.WellKnownTypes()),
+		jsonschema.WithTagInterpreter("protobuf", pv),
+		jsonschema.WithTypeSchemaExtender(pv),
+	)
+	require.NoError(t, err)
+
+	return s
+}
+
+// validUser is a User every rule accepts.
+func validUser() *testpb.User {
+	return &testpb.User{
+		DisplayName: "Ada",
+		Email:       "ada@example.com",
+		Handle:      "ada_l",
+		Age:         36,
+		Quota:       1 << 40,
+		Priority:    2,
+		Score:       0.5,
+		Status:      testpb.Status_STATUS_ACTIVE,
+		Tags:        []string{"go", "proto"},
+		Limits:      map[string]int32{"x-rate": 10},
+		Avatar:      []byte{0x89, 'P', 'N', 'G'},
+		Home:        &testpb.Address{City: "London", Codes: []*wrapperspb.Int64Value{wrapperspb.Int64(-7)}},
+		CreatedAt:   timestamppb.New(timestamppb.Now().AsTime().Truncate(1000)),
+		Ttl:         durationpb.New(90_500_000_000),
+		Nickname:    wrapperspb.String("ada"),
+		Metadata:    &structpb.Struct{Fields: map[string]*structpb.Value{"k": structpb.NewListValue(&structpb.ListValue{})}},
+		Note:        "hello",
+		Bio:         proto.String(""),
+		Code:        "ABC123",
+		Level:       25,
+		Zip:         "12345",
+		Contact:     &testpb.User_Phone{Phone: "+4420"},
+	}
+}
+
+func TestInterpreter_ProtojsonOutput(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]proto.Message{
+		"user": validUser(),
+		"user with empty fields": &testpb.User{
+			DisplayName: "A", Tags: []string{"ab"}, Home: &testpb.Address{}, Score: 1,
+		},
+		"user with message member": func() proto.Message {
+			u := validUser()
+			u.Contact = &testpb.User_Mailing{Mailing: &testpb.Address{City: "Paris"}}
+
+			return u
+		}(),
+		"user with well-known member": func() proto.Message {
+			u := validUser()
+			u.Contact = &testpb.User_ReachableAt{ReachableAt: timestamppb.Now()}
+
+			return u
+		}(),
+		"contact": &testpb.Contact{
+			Email:    "a@example.com",
+			Verified: true,
+			Token:    []byte{0xff},
+			Mode:     testpb.Status_STATUS_DISABLED,
+			History:  []testpb.Status{testpb.Status_STATUS_UNSPECIFIED},
+			Host:     "2001:db8::1",
+			Channel:  &testpb.Contact_Level{Level: wrapperspb.Int32(3)},
+		},
+	}
+
+	for name, msg := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := generate(t, reflect.TypeOf(msg).Elem(), protovalidate.NewInterpreter())
+
+			v, err := jsonschema.Compile(t.Context(), s, jsonschema.WithFormats(true))
+			require.NoError(t, err)
+
+			doc, err := protojson.Marshal(msg)
+			require.NoError(t, err)
+
+			require.NoError(t, v.ValidateJSON(t.Context(), doc), string(doc))
+		})
+	}
+}
+
+func TestInterpreter_Rules(t *testing.T) {
+	t.Parallel()
+
+	const home = `"home":{}`
+
+	tests := map[string]struct {
+		typ     reflect.Type
+		valid   []string
+		invalid []string
+	}{
+		"required": {
+			typ:     reflect.TypeFor[testpb.User](),
+			valid:   []string{`{` + home + `}`},
+			invalid: []string{`{}`},
+		},
+		"string lengths": {
+			typ:     reflect.TypeFor[testpb.User](),
+			valid:   []string{`{` + home + `,"displayName":"a","code":"123456"}`},
+			invalid: []string{`{` + home + `,"displayName":""}`, `{` + home + `,"code":"12345"}`},
+		},
+		"pattern and not_contains": {
+			typ:     reflect.TypeFor[testpb.User](),
+			valid:   []string{`{` + home + `,"handle":"a_b"}`},
+			invalid: []string{`{` + home + `,"handle":"a__b"}`, `{` + home + `,"handle":"A"}`},
+		},
+		"number bounds": {
+			typ:     reflect.TypeFor[testpb.User](),
+			valid:   []string{`{` + home + `,"age":0,"score":1}`, `{` + home + `,"age":149}`},
+			invalid: []string{`{` + home + `,"age":150}`, `{` + home + `,"age":-1}`, `{` + home + `,"score":0}`},
+		},
+		"reversed range": {
+			typ:     reflect.TypeFor[testpb.User](),
+			valid:   []string{`{` + home + `,"level":9}`, `{` + home + `,"level":21}`},
+			invalid: []string{`{` + home + `,"level":10}`, `{` + home + `,"level":20}`},
+		},
+		"in and not_in": {
+			typ:   reflect.TypeFor[testpb.User](),
+			valid: []string{`{` + home + `,"priority":3,"quota":"12"}`},
+			invalid: []string{
+				`{` + home + `,"priority":4}`,
+				`{` + home + `,"quota":"13"}`,
+				`{` + home + `,"status":"STATUS_UNSPECIFIED"}`,
+			},
+		},
+		"repeated": {
+			typ:   reflect.TypeFor[testpb.User](),
+			valid: []string{`{` + home + `,"tags":["ab","cd"]}`},
+			invalid: []string{
+				`{` + home + `,"tags":[]}`,
+				`{` + home + `,"tags":["ab","ab"]}`,
+				`{` + home + `,"tags":["a"]}`,
+				`{` + home + `,"tags":["a1","a2","a3","a4","a5","a6"]}`,
+			},
+		},
+		"map": {
+			typ:   reflect.TypeFor[testpb.User](),
+			valid: []string{`{` + home + `,"limits":{"x-a":0,"x-b":1}}`},
+			invalid: []string{
+				`{` + home + `,"limits":{"a":0}}`,
+				`{` + home + `,"limits":{"x-a":-1}}`,
+				`{` + home + `,"limits":{"x-a":0,"x-b":0,"x-c":0,"x-d":0}}`,
+			},
+		},
+		"wrapper and optional": {
+			typ:     reflect.TypeFor[testpb.User](),
+			valid:   []string{`{` + home + `,"nickname":"0123456789","bio":""}`},
+			invalid: []string{`{` + home + `,"nickname":"0123456789a"}`},
+		},
+		"json_name": {
+			typ:     reflect.TypeFor[testpb.User](),
+			valid:   []string{`{` + home + `,"postcode":"12345"}`},
+			invalid: []string{`{` + home + `,"postcode":"1234"}`, `{` + home + `,"zip":"12345"}`},
+		},
+		"ignore if zero value": {
+			typ:     reflect.TypeFor[testpb.User](),
+			valid:   []string{`{` + home + `,"code":""}`, `{` + home + `,"code":"123456"}`},
+			invalid: []string{`{` + home + `,"code":"1234567"}`, `{` + home + `,"code":0}`},
+		},
+		"ignore always": {
+			typ:   reflect.TypeFor[testpb.User](),
+			valid: []string{`{` + home + `,"legacyId":"not a uuid"}`},
+		},
+		"oneof members": {
+			typ:   reflect.TypeFor[testpb.User](),
+			valid: []string{`{` + home + `,"phone":"+1"}`, `{` + home + `,"mailing":{"city":"x"}}`},
+			invalid: []string{
+				`{` + home + `,"phone":"1"}`,
+				`{` + home + `,"phone":"+1","mailing":{}}`,
+				`{` + home + `,"reachableAt":"yesterday"}`,
+			},
+		},
+		"required oneof": {
+			typ:   reflect.TypeFor[testpb.Contact](),
+			valid: []string{`{"phone":"1","count":"5"}`, `{"email":"a@example.com","state":"STATUS_ACTIVE"}`},
+			invalid: []string{
+				`{"phone":"1"}`,
+				`{"phone":"1","count":"6"}`,
+				`{"phone":"1","level":0}`,
+				`{"phone":"1","count":"5","level":2}`,
+			},
+		},
+		"message oneof": {
+			typ: reflect.TypeFor[testpb.Contact](),
+			invalid: []string{
+				`{"count":"5"}`,
+				`{"email":"a@example.com","phone":"1","count":"5"}`,
+			},
+		},
+		"enum, bool, and bytes values": {
+			typ:   reflect.TypeFor[testpb.Contact](),
+			valid: []string{`{"phone":"1","count":"5","verified":true,"token":"AQI=","mode":"STATUS_ACTIVE"}`},
+			invalid: []string{
+				`{"phone":"1","count":"5","verified":false}`,
+				`{"phone":"1","count":"5","token":"AQ=="}`,
+				`{"phone":"1","count":"5","mode":"STATUS_UNSPECIFIED"}`,
+				`{"phone":"1","count":"5","mode":1}`,
+				`{"phone":"1","count":"5","history":["STATUS_GONE"]}`,
+			},
+		},
+		"ip": {
+			typ:     reflect.TypeFor[testpb.Contact](),
+			valid:   []string{`{"phone":"1","count":"5","host":"192.0.2.1"}`, `{"phone":"1","count":"5","host":"::1"}`},
+			invalid: []string{`{"phone":"1","count":"5","host":"example.com"}`},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := generate(t, tc.typ, protovalidate.NewInterpreter())
+
+			v, err := jsonschema.Compile(t.Context(), s, jsonschema.WithFormats(true))
+			require.NoError(t, err)
+
+			for _, doc := range tc.valid {
+				require.NoError(t, v.ValidateJSON(t.Context(), []byte(doc)), doc)
+			}
+
+			for _, doc := range tc.invalid {
+				require.Error(t, v.ValidateJSON(t.Context(), []byte(doc)), doc)
+			}
+		})
+	}
+}
+
+func TestInterpreter_Skipped(t *testing.T) {
+	t.Parallel()
+
+	pv := protovalidate.NewInterpreter()
+
+	// Generating twice records each rule once.
+	generate(t, reflect.TypeFor[testpb.User](), pv)
+	generate(t, reflect.TypeFor[testpb.User](), pv)
+
+	const int64Reason = "protojson writes a 64-bit integer as a decimal string, which no numeric keyword bounds"
+
+	assert.Equal(t, []protovalidate.SkippedRule{
+		{Element: "testpb.v1.User", Rule: "cel", Reason: "this.display_name != this.email"},
+		{
+			Element: "testpb.v1.User.avatar", Rule: "bytes.max_len",
+			Reason: "the rule reads the decoded bytes, which a base64 string does not expose to a keyword",
+		},
+		{Element: "testpb.v1.User.note", Rule: "cel", Reason: "this == this.trim()"},
+		{Element: "testpb.v1.User.quota", Rule: "int64.gt", Reason: int64Reason},
+		{
+			Element: "testpb.v1.User.ttl", Rule: "duration.gt",
+			Reason: "a duration rule compares the parsed message, not its protojson form",
+		},
+	}, pv.Skipped())
+}
+
+func TestInterpreter_Shape(t *testing.T) {
+	t.Parallel()
+
+	s := generate(t, reflect.TypeFor[testpb.User](), protovalidate.NewInterpreter())
+
+	// The first pattern keeps the pattern keyword and the negated rule sits
+	// under a not beside it.
+	handle := s.Properties["handle"]
+	assert.Equal(t, `^[a-z0-9_]+$`, handle.Pattern)
+	require.Len(t, handle.AllOf, 1)
+	require.NotNil(t, handle.AllOf[0].Not)
+	assert.Equal(t, `__`, handle.AllOf[0].Not.Pattern)
+
+	// A 64-bit integer is a decimal string, so its bound is skipped while its
+	// forbidden value is compared as text.
+	quota := s.Properties["quota"]
+	assert.Equal(t, "string", quota.Type)
+	assert.Nil(t, quota.ExclusiveMinimum)
+	require.NotNil(t, quota.Not)
+	require.NotNil(t, quota.Not.Const)
+	assert.Equal(t, "13", *quota.Not.Const)
+
+	assert.Equal(t, []string{"home"}, s.Required)
+	assert.NotContains(t, s.Properties, "contact")
+}
+
+func TestInterpreter_Errors(t *testing.T) {
+	t.Parallel()
+
+	type notMessage struct {
+		V string `protobuf:"bytes,1,opt,name=v,proto3"`
+	}
+
+	_, err := jsonschema.GenerateFor[notMessage](t.Context(),
+		jsonschema.WithTagInterpreter("protobuf", protovalidate.NewInterpreter()),
+	)
+	require.ErrorContains(t, err, "is not a generated protobuf message")
+}
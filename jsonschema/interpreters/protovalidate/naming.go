@@ -0,0 +1,44 @@
+package protovalidate
+
+import (
+	"reflect"
+	"strings"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+// Naming returns the [jsonschema.FieldNaming] that names a generated message's
+// fields as protojson does: by the field's JSON name, which is its name in
+// lowerCamelCase unless the .proto file sets json_name. Every field is
+// optional, since protojson leaves out a field that is unset or, without
+// presence, zero. A field without a protobuf tag, such as the interface field
+// holding a oneof, is left out; the [Interpreter] adds a oneof's members
+// instead. Pass the option to generation and validation alike, through
+// [jsonschema.WithFieldNaming].
+//
+// The names are the ones protojson writes by default. Its UseProtoNames
+// option writes the .proto names instead, and its parser accepts both.
+func Naming() jsonschema.FieldNaming {
+	return jsonschema.NamingFunc(func(f reflect.StructField) jsonschema.FieldName {
+		tag, ok := f.Tag.Lookup("protobuf")
+		if !ok {
+			return jsonschema.FieldName{}
+		}
+
+		var name string
+
+		// The tag carries json= only when the JSON name differs from the
+		// .proto name, which then is already lowerCamelCase.
+		for part := range strings.SplitSeq(tag, ",") {
+			if v, found := strings.CutPrefix(part, "json="); found {
+				return jsonschema.FieldName{Name: v, OmitEmpty: true}
+			}
+
+			if v, found := strings.CutPrefix(part, "name="); found {
+				name = v
+			}
+		}
+
+		return jsonschema.FieldName{Name: name, OmitEmpty: true}
+	})
+}
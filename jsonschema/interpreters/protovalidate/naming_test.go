@@ -0,0 +1,56 @@
+package protovalidate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/interpreters/protovalidate"
+	"go.jacobcolvin.com/x/jsonschema/interpreters/protovalidate/internal/testpb"
+)
+
+func TestNaming(t *testing.T) {
+	t.Parallel()
+
+	s, err := jsonschema.GenerateFor[testpb.User](t.Context(),
+		jsonschema.WithFieldNaming(protovalidate.Naming()),
+	)
+	require.NoError(t, err)
+
+	for _, name := range []string{"displayName", "createdAt", "legacyId", "postcode", "bio"} {
+		assert.Contains(t, s.Properties, name)
+	}
+
+	for _, name := range []string{"display_name", "DisplayName", "zip", "Contact", "contact", "state"} {
+		assert.NotContains(t, s.Properties, name)
+	}
+
+	assert.Empty(t, s.Required)
+}
+
+func TestNaming_ValidateValue(t *testing.T) {
+	t.Parallel()
+
+	naming := protovalidate.Naming()
+	pv := protovalidate.NewInterpreter()
+
+	s, err := jsonschema.GenerateFor[testpb.Address](t.Context(),
+		jsonschema.WithFieldNaming(naming),
+		jsonschema.WithTypeSchemaProvider(protovalidate.WellKnownTypes()),
+		jsonschema.WithTagInterpreter("protobuf", pv),
+		jsonschema.WithTypeSchemaExtender(pv),
+	)
+	require.NoError(t, err)
+
+	v, err := jsonschema.Compile(t.Context(), s, jsonschema.WithFieldNaming(naming))
+	require.NoError(t, err)
+
+	// The names protojson writes are the names the schema carries.
+	doc, err := protojson.Marshal(&testpb.Address{City: "x"})
+	require.NoError(t, err)
+	require.NoError(t, v.ValidateJSON(t.Context(), doc))
+	require.Error(t, v.ValidateJSON(t.Context(), []byte(`{"city":""}`)))
+}
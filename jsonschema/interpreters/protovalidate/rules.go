@@ -0,0 +1,825 @@
+package protovalidate
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/numkind"
+)
+
+// Reasons shared by several skipped rules.
+const (
+	byteCountReason = "a count of UTF-8 bytes has no JSON Schema keyword"
+	int64Reason     = "protojson writes a 64-bit integer as a decimal string, which no numeric keyword bounds"
+	bytesReason     = "the rule reads the decoded bytes, which a base64 string does not expose to a keyword"
+)
+
+// stringFormats are the well-known string rules a format states: one format,
+// or the formats one of which the value must meet.
+var stringFormats = map[protoreflect.Name][]string{
+	"email":    {"email"},
+	"hostname": {"hostname"},
+	"ip":       {"ipv4", "ipv6"},
+	"ipv4":     {"ipv4"},
+	"ipv6":     {"ipv6"},
+	"uri":      {"uri"},
+	"uri_ref":  {"uri-reference"},
+	"address":  {"hostname", "ipv4", "ipv6"},
+	"uuid":     {"uuid"},
+}
+
+// stringPatterns are the well-known string rules a pattern states, each the
+// grammar protovalidate checks.
+var stringPatterns = map[protoreflect.Name]string{
+	"tuuid":            `^[0-9a-fA-F]{32}$`,
+	"ulid":             `^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`,
+	"protobuf_fqn":     `^[A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)*$`,
+	"protobuf_dot_fqn": `^\.[A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)*$`,
+}
+
+// wrappedTypes maps each wrapper message to the Go type of the value it wraps,
+// which is what a rule on a wrapper field constrains.
+var wrappedTypes = map[reflect.Type]reflect.Type{
+	typeDoubleValue: reflect.TypeFor[float64](),
+	typeFloatValue:  reflect.TypeFor[float32](),
+	typeInt64Value:  typeInt64,
+	typeUInt64Value: typeUint64,
+	typeInt32Value:  reflect.TypeFor[int32](),
+	typeUInt32Value: reflect.TypeFor[uint32](),
+	typeBoolValue:   reflect.TypeFor[bool](),
+	typeStringValue: reflect.TypeFor[string](),
+	typeBytesValue:  reflect.TypeFor[[]byte](),
+}
+
+// scope is where a rule is read: the element declaring it, and the path of the
+// FieldRules message within that element's options, so a skipped rule or an
+// error names the rule the way protovalidate's own violations do.
+type scope struct {
+	i       *Interpreter
+	element protoreflect.FullName
+	path    string
+}
+
+// rule is the path of the named rule, or of the scope's own rules message
+// for an empty name.
+func (s scope) rule(name protoreflect.Name) string {
+	switch {
+	case name == "":
+		return s.path
+	case s.path == "":
+		return string(name)
+	}
+
+	return s.path + "." + string(name)
+}
+
+// within is the scope of the rules message under the named rule.
+func (s scope) within(name protoreflect.Name) scope {
+	s.path = s.rule(name)
+	return s
+}
+
+// skip records the named rule as one the schema does not state.
+func (s scope) skip(name protoreflect.Name, reason string) {
+	s.i.record(SkippedRule{Element: s.element, Rule: s.rule(name), Reason: reason})
+}
+
+// wrap names the rule an error came from.
+func (s scope) wrap(name protoreflect.Name, err error) error {
+	return fmt.Errorf("protovalidate: %s: %s: %w", s.element, s.rule(name), err)
+}
+
+// position is the value a FieldRules message constrains: a field, one element
+// of a repeated field, or a map's keys or values.
+type position struct {
+	field jsonschema.FieldContext
+	// The fd field describes the value. A map's keys and values have their own
+	// descriptors; an element of a repeated field shares the field's.
+	fd protoreflect.FieldDescriptor
+	// The element field marks one element of a repeated field.
+	element bool
+}
+
+// shape classifies the position, reading a wrapper message as the value it
+// wraps, since protojson writes the bare value and protovalidate applies the
+// wrapped type's rules to it.
+func (p position) shape() jsonschema.Shape {
+	if wrapped, ok := wrappedTypes[numkind.DerefType(p.field.Type)]; ok {
+		return jsonschema.ShapeOf(wrapped, wellKnownSchema(numkind.DerefType(p.field.Type)))
+	}
+
+	return p.field.Shape()
+}
+
+// collection reports whether the position holds a whole repeated or map field.
+func (p position) collection() bool {
+	return !p.element && (p.fd.IsList() || p.fd.IsMap())
+}
+
+// applyFieldRules applies one FieldRules message to a position.
+func applyFieldRules(s scope, p position, rules *validate.FieldRules) error {
+	if rules.GetIgnore() == validate.Ignore_IGNORE_ALWAYS {
+		return nil
+	}
+
+	for _, expr := range rules.GetCelExpression() {
+		s.skip("cel_expression", expr)
+	}
+
+	for _, rule := range rules.GetCel() {
+		s.skip("cel", rule.GetExpression())
+	}
+
+	if rules.GetRequired() {
+		err := applyRequired(p)
+		if err != nil {
+			return s.wrap("required", err)
+		}
+	}
+
+	m := rules.ProtoReflect()
+
+	typ := m.WhichOneof(m.Descriptor().Oneofs().ByName("type"))
+	if typ == nil {
+		return nil
+	}
+
+	sub := m.Get(typ).Message()
+	s = s.within(typ.Name())
+
+	skipPredefined(s, sub)
+
+	if rules.GetIgnore() == validate.Ignore_IGNORE_IF_ZERO_VALUE && !p.fd.HasPresence() &&
+		(p.collection() || p.fd.Kind() != protoreflect.MessageKind) {
+		return applyUnlessZero(s, p, typ.Name(), sub)
+	}
+
+	return applyTypeRules(s, p, typ.Name(), sub)
+}
+
+// applyTypeRules applies the rules message set in a FieldRules message's type
+// oneof, which the name selects.
+func applyTypeRules(s scope, p position, name protoreflect.Name, sub protoreflect.Message) error {
+	switch name {
+	case "string":
+		return eachRule(s, sub, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+			return applyStringRule(s, p, fd, v)
+		})
+	case "bytes":
+		return eachRule(s, sub, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+			return applyBytesRule(s, p, fd, v)
+		})
+	case "bool":
+		return eachRule(s, sub, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+			return applyScalarRule(p, fd, v)
+		})
+	case "enum":
+		return applyEnumRules(s, p, sub)
+	case "repeated":
+		return applyRepeatedRules(s, p, sub)
+	case "map":
+		return applyMapRules(s, p, sub)
+	case "any", "duration", "field_mask", "timestamp":
+		return eachRule(s, sub, func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) error {
+			if fd.Name() != "example" {
+				s.skip(fd.Name(), fmt.Sprintf("a %s rule compares the parsed message, not its protojson form", name))
+			}
+
+			return nil
+		})
+	}
+
+	return applyNumberRules(s, p, sub)
+}
+
+// applyUnlessZero applies the rules of a field without presence under
+// IGNORE_IF_ZERO_VALUE. protovalidate skips them for the zero value, which
+// protojson input may still carry, so they join the canvas's allOf as an anyOf
+// of the zero value and the rules. An empty list or map meets every rule but a
+// minimum count, so only that is made conditional; the rest, which reach the
+// elements, apply as they stand.
+func applyUnlessZero(s scope, p position, name protoreflect.Name, sub protoreflect.Message) error {
+	if p.collection() {
+		floor := sub.Descriptor().Fields().ByName("min_items")
+		if name == "map" {
+			floor = sub.Descriptor().Fields().ByName("min_pairs")
+		}
+
+		if floor == nil || !sub.Has(floor) {
+			return applyTypeRules(s, p, name, sub)
+		}
+
+		rest := proto.Clone(sub.Interface()).ProtoReflect()
+		rest.Clear(floor)
+
+		err := applyTypeRules(s, p, name, rest)
+		if err != nil {
+			return err
+		}
+
+		only := sub.New()
+		only.Set(floor, sub.Get(floor))
+		sub = only
+	}
+
+	branch := p
+	branch.field = p.field.Detached()
+
+	err := applyTypeRules(s, branch, name, sub)
+	if err != nil {
+		return err
+	}
+
+	// Examples annotate the field rather than the branch.
+	p.field.Canvas.Examples = append(p.field.Canvas.Examples, branch.field.Canvas.Examples...)
+	branch.field.Canvas.Examples = nil
+
+	if jsonschema.IsTrueSchema(branch.field.Canvas) {
+		return nil
+	}
+
+	zero := p
+	zero.field = p.field.Detached()
+
+	err = applyNonZero(zero)
+	if err != nil {
+		return s.wrap("", err)
+	}
+
+	p.field.Canvas.AllOf = append(p.field.Canvas.AllOf, &jsonschema.Schema{
+		AnyOf: []*jsonschema.Schema{{Not: zero.field.Canvas}, branch.field.Canvas},
+	})
+
+	return nil
+}
+
+// eachRule calls apply for every rule set in a rules message, in declaration
+// order so that which of two patterns keeps the pattern keyword does not vary,
+// wrapping any error with the rule's path.
+func eachRule(s scope, m protoreflect.Message, apply func(protoreflect.FieldDescriptor, protoreflect.Value) error) error {
+	fields := m.Descriptor().Fields()
+
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		if !m.Has(fd) {
+			continue
+		}
+
+		err := apply(fd, m.Get(fd))
+		if err != nil {
+			return s.wrap(fd.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+// skipPredefined records the predefined rules set on a rules message. Each is
+// an extension whose meaning is a CEL expression on the extension's own
+// declaration.
+func skipPredefined(s scope, m protoreflect.Message) {
+	var names []protoreflect.FullName
+
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.IsExtension() {
+			names = append(names, fd.FullName())
+		}
+
+		return true
+	})
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		s.skip(protoreflect.Name("["+name+"]"), "a predefined rule is a CEL expression")
+	}
+}
+
+// applyRequired applies required: the property must be present and, for a
+// field without presence, hold a value other than its zero, which is the only
+// value protojson leaves out. A repeated or map field must be non-empty.
+func applyRequired(p position) error {
+	if p.field.Parent != nil && p.field.Name != "" && !slices.Contains(p.field.Parent.Required, p.field.Name) {
+		p.field.Parent.Required = append(p.field.Parent.Required, p.field.Name)
+	}
+
+	if !p.collection() && (p.fd.HasPresence() || p.fd.Kind() == protoreflect.MessageKind) {
+		return nil
+	}
+
+	return applyNonZero(p)
+}
+
+// applyNonZero constrains a position to a value other than its zero: a
+// non-empty list or map, or a scalar other than its zero value.
+func applyNonZero(p position) error {
+	shape := p.shape()
+	if p.fd.Kind() == protoreflect.EnumKind && !p.collection() && named(shape) {
+		p.field.ConstraintsFor(shape).Forbid(enumValue(p.fd.Enum(), 0, true))
+		return nil
+	}
+
+	//nolint:wrapcheck // The caller names the rule.
+	return p.field.ConstraintsFor(shape).Apply(jsonschema.OpNonZero, jsonschema.AxisAuto)
+}
+
+// applyStringRule applies one rule of a StringRules message.
+func applyStringRule(s scope, p position, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	shape := p.shape()
+	c := p.field.ConstraintsFor(shape)
+
+	switch name := fd.Name(); name {
+	case "const":
+		return c.Apply(jsonschema.OpEqual, jsonschema.AxisAuto, v.String())
+	case "len":
+		return c.Apply(jsonschema.OpExactSize, jsonschema.AxisLength, text(fd, v))
+	case "min_len":
+		return c.Apply(jsonschema.OpFloorIncl, jsonschema.AxisLength, text(fd, v))
+	case "max_len":
+		return c.Apply(jsonschema.OpCeilIncl, jsonschema.AxisLength, text(fd, v))
+	case "len_bytes", "min_bytes", "max_bytes":
+		s.skip(name, byteCountReason)
+	case "pattern":
+		return applyKeyword(p.field, shape, jsonschema.OpPattern, v.String(), false)
+	case "prefix":
+		return applyKeyword(p.field, shape, jsonschema.OpPattern, "^"+regexp.QuoteMeta(v.String()), false)
+	case "suffix":
+		return applyKeyword(p.field, shape, jsonschema.OpPattern, regexp.QuoteMeta(v.String())+"$", false)
+	case "contains":
+		return applyKeyword(p.field, shape, jsonschema.OpPattern, regexp.QuoteMeta(v.String()), false)
+	case "not_contains":
+		return applyKeyword(p.field, shape, jsonschema.OpPattern, regexp.QuoteMeta(v.String()), true)
+	case "in":
+		return c.Apply(jsonschema.OpOneOf, jsonschema.AxisAuto, texts(fd, v.List())...)
+	case "not_in":
+		for _, value := range texts(fd, v.List()) {
+			err := c.Apply(jsonschema.OpNotEqual, jsonschema.AxisAuto, value)
+			if err != nil {
+				return err //nolint:wrapcheck // The caller names the rule.
+			}
+		}
+	case "example":
+		appendExamples(p.field, fd, v.List(), shape)
+	case "strict":
+		// Strict only tightens well_known_regex, which is skipped.
+	default:
+		return applyWellKnownString(s, p, shape, fd, v)
+	}
+
+	return nil
+}
+
+// applyWellKnownString applies a well_known string rule. A false value asks
+// for nothing.
+func applyWellKnownString(s scope, p position, shape jsonschema.Shape, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	name := fd.Name()
+	if fd.Kind() == protoreflect.BoolKind && !v.Bool() {
+		return nil
+	}
+
+	if pattern, ok := stringPatterns[name]; ok {
+		return applyKeyword(p.field, shape, jsonschema.OpPattern, pattern, false)
+	}
+
+	formats, ok := stringFormats[name]
+	if !ok {
+		s.skip(name, "no JSON Schema format or pattern states it")
+		return nil
+	}
+
+	if len(formats) == 1 {
+		return applyKeyword(p.field, shape, jsonschema.OpFormat, formats[0], false)
+	}
+
+	branches := make([]*jsonschema.Schema, 0, len(formats))
+
+	for _, format := range formats {
+		branch := p.field.Detached()
+
+		err := branch.ConstraintsFor(shape).Apply(jsonschema.OpFormat, jsonschema.AxisAuto, format)
+		if err != nil {
+			return err //nolint:wrapcheck // The caller names the rule.
+		}
+
+		branches = append(branches, branch.Canvas)
+	}
+
+	p.field.Canvas.AllOf = append(p.field.Canvas.AllOf, &jsonschema.Schema{AnyOf: branches})
+
+	return nil
+}
+
+// applyKeyword applies a pattern or format. The canvas has one slot for each,
+// which the first rule keeps; a second, which must hold as well, joins the
+// canvas's allOf instead, as does a negated rule under a not.
+func applyKeyword(field jsonschema.FieldContext, shape jsonschema.Shape, op jsonschema.Op, value string, negated bool) error {
+	occupied := op == jsonschema.OpPattern && field.Canvas.Pattern != "" && field.Canvas.Pattern != value ||
+		op == jsonschema.OpFormat && field.Canvas.Format != "" && field.Canvas.Format != value
+
+	target := field
+	if negated || occupied {
+		target = field.Detached()
+	}
+
+	err := target.ConstraintsFor(shape).Apply(op, jsonschema.AxisAuto, value)
+	if err != nil {
+		return err //nolint:wrapcheck // The caller names the rule.
+	}
+
+	switch {
+	case negated:
+		field.Canvas.AllOf = append(field.Canvas.AllOf, &jsonschema.Schema{Not: target.Canvas})
+	case occupied:
+		field.Canvas.AllOf = append(field.Canvas.AllOf, target.Canvas)
+	}
+
+	return nil
+}
+
+// applyBytesRule applies one rule of a BytesRules message. Only the rules that
+// compare whole values carry over, against the base64 text protojson writes.
+func applyBytesRule(s scope, p position, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	c := p.field.ConstraintsFor(p.shape())
+
+	switch name := fd.Name(); name {
+	case "const":
+		return c.SetConst(base64.StdEncoding.EncodeToString(v.Bytes()))
+	case "in":
+		return c.SetEnum(instances(fd, v.List(), p.shape()))
+	case "not_in":
+		for _, value := range instances(fd, v.List(), p.shape()) {
+			c.Forbid(value)
+		}
+	case "example":
+		appendExamples(p.field, fd, v.List(), p.shape())
+	default:
+		if fd.Kind() != protoreflect.BoolKind || v.Bool() {
+			s.skip(name, bytesReason)
+		}
+	}
+
+	return nil
+}
+
+// applyScalarRule applies a const or example, the rules a BoolRules message
+// has.
+func applyScalarRule(p position, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	if fd.Name() == "example" {
+		appendExamples(p.field, fd, v.List(), p.shape())
+		return nil
+	}
+
+	//nolint:wrapcheck // The caller names the rule.
+	return p.field.ConstraintsFor(p.shape()).Apply(jsonschema.OpEqual, jsonschema.AxisAuto, text(fd, v))
+}
+
+// applyNumberRules applies one of the numeric rules messages, which share
+// their rule names across every numeric type.
+//
+// A lower bound above the upper one reverses the range: protovalidate then
+// accepts a value outside it, which is an anyOf of the two bounds.
+func applyNumberRules(s scope, p position, m protoreflect.Message) error {
+	shape := p.shape()
+	coerced := shape.Form == jsonschema.FormCoercedNumber
+
+	fields := m.Descriptor().Fields()
+	lower := m.WhichOneof(m.Descriptor().Oneofs().ByName("greater_than"))
+	upper := m.WhichOneof(m.Descriptor().Oneofs().ByName("less_than"))
+
+	if lower != nil && upper != nil && !coerced && less(upper, m.Get(upper), m.Get(lower)) {
+		branches := make([]*jsonschema.Schema, 0, 2)
+
+		for _, bound := range []protoreflect.FieldDescriptor{upper, lower} {
+			branch := p.field.Detached()
+
+			err := applyBound(branch, shape, bound, m.Get(bound))
+			if err != nil {
+				return s.wrap(bound.Name(), err)
+			}
+
+			branches = append(branches, branch.Canvas)
+		}
+
+		p.field.Canvas.AllOf = append(p.field.Canvas.AllOf, &jsonschema.Schema{AnyOf: branches})
+		lower, upper = nil, nil
+	}
+
+	c := p.field.ConstraintsFor(shape)
+
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		if !m.Has(fd) {
+			continue
+		}
+
+		v := m.Get(fd)
+
+		var err error
+
+		switch name := fd.Name(); name {
+		case "lt", "lte", "gt", "gte":
+			switch {
+			case fd != lower && fd != upper:
+			case coerced:
+				s.skip(name, int64Reason)
+			default:
+				err = applyBound(p.field, shape, fd, v)
+			}
+		case "const":
+			err = c.Apply(jsonschema.OpEqual, jsonschema.AxisAuto, text(fd, v))
+		case "in":
+			err = c.Apply(jsonschema.OpOneOf, jsonschema.AxisAuto, texts(fd, v.List())...)
+		case "not_in":
+			for _, value := range texts(fd, v.List()) {
+				err = c.Apply(jsonschema.OpNotEqual, jsonschema.AxisAuto, value)
+				if err != nil {
+					break
+				}
+			}
+		case "example":
+			appendExamples(p.field, fd, v.List(), shape)
+		case "finite":
+			// Every JSON number is finite. Protojson writes the non-finite
+			// values as strings the field's schema already rejects.
+		default:
+			s.skip(name, "unknown numeric rule")
+		}
+
+		if err != nil {
+			return s.wrap(fd.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+// applyBound applies one of gt, gte, lt, and lte.
+func applyBound(field jsonschema.FieldContext, shape jsonschema.Shape, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	op := map[protoreflect.Name]jsonschema.Op{
+		"gt":  jsonschema.OpFloorExcl,
+		"gte": jsonschema.OpFloorIncl,
+		"lt":  jsonschema.OpCeilExcl,
+		"lte": jsonschema.OpCeilIncl,
+	}[fd.Name()]
+
+	//nolint:wrapcheck // The caller names the rule.
+	return field.ConstraintsFor(shape).Apply(op, jsonschema.AxisNumeric, text(fd, v))
+}
+
+// applyEnumRules applies an EnumRules message. Its values are numbers, which
+// protojson writes as the values' names.
+func applyEnumRules(s scope, p position, m protoreflect.Message) error {
+	rules, ok := m.Interface().(*validate.EnumRules)
+	if !ok {
+		return nil
+	}
+
+	shape := p.shape()
+	isNamed := named(shape)
+	ed := p.fd.Enum()
+	c := p.field.ConstraintsFor(shape)
+
+	if rules.Const != nil {
+		err := c.SetConst(enumValue(ed, rules.GetConst(), isNamed))
+		if err != nil {
+			return s.wrap("const", err)
+		}
+	}
+
+	// With defined_only, the set in allows is cut to the defined values. The
+	// names of a named enum already are that set, so there is nothing to add
+	// unless in names some.
+	allowed := rules.GetIn()
+	if rules.GetDefinedOnly() && (len(allowed) > 0 || !isNamed) {
+		allowed = definedValues(ed, allowed)
+	}
+
+	if len(allowed) > 0 || rules.GetDefinedOnly() && !isNamed {
+		values := make([]any, 0, len(allowed))
+		for _, n := range allowed {
+			values = append(values, enumValue(ed, n, isNamed))
+		}
+
+		// The names are the type's own enum, which the canvas cannot replace,
+		// so the allowed subset joins the canvas's allOf.
+		if isNamed {
+			p.field.Canvas.AllOf = append(p.field.Canvas.AllOf, &jsonschema.Schema{Enum: values})
+		} else if err := c.SetEnum(values); err != nil {
+			return s.wrap("in", err)
+		}
+	}
+
+	for _, n := range rules.GetNotIn() {
+		c.Forbid(enumValue(ed, n, isNamed))
+	}
+
+	for _, n := range rules.GetExample() {
+		p.field.Canvas.Examples = append(p.field.Canvas.Examples, enumValue(ed, n, isNamed))
+	}
+
+	return nil
+}
+
+// definedValues returns the values of in the enum defines, or every defined
+// value when in is empty.
+func definedValues(ed protoreflect.EnumDescriptor, in []int32) []int32 {
+	values := ed.Values()
+
+	if len(in) == 0 {
+		out := make([]int32, 0, values.Len())
+		for i := range values.Len() {
+			out = append(out, int32(values.Get(i).Number()))
+		}
+
+		return out
+	}
+
+	return slices.DeleteFunc(slices.Clone(in), func(n int32) bool {
+		return values.ByNumber(protoreflect.EnumNumber(n)) == nil
+	})
+}
+
+// applyRepeatedRules applies a RepeatedRules message: the item count and
+// uniqueness to the array, and the items rules to its element.
+func applyRepeatedRules(s scope, p position, m protoreflect.Message) error {
+	c := p.field.ConstraintsFor(p.shape())
+
+	return eachRule(s, m, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		switch fd.Name() {
+		case "min_items":
+			return c.Apply(jsonschema.OpFloorIncl, jsonschema.AxisItems, text(fd, v))
+		case "max_items":
+			return c.Apply(jsonschema.OpCeilIncl, jsonschema.AxisItems, text(fd, v))
+		case "unique":
+			if !v.Bool() {
+				return nil
+			}
+
+			return c.Apply(jsonschema.OpUnique, jsonschema.AxisAuto, "true")
+		case "items":
+			return applyNested(s.within("items"), p.field.ElementContexts(), p.fd, true, v)
+		}
+
+		return nil
+	})
+}
+
+// applyMapRules applies a MapRules message: the pair count to the object, and
+// the keys and values rules to its property names and values.
+func applyMapRules(s scope, p position, m protoreflect.Message) error {
+	c := p.field.ConstraintsFor(p.shape())
+
+	return eachRule(s, m, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		switch fd.Name() {
+		case "min_pairs":
+			return c.Apply(jsonschema.OpFloorIncl, jsonschema.AxisProperties, text(fd, v))
+		case "max_pairs":
+			return c.Apply(jsonschema.OpCeilIncl, jsonschema.AxisProperties, text(fd, v))
+		case "keys":
+			var keys []jsonschema.FieldContext
+			if key, ok := p.field.KeyContext(); ok {
+				keys = append(keys, key)
+			}
+
+			return applyNested(s.within("keys"), keys, p.fd.MapKey(), false, v)
+		case "values":
+			return applyNested(s.within("values"), p.field.ElementContexts(), p.fd.MapValue(), false, v)
+		}
+
+		return nil
+	})
+}
+
+// applyNested applies the FieldRules message of items, keys, or values to
+// each element context. A field with no element schema (one a provider
+// supplied) has no context, and the rules are recorded as skipped.
+func applyNested(s scope, elems []jsonschema.FieldContext, fd protoreflect.FieldDescriptor, element bool, v protoreflect.Value) error {
+	rules, ok := v.Message().Interface().(*validate.FieldRules)
+	if !ok {
+		return nil
+	}
+
+	if len(elems) == 0 {
+		s.skip("", "the field's schema has no element schema to constrain")
+		return nil
+	}
+
+	for _, elem := range elems {
+		err := applyFieldRules(s, position{field: elem, fd: fd, element: element}, rules)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// named reports whether an enum position is written as names, which it is
+// when [WellKnownTypes] supplied its schema.
+func named(shape jsonschema.Shape) bool {
+	return shape.Form != jsonschema.FormNumber
+}
+
+// enumValue is the instance protojson writes for an enum number: its name, or
+// the number itself when the enum defines no such value or the schema is
+// numeric.
+func enumValue(ed protoreflect.EnumDescriptor, n int32, isNamed bool) any {
+	if isNamed {
+		if value := ed.Values().ByNumber(protoreflect.EnumNumber(n)); value != nil {
+			return string(value.Name())
+		}
+	}
+
+	return n
+}
+
+// less reports whether a < b for two values of fd's kind.
+func less(fd protoreflect.FieldDescriptor, a, b protoreflect.Value) bool {
+	switch fd.Kind() {
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return a.Float() < b.Float()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return a.Uint() < b.Uint()
+	default:
+		return a.Int() < b.Int()
+	}
+}
+
+// text spells a scalar rule value as the parameter the constraint model parses.
+func text(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return v.String()
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(v.Bool())
+	case protoreflect.FloatKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32)
+	case protoreflect.DoubleKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10)
+	default:
+		return strconv.FormatInt(v.Int(), 10)
+	}
+}
+
+// texts spells each value of a repeated rule.
+func texts(fd protoreflect.FieldDescriptor, list protoreflect.List) []string {
+	out := make([]string, 0, list.Len())
+	for i := range list.Len() {
+		out = append(out, text(fd, list.Get(i)))
+	}
+
+	return out
+}
+
+// instances is each value of a repeated rule as the JSON value protojson
+// writes for it.
+func instances(fd protoreflect.FieldDescriptor, list protoreflect.List, shape jsonschema.Shape) []any {
+	out := make([]any, 0, list.Len())
+
+	for i := range list.Len() {
+		v := list.Get(i)
+
+		switch fd.Kind() {
+		case protoreflect.BytesKind:
+			out = append(out, base64.StdEncoding.EncodeToString(v.Bytes()))
+		case protoreflect.StringKind:
+			out = append(out, v.String())
+		case protoreflect.BoolKind:
+			out = append(out, v.Bool())
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			out = append(out, v.Float())
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			out = append(out, number(shape, v.Uint(), text(fd, v)))
+		default:
+			out = append(out, number(shape, v.Int(), text(fd, v)))
+		}
+	}
+
+	return out
+}
+
+// appendExamples adds a rule's example values to the field's examples.
+func appendExamples(field jsonschema.FieldContext, fd protoreflect.FieldDescriptor, list protoreflect.List, shape jsonschema.Shape) {
+	field.Canvas.Examples = append(field.Canvas.Examples, instances(fd, list, shape)...)
+}
+
+// number is an integer as protojson writes it at the shape's form: the decimal
+// string of a 64-bit integer, or the number.
+func number[T int64 | uint64](shape jsonschema.Shape, n T, text string) any {
+	if shape.Form == jsonschema.FormCoercedNumber {
+		return text
+	}
+
+	return n
+}
@@ -0,0 +1,170 @@
+package protovalidate
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/typename"
+)
+
+// Patterns for the text protojson writes where JSON has no matching type.
+const (
+	// int64Pattern matches a signed 64-bit integer in protojson's decimal
+	// string form. The pattern does not bound the magnitude.
+	int64Pattern = `^-?(?:0|[1-9][0-9]*)$`
+	// uint64Pattern matches an unsigned 64-bit integer in the same form.
+	uint64Pattern = `^(?:0|[1-9][0-9]*)$`
+	// durationPattern matches a google.protobuf.Duration: signed seconds
+	// with up to nine fractional digits and an "s" suffix.
+	durationPattern = `^-?[0-9]+(?:\.[0-9]{1,9})?s$`
+	// fieldMaskPattern matches a google.protobuf.FieldMask: comma-separated
+	// paths of lowerCamelCase names joined by dots, or the empty string.
+	fieldMaskPattern = `^(?:[a-z][a-zA-Z0-9]*(?:\.[a-z][a-zA-Z0-9]*)*` +
+		`(?:,[a-z][a-zA-Z0-9]*(?:\.[a-z][a-zA-Z0-9]*)*)*)?$`
+)
+
+var (
+	typeInt64     = reflect.TypeFor[int64]()
+	typeUint64    = reflect.TypeFor[uint64]()
+	typeNullValue = reflect.TypeFor[structpb.NullValue]()
+	typeEnum      = reflect.TypeFor[protoreflect.Enum]()
+
+	typeTimestamp   = reflect.TypeFor[timestamppb.Timestamp]()
+	typeDuration    = reflect.TypeFor[durationpb.Duration]()
+	typeStruct      = reflect.TypeFor[structpb.Struct]()
+	typeValue       = reflect.TypeFor[structpb.Value]()
+	typeListValue   = reflect.TypeFor[structpb.ListValue]()
+	typeAny         = reflect.TypeFor[anypb.Any]()
+	typeEmpty       = reflect.TypeFor[emptypb.Empty]()
+	typeFieldMask   = reflect.TypeFor[fieldmaskpb.FieldMask]()
+	typeDoubleValue = reflect.TypeFor[wrapperspb.DoubleValue]()
+	typeFloatValue  = reflect.TypeFor[wrapperspb.FloatValue]()
+	typeInt64Value  = reflect.TypeFor[wrapperspb.Int64Value]()
+	typeUInt64Value = reflect.TypeFor[wrapperspb.UInt64Value]()
+	typeInt32Value  = reflect.TypeFor[wrapperspb.Int32Value]()
+	typeUInt32Value = reflect.TypeFor[wrapperspb.UInt32Value]()
+	typeBoolValue   = reflect.TypeFor[wrapperspb.BoolValue]()
+	typeStringValue = reflect.TypeFor[wrapperspb.StringValue]()
+	typeBytesValue  = reflect.TypeFor[wrapperspb.BytesValue]()
+)
+
+// WellKnownTypes returns a [jsonschema.TypeSchemaProvider] describing the
+// types whose protojson encoding differs from what reflection sees in the
+// generated Go struct. Register it with [jsonschema.WithTypeSchemaProvider];
+// it answers [jsonschema.ErrTypeNotHandled] for every other type. It maps:
+//
+//   - google.protobuf.Timestamp to an RFC 3339 string (the date-time format).
+//   - google.protobuf.Duration to a decimal count of seconds suffixed "s".
+//   - The wrapper types to the value they wrap: Int64Value and UInt64Value to
+//     decimal strings, BytesValue to a base64 string, the rest to the JSON
+//     scalar of their value.
+//   - google.protobuf.Struct to an object, ListValue to an array, and Value to
+//     any JSON value.
+//   - google.protobuf.Any to an object carrying an "@type" URL,
+//     google.protobuf.Empty to the empty object, and FieldMask to its
+//     comma-separated paths.
+//   - A generated enum to the string names of its values, and NullValue to
+//     null.
+//   - int64 and uint64 to the decimal strings protojson writes for every
+//     64-bit integer field.
+//
+// The int64 and uint64 entries match those types wherever they occur in the
+// generation run, so register the provider only when generating schemas for
+// protobuf messages. Protojson writes an enum value with no name as its number
+// and a non-finite float or double as "NaN", "Infinity", or "-Infinity"; both
+// fall outside the schemas here.
+func WellKnownTypes() jsonschema.TypeSchemaProvider {
+	return wellKnownTypes{}
+}
+
+// wellKnownTypes is the [jsonschema.TypeSchemaProvider] [WellKnownTypes]
+// returns.
+type wellKnownTypes struct{}
+
+func (wellKnownTypes) SchemaForType(_ context.Context, tc jsonschema.TypeContext) (jsonschema.TypeSchema, error) {
+	s := wellKnownSchema(tc.Type)
+	if s == nil {
+		return jsonschema.TypeSchema{}, fmt.Errorf("%w: %s", jsonschema.ErrTypeNotHandled, tc.Type)
+	}
+
+	return jsonschema.TypeSchema{Value: s}, nil
+}
+
+// wellKnownSchema returns the provider's schema for t, or nil.
+func wellKnownSchema(t reflect.Type) *jsonschema.Schema {
+	switch t {
+	case typeInt64, typeInt64Value:
+		return &jsonschema.Schema{Type: typename.String, Pattern: int64Pattern}
+	case typeUint64, typeUInt64Value:
+		return &jsonschema.Schema{Type: typename.String, Pattern: uint64Pattern}
+	case typeNullValue:
+		return &jsonschema.Schema{Type: typename.Null}
+	case typeTimestamp:
+		return &jsonschema.Schema{Type: typename.String, Format: "date-time"}
+	case typeDuration:
+		return &jsonschema.Schema{Type: typename.String, Pattern: durationPattern}
+	case typeStruct:
+		return &jsonschema.Schema{Type: typename.Object}
+	case typeListValue:
+		return &jsonschema.Schema{Type: typename.Array}
+	case typeValue:
+		return &jsonschema.Schema{}
+	case typeAny:
+		return &jsonschema.Schema{
+			Type:       typename.Object,
+			Properties: map[string]*jsonschema.Schema{"@type": {Type: typename.String}},
+			Required:   []string{"@type"},
+		}
+	case typeEmpty:
+		return &jsonschema.Schema{Type: typename.Object, MaxProperties: new(0)}
+	case typeFieldMask:
+		return &jsonschema.Schema{Type: typename.String, Pattern: fieldMaskPattern}
+	case typeDoubleValue, typeFloatValue:
+		return &jsonschema.Schema{Type: typename.Number}
+	case typeInt32Value:
+		return boundedInteger(math.MinInt32, math.MaxInt32)
+	case typeUInt32Value:
+		return boundedInteger(0, math.MaxUint32)
+	case typeBoolValue:
+		return &jsonschema.Schema{Type: typename.Boolean}
+	case typeStringValue:
+		return &jsonschema.Schema{Type: typename.String}
+	case typeBytesValue:
+		return &jsonschema.Schema{Type: typename.String, ContentEncoding: "base64"}
+	}
+
+	if t.Kind() == reflect.Int32 && t.Implements(typeEnum) {
+		return enumSchema(reflect.Zero(t).Interface().(protoreflect.Enum).Descriptor())
+	}
+
+	return nil
+}
+
+// enumSchema is the string form of an enum: the names of its values.
+func enumSchema(ed protoreflect.EnumDescriptor) *jsonschema.Schema {
+	values := ed.Values()
+	names := make([]any, 0, values.Len())
+
+	for i := range values.Len() {
+		names = append(names, string(values.Get(i).Name()))
+	}
+
+	return &jsonschema.Schema{Type: typename.String, Enum: names}
+}
+
+// boundedInteger is an integer schema with inclusive bounds.
+func boundedInteger(minimum, maximum float64) *jsonschema.Schema {
+	return &jsonschema.Schema{Type: typename.Integer, Minimum: new(minimum), Maximum: new(maximum)}
+}
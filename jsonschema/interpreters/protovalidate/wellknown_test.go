@@ -0,0 +1,140 @@
+package protovalidate_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/interpreters/protovalidate"
+	"go.jacobcolvin.com/x/jsonschema/interpreters/protovalidate/internal/testpb"
+)
+
+func TestWellKnownTypes(t *testing.T) {
+	t.Parallel()
+
+	mustAny := func(m proto.Message) *anypb.Any {
+		a, err := anypb.New(m)
+		require.NoError(t, err)
+
+		return a
+	}
+
+	tests := map[string]struct {
+		values  []proto.Message
+		invalid []string
+	}{
+		"Timestamp": {
+			values:  []proto.Message{timestamppb.Now(), &timestamppb.Timestamp{}},
+			invalid: []string{`"2024-01-01"`, `{"seconds":1}`},
+		},
+		"Duration": {
+			values:  []proto.Message{durationpb.New(-1500), &durationpb.Duration{Seconds: 3}},
+			invalid: []string{`"3"`, `"1m"`, `"1.0000000001s"`},
+		},
+		"Int64Value": {
+			values:  []proto.Message{wrapperspb.Int64(-1 << 62), wrapperspb.Int64(0)},
+			invalid: []string{`1`, `"01"`, `{"value":"1"}`},
+		},
+		"UInt64Value": {
+			values:  []proto.Message{wrapperspb.UInt64(1 << 63)},
+			invalid: []string{`"-1"`},
+		},
+		"Int32Value": {
+			values:  []proto.Message{wrapperspb.Int32(-5)},
+			invalid: []string{`"5"`, `2147483648`},
+		},
+		"UInt32Value": {
+			values:  []proto.Message{wrapperspb.UInt32(5)},
+			invalid: []string{`-1`},
+		},
+		"DoubleValue": {values: []proto.Message{wrapperspb.Double(0.25)}, invalid: []string{`"0.25"`}},
+		"FloatValue":  {values: []proto.Message{wrapperspb.Float(2)}, invalid: []string{`true`}},
+		"BoolValue":   {values: []proto.Message{wrapperspb.Bool(true)}, invalid: []string{`"true"`}},
+		"StringValue": {values: []proto.Message{wrapperspb.String("x")}, invalid: []string{`1`}},
+		"BytesValue":  {values: []proto.Message{wrapperspb.Bytes([]byte{1, 2, 3})}, invalid: []string{`[1]`}},
+		"Struct": {
+			values:  []proto.Message{&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewNullValue()}}},
+			invalid: []string{`[]`},
+		},
+		"ListValue": {
+			values:  []proto.Message{&structpb.ListValue{Values: []*structpb.Value{structpb.NewBoolValue(true)}}},
+			invalid: []string{`{}`},
+		},
+		"Value": {values: []proto.Message{structpb.NewNullValue(), structpb.NewStringValue("x")}},
+		"Any": {
+			values:  []proto.Message{mustAny(wrapperspb.Int64(3)), mustAny(&testpb.Address{City: "x"})},
+			invalid: []string{`{}`},
+		},
+		"Empty": {values: []proto.Message{&emptypb.Empty{}}, invalid: []string{`{"a":1}`}},
+		"FieldMask": {
+			values:  []proto.Message{&fieldmaskpb.FieldMask{Paths: []string{"display_name", "home.city"}}},
+			invalid: []string{`"display_name"`, `["a"]`},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := jsonschema.Generate(t.Context(), reflect.TypeOf(tc.values[0]).Elem(),
+				jsonschema.WithTypeSchemaProvider(protovalidate.WellKnownTypes()),
+			)
+			require.NoError(t, err)
+
+			v, err := jsonschema.Compile(t.Context(), s, jsonschema.WithFormats(true))
+			require.NoError(t, err)
+
+			for _, m := range tc.values {
+				doc, err := protojson.Marshal(m)
+				require.NoError(t, err)
+				require.NoError(t, v.ValidateJSON(t.Context(), doc), string(doc))
+			}
+
+			for _, doc := range tc.invalid {
+				require.Error(t, v.ValidateJSON(t.Context(), []byte(doc)), doc)
+			}
+		})
+	}
+}
+
+func TestWellKnownTypes_Scalars(t *testing.T) {
+	t.Parallel()
+
+	type scalars struct {
+		Status testpb.Status      `json:"status"`
+		Null   structpb.NullValue `json:"null"`
+		Signed int64              `json:"signed"`
+		Count  uint64             `json:"count"`
+	}
+
+	s, err := jsonschema.GenerateFor[scalars](t.Context(),
+		jsonschema.WithTypeSchemaProvider(protovalidate.WellKnownTypes()),
+	)
+	require.NoError(t, err)
+
+	v, err := jsonschema.Compile(t.Context(), s)
+	require.NoError(t, err)
+
+	require.NoError(t, v.ValidateJSON(t.Context(),
+		[]byte(`{"status":"STATUS_ACTIVE","null":null,"signed":"-9223372036854775808","count":"18446744073709551615"}`)))
+
+	for _, doc := range []string{
+		`{"status":1,"null":null,"signed":"1","count":"1"}`,
+		`{"status":"STATUS_ACTIVE","null":"NULL_VALUE","signed":"1","count":"1"}`,
+		`{"status":"STATUS_ACTIVE","null":null,"signed":1,"count":"1"}`,
+		`{"status":"STATUS_ACTIVE","null":null,"signed":"1","count":"-1"}`,
+	} {
+		require.Error(t, v.ValidateJSON(t.Context(), []byte(doc)), doc)
+	}
+}
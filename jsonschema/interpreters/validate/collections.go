@@ -3,6 +3,8 @@ package validate
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"go.jacobcolvin.com/x/jsonschema"
 	"go.jacobcolvin.com/x/jsonschema/internal/tagmodel"
@@ -17,7 +19,26 @@ import (
 // sequence itself reach the elements by one path and cannot disagree about what
 // an element is. What a dive does not do is decide anything about the elements:
 // their shapes classify themselves.
+//
+// A keys...endkeys block right after the dive is the one part that is not
+// about the elements: it constrains the map's keys, and the parts after it
+// reach the values as usual.
 func applyDive(remaining []string, field jsonschema.FieldContext) error {
+	if len(remaining) > 0 && strings.TrimSpace(remaining[0]) == "keys" {
+		end := slices.IndexFunc(remaining, func(p string) bool { return strings.TrimSpace(p) == "endkeys" })
+		if end > 0 {
+			err := applyKeys(remaining[1:end], field)
+			if err != nil {
+				return err
+			}
+
+			remaining = remaining[end+1:]
+			if !hasConstraint(remaining) {
+				return nil
+			}
+		}
+	}
+
 	elems := field.ElementContexts()
 	if len(elems) == 0 {
 		return fmt.Errorf("validate tag: cannot dive: %w", noElementsReason(field))
@@ -33,6 +54,21 @@ func applyDive(remaining []string, field jsonschema.FieldContext) error {
 	return nil
 }
 
+// applyKeys applies the parts of a keys...endkeys block to the map's key
+// context, which renders as the map's propertyNames. The parts run through
+// [applyParts] like any other, against the property name's shape: a string
+// key takes lengths, patterns, formats, and oneof as they are, and an integer
+// key, whose name is its digits, takes oneof as the digit strings but rejects
+// a numeric bound no keyword over the name can state.
+func applyKeys(parts []string, field jsonschema.FieldContext) error {
+	keys, ok := field.KeyContext()
+	if !ok {
+		return fmt.Errorf("validate tag: keys: %s has no map keys to constrain", field.Type)
+	}
+
+	return applyParts(parts, keys)
+}
+
 // noElementsReason names why a field has no element schema to descend into.
 // The reason itself comes from the shared model, so a dive and a sequence-wide
 // rule report the same fact in the same words. Reporting at all -- rather than
@@ -0,0 +1,411 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/typename"
+)
+
+// conditionalRule is one row of the conditional table: a required_* or
+// excluded_* validator, which asserts the field is set (or unset) when a
+// condition on its sibling fields holds.
+type conditionalRule struct {
+	// The cond field names how the parameter's fields combine into the
+	// condition.
+	cond condition
+	// The exclude field is set for the excluded_* family, whose field must be
+	// unset rather than set while the condition holds.
+	exclude bool
+}
+
+// condition is how a conditional validator reads its parameter.
+type condition int
+
+const (
+	// condWith holds when any named field is set.
+	condWith condition = iota
+	// condWithAll holds when every named field is set.
+	condWithAll
+	// condWithout holds when any named field is unset.
+	condWithout
+	// condWithoutAll holds when every named field is unset.
+	condWithoutAll
+	// condIf holds when every field=value pair matches.
+	condIf
+	// condUnless holds when no field=value pair matches.
+	condUnless
+)
+
+// conditionalKeys is the conditional half of go-playground's cross-field
+// vocabulary. Each names a condition on sibling fields and whether the field
+// must then be set or unset, which JSON Schema states on the enclosing object
+// with if/then; the rest of the cross-field validators compare two values,
+// which no keyword can, and stay skipped.
+var conditionalKeys = map[string]conditionalRule{
+	"required_with":        {cond: condWith},
+	"required_with_all":    {cond: condWithAll},
+	"required_without":     {cond: condWithout},
+	"required_without_all": {cond: condWithoutAll},
+	"required_if":          {cond: condIf},
+	"required_unless":      {cond: condUnless},
+	"excluded_with":        {cond: condWith, exclude: true},
+	"excluded_with_all":    {cond: condWithAll, exclude: true},
+	"excluded_without":     {cond: condWithout, exclude: true},
+	"excluded_without_all": {cond: condWithoutAll, exclude: true},
+	"excluded_if":          {cond: condIf, exclude: true},
+	"excluded_unless":      {cond: condUnless, exclude: true},
+}
+
+// applyConditional translates one conditional validator onto the field's
+// parent object: an if naming the condition on the sibling properties, and a
+// then asserting the field's own property set or unset.
+//
+// Set means what go-playground's hasValue means: a nil pointer, slice, map, or
+// interface is unset, and any other value is unset when it is its zero value.
+// A property says that directly when encoding/json omits the field exactly
+// when it is zero ([jsonschema.FieldContext.OmitsZero]); otherwise a nilable
+// field is set when it is present and not null, and a scalar when it is
+// present and non-zero, which is the required rule applied to a scratch canvas.
+// Any other field has no such reading, a struct or an omitempty slice (absent
+// while empty but not nil) say, so a rule naming one is an error rather than a
+// condition that silently differs.
+//
+// A required_with whose field and triggers all omit their zero values is
+// exactly dependentRequired (dependencies under [jsonschema.Draft7]), which is
+// what it is written as.
+func applyConditional(key, value string, rule conditionalRule, field jsonschema.FieldContext) error {
+	tag := key + "=" + value
+
+	if field.Parent == nil || field.Name == "" {
+		return fmt.Errorf("validate tag: %s: no enclosing struct to read sibling fields from", tag)
+	}
+
+	params := splitOneOfValues(value)
+	if key == "excluded_without" {
+		// The one member of the family go-playground does not split: its
+		// parameter is a single field name.
+		params = []string{strings.TrimSpace(value)}
+	}
+
+	if len(params) == 0 || params[0] == "" {
+		return fmt.Errorf("validate tag: %s names no field", key)
+	}
+
+	// Go-playground panics on a required_if naming a field twice, though
+	// excluded_if only never fires and required_unless reads it as either
+	// value.
+	if key == "required_if" {
+		seen := make(map[string]bool, len(params)/2)
+		for i := 0; i < len(params); i += 2 {
+			if seen[params[i]] {
+				return fmt.Errorf("validate tag: %s names field %s twice", key, params[i])
+			}
+
+			seen[params[i]] = true
+		}
+	}
+
+	if rule.cond == condWith && !rule.exclude && dependentRequired(params, field) {
+		return nil
+	}
+
+	cond, err := conditionSchema(rule.cond, params, field)
+	if err != nil {
+		return fmt.Errorf("validate tag: %s: %w", tag, err)
+	}
+
+	then, err := presence(field)
+	if err != nil {
+		return fmt.Errorf("validate tag: %s: %w", tag, err)
+	}
+
+	if rule.exclude {
+		then = negate(then)
+	}
+
+	field.Parent.AllOf = append(field.Parent.AllOf, &jsonschema.Schema{If: cond, Then: then})
+
+	return nil
+}
+
+// dependentRequired writes a required_with as a property dependency when every
+// name in it and the field itself omit their zero values, and reports whether
+// it did.
+func dependentRequired(params []string, field jsonschema.FieldContext) bool {
+	if !field.OmitsZero() {
+		return false
+	}
+
+	names := make([]string, len(params))
+	for i, param := range params {
+		sib, ok := field.Sibling(param)
+		if !ok || !sib.OmitsZero() {
+			return false
+		}
+
+		names[i] = sib.Name
+	}
+
+	deps := &field.Parent.DependentRequired
+	if field.Draft == jsonschema.Draft7 {
+		deps = &field.Parent.DependencyStrings
+	}
+
+	if *deps == nil {
+		*deps = map[string][]string{}
+	}
+
+	for _, name := range names {
+		if !slices.Contains((*deps)[name], field.Name) {
+			(*deps)[name] = append((*deps)[name], field.Name)
+		}
+	}
+
+	return true
+}
+
+// conditionSchema builds the if schema for a condition over params.
+func conditionSchema(cond condition, params []string, field jsonschema.FieldContext) (*jsonschema.Schema, error) {
+	if cond == condIf || cond == condUnless {
+		return valueCondition(cond, params, field)
+	}
+
+	terms := make([]*jsonschema.Schema, 0, len(params))
+
+	for _, param := range params {
+		sib, err := sibling(param, field)
+		if err != nil {
+			return nil, err
+		}
+
+		term, err := presence(sib)
+		if err != nil {
+			return nil, err
+		}
+
+		if cond == condWithout || cond == condWithoutAll {
+			term = negate(term)
+		}
+
+		terms = append(terms, term)
+	}
+
+	if cond == condWith || cond == condWithout {
+		return anyOf(terms), nil
+	}
+
+	return allOf(terms), nil
+}
+
+// valueCondition builds the if schema for the field=value pairs of a
+// required_if or required_unless family rule.
+func valueCondition(cond condition, params []string, field jsonschema.FieldContext) (*jsonschema.Schema, error) {
+	if len(params)%2 != 0 {
+		return nil, fmt.Errorf("want field and value pairs, got %d params", len(params))
+	}
+
+	terms := make([]*jsonschema.Schema, 0, len(params)/2)
+
+	for i := 0; i < len(params); i += 2 {
+		name, value := params[i], params[i+1]
+
+		sib, err := sibling(name, field)
+		if err != nil {
+			return nil, err
+		}
+
+		term, err := equals(sib, value)
+		if err != nil {
+			return nil, err
+		}
+
+		if cond == condUnless {
+			term = negate(term)
+		}
+
+		terms = append(terms, term)
+	}
+
+	return allOf(terms), nil
+}
+
+// sibling looks up the field a conditional names, by its Go field name as
+// go-playground does.
+func sibling(name string, field jsonschema.FieldContext) (jsonschema.FieldContext, error) {
+	sib, ok := field.Sibling(name)
+	if !ok {
+		return jsonschema.FieldContext{}, fmt.Errorf("no field %s in the enclosing struct", name)
+	}
+
+	return sib, nil
+}
+
+// presence is the schema an object satisfies when the field is set.
+func presence(field jsonschema.FieldContext) (*jsonschema.Schema, error) {
+	required := &jsonschema.Schema{Required: []string{field.Name}}
+	if field.OmitsZero() {
+		return required, nil
+	}
+
+	if field.Omittable() {
+		return nil, fmt.Errorf("cannot tell whether %s is set: it is omitted for values other than the zero value",
+			field.Name)
+	}
+
+	switch field.Type.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		required.Properties = map[string]*jsonschema.Schema{
+			field.Name: {Not: &jsonschema.Schema{Type: typename.Null}},
+		}
+
+		return required, nil
+	}
+
+	shape := field.Shape()
+	if !isScalarForm(shape.Form) {
+		return nil, fmt.Errorf("cannot tell from %s whether %s is set; omit it when zero (omitzero)",
+			field.Type, field.Name)
+	}
+
+	nonZero, err := scratch(field, shape, jsonschema.OpNonZero)
+	if err != nil {
+		return nil, err
+	}
+
+	required.Properties = map[string]*jsonschema.Schema{field.Name: nonZero}
+
+	return required, nil
+}
+
+// equals is the schema an object satisfies when the field equals value as
+// go-playground compares it: the parsed number, true only for "true", and the
+// exact string, through a pointer when it is not nil. A nil pointer equals
+// only "nil", which a pointer to a number or bool go-playground panics on once
+// it is set, so that is an error.
+func equals(field jsonschema.FieldContext, value string) (*jsonschema.Schema, error) {
+	shape := field.Shape()
+	if !isScalarForm(shape.Form) {
+		return nil, fmt.Errorf("cannot compare %s %s to a value", field.Type, field.Name)
+	}
+
+	if shape.Kind == reflect.Bool && value != "true" {
+		value = "false"
+	}
+
+	pinned, err := scratch(field, shape, jsonschema.OpEqual, value)
+	if err != nil {
+		return nil, err
+	}
+
+	cond := &jsonschema.Schema{Properties: map[string]*jsonschema.Schema{field.Name: pinned}}
+
+	pointer := field.Type.Kind() == reflect.Pointer
+	if value == "nil" && pointer {
+		set, err := presence(field)
+		if err != nil {
+			return nil, err
+		}
+
+		cond.Required = []string{field.Name}
+
+		return anyOf([]*jsonschema.Schema{negate(set), cond}), nil
+	}
+
+	// An omitted non-pointer field decodes as its zero value, so a condition
+	// on the zero value also holds while the property is absent.
+	if !pointer && field.OmitsZero() {
+		zero, err := scratch(field, shape, jsonschema.OpEqual, zeroLiteral(shape.Kind))
+		if err == nil && reflect.DeepEqual(zero, pinned) {
+			return cond, nil
+		}
+	}
+
+	cond.Required = []string{field.Name}
+
+	return cond, nil
+}
+
+// scratch applies one operation to a detached copy of the field and returns
+// its canvas, so a sibling's value constraint can be stated inside a condition
+// without touching its own schema.
+func scratch(field jsonschema.FieldContext, shape jsonschema.Shape, op jsonschema.Op, params ...string) (*jsonschema.Schema, error) {
+	field = field.Detached()
+
+	err := field.ConstraintsFor(shape).Apply(op, jsonschema.AxisAuto, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return field.Canvas, nil
+}
+
+// isScalarForm reports whether a form's zero value and equality are the Go
+// value's, which is what lets a scratch canvas state them.
+func isScalarForm(form jsonschema.Form) bool {
+	switch form {
+	case jsonschema.FormString, jsonschema.FormNumber, jsonschema.FormBool,
+		jsonschema.FormCoercedNumber, jsonschema.FormCoercedBool, jsonschema.FormCoercedString:
+		return true
+	}
+
+	return false
+}
+
+// zeroLiteral spells the zero value of a scalar kind as a tag parameter.
+func zeroLiteral(kind reflect.Kind) string {
+	switch kind {
+	case reflect.String:
+		return ""
+	case reflect.Bool:
+		return "false"
+	default:
+		return "0"
+	}
+}
+
+// anyOf combines terms, leaving a single term bare.
+func anyOf(terms []*jsonschema.Schema) *jsonschema.Schema {
+	if len(terms) == 1 {
+		return terms[0]
+	}
+
+	return &jsonschema.Schema{AnyOf: terms}
+}
+
+// allOf combines terms, leaving a single term bare.
+func allOf(terms []*jsonschema.Schema) *jsonschema.Schema {
+	if len(terms) == 1 {
+		return terms[0]
+	}
+
+	return &jsonschema.Schema{AllOf: terms}
+}
+
+// isNilable reports whether the field's Go value can be nil, which is when an
+// omitnil stops validation.
+func isNilable(field jsonschema.FieldContext) bool {
+	if field.Type == nil {
+		return false
+	}
+
+	switch field.Type.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return true
+	}
+
+	return false
+}
+
+// negate is the schema an object satisfies when s fails, unwrapping a bare
+// not rather than stacking a second one on it.
+func negate(s *jsonschema.Schema) *jsonschema.Schema {
+	if s.Not != nil && reflect.DeepEqual(*s, jsonschema.Schema{Not: s.Not}) {
+		return s.Not
+	}
+
+	return &jsonschema.Schema{Not: s}
+}
@@ -0,0 +1,815 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// errLayout reports a datetime layout whose text time.Parse reads in a way no
+// pattern states: a field whose extent depends on what follows it, or one this
+// translation does not model.
+var errLayout = errors.New("no pattern matches what time.Parse accepts")
+
+// layoutElem is one element of a Go time layout, named after the reference
+// time it spells. The zero value is literal text.
+type layoutElem int
+
+const (
+	elemLiteral layoutElem = iota
+	elemSpace
+	elemLongMonth
+	elemMonth
+	elemNumMonth
+	elemZeroMonth
+	elemLongWeekDay
+	elemWeekDay
+	elemDay
+	elemUnderDay
+	elemZeroDay
+	elemUnderYearDay
+	elemZeroYearDay
+	elemHour
+	elemHour12
+	elemZeroHour12
+	elemMinute
+	elemZeroMinute
+	elemSecond
+	elemZeroSecond
+	elemLongYear
+	elemYear
+	elemPM
+	elemLowerPM
+	elemTZ
+	elemISO8601TZ
+	elemISO8601SecondsTZ
+	elemISO8601ShortTZ
+	elemISO8601ColonTZ
+	elemISO8601ColonSecondsTZ
+	elemNumTZ
+	elemNumSecondsTZ
+	elemNumShortTZ
+	elemNumColonTZ
+	elemNumColonSecondsTZ
+	elemFracSecond0
+	elemFracSecond9
+)
+
+// layoutToken is one element of a tokenized layout.
+type layoutToken struct {
+	// The text field is the literal text of an elemLiteral token.
+	text string
+	// The digits field is the digit count of an elemFracSecond0 token.
+	digits int
+	elem   layoutElem
+}
+
+// The offset fields of a numeric zone, as time.Parse range-checks them: an
+// hour up to 24 and a minute or second up to 60, each two digits.
+const (
+	zoneHour = `(?:[01][0-9]|2[0-4])`
+	zoneMin  = `(?:[0-5][0-9]|60)`
+)
+
+// The year patterns a February 29th requires. A four-digit year is leap by the
+// Gregorian rule; a two-digit year reads as 19yy or 20yy, where the rule
+// reduces to divisibility by four, and a signed digit reads as 2000 plus or
+// minus it.
+const (
+	leapLongYear = `(?:[0-9]{2}(?:0[48]|[2468][048]|[13579][26])|(?:[02468][048]|[13579][26])00)`
+	leapYear     = `(?:[02468][048]|[13579][26]|[+-][048])`
+)
+
+// datetimePattern is the pattern a string meets exactly when time.Parse reads
+// it under layout, which is go-playground's datetime=<layout>.
+//
+// Each layout element becomes the text time.Parse accepts there: a number
+// within the element's range, written with the digit count the element reads,
+// a month or weekday name in any ASCII case, a zone in each spelling the parser
+// takes. The day of the month is the one check time.Parse makes across
+// elements, against the month and year, so a layout naming a day is the
+// alternation of one copy per month length, February 29th requiring a leap
+// year.
+//
+// A layout is an error where the parser's reading cannot be stated over the
+// text alone: a variable-width number or fraction directly followed by text
+// that may itself begin with a digit, since the parser takes the digits
+// greedily; a day of the year; or a month, day, or year named twice.
+func datetimePattern(layout string) (string, error) {
+	toks, err := layoutTokens(layout)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", errLayout, err)
+	}
+
+	var alts []string
+
+	for _, cal := range calendars(toks) {
+		text, err := layoutText(toks, cal)
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", errLayout, err)
+		}
+
+		alts = append(alts, text)
+	}
+
+	return `^(?:` + strings.Join(alts, "|") + `)$`, nil
+}
+
+// calendar is one month length the day of the month is checked against.
+type calendar struct {
+	// The months field lists the months of this length, or is nil when the
+	// layout names no day and every month is allowed.
+	months []int
+	dayLo  int
+	dayHi  int
+	// The leap field requires a leap year, for February 29th.
+	leap bool
+}
+
+// calendars returns one calendar per month length the layout's day must be
+// checked against: a single one when it names no day, or no month (which then
+// defaults to January), and otherwise the 31- and 30-day months, February up to
+// the 28th, and February 29th.
+func calendars(toks []layoutToken) []calendar {
+	hasDay := slices.ContainsFunc(toks, func(t layoutToken) bool { return isDayElem(t.elem) })
+	hasMonth := slices.ContainsFunc(toks, func(t layoutToken) bool { return isMonthElem(t.elem) })
+
+	switch {
+	case !hasDay:
+		return []calendar{{}}
+	case !hasMonth:
+		return []calendar{{dayLo: 1, dayHi: 31}}
+	}
+
+	return []calendar{
+		{months: []int{1, 3, 5, 7, 8, 10, 12}, dayLo: 1, dayHi: 31},
+		{months: []int{4, 6, 9, 11}, dayLo: 1, dayHi: 30},
+		{months: []int{2}, dayLo: 1, dayHi: 28},
+		{months: []int{2}, dayLo: 29, dayHi: 29, leap: true},
+	}
+}
+
+// layoutTokens splits a layout into its elements the way time.Parse does,
+// separating each run of spaces, which the parser matches as one or more
+// spaces, from the literal text around it.
+func layoutTokens(layout string) ([]layoutToken, error) {
+	var (
+		toks []layoutToken
+		seen = map[string]bool{}
+	)
+
+	for layout != "" {
+		prefix, tok, suffix := nextLayoutChunk(layout)
+		toks = appendLiteral(toks, prefix)
+
+		if tok.elem == elemLiteral {
+			break
+		}
+
+		field := ""
+
+		switch {
+		case tok.elem == elemUnderYearDay || tok.elem == elemZeroYearDay:
+			return nil, errors.New("a day of the year is not supported")
+		case tok.elem == elemFracSecond0 && tok.digits > 9:
+			return nil, errors.New("a fraction longer than nine digits is not supported")
+		case isMonthElem(tok.elem):
+			field = "month"
+		case isDayElem(tok.elem):
+			field = "day"
+		case tok.elem == elemYear || tok.elem == elemLongYear:
+			field = "year"
+		}
+
+		if field != "" {
+			if seen[field] {
+				return nil, fmt.Errorf("the layout names the %s twice", field)
+			}
+
+			seen[field] = true
+		}
+
+		toks = append(toks, tok)
+		layout = suffix
+	}
+
+	return toks, nil
+}
+
+// appendLiteral appends literal layout text, one token per run of spaces and
+// per run of anything else.
+func appendLiteral(toks []layoutToken, text string) []layoutToken {
+	for text != "" {
+		if text[0] == ' ' {
+			toks = append(toks, layoutToken{elem: elemSpace})
+			text = strings.TrimLeft(text, " ")
+
+			continue
+		}
+
+		n := strings.IndexByte(text, ' ')
+		if n < 0 {
+			n = len(text)
+		}
+
+		toks = append(toks, layoutToken{text: text[:n]})
+		text = text[n:]
+	}
+
+	return toks
+}
+
+// nextLayoutChunk finds the first element in layout, returning the literal text
+// before it, the element, and the text after it. It is time's own tokenizer,
+// restated, since the two must agree on where every element starts.
+//
+//nolint:gocyclo // One case per reference-time spelling, as in package time.
+func nextLayoutChunk(layout string) (string, layoutToken, string) {
+	at := func(i, n int, e layoutElem) (string, layoutToken, string) {
+		return layout[:i], layoutToken{elem: e}, layout[i+n:]
+	}
+
+	for i := 0; i < len(layout); i++ {
+		rest := layout[i:]
+
+		switch layout[i] {
+		case 'J':
+			if strings.HasPrefix(rest, "January") {
+				return at(i, 7, elemLongMonth)
+			}
+
+			if strings.HasPrefix(rest, "Jan") && !startsLower(rest[3:]) {
+				return at(i, 3, elemMonth)
+			}
+
+		case 'M':
+			if strings.HasPrefix(rest, "Monday") {
+				return at(i, 6, elemLongWeekDay)
+			}
+
+			if strings.HasPrefix(rest, "Mon") && !startsLower(rest[3:]) {
+				return at(i, 3, elemWeekDay)
+			}
+
+			if strings.HasPrefix(rest, "MST") {
+				return at(i, 3, elemTZ)
+			}
+
+		case '0':
+			if len(rest) >= 2 && '1' <= rest[1] && rest[1] <= '6' {
+				zero := [...]layoutElem{
+					elemZeroMonth, elemZeroDay, elemZeroHour12, elemZeroMinute, elemZeroSecond, elemYear,
+				}
+
+				return at(i, 2, zero[rest[1]-'1'])
+			}
+
+			if strings.HasPrefix(rest, "002") {
+				return at(i, 3, elemZeroYearDay)
+			}
+
+		case '1':
+			if strings.HasPrefix(rest, "15") {
+				return at(i, 2, elemHour)
+			}
+
+			return at(i, 1, elemNumMonth)
+
+		case '2':
+			if strings.HasPrefix(rest, "2006") {
+				return at(i, 4, elemLongYear)
+			}
+
+			return at(i, 1, elemDay)
+
+		case '_':
+			if strings.HasPrefix(rest, "_2006") {
+				// A literal underscore, then the year.
+				return at(i+1, 4, elemLongYear)
+			}
+
+			if strings.HasPrefix(rest, "_2") {
+				return at(i, 2, elemUnderDay)
+			}
+
+			if strings.HasPrefix(rest, "__2") {
+				return at(i, 3, elemUnderYearDay)
+			}
+
+		case '3':
+			return at(i, 1, elemHour12)
+
+		case '4':
+			return at(i, 1, elemMinute)
+
+		case '5':
+			return at(i, 1, elemSecond)
+
+		case 'P':
+			if strings.HasPrefix(rest, "PM") {
+				return at(i, 2, elemPM)
+			}
+
+		case 'p':
+			if strings.HasPrefix(rest, "pm") {
+				return at(i, 2, elemLowerPM)
+			}
+
+		case '-', 'Z':
+			for _, z := range zoneSpellings {
+				if strings.HasPrefix(rest, string(layout[i])+z.spelling) {
+					e := z.num
+					if layout[i] == 'Z' {
+						e = z.iso
+					}
+
+					return at(i, 1+len(z.spelling), e)
+				}
+			}
+
+		case '.', ',':
+			if i+1 < len(layout) && (layout[i+1] == '0' || layout[i+1] == '9') {
+				j := i + 1
+				for j < len(layout) && layout[j] == layout[i+1] {
+					j++
+				}
+
+				// A fraction is a run of one digit that ends the number.
+				if j == len(layout) || !isASCIIDigit(layout[j]) {
+					if layout[i+1] == '9' {
+						return at(i, j-i, elemFracSecond9)
+					}
+
+					prefix, tok, suffix := at(i, j-i, elemFracSecond0)
+					tok.digits = j - i - 1
+
+					return prefix, tok, suffix
+				}
+			}
+		}
+	}
+
+	return layout, layoutToken{}, ""
+}
+
+// zoneSpellings lists the numeric zone elements after their leading - or Z, in
+// the order time tries them, so a longer spelling wins over its prefix.
+var zoneSpellings = []struct {
+	spelling string
+	num      layoutElem
+	iso      layoutElem
+}{
+	{"070000", elemNumSecondsTZ, elemISO8601SecondsTZ},
+	{"07:00:00", elemNumColonSecondsTZ, elemISO8601ColonSecondsTZ},
+	{"0700", elemNumTZ, elemISO8601TZ},
+	{"07:00", elemNumColonTZ, elemISO8601ColonTZ},
+	{"07", elemNumShortTZ, elemISO8601ShortTZ},
+}
+
+// layoutText is the pattern the tokens match under one calendar.
+func layoutText(toks []layoutToken, cal calendar) (string, error) {
+	var b strings.Builder
+
+	for i, tok := range toks {
+		text, err := elemText(tok, toks[i+1:], cal)
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(text)
+	}
+
+	return b.String(), nil
+}
+
+// elemText is the pattern one element matches, given the elements after it and
+// the calendar the day is checked against.
+//
+//nolint:gocyclo // One case per layout element.
+func elemText(tok layoutToken, rest []layoutToken, cal calendar) (string, error) {
+	switch tok.elem {
+	case elemLiteral:
+		return regexp.QuoteMeta(tok.text), nil
+
+	case elemSpace:
+		// The parser skips every space in the value here, and a value that has
+		// already ended matches a layout's remaining spaces.
+		first, _ := firstBytes(rest)
+		if strings.ContainsRune(first, ' ') && rest[0].elem != elemUnderDay {
+			return "", errors.New("spaces are followed by an optional space")
+		}
+
+		return `(?: +|$)`, nil
+
+	case elemMonth, elemLongMonth:
+		return nameText(cal.months, tok.elem == elemLongMonth, func(m int) string {
+			return time.Month(m).String()
+		}), nil
+
+	case elemNumMonth, elemZeroMonth:
+		months := cal.months
+		if months == nil {
+			months = numberRange(1, 12)
+		}
+
+		return numberText(months, tok.elem == elemZeroMonth, rest)
+
+	case elemWeekDay, elemLongWeekDay:
+		return nameText(numberRange(0, 6), tok.elem == elemLongWeekDay, func(d int) string {
+			return time.Weekday(d).String()
+		}), nil
+
+	case elemDay, elemZeroDay:
+		return numberText(numberRange(cal.dayLo, cal.dayHi), tok.elem == elemZeroDay, rest)
+
+	case elemUnderDay:
+		text, err := numberText(numberRange(cal.dayLo, cal.dayHi), false, rest)
+
+		return ` ?` + text, err
+
+	case elemHour:
+		return numberText(numberRange(0, 23), false, rest)
+
+	case elemHour12, elemZeroHour12:
+		return numberText(numberRange(0, 12), tok.elem == elemZeroHour12, rest)
+
+	case elemMinute, elemZeroMinute:
+		return numberText(numberRange(0, 59), tok.elem == elemZeroMinute, rest)
+
+	case elemSecond, elemZeroSecond:
+		return secondText(tok, rest)
+
+	case elemLongYear:
+		if cal.leap {
+			return leapLongYear, nil
+		}
+
+		return `[0-9]{4}`, nil
+
+	case elemYear:
+		// The parser reads two bytes as a possibly signed integer.
+		if cal.leap {
+			return leapYear, nil
+		}
+
+		return `(?:[0-9]{2}|[+-][0-9])`, nil
+
+	case elemPM:
+		return `(?:AM|PM)`, nil
+
+	case elemLowerPM:
+		return `(?:am|pm)`, nil
+
+	case elemTZ:
+		return zoneNameText(rest)
+
+	case elemFracSecond0:
+		// The parser takes the digit count, either separator, and an integer
+		// that may be signed but not negative.
+		if tok.digits == 1 {
+			return `[.,][0-9+-]`, nil
+		}
+
+		n := tok.digits - 1
+
+		return fmt.Sprintf(`[.,](?:[0-9]{%d}|\+[0-9]{%d}|-0{%d})`, tok.digits, n, n), nil
+
+	case elemFracSecond9:
+		err := checkFraction(rest)
+
+		return `(?:[.,][0-9]+)?`, err
+	}
+
+	return numericZoneText(tok.elem), nil
+}
+
+// secondText is the seconds element, followed by the fraction the parser takes
+// after it when the layout names none.
+func secondText(tok layoutToken, rest []layoutToken) (string, error) {
+	text, err := numberText(numberRange(0, 59), tok.elem == elemZeroSecond, rest)
+	if err != nil {
+		return "", err
+	}
+
+	// The parser leaves a fraction to the layout's next element when that
+	// element is one.
+	next := slices.IndexFunc(rest, func(t layoutToken) bool {
+		return t.elem != elemLiteral && t.elem != elemSpace
+	})
+	if next >= 0 && (rest[next].elem == elemFracSecond0 || rest[next].elem == elemFracSecond9) {
+		return text, nil
+	}
+
+	// Text that must begin with a digit leaves no room for a fraction the
+	// parser would take digits from.
+	if mustStartWithDigit(rest) {
+		return text, nil
+	}
+
+	err = checkFraction(rest)
+	if err != nil {
+		return "", err
+	}
+
+	return text + `(?:[.,][0-9]+)?`, nil
+}
+
+// checkFraction rejects an optional fraction followed by text that could
+// continue it, where the parser's greedy reading and a pattern's would differ.
+func checkFraction(rest []layoutToken) error {
+	first, _ := firstBytes(rest)
+	if strings.ContainsAny(first, "0123456789.,") {
+		return errors.New("a fraction is followed by text that may begin with a digit or separator")
+	}
+
+	return nil
+}
+
+// numericZoneText is the pattern of a numeric zone offset element, with the Z
+// the ISO 8601 elements also accept.
+func numericZoneText(e layoutElem) string {
+	var text string
+
+	switch e {
+	case elemNumShortTZ, elemISO8601ShortTZ:
+		text = `[+-]` + zoneHour
+	case elemNumColonTZ, elemISO8601ColonTZ:
+		text = `[+-]` + zoneHour + `:` + zoneMin
+	case elemNumSecondsTZ, elemISO8601SecondsTZ:
+		text = `[+-]` + zoneHour + zoneMin + zoneMin
+	case elemNumColonSecondsTZ, elemISO8601ColonSecondsTZ:
+		text = `[+-]` + zoneHour + `:` + zoneMin + `:` + zoneMin
+	default:
+		text = `[+-]` + zoneHour + zoneMin
+	}
+
+	switch e {
+	case elemISO8601TZ, elemISO8601ShortTZ, elemISO8601ColonTZ, elemISO8601SecondsTZ, elemISO8601ColonSecondsTZ:
+		return `(?:Z|` + text + `)`
+	}
+
+	return text
+}
+
+// zoneNameText is the pattern of the MST element, which the parser reads as UTC,
+// one of two mixed-case names, GMT with an optional hour offset, a bare signed
+// hour, or three to five capitals (four or five ending in T, or WITA). Each
+// reading but the first two runs as far as its characters go, so the text after
+// it must not begin with a capital, digit, or sign. A bare offset also needs
+// three characters left in the value, so at the end of the layout it takes at
+// least two digits.
+func zoneNameText(rest []layoutToken) (string, error) {
+	first, _ := firstBytes(rest)
+	if strings.ContainsAny(first, "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789+-") {
+		return "", errors.New("a zone name is followed by text that may continue it")
+	}
+
+	offset := `[+-]0*(?:1?[0-9]|2[0-3])`
+
+	switch {
+	case len(rest) == 0:
+		offset = `[+-](?:0+(?:1?[0-9]|2[0-3])|1[0-9]|2[0-3])`
+	case canBeEmpty(rest):
+		return "", errors.New("a zone name is followed by optional text")
+	}
+
+	// Three capitals other than UTC and GMT, which the parser reads on their own.
+	const head = `(?:[A-FH-TV-Z][A-Z]{2}|G(?:[A-LN-Z][A-Z]|M[A-SU-Z])|U(?:[A-SU-Z][A-Z]|T[ABD-Z]))`
+
+	return `(?:UTC|ChST|MeST|GMT(?:[+-]0*(?:1?[0-9]|2[0-3]))?|` + offset + `|` +
+		head + `(?:[A-Z]?T)?|WITA)`, nil
+}
+
+// numberText is the pattern of a numeric element holding one of values. A
+// fixed element reads two digits; any other reads one, or two when a second
+// digit follows, so its one-digit form is only safe when the text after it
+// cannot begin with a digit, and is dropped when that text must.
+func numberText(values []int, fixed bool, rest []layoutToken) (string, error) {
+	oneDigit := !fixed
+
+	if oneDigit {
+		first, _ := firstBytes(rest)
+
+		switch {
+		case mustStartWithDigit(rest):
+			oneDigit = false
+		case strings.ContainsAny(first, "0123456789"):
+			return "", errors.New("a variable-width number is followed by text that may begin with a digit")
+		}
+	}
+
+	var texts []string
+
+	if oneDigit {
+		for _, v := range values {
+			if v < 10 {
+				texts = append(texts, strconv.Itoa(v))
+			}
+		}
+	}
+
+	for _, v := range values {
+		texts = append(texts, fmt.Sprintf("%02d", v))
+	}
+
+	return digitAlternation(texts), nil
+}
+
+// nameText is the pattern of a month or weekday name element holding one of
+// values: the short or long name, matched in any ASCII case as the parser
+// matches it.
+func nameText(values []int, long bool, name func(int) string) string {
+	if values == nil {
+		values = numberRange(1, 12)
+	}
+
+	alts := make([]string, 0, len(values))
+
+	for _, v := range values {
+		n := name(v)
+		if !long {
+			n = n[:3]
+		}
+
+		var b strings.Builder
+
+		for _, c := range []byte(n) {
+			lower, upper := strings.ToLower(string(c)), strings.ToUpper(string(c))
+			b.WriteString(`[` + upper + lower + `]`)
+		}
+
+		alts = append(alts, b.String())
+	}
+
+	return `(?:` + strings.Join(alts, "|") + `)`
+}
+
+// digitAlternation joins same-length digit strings into a compact alternation,
+// folding strings that differ only in their last digit into a class.
+func digitAlternation(texts []string) string {
+	var (
+		prefixes []string
+		lasts    = map[string][]byte{}
+	)
+
+	for _, t := range texts {
+		p := t[:len(t)-1]
+		if _, ok := lasts[p]; !ok {
+			prefixes = append(prefixes, p)
+		}
+
+		lasts[p] = append(lasts[p], t[len(t)-1])
+	}
+
+	alts := make([]string, 0, len(prefixes))
+
+	for _, p := range prefixes {
+		alts = append(alts, p+digitClass(lasts[p]))
+	}
+
+	if len(alts) == 1 {
+		return alts[0]
+	}
+
+	return `(?:` + strings.Join(alts, "|") + `)`
+}
+
+// digitClass is a class of ascending digits, with each run of three or more
+// written as a range.
+func digitClass(ds []byte) string {
+	if len(ds) == 1 {
+		return string(ds)
+	}
+
+	var b strings.Builder
+
+	b.WriteByte('[')
+
+	for i := 0; i < len(ds); {
+		j := i
+		for j+1 < len(ds) && ds[j+1] == ds[j]+1 {
+			j++
+		}
+
+		b.WriteByte(ds[i])
+
+		switch {
+		case j-i >= 2:
+			b.WriteByte('-')
+			b.WriteByte(ds[j])
+		case j > i:
+			b.WriteByte(ds[j])
+		}
+
+		i = j + 1
+	}
+
+	b.WriteByte(']')
+
+	return b.String()
+}
+
+// firstBytes returns the bytes the text matching toks may begin with, and
+// whether it may be empty. A run of spaces counts as beginning with a space,
+// since it matches nothing only where the value has ended.
+func firstBytes(toks []layoutToken) (string, bool) {
+	var b strings.Builder
+
+	for _, tok := range toks {
+		b.WriteString(elemFirstBytes(tok))
+
+		if tok.elem != elemFracSecond9 {
+			return b.String(), false
+		}
+	}
+
+	return b.String(), true
+}
+
+// elemFirstBytes returns the bytes the text one element matches may begin with.
+func elemFirstBytes(tok layoutToken) string {
+	const (
+		digits  = "0123456789"
+		capital = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	)
+
+	switch tok.elem {
+	case elemLiteral:
+		return tok.text[:1]
+	case elemSpace:
+		return " "
+	case elemMonth, elemLongMonth:
+		return "JFMASONDjfmasond"
+	case elemWeekDay, elemLongWeekDay:
+		return "SMTWFsmtwf"
+	case elemUnderDay:
+		return " " + digits
+	case elemYear:
+		return "+-" + digits
+	case elemPM:
+		return "AP"
+	case elemLowerPM:
+		return "ap"
+	case elemTZ:
+		return "+-" + capital
+	case elemFracSecond0, elemFracSecond9:
+		return ".,"
+	case elemNumTZ, elemNumSecondsTZ, elemNumShortTZ, elemNumColonTZ, elemNumColonSecondsTZ:
+		return "+-"
+	case elemISO8601TZ, elemISO8601SecondsTZ, elemISO8601ShortTZ, elemISO8601ColonTZ, elemISO8601ColonSecondsTZ:
+		return "Z+-"
+	}
+
+	return digits
+}
+
+// mustStartWithDigit reports whether the text matching toks always begins
+// with a digit.
+func mustStartWithDigit(toks []layoutToken) bool {
+	first, empty := firstBytes(toks)
+
+	return !empty && strings.Trim(first, "0123456789") == ""
+}
+
+// canBeEmpty reports whether toks may match no text: every element is a run of
+// spaces, which matches nothing once the value has ended, or an optional
+// fraction.
+func canBeEmpty(toks []layoutToken) bool {
+	return !slices.ContainsFunc(toks, func(t layoutToken) bool {
+		return t.elem != elemSpace && t.elem != elemFracSecond9
+	})
+}
+
+// numberRange returns the integers from lo through hi.
+func numberRange(lo, hi int) []int {
+	out := make([]int, 0, hi-lo+1)
+	for v := lo; v <= hi; v++ {
+		out = append(out, v)
+	}
+
+	return out
+}
+
+// isMonthElem reports whether e names the month.
+func isMonthElem(e layoutElem) bool {
+	return e == elemMonth || e == elemLongMonth || e == elemNumMonth || e == elemZeroMonth
+}
+
+// isDayElem reports whether e names the day of the month.
+func isDayElem(e layoutElem) bool {
+	return e == elemDay || e == elemUnderDay || e == elemZeroDay
+}
+
+// startsLower reports whether s begins with a lower-case ASCII letter.
+func startsLower(s string) bool {
+	return s != "" && 'a' <= s[0] && s[0] <= 'z'
+}
+
+// isASCIIDigit reports whether c is an ASCII digit.
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
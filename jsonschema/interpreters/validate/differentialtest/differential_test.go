@@ -27,18 +27,23 @@ import (
 //	validate.Struct(v) == nil  iff  schema.ValidateJSON(json.Marshal(v)) == nil
 //
 // Curation is the crux. Only the structural constraints the interpreter models
-// faithfully appear here (min/max/len/gt/lt/gte/lte/eq/ne/oneof/required/dive on
-// strings, numbers, bools, slices, arrays, and maps, plus unique on slices and
-// arrays). The tags the interpreter diverges from go-playground by design are
-// excluded: format and pattern tags (go-playground uses its own regexes while
-// the schema delegates to internal/format -- that surface is rig 3's job),
-// content tags, cross-field and conditional validators, the | OR operator,
-// keys...endkeys, and json:",string" numeric *bounds* (the value rules on
-// coerced fields are covered, by coercedConstraints below). Every field is
-// non-pointer and always present with no omitempty, which eliminates the
-// "empty value skipped by one side" divergence class. Unique stays on slices
-// and arrays because the interpreter makes it a no-op on maps while
-// go-playground rejects duplicate map values.
+// faithfully appear here (min/max/len/gt/lt/gte/lte/eq/ne/oneof/required/dive
+// on strings, numbers, bools, slices, arrays, and maps, plus unique on slices
+// and arrays, and the pattern tags, each restating go-playground's own regex or
+// the parser it calls). The tags the interpreter diverges from go-playground by
+// design are excluded: the format tags (go-playground uses its own regexes
+// while the schema delegates to internal/format -- that surface is rig 3's
+// job), content tags, the value-comparing cross-field validators (eqfield,
+// gtfield, and the rest), | OR alternatives the interpreter widens rather than
+// translates (orConstraints below covers the translated ones), and
+// json:",string" numeric *bounds* (the value rules on coerced fields are
+// covered, by coercedConstraints below). Every value-constrained field is
+// non-pointer and always present with no omitempty, which eliminates the "empty
+// value skipped by one side" divergence class; the conditional rosters below
+// vary presence on purpose, since whether a field is set is exactly what their
+// rules read.
+// Unique stays on slices and arrays because the interpreter makes it a no-op on
+// maps while go-playground rejects duplicate map values.
 
 // stringConstraints exercises the modeled string tags. Length rules are hit
 // from both sides by random strings; oneof/eq/ne are biased toward their
@@ -152,6 +157,123 @@ type coercedConstraints struct {
 	FEq   float64 `json:"f_eq,string"   validate:"eq=2.5"`
 }
 
+// presenceConditionals exercises the required_with and required_without
+// families, whose conditions read whether sibling fields are set. The triggers
+// cover the three ways a property says so: Name is omitted exactly when empty,
+// Ptr is set when not null, and Count when non-zero. Dep and Name both omit
+// their zero values, so its rule is written as dependentRequired.
+type presenceConditionals struct {
+	Name  string  `json:"name,omitempty"`
+	Count int8    `json:"count"`
+	Ptr   *int8   `json:"ptr"`
+	Email string  `json:"email"           validate:"required_with=Name Count"`
+	Phone int8    `json:"phone,omitempty" validate:"required_with_all=Name Ptr"`
+	Fax   bool    `json:"fax"             validate:"required_without=Count Ptr"`
+	Pager *string `json:"pager"           validate:"required_without_all=Name Ptr"`
+	Dep   string  `json:"dep,omitzero"    validate:"required_with=Name"`
+}
+
+// exclusionConditionals mirrors presenceConditionals for the excluded_with and
+// excluded_without families, where the field must be unset rather than set.
+type exclusionConditionals struct {
+	Name  string  `json:"name,omitempty"`
+	Count int8    `json:"count"`
+	Ptr   *int8   `json:"ptr"`
+	Email string  `json:"email"           validate:"excluded_with=Name Count"`
+	Phone int8    `json:"phone,omitempty" validate:"excluded_with_all=Name Ptr"`
+	Fax   bool    `json:"fax"             validate:"excluded_without=Ptr"`
+	Pager *string `json:"pager"           validate:"excluded_without_all=Name Count"`
+}
+
+// valueConditionals exercises the required_if and required_unless families,
+// whose conditions compare siblings with literal values. Kind ” is the zero
+// value of an omitempty field, which matches while the property is absent.
+// Ref nil matches a null pointer, and a set one holding "nil"; it is a string
+// pointer because go-playground panics comparing a set number with nil.
+type valueConditionals struct {
+	Kind  string  `json:"kind,omitempty"`
+	Level int8    `json:"level"`
+	On    bool    `json:"on,omitempty"`
+	Ref   *string `json:"ref"`
+	Num   *int8   `json:"num,omitempty"`
+	A     string  `json:"a"           validate:"required_if=Kind alpha Level 2"`
+	B     int8    `json:"b"           validate:"required_unless=On true"`
+	C     string  `json:"c,omitempty" validate:"excluded_if=Level 0"`
+	D     string  `json:"d"           validate:"excluded_unless=Ref nil Kind ''"`
+	E     string  `json:"e"           validate:"required_if=Num 3"`
+}
+
+// orConstraints exercises the | OR operator, whose alternatives become an
+// anyOf of what each contributes alone. The groups mix rule families (a length
+// or a pinned value), sit beside a plain constraint in the next comma group,
+// run on a json:",string" field whose branches compare the quoted text, and
+// sit behind omitempty on a pointer, whose null branch must stay outside them.
+type orConstraints struct {
+	Str     string `json:"str"            validate:"min=5|eq=ab"`
+	Num     int    `json:"num"            validate:"eq=1|eq=5|gt=10"`
+	Mixed   string `json:"mixed"          validate:"oneof=a b|len=3,max=4"`
+	Coerced int    `json:"coerced,string" validate:"eq=7|eq=8|oneof=20 30"`
+	Ptr     *int8  `json:"ptr"            validate:"omitempty,lt=3|gt=100"`
+}
+
+// stringPredicates exercises the string validators that become patterns:
+// go-playground's own regular expressions (hexadecimal, hexcolor, e164,
+// semver, fqdn, hostname_rfc1123, url_encoded, jwt, and base64url), the affix
+// and substring tests and their negations, case, and boolean text. The Both
+// field carries two patterns, the second of which joins allOf rather than
+// displacing the first.
+type stringPredicates struct {
+	Prefix   string `json:"prefix"    validate:"startswith=ab"`
+	Suffix   string `json:"suffix"    validate:"endswith=.z"`
+	Contains string `json:"contains"  validate:"contains=a"`
+	AnyOf    string `json:"any_of"    validate:"containsany=!-]"`
+	NoPrefix string `json:"no_prefix" validate:"startsnotwith=-"`
+	NoSuffix string `json:"no_suffix" validate:"endsnotwith=."`
+	Excludes string `json:"excludes"  validate:"excludes=ab"`
+	NoneOf   string `json:"none_of"   validate:"excludesall=#-"`
+	Lower    string `json:"lower"     validate:"lowercase"`
+	Upper    string `json:"upper"     validate:"uppercase"`
+	Bool     string `json:"bool"      validate:"boolean"`
+	Hex      string `json:"hex"       validate:"hexadecimal"`
+	Color    string `json:"color"     validate:"hexcolor"`
+	Phone    string `json:"phone"     validate:"e164"`
+	Version  string `json:"version"   validate:"semver"`
+	FQDN     string `json:"fqdn"      validate:"fqdn"`
+	Host     string `json:"host"      validate:"hostname_rfc1123"`
+	Encoded  string `json:"encoded"   validate:"url_encoded"`
+	Token    string `json:"token"     validate:"jwt"`
+	Base64   string `json:"base64"    validate:"base64url"`
+	Both     string `json:"both"      validate:"alpha,endswith=z"`
+}
+
+// networkText exercises the network validators over text: ip, an anyOf of the
+// ipv4 and ipv6 formats, and cidr and mac, patterns restating what net parses.
+type networkText struct {
+	IP   string `json:"ip"   validate:"ip"`
+	CIDR string `json:"cidr" validate:"cidr"`
+	MAC  string `json:"mac"  validate:"mac"`
+}
+
+// datetimeLayouts exercises datetime, whose pattern is read off the layout:
+// fixed and variable-width numbers, month names in any case, an implicit
+// fraction, a padded day, and zone offsets and abbreviations.
+type datetimeLayouts struct {
+	Date  string `json:"date"  validate:"datetime=2006-01-02"`
+	Stamp string `json:"stamp" validate:"datetime=Jan _2 15:04:05"`
+	Short string `json:"short" validate:"datetime=1/2/06 3:04PM"`
+	Zone  string `json:"zone"  validate:"datetime=15:04:05.000 -07:00"`
+	Named string `json:"named" validate:"datetime=Mon 2 MST"`
+}
+
+// keyConstraints exercises keys...endkeys blocks, which constrain the map's
+// property names: a string key's length and membership, an integer key's
+// membership compared as its digits, and value rules after the block.
+type keyConstraints struct {
+	Len   map[string]int `json:"len"    validate:"dive,keys,min=2,max=4,endkeys"`
+	OneOf map[string]int `json:"one_of" validate:"dive,keys,oneof=a bb,endkeys,min=1"`
+	Ints  map[int]string `json:"ints"   validate:"dive,keys,oneof=1 20,endkeys"`
+}
+
 func FuzzValidatorStringConstraints(f *testing.F) {
 	fuzzValidatorDifferential[stringConstraints](f, fuzzfill.WithCandidates(map[string][]string{
 		"OneOf": {"alpha", "beta", "gamma", "delta"},
@@ -201,6 +323,157 @@ func FuzzValidatorCoercedConstraints(f *testing.F) {
 	}))
 }
 
+func FuzzValidatorPresenceConditionals(f *testing.F) {
+	fuzzValidatorDifferential[presenceConditionals](f, fuzzfill.WithCandidates(map[string][]string{
+		"Name":  {"", "n"},
+		"Count": {"0", "1"},
+		"Email": {"", "e"},
+		"Phone": {"0", "1"},
+		"Dep":   {"", "d"},
+	}))
+}
+
+func FuzzValidatorExclusionConditionals(f *testing.F) {
+	fuzzValidatorDifferential[exclusionConditionals](f, fuzzfill.WithCandidates(map[string][]string{
+		"Name":  {"", "n"},
+		"Count": {"0", "1"},
+		"Email": {"", "e"},
+		"Phone": {"0", "1"},
+	}))
+}
+
+func FuzzValidatorValueConditionals(f *testing.F) {
+	fuzzValidatorDifferential[valueConditionals](f, fuzzfill.WithCandidates(map[string][]string{
+		"Kind":  {"", "alpha", "beta"},
+		"Level": {"0", "2", "3"},
+		"A":     {"", "a"},
+		"B":     {"0", "1"},
+		"C":     {"", "c"},
+		"D":     {"", "d"},
+		"E":     {"", "e"},
+	}))
+}
+
+func FuzzValidatorOrConstraints(f *testing.F) {
+	fuzzValidatorDifferential[orConstraints](f, fuzzfill.WithCandidates(map[string][]string{
+		"Str":     {"ab", "abcde", "abc"},
+		"Num":     {"1", "5", "10", "11"},
+		"Mixed":   {"a", "b", "abc", "abcde"},
+		"Coerced": {"7", "8", "20", "21"},
+	}))
+}
+
+func FuzzValidatorKeyConstraints(f *testing.F) {
+	fuzzValidatorDifferential[keyConstraints](f)
+}
+
+// stringPredicateCandidates biases each stringPredicates field toward values
+// on both sides of its rule.
+var stringPredicateCandidates = map[string][]string{
+	"Prefix":   {"ab", "abc", "a"},
+	"Suffix":   {"a.z", ".z", "az"},
+	"Contains": {"a", "b"},
+	"NoPrefix": {"-a", "a-"},
+	"NoSuffix": {"a.", ".a"},
+	"Excludes": {"xaby", "a b"},
+	"Lower":    {"abc", "aBc", "ǅ", "ß"},
+	"Upper":    {"ABC", "AbC", "ǅ", "Σ"},
+	"Bool":     {"true", "T", "0", "tRUE", "yes"},
+	"Hex":      {"0x1f", "1F", "0x", "g"},
+	"Color":    {"#fff", "#ffff", "#fffff", "#12345678"},
+	"Phone":    {"+14155552671", "+1", "14155552671", "+04155552671", "+1415555267123456"},
+	"Version":  {"1.2.3", "1.2.3-rc.1+build.5", "01.2.3", "1.2", "1.2.3-01"},
+	"FQDN":     {"example.com", "example.com.", "a.b-c.io", "localhost", "-a.com", "a..com", "a.1com"},
+	"Host":     {"example", "a-b.example.com", "1a", "-a", "a_b", "a."},
+	"Encoded":  {"a%20b", "%2F", "%zz", "a%2", "a b"},
+	"Token":    {"eyJhbGciOiJIUzI1NiJ9.e30.sig-_", "a.b.", "a.b", "a.b.c.d", "a+b.c.d"},
+	"Base64":   {"YWJj", "YWI=", "YQ==", "-_-_", "YWJ", "YW+=", "YQ"},
+	"Both":     {"az", "a1z", "z"},
+}
+
+func FuzzValidatorStringPredicates(f *testing.F) {
+	fuzzValidatorDifferential[stringPredicates](f, fuzzfill.WithCandidates(stringPredicateCandidates))
+}
+
+// TestValidatorStringPredicateCandidates compares the two validators on each
+// stringPredicates candidate alone, in a struct holding only its field. The
+// fuzz target only sees a field's accepted values when every other field's
+// rule passes too; here each one is compared on its own, and every field's
+// candidates must include a value go-playground accepts and one it rejects.
+func TestValidatorStringPredicateCandidates(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	reference := playground.New(playground.WithRequiredStructEnabled())
+	fields := reflect.TypeFor[stringPredicates]()
+
+	for i := range fields.NumField() {
+		field := fields.Field(i)
+
+		candidates, ok := stringPredicateCandidates[field.Name]
+		if !ok {
+			continue
+		}
+
+		t.Run(field.Name, func(t *testing.T) {
+			t.Parallel()
+
+			typ := reflect.StructOf([]reflect.StructField{field})
+
+			schema, err := jsonschema.Generate(ctx, typ,
+				jsonschema.WithTagInterpreter("validate", validate.NewInterpreter()),
+			)
+			require.NoError(t, err)
+
+			validator, err := jsonschema.Compile(ctx, schema)
+			require.NoError(t, err)
+
+			var accepted, rejected bool
+
+			for _, candidate := range candidates {
+				val := reflect.New(typ)
+				val.Elem().Field(0).SetString(candidate)
+
+				referenceReject, err := referenceRejects(reference, val.Interface())
+				require.NoError(t, err)
+
+				instance, err := json.Marshal(val.Interface())
+				require.NoError(t, err)
+
+				schemaReject := validator.ValidateJSON(ctx, instance) != nil
+				require.Equal(t, referenceReject, schemaReject, "%s %q", field.Tag.Get("validate"), candidate)
+
+				accepted = accepted || !referenceReject
+				rejected = rejected || referenceReject
+			}
+
+			require.True(t, accepted, "no accepted candidate")
+			require.True(t, rejected, "no rejected candidate")
+		})
+	}
+}
+
+func FuzzValidatorNetworkText(f *testing.F) {
+	fuzzValidatorDifferentialWith[networkText](f,
+		[]jsonschema.ValidateOption{jsonschema.WithFormats(true)},
+		fuzzfill.WithCandidates(map[string][]string{
+			"IP":   {"192.0.2.1", "192.0.2.01", "::1", "::ffff:192.0.2.1", "fe80::1%eth0", "1::2::3"},
+			"CIDR": {"192.0.2.0/24", "192.0.2.0/33", "::/0", "::1/129", "10.0.0.1/008"},
+			"MAC":  {"00:00:5e:00:53:01", "00-00-5e-00-53-01", "0000.5e00.5301", "00:00:5e:00:53"},
+		}),
+	)
+}
+
+func FuzzValidatorDatetimeLayouts(f *testing.F) {
+	fuzzValidatorDifferential[datetimeLayouts](f, fuzzfill.WithCandidates(map[string][]string{
+		"Date":  {"2024-02-29", "2023-02-29", "2024-04-31", "2024-1-02"},
+		"Stamp": {"Jan  2 15:04:05", "jan 31 23:59:59.5", "Feb 30 00:00:00", "Jan 2 15:04:05"},
+		"Short": {"1/2/06 3:04PM", "12/31/99 12:00am", "2/29/01 1:00PM", "13/1/06 3:04PM"},
+		"Zone":  {"15:04:05.000 -07:00", "23:59:59.999 Z", "00:00:00.00 +01:00", "24:00:00.000 Z"},
+		"Named": {"Mon 2 MST", "sun 31 UTC", "Tue 2 GMT+1", "Wed 2 +03", "Thu 2 mst"},
+	}))
+}
+
 // fuzzValidatorDifferential is the shared body for every rig-2 target. It
 // generates T's schema through the validate interpreter, compiles it, and
 // builds a go-playground validator once, then for each blob fills a T and
@@ -209,6 +482,19 @@ func FuzzValidatorCoercedConstraints(f *testing.F) {
 func fuzzValidatorDifferential[T any](f *testing.F, fillOpts ...fuzzfill.Option) {
 	f.Helper()
 
+	fuzzValidatorDifferentialWith[T](f, nil, fillOpts...)
+}
+
+// fuzzValidatorDifferentialWith is [fuzzValidatorDifferential] compiling the
+// schema with compileOpts, for a roster whose tags become formats that only
+// assert when format assertion is enabled.
+func fuzzValidatorDifferentialWith[T any](
+	f *testing.F,
+	compileOpts []jsonschema.ValidateOption,
+	fillOpts ...fuzzfill.Option,
+) {
+	f.Helper()
+
 	ctx := context.Background()
 
 	schema, err := jsonschema.GenerateFor[T](ctx,
@@ -216,7 +502,7 @@ func fuzzValidatorDifferential[T any](f *testing.F, fillOpts ...fuzzfill.Option)
 	)
 	require.NoError(f, err, "generate schema for %T", *new(T))
 
-	validator, err := jsonschema.Compile(ctx, schema)
+	validator, err := jsonschema.Compile(ctx, schema, compileOpts...)
 	require.NoError(f, err, "compile schema for %T", *new(T))
 
 	schemaJSON, err := json.MarshalIndent(schema, "", "  ")
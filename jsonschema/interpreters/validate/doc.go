@@ -161,6 +161,7 @@
 // Format tags (mapped to "format"):
 //
 //   - email, url (-> "uri"), uri (-> "uri-reference"), uuid, ipv4, ipv6, hostname
+//   - ip: an anyOf of the ipv4 and ipv6 formats
 //
 // Pattern tags (mapped to "pattern"):
 //
@@ -169,6 +170,28 @@
 //   - numeric: ^[-+]?[0-9]+(?:\.[0-9]+)?$
 //   - number: ^[0-9]+$
 //   - ascii: ^[\x00-\x7F]*$
+//   - hexadecimal, hexcolor, e164, semver, fqdn, hostname_rfc1123,
+//     url_encoded, jwt, base64url: go-playground's own regular expression
+//   - cidr, mac: what net.ParseCIDR and net.ParseMAC accept
+//   - lowercase, uppercase: a non-empty string of runes strings.ToLower (or
+//     strings.ToUpper) leaves alone, a class read off the Unicode case tables
+//   - boolean: what strconv.ParseBool accepts; on a bool field it is a no-op
+//   - startswith=s, endswith=s, contains=s: the escaped text, anchored at the
+//     start, the end, or neither
+//   - containsany=s: a class of the parameter's runes
+//   - startsnotwith, endsnotwith, excludes, excludesall: the pattern of their
+//     positive counterpart under a not, in an allOf entry beside the field's
+//     other constraints
+//   - datetime=layout: the values time.Parse accepts for the layout, calendar
+//     included (no April 31, February 29 only in a leap year). A layout whose
+//     accepted text no pattern states -- a day of the year, or a variable-width
+//     number followed by text that may begin with a digit -- is an error.
+//
+// A field carries one pattern keyword, so a second pattern tag on the same
+// field (alpha,endswith=z) is written as an allOf entry rather than displacing
+// the first; go-playground requires both. The cidrv4 and cidrv6 tags compare
+// the parsed address with its network, which no pattern states at a reasonable
+// size, so they are an error.
 //
 // Content tags:
 //
@@ -202,20 +225,102 @@
 // encodes as a single base64 string with no element schema either one could
 // reach.
 //
+// # Map Keys
+//
+// A keys...endkeys block right after a map's dive constrains the map's keys,
+// which the generator renders as the object's propertyNames, while the parts
+// after endkeys reach the values as usual:
+//
+//	Labels map[string]string `validate:"dive,keys,alpha,max=20,endkeys,min=1"`
+//	// {"propertyNames": {"pattern": "^[a-zA-Z]+$", "maxLength": 20},
+//	//  "additionalProperties": {"type": "string", "minLength": 1}}
+//
+// A rule inside the block applies to the property name's shape, so a string
+// key takes lengths, pattern tags, formats, and oneof (an enum of names) as a
+// string field does. An integer key's name is its digits: oneof becomes an
+// enum of digit strings, and a numeric bound, which no keyword over a name can
+// state, is an error like the same bound on a json:",string" field. A keys
+// block on a field that is not a map is an error, and one anywhere but right
+// after a dive, which go-playground rejects, is skipped.
+//
+// # Conditional Validators
+//
+// The required_* and excluded_* families (required_with, required_with_all,
+// required_without, required_without_all, required_if, required_unless, and
+// their excluded_ counterparts) say a field must be set, or unset, when a
+// condition on its sibling fields holds. They are written onto the enclosing
+// object as an allOf entry with an if naming the condition and a then naming
+// the field, since that is where both properties are visible:
+//
+//	Email string `json:"email" validate:"required_with=Name"`
+//	// parent: "allOf": [{"if": <Name set>, "then": <email set>}]
+//
+// Fields are named by their Go field name, as go-playground names them, and
+// "set" is go-playground's reading: a pointer, slice, map, or interface is set
+// when it is not nil, and any other value when it is not its zero value. A
+// property says so in one of three ways:
+//
+//   - A field encoding/json omits exactly when it is zero (omitzero, or
+//     omitempty on a string, number, bool, pointer, or interface) is set when
+//     the property is present.
+//   - Any other pointer, slice, map, or interface is set when the property is
+//     present and not null.
+//   - Any other string, number, or bool, json:",string" included, is set when
+//     the property is present and its value is what required accepts.
+//
+// A field with no such reading is an error: a struct or array, or an omitempty
+// slice, which is absent while empty but not nil. A required_with whose field
+// and triggers are all of the first kind is dependentRequired (dependencies
+// under Draft 7) instead of an if/then.
+//
+// The if and unless families compare a sibling with a value the way
+// go-playground does: a number parsed at the field's kind, a bool true only
+// for "true", and a string exactly, through a pointer that is not nil. A nil
+// pointer equals only "nil" -- which a set string pointer also can -- and a
+// set pointer to a number or bool makes go-playground panic on "nil", so that
+// is an error, as is comparing a slice, map, or array (go-playground compares
+// its length). A value condition on the zero value of a field omitted when
+// zero also holds while the property is absent. An odd parameter count is an
+// error, as is a required_if naming a field twice, both of which go-playground
+// panics on.
+//
+// A required_* rule after omitempty (or omitzero, or omitnil on a nilable
+// field) contributes nothing: go-playground stops there while the field is
+// unset, the only state the rule rejects. A conditional under dive is an
+// error, since an element has no sibling fields.
+//
+// # OR Alternatives
+//
+// Within a comma group the | operator separates alternatives, any one of
+// which go-playground accepts. The group becomes an anyOf with one branch per
+// alternative, each what that alternative contributes on its own, placed in an
+// allOf entry on the field's value so a pointer's null branch stays outside
+// it and later comma groups still apply:
+//
+//	Level int `validate:"max=1|min=10"`
+//	// {"type": "integer", "allOf": [{"anyOf": [{"maximum": 1}, {"minimum": 10}]}]}
+//
+// An alternative that cannot be translated -- a format this package does not
+// know (iscolor), a skipped or control tag, or a rule the field's shape
+// rejects, such as an element rule -- widens to the empty schema rather than
+// being dropped, since dropping it would reject values go-playground accepts.
+// The group then admits every value and contributes nothing. A required
+// alternative asserts the non-zero value, but not the property, since another
+// alternative may hold instead.
+//
 // # Skipped and Unrecognized Tags
 //
-// Some tags carry no JSON Schema representation and are skipped: cross-field and
-// conditional validators (eqfield, required_if, skip_unless, ...), control tags
-// that govern when validation runs (omitempty, structonly, ...), and the
-// constraints inside a keys...endkeys block (map-key constraints are not
-// modeled). The | OR operator is not modeled either: within a single comma
-// group the pipe separates OR alternatives, of which only the first is
-// interpreted, so later comma-separated constraints still apply.
+// Some tags carry no JSON Schema representation and are skipped: the
+// cross-field validators that compare two values (eqfield, gtfield,
+// fieldcontains, ...) and skip_unless, control tags that govern when
+// validation runs (omitempty, structonly, ...), and a keys...endkeys block out
+// of place.
 //
 // Any other key that is not a recognized constraint causes Interpret to return
 // an error rather than being silently consumed, so a typo'd or unsupported
 // validator surfaces at generation time instead of yielding a schema that
-// quietly drops the intended constraint.
+// quietly drops the intended constraint. An OR alternative is the exception,
+// widening as above.
 //
 // # Implementation
 //
@@ -225,6 +330,8 @@
 // given field is the shared constraint model's, which the jsonschema struct tag
 // runs through as well, so the two dialects cannot drift on a rule they both
 // express. Every constraint is contributed through [jsonschema.Constraints];
-// this package holds no scalar parser and writes no schema keyword directly,
-// which is what keeps the two interpretations one.
+// this package holds no scalar parser and writes no value keyword directly,
+// which is what keeps the two interpretations one. What it does write is on
+// the enclosing object -- required, and a conditional's if/then, whose value
+// tests are themselves built through the model on a scratch canvas.
 package validate
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"slices"
 	"strings"
@@ -44,7 +45,9 @@ var (
 // key names lives in the key table, and what that operation does to a given
 // field shape lives in the shared constraint model. There is no parse or
 // emission path of its own: every constraint is contributed through
-// [jsonschema.Constraints], so this package writes no schema keyword directly.
+// [jsonschema.Constraints], so this package writes no value keyword directly.
+// The rules it does write go on the enclosing object: required, and the
+// if/then of a conditional validator.
 type Interpreter struct{}
 
 // NewInterpreter returns a new validate tag interpreter.
@@ -67,22 +70,25 @@ func (i *Interpreter) Interpret(_ context.Context, field jsonschema.FieldContext
 
 // applyParts applies a sequence of validator tag parts to a field.
 func applyParts(parts []string, field jsonschema.FieldContext) error {
-	var inKeys bool
+	var inKeys, omitting bool
 
 	for idx := range parts {
-		part := parts[idx]
-		// The | OR operator is not modeled. The go-playground/validator parser
-		// splits a comma group on the pipe and treats the alternatives as OR;
-		// here only the first alternative (the group before the first pipe) is
-		// interpreted, matching the documented behavior. Stripping per part
-		// rather than across the whole tag keeps later comma-separated
-		// constraints intact. A literal pipe in a param is written 0x7C and
-		// survives, since unescapeParam runs after this split.
-		if i := strings.IndexByte(part, '|'); i >= 0 {
-			part = part[:i]
+		part := strings.TrimSpace(parts[idx])
+
+		// The go-playground/validator parser splits a comma group on the pipe
+		// and treats the alternatives as OR, which is an anyOf of what each
+		// alternative alone would contribute. Splitting per part rather than
+		// across the whole tag keeps later comma-separated constraints intact.
+		// A literal pipe in a param is written 0x7C and survives, since
+		// unescapeParam runs after this split.
+		if alts := strings.Split(part, "|"); len(alts) > 1 {
+			if !inKeys {
+				applyAlternatives(alts, field)
+			}
+
+			continue
 		}
 
-		part = strings.TrimSpace(part)
 		if part == "" || part == "-" {
 			continue
 		}
@@ -112,19 +118,43 @@ func applyParts(parts []string, field jsonschema.FieldContext) error {
 			value = unescapeParam(value)
 		}
 
-		// Skip cross-field validators, and the control tags that govern when
-		// validation runs rather than expressing a value constraint (e.g.
-		// omitempty, structonly). Neither has a schema representation, and
-		// neither may be treated as an unknown validator.
+		// A conditional validator is a rule on the enclosing object rather
+		// than on this field's value. Go-playground stops at an omitempty (or
+		// omitzero, or omitnil on a nilable field) while the field is unset,
+		// which is the only state a required_* rule rejects, so one after it
+		// never fails and contributes nothing.
+		if rule, ok := conditionalKeys[key]; ok && !inKeys {
+			if omitting && !rule.exclude {
+				continue
+			}
+
+			err := applyConditional(key, value, rule, field)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if key == "omitempty" || key == "omitzero" || key == "omitnil" && isNilable(field) {
+			omitting = true
+		}
+
+		// Skip the cross-field validators that compare two values, and the
+		// control tags that govern when validation runs rather than expressing
+		// a value constraint (e.g. omitempty, structonly). Neither has a
+		// schema representation, and neither may be treated as an unknown
+		// validator.
 		if isCrossFieldValidator(key) || isControlTag(key) {
 			continue
 		}
 
-		// Map key validators: constraints between keys and endkeys apply to
-		// the map's keys (not modeled here) and are skipped. A keys without a
-		// matching endkeys is malformed; rather than swallowing every later
-		// constraint, the keys marker is ignored so the remaining constraints
-		// still apply to the value schema.
+		// Map key validators: a keys...endkeys block right after a dive is
+		// taken by applyDive, so one reaching here is out of place, which
+		// go-playground rejects, and is skipped along with its contents. A
+		// keys without a matching endkeys is malformed; rather than swallowing
+		// every later constraint, the keys marker is ignored so the remaining
+		// constraints still apply to the value schema.
 		if key == "keys" {
 			if hasEndkeys(parts[idx+1:]) {
 				inKeys = true
@@ -167,11 +197,22 @@ func applyValidator(key, value string, hasValue bool, field jsonschema.FieldCont
 		return fmt.Errorf("validate tag: unrecognized validator %q", key)
 	}
 
+	if rule.unmapped != "" {
+		return fmt.Errorf("validate tag: %s has no JSON Schema equivalent (%s)", key, rule.unmapped)
+	}
+
+	if len(rule.anyOf) > 0 {
+		return applyUnion(key, value, hasValue, rule.anyOf, field)
+	}
+
 	if rule.Op == tagmodel.OpNonZero && field.Parent != nil && field.Name != "" {
 		addRequired(field.Parent, field.Name)
 	}
 
 	shape := shapeOf(field)
+	if rule.vacuousFor != reflect.Invalid && shape.Kind == rule.vacuousFor {
+		return nil
+	}
 
 	bound, err := tagmodel.Bind(rule.KeyRule, shape, value, hasValue)
 	if err != nil {
@@ -185,14 +226,135 @@ func applyValidator(key, value string, hasValue bool, field jsonschema.FieldCont
 		return fmt.Errorf("validate tag: %s: %w", reason, err)
 	}
 
-	err = field.ConstraintsFor(shape).Apply(bound.Op, bound.Axis, bound.Params.Values()...)
+	params := bound.Params.Values()
+	if rule.toPattern != nil {
+		pattern, err := rule.toPattern(params[0])
+		if err != nil {
+			return fmt.Errorf("validate tag: %s=%s: %w", key, value, err)
+		}
+
+		params = []string{pattern}
+	}
+
+	// A negated rule is built on a scratch canvas and joins the field's allOf
+	// under a not, so it sits beside the field's other constraints, on the
+	// value branch, rather than replacing any of them. So does a second
+	// pattern or format: the canvas has one slot for each, which the model
+	// keeps for the first, but go-playground requires both validators to hold.
+	scratch := rule.negated || occupied(field.Canvas, bound.Op, params)
+
+	target := field
+	if scratch {
+		target = field.Detached()
+	}
+
+	err = target.ConstraintsFor(shape).Apply(bound.Op, bound.Axis, params...)
 	if err != nil {
 		return wrapApplyError(key, value, err)
 	}
 
+	switch {
+	case rule.negated:
+		field.Canvas.AllOf = append(field.Canvas.AllOf, negate(target.Canvas))
+	case scratch:
+		field.Canvas.AllOf = append(field.Canvas.AllOf, target.Canvas)
+	}
+
+	return nil
+}
+
+// occupied reports whether the canvas already carries a different value in the
+// string keyword op sets.
+func occupied(canvas *jsonschema.Schema, op tagmodel.Op, params []string) bool {
+	switch op {
+	case tagmodel.OpPattern:
+		return canvas.Pattern != "" && canvas.Pattern != params[0]
+	case tagmodel.OpFormat:
+		return canvas.Format != "" && canvas.Format != params[0]
+	}
+
+	return false
+}
+
+// applyUnion applies a validator that is the OR of others as an anyOf in the
+// field's canvas allOf, one branch per validator, as an OR group would be. Unlike
+// an alternative the author wrote, a branch the field's shape rejects is an
+// error, since the validator itself is then not one the field can carry.
+func applyUnion(key, value string, hasValue bool, keys []string, field jsonschema.FieldContext) error {
+	if hasValue {
+		return fmt.Errorf("validate tag: %s: takes no parameter, got %q", key, value)
+	}
+
+	branches := make([]*jsonschema.Schema, 0, len(keys))
+
+	for _, k := range keys {
+		branch := field.Detached()
+
+		err := applyValidator(k, "", false, branch)
+		if err != nil {
+			return fmt.Errorf("validate tag: %s: %w", key, err)
+		}
+
+		branches = append(branches, branch.Canvas)
+	}
+
+	field.Canvas.AllOf = append(field.Canvas.AllOf, &jsonschema.Schema{AnyOf: branches})
+
 	return nil
 }
 
+// applyAlternatives applies an OR group as an anyOf in the field's canvas
+// allOf, one branch per alternative. Each branch is the alternative applied
+// alone to a detached copy of the field, so it goes through the same shape
+// rules as the field's own constraints and lands on the same value branch,
+// beside whatever null encoding the field has; required inside a branch
+// asserts the non-zero value but not the property, since only one alternative
+// need hold.
+//
+// An alternative that cannot be translated (an unrecognized or skipped key, a
+// dive, a rule the field's shape rejects) widens to the empty schema rather
+// than being dropped, since dropping it would reject values go-playground
+// accepts. An empty branch admits every value and so does the whole group, which
+// then contributes nothing.
+func applyAlternatives(alts []string, field jsonschema.FieldContext) {
+	branches := make([]*jsonschema.Schema, 0, len(alts))
+
+	for _, alt := range alts {
+		branch := alternative(strings.TrimSpace(alt), field)
+		if reflect.DeepEqual(*branch, jsonschema.Schema{}) {
+			return
+		}
+
+		branches = append(branches, branch)
+	}
+
+	// The group joins the canvas's allOf, which is the conjunction the value
+	// branch keeps: another group on the field is a second entry beside it.
+	field.Canvas.AllOf = append(field.Canvas.AllOf, &jsonschema.Schema{AnyOf: branches})
+}
+
+// alternative returns the schema one OR alternative contributes on its own,
+// or the empty schema when it cannot be translated.
+func alternative(alt string, field jsonschema.FieldContext) *jsonschema.Schema {
+	key, value, hasValue := strings.Cut(alt, "=")
+	if hasValue {
+		value = unescapeParam(value)
+	}
+
+	if _, known := validatorKeys[key]; !known {
+		return &jsonschema.Schema{}
+	}
+
+	branch := field.Detached()
+
+	err := applyValidator(key, value, hasValue, branch)
+	if err != nil {
+		return &jsonschema.Schema{}
+	}
+
+	return branch.Canvas
+}
+
 // wrapApplyError gives a model error this dialect's phrasing. A conflict is
 // re-reported through this package's own sentinel, so the identity
 // [ErrConflictingConstraints] promises holds through every layer; everything
@@ -243,11 +405,12 @@ func hasConstraint(parts []string) bool {
 		}
 
 		// A control tag such as omitempty or structonly, or a cross-field
-		// validator, governs when validation runs rather than constraining a
-		// value, so it does not satisfy a trailing dive. Match on the key before
-		// any equals sign.
+		// validator, governs when validation runs or constrains the enclosing
+		// object rather than a value, so it does not satisfy a trailing dive.
+		// Match on the key before any equals sign.
 		key, _, _ := strings.Cut(p, "=")
-		if isControlTag(key) || isCrossFieldValidator(key) {
+		_, conditional := conditionalKeys[key]
+		if isControlTag(key) || isCrossFieldValidator(key) || conditional {
 			continue
 		}
 
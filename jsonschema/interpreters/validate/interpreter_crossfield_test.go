@@ -0,0 +1,233 @@
+package validate_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/interpreters/validate"
+)
+
+// The rosters below pair a conditional validator with the sibling shapes its
+// translation distinguishes: an omitempty string whose presence alone says it
+// is set, a pointer set when not null, and a plain scalar set when non-zero.
+
+type conditionalWith struct {
+	Name  string `json:"name,omitempty"`
+	Count int    `json:"count"`
+	Email string `json:"email" validate:"required_with=Name Count"`
+}
+
+type conditionalWithoutAll struct {
+	Phone *string `json:"phone"`
+	Mail  string  `json:"mail,omitempty"`
+	Other bool    `json:"other" validate:"required_without_all=Phone Mail"`
+}
+
+type conditionalIf struct {
+	Kind  string `json:"kind,omitempty"`
+	Level int    `json:"level,omitempty"`
+	Note  string `json:"note,omitempty" validate:"required_if=Kind 'a b' Level 0"`
+}
+
+type conditionalUnless struct {
+	Host  *string `json:"host"`
+	Proxy string  `json:"proxy,omitempty" validate:"excluded_unless=Host nil"`
+}
+
+type conditionalExcludedWith struct {
+	Token string `json:"token,omitempty"`
+	User  string `json:"user" validate:"excluded_with=Token"`
+}
+
+func TestValidateInterpreter_ConditionalRules(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		typ     reflect.Type
+		valid   []string
+		invalid []string
+	}{
+		"required_with": {
+			typ:     reflect.TypeFor[conditionalWith](),
+			valid:   []string{`{"count":0,"email":""}`, `{"name":"n","count":0,"email":"e"}`, `{"count":1,"email":"e"}`},
+			invalid: []string{`{"name":"n","count":0,"email":""}`, `{"count":2,"email":""}`},
+		},
+		"required_without_all": {
+			typ:     reflect.TypeFor[conditionalWithoutAll](),
+			valid:   []string{`{"phone":null,"other":true}`, `{"phone":"p","other":false}`, `{"phone":null,"mail":"m","other":false}`},
+			invalid: []string{`{"phone":null,"other":false}`},
+		},
+		"required_if": {
+			typ:     reflect.TypeFor[conditionalIf](),
+			valid:   []string{`{}`, `{"kind":"a b","level":1}`, `{"kind":"a b","note":"n"}`},
+			invalid: []string{`{"kind":"a b"}`, `{"kind":"a b","level":0}`},
+		},
+		"excluded_unless": {
+			typ:     reflect.TypeFor[conditionalUnless](),
+			valid:   []string{`{"host":null,"proxy":"p"}`, `{"host":"nil","proxy":"p"}`, `{"host":"h"}`},
+			invalid: []string{`{"host":"h","proxy":"p"}`, `{"host":"","proxy":"p"}`},
+		},
+		"excluded_with": {
+			typ:     reflect.TypeFor[conditionalExcludedWith](),
+			valid:   []string{`{"user":"u"}`, `{"token":"t","user":""}`},
+			invalid: []string{`{"token":"t","user":"u"}`},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := jsonschema.Generate(t.Context(), tc.typ,
+				jsonschema.WithTagInterpreter("validate", validate.NewInterpreter()),
+			)
+			require.NoError(t, err)
+
+			v, err := jsonschema.Compile(t.Context(), s)
+			require.NoError(t, err)
+
+			for _, doc := range tc.valid {
+				require.NoError(t, v.ValidateJSON(t.Context(), []byte(doc)), doc)
+			}
+
+			for _, doc := range tc.invalid {
+				require.Error(t, v.ValidateJSON(t.Context(), []byte(doc)), doc)
+			}
+		})
+	}
+}
+
+func TestValidateInterpreter_ConditionalDependentRequired(t *testing.T) {
+	t.Parallel()
+
+	// Both sides omit their zero values, so required_with is exactly a
+	// property dependency, spelled per draft.
+	type Config struct {
+		User string `json:"user,omitempty"`
+		Host string `json:"host,omitempty"`
+		Pass string `json:"pass,omitzero"  validate:"required_with=User Host"`
+	}
+
+	tests := map[string]struct {
+		draft   jsonschema.Draft
+		keyword string
+	}{
+		"draft 2020-12": {draft: jsonschema.Draft2020, keyword: "dependentRequired"},
+		"draft 7":       {draft: jsonschema.Draft7, keyword: "dependencies"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := jsonschema.GenerateFor[Config](t.Context(),
+				jsonschema.WithDraft(tc.draft),
+				jsonschema.WithTagInterpreter("validate", validate.NewInterpreter()),
+			)
+			require.NoError(t, err)
+
+			got, err := json.Marshal(s)
+			require.NoError(t, err)
+
+			var doc map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(got, &doc))
+			assert.JSONEq(t, `{"user":["pass"],"host":["pass"]}`, string(doc[tc.keyword]))
+			assert.NotContains(t, doc, "allOf")
+		})
+	}
+}
+
+func TestValidateInterpreter_ConditionalAfterOmitempty(t *testing.T) {
+	t.Parallel()
+
+	// Go-playground stops at omitempty while the field is unset, the only
+	// state required_with rejects, so it contributes nothing; excluded_with
+	// rejects a set field and still applies.
+	type Config struct {
+		Name  string `json:"name,omitempty"`
+		Email string `json:"email" validate:"omitempty,required_with=Name"`
+		Alias string `json:"alias" validate:"omitempty,excluded_with=Name"`
+	}
+
+	s, err := jsonschema.GenerateFor[Config](t.Context(),
+		jsonschema.WithTagInterpreter("validate", validate.NewInterpreter()),
+	)
+	require.NoError(t, err)
+
+	require.Len(t, s.AllOf, 1)
+	assert.Equal(t, []string{"name"}, s.AllOf[0].If.Required)
+	assert.Equal(t, []string{"alias"}, s.AllOf[0].Then.Not.Required)
+}
+
+func TestValidateInterpreter_ConditionalErrors(t *testing.T) {
+	t.Parallel()
+
+	type unknownField struct {
+		A string `json:"a" validate:"required_with=Missing"`
+	}
+
+	type oddParams struct {
+		B int    `json:"b"`
+		A string `json:"a" validate:"required_if=B"`
+	}
+
+	type duplicateParams struct {
+		B int    `json:"b"`
+		A string `json:"a" validate:"required_if=B 1 B 2"`
+	}
+
+	type structSibling struct {
+		B struct{ X int } `json:"b"`
+		A string          `json:"a" validate:"required_with=B"`
+	}
+
+	type omitemptySlice struct {
+		B []int  `json:"b,omitempty"`
+		A string `json:"a" validate:"required_with=B"`
+	}
+
+	type sliceValue struct {
+		B []int  `json:"b"`
+		A string `json:"a" validate:"required_if=B 2"`
+	}
+
+	type nilNumber struct {
+		B *int   `json:"b"`
+		A string `json:"a" validate:"required_if=B nil"`
+	}
+
+	type underDive struct {
+		B string   `json:"b"`
+		A []string `json:"a" validate:"dive,required_with=B,min=1"`
+	}
+
+	tests := map[string]struct {
+		typ  reflect.Type
+		want string
+	}{
+		"unknown field":      {typ: reflect.TypeFor[unknownField](), want: "no field Missing"},
+		"odd params":         {typ: reflect.TypeFor[oddParams](), want: "want field and value pairs"},
+		"duplicate field":    {typ: reflect.TypeFor[duplicateParams](), want: "names field B twice"},
+		"struct sibling":     {typ: reflect.TypeFor[structSibling](), want: "whether b is set"},
+		"omitempty slice":    {typ: reflect.TypeFor[omitemptySlice](), want: "whether b is set"},
+		"slice value":        {typ: reflect.TypeFor[sliceValue](), want: "cannot compare"},
+		"nil number":         {typ: reflect.TypeFor[nilNumber](), want: "required_if=B nil"},
+		"no enclosing field": {typ: reflect.TypeFor[underDive](), want: "no enclosing struct"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := jsonschema.Generate(t.Context(), tc.typ,
+				jsonschema.WithTagInterpreter("validate", validate.NewInterpreter()),
+			)
+			require.ErrorContains(t, err, tc.want)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package validate_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/interpreters/validate"
+)
+
+// Each roster below holds one validator on field v, so its instances are the
+// bare value wrapped as {"v": ...}.
+
+type stringsPrefixSuffix struct {
+	V string `json:"v" validate:"startswith=a.b,endswith=z"`
+}
+
+type stringsExcludes struct {
+	V string `json:"v" validate:"min=2,excludes=x,excludesall=!-"`
+}
+
+type stringsContainsAny struct {
+	V string `json:"v" validate:"containsany=]^-"`
+}
+
+type stringsNotAffixed struct {
+	V string `json:"v" validate:"startsnotwith=-,endsnotwith=."`
+}
+
+type stringsCase struct {
+	Lower string `json:"lower" validate:"lowercase"`
+	Upper string `json:"upper" validate:"uppercase"`
+}
+
+type stringsBoolean struct {
+	Text string `json:"text" validate:"boolean"`
+	Flag bool   `json:"flag" validate:"boolean"`
+}
+
+type stringsIP struct {
+	V string `json:"v" validate:"ip"`
+}
+
+type stringsCIDR struct {
+	V string `json:"v" validate:"cidr"`
+}
+
+type stringsMAC struct {
+	V string `json:"v" validate:"mac"`
+}
+
+type stringsDatetime struct {
+	V string `json:"v" validate:"datetime=2006-01-02"`
+}
+
+type stringsDatetimeClock struct {
+	V string `json:"v" validate:"datetime=Jan _2 15:04:05.000 MST"`
+}
+
+func TestValidateInterpreter_StringPredicates(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		typ     reflect.Type
+		formats bool
+		valid   []string
+		invalid []string
+	}{
+		"startswith and endswith": {
+			typ:     reflect.TypeFor[stringsPrefixSuffix](),
+			valid:   []string{`{"v":"a.bz"}`, `{"v":"a.b-z"}`},
+			invalid: []string{`{"v":"axbz"}`, `{"v":"a.b"}`, `{"v":"za.b"}`},
+		},
+		"excludes and excludesall": {
+			typ:     reflect.TypeFor[stringsExcludes](),
+			valid:   []string{`{"v":"ab"}`, `{"v":"a.b"}`},
+			invalid: []string{`{"v":"ax"}`, `{"v":"a!"}`, `{"v":"a-b"}`, `{"v":"a"}`},
+		},
+		"containsany": {
+			typ:     reflect.TypeFor[stringsContainsAny](),
+			valid:   []string{`{"v":"a]"}`, `{"v":"^"}`, `{"v":"a-b"}`},
+			invalid: []string{`{"v":"abc"}`, `{"v":""}`},
+		},
+		"startsnotwith and endsnotwith": {
+			typ:     reflect.TypeFor[stringsNotAffixed](),
+			valid:   []string{`{"v":""}`, `{"v":"a-b.c"}`},
+			invalid: []string{`{"v":"-a"}`, `{"v":"a."}`},
+		},
+		"lowercase and uppercase": {
+			typ:   reflect.TypeFor[stringsCase](),
+			valid: []string{`{"lower":"abc1 ß","upper":"ABC1 Σ"}`},
+			invalid: []string{
+				`{"lower":"","upper":"A"}`,
+				`{"lower":"aBc","upper":"A"}`,
+				`{"lower":"ǅ","upper":"A"}`,
+				`{"lower":"Ⅻ","upper":"A"}`,
+				`{"lower":"a","upper":"Ab"}`,
+			},
+		},
+		"boolean": {
+			typ:     reflect.TypeFor[stringsBoolean](),
+			valid:   []string{`{"text":"true","flag":false}`, `{"text":"F","flag":true}`, `{"text":"1","flag":true}`},
+			invalid: []string{`{"text":"yes","flag":true}`, `{"text":"tRUE","flag":true}`},
+		},
+		"ip": {
+			typ:     reflect.TypeFor[stringsIP](),
+			formats: true,
+			valid:   []string{`{"v":"192.0.2.1"}`, `{"v":"2001:db8::1"}`, `{"v":"::ffff:192.0.2.1"}`},
+			invalid: []string{`{"v":"192.0.2"}`, `{"v":"host"}`},
+		},
+		"cidr": {
+			typ:     reflect.TypeFor[stringsCIDR](),
+			valid:   []string{`{"v":"192.0.2.0/24"}`, `{"v":"192.0.2.7/024"}`, `{"v":"2001:db8::/128"}`},
+			invalid: []string{`{"v":"192.0.2.0/33"}`, `{"v":"2001:db8::/129"}`, `{"v":"192.0.2.0"}`, `{"v":"01.0.0.0/8"}`},
+		},
+		"mac": {
+			typ: reflect.TypeFor[stringsMAC](),
+			valid: []string{
+				`{"v":"00:00:5e:00:53:01"}`,
+				`{"v":"00-00-5E-00-53-01"}`,
+				`{"v":"0000.5e00.5301"}`,
+				`{"v":"00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01"}`,
+			},
+			invalid: []string{`{"v":"00:00:5e:00:53"}`, `{"v":"00:00-5e:00:53:01"}`, `{"v":"0:0:5e:0:53:1"}`},
+		},
+		"datetime date": {
+			typ:     reflect.TypeFor[stringsDatetime](),
+			valid:   []string{`{"v":"2024-02-29"}`, `{"v":"2023-12-31"}`, `{"v":"2000-02-29"}`},
+			invalid: []string{`{"v":"2023-02-29"}`, `{"v":"1900-02-29"}`, `{"v":"2024-04-31"}`, `{"v":"2024-1-02"}`},
+		},
+		"datetime clock": {
+			typ: reflect.TypeFor[stringsDatetimeClock](),
+			valid: []string{
+				`{"v":"Feb  3 04:05:06.789 UTC"}`,
+				`{"v":"feb 13 23:59:59.000 +05"}`,
+				`{"v":"JAN 31 00:00:00.123 GMT-7"}`,
+			},
+			invalid: []string{
+				`{"v":"Feb 30 04:05:06.789 UTC"}`,
+				`{"v":"Feb  3 04:05:06.78 UTC"}`,
+				`{"v":"Feb  3 24:05:06.789 UTC"}`,
+				`{"v":"Feb  3 04:05:06.789 utc"}`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := jsonschema.Generate(t.Context(), tc.typ,
+				jsonschema.WithTagInterpreter("validate", validate.NewInterpreter()),
+			)
+			require.NoError(t, err)
+
+			v, err := jsonschema.Compile(t.Context(), s, jsonschema.WithFormats(tc.formats))
+			require.NoError(t, err)
+
+			for _, doc := range tc.valid {
+				require.NoError(t, v.ValidateJSON(t.Context(), []byte(doc)), doc)
+			}
+
+			for _, doc := range tc.invalid {
+				require.Error(t, v.ValidateJSON(t.Context(), []byte(doc)), doc)
+			}
+		})
+	}
+}
+
+func TestValidateInterpreter_StringPredicateShape(t *testing.T) {
+	t.Parallel()
+
+	type Affixed struct {
+		Code string `json:"code" validate:"alpha,startswith=ab,excludes=c"`
+		Flag bool   `json:"flag" validate:"boolean"`
+	}
+
+	s, err := jsonschema.GenerateFor[Affixed](t.Context(),
+		jsonschema.WithTagInterpreter("validate", validate.NewInterpreter()),
+	)
+	require.NoError(t, err)
+
+	// The first pattern keeps the pattern keyword; the second joins allOf
+	// rather than being dropped, and the negated rule sits under a not.
+	code := s.Properties["code"]
+	assert.Equal(t, `^[a-zA-Z]+$`, code.Pattern)
+	require.Len(t, code.AllOf, 2)
+	assert.Equal(t, `^ab`, code.AllOf[0].Pattern)
+	require.NotNil(t, code.AllOf[1].Not)
+	assert.Equal(t, `c`, code.AllOf[1].Not.Pattern)
+
+	flag := s.Properties["flag"]
+	assert.Empty(t, flag.Pattern)
+	assert.Empty(t, flag.AllOf)
+}
+
+func TestValidateInterpreter_StringPredicateErrors(t *testing.T) {
+	t.Parallel()
+
+	type cidrV4 struct {
+		V string `json:"v" validate:"cidrv4"`
+	}
+
+	type cidrV6 struct {
+		V string `json:"v" validate:"cidrv6"`
+	}
+
+	type emptyPrefix struct {
+		V string `json:"v" validate:"startswith"`
+	}
+
+	type ipParam struct {
+		V string `json:"v" validate:"ip=4"`
+	}
+
+	type ipOnInt struct {
+		V int `json:"v" validate:"ip"`
+	}
+
+	type ambiguousDigits struct {
+		V string `json:"v" validate:"datetime=1_2"`
+	}
+
+	type yearDay struct {
+		V string `json:"v" validate:"datetime=2006-002"`
+	}
+
+	type twoYears struct {
+		V string `json:"v" validate:"datetime=2006 06"`
+	}
+
+	tests := map[string]struct {
+		typ  reflect.Type
+		want string
+	}{
+		"cidrv4":           {typ: reflect.TypeFor[cidrV4](), want: "cidrv4 has no JSON Schema equivalent"},
+		"cidrv6":           {typ: reflect.TypeFor[cidrV6](), want: "cidrv6 has no JSON Schema equivalent"},
+		"missing text":     {typ: reflect.TypeFor[emptyPrefix](), want: "startswith"},
+		"ip parameter":     {typ: reflect.TypeFor[ipParam](), want: "takes no parameter"},
+		"ip on integer":    {typ: reflect.TypeFor[ipOnInt](), want: "validate tag: ip"},
+		"ambiguous digits": {typ: reflect.TypeFor[ambiguousDigits](), want: "datetime=1_2"},
+		"day of year":      {typ: reflect.TypeFor[yearDay](), want: "datetime=2006-002"},
+		"repeated year":    {typ: reflect.TypeFor[twoYears](), want: "datetime=2006 06"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := jsonschema.Generate(t.Context(), tc.typ,
+				jsonschema.WithTagInterpreter("validate", validate.NewInterpreter()),
+			)
+			require.ErrorContains(t, err, tc.want)
+		})
+	}
+}
@@ -984,7 +984,10 @@ func TestValidateInterpreter_OrOperator(t *testing.T) {
 	t.Parallel()
 
 	type Config struct {
-		Value int `json:"value" validate:"min=1|max=10"`
+		Value int     `json:"value" validate:"max=1|min=10"`
+		Ptr   *string `json:"ptr"   validate:"omitempty,len=2|email"`
+		Wide  string  `json:"wide"  validate:"iscolor|len=3"`
+		Twice string  `json:"twice" validate:"len=1|len=3,eq=a|eq=bbb"`
 	}
 
 	s, err := jsonschema.GenerateFor[Config](t.Context(),
@@ -992,9 +995,24 @@ func TestValidateInterpreter_OrOperator(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	// Only first group before | is used.
-	assert.Equal(t, new(float64(1)), s.Properties["value"].Minimum)
-	assert.Nil(t, s.Properties["value"].Maximum)
+	got, err := json.Marshal(s.Properties)
+	require.NoError(t, err)
+
+	// Each group lands on the value branch; the pointer keeps its null branch
+	// beside it. An untranslatable alternative widens its group to every
+	// value, and two groups on one field are two allOf entries.
+	assert.JSONEq(t, `{
+		"value": {"type":"integer","allOf":[{"anyOf":[{"maximum":1},{"minimum":10}]}]},
+		"ptr": {"anyOf":[
+			{"type":"string","allOf":[{"anyOf":[{"minLength":2,"maxLength":2},{"format":"email"}]}]},
+			{"type":"null"}
+		]},
+		"wide": {"type":"string"},
+		"twice": {"type":"string","allOf":[
+			{"anyOf":[{"minLength":1,"maxLength":1},{"minLength":3,"maxLength":3}]},
+			{"anyOf":[{"const":"a"},{"const":"bbb"}]}
+		]}
+	}`, string(got))
 }
 
 func TestValidateInterpreter_CrossFieldIgnored(t *testing.T) {
@@ -1015,7 +1033,7 @@ func TestValidateInterpreter_CrossFieldIgnored(t *testing.T) {
 	assert.Contains(t, s.Required, "end")
 }
 
-func TestValidateInterpreter_MapKeyValidatorsIgnored(t *testing.T) {
+func TestValidateInterpreter_MapKeyValidators(t *testing.T) {
 	t.Parallel()
 
 	type Config struct {
@@ -1027,14 +1045,78 @@ func TestValidateInterpreter_MapKeyValidatorsIgnored(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	// Keys/endkeys and everything between them should be silently ignored.
-	// Min=1 applies to the map (minProperties), dive descends into
-	// additionalProperties (value type), keys...endkeys block (including
-	// min=3) is skipped, min=2 applies to value's minLength.
+	// Min=1 applies to the map (minProperties), the keys...endkeys block to
+	// its property names, and min=2 after it to the values.
 	assert.Equal(t, new(1), s.Properties["data"].MinProperties)
+	require.NotNil(t, s.Properties["data"].PropertyNames)
+	assert.Equal(t, new(3), s.Properties["data"].PropertyNames.MinLength)
 	assert.Equal(t, new(2), s.Properties["data"].AdditionalProperties.MinLength)
 }
 
+func TestValidateInterpreter_MapKeyBlocks(t *testing.T) {
+	t.Parallel()
+
+	type Config struct {
+		Names   map[string]int `json:"names"   validate:"dive,keys,alpha,max=4,endkeys"`
+		Choices map[string]int `json:"choices" validate:"dive,keys,oneof=a 'b c',endkeys,min=1"`
+		Hosts   map[string]int `json:"hosts"   validate:"dive,keys,hostname,endkeys"`
+		Ports   map[int]string `json:"ports"   validate:"dive,keys,oneof=80 443,endkeys"`
+	}
+
+	s, err := jsonschema.GenerateFor[Config](t.Context(),
+		jsonschema.WithTagInterpreter("validate", validate.NewInterpreter()),
+	)
+	require.NoError(t, err)
+
+	got, err := json.Marshal(s.Properties)
+	require.NoError(t, err)
+
+	var props map[string]struct {
+		PropertyNames        json.RawMessage `json:"propertyNames"`
+		AdditionalProperties json.RawMessage `json:"additionalProperties"`
+	}
+	require.NoError(t, json.Unmarshal(got, &props))
+
+	assert.JSONEq(t, `{"pattern":"^[a-zA-Z]+$","maxLength":4}`, string(props["names"].PropertyNames))
+	assert.JSONEq(t, `{"type":"integer"}`, string(props["names"].AdditionalProperties))
+	assert.JSONEq(t, `{"enum":["a","b c"]}`, string(props["choices"].PropertyNames))
+	assert.JSONEq(t, `{"type":"integer","minimum":1}`, string(props["choices"].AdditionalProperties))
+	assert.JSONEq(t, `{"format":"hostname"}`, string(props["hosts"].PropertyNames))
+	assert.JSONEq(t, `{"type":"string","pattern":"^-?[0-9]+$","enum":["80","443"]}`,
+		string(props["ports"].PropertyNames))
+}
+
+func TestValidateInterpreter_MapKeyBlockErrors(t *testing.T) {
+	t.Parallel()
+
+	type notMap struct {
+		Data []string `json:"data" validate:"dive,keys,min=1,endkeys"`
+	}
+
+	type numericKeyBound struct {
+		Data map[int]string `json:"data" validate:"dive,keys,min=1,endkeys"`
+	}
+
+	tests := map[string]struct {
+		typ  reflect.Type
+		want string
+	}{
+		"not a map":         {typ: reflect.TypeFor[notMap](), want: "no map keys to constrain"},
+		"numeric key bound": {typ: reflect.TypeFor[numericKeyBound](), want: "validate tag: min"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := jsonschema.Generate(t.Context(), tc.typ,
+				jsonschema.WithTagInterpreter("validate", validate.NewInterpreter()),
+			)
+			require.ErrorContains(t, err, tc.want)
+		})
+	}
+}
+
 func TestValidateInterpreter_ExclusiveCollectionConstraints(t *testing.T) {
 	t.Parallel()
 
@@ -2288,10 +2370,10 @@ func TestValidateInterpreter_PipeBindsWithinCommaGroup(t *testing.T) {
 
 	// The | OR operator binds within a single comma group: go-playground splits
 	// on commas first, then treats the pipe as OR. A pipe in an earlier group
-	// must not swallow later comma-separated constraints. Here oneof=a|b keeps
-	// only its first alternative ("a"), and the trailing min=2 still applies.
+	// must not swallow later comma-separated constraints. Here oneof=a|eq=bb
+	// is one disjunction, and the trailing min=2 still applies.
 	type Form struct {
-		Name string `json:"name" validate:"oneof=a|b,min=2"`
+		Name string `json:"name" validate:"oneof=a|eq=bb,min=2"`
 	}
 
 	s, err := jsonschema.GenerateFor[Form](t.Context(),
@@ -2301,8 +2383,9 @@ func TestValidateInterpreter_PipeBindsWithinCommaGroup(t *testing.T) {
 
 	field := s.Properties["name"]
 	require.NotNil(t, field)
-	assert.Equal(t, []any{"a"}, field.Enum,
-		"only the first OR alternative of oneof=a|b is interpreted")
+	require.Len(t, field.AllOf, 1)
+	assert.Equal(t, []*jsonschema.Schema{{Enum: []any{"a"}}, {Const: new(any("bb"))}}, field.AllOf[0].AnyOf,
+		"oneof=a|eq=bb is an anyOf of both alternatives")
 	require.NotNil(t, field.MinLength,
 		"min after a pipe-bearing constraint must still apply")
 	assert.Equal(t, 2, *field.MinLength)
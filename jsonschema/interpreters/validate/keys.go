@@ -1,6 +1,9 @@
 package validate
 
 import (
+	"reflect"
+	"unicode"
+
 	"go.jacobcolvin.com/x/jsonschema"
 	"go.jacobcolvin.com/x/jsonschema/internal/content"
 	"go.jacobcolvin.com/x/jsonschema/internal/tagmodel"
@@ -18,6 +21,24 @@ type validatorRule struct {
 	// replaces the generic arity message so the error says what is actually
 	// unrepresentable, not merely that the parameter was unexpected.
 	paramNote string
+	// The unmapped field marks a validator go-playground defines that no
+	// keyword states faithfully. It is the reason the key is an error rather
+	// than an unrecognized one.
+	unmapped string
+	// The toPattern field turns the parameter into the pattern the row's
+	// operation carries, for a validator whose parameter is text to look for
+	// rather than a pattern: startswith=ab is the pattern ^ab.
+	toPattern func(param string) (string, error)
+	// The anyOf field names the validators this one is the OR of, for one
+	// that accepts exactly what any of them does: ip is ipv4|ipv6.
+	anyOf []string
+	// The negated field marks a validator that holds exactly where its rule
+	// fails, as excludes is the opposite of contains. The rule is written
+	// under a not beside the field's other constraints.
+	negated bool
+	// The vacuousFor field is a kind every value of which go-playground
+	// accepts under this validator, so the row contributes nothing there.
+	vacuousFor reflect.Kind
 	tagmodel.KeyRule
 }
 
@@ -71,12 +92,58 @@ var validatorKeys = map[string]validatorRule{
 	"ipv6":     formatKey("ipv6"),
 	"hostname": formatKey("hostname"),
 
+	// Go's net.ParseIP accepts what either format does: the ipv4 format is
+	// an address with no colon and the ipv6 format one with a colon.
+	"ip": {anyOf: []string{"ipv4", "ipv6"}},
+
+	// ParseCIDR's grammar is a pattern, but the v4 and v6 forms also compare
+	// the parsed address with its network, which no pattern over the text
+	// states at a reasonable size.
+	"cidr": patternKey(cidrPattern),
+	"cidrv4": {
+		unmapped: "its address must be the network's own, with every host bit zero",
+	},
+	"cidrv6": {
+		unmapped: "it rejects every spelling of an IPv4-mapped address",
+	},
+	"mac": patternKey(macPattern),
+
 	"alpha":    patternKey(`^[a-zA-Z]+$`),
 	"alphanum": patternKey(`^[a-zA-Z0-9]+$`),
 	"numeric":  patternKey(`^[-+]?[0-9]+(?:\.[0-9]+)?$`),
 	"number":   patternKey(`^[0-9]+$`),
 	"ascii":    patternKey(`^[\x00-\x7F]*$`),
 
+	"hexadecimal":      patternKey(hexadecimalPattern),
+	"hexcolor":         patternKey(hexColorPattern),
+	"e164":             patternKey(e164Pattern),
+	"semver":           patternKey(semverPattern),
+	"fqdn":             patternKey(fqdnPattern),
+	"hostname_rfc1123": patternKey(hostnameRFC1123Pattern),
+	"url_encoded":      patternKey(urlEncodedPattern),
+	"jwt":              patternKey(jwtPattern),
+	"base64url":        patternKey(base64URLPattern),
+	"lowercase":        patternKey(casedPattern(unicode.LowerCase)),
+	"uppercase":        patternKey(casedPattern(unicode.UpperCase)),
+
+	// A bool field is always a boolean; text is one when strconv.ParseBool
+	// reads it.
+	"boolean": {
+		KeyRule:    tagmodel.KeyRule{Op: tagmodel.OpPattern, Param: tagmodel.ParamNone, Implied: boolPattern},
+		vacuousFor: reflect.Bool,
+	},
+
+	"startswith":    textKey(prefixPattern),
+	"endswith":      textKey(suffixPattern),
+	"contains":      textKey(substringPattern),
+	"containsany":   textKey(anyRunePattern),
+	"startsnotwith": negatedKey(textKey(prefixPattern)),
+	"endsnotwith":   negatedKey(textKey(suffixPattern)),
+	"excludes":      negatedKey(textKey(substringPattern)),
+	"excludesall":   negatedKey(textKey(anyRunePattern)),
+
+	"datetime": textKey(datetimePattern),
+
 	"json": {KeyRule: tagmodel.KeyRule{
 		Op: tagmodel.OpContentMediaType, Param: tagmodel.ParamNone, Implied: "application/json",
 	}},
@@ -101,6 +168,22 @@ func patternKey(pattern string) validatorRule {
 	}}
 }
 
+// textKey is a validator whose parameter becomes its pattern, escaped or
+// translated by the row's own function rather than taken as a pattern.
+func textKey(toPattern func(string) (string, error)) validatorRule {
+	return validatorRule{
+		KeyRule:   tagmodel.KeyRule{Op: tagmodel.OpPattern, Param: tagmodel.ParamRequired},
+		toPattern: toPattern,
+	}
+}
+
+// negatedKey is the validator that holds exactly where rule fails.
+func negatedKey(rule validatorRule) validatorRule {
+	rule.negated = true
+
+	return rule
+}
+
 // isControlTag reports whether a key is a go-playground/validator control tag
 // that governs when validation runs rather than expressing a value constraint.
 // These have no JSON Schema representation and are skipped.
@@ -115,14 +198,15 @@ func isControlTag(key string) bool {
 }
 
 // isCrossFieldValidator reports whether a key is a cross-field validator that
-// should be silently ignored.
+// should be silently ignored: one comparing the field's value with another
+// field's, which no schema keyword can express. The conditional required_* and
+// excluded_* validators are translated instead (see conditionalKeys), and
+// skip_unless, which gates the field's other rules on a sibling's value, is
+// skipped with these.
 func isCrossFieldValidator(key string) bool {
 	switch key {
 	case "eqfield", "nefield", "gtfield", "gtefield", "ltfield", "ltefield",
 		"eqcsfield", "necsfield", "gtcsfield", "gtecsfield", "ltcsfield", "ltecsfield",
-		"required_if", "required_unless", "required_with", "required_with_all",
-		"required_without", "required_without_all", "excluded_if", "excluded_unless",
-		"excluded_with", "excluded_with_all", "excluded_without", "excluded_without_all",
 		"skip_unless", "fieldcontains", "fieldexcludes":
 		return true
 	}
@@ -0,0 +1,219 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The go-playground regular expressions a pattern row carries verbatim. Each
+// is the library's own, so the schema's search and go-playground's match are
+// the same RE2 program over the same text.
+const (
+	hexadecimalPattern = `^(0[xX])?[0-9a-fA-F]+$`
+	hexColorPattern    = `^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`
+	e164Pattern        = `^\+?[1-9]\d{7,14}$`
+	semverPattern      = `^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+		`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+		`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`
+	hostnameRFC1123Pattern = `^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?` +
+		`(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`
+	fqdnPattern = `^([a-zA-Z0-9]{1}[a-zA-Z0-9-]{0,62})(\.[a-zA-Z0-9]{1}[a-zA-Z0-9-]{0,62})*?` +
+		`(\.[a-zA-Z]{1}[a-zA-Z0-9-]{0,62})\.?$`
+	urlEncodedPattern = `^(?:[^%]|%[0-9A-Fa-f]{2})*$`
+	jwtPattern        = `^[A-Za-z0-9-_]+\.[A-Za-z0-9-_]+\.[A-Za-z0-9-_]*$`
+	base64URLPattern  = `^(?:[A-Za-z0-9-_]{4})*(?:[A-Za-z0-9-_]{2}==|[A-Za-z0-9-_]{3}=|[A-Za-z0-9-_]{4})$`
+
+	// The boolPattern pattern is the text strconv.ParseBool accepts.
+	boolPattern = `^(?:1|t|T|TRUE|true|True|0|f|F|FALSE|false|False)$`
+)
+
+// The network patterns restate a net parser rather than a go-playground
+// regular expression, so they are assembled from the grammar the parser
+// implements.
+var (
+	// The macPattern pattern is what net.ParseMAC accepts: a 48-, 64-, or
+	// 160-bit address as hex octets separated by one repeated colon or hyphen,
+	// as dotted groups of four hex digits, or as bare hex.
+	macPattern = `^(?:` + strings.Join([]string{
+		separatedHex(2, ":", 6, 8, 20),
+		separatedHex(2, "-", 6, 8, 20),
+		separatedHex(4, `\.`, 3, 4, 10),
+		`[0-9A-Fa-f]{12}|[0-9A-Fa-f]{16}|[0-9A-Fa-f]{40}`,
+	}, "|") + `)$`
+
+	// The cidrPattern pattern is what net.ParseCIDR accepts: an address without
+	// a zone, a slash, and a decimal prefix length (leading zeros allowed) no
+	// longer than the address.
+	cidrPattern = `^(?:` + ipv4Text + `/0*(?:[0-9]|[12][0-9]|3[0-2])|` +
+		ipv6Text() + `/0*(?:[0-9]|[1-9][0-9]|1[01][0-9]|12[0-8]))$`
+)
+
+// ipv4Text is a dotted-quad address as net/netip parses one: four decimal
+// octets, none above 255 or written with a leading zero.
+const ipv4Text = `(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])(?:\.(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])){3}`
+
+// ipv6Text is an IPv6 address as net/netip parses one without a zone: eight
+// groups of one to four hex digits, a :: standing for at least one zero group,
+// and an optional dotted quad in place of the last two. The alternatives are
+// RFC 3986's IPv6address, one per count of groups after the ::.
+func ipv6Text() string {
+	const h16 = `[0-9A-Fa-f]{1,4}`
+
+	ls32 := `(?:` + h16 + `:` + h16 + `|` + ipv4Text + `)`
+	tails := []string{
+		`(?:` + h16 + `:){5}` + ls32,
+		`(?:` + h16 + `:){4}` + ls32,
+		`(?:` + h16 + `:){3}` + ls32,
+		`(?:` + h16 + `:){2}` + ls32,
+		h16 + `:` + ls32,
+		ls32,
+		h16,
+		``,
+	}
+
+	alts := []string{`(?:` + h16 + `:){6}` + ls32}
+
+	for i, tail := range tails {
+		head := ""
+		if i > 0 {
+			head = fmt.Sprintf(`(?:(?:%s:){0,%d}%s)?`, h16, i-1, h16)
+		}
+
+		alts = append(alts, head+`::`+tail)
+	}
+
+	return `(?:` + strings.Join(alts, "|") + `)`
+}
+
+// separatedHex is the alternation of runs of n-digit hex groups joined by sep,
+// one alternative per group count.
+func separatedHex(n int, sep string, counts ...int) string {
+	group := fmt.Sprintf(`[0-9A-Fa-f]{%d}`, n)
+
+	alts := make([]string, 0, len(counts))
+	for _, c := range counts {
+		alts = append(alts, fmt.Sprintf(`(?:%s%s){%d}`, sep, group, c-1))
+	}
+
+	return group + `(?:` + strings.Join(alts, "|") + `)`
+}
+
+// casedPattern is the pattern a string meets when mapping it to the given case
+// leaves it unchanged and it is not empty, which is go-playground's lowercase
+// (c = [unicode.LowerCase]) and uppercase ([unicode.UpperCase]):
+// strings.ToLower maps rune by rune, so a string is its own lower case exactly
+// when none of its runes has a different one. The class of runes that do is
+// read off the Unicode case tables the standard library maps with, rather than
+// approximated by \p{Lu}, which misses titlecase letters, circled letters,
+// Roman numerals, and the rest of what strings.ToLower changes.
+func casedPattern(c int) string {
+	var b strings.Builder
+
+	lo, hi := rune(-1), rune(-1)
+
+	flush := func() {
+		if lo < 0 {
+			return
+		}
+
+		b.WriteString(classRune(lo))
+
+		if hi > lo+1 {
+			b.WriteByte('-')
+		}
+
+		if hi > lo {
+			b.WriteString(classRune(hi))
+		}
+	}
+
+	add := func(r rune) {
+		if lo >= 0 && r == hi+1 {
+			hi = r
+			return
+		}
+
+		flush()
+
+		lo, hi = r, r
+	}
+
+	for _, cr := range unicode.CaseRanges {
+		delta := cr.Delta[c]
+
+		switch {
+		case delta > unicode.MaxRune:
+			// An upper-lower sequence alternates, starting upper: lowering
+			// changes the even offsets and uppering the odd ones.
+			start := rune(cr.Lo)
+			if c == unicode.UpperCase {
+				start++
+			}
+
+			for r := start; r <= rune(cr.Hi); r += 2 {
+				add(r)
+			}
+
+		case delta != 0:
+			for r := rune(cr.Lo); r <= rune(cr.Hi); r++ {
+				add(r)
+			}
+		}
+	}
+
+	flush()
+
+	return `^[^` + b.String() + `]+$`
+}
+
+// classRune spells a rune inside a character class: ASCII as itself, anything
+// else as a hex escape so the pattern stays printable.
+func classRune(r rune) string {
+	if r < utf8.RuneSelf {
+		return regexp.QuoteMeta(string(r))
+	}
+
+	return fmt.Sprintf(`\x{%x}`, r)
+}
+
+// prefixPattern is startswith's parameter as a pattern: the literal text,
+// anchored at the start.
+func prefixPattern(param string) (string, error) {
+	return "^" + regexp.QuoteMeta(param), nil
+}
+
+// suffixPattern is endswith's parameter as a pattern: the literal text,
+// anchored at the end.
+func suffixPattern(param string) (string, error) {
+	return regexp.QuoteMeta(param) + "$", nil
+}
+
+// substringPattern is contains's parameter as a pattern: the literal text,
+// found anywhere, since a pattern is a search rather than a whole-string match.
+func substringPattern(param string) (string, error) {
+	return regexp.QuoteMeta(param), nil
+}
+
+// anyRunePattern is containsany's parameter as a pattern: a class of its runes,
+// each escaped, so the pattern is found wherever one of them occurs.
+func anyRunePattern(param string) (string, error) {
+	var b strings.Builder
+
+	b.WriteByte('[')
+
+	for _, r := range param {
+		if r == '-' {
+			b.WriteString(`\-`)
+			continue
+		}
+
+		b.WriteString(regexp.QuoteMeta(string(r)))
+	}
+
+	b.WriteByte(']')
+
+	return b.String(), nil
+}
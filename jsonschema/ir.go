@@ -14,16 +14,21 @@ const (
 	// an opaque override/provider payload. Its payload is rendered as-is.
 	kindValue nodeKind = iota
 	// A kindObject node is a struct: props hold its declared properties and
-	// embeds its allOf/anyOf composition branches.
+	// embeds its allOf/anyOf composition branches. Under a naming dialect,
+	// items holds the value node of a map inlined into the struct.
 	kindObject
 	// A kindList node is a slice: items holds the element node.
 	kindList
 	// A kindTuple node is a fixed-length array: prefix holds one node per element.
 	kindTuple
-	// A kindMap node is a map: items holds the value node.
+	// A kindMap node is a map: items holds the value node and keys the key
+	// node.
 	kindMap
 	// A kindRef node is a reference to a $defs entry named by def.
 	kindRef
+	// A kindUnion node is a registered discriminated union: union holds one
+	// branch per variant.
+	kindUnion
 )
 
 // node is one position in the generation IR. Build produces a node tree
@@ -35,7 +40,8 @@ const (
 type node struct {
 	payload *Schema   // bare type-derived payload; sub-schema fields hold child payloads (shared)
 	def     *defEntry // non-nil iff kindRef
-	items   *node     // slice element / map value
+	items   *node     // slice element / map value / inlined map value
+	keys    *node     // map key, rendered as propertyNames
 	// The authored canvas carries the field-level facts that field and element
 	// hooks (the jsonschema tag, the comment provider, tag interpreters) declare:
 	// annotations, value-scoped const/enum, and numeric/string/array bounds. It is
@@ -61,6 +67,7 @@ type node struct {
 	props   []nodeProp  // struct properties, declaration order
 	prefix  []*node     // array elements (prefixItems / itemsArray)
 	embeds  []embedNode // struct allOf/anyOf composition branches
+	union   *unionNode  // non-nil iff kindUnion
 
 	kind nodeKind
 	// Nullable is the single deferred null decision for a non-kindRef node; base
@@ -113,16 +120,30 @@ type embedNode struct {
 	optional bool
 }
 
+// unionNode is a discriminated union's variants and the property whose value
+// selects among them.
+type unionNode struct {
+	property string
+	branches []unionBranch
+}
+
+// unionBranch is one union variant: its struct node and discriminator value.
+type unionBranch struct {
+	node  *node
+	value string
+}
+
 // defEntry is a shared $defs entry. Every reference to the type is a kindRef
 // node linking here, so the body is built once and each reference resolves its
 // own null decision from its pointer-ness and the entry's stance, making $defs
 // nullability order-independent.
 type defEntry struct {
-	typ      reflect.Type
-	body     *node   // bare value node; nil while a cycle placeholder
-	rendered *Schema // memoized render(body); the $defs value and null-dedup target
-	baseName string  // namer output, pre-disambiguation; the provisional $ref token
-	name     string  // final $defs key; set by assignDefNames before render
+	typ      reflect.Type // nil for a type known only statically
+	pkgPath  string       // import path of the type's package, for disambiguation
+	body     *node        // bare value node; nil while a cycle placeholder
+	rendered *Schema      // memoized render(body); the $defs value and null-dedup target
+	baseName string       // namer output, pre-disambiguation; the provisional $ref token
+	name     string       // final $defs key; set by assignDefNames before render
 	// Nullability is the type's declared null-admission stance, recorded once at
 	// definition time and combined with each reference's pointer-ness in
 	// nullableDecision. The stance is a per-type property, so recording it on the
@@ -144,7 +165,7 @@ func (g *generator) newDefEntry(t reflect.Type) *defEntry {
 		return e
 	}
 
-	e := &defEntry{typ: t, baseName: g.schemaName(t)}
+	e := &defEntry{typ: t, pkgPath: t.PkgPath(), baseName: g.schemaName(t)}
 	g.typeToDef[t] = e
 	g.defs = append(g.defs, e)
 
@@ -182,6 +203,10 @@ func allocCanvasTree(n *node, draft Draft) {
 			a.AdditionalProperties = n.items.authored
 		}
 
+		// The key canvas is reached through the node alone: propertyNames is
+		// structural, so linking it here would not carry it to render anyway.
+		allocCanvasTree(n.keys, draft)
+
 	case kindTuple:
 		elems := make([]*Schema, len(n.prefix))
 		for i, c := range n.prefix {
@@ -196,9 +221,10 @@ func allocCanvasTree(n *node, draft Draft) {
 			a.PrefixItems = elems
 		}
 
-	case kindValue, kindObject, kindRef:
-		// A leaf value, a struct (its properties are separate field nodes), or a
-		// $ref carries no element canvas of its own.
+	case kindValue, kindObject, kindRef, kindUnion:
+		// A leaf value, a struct (its properties are separate field nodes), a
+		// $ref, or a union (its branches are type-level schemas) carries no
+		// element canvas of its own.
 	}
 
 	n.authored = a
@@ -240,7 +266,8 @@ func (g *generator) defineType(t reflect.Type, body *node, stance Nullability, p
 }
 
 // walkNodes visits every node reachable from root, following items, props,
-// prefix, embeds, and each reference's def body, calling visit on each node.
+// prefix, embeds, union branches, and each reference's def body, calling visit
+// on each node.
 // Seen guards def bodies so a self- or mutually recursive graph terminates and
 // each body is descended once; on return it holds every def reached. The
 // visitor may inspect n.def.
@@ -257,6 +284,7 @@ func walkNodes(root *node, seen map[*defEntry]bool, visit func(*node)) {
 	}
 
 	walkNodes(root.items, seen, visit)
+	walkNodes(root.keys, seen, visit)
 
 	for _, p := range root.props {
 		walkNodes(p.schema, seen, visit)
@@ -269,6 +297,12 @@ func walkNodes(root *node, seen map[*defEntry]bool, visit func(*node)) {
 	for _, e := range root.embeds {
 		walkNodes(e.branch, seen, visit)
 	}
+
+	if root.union != nil {
+		for _, b := range root.union.branches {
+			walkNodes(b.node, seen, visit)
+		}
+	}
 }
 
 // payloadRefTargets maps every $defs ref string a hook may have authored to its
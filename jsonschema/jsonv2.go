@@ -0,0 +1,189 @@
+package jsonschema
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/content"
+	"go.jacobcolvin.com/x/jsonschema/internal/fieldname"
+	"go.jacobcolvin.com/x/jsonschema/internal/numkind"
+	"go.jacobcolvin.com/x/jsonschema/internal/typename"
+)
+
+// Patterns of the encoded forms encoding/json/v2's format option selects.
+const (
+	patternBase64URL = `^([A-Za-z0-9_-]{4})*([A-Za-z0-9_-]{2}==|[A-Za-z0-9_-]{3}=)?$`
+	patternBase32    = `^([A-Z2-7]{8})*([A-Z2-7]{2}={6}|[A-Z2-7]{4}={4}|[A-Z2-7]{5}={3}|[A-Z2-7]{7}=)?$`
+	patternBase32Hex = `^([0-9A-V]{8})*([0-9A-V]{2}={6}|[0-9A-V]{4}={4}|[0-9A-V]{5}={3}|[0-9A-V]{7}=)?$`
+	patternBase16    = `^([0-9a-fA-F]{2})*$`
+	// PatternDecimal is a quoted number: the string option, or a format whose
+	// number the string option quotes.
+	patternDecimal = `^-?[0-9]+(\.[0-9]+)?$`
+	// PatternGoDuration is time.Duration's String form.
+	patternGoDuration = `^-?([0-9]+h)?([0-9]+m)?[0-9]+(\.[0-9]+)?(ns|µs|ms|s)$`
+	// PatternISO8601Duration is the ISO 8601 subset encoding/json/v2 emits:
+	// hours, minutes, and seconds, with a fraction on the seconds only.
+	patternISO8601Duration = `^-?PT([0-9]+H)?([0-9]+M)?([0-9]+(\.[0-9]+)?S)?$`
+)
+
+// jsonv2Values reports whether values follow encoding/json/v2's encoding
+// rather than encoding/json's.
+func (g *generator) jsonv2Values() bool {
+	return g.naming != nil && g.naming.Values == fieldname.ValuesJSONv2
+}
+
+// nullContainers reports whether a nil slice or map encodes as null, as it
+// does under encoding/json; yaml.v3 and encoding/json/v2 encode an empty one.
+func (g *generator) nullContainers() bool {
+	return g.nullable && (g.naming == nil || g.naming.Values == fieldname.ValuesJSON)
+}
+
+// jsonv2BytesNode returns the node of a byte slice or array under
+// encoding/json/v2, which encodes both as one base64 string and a nil slice
+// as the empty string. An array's encoding has a fixed length.
+func (g *generator) jsonv2BytesNode(t reflect.Type, nullable bool) *node {
+	s := &Schema{ContentEncoding: content.Base64}
+	if t.Kind() == reflect.Array {
+		n := base64.StdEncoding.EncodedLen(t.Len())
+		s.MinLength, s.MaxLength = new(n), new(n)
+	}
+
+	return &node{kind: kindValue, payload: s, base: typename.String, nullable: nullable}
+}
+
+// formatNode returns the schema of a field of type t carrying
+// encoding/json/v2's format option, which the tag parser has already checked
+// applies to t: a time, a duration, bytes, a float, or a slice or map. The
+// format applies through pointers, which keep their null. Quoted is the
+// string option, which quotes a numeric format.
+func (g *generator) formatNode(t reflect.Type, format string, quoted bool) (*node, error) {
+	nullable := t.Kind() == reflect.Pointer && g.nullable
+	t = numkind.DerefType(t)
+
+	switch {
+	case t == typeTime:
+		return g.scalarNode(timeFormatSchema(format, quoted), nullable), nil
+
+	case t == typeDuration:
+		return g.scalarNode(durationFormatSchema(format, quoted), nullable), nil
+
+	case fieldname.IsJSONv2Bytes(t):
+		return g.bytesFormatNode(t, format, nullable), nil
+
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		if quoted {
+			return g.scalarNode(&Schema{Type: typename.String}, nullable), nil
+		}
+
+		return g.scalarNode(&Schema{AnyOf: []*Schema{
+			{Type: typename.Number},
+			{Enum: []any{"NaN", "Infinity", "-Infinity"}},
+		}}, nullable), nil
+
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Map:
+		n, err := g.schemaForType(t, nullable)
+		if err != nil {
+			return nil, err
+		}
+
+		// Emitnull restores encoding/json's null for a nil container.
+		if format == "emitnull" && g.nullable {
+			if n.kind == kindRef {
+				n.ptrNullable = true
+			} else {
+				n.nullable = true
+			}
+		}
+
+		return n, nil
+
+	default:
+		return nil, fmt.Errorf("%w: format %q on %s", ErrUnsupportedType, format, t)
+	}
+}
+
+// bytesFormatNode returns the node of a byte slice or array of type t under
+// a format option: a string in the chosen encoding, with a fixed length for
+// an array, or an array of bytes.
+func (g *generator) bytesFormatNode(t reflect.Type, format string, nullable bool) *node {
+	var (
+		s   *Schema
+		enc func(n int) int
+	)
+
+	switch format {
+	case "array":
+		items := g.scalarNode(boundedInteger(0, 255), false)
+		s = &Schema{Items: items.payload}
+
+		if t.Kind() == reflect.Array {
+			s.MinItems, s.MaxItems = new(t.Len()), new(t.Len())
+		}
+
+		return &node{kind: kindList, payload: s, items: items, base: typename.Array, nullable: nullable}
+	case "base64url":
+		s, enc = &Schema{Pattern: patternBase64URL}, base64.URLEncoding.EncodedLen
+	case "base32":
+		s, enc = &Schema{Pattern: patternBase32}, base32.StdEncoding.EncodedLen
+	case "base32hex":
+		s, enc = &Schema{Pattern: patternBase32Hex}, base32.HexEncoding.EncodedLen
+	case "base16", "hex":
+		s, enc = &Schema{Pattern: patternBase16}, func(n int) int { return 2 * n }
+	default:
+		return g.jsonv2BytesNode(t, nullable)
+	}
+
+	if t.Kind() == reflect.Array {
+		n := enc(t.Len())
+		s.MinLength, s.MaxLength = new(n), new(n)
+	}
+
+	return &node{kind: kindValue, payload: s, base: typename.String, nullable: nullable}
+}
+
+// timeFormatSchema returns the schema of a time.Time under a format option:
+// RFC 3339 is a date-time, DateOnly a date, a unix form a number of seconds
+// (or of a smaller unit, an integer of nanoseconds), and any other layout a
+// string.
+func timeFormatSchema(format string, quoted bool) *Schema {
+	switch format {
+	case "unix", "unixmilli", "unixmicro", "unixnano":
+		switch {
+		case quoted:
+			return &Schema{Type: typename.String, Pattern: patternDecimal}
+		case format == "unixnano":
+			return &Schema{Type: typename.Integer}
+		default:
+			return &Schema{Type: typename.Number}
+		}
+	case "RFC3339", "RFC3339Nano":
+		return &Schema{Type: typename.String, Format: formatDateTime}
+	case "DateOnly":
+		return &Schema{Type: typename.String, Format: "date"}
+	default:
+		return &Schema{Type: typename.String}
+	}
+}
+
+// durationFormatSchema returns the schema of a time.Duration under a format
+// option: its String form for units, an ISO 8601 duration, or a number of
+// seconds, milliseconds, or microseconds, or an integer of nanoseconds.
+func durationFormatSchema(format string, quoted bool) *Schema {
+	switch format {
+	case "units":
+		return &Schema{Type: typename.String, Pattern: patternGoDuration}
+	case "iso8601":
+		return &Schema{Type: typename.String, Pattern: patternISO8601Duration}
+	}
+
+	switch {
+	case quoted:
+		return &Schema{Type: typename.String, Pattern: patternDecimal}
+	case format == "nano":
+		return &Schema{Type: typename.Integer}
+	default:
+		return &Schema{Type: typename.Number}
+	}
+}
@@ -0,0 +1,65 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package jsonschema_test
+
+import (
+	jsonv2 "encoding/json/v2"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+type v2StdConfig struct {
+	v2Meta
+
+	Tags   []string          `json:"tags"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Maybe  *[]int            `json:"maybe"`
+	Data   []byte            `json:"data"`
+	Hash   [4]byte           `json:"hash"`
+	Since  time.Time         `json:"since"`
+	Count  int64             `json:"count,string"`
+	Extra  map[string]int    `json:",embed"`
+}
+
+// TestNamingJSONv2_Std checks the closed loop against encoding/json/v2
+// itself: what its Marshal emits validates against the schema generated
+// under NamingJSONv2.
+func TestNamingJSONv2_Std(t *testing.T) {
+	t.Parallel()
+
+	naming := jsonschema.WithFieldNaming(jsonschema.NamingJSONv2)
+
+	s, err := jsonschema.GenerateFor[v2StdConfig](t.Context(), naming)
+	require.NoError(t, err)
+
+	v, err := jsonschema.Compile(t.Context(), s, naming, jsonschema.WithFormats(true), jsonschema.WithContent(true),
+		jsonschema.WithMarshaler(func(v any) ([]byte, error) { return jsonv2.Marshal(v) }))
+	require.NoError(t, err)
+
+	tcs := map[string]v2StdConfig{
+		"zero": {},
+		"populated": {
+			v2Meta: v2Meta{Owner: "ops", Note: "n"},
+			Tags:   []string{"a"},
+			Labels: map[string]string{"k": "v"},
+			Maybe:  &[]int{1},
+			Data:   []byte("data"),
+			Hash:   [4]byte{1, 2, 3, 4},
+			Since:  time.Date(2024, 2, 29, 10, 0, 0, 5, time.UTC),
+			Count:  -5,
+			Extra:  map[string]int{"x": 1},
+		},
+	}
+
+	for name, value := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.NoError(t, v.ValidateValue(t.Context(), value))
+		})
+	}
+}
@@ -0,0 +1,462 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+type v2Meta struct {
+	Owner string `json:"owner"`
+	Note  string `json:"note,omitempty"`
+}
+
+type v2Config struct {
+	v2Meta
+
+	Tags     []string          `json:"tags"`
+	Labels   map[string]string `json:"labels,omitzero"`
+	Maybe    *[]int            `json:"maybe"`
+	Nulls    []int             `json:"nulls,format:emitnull"`
+	Data     []byte            `json:"data"`
+	Hash     [4]byte           `json:"hash"`
+	Hex      []byte            `json:"hex,format:base16"`
+	Raw      []byte            `json:"raw,format:array"`
+	Timeout  time.Duration     `json:"timeout,format:units"`
+	Interval time.Duration     `json:"interval,format:sec"`
+	Period   time.Duration     `json:"period,format:iso8601"`
+	Since    time.Time         `json:"since,format:unix"`
+	Day      time.Time         `json:"day,format:DateOnly"`
+	Ratio    float64           `json:"ratio,format:nonfinite"`
+	Count    int64             `json:"count,string"`
+	Odd      string            `json:"'odd,name',omitempty"`
+	Extra    map[string]int    `json:",inline"`
+}
+
+func TestNamingJSONv2(t *testing.T) {
+	t.Parallel()
+
+	s, err := jsonschema.GenerateFor[v2Config](t.Context(), jsonschema.WithFieldNaming(jsonschema.NamingJSONv2))
+	require.NoError(t, err)
+
+	got, err := json.Marshal(s)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"owner": {"type": "string"},
+			"note": {"type": "string"},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"labels": {"type": "object", "additionalProperties": {"type": "string"}},
+			"maybe": {"type": ["null", "array"], "items": {"type": "integer"}},
+			"nulls": {"type": ["null", "array"], "items": {"type": "integer"}},
+			"data": {"type": "string", "contentEncoding": "base64"},
+			"hash": {"type": "string", "contentEncoding": "base64", "minLength": 8, "maxLength": 8},
+			"hex": {"type": "string", "pattern": "^([0-9a-fA-F]{2})*$"},
+			"raw": {"type": "array", "items": {"type": "integer", "minimum": 0, "maximum": 255}},
+			"timeout": {"type": "string", "pattern": "^-?([0-9]+h)?([0-9]+m)?[0-9]+(\\.[0-9]+)?(ns|µs|ms|s)$"},
+			"interval": {"type": "number"},
+			"period": {"type": "string", "pattern": "^-?PT([0-9]+H)?([0-9]+M)?([0-9]+(\\.[0-9]+)?S)?$"},
+			"since": {"type": "number"},
+			"day": {"type": "string", "format": "date"},
+			"ratio": {"anyOf": [{"type": "number"}, {"enum": ["NaN", "Infinity", "-Infinity"]}]},
+			"count": {"type": "string"},
+			"odd,name": {"type": "string"}
+		},
+		"required": [
+			"owner", "tags", "maybe", "nulls", "data", "hash", "hex", "raw", "timeout",
+			"interval", "period", "since", "day", "ratio", "count"
+		],
+		"additionalProperties": {"type": "integer"}
+	}`, string(got))
+}
+
+// TestNamingJSONv2_ValidateValue checks the closed loop: ValidateValue encodes
+// each value as encoding/json/v2 does, and the generated schema accepts it.
+func TestNamingJSONv2_ValidateValue(t *testing.T) {
+	t.Parallel()
+
+	naming := jsonschema.WithFieldNaming(jsonschema.NamingJSONv2)
+
+	s, err := jsonschema.GenerateFor[v2Config](t.Context(), naming)
+	require.NoError(t, err)
+
+	v, err := jsonschema.Compile(t.Context(), s, naming, jsonschema.WithFormats(true), jsonschema.WithContent(true))
+	require.NoError(t, err)
+
+	tcs := map[string]struct {
+		value  v2Config
+		encErr string
+	}{
+		"zero": {},
+		"populated": {
+			value: v2Config{
+				v2Meta:   v2Meta{Owner: "ops", Note: "n"},
+				Tags:     []string{"a"},
+				Labels:   map[string]string{"k": "v"},
+				Maybe:    &[]int{1},
+				Nulls:    []int{2},
+				Data:     []byte("data"),
+				Hash:     [4]byte{1, 2, 3, 4},
+				Hex:      []byte{0x0a, 0xff},
+				Raw:      []byte{7},
+				Timeout:  90 * time.Second,
+				Interval: 1500 * time.Millisecond,
+				Period:   -time.Hour - 30*time.Second,
+				Since:    time.Unix(-1, 5e8),
+				Day:      time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC),
+				Ratio:    1.25,
+				Count:    math.MaxInt64,
+				Odd:      "o",
+				Extra:    map[string]int{"x": 1},
+			},
+		},
+		"nonfinite": {
+			value: v2Config{Ratio: math.Inf(-1)},
+		},
+		"inline key conflict": {
+			value:  v2Config{Extra: map[string]int{"owner": 1}},
+			encErr: `inlined map key "owner" conflicts with a struct field`,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := v.ValidateValue(t.Context(), tc.value)
+			if tc.encErr != "" {
+				require.ErrorContains(t, err, tc.encErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+type v2Left struct {
+	Name string
+	Left int
+}
+
+type v2Right struct {
+	Name string
+	Kind string `json:"kind"`
+}
+
+type v2Tagged struct {
+	Kind string `json:"kind"`
+}
+
+type v2Deep struct {
+	v2Tagged
+}
+
+type v2Dominance struct {
+	v2Left
+	v2Right
+	v2Deep
+
+	ID string
+}
+
+func TestNamingJSONv2_Dominance(t *testing.T) {
+	t.Parallel()
+
+	naming := jsonschema.WithFieldNaming(jsonschema.NamingJSONv2)
+
+	s, err := jsonschema.GenerateFor[v2Dominance](t.Context(), naming)
+	require.NoError(t, err)
+
+	got, err := json.Marshal(s)
+	require.NoError(t, err)
+
+	// Name ties at one depth and is dropped; the shallower kind wins over
+	// the deeper one.
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"Left": {"type": "integer"},
+			"kind": {"type": "string"},
+			"ID": {"type": "string"}
+		},
+		"required": ["Left", "kind", "ID"],
+		"additionalProperties": false
+	}`, string(got))
+
+	v, err := jsonschema.Compile(t.Context(), s, naming)
+	require.NoError(t, err)
+	require.NoError(t, v.ValidateValue(t.Context(), v2Dominance{v2Right: v2Right{Kind: "k"}}))
+}
+
+type v2Duration struct {
+	Timeout time.Duration `json:"timeout"`
+}
+
+type v2BadFormat struct {
+	Name string `json:"name,format:base16"`
+}
+
+type v2BadString struct {
+	On bool `json:"on,string"`
+}
+
+type v2Mutant struct {
+	Name string `json:"name,omitEmpty"`
+}
+
+type v2Repeated struct {
+	Name string `json:"name,omitzero,omitzero"`
+}
+
+type v2FormatNotLast struct {
+	Data []byte `json:"data,format:hex,omitzero"`
+}
+
+type v2Label string
+
+type v2EmbedScalar struct {
+	v2Label
+}
+
+type v2EmbedOptions struct {
+	v2Meta `json:",omitempty"`
+}
+
+type v2Text struct {
+	Value string
+}
+
+func (t *v2Text) UnmarshalText(b []byte) error {
+	t.Value = string(b)
+
+	return nil
+}
+
+type v2EmbedMarshaler struct {
+	Text v2Text `json:",embed"`
+}
+
+type v2SameKey struct {
+	A string `json:"key"`
+	B string `json:"key"` //nolint:govet // The duplicate json name is the conflict under test.
+}
+
+func TestNamingJSONv2_Errors(t *testing.T) {
+	t.Parallel()
+
+	naming := jsonschema.WithFieldNaming(jsonschema.NamingJSONv2)
+
+	tcs := map[string]struct {
+		gen    func(t *testing.T) error
+		value  any
+		target error
+		msg    string
+	}{
+		"duration without format": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[v2Duration](t.Context(), naming)
+
+				return err
+			},
+			value:  v2Duration{},
+			target: jsonschema.ErrUnsupportedType,
+			msg:    "no default",
+		},
+		"format on string": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[v2BadFormat](t.Context(), naming)
+
+				return err
+			},
+			value:  v2BadFormat{},
+			target: jsonschema.ErrInvalidFieldNaming,
+			msg:    `format "base16" does not apply to string`,
+		},
+		"string on bool": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[v2BadString](t.Context(), naming)
+
+				return err
+			},
+			value:  v2BadString{},
+			target: jsonschema.ErrInvalidFieldNaming,
+			msg:    "the string option does not apply to bool",
+		},
+		"mutant option": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[v2Mutant](t.Context(), naming)
+
+				return err
+			},
+			value:  v2Mutant{},
+			target: jsonschema.ErrInvalidFieldNaming,
+			msg:    `did you mean "omitempty"?`,
+		},
+		"repeated option": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[v2Repeated](t.Context(), naming)
+
+				return err
+			},
+			value:  v2Repeated{},
+			target: jsonschema.ErrInvalidFieldNaming,
+			msg:    `duplicate option "omitzero"`,
+		},
+		"format not last": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[v2FormatNotLast](t.Context(), naming)
+
+				return err
+			},
+			value:  v2FormatNotLast{},
+			target: jsonschema.ErrInvalidFieldNaming,
+			msg:    "format must be the last option",
+		},
+		"embedded scalar": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[v2EmbedScalar](t.Context(), naming)
+
+				return err
+			},
+			value:  v2EmbedScalar{},
+			target: jsonschema.ErrInvalidFieldNaming,
+			msg:    "must be given a JSON name",
+		},
+		"embed with options": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[v2EmbedOptions](t.Context(), naming)
+
+				return err
+			},
+			value:  v2EmbedOptions{},
+			target: jsonschema.ErrInvalidFieldNaming,
+			msg:    "cannot have options other than embed",
+		},
+		"embed marshaler": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[v2EmbedMarshaler](t.Context(), naming)
+
+				return err
+			},
+			value:  v2EmbedMarshaler{},
+			target: jsonschema.ErrInvalidFieldNaming,
+			msg:    "which has marshal or unmarshal methods",
+		},
+		"same key in one struct": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[v2SameKey](t.Context(), naming)
+
+				return err
+			},
+			value:  v2SameKey{},
+			target: jsonschema.ErrInvalidFieldNaming,
+			msg:    `duplicated json key "key"`,
+		},
+	}
+
+	v, err := jsonschema.Compile(t.Context(), &jsonschema.Schema{}, naming)
+	require.NoError(t, err)
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.gen(t)
+			require.ErrorIs(t, err, tc.target)
+			assert.ErrorContains(t, err, tc.msg)
+
+			err = v.ValidateValue(t.Context(), tc.value)
+			require.Error(t, err)
+			assert.ErrorContains(t, err, tc.msg)
+		})
+	}
+}
+
+var errMarshal = errors.New("marshal failed")
+
+func TestWithMarshaler(t *testing.T) {
+	t.Parallel()
+
+	naming := jsonschema.WithFieldNaming(jsonschema.NamingJSONv2)
+
+	s, err := jsonschema.GenerateFor[v2Meta](t.Context(), naming)
+	require.NoError(t, err)
+
+	tcs := map[string]struct {
+		marshal func(v any) ([]byte, error)
+		err     error
+		paths   []string
+	}{
+		"encoder output": {
+			marshal: func(any) ([]byte, error) { return []byte(`{"owner": 1}`), nil },
+			paths:   []string{"v2Meta.Owner"},
+		},
+		"encoder error": {
+			marshal: func(any) ([]byte, error) { return nil, errMarshal },
+			err:     errMarshal,
+		},
+		"nil restores the walk": {},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := jsonschema.Compile(t.Context(), s, naming,
+				jsonschema.WithMarshaler(tc.marshal), jsonschema.WithGoFieldPaths(true))
+			require.NoError(t, err)
+
+			err = v.ValidateValue(t.Context(), v2Meta{Owner: "ops"})
+
+			switch {
+			case tc.err != nil:
+				require.ErrorIs(t, err, tc.err)
+			case tc.paths != nil:
+				ve, ok := errors.AsType[*jsonschema.ValidationError](err)
+				require.True(t, ok, "want a validation error, got %v", err)
+
+				var paths []string
+
+				for _, leaf := range ve.Leaves() {
+					paths = append(paths, leaf.GoFieldPath())
+				}
+
+				assert.Equal(t, tc.paths, paths)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
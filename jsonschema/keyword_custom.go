@@ -0,0 +1,371 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/keywordmeta"
+)
+
+// customKeyword is one [WithKeyword] registration: the keyword name, the
+// vocabulary URI that owns it, and the implementation.
+type customKeyword struct {
+	impl  CustomKeyword
+	name  string
+	vocab string
+}
+
+// WithKeyword registers a custom keyword implementation under the keyword
+// name it reads and the URI of the vocabulary that owns it. The keyword's
+// value is read from each schema node's Extra map, the home of every keyword
+// the [Schema] type does not model, so a name the type models as a typed
+// field (such as "minimum") can never apply: [Compile] rejects it with an
+// error wrapping [ErrInvalidKeyword]. Registering a name again replaces the
+// previous registration. A nil k, an empty name, or an empty vocabulary URI
+// is ignored.
+//
+// The vocabulary gates the keyword the way the standard vocabularies gate the
+// built-in keyword groups (see Vocabulary Support in the package docs): under
+// Draft 2020-12 it is active when [WithVocabularies] lists its URI, or, absent
+// that override, when the metaschema found through [WithMetaSchemaResolver]
+// lists it in $vocabulary (true or false alike), and it is active by default
+// when neither supplies a vocabulary set. Registration also makes the URI a
+// recognized vocabulary, so a metaschema that marks it required no longer
+// fails with [ErrUnknownVocabulary]. Under Draft 7, which has no vocabulary
+// concept, every registered keyword is active.
+//
+// Custom keywords evaluate after every built-in assertion and applicator at
+// the same schema node, in keyword-name order, and before unevaluatedProperties
+// and unevaluatedItems, so the [KeywordContext] they receive sees the
+// evaluation annotations of all their siblings. Under Draft 7 a $ref
+// suppresses them along with its other siblings.
+func WithKeyword(vocabulary, name string, k CustomKeyword) ValidateOption {
+	return validateOptionFunc(func(v *validator) {
+		if k == nil || name == "" || vocabulary == "" {
+			return
+		}
+
+		if v.customKeywords == nil {
+			v.customKeywords = map[string]*customKeyword{}
+		}
+
+		v.customKeywords[name] = &customKeyword{impl: k, name: name, vocab: vocabulary}
+	})
+}
+
+// KeywordContext is the run-time view a [KeywordEvaluator] receives for one
+// instance node: the instance, where the walk is, and what the keyword's
+// siblings at the same schema node evaluated. It is valid only for the
+// duration of the EvaluateKeyword call.
+type KeywordContext struct {
+	ec   evalContext
+	name string
+}
+
+// Name returns the keyword name being evaluated.
+func (kc *KeywordContext) Name() string {
+	return kc.name
+}
+
+// Schema returns the schema node carrying the keyword. Treat it as read-only.
+func (kc *KeywordContext) Schema() *Schema {
+	return kc.ec.schema
+}
+
+// Instance returns the instance value at this node, in the normalized form
+// [Validator.Validate] accepts: map[string]any, []any, string, bool, nil, or
+// a number ([encoding/json.Number] for decoded JSON).
+func (kc *KeywordContext) Instance() any {
+	return kc.ec.instance
+}
+
+// InstancePath returns the JSON Pointer to the instance value, as reported by
+// [ValidationError.InstancePath].
+func (kc *KeywordContext) InstancePath() string {
+	return kc.ec.instancePath.ptr
+}
+
+// InstanceSegments returns the typed form of [KeywordContext.InstancePath],
+// as reported by [ValidationError.InstanceSegments]. Treat it as read-only.
+func (kc *KeywordContext) InstanceSegments() []Segment {
+	return kc.ec.instancePath.segs
+}
+
+// SchemaPath returns the JSON Pointer to the keyword within the schema, as
+// reported by [ValidationError.SchemaPath]: the evaluation path, which runs
+// through every $ref and applicator the walk followed to reach this node.
+func (kc *KeywordContext) SchemaPath() string {
+	return kc.location().ptr
+}
+
+// SchemaSegments returns the typed form of [KeywordContext.SchemaPath], as
+// reported by [ValidationError.SchemaSegments].
+func (kc *KeywordContext) SchemaSegments() []Segment {
+	return kc.location().segs
+}
+
+// PropertyEvaluated reports whether a sibling keyword at this schema node
+// (properties, patternProperties, additionalProperties, or an in-place
+// applicator such as allOf or $ref, through its successful subschemas)
+// evaluated the instance property name: the annotation unevaluatedProperties
+// consults.
+func (kc *KeywordContext) PropertyEvaluated(name string) bool {
+	return kc.ec.ann.AllPropertiesSet() || kc.ec.ann.Evaluated(name)
+}
+
+// ItemEvaluated reports whether a sibling keyword at this schema node
+// (prefixItems, items, contains, or an in-place applicator, through its
+// successful subschemas) evaluated the instance array item at index i: the
+// annotation unevaluatedItems consults.
+func (kc *KeywordContext) ItemEvaluated(i int) bool {
+	return kc.ec.ann.AllItemsSet() || kc.ec.ann.ItemEvaluated(i)
+}
+
+// Annotation returns the value a custom keyword recorded with
+// [KeywordContext.Annotate] at this schema node, including one collected
+// from a successful subschema of an in-place applicator, and whether one
+// was recorded. Custom keywords evaluate in name order, so a keyword sees
+// the values of the siblings whose names sort before its own.
+func (kc *KeywordContext) Annotation(keyword string) (any, bool) {
+	return kc.ec.ann.Value(keyword)
+}
+
+// Annotate records value as this keyword's annotation at this schema node,
+// for [KeywordContext.Annotation] on later siblings and on the custom
+// keywords of enclosing schemas. Like every annotation, it is dropped when
+// the subschema that recorded it fails.
+func (kc *KeywordContext) Annotate(value any) {
+	kc.ec.ann.SetValue(kc.name, value)
+}
+
+// Error returns a validation error for this keyword at this instance node,
+// with the given message and causes, carrying the same path fields the
+// built-in keywords report.
+func (kc *KeywordContext) Error(msg string, causes ...*ValidationError) *ValidationError {
+	return newError(kc.ec.instancePath, kc.location(), kc.name, msg, causes)
+}
+
+// location returns the schema location of the keyword token. Unlike the
+// built-in keyword names, a custom name may carry JSON Pointer specials, so
+// it is appended as an escaped member key.
+func (kc *KeywordContext) location() schemaLocation {
+	return kc.ec.schemaPath.key(kc.name)
+}
+
+// customRow builds the dispatch row for one registered keyword. It runs in
+// phaseCustom, between the built-in assertions and the unevaluated keywords;
+// the vocabulary gate was already decided by the caller, so the row carries
+// vocabCore and an unbounded draft range.
+func (ck *customKeyword) customRow() keywordEntry {
+	return keywordEntry{
+		name:     ck.name,
+		keywords: []string{ck.name},
+		drafts:   keywordmeta.DraftsAll,
+		vocab:    vocabCore,
+		phase:    phaseCustom,
+		compile:  ck.compileNode,
+		eval:     ck.eval,
+	}
+}
+
+// compileNode is the row's Compile-time step: it compiles the keyword for a
+// node that carries it and caches the evaluator under the node's id, or
+// records the first compile failure for [Compile] to report.
+func (ck *customKeyword) compileNode(v *validator, id int, s *Schema) {
+	if _, ok := s.Extra[ck.name]; !ok {
+		return
+	}
+
+	ev, err := ck.compileAt(v, s)
+	if err != nil {
+		if v.compileErr == nil {
+			v.compileErr = fmt.Errorf("%w: %q at %s: %w", ErrInvalidKeyword, ck.name, v.locate(s), err)
+		}
+
+		return
+	}
+
+	if v.customEvals[id] == nil {
+		v.customEvals[id] = map[string]KeywordEvaluator{}
+	}
+
+	v.customEvals[id][ck.name] = ev
+}
+
+// compileAt runs the implementation's compile step for one node.
+func (ck *customKeyword) compileAt(v *validator, s *Schema) (KeywordEvaluator, error) {
+	raw, err := json.Marshal(s.Extra[ck.name])
+	if err != nil {
+		return nil, fmt.Errorf("marshal value: %w", err)
+	}
+
+	//nolint:wrapcheck // The caller wraps with the keyword and location.
+	return ck.impl.CompileKeyword(v.runContext(), KeywordCompileContext{
+		Schema: s,
+		Name:   ck.name,
+		Value:  raw,
+		Draft:  v.draft,
+	})
+}
+
+// evaluator returns the compiled evaluator for the node, preferring the
+// per-node cache and compiling on the fly for a schema outside the index (a
+// remote or JSON-pointer fallback schema reached only at validation time).
+func (ck *customKeyword) evaluator(v *validator, id int, s *Schema) (KeywordEvaluator, error) {
+	if v.inIndex(id) {
+		if ev, ok := v.customEvals[id][ck.name]; ok {
+			return ev, nil
+		}
+	}
+
+	return ck.compileAt(v, s)
+}
+
+// eval is the row's eval step: it runs the node's evaluator and converts what
+// it returns into the validation error contract.
+func (ck *customKeyword) eval(ctx evalContext) []*ValidationError {
+	if _, ok := ctx.schema.Extra[ck.name]; !ok {
+		return nil
+	}
+
+	kc := &KeywordContext{ec: ctx, name: ck.name}
+
+	ev, err := ck.evaluator(ctx.v, ctx.nodeID, ctx.schema)
+	if err != nil {
+		e := kc.Error(fmt.Sprintf("cannot compile keyword: %v", err))
+		e.err = fmt.Errorf("%w: %w", ErrInvalidKeyword, err)
+
+		return []*ValidationError{e}
+	}
+
+	if ev == nil {
+		return nil
+	}
+
+	err = ev.EvaluateKeyword(ctx.v.runContext(), kc)
+	if err == nil {
+		return nil
+	}
+
+	//nolint:errorlint // Only an unwrapped *ValidationError is surfaced as built.
+	if ve, ok := err.(*ValidationError); ok {
+		return []*ValidationError{ve}
+	}
+
+	e := kc.Error(err.Error())
+	// Attach the evaluator's error so a sentinel it returns stays reachable
+	// via errors.Is/As on the validation result, as evalFormat does.
+	e.err = err
+
+	return []*ValidationError{e}
+}
+
+// checkCustomKeywords rejects a registration whose name the Schema type
+// models as a typed field: upstream decodes that keyword into the field, so
+// it never reaches Extra and the registration could never apply.
+func (v *validator) checkCustomKeywords() error {
+	for _, name := range slices.Sorted(maps.Keys(v.customKeywords)) {
+		if !landsInExtra(name) {
+			return fmt.Errorf("%w: %q is a built-in keyword", ErrInvalidKeyword, name)
+		}
+	}
+
+	return nil
+}
+
+// landsInExtra reports whether a schema document member named name decodes
+// into the Extra map rather than a typed Schema field. It asks the upstream
+// decoder directly, so the answer tracks whatever fields the Schema type
+// models.
+func landsInExtra(name string) bool {
+	doc, err := json.Marshal(map[string]any{name: map[string]any{}})
+	if err != nil {
+		return false
+	}
+
+	var s Schema
+
+	err = json.Unmarshal(doc, &s)
+	if err != nil {
+		return false
+	}
+
+	_, ok := s.Extra[name]
+
+	return ok
+}
+
+// customVocabActive reports whether the vocabulary URI owning a registered
+// keyword is active for this run. A nil customVocabs means no vocabulary set
+// was supplied (Draft 7, or Draft 2020-12 with neither an override nor a
+// metaschema $vocabulary), so every registered vocabulary is active.
+func (v *validator) customVocabActive(uri string) bool {
+	if v.customVocabs == nil {
+		return true
+	}
+
+	return v.customVocabs[uri]
+}
+
+// registeredVocab reports whether uri is the vocabulary of a registered
+// custom keyword, which makes it a vocabulary this run recognizes.
+func (v *validator) registeredVocab(uri string) bool {
+	for _, ck := range v.customKeywords {
+		if ck.vocab == uri {
+			return true
+		}
+	}
+
+	return false
+}
+
+// buildCustomRows returns the dispatch rows of the registered keywords whose
+// vocabulary is active, in keyword-name order.
+func (v *validator) buildCustomRows() []keywordEntry {
+	var rows []keywordEntry
+
+	for _, name := range slices.Sorted(maps.Keys(v.customKeywords)) {
+		ck := v.customKeywords[name]
+		if v.customVocabActive(ck.vocab) {
+			rows = append(rows, ck.customRow())
+		}
+	}
+
+	return rows
+}
+
+// hasCustomKeyword reports whether schema carries a keyword one of the run's
+// active custom rows evaluates, so the walk collects the annotations a
+// [KeywordContext] reads even when no unevaluated keyword asks for them.
+func (v *validator) hasCustomKeyword(schema *Schema) bool {
+	if len(v.customRows) == 0 || len(schema.Extra) == 0 {
+		return false
+	}
+
+	for i := range v.customRows {
+		if _, ok := schema.Extra[v.customRows[i].name]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// locate names s for a compile error message: its JSON Pointer fragment
+// within the root document, or for a node outside the root's typed tree (a
+// fetched remote) its $id when it has one.
+func (v *validator) locate(s *Schema) string {
+	for loc, node := range Schemas(v.root) {
+		if node == s {
+			return "#" + loc.Pointer
+		}
+	}
+
+	if s.ID != "" {
+		return s.ID
+	}
+
+	return "a fetched document"
+}
@@ -0,0 +1,312 @@
+package jsonschema
+
+import (
+	"fmt"
+)
+
+// Limit names a validation limit a [LimitError] reports.
+type Limit string
+
+// The limits [WithMaxErrors], [WithMaxDepth], [WithMaxNodes], and
+// [WithMaxSteps] configure.
+const (
+	LimitErrors Limit = "max errors"
+	LimitDepth  Limit = "max depth"
+	LimitNodes  Limit = "max nodes"
+	LimitSteps  Limit = "max steps"
+)
+
+// WithFailFast stops validation at the first failure, for callers that need
+// only a yes/no answer. The returned [*ValidationError] holds that one
+// failure (with the wrappers leading to it) rather than every failure.
+//
+// A failure inside an anyOf or oneOf branch, a not, an if, or a contains
+// item test is provisional, since the enclosing keyword may still pass, so
+// it does not stop the run; instead each such evaluation ends as soon as its
+// outcome is decided: a schema node at its first failing keyword, allOf at
+// its first failing branch, anyOf at its first matching branch (when no
+// unevaluatedProperties or unevaluatedItems needs the other branches'
+// annotations), and oneOf at its second matching branch, whose message then
+// reports two matches rather than every match.
+func WithFailFast(enabled bool) ValidateOption {
+	return validateOptionFunc(func(v *validator) { v.limits.failFast = enabled })
+}
+
+// WithMaxErrors stops validation once n failures have been found, counted as
+// [ValidationError.Leaves] counts them, and returns a [*LimitError] for
+// [LimitErrors] carrying those failures. Provisional failures (see
+// [WithFailFast]) are not counted. A non-positive n removes the limit.
+func WithMaxErrors(n int) ValidateOption {
+	return validateOptionFunc(func(v *validator) { v.limits.maxErrors = max(n, 0) })
+}
+
+// WithMaxDepth rejects an instance nested more than n deep with a
+// [*LimitError] for [LimitDepth], before any keyword is evaluated. A value's
+// depth is the number of objects and arrays enclosing it, so the root is at
+// depth 0 and the members of a root object at depth 1. A non-positive n
+// removes the limit.
+func WithMaxDepth(n int) ValidateOption {
+	return validateOptionFunc(func(v *validator) { v.limits.maxDepth = max(n, 0) })
+}
+
+// WithMaxNodes rejects an instance of more than n values (the root, every
+// object member value, and every array element) with a [*LimitError] for
+// [LimitNodes], before any keyword is evaluated. A non-positive n removes the
+// limit.
+func WithMaxNodes(n int) ValidateOption {
+	return validateOptionFunc(func(v *validator) { v.limits.maxNodes = max(n, 0) })
+}
+
+// WithMaxSteps bounds the work of one validation run: each evaluation of a
+// schema node against an instance location is one step, and the run stops
+// at step n+1 with a [*LimitError] for [LimitSteps] carrying the failures
+// found so far. Applicators multiply steps (every anyOf branch, every $ref
+// target, every array item under items), so the budget bounds cost where
+// instance size alone does not. A non-positive n removes the limit.
+func WithMaxSteps(n int) ValidateOption {
+	return validateOptionFunc(func(v *validator) { v.limits.maxSteps = max(n, 0) })
+}
+
+// LimitError is returned by validation that stopped at a limit configured by
+// [WithMaxErrors], [WithMaxDepth], [WithMaxNodes], or [WithMaxSteps]. It
+// matches [ErrLimitExceeded] with [errors.Is], and [errors.AsType] finds
+// Partial through it, so a caller that only tests for a [*ValidationError]
+// still sees the failures found.
+//
+// The verdict of a run stopped by [LimitErrors] is known (the instance is
+// invalid) while its report is incomplete; the other limits stop before the
+// verdict is known, and the instance must be treated as rejected.
+type LimitError struct {
+	// Partial holds the failures found before the run stopped, assembled as
+	// a complete run assembles them, or nil when none were found.
+	Partial *ValidationError
+
+	// Limit is the limit that stopped the run.
+	Limit Limit
+
+	// Max is the configured value of Limit.
+	Max int
+}
+
+// Error reports the limit. Partial is left out so the message stays bounded
+// for the untrusted instances limits exist for.
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%v: %s %d", ErrLimitExceeded, e.Limit, e.Max)
+}
+
+// Unwrap returns [ErrLimitExceeded] and, when present, Partial.
+func (e *LimitError) Unwrap() []error {
+	if e.Partial == nil {
+		return []error{ErrLimitExceeded}
+	}
+
+	return []error{ErrLimitExceeded, e.Partial}
+}
+
+// runLimits is the compiled limit configuration.
+type runLimits struct {
+	failFast  bool
+	maxErrors int
+	maxDepth  int
+	maxNodes  int
+	maxSteps  int
+}
+
+// active reports whether any limit is configured, so runs without limits
+// carry no budget.
+func (l runLimits) active() bool {
+	return l.failFast || l.maxErrors > 0 || l.maxDepth > 0 || l.maxNodes > 0 || l.maxSteps > 0
+}
+
+// countsFailures reports whether the run must count definite failures.
+func (l runLimits) countsFailures() bool {
+	return l.failFast || l.maxErrors > 0
+}
+
+// runBudget is one run's spending against its [runLimits]. The speculative
+// depth counts the enclosing evaluations whose failures are provisional;
+// failures counts the definite ones found so far. Once halted, every further
+// evaluation returns at once, and exceeded names the limit responsible ("" for
+// a fail-fast stop, which is not a limit).
+type runBudget struct {
+	exceeded    Limit
+	steps       int
+	failures    int
+	speculative int
+	halted      bool
+}
+
+// noSpeculation is the leave func of a run without a budget.
+func noSpeculation() {}
+
+// speculate marks the start of an evaluation whose failures are provisional
+// and returns the func that ends it.
+func (v *validator) speculate() func() {
+	b := v.budget
+	if b == nil {
+		return noSpeculation
+	}
+
+	b.speculative++
+
+	return func() { b.speculative-- }
+}
+
+// stopBranches reports whether a keyword whose outcome is already decided may
+// skip its remaining branches: under fail-fast, or once the run is halted.
+func (v *validator) stopBranches() bool {
+	return v.budget != nil && (v.limits.failFast || v.budget.halted)
+}
+
+// halted reports whether the run has stopped. An evaluation that returns
+// after the run stops was cut short, so its empty result is not a match and a
+// keyword that interprets one must report nothing.
+func (v *validator) halted() bool {
+	return v.budget != nil && v.budget.halted
+}
+
+// enterNode spends a step on one schema-node evaluation, reporting false when
+// the run is halted or the step budget is spent.
+func (v *validator) enterNode() bool {
+	b := v.budget
+	if b == nil {
+		return true
+	}
+
+	if b.halted {
+		return false
+	}
+
+	if v.limits.maxSteps > 0 {
+		b.steps++
+		if b.steps > v.limits.maxSteps {
+			b.halt(LimitSteps)
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// afterKeyword accounts for the failures one keyword returned at a schema
+// node, given the definite-failure count before it ran, and reports whether
+// the node must stop evaluating. A definite keyword's failures include every
+// definite failure found beneath it, so the count is reset rather than added
+// to.
+func (v *validator) afterKeyword(before int, errs []*ValidationError) bool {
+	b := v.budget
+	if b == nil {
+		return false
+	}
+
+	if b.halted {
+		return true
+	}
+
+	if len(errs) == 0 {
+		return false
+	}
+
+	if b.speculative > 0 {
+		return v.limits.failFast
+	}
+
+	if !v.limits.countsFailures() {
+		return false
+	}
+
+	n := 0
+	for _, e := range errs {
+		n += len(e.Leaves())
+	}
+
+	b.failures = before + n
+
+	switch {
+	case v.limits.maxErrors > 0 && b.failures >= v.limits.maxErrors:
+		b.halt(LimitErrors)
+	case v.limits.failFast:
+		b.halt("")
+	}
+
+	return b.halted
+}
+
+// failureCount returns the definite failures counted so far.
+func (v *validator) failureCount() int {
+	if v.budget == nil {
+		return 0
+	}
+
+	return v.budget.failures
+}
+
+func (b *runBudget) halt(limit Limit) {
+	b.halted = true
+	b.exceeded = limit
+}
+
+// limitMax returns the configured value of limit.
+func (l runLimits) limitMax(limit Limit) int {
+	switch limit {
+	case LimitErrors:
+		return l.maxErrors
+	case LimitDepth:
+		return l.maxDepth
+	case LimitNodes:
+		return l.maxNodes
+	default:
+		return l.maxSteps
+	}
+}
+
+// checkInstance enforces the depth and node limits on a normalized instance,
+// stopping its count as soon as either is exceeded.
+func (l runLimits) checkInstance(instance any) error {
+	if l.maxDepth <= 0 && l.maxNodes <= 0 {
+		return nil
+	}
+
+	nodes := 0
+
+	var walk func(x any, depth int) Limit
+
+	walk = func(x any, depth int) Limit {
+		nodes++
+		if l.maxNodes > 0 && nodes > l.maxNodes {
+			return LimitNodes
+		}
+
+		switch x := x.(type) {
+		case map[string]any:
+			if len(x) > 0 && l.maxDepth > 0 && depth >= l.maxDepth {
+				return LimitDepth
+			}
+
+			for _, m := range x {
+				if limit := walk(m, depth+1); limit != "" {
+					return limit
+				}
+			}
+		case []any:
+			if len(x) > 0 && l.maxDepth > 0 && depth >= l.maxDepth {
+				return LimitDepth
+			}
+
+			for _, m := range x {
+				if limit := walk(m, depth+1); limit != "" {
+					return limit
+				}
+			}
+		}
+
+		return ""
+	}
+
+	if limit := walk(instance, 0); limit != "" {
+		return &LimitError{Limit: limit, Max: l.limitMax(limit)}
+	}
+
+	return nil
+}
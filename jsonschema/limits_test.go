@@ -0,0 +1,244 @@
+package jsonschema_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+const limitsSchema = `{
+	"type": "object",
+	"properties": {
+		"a": {"type": "string", "minLength": 2},
+		"b": {"type": "string", "minLength": 2},
+		"c": {"type": "string", "minLength": 2},
+		"d": {"anyOf": [{"type": "string"}, {"type": "integer"}]},
+		"e": {"oneOf": [{"type": "integer"}, {"minimum": 0}]},
+		"f": {"not": {"const": 1}},
+		"g": {"if": {"type": "string"}, "then": {"maxLength": 3}, "else": {"type": "integer"}},
+		"h": {"contains": {"const": 1}},
+		"i": {"allOf": [{"type": "integer"}, {"minimum": 10}]}
+	}
+}`
+
+func TestWithFailFast_Verdict(t *testing.T) {
+	t.Parallel()
+
+	full := jsonschema.MustCompileJSON([]byte(limitsSchema))
+	fast := jsonschema.MustCompileJSON([]byte(limitsSchema), jsonschema.WithFailFast(true))
+
+	tests := map[string]string{
+		"valid":              `{"a": "aa", "d": 1, "e": -1, "f": 2, "g": "abc", "h": [0, 1], "i": 10}`,
+		"many failures":      `{"a": "a", "b": "b", "c": "c"}`,
+		"anyOf second match": `{"d": 1}`,
+		"anyOf no match":     `{"d": true}`,
+		"oneOf two matches":  `{"e": 1}`,
+		"oneOf one match":    `{"e": 0.5}`,
+		"not":                `{"f": 1}`,
+		"if then":            `{"g": "abcd"}`,
+		"if else":            `{"g": 1.5}`,
+		"contains miss":      `{"h": [0, 2]}`,
+		"contains hit":       `{"h": [0, 1]}`,
+		"allOf":              `{"i": 1.5}`,
+	}
+
+	for name, instance := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			want := full.ValidateJSON(t.Context(), []byte(instance))
+			got := fast.ValidateJSON(t.Context(), []byte(instance))
+
+			if want == nil {
+				require.NoError(t, got)
+
+				return
+			}
+
+			require.Error(t, got)
+			require.NotErrorIs(t, got, jsonschema.ErrLimitExceeded)
+
+			// The fail-fast report is one definite failure of the full report,
+			// whose leaves are among the full report's.
+			wantVE, ok := errors.AsType[*jsonschema.ValidationError](want)
+			require.True(t, ok)
+
+			gotVE, ok := errors.AsType[*jsonschema.ValidationError](got)
+			require.True(t, ok)
+
+			wantLeaves := map[string]bool{}
+			for _, leaf := range wantVE.Leaves() {
+				wantLeaves[leaf.SchemaPath] = true
+			}
+
+			gotLeaves := gotVE.Leaves()
+			require.NotEmpty(t, gotLeaves)
+			assert.Less(t, len(gotLeaves), len(wantVE.Leaves())+1)
+
+			for _, leaf := range gotLeaves {
+				assert.True(t, wantLeaves[leaf.SchemaPath], leaf.SchemaPath)
+			}
+		})
+	}
+}
+
+func TestWithFailFast_StopsAtFirstFailure(t *testing.T) {
+	t.Parallel()
+
+	v := jsonschema.MustCompileJSON([]byte(limitsSchema), jsonschema.WithFailFast(true))
+
+	err := v.ValidateJSON(t.Context(), []byte(`{"a": "a", "b": "b", "c": "c"}`))
+	ve, ok := errors.AsType[*jsonschema.ValidationError](err)
+	require.True(t, ok)
+	assert.Len(t, ve.Leaves(), 1)
+}
+
+func TestWithFailFast_OneOfStopsAtSecondMatch(t *testing.T) {
+	t.Parallel()
+
+	v := jsonschema.MustCompileJSON([]byte(`{"oneOf": [{}, {}, {}]}`), jsonschema.WithFailFast(true))
+
+	err := v.Validate(t.Context(), 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "validated against 2 subschemas")
+}
+
+func TestWithMaxErrors(t *testing.T) {
+	t.Parallel()
+
+	v := jsonschema.MustCompileJSON([]byte(limitsSchema), jsonschema.WithMaxErrors(2))
+
+	err := v.ValidateJSON(t.Context(), []byte(`{"a": "a", "b": "b", "c": "c"}`))
+	require.ErrorIs(t, err, jsonschema.ErrLimitExceeded)
+
+	le, ok := errors.AsType[*jsonschema.LimitError](err)
+	require.True(t, ok)
+	assert.Equal(t, jsonschema.LimitErrors, le.Limit)
+	assert.Equal(t, 2, le.Max)
+	require.NotNil(t, le.Partial)
+	assert.Len(t, le.Partial.Leaves(), 2)
+	assert.Equal(t, "validation limit exceeded: max errors 2", err.Error())
+
+	ve, ok := errors.AsType[*jsonschema.ValidationError](err)
+	require.True(t, ok)
+	assert.Same(t, le.Partial, ve)
+
+	// Fewer failures than the limit is a complete result.
+	err = v.ValidateJSON(t.Context(), []byte(`{"a": "a"}`))
+	require.Error(t, err)
+	require.NotErrorIs(t, err, jsonschema.ErrLimitExceeded)
+
+	// Provisional failures in anyOf branches are not counted.
+	err = v.ValidateJSON(t.Context(), []byte(`{"d": 1}`))
+	require.NoError(t, err)
+}
+
+func TestWithMaxDepthAndNodes(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opt      jsonschema.ValidateOption
+		instance string
+		limit    jsonschema.Limit
+	}{
+		"depth within":  {opt: jsonschema.WithMaxDepth(2), instance: `{"a": [1]}`},
+		"depth over":    {opt: jsonschema.WithMaxDepth(2), instance: `{"a": [[1]]}`, limit: jsonschema.LimitDepth},
+		"depth empty":   {opt: jsonschema.WithMaxDepth(1), instance: `{"a": []}`},
+		"scalar":        {opt: jsonschema.WithMaxDepth(1), instance: `1`},
+		"nodes within":  {opt: jsonschema.WithMaxNodes(4), instance: `{"a": [1, 2]}`},
+		"nodes over":    {opt: jsonschema.WithMaxNodes(3), instance: `{"a": [1, 2]}`, limit: jsonschema.LimitNodes},
+		"no limit zero": {opt: jsonschema.WithMaxNodes(0), instance: `{"a": [1, 2]}`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v := jsonschema.MustCompileJSON([]byte(`{"type": "object"}`), tc.opt)
+
+			err := v.ValidateJSON(t.Context(), []byte(tc.instance))
+			if tc.limit == "" {
+				require.NotErrorIs(t, err, jsonschema.ErrLimitExceeded)
+
+				return
+			}
+
+			le, ok := errors.AsType[*jsonschema.LimitError](err)
+			require.True(t, ok)
+			assert.Equal(t, tc.limit, le.Limit)
+			assert.Nil(t, le.Partial)
+		})
+	}
+}
+
+func TestWithMaxSteps(t *testing.T) {
+	t.Parallel()
+
+	schema := []byte(`{
+		"$defs": {"node": {"type": "object", "properties": {"next": {"$ref": "#/$defs/node"}, "n": {"minimum": 1}}}},
+		"$ref": "#/$defs/node"
+	}`)
+
+	instance := []byte(`{"n": 0, "next": {"next": {"next": {"next": {"n": 0}}}}}`)
+
+	full := jsonschema.MustCompileJSON(schema)
+	require.Error(t, full.ValidateJSON(t.Context(), instance))
+
+	tight := jsonschema.MustCompileJSON(schema, jsonschema.WithMaxSteps(6))
+
+	err := tight.ValidateJSON(t.Context(), instance)
+	le, ok := errors.AsType[*jsonschema.LimitError](err)
+	require.True(t, ok)
+	assert.Equal(t, jsonschema.LimitSteps, le.Limit)
+	assert.Equal(t, 6, le.Max)
+
+	roomy := jsonschema.MustCompileJSON(schema, jsonschema.WithMaxSteps(1000))
+
+	err = roomy.ValidateJSON(t.Context(), instance)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, jsonschema.ErrLimitExceeded)
+	assert.Equal(t, full.ValidateJSON(t.Context(), instance).Error(), err.Error())
+}
+
+func TestWithMaxSteps_HaltInsideSubschema(t *testing.T) {
+	t.Parallel()
+
+	// Each subschema halts at its "x" property, so the keyword holding it must
+	// not read the cut-short evaluation as a match or a failure.
+	tests := map[string]string{
+		"not":      `{"not": {"properties": {"x": {}}}}`,
+		"anyOf":    `{"anyOf": [{"properties": {"x": {}}}, {"required": ["y"]}]}`,
+		"oneOf":    `{"oneOf": [{"properties": {"x": {}}}, {}]}`,
+		"if":       `{"if": {"properties": {"x": {}}}, "then": false}`,
+		"contains": `{"contains": {"properties": {"x": {}}}}`,
+	}
+
+	for name, b := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := `{"properties": {"a": {"minimum": 1}, "b": ` + b + `}}`
+			v := jsonschema.MustCompileJSON([]byte(schema), jsonschema.WithMaxSteps(4))
+
+			instance := `{"a": 0, "b": {"x": 1}}`
+			if name == "contains" {
+				instance = `{"a": 0, "b": [{"x": 1}]}`
+			}
+
+			err := v.ValidateJSON(t.Context(), []byte(instance))
+
+			le, ok := errors.AsType[*jsonschema.LimitError](err)
+			require.True(t, ok)
+			assert.Equal(t, jsonschema.LimitSteps, le.Limit)
+			require.NotNil(t, le.Partial)
+
+			leaves := le.Partial.Leaves()
+			require.Len(t, leaves, 1)
+			assert.Equal(t, "/properties/a/minimum", leaves[0].SchemaPath)
+		})
+	}
+}
@@ -0,0 +1,565 @@
+package jsonschema
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/goast"
+)
+
+// kubebuilderValidation is the namespace of the kubebuilder validation markers.
+const kubebuilderValidation = "kubebuilder:validation:"
+
+// ignoredMarkerPrefixes are the marker namespaces that configure code or CRD
+// generation rather than validation. They are recognized, so a type carrying
+// them is not reported, and contribute nothing to the schema.
+var ignoredMarkerPrefixes = []string{
+	"kubebuilder:object:",
+	"kubebuilder:subresource:",
+	"kubebuilder:printcolumn",
+	"kubebuilder:resource",
+	"kubebuilder:storageversion",
+	"kubebuilder:skip",
+	"kubebuilder:rbac:",
+	"kubebuilder:webhook:",
+	"k8s:",
+	"genclient",
+	"groupName",
+	"versionName",
+	"listMapKey",
+	"mapType",
+	"structType",
+}
+
+// skippedMarkers are the markers that constrain a value in ways JSON Schema
+// has no keyword for, each with the reason [SkippedMarker] gives. They are
+// recognized and recorded rather than reported. An XValidation marker's
+// reason is its rule.
+var skippedMarkers = map[string]string{
+	kubebuilderValidation + "XValidation": "",
+	kubebuilderValidation + "Type":        "the schema keeps the type the Go type derives",
+	kubebuilderValidation + "XIntOrString": "x-kubernetes-int-or-string is a Kubernetes extension; " +
+		"the schema keeps the type the Go type derives",
+	kubebuilderValidation + "EmbeddedResource": "x-kubernetes-embedded-resource is a Kubernetes extension with " +
+		"no JSON Schema keyword",
+	kubebuilderValidation + "Schemaless": "the schema keeps the Go type's schema, which the marker leaves out of " +
+		"a CRD",
+	"kubebuilder:pruning:PreserveUnknownFields": "x-kubernetes-preserve-unknown-fields is a Kubernetes extension " +
+		"with no JSON Schema keyword",
+	"patchMergeKey": "strategic merge patch metadata has no JSON Schema keyword",
+	"patchStrategy": "strategic merge patch metadata has no JSON Schema keyword",
+	"protobuf":      "protobuf generation options have no JSON Schema keyword",
+	"union":         "a union's one-member rule has no JSON Schema keyword the markers state",
+	"enum":          "the values are the type's constants, which GoCommentProvider.Enums lists",
+}
+
+// SkippedMarker is a marker the [GoMarkerInterpreter] recognized but could
+// not state in JSON Schema. The schema is then looser than the marker: it
+// accepts every value the marker accepts and some it rejects.
+type SkippedMarker struct {
+	// Element names the type or field carrying the marker: the type's import
+	// path and name, such as "example.com/api/v1.Widget", followed for a field
+	// by its Go name.
+	Element string
+	// Marker is the marker's name, such as "+kubebuilder:validation:XValidation".
+	Marker string
+	// Reason says why the marker has no JSON Schema equivalent. For an
+	// XValidation marker it is the rule.
+	Reason string
+}
+
+// GoMarkerInterpreter reads the kubebuilder-style markers in Go doc comments,
+// the "+name" and "+name=value" lines Kubernetes API types carry, and
+// contributes them through the [Constraints] facade tag interpreters use, so
+// a marker bound intersects with the Go kind's and with every tag's, and a
+// second enum or default is [ErrConstraintConflict]. It is both a
+// [FieldInterpreter] reading a field's doc comment and a [TypeSchemaExtender]
+// reading a named type's; register it with [WithFieldInterpreter] and
+// [WithTypeSchemaExtender]. Obtain one from [GoCommentProvider.Markers]: it
+// loads packages through the provider, sharing its package cache and its
+// failure modes.
+//
+// The markers understood, with kv standing for +kubebuilder:validation:, are:
+//
+//   - kv:Minimum, kv:Maximum, with kv:ExclusiveMinimum and
+//     kv:ExclusiveMaximum (true) making them exclusive
+//   - kv:MinLength, kv:MaxLength, kv:MinItems, kv:MaxItems,
+//     kv:MinProperties, kv:MaxProperties, kv:MultipleOf
+//   - kv:Pattern (optionally quoted or backquoted), kv:Format
+//   - kv:Enum, with values separated by semicolons
+//   - kv:UniqueItems=true and +listType=set: uniqueItems; +listType=atomic
+//     and +listType=map contribute nothing
+//   - +kubebuilder:default and +default: the JSON default, or the value as a
+//     string when it is not JSON
+//   - +optional and kv:Optional, +required and kv:Required: remove the field
+//     from, or add it to, its parent's required list (fields only)
+//
+// Markers that configure code generation rather than validation
+// (+kubebuilder:object:root, +kubebuilder:subresource:status,
+// +kubebuilder:printcolumn, +genclient, +k8s:..., +listMapKey, and the like)
+// are recognized and ignored. +nullable makes the field, or every occurrence
+// of the type, admit null. Markers that constrain the value in ways JSON
+// Schema cannot state (kv:XValidation's CEL rules, kv:Type, kv:XIntOrString,
+// kv:EmbeddedResource, kv:Schemaless,
+// +kubebuilder:pruning:PreserveUnknownFields, +patchMergeKey,
+// +patchStrategy, +protobuf, +union, and +enum) are recognized and recorded;
+// [GoMarkerInterpreter.Skipped] lists them once generation returns. Any other
+// marker is reported as [ErrUnknownMarker] rather than silently dropped, as
+// is a field marker on a type. A line is a marker only in a marker's shape:
+// a colon- or dot-separated name, or a single word the interpreter knows,
+// followed by "=" or the line's end; other lines starting with "+" are
+// prose. Only the doc comment attached to the
+// declaration is read, so a marker block separated from it by a blank line is
+// not seen.
+type GoMarkerInterpreter struct {
+	comments *GoCommentProvider
+	skipped  map[SkippedMarker]struct{}
+	mu       sync.Mutex
+}
+
+// Markers returns a [GoMarkerInterpreter] loading packages through ce. From
+// then on, the descriptions ce serves omit marker lines.
+func (ce *GoCommentProvider) Markers() *GoMarkerInterpreter {
+	ce.markers.Store(true)
+
+	return &GoMarkerInterpreter{comments: ce, skipped: map[SkippedMarker]struct{}{}}
+}
+
+// Skipped returns the markers the interpreter has skipped across every
+// generation it took part in, sorted by element, marker, and reason.
+func (m *GoMarkerInterpreter) Skipped() []SkippedMarker {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make([]SkippedMarker, 0, len(m.skipped))
+	for r := range m.skipped {
+		out = append(out, r)
+	}
+
+	slices.SortFunc(out, func(a, b SkippedMarker) int {
+		return cmp.Or(cmp.Compare(a.Element, b.Element), cmp.Compare(a.Marker, b.Marker), cmp.Compare(a.Reason, b.Reason))
+	})
+
+	return out
+}
+
+// record adds a skipped marker. A marker is recorded once however many times
+// its type is generated.
+func (m *GoMarkerInterpreter) record(r SkippedMarker) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.skipped[r] = struct{}{}
+}
+
+// InterpretField applies the markers in the field's doc comment.
+func (m *GoMarkerInterpreter) InterpretField(ctx context.Context, fc FieldContext) error {
+	owner := fc.Owner
+	if owner == nil || owner.Name() == "" || owner.PkgPath() == "" {
+		return nil
+	}
+
+	files, err := m.comments.sourceFiles(ctx, owner.PkgPath())
+	if err != nil {
+		return err
+	}
+
+	doc, _ := goast.StructFieldDocThroughAliases(files, owner.Name(), fc.StructField.Name)
+	site := markerSite{element: owner.PkgPath() + "." + owner.Name() + "." + fc.StructField.Name}
+
+	return m.applyMarkers(fc, site, parseMarkers(doc))
+}
+
+// ExtendSchemaForType applies the markers in a named type's doc comment to its
+// schema.
+func (m *GoMarkerInterpreter) ExtendSchemaForType(ctx context.Context, tc TypeContext, ts *TypeSchema) error {
+	t := tc.Type
+	if t.Name() == "" || t.PkgPath() == "" || ts.Value == nil {
+		return nil
+	}
+
+	files, err := m.comments.sourceFiles(ctx, t.PkgPath())
+	if err != nil {
+		return err
+	}
+
+	doc, _ := goast.TypeDoc(files, goast.BaseTypeName(t.Name()))
+
+	// The type's schema is both the canvas and the base, so a marker bound
+	// still intersects with the kind-derived one.
+	fc := FieldContext{Type: t, Canvas: ts.Value, Base: ts.Value, Draft: tc.Draft}
+	site := markerSite{element: t.PkgPath() + "." + t.Name(), ts: ts}
+
+	return m.applyMarkers(fc, site, parseMarkers(doc))
+}
+
+// marker is one "+name" or "+name=value" comment line.
+type marker struct {
+	name  string
+	value string
+}
+
+// bareMarkers are the markers without a namespace the interpreter applies.
+var bareMarkers = []string{"optional", "required", "nullable", "default", "listType"}
+
+// isMarkerLine reports whether a doc comment line is a marker: a plus sign
+// directly followed by a name that starts with a letter and runs without
+// spaces to "=" or the end of the line. The name must be namespaced by a
+// colon or dot, or be a single word the interpreter knows, so prose such as
+// "+Inf is accepted" is not taken for a marker.
+func isMarkerLine(line string) bool {
+	line = strings.TrimSpace(line)
+
+	rest, ok := strings.CutPrefix(line, "+")
+	if !ok {
+		return false
+	}
+
+	name, _, _ := strings.Cut(rest, "=")
+	if name == "" || !isASCIILetter(name[0]) || strings.ContainsFunc(name, unicode.IsSpace) {
+		return false
+	}
+
+	return strings.ContainsAny(name, ":.") || isBareMarker(name)
+}
+
+// isASCIILetter reports whether c is an ASCII letter.
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// isBareMarker reports whether name, a marker name without a namespace, is
+// one the interpreter applies, ignores, or skips.
+func isBareMarker(name string) bool {
+	if slices.Contains(bareMarkers, name) {
+		return true
+	}
+
+	if _, ok := skippedMarkers[name]; ok {
+		return true
+	}
+
+	return slices.ContainsFunc(ignoredMarkerPrefixes, func(prefix string) bool {
+		return !strings.Contains(prefix, ":") && strings.HasPrefix(name, prefix)
+	})
+}
+
+// parseMarkers returns the markers in doc, in order.
+func parseMarkers(doc string) []marker {
+	var out []marker
+
+	for line := range strings.Lines(doc) {
+		if !isMarkerLine(line) {
+			continue
+		}
+
+		name, value, _ := strings.Cut(strings.TrimSpace(line)[1:], "=")
+		out = append(out, marker{name: name, value: value})
+	}
+
+	return out
+}
+
+// stripMarkers removes the marker lines from doc, so a description does not
+// repeat them. A doc without marker lines is returned as it is.
+func stripMarkers(doc string) string {
+	var (
+		b        strings.Builder
+		stripped bool
+	)
+
+	for line := range strings.Lines(doc) {
+		if isMarkerLine(line) {
+			stripped = true
+			continue
+		}
+
+		b.WriteString(line)
+	}
+
+	if !stripped {
+		return doc
+	}
+
+	// Only the line breaks the removed lines leave at either end go.
+	return strings.Trim(b.String(), "\n")
+}
+
+// markerSite is the declaration markers are read from: the element a
+// [SkippedMarker] names and, for a type, its schema.
+type markerSite struct {
+	ts      *TypeSchema
+	element string
+}
+
+// numericMarkers collects the numeric bound markers, which apply together
+// once their exclusivity flags are known.
+type numericMarkers struct {
+	minimum, maximum           string
+	exclusiveMin, exclusiveMax bool
+}
+
+// applyMarkers contributes ms, read from site, to fc.
+func (m *GoMarkerInterpreter) applyMarkers(fc FieldContext, site markerSite, ms []marker) error {
+	if len(ms) == 0 {
+		return nil
+	}
+
+	var num numericMarkers
+
+	for _, mk := range ms {
+		err := m.applyMarker(fc, site, mk, &num)
+		if err != nil {
+			return fmt.Errorf("marker +%s: %w", mk.name, err)
+		}
+	}
+
+	c := fc.Constraints()
+
+	if num.minimum != "" {
+		op := OpFloorIncl
+		if num.exclusiveMin {
+			op = OpFloorExcl
+		}
+
+		err := c.Apply(op, AxisNumeric, num.minimum)
+		if err != nil {
+			return fmt.Errorf("marker +%sMinimum: %w", kubebuilderValidation, err)
+		}
+	}
+
+	if num.maximum != "" {
+		op := OpCeilIncl
+		if num.exclusiveMax {
+			op = OpCeilExcl
+		}
+
+		err := c.Apply(op, AxisNumeric, num.maximum)
+		if err != nil {
+			return fmt.Errorf("marker +%sMaximum: %w", kubebuilderValidation, err)
+		}
+	}
+
+	return nil
+}
+
+// sizeMarkers maps each size marker to its operation and axis.
+var sizeMarkers = map[string]struct {
+	op   Op
+	axis Axis
+}{
+	"MinLength":     {OpFloorIncl, AxisLength},
+	"MaxLength":     {OpCeilIncl, AxisLength},
+	"MinItems":      {OpFloorIncl, AxisItems},
+	"MaxItems":      {OpCeilIncl, AxisItems},
+	"MinProperties": {OpFloorIncl, AxisProperties},
+	"MaxProperties": {OpCeilIncl, AxisProperties},
+}
+
+// applyMarker contributes one marker, deferring the numeric bounds to num.
+func (m *GoMarkerInterpreter) applyMarker(fc FieldContext, site markerSite, mk marker, num *numericMarkers) error {
+	c := fc.Constraints()
+	field := site.ts == nil
+
+	if name, reason, ok := skippedMarker(mk); ok {
+		m.record(SkippedMarker{Element: site.element, Marker: "+" + name, Reason: reason})
+		return nil
+	}
+
+	if rule, ok := strings.CutPrefix(mk.name, kubebuilderValidation); ok {
+		if size, ok := sizeMarkers[rule]; ok {
+			return c.Apply(size.op, size.axis, mk.value)
+		}
+
+		switch rule {
+		case "Minimum":
+			num.minimum = mk.value
+		case "Maximum":
+			num.maximum = mk.value
+		case "ExclusiveMinimum":
+			return parseMarkerBool(mk.value, &num.exclusiveMin)
+		case "ExclusiveMaximum":
+			return parseMarkerBool(mk.value, &num.exclusiveMax)
+		case "MultipleOf":
+			f, err := strconv.ParseFloat(mk.value, 64)
+			if err != nil {
+				return fmt.Errorf("invalid value %q: %w", mk.value, err)
+			}
+
+			return c.SetMultipleOf(f)
+		case "Pattern":
+			return c.Apply(OpPattern, AxisAuto, unquoteMarker(mk.value))
+		case "Format":
+			return c.Apply(OpFormat, AxisAuto, mk.value)
+		case "Enum":
+			return c.Apply(OpOneOf, AxisAuto, markerEnum(mk.value)...)
+		case "UniqueItems":
+			var unique bool
+
+			err := parseMarkerBool(mk.value, &unique)
+			if err != nil || !unique {
+				return err
+			}
+
+			return c.Apply(OpUnique, AxisAuto, "true")
+		case "Optional", "Required":
+			return applyPresence(fc, rule == "Required", field)
+		default:
+			return fmt.Errorf("%w: +%s", ErrUnknownMarker, mk.name)
+		}
+
+		return nil
+	}
+
+	switch mk.name {
+	case "optional", "required":
+		return applyPresence(fc, mk.name == "required", field)
+	case "kubebuilder:default", "default":
+		return setMarkerDefault(fc, mk.value)
+	case "nullable":
+		applyNullable(fc, site.ts)
+		return nil
+	case "listType":
+		switch mk.value {
+		case "set":
+			return c.Apply(OpUnique, AxisAuto, "true")
+		case "atomic", "map":
+			return nil
+		default:
+			return fmt.Errorf("invalid list type %q", mk.value)
+		}
+	}
+
+	for _, prefix := range ignoredMarkerPrefixes {
+		if strings.HasPrefix(mk.name, prefix) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: +%s", ErrUnknownMarker, mk.name)
+}
+
+// skippedMarker returns the name of the skipped marker mk is and the reason
+// it is skipped, and reports whether it is one. A marker may carry arguments
+// after its name (+kubebuilder:validation:XValidation:rule=...), which are an
+// XValidation marker's reason, and a +protobuf marker a dotted option path.
+func skippedMarker(mk marker) (string, string, bool) {
+	text := mk.name
+	if mk.value != "" {
+		text += "=" + mk.value
+	}
+
+	for name, reason := range skippedMarkers {
+		rest, ok := strings.CutPrefix(text, name)
+		if !ok || rest != "" && !strings.ContainsRune("=:.", rune(rest[0])) {
+			continue
+		}
+
+		return name, cmp.Or(reason, rest[min(len(rest), 1):]), true
+	}
+
+	return "", "", false
+}
+
+// applyNullable makes the value admit null: every occurrence of a type,
+// through its [Nullability], or the field's own value. A field context a
+// caller builds has no node to mark, and is left unchanged.
+func applyNullable(fc FieldContext, ts *TypeSchema) {
+	switch {
+	case ts != nil:
+		ts.Nullability = NullAllowed
+	case fc.node == nil:
+	case fc.node.kind == kindRef:
+		fc.node.ptrNullable = true
+	default:
+		fc.node.nullable = true
+	}
+}
+
+// applyPresence adds the field to its parent's required list, or removes it.
+func applyPresence(fc FieldContext, required, field bool) error {
+	if !field || fc.Parent == nil {
+		return fmt.Errorf("%w: a presence marker applies to struct fields only", ErrUnknownMarker)
+	}
+
+	has := slices.Contains(fc.Parent.Required, fc.Name)
+
+	switch {
+	case required && !has:
+		fc.Parent.Required = append(fc.Parent.Required, fc.Name)
+	case !required && has:
+		fc.Parent.Required = slices.DeleteFunc(fc.Parent.Required, func(n string) bool { return n == fc.Name })
+	}
+
+	return nil
+}
+
+// setMarkerDefault records a default marker's value: the JSON it spells, or
+// else the value as a JSON string, as kubebuilder reads an unquoted string.
+func setMarkerDefault(fc FieldContext, value string) error {
+	if fc.Canvas.Default != nil {
+		return fmt.Errorf("%w: a default is already set", ErrConstraintConflict)
+	}
+
+	raw := json.RawMessage(value)
+	if !json.Valid(raw) {
+		quoted, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("invalid default %q: %w", value, err)
+		}
+
+		raw = quoted
+	}
+
+	fc.Canvas.Default = raw
+
+	return nil
+}
+
+// parseMarkerBool parses a boolean marker value; a bare marker means true.
+func parseMarkerBool(value string, dst *bool) error {
+	if value == "" {
+		*dst = true
+
+		return nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("invalid value %q: %w", value, err)
+	}
+
+	*dst = b
+
+	return nil
+}
+
+// markerEnum splits an Enum marker's semicolon-separated values, unquoting
+// each quoted one.
+func markerEnum(value string) []string {
+	values := strings.Split(value, ";")
+	for i, v := range values {
+		values[i] = unquoteMarker(strings.TrimSpace(v))
+	}
+
+	return values
+}
+
+// unquoteMarker removes the double quotes or backquotes around a marker
+// value, leaving any other value as written.
+func unquoteMarker(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '`') && value[len(value)-1] == value[0] {
+		if s, err := strconv.Unquote(value); err == nil {
+			return s
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,190 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha"
+)
+
+func TestGoMarkerInterpreter(t *testing.T) {
+	t.Parallel()
+
+	comments := jsonschema.NewGoCommentProvider()
+	markers := comments.Markers()
+
+	s, err := jsonschema.GenerateFor[alpha.Workload](t.Context(),
+		jsonschema.WithFieldInterpreter(markers),
+		jsonschema.WithTypeSchemaExtender(markers),
+		jsonschema.WithDescriptionProvider(comments),
+		jsonschema.WithDefinitions(false),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Workload holds the comment-marker test fields.", s.Description)
+	assert.ElementsMatch(t, []string{"name", "ports", "timeout"}, s.Required)
+
+	tests := map[string]string{
+		"name": `{
+			"type": "string",
+			"description": "Name is the workload name.",
+			"pattern": "^[a-z]+$",
+			"maxLength": 63
+		}`,
+		"mode": `{
+			"type": "string",
+			"description": "Mode selects the rollout mode.",
+			"enum": ["Recreate", "RollingUpdate"],
+			"default": "RollingUpdate"
+		}`,
+		"ports": `{
+			"type": ["null", "array"],
+			"description": "Ports lists the exposed ports.",
+			"items": {"type": "integer", "minimum": 0, "maximum": 65535},
+			"minItems": 1,
+			"uniqueItems": true
+		}`,
+		"timeout": `{
+			"anyOf": [{"type": "integer", "minimum": 0, "maximum": 255}, {"type": "null"}],
+			"description": "Timeout is in seconds.",
+			"default": 30
+		}`,
+	}
+
+	for name, want := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := json.Marshal(s.Properties[name])
+			require.NoError(t, err)
+			assert.JSONEq(t, want, string(got))
+		})
+	}
+
+	// The type's markers and the field's intersect: [1, 10] from Replicas
+	// narrowed to [1, 5) by the field.
+	valid := `{"name": "web", "ports": [80], "timeout": 5, "replicas": 4}`
+	require.NoError(t, validateJSON(t.Context(), s, []byte(valid)))
+
+	for name, instance := range map[string]string{
+		"pattern":          `{"name": "Web", "ports": [80], "timeout": 5}`,
+		"enum":             `{"name": "web", "mode": "Blue", "ports": [80], "timeout": 5}`,
+		"set":              `{"name": "web", "ports": [80, 80], "timeout": 5}`,
+		"min items":        `{"name": "web", "ports": [], "timeout": 5}`,
+		"exclusive max":    `{"name": "web", "ports": [80], "timeout": 5, "replicas": 5}`,
+		"type minimum":     `{"name": "web", "ports": [80], "timeout": 5, "replicas": 0}`,
+		"marker required":  `{"name": "web", "ports": [80]}`,
+		"kind bound stays": `{"name": "web", "ports": [80], "timeout": 256}`,
+	} {
+		require.Error(t, validateJSON(t.Context(), s, []byte(instance)), name)
+	}
+}
+
+func TestGoMarkerInterpreter_Errors(t *testing.T) {
+	t.Parallel()
+
+	markers := jsonschema.NewGoCommentProvider().Markers()
+
+	_, err := jsonschema.GenerateFor[alpha.Misspelled](t.Context(), jsonschema.WithFieldInterpreter(markers))
+	require.ErrorIs(t, err, jsonschema.ErrUnknownMarker)
+	assert.ErrorContains(t, err, "+kubebuilder:validation:Minimun")
+
+	_, err = jsonschema.GenerateFor[alpha.Reenumerated](t.Context(), jsonschema.WithFieldInterpreter(markers))
+	require.ErrorIs(t, err, jsonschema.ErrConstraintConflict)
+
+	// A bound parses at the field's Go kind, as a tag's does.
+	_, err = jsonschema.GenerateFor[alpha.NegativeUnsigned](t.Context(), jsonschema.WithFieldInterpreter(markers))
+	require.ErrorContains(t, err, "+kubebuilder:validation:Maximum")
+}
+
+func TestGoMarkerInterpreter_Skipped(t *testing.T) {
+	t.Parallel()
+
+	markers := jsonschema.NewGoCommentProvider().Markers()
+
+	s, err := jsonschema.GenerateFor[alpha.Manifest](t.Context(),
+		jsonschema.WithFieldInterpreter(markers),
+		jsonschema.WithTypeSchemaExtender(markers),
+		jsonschema.WithDefinitions(false),
+	)
+	require.NoError(t, err)
+
+	for name, instance := range map[string]string{
+		"nullable field": `{"note": null, "revision": 1, "spec": {}}`,
+		"nullable type":  `{"note": "a", "revision": null, "spec": {}}`,
+	} {
+		require.NoError(t, validateJSON(t.Context(), s, []byte(instance)), name)
+	}
+
+	const manifest = "go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha.Manifest"
+
+	assert.Equal(t, []jsonschema.SkippedMarker{
+		{
+			Element: manifest + ".Spec",
+			Marker:  "+kubebuilder:pruning:PreserveUnknownFields",
+			Reason:  "x-kubernetes-preserve-unknown-fields is a Kubernetes extension with no JSON Schema keyword",
+		},
+		{
+			Element: manifest + ".Spec",
+			Marker:  "+kubebuilder:validation:XValidation",
+			Reason:  `rule="self.size() > 0",message="spec is empty"`,
+		},
+		{
+			Element: manifest + ".Spec",
+			Marker:  "+patchMergeKey",
+			Reason:  "strategic merge patch metadata has no JSON Schema keyword",
+		},
+		{
+			Element: manifest + ".Spec",
+			Marker:  "+patchStrategy",
+			Reason:  "strategic merge patch metadata has no JSON Schema keyword",
+		},
+		{
+			Element: manifest + ".Spec",
+			Marker:  "+protobuf",
+			Reason:  "protobuf generation options have no JSON Schema keyword",
+		},
+	}, markers.Skipped())
+}
+
+func TestGoCommentProvider_MarkerLines(t *testing.T) {
+	t.Parallel()
+
+	// Without its marker interpreter, the provider serves comments verbatim.
+	s, err := jsonschema.GenerateFor[alpha.Workload](t.Context(),
+		jsonschema.WithDescriptionProvider(jsonschema.NewGoCommentProvider()),
+		jsonschema.WithDefinitions(false),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Name is the workload name.\n"+
+		"+kubebuilder:validation:Pattern=`^[a-z]+$`\n"+
+		"+kubebuilder:validation:MaxLength=63", s.Properties["name"].Description)
+}
+
+func TestGoMarkerInterpreter_Prose(t *testing.T) {
+	t.Parallel()
+
+	comments := jsonschema.NewGoCommentProvider()
+	markers := comments.Markers()
+
+	// A line starting with a plus sign is a marker only in a marker's shape,
+	// so prose is neither reported nor stripped from the description.
+	s, err := jsonschema.GenerateFor[alpha.Bounds](t.Context(),
+		jsonschema.WithFieldInterpreter(markers),
+		jsonschema.WithTypeSchemaExtender(markers),
+		jsonschema.WithDescriptionProvider(comments),
+		jsonschema.WithDefinitions(false),
+	)
+	require.NoError(t, err)
+
+	limit := s.Properties["limit"]
+	assert.Equal(t, "Limit caps the value.\n+Inf is accepted as a value, and so is\n+e.g. the largest float.",
+		limit.Description)
+	require.NotNil(t, limit.Minimum)
+	assert.InDelta(t, 0, *limit.Minimum, 0)
+}
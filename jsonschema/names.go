@@ -58,8 +58,8 @@ func (g *generator) shouldExtract(t reflect.Type) bool {
 	}
 
 	// Named non-struct types are extracted only if they implement
-	// JSONSchemaProvider or JSONSchemaExtender.
-	return implementsProvider(t) || implementsExtender(t)
+	// JSONSchemaProvider or JSONSchemaExtender, or are registered unions.
+	return implementsProvider(t) || implementsExtender(t) || g.unions[t] != nil
 }
 
 // assignDefNames assigns each def entry its final $defs key, disambiguating
@@ -113,7 +113,7 @@ func (g *generator) assignDefNames() {
 			// uses: a package path element may legally contain a JSON Pointer
 			// special character (the tilde, allowed in module paths), which
 			// would otherwise misresolve the generated $ref token.
-			baseCandidates[i] = jsonptr.SafeToken(path.Base(e.typ.PkgPath())) + "_" + base
+			baseCandidates[i] = jsonptr.SafeToken(path.Base(e.pkgPath)) + "_" + base
 		}
 
 		// Pick the first scheme whose names are unique within the group and do
@@ -126,7 +126,7 @@ func (g *generator) assignDefNames() {
 			// tilde and the other characters invalid in a $ref token.
 			fullCandidates := make([]string, len(entries))
 			for i, e := range entries {
-				fullCandidates[i] = jsonptr.SafeToken(e.typ.PkgPath()) + "_" + base
+				fullCandidates[i] = jsonptr.SafeToken(e.pkgPath) + "_" + base
 			}
 
 			chosen = fullCandidates
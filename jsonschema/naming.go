@@ -0,0 +1,240 @@
+package jsonschema
+
+import (
+	"reflect"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/fieldname"
+	"go.jacobcolvin.com/x/jsonschema/internal/reflectkind"
+	"go.jacobcolvin.com/x/jsonschema/internal/typename"
+)
+
+// ErrInvalidFieldNaming reports a struct a [FieldNaming] dialect cannot map to
+// an object: two fields claiming one key, an inlined field that is neither a
+// struct nor a map with string keys, a second inlined map, a struct that
+// inlines itself, or a tag option the library rejects.
+var ErrInvalidFieldNaming = fieldname.ErrInvalid
+
+// FieldNaming is a struct field naming dialect: the rules a serialization
+// library uses to turn a Go struct into an object, namely which struct tag
+// names each property, which fields are omitted and when, and which fields
+// are inlined into their parent. Select one with [WithFieldNaming]. The zero
+// FieldNaming is [NamingJSON].
+type FieldNaming struct {
+	d *fieldname.Dialect
+}
+
+var (
+	// NamingJSON is encoding/json's naming, the default: the json tag, the
+	// field name as declared, omitempty and omitzero, and embedded structs
+	// promoting their fields with encoding/json's shadowing rules.
+	NamingJSON = FieldNaming{}
+
+	// NamingYAML is gopkg.in/yaml.v3's naming, which github.com/goccy/go-yaml
+	// shares: the yaml tag, the field name lowercased by default, omitempty
+	// (a zero value, with a struct zero when all its exported fields are, or
+	// an IsZero method), and inline, which merges a struct's fields into its
+	// parent or collects the parent's other keys in a map with string keys.
+	// An embedded struct without inline is an ordinary property named by its
+	// lowercased type name, and two fields claiming one key are an error, as
+	// yaml.v3 reports them.
+	//
+	// Values follow yaml.v3 as well: a MarshalYAML or MarshalText method
+	// encodes its type (MarshalJSON is not consulted), a [time.Duration] is
+	// its String form, and a byte slice is a sequence of integers.
+	NamingYAML = FieldNaming{d: fieldname.YAML}
+
+	// NamingMapstructure is the naming of github.com/go-viper/mapstructure
+	// and its github.com/mitchellh/mapstructure predecessor: the mapstructure
+	// tag, the field name as declared by default, squash to merge a struct's
+	// fields into its parent, remain to collect the parent's other keys in a
+	// map, and omitempty and omitzero. An embedded struct without squash is an
+	// ordinary property named by its type name. Values below a struct field
+	// are encoded as encoding/json encodes them.
+	//
+	// Mapstructure matches keys case-insensitively when decoding, but the
+	// schema names each property exactly as mapstructure encodes it; tag the
+	// fields whose keys are spelled differently in the source documents.
+	NamingMapstructure = FieldNaming{d: fieldname.Mapstructure}
+
+	// NamingJSONv2 is encoding/json/v2's naming and value encoding, which
+	// github.com/go-json-experiment/json shares: the json tag, the field name
+	// as declared by default, omitempty (decided on the encoded value, so an
+	// empty object or array is omitted too), omitzero (an IsZero method or
+	// the zero value), and embed, which merges a struct's fields into its
+	// parent or collects the parent's other keys in a map with string keys
+	// or a jsontext.Value. Go-json-experiment's inline and unknown options
+	// are read as embed. An embedded struct without a JSON name is merged
+	// implicitly, and fields claiming one key resolve as encoding/json
+	// resolves them.
+	//
+	// Values follow v2 as well: a nil slice or map is an empty array or
+	// object rather than null, a byte array is base64 like a byte slice, the
+	// ,string option quotes numbers only, and a [time.Duration] has no default
+	// representation, so generation rejects one without a format option.
+	// The format option selects a byte encoding (base64, base64url, base32,
+	// base32hex, base16 or hex, or array), a time layout or unix form, a
+	// duration form (units, iso8601, sec, milli, micro, or nano), nonfinite
+	// for a float, and emitnull or emitempty for a slice or map, as
+	// go-json-experiment defines them. Property names are matched
+	// case-sensitively, as v2 decodes them by default. To validate what an
+	// encoder really emits, pass its Marshal to [WithMarshaler].
+	NamingJSONv2 = FieldNaming{d: fieldname.JSONv2}
+)
+
+// FieldName is a [NamingFunc] function's reading of one struct field.
+type FieldName struct {
+	// Name is the field's property name; an empty Name omits the field
+	// unless Inline is set.
+	Name string
+	// OmitEmpty omits the field when it holds the zero value of its type,
+	// making the property optional.
+	OmitEmpty bool
+	// Inline merges the field into its parent: a struct's (or a struct
+	// pointer's) fields join the parent's properties, and a map with string
+	// keys collects the parent's other keys. Name is ignored.
+	Inline bool
+}
+
+// NamingFunc returns the [FieldNaming] that names each struct field by calling
+// name, for a library the predefined dialects do not cover. Name sees every
+// exported field and every embedded one; an unexported embedded field it does
+// not inline is omitted. Resolution follows the predefined non-JSON dialects:
+// an embedded struct is an ordinary property unless inlined, and two fields
+// claiming one key are [ErrInvalidFieldNaming]. Values below a struct field
+// are encoded as encoding/json encodes them.
+func NamingFunc(name func(f reflect.StructField) FieldName) FieldNaming {
+	return FieldNaming{d: &fieldname.Dialect{
+		Key: "custom",
+		Parse: func(f reflect.StructField) (fieldname.Tag, error) {
+			n := name(f)
+
+			return fieldname.Tag{Name: n.Name, OmitZero: n.OmitEmpty, Inline: n.Inline}, nil
+		},
+		Empty: fieldname.Empty,
+	}}
+}
+
+// FieldNamingOption is the option type returned by [WithFieldNaming]: a single
+// option value that configures generation ([GenerateOption]) and validation
+// ([ValidateOption]) alike, so one dialect drives both sides.
+type FieldNamingOption interface {
+	GenerateOption
+	ValidateOption
+}
+
+// fieldNamingOption is the [FieldNamingOption] returned by [WithFieldNaming].
+type fieldNamingOption struct {
+	n FieldNaming
+}
+
+func (o fieldNamingOption) applyGenerate(g *generator) { g.naming = o.n.d }
+
+func (o fieldNamingOption) applyValidate(v *validator) { v.naming = o.n.d }
+
+// WithFieldNaming selects the struct field naming dialect (default:
+// [NamingJSON]). The returned option serves generation and validation alike:
+// pass it to both, so a schema generated for a YAML-decoded config validates
+// the very object yaml.v3 would encode the config as.
+//
+// During generation it decides each struct's property names, which properties
+// are required (every field the dialect does not omit when empty), and which
+// fields are inlined. An inlined map with string keys becomes the object's
+// additionalProperties. Under a non-JSON dialect the json tag is not read at
+// all: its ",string" option and name do not apply, and an embedded struct is
+// never composed through allOf, since only inlining merges it. [NamingJSONv2]
+// reads the json tag under v2's rules, merging embedded structs inline.
+//
+// During validation it selects the encoder [Validator.ValidateValue] uses to
+// compute the instance of a Go value, with the Go field paths of
+// [WithGoFieldPaths] resolved through the same dialect. Generated Go
+// validators ([GenerateGo]) convert typed values as encoding/json does,
+// whatever the dialect.
+func WithFieldNaming(n FieldNaming) FieldNamingOption {
+	return fieldNamingOption{n: n}
+}
+
+// typeYAMLMarshaler is gopkg.in/yaml.v3's Marshaler, matched by method so the
+// package needs no yaml dependency.
+var typeYAMLMarshaler = reflect.TypeFor[interface{ MarshalYAML() (any, error) }]()
+
+// yamlValues reports whether values follow yaml.v3's encoding rather than
+// encoding/json's.
+func (g *generator) yamlValues() bool {
+	return g.naming != nil && g.naming.Values == fieldname.ValuesYAML
+}
+
+// yamlBuiltinOverride returns the built-in overrides yaml's value rules
+// change: a duration is its String form, and a big.Int its MarshalText
+// decimal, where encoding/json emits a bare number for both.
+func yamlBuiltinOverride(t reflect.Type) (*Schema, bool) {
+	switch t {
+	case typeDuration:
+		return &Schema{Type: typename.String}, true
+	case typeBigInt:
+		return &Schema{Type: typename.String, Pattern: `^-?[0-9]+$`}, true
+	}
+
+	return nil, false
+}
+
+// yamlMethodSchema returns the schema of a type yaml.v3 encodes through a
+// method in its method set or its pointer's: MarshalYAML can return any value,
+// and MarshalText a string.
+func yamlMethodSchema(t reflect.Type) (*Schema, bool) {
+	pt := reflect.PointerTo(t)
+
+	switch {
+	case t.Implements(typeYAMLMarshaler) || pt.Implements(typeYAMLMarshaler):
+		return &Schema{}, true
+	case t.Implements(reflectkind.TypeTextMarshaler) || pt.Implements(reflectkind.TypeTextMarshaler):
+		return &Schema{Type: typename.String}, true
+	default:
+		return nil, false
+	}
+}
+
+// dialectFields resolves struct type t under the naming dialect, returning its
+// keyed fields and its inlined map, if any. A field reached through an inlined
+// pointer is never required, since a nil pointer leaves it out.
+func (g *generator) dialectFields(t reflect.Type) ([]structFieldInfo, *fieldname.Field, error) {
+	fs, err := g.naming.Fields(t)
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck // The dialect names the struct and field.
+	}
+
+	fields := make([]structFieldInfo, len(fs.List))
+	for i, f := range fs.List {
+		fields[i] = structFieldInfo{
+			jsonName:   f.Name,
+			field:      f.Field,
+			omitempty:  f.OmitEmpty || f.Optional,
+			omitzero:   f.OmitZero,
+			jsonString: f.String,
+			format:     f.Format,
+		}
+	}
+
+	return fields, fs.Rest, nil
+}
+
+// inlineRest makes the map inlined into struct node obj its additionalProperties:
+// the map collects every key the struct's fields do not claim.
+func (g *generator) inlineRest(obj *node, rest *fieldname.Field) error {
+	// An inlined jsontext.Value holds the other members as raw JSON.
+	if fieldname.IsJSONTextValue(rest.Field.Type) {
+		obj.items = g.scalarNode(&Schema{}, false)
+		obj.payload.AdditionalProperties = obj.items.payload
+
+		return nil
+	}
+
+	val, err := g.schemaForType(rest.Field.Type.Elem(), false)
+	if err != nil {
+		return err
+	}
+
+	obj.items = val
+	obj.payload.AdditionalProperties = val.payload
+
+	return nil
+}
@@ -0,0 +1,441 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+type namingTLS struct {
+	CertFile string `mapstructure:"cert_file" yaml:"cert_file" jsonschema:"minLength=1"`
+	Insecure bool   `yaml:",omitempty"`
+}
+
+type namingCommon struct {
+	Labels map[string]string `mapstructure:"labels,omitempty" yaml:"labels,omitempty"`
+	Name   string            `mapstructure:"name"             yaml:"name"`
+}
+
+type namingConfig struct {
+	namingCommon `mapstructure:",squash" yaml:",inline"`
+
+	TLS     *namingTLS     `mapstructure:"tls,omitempty" yaml:"tls,omitempty"`
+	Extra   map[string]int `mapstructure:",remain"       yaml:",inline"`
+	Retries int            `json:"retries_json"`
+	Timeout time.Duration  `mapstructure:"timeout"       yaml:"timeout"`
+}
+
+type namingLevel int
+
+func (l namingLevel) MarshalYAML() (any, error) {
+	if l < 0 {
+		return nil, errors.New("negative level")
+	}
+
+	return map[string]int{"level": int(l)}, nil
+}
+
+type namingValues struct {
+	Size  *big.Int    `yaml:"size"`
+	Data  []byte      `yaml:"data"`
+	Tags  []string    `yaml:"tags"`
+	When  time.Time   `yaml:"when"`
+	Level namingLevel `yaml:"level"`
+}
+
+func TestWithFieldNaming(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		naming jsonschema.FieldNaming
+		want   string
+	}{
+		"yaml": {
+			naming: jsonschema.NamingYAML,
+			want: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"type": "object",
+				"properties": {
+					"labels": {"type": "object", "additionalProperties": {"type": "string"}},
+					"name": {"type": "string"},
+					"tls": {"anyOf": [{"$ref": "#/$defs/namingTLS"}, {"type": "null"}]},
+					"retries": {"type": "integer"},
+					"timeout": {"type": "string"}
+				},
+				"required": ["name", "retries", "timeout"],
+				"additionalProperties": {"type": "integer"},
+				"$defs": {
+					"namingTLS": {
+						"type": "object",
+						"properties": {
+							"cert_file": {"type": "string", "minLength": 1},
+							"insecure": {"type": "boolean"}
+						},
+						"required": ["cert_file"],
+						"additionalProperties": false
+					}
+				}
+			}`,
+		},
+		"mapstructure": {
+			naming: jsonschema.NamingMapstructure,
+			want: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"type": "object",
+				"properties": {
+					"labels": {"type": ["null", "object"], "additionalProperties": {"type": "string"}},
+					"name": {"type": "string"},
+					"tls": {"anyOf": [{"$ref": "#/$defs/namingTLS"}, {"type": "null"}]},
+					"Retries": {"type": "integer"},
+					"timeout": {
+						"type": "integer",
+						"minimum": -9223372036854775808,
+						"exclusiveMaximum": 9223372036854775808
+					}
+				},
+				"required": ["name", "Retries", "timeout"],
+				"additionalProperties": {"type": "integer"},
+				"$defs": {
+					"namingTLS": {
+						"type": "object",
+						"properties": {
+							"cert_file": {"type": "string", "minLength": 1},
+							"Insecure": {"type": "boolean"}
+						},
+						"required": ["cert_file", "Insecure"],
+						"additionalProperties": false
+					}
+				}
+			}`,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := jsonschema.GenerateFor[namingConfig](t.Context(), jsonschema.WithFieldNaming(tc.naming))
+			require.NoError(t, err)
+
+			got, err := json.Marshal(s)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestWithFieldNaming_YAMLValues(t *testing.T) {
+	t.Parallel()
+
+	s, err := jsonschema.GenerateFor[namingValues](t.Context(), jsonschema.WithFieldNaming(jsonschema.NamingYAML))
+	require.NoError(t, err)
+
+	got, err := json.Marshal(s)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"size": {"anyOf": [{"$ref": "#/$defs/Int"}, {"type": "null"}]},
+			"data": {"type": "array", "items": {"type": "integer", "minimum": 0, "maximum": 255}},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"when": {"$ref": "#/$defs/Time"},
+			"level": true
+		},
+		"required": ["size", "data", "tags", "when", "level"],
+		"additionalProperties": false,
+		"$defs": {
+			"Int": {"type": "string", "pattern": "^-?[0-9]+$"},
+			"Time": {"type": "string", "format": "date-time"}
+		}
+	}`, string(got))
+}
+
+// TestWithFieldNaming_YAMLRoundTrip checks generation and ValidateValue against
+// yaml.v3 itself: each value validates through ValidateValue exactly as the
+// document yaml.v3 encodes it validates.
+func TestWithFieldNaming_YAMLRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	naming := jsonschema.WithFieldNaming(jsonschema.NamingYAML)
+
+	tcs := map[string]struct {
+		value  any
+		valid  bool
+		encErr bool
+	}{
+		"config": {
+			value: namingConfig{
+				namingCommon: namingCommon{Name: "api", Labels: map[string]string{"tier": "web"}},
+				TLS:          &namingTLS{CertFile: "cert.pem"},
+				Extra:        map[string]int{"workers": 4},
+				Timeout:      90 * time.Second,
+			},
+			valid: true,
+		},
+		"empty cert file": {
+			value: namingConfig{TLS: &namingTLS{Insecure: true}},
+		},
+		"extra key claimed by a field": {
+			value:  namingConfig{Extra: map[string]int{"name": 1, "port": 8080}},
+			encErr: true,
+		},
+		"values": {
+			value: &namingValues{
+				Size:  big.NewInt(-12),
+				Data:  []byte("hi"),
+				When:  time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+				Level: 3,
+			},
+			valid: true,
+		},
+		"values zero": {
+			value: namingValues{},
+			valid: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			typ := reflect.TypeOf(tc.value)
+			for typ.Kind() == reflect.Pointer {
+				typ = typ.Elem()
+			}
+
+			var (
+				s   *jsonschema.Schema
+				err error
+			)
+
+			switch typ {
+			case reflect.TypeFor[namingConfig]():
+				s, err = jsonschema.GenerateFor[namingConfig](t.Context(), naming)
+			default:
+				s, err = jsonschema.GenerateFor[namingValues](t.Context(), naming)
+			}
+
+			require.NoError(t, err)
+
+			v, err := jsonschema.Compile(t.Context(), s, naming)
+			require.NoError(t, err)
+
+			if tc.encErr {
+				// Yaml.v3 panics rather than returning this error.
+				assert.Panics(t, func() { _, _ = yaml.Marshal(tc.value) })
+				require.Error(t, v.ValidateValue(t.Context(), tc.value))
+
+				return
+			}
+
+			doc, err := yaml.Marshal(tc.value)
+			require.NoError(t, err)
+
+			var decoded any
+			require.NoError(t, yaml.Unmarshal(doc, &decoded))
+
+			instance, err := json.Marshal(decoded)
+			require.NoError(t, err)
+
+			docErr := v.ValidateJSON(t.Context(), instance)
+			valueErr := v.ValidateValue(t.Context(), tc.value)
+
+			if tc.valid {
+				require.NoError(t, docErr, "yaml:\n%s", doc)
+				require.NoError(t, valueErr)
+			} else {
+				require.Error(t, docErr, "yaml:\n%s", doc)
+				require.Error(t, valueErr)
+				assert.Equal(t, docErr.Error(), valueErr.Error())
+			}
+		})
+	}
+}
+
+func TestWithFieldNaming_GoFieldPaths(t *testing.T) {
+	t.Parallel()
+
+	naming := jsonschema.WithFieldNaming(jsonschema.NamingYAML)
+
+	s, err := jsonschema.GenerateFor[namingConfig](t.Context(), naming)
+	require.NoError(t, err)
+
+	v, err := jsonschema.Compile(t.Context(), s, naming, jsonschema.WithGoFieldPaths(true))
+	require.NoError(t, err)
+
+	err = v.ValidateValue(t.Context(), namingConfig{
+		namingCommon: namingCommon{Name: "api"},
+		TLS:          &namingTLS{},
+	})
+	require.Error(t, err)
+
+	ve, ok := errors.AsType[*jsonschema.ValidationError](err)
+	require.True(t, ok)
+
+	var paths []string
+	for _, leaf := range ve.Leaves() {
+		paths = append(paths, leaf.GoFieldPath())
+	}
+
+	assert.Contains(t, paths, "namingConfig.TLS.CertFile")
+}
+
+func TestNamingFunc(t *testing.T) {
+	t.Parallel()
+
+	type Server struct {
+		ListenAddr string
+		MaxConns   int `env:"-"`
+		Debug      bool
+	}
+
+	snake := jsonschema.NamingFunc(func(f reflect.StructField) jsonschema.FieldName {
+		if f.Tag.Get("env") == "-" {
+			return jsonschema.FieldName{}
+		}
+
+		var b strings.Builder
+
+		for i, r := range f.Name {
+			if i > 0 && 'A' <= r && r <= 'Z' {
+				b.WriteByte('_')
+			}
+
+			b.WriteRune(r)
+		}
+
+		return jsonschema.FieldName{Name: strings.ToUpper(b.String()), OmitEmpty: f.Type.Kind() == reflect.Bool}
+	})
+
+	s, err := jsonschema.GenerateFor[Server](t.Context(), jsonschema.WithFieldNaming(snake))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"LISTEN_ADDR"}, s.Required)
+	assert.Len(t, s.Properties, 2)
+	assert.Contains(t, s.Properties, "DEBUG")
+
+	v, err := jsonschema.Compile(t.Context(), s, jsonschema.WithFieldNaming(snake))
+	require.NoError(t, err)
+
+	require.NoError(t, v.ValidateValue(t.Context(), Server{ListenAddr: ":80", MaxConns: 10}))
+}
+
+type namingDuplicate struct {
+	A string `yaml:"key"`
+	B string `yaml:"key"`
+}
+
+type namingInlineScalar struct {
+	A string `yaml:",inline"`
+}
+
+type namingTwoMaps struct {
+	A map[string]any `yaml:",inline"`
+	B map[string]any `yaml:",inline"`
+}
+
+type namingBadFlag struct {
+	A string `yaml:"a,omitzero"`
+}
+
+type namingSelf struct {
+	*namingSelf `yaml:",inline"`
+
+	A string `yaml:"a"`
+}
+
+func TestWithFieldNaming_Errors(t *testing.T) {
+	t.Parallel()
+
+	naming := jsonschema.WithFieldNaming(jsonschema.NamingYAML)
+
+	tcs := map[string]struct {
+		gen   func(t *testing.T) error
+		value any
+		msg   string
+	}{
+		"duplicate key": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[namingDuplicate](t.Context(), naming)
+
+				return err
+			},
+			value: namingDuplicate{},
+			msg:   `duplicated yaml key "key"`,
+		},
+		"inline scalar": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[namingInlineScalar](t.Context(), naming)
+
+				return err
+			},
+			value: namingInlineScalar{},
+			msg:   "an inlined field must be a struct or a map with string keys",
+		},
+		"two inline maps": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[namingTwoMaps](t.Context(), naming)
+
+				return err
+			},
+			value: namingTwoMaps{},
+			msg:   "more than one inlined map",
+		},
+		"unsupported flag": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[namingBadFlag](t.Context(), naming)
+
+				return err
+			},
+			value: namingBadFlag{},
+			msg:   `unsupported flag "omitzero"`,
+		},
+		"inline cycle": {
+			gen: func(t *testing.T) error {
+				t.Helper()
+
+				_, err := jsonschema.GenerateFor[namingSelf](t.Context(), naming)
+
+				return err
+			},
+			value: namingSelf{},
+			msg:   "inlines itself",
+		},
+	}
+
+	v, err := jsonschema.Compile(t.Context(), &jsonschema.Schema{}, naming)
+	require.NoError(t, err)
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.gen(t)
+			require.ErrorIs(t, err, jsonschema.ErrInvalidFieldNaming)
+			assert.ErrorContains(t, err, tc.msg)
+
+			err = v.ValidateValue(t.Context(), tc.value)
+			require.ErrorIs(t, err, jsonschema.ErrInvalidFieldNaming)
+			assert.ErrorContains(t, err, tc.msg)
+		})
+	}
+}
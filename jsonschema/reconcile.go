@@ -1,6 +1,7 @@
 package jsonschema
 
 import (
+	"maps"
 	"slices"
 
 	"go.jacobcolvin.com/x/jsonschema/internal/constraint"
@@ -216,8 +217,10 @@ func canvasAuthorsBounds(canvas *Schema) bool {
 // overlayAuthored merges the authored canvas onto merged: every keyword
 // [keywordmeta.Authored] declares (the wrapper-scoped annotations and bounds
 // alongside the value-scoped const, enum, and string-content keywords), plus the
-// forbid-value allOf conjuncts. It sets a keyword only where the canvas authored
-// it, so a payload keyword the canvas did not touch survives. Structural
+// forbid-value allOf conjuncts, and the extension keywords in the canvas's
+// Extra (a [GoDocInterpreter]'s markdownDescription), merged key by key. It
+// sets a keyword only where the canvas authored it, so a payload keyword the
+// canvas did not touch survives. Structural
 // sub-schema fields (items, properties, additionalProperties) declare no Assign,
 // which keeps them out of the set: they hold the child element canvases for the
 // tag path to navigate, and each child reconciles its own canvas onto the
@@ -240,15 +243,26 @@ func overlayAuthored(merged, canvas, base *Schema) {
 		}
 	}
 
-	// A forbidValue accumulation lands in the canvas's allOf; append it after the
-	// payload's own allOf (a composite's embed branches) so both apply
-	// conjunctively. The two never coexist -- a field carrying an embed allOf is a
+	// A forbidValue accumulation, or an interpreter's disjunction, lands in the
+	// canvas's allOf; append it after the payload's own allOf (a composite's
+	// embed branches) so both apply conjunctively. The two never coexist -- a field carrying an embed allOf is a
 	// struct, which no forbid-value tag targets -- but the clone keeps the
 	// payload's slice header intact regardless.
 	if len(canvas.AllOf) > 0 {
 		merged.AllOf = append(slices.Clone(base.AllOf), canvas.AllOf...)
 	}
 
+	// Merged shares its Extra map with the payload, so merge into a copy.
+	if len(canvas.Extra) > 0 {
+		extra := maps.Clone(merged.Extra)
+		if extra == nil {
+			extra = map[string]any{}
+		}
+
+		maps.Copy(extra, canvas.Extra)
+		merged.Extra = extra
+	}
+
 	// A canvas not composes with a type-derived not rather than replacing it:
 	// the generic overlay above blind-assigned the canvas value over the type's
 	// own forbid, which would loosen the type constraint (a field-level forbid
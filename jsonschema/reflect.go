@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"go.jacobcolvin.com/x/jsonschema/internal/content"
+	"go.jacobcolvin.com/x/jsonschema/internal/fieldname"
 	"go.jacobcolvin.com/x/jsonschema/internal/jsontag"
 	"go.jacobcolvin.com/x/jsonschema/internal/numkind"
 	"go.jacobcolvin.com/x/jsonschema/internal/reflectkind"
@@ -30,6 +31,7 @@ var (
 	typeBigInt         = reflect.TypeFor[big.Int]()
 	typeBigRat         = reflect.TypeFor[big.Rat]()
 	typeBigFloat       = reflect.TypeFor[big.Float]()
+	typeDuration       = reflect.TypeFor[time.Duration]()
 	typeProvider       = reflect.TypeFor[JSONSchemaProvider]()
 	typeExtender       = reflect.TypeFor[JSONSchemaExtender]()
 
@@ -71,6 +73,7 @@ type generator struct {
 	defaultsFrom         any
 	descriptionProvider  DescriptionProvider
 	tagInterpreters      []tagInterpreterRegistration
+	fieldInterpreters    []FieldInterpreter
 	typeExtenders        []TypeSchemaExtender
 	draft                Draft
 	profile              draftProfile // per-draft behavioral policy, resolved once from draft
@@ -79,6 +82,17 @@ type generator struct {
 	nullable             bool
 	defaultsFromSet      bool
 	rootTitle            bool
+	// CustomNamer is set when [WithNamer] installed a namer of the caller's,
+	// which [GenerateStatic] cannot consult without a [reflect.Type].
+	customNamer bool
+	// GenericBase is the [WithGenericDefinitions] base URI; empty when
+	// instantiations are expanded.
+	genericBase string
+	// Unions maps each interface registered with WithUnion to its
+	// discriminated union.
+	unions map[reflect.Type]*unionSpec
+	// Naming is the [WithFieldNaming] dialect; nil is encoding/json's.
+	naming *fieldname.Dialect
 }
 
 // typeOverrideResult memoizes one [generator.resolveTypeSchema] consultation so
@@ -136,10 +150,61 @@ func (g *generator) forRun(ctx context.Context) *generator {
 
 // generate produces the root schema for the given type.
 func (g *generator) generate(t reflect.Type) (*Schema, error) {
+	err := g.checkGenericBase()
+	if err != nil {
+		return nil, err
+	}
+
+	schema, _, reached, err := g.renderRoot(t, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.attachDefs(schema, reached)
+}
+
+// attachDefs completes a rendered root as a document: it sets $schema and
+// places the reached definitions under $defs (definitions under Draft-07),
+// templating generic instantiations under [WithGenericDefinitions].
+func (g *generator) attachDefs(schema *Schema, reached []*defEntry) (*Schema, error) {
+	// Set $schema on root.
+	schema.Schema = g.draft.schemaURI()
+
+	// Attach $defs if any.
+	if len(reached) > 0 {
+		defs := make(map[string]*Schema, len(reached))
+		for _, e := range reached {
+			defs[e.name] = e.rendered
+		}
+
+		templated, err := g.templateGenerics(schema, defs, reached)
+		if err != nil {
+			return nil, err
+		}
+
+		if templated {
+			schema.ID = g.genericBase
+		}
+
+		if g.profile.definitionsKeyword {
+			schema.Definitions = defs
+		} else {
+			schema.Defs = defs
+		}
+	}
+
+	return schema, nil
+}
+
+// renderRoot builds and renders the schema for t, returning the rendered
+// root, its final node, and the def entries it reaches, each rendered. The
+// root is inlined when inlineRoot is set and its def is reached from nowhere
+// else. The caller attaches $schema and places the definitions.
+func (g *generator) renderRoot(t reflect.Type, inlineRoot bool) (*Schema, *node, []*defEntry, error) {
 	// A nil type carries no kind to reflect on; report it through the error
 	// contract instead of panicking in numkind.DerefType.
 	if t == nil {
-		return nil, fmt.Errorf("%w: nil type", ErrUnsupportedType)
+		return nil, nil, nil, fmt.Errorf("%w: nil type", ErrUnsupportedType)
 	}
 
 	// Follow pointers for root type identity.
@@ -147,9 +212,32 @@ func (g *generator) generate(t reflect.Type) (*Schema, error) {
 
 	root, err := g.schemaForType(t, false)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
+	}
+
+	schema, root, reached := g.renderRootNode(root, inlineRoot)
+
+	// Seed property defaults from the WithDefaultsFrom instance, resolving the
+	// produced root schema to the object that carries the properties (through a
+	// nullable wrapper, or to the $defs body of a recursive root).
+	if g.defaultsFromSet {
+		err := g.applyInstanceDefaults(g.defaultsFrom, rootType, g.rootDefaultsTarget(schema, root))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if g.rootTitle {
+		g.titleRoot(schema, root, g.schemaName(rootType))
 	}
 
+	return schema, root, reached, nil
+}
+
+// renderRootNode renders a built root node, returning the rendered root, its
+// final node, and the def entries it reaches, each rendered. The root is
+// inlined when inlineRoot is set and its def is reached from nowhere else.
+func (g *generator) renderRootNode(root *node, inlineRoot bool) (*Schema, *node, []*defEntry) {
 	// Assign final $defs names (disambiguating collisions) before render emits
 	// any $ref string. Names are keyed on defEntry identity, so reachability and
 	// root inlining below key on identity too and need no renamed-entry lookup.
@@ -157,7 +245,9 @@ func (g *generator) generate(t reflect.Type) (*Schema, error) {
 
 	// Inline a root $ref whose def is reached from nowhere else; a self- or
 	// mutually recursive root keeps its $ref so those references never dangle.
-	root = g.maybeInlineRoot(root)
+	if inlineRoot {
+		root = g.maybeInlineRoot(root)
+	}
 
 	schema := g.render(root)
 
@@ -168,45 +258,19 @@ func (g *generator) generate(t reflect.Type) (*Schema, error) {
 		g.renderDef(e)
 	}
 
-	// Seed property defaults from the WithDefaultsFrom instance, resolving the
-	// produced root schema to the object that carries the properties (through a
-	// nullable wrapper, or to the $defs body of a recursive root).
-	if g.defaultsFromSet {
-		err := g.applyInstanceDefaults(g.defaultsFrom, rootType, g.rootDefaultsTarget(schema, root))
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// Set the root title from the type name when WithRootTitle is enabled and
-	// nothing else (WithTypeSchema, JSONSchemaProvider, an extender, or tags)
-	// supplied one. Unnamed roots produce an empty name even after the
-	// empty-answer deferral to the default namer, and stay untitled.
-	if g.rootTitle {
-		target := g.rootTitleTarget(schema, root)
-		if name := g.schemaName(rootType); name != "" && target.Title == "" {
-			target.Title = name
-		}
-	}
-
-	// Set $schema on root.
-	schema.Schema = g.draft.schemaURI()
-
-	// Attach $defs if any.
-	if len(reached) > 0 {
-		defs := make(map[string]*Schema, len(reached))
-		for _, e := range reached {
-			defs[e.name] = e.rendered
-		}
+	return schema, root, reached
+}
 
-		if g.profile.definitionsKeyword {
-			schema.Definitions = defs
-		} else {
-			schema.Defs = defs
-		}
+// titleRoot sets the root title to name, the root type's definition name,
+// for WithRootTitle, unless something else (WithTypeSchema,
+// JSONSchemaProvider, an extender, or tags) supplied one. Unnamed roots
+// produce an empty name even after the empty-answer deferral to the default
+// namer, and stay untitled.
+func (g *generator) titleRoot(schema *Schema, root *node, name string) {
+	target := g.rootTitleTarget(schema, root)
+	if name != "" && target.Title == "" {
+		target.Title = name
 	}
-
-	return schema, nil
 }
 
 // rootDefaultsTarget resolves the schema that WithDefaultsFrom seeds. A
@@ -299,11 +363,28 @@ func (g *generator) schemaForType(t reflect.Type, nullable bool) (*node, error)
 		return g.handleProviderType(t, nullable)
 	}
 
+	// Encoding/json/v2 gives a duration no default encoding; only a format
+	// option on the field chooses one.
+	if t == typeDuration && g.jsonv2Values() {
+		return nil, fmt.Errorf("%w: %s has no default encoding/json/v2 representation; give the field a format",
+			ErrUnsupportedType, t)
+	}
+
 	// 3. Built-in overrides.
 	if s, ok := g.builtinOverride(t); ok {
 		return g.handleBuiltinType(t, s, nullable)
 	}
 
+	// Under yaml's value rules MarshalYAML and then MarshalText decide the
+	// encoding and MarshalJSON is never consulted, so steps 4 and 5 are
+	// encoding/json's alone.
+	yamlValues := g.yamlValues()
+	if yamlValues {
+		if s, ok := yamlMethodSchema(t); ok {
+			return g.handleBuiltinType(t, s, nullable)
+		}
+	}
+
 	// 4. Marshaler methods promoted from an embedded field. A struct whose
 	// method set includes a promoted MarshalJSON or MarshalText is serialized
 	// by that method. Encoding/json resolves marshalers through the method set,
@@ -315,11 +396,11 @@ func (g *generator) schemaForType(t reflect.Type, nullable bool) (*node, error)
 	// here: per the documented resolution priority it falls through to
 	// kind-based reflection, and WithTypeSchema or JSONSchemaProvider is the
 	// escape hatch for its real shape.
-	if reflectkind.IsPromotedJSONMarshaler(t) {
+	if !yamlValues && reflectkind.IsPromotedJSONMarshaler(t) {
 		return g.handleBuiltinType(t, &Schema{}, nullable)
 	}
 
-	if reflectkind.IsPromotedTextMarshaler(t) && !reflectkind.ImplementsJSONMarshaler(t) {
+	if !yamlValues && reflectkind.IsPromotedTextMarshaler(t) && !reflectkind.ImplementsJSONMarshaler(t) {
 		return g.handleBuiltinType(t, &Schema{Type: typename.String}, nullable)
 	}
 
@@ -331,7 +412,7 @@ func (g *generator) schemaForType(t reflect.Type, nullable bool) (*node, error)
 	// output. Such a type falls through to kind-based reflection like any
 	// other direct json.Marshaler, mirroring the guard on step 4's promoted
 	// TextMarshaler branch.
-	if reflectkind.IsDirectTextMarshaler(t) && !reflectkind.ImplementsJSONMarshaler(t) {
+	if !yamlValues && reflectkind.IsDirectTextMarshaler(t) && !reflectkind.ImplementsJSONMarshaler(t) {
 		s := &Schema{Type: typename.String}
 		return g.handleBuiltinType(t, s, nullable)
 	}
@@ -341,8 +422,11 @@ func (g *generator) schemaForType(t reflect.Type, nullable bool) (*node, error)
 	// bound through schemaForKind. Tracking the type on the visiting stack lets a
 	// re-entry link to its def entry, breaking the cycle exactly as
 	// schemaForStruct does for self-referential structs. Struct types run their
-	// own equivalent guard inside schemaForStruct, so they are excluded here.
-	guarded := t.Kind() != reflect.Struct && t.Name() != "" && reflectkind.IsRecursiveContainerKind(t.Kind())
+	// own equivalent guard inside schemaForStruct, so they are excluded here. A
+	// registered union is guarded too: a variant holding the interface (an
+	// expression tree's operands) reaches the union again.
+	guarded := t.Kind() != reflect.Struct && t.Name() != "" &&
+		(reflectkind.IsRecursiveContainerKind(t.Kind()) || g.unions[t] != nil)
 	if guarded {
 		if g.visiting[t] {
 			return g.refNode(g.newDefEntry(t), nullable), nil
@@ -719,6 +803,18 @@ func (g *generator) byteSliceNode() *node {
 // [generator.byteSliceNode] on the slice reflection path so both an exact []byte
 // and a named byte-slice type share one encoding.
 func (g *generator) builtinOverride(t reflect.Type) (*Schema, bool) {
+	if g.yamlValues() {
+		if s, ok := yamlBuiltinOverride(t); ok {
+			return s, true
+		}
+
+		// These three are encoding/json's: yaml.v3 encodes them by their
+		// methods and kinds instead.
+		if t == typeSlogLevel || t == typeJSONRawMessage || t == typeJSONNumber {
+			return nil, false
+		}
+	}
+
 	switch t {
 	case typeTime:
 		return &Schema{Type: typename.String, Format: formatDateTime}, true
@@ -765,16 +861,59 @@ func (g *generator) scalarNode(payload *Schema, nullable bool) *node {
 
 // schemaForKind handles the kind-based reflection step, producing a node.
 func (g *generator) schemaForKind(t reflect.Type, nullable bool) (*node, error) {
+	if s, ok := scalarKindSchema(t.Kind()); ok {
+		return g.scalarNode(s, nullable), nil
+	}
+
 	switch t.Kind() {
+	case reflect.Interface:
+		if spec, ok := g.unions[t]; ok {
+			n, err := g.schemaForUnion(spec)
+			if err != nil {
+				return nil, err
+			}
+
+			n.nullable = nullable
+
+			return n, nil
+		}
+
+		return g.scalarNode(&Schema{}, nullable), nil
+
+	case reflect.Slice:
+		return g.schemaForSlice(t, nullable)
+
+	case reflect.Array:
+		return g.schemaForArray(t, nullable)
+
+	case reflect.Map:
+		return g.schemaForMap(t, nullable)
+
+	case reflect.Struct:
+		return g.schemaForStruct(t, nullable)
+
+	default:
+		// Func, chan, complex, and unsafe.Pointer have no JSON Schema
+		// representation; encoding/json cannot marshal them either.
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, t)
+	}
+}
+
+// scalarKindSchema returns the schema of a scalar kind: a boolean, a string,
+// or a number, with the bounds the kind's width names exactly. It reports false
+// for every composite or unsupported kind. Both the reflection and the static
+// builders derive their scalars here.
+func scalarKindSchema(k reflect.Kind) (*Schema, bool) {
+	switch k {
 	case reflect.Bool:
-		return g.scalarNode(&Schema{Type: typename.Boolean}, nullable), nil
+		return &Schema{Type: typename.Boolean}, true
 
 	case reflect.String:
-		return g.scalarNode(&Schema{Type: typename.String}, nullable), nil
+		return &Schema{Type: typename.String}, true
 
 	case reflect.Int:
 		// Plain int is platform-dependent (32 or 64 bit), so leave it unbounded.
-		return g.scalarNode(&Schema{Type: typename.Integer}, nullable), nil
+		return &Schema{Type: typename.Integer}, true
 
 	case reflect.Int64:
 		// Float64 has a 52-bit mantissa and cannot represent MaxInt64 (2^63-1)
@@ -789,48 +928,31 @@ func (g *generator) schemaForKind(t reflect.Type, nullable bool) (*node, error)
 			ExclusiveMaximum: new(exclusiveMaxInt64),
 		}
 
-		return g.scalarNode(s, nullable), nil
+		return s, true
 
 	case reflect.Int8, reflect.Int16, reflect.Int32,
 		reflect.Uint8, reflect.Uint16, reflect.Uint32:
 		// Fixed-width integers whose full range float64 can name inclusively.
-		b := inclusiveIntBounds[t.Kind()]
-		return g.scalarNode(boundedInteger(b[0], b[1]), nullable), nil
+		b := inclusiveIntBounds[k]
+		return boundedInteger(b[0], b[1]), true
 
 	case reflect.Uint, reflect.Uintptr:
 		// Uint/uintptr are platform-dependent; only a lower bound is certain.
 		s := &Schema{Type: typename.Integer, Minimum: new(float64(0))}
-		return g.scalarNode(s, nullable), nil
+		return s, true
 
 	case reflect.Uint64:
 		// Float64 cannot represent MaxUint64 (2^64-1) exactly; see the Int64 case.
 		// 2^64 is exactly representable, so an exclusive maximum of 2^64 admits
 		// exactly v <= 2^64-1 = MaxUint64, including the boundary value.
 		s := &Schema{Type: typename.Integer, Minimum: new(float64(0)), ExclusiveMaximum: new(exclusiveMaxUint64)}
-		return g.scalarNode(s, nullable), nil
+		return s, true
 
 	case reflect.Float32, reflect.Float64:
-		return g.scalarNode(&Schema{Type: typename.Number}, nullable), nil
-
-	case reflect.Interface:
-		return g.scalarNode(&Schema{}, nullable), nil
-
-	case reflect.Slice:
-		return g.schemaForSlice(t, nullable)
-
-	case reflect.Array:
-		return g.schemaForArray(t, nullable)
-
-	case reflect.Map:
-		return g.schemaForMap(t, nullable)
-
-	case reflect.Struct:
-		return g.schemaForStruct(t, nullable)
+		return &Schema{Type: typename.Number}, true
 
 	default:
-		// Func, chan, complex, and unsafe.Pointer have no JSON Schema
-		// representation; encoding/json cannot marshal them either.
-		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, t)
+		return nil, false
 	}
 }
 
@@ -852,13 +974,22 @@ func (g *generator) schemaForSlice(t reflect.Type, nullable bool) (*node, error)
 	// (uint8) drives this, not the slice's exact type, so named byte-slice types
 	// (type Bytes []byte) and slices of named uint8 elements are base64 too,
 	// with the marshaler-bearing-element exception the predicate carries.
-	if reflectkind.IsBase64ByteSlice(t) {
+	// Encoding/json/v2 encodes only unnamed byte elements as base64, and a nil
+	// slice as the empty string.
+	switch {
+	case g.jsonv2Values():
+		if fieldname.IsJSONv2Bytes(t) {
+			return g.jsonv2BytesNode(t, nullable), nil
+		}
+	case reflectkind.IsBase64ByteSlice(t) && !g.yamlValues():
 		return g.byteSliceNode(), nil
 	}
 
 	// A slice is nil-able in Go, so it folds g.nullable into the node itself,
 	// independent of the threaded flag: a bare non-pointer []int field still
-	// produces the ["null","array"] type list.
+	// produces the ["null","array"] type list. Under yaml's and
+	// encoding/json/v2's value rules a nil slice is an empty sequence, so only
+	// a pointer to it is null.
 	items, err := g.schemaForType(t.Elem(), false)
 	if err != nil {
 		return nil, fmt.Errorf("element type: %w", err)
@@ -868,7 +999,7 @@ func (g *generator) schemaForSlice(t reflect.Type, nullable bool) (*node, error)
 		kind:     kindList,
 		payload:  &Schema{Items: items.payload},
 		items:    items,
-		nullable: g.nullable,
+		nullable: nullable || g.nullContainers(),
 		base:     typename.Array,
 	}, nil
 }
@@ -879,6 +1010,10 @@ func (g *generator) schemaForSlice(t reflect.Type, nullable bool) (*node, error)
 // generated independently so the result is a tree (no shared sub-schema
 // pointers), which the validator requires.
 func (g *generator) schemaForArray(t reflect.Type, nullable bool) (*node, error) {
+	if g.jsonv2Values() && fieldname.IsJSONv2Bytes(t) {
+		return g.jsonv2BytesNode(t, nullable), nil
+	}
+
 	n := t.Len()
 
 	prefix := make([]*node, n)
@@ -919,21 +1054,97 @@ func (g *generator) schemaForMap(t reflect.Type, nullable bool) (*node, error) {
 	}
 
 	// A map is nil-able in Go, so like a slice it folds g.nullable into the node
-	// itself, independent of the threaded flag.
+	// itself, independent of the threaded flag (and, like a slice, is null only
+	// through a pointer under yaml's and encoding/json/v2's value rules).
 	val, err := g.schemaForType(t.Elem(), false)
 	if err != nil {
 		return nil, fmt.Errorf("map value type: %w", err)
 	}
 
+	keys, err := g.mapKeyNode(t.Key())
+	if err != nil {
+		return nil, fmt.Errorf("map key type: %w", err)
+	}
+
+	payload := &Schema{AdditionalProperties: val.payload}
+	if !schemashape.IsEmpty(keys.payload) {
+		payload.PropertyNames = keys.payload
+	}
+
 	return &node{
 		kind:     kindMap,
-		payload:  &Schema{AdditionalProperties: val.payload},
+		payload:  payload,
 		items:    val,
-		nullable: g.nullable,
+		keys:     keys,
+		nullable: nullable || g.nullContainers(),
 		base:     typename.Object,
 	}, nil
 }
 
+// mapKeyNode builds the node for a map's keys, which the encoder writes as
+// property names: a string kind as itself (encoding/json checks the kind
+// before any method), a TextMarshaler as its text, and an integer as its
+// decimal digits. A string key leaves the names unconstrained, so its payload
+// is empty and renders no propertyNames unless a field-level hook constrains
+// it; the others state what an encoded name can be.
+func (g *generator) mapKeyNode(t reflect.Type) (*node, error) {
+	switch {
+	case t.Kind() == reflect.String:
+		return g.scalarNode(&Schema{}, false), nil
+
+	case t.Implements(reflectkind.TypeTextMarshaler):
+		return g.textKeyNode(t)
+
+	case numkind.IsUnsigned(t.Kind()):
+		return g.scalarNode(&Schema{Type: typename.String, Pattern: `^[0-9]+$`}, false), nil
+
+	default:
+		return g.scalarNode(&Schema{Type: typename.String, Pattern: `^-?[0-9]+$`}, false), nil
+	}
+}
+
+// textKeyNode builds the key node for a TextMarshaler key. The type's schema
+// describes its text only when something states it: a type override, a
+// provider, an extender, or a built-in string schema. A plain TextMarshaler
+// would say no more than that a name is a string, so it stays empty rather
+// than registering a $defs entry for nothing. A schema that turns out not to
+// be a string (a MarshalJSON encoding, say) describes the type's values and
+// not its text, and is dropped the same way.
+func (g *generator) textKeyNode(t reflect.Type) (*node, error) {
+	unconstrained := g.scalarNode(&Schema{}, false)
+	bare := numkind.DerefType(t)
+
+	_, overridden, err := g.resolveTypeSchema(bare)
+	if err != nil {
+		return nil, err
+	}
+
+	builtin, hasBuiltin := g.builtinOverride(bare)
+	_, defined := g.typeToDef[bare]
+
+	described := overridden || defined || implementsProvider(bare) || implementsExtender(bare) ||
+		hasBuiltin && builtin.Type == typename.String
+	if !described {
+		return unconstrained, nil
+	}
+
+	keys, err := g.schemaForType(bare, false)
+	if err != nil {
+		return nil, err
+	}
+
+	body := keys
+	if keys.kind == kindRef {
+		body = keys.def.body
+	}
+
+	if body == nil || body.payload.Type != typename.String {
+		return unconstrained, nil
+	}
+
+	return keys, nil
+}
+
 // schemaForStruct generates a schema for struct types.
 func (g *generator) schemaForStruct(t reflect.Type, nullable bool) (*node, error) {
 	// Cycle detection: even when definitions are disabled, cyclic types must
@@ -1005,13 +1216,37 @@ func (g *generator) buildStructSchema(t reflect.Type) (*node, Nullability, error
 
 	obj := &node{kind: kindObject, payload: s}
 
-	// Process fields using encoding/json rules.
+	// Process fields using encoding/json rules, or the naming dialect's.
 	//
 	// Two passes: first build every field's schema and populate Properties,
 	// then run tag interpreters. This ensures a tag interpreter observing
 	// FieldContext.Parent sees the complete sibling property set regardless of
 	// field order.
-	fields, ghostWon := g.collectStructFields(t)
+	var (
+		fields   []structFieldInfo
+		ghostWon []string
+	)
+
+	if g.naming != nil {
+		var (
+			rest *fieldname.Field
+			err  error
+		)
+
+		fields, rest, err = g.dialectFields(t)
+		if err != nil {
+			return nil, NullFromReflection, err
+		}
+
+		if rest != nil {
+			err := g.inlineRest(obj, rest)
+			if err != nil {
+				return nil, NullFromReflection, fmt.Errorf("field %s: %w", rest.Field.Name, err)
+			}
+		}
+	} else {
+		fields, ghostWon = g.collectStructFields(t)
+	}
 
 	var hasAllOf, hasShadowPartial bool
 
@@ -1043,10 +1278,23 @@ func (g *generator) buildStructSchema(t reflect.Type) (*node, Nullability, error
 		pending = append(pending, pendingField{fi: fields[idx], node: fieldNode})
 	}
 
+	// A cross-field rule reaches the other fields by Go name through
+	// FieldContext.Sibling, which sees each with the context its own
+	// interpreters get.
+	siblings := func(name string) (FieldContext, bool) {
+		for i := range pending {
+			if pending[i].fi.field.Name == name {
+				return g.fieldContext(t, pending[i].fi, pending[i].node, obj.payload), true
+			}
+		}
+
+		return FieldContext{}, false
+	}
+
 	for i := range pending {
 		pf := &pending[i]
 
-		err := g.applyFieldInterpreters(t, pf.fi, pf.node, obj)
+		err := g.applyFieldInterpreters(t, pf.fi, pf.node, obj, siblings)
 		if err != nil {
 			return nil, NullFromReflection, fmt.Errorf("field %q: %w", pf.fi.jsonName, err)
 		}
@@ -1121,11 +1369,15 @@ type structFieldInfo struct {
 	omitzero        bool
 	jsonString      bool
 	composeViaAllOf bool
+	// Format is encoding/json/v2's format option, which replaces the
+	// field's type-derived schema with the chosen encoding's.
+	format string
 	// Optional is true for an allOf-composed embed reached through a
 	// pointer-typed embedded field (directly or via an enclosing pointer
 	// embed). Encoding/json omits the embed's entire contribution when the
 	// pointer is nil, so the composed schema must not be unconditionally
-	// required. Regular fields fold this into omitempty instead.
+	// required. Regular fields fold this into omitempty as well, and keep it
+	// so [FieldContext.OmitsZero] can tell the two omissions apart.
 	optional bool
 	// Shadowed marks an allOf-composed embed at least one of whose promoted
 	// JSON names loses encoding/json's field resolution to a real field: the
@@ -1539,6 +1791,7 @@ func (g *generator) collectStructFields(t reflect.Type) ([]structFieldInfo, []st
 			omitempty:  info.Omitempty || atMin[0].optional,
 			omitzero:   info.Omitzero,
 			jsonString: info.JSONString,
+			optional:   atMin[0].optional,
 		}
 		result = append(result, sfi)
 	}
@@ -1705,7 +1958,7 @@ func (g *generator) buildFieldSchema(
 	// generating the field's own type is skipped: it would be wasted work and,
 	// for a type extracted to $defs (a provider or extender), would register an
 	// orphan definition and drop the provider's constraints.
-	stringOverride := fi.jsonString && reflectkind.IsStringableType(fieldType)
+	stringOverride := fi.jsonString && fi.format == "" && reflectkind.IsStringableType(fieldType)
 	tagTypeSchema := (*Schema)(nil)
 
 	var (
@@ -1713,27 +1966,18 @@ func (g *generator) buildFieldSchema(
 		err       error
 	)
 
-	if stringOverride {
-		// A pointer to a stringable type is a nilable container, so it shares the
-		// slice/map null-branch policy (recorded on the node, applied by render);
-		// a non-pointer is always a bare string.
-		payload := &Schema{}
-		fieldNode = &node{kind: kindValue, payload: payload}
-
-		if isPointer {
-			fieldNode.nullable = g.nullable
-			fieldNode.base = typename.String
-			tagTypeSchema = &Schema{Types: []string{typename.Null, typename.String}}
-			// Tag interpreters dispatch on FieldContext.Base; hand them the
-			// same corrected view the jsonschema tag gets, or they would
-			// classify the field at its native kind and bypass the
-			// coerced-form column (emitting a numeric const against a string
-			// schema, or an inert bound instead of the documented rejection).
-			fieldNode.tagView = tagTypeSchema
-		} else {
-			payload.Type = typename.String
+	switch {
+	case fi.format != "":
+		fieldNode, err = g.formatNode(fieldType, fi.format, fi.jsonString)
+		if err != nil {
+			return nil, err
 		}
-	} else {
+
+	case stringOverride:
+		fieldNode = g.stringOverrideNode(isPointer)
+		tagTypeSchema = fieldNode.tagView
+
+	default:
 		fieldNode, err = g.schemaForType(fieldType, false)
 		if err != nil {
 			return nil, err
@@ -1759,28 +2003,75 @@ func (g *generator) buildFieldSchema(
 	// restructures the type-derived payload (it replaces the reflected assertion),
 	// so it takes the payload directly.
 	if tag, ok := fi.field.Tag.Lookup("jsonschema"); ok {
-		res, err := tagparse.Apply(
-			tag, fieldType, fieldNode.authored, fieldNode.payload, tagTypeSchema, stringOverride)
+		fieldNode, err = applySchemaTag(tag, fieldType, fieldNode, tagTypeSchema, stringOverride)
 		if err != nil {
-			// Tagparse carries its own ErrInvalidType sentinel; map it onto the
-			// package's exported ErrInvalidType so errors.Is keeps working.
-			if errors.Is(err, tagparse.ErrInvalidType) {
-				err = fmt.Errorf("%w: %w", ErrInvalidType, err)
-			}
-
-			// Tagparse errors already carry the "jsonschema tag:" prefix.
 			return nil, err
 		}
+	}
+
+	addProperty(parent, fi, fieldNode)
+
+	return fieldNode, nil
+}
+
+// stringOverrideNode builds the node of a field whose json:",string" option
+// coerces it to a string. A pointer to a stringable type is a nilable
+// container, so it shares the slice/map null-branch policy (recorded on the
+// node, applied by render) and carries the coerced view the field-level hooks
+// dispatch on as its tagView; a non-pointer is always a bare string.
+func (g *generator) stringOverrideNode(isPointer bool) *node {
+	payload := &Schema{}
+	n := &node{kind: kindValue, payload: payload}
+
+	if isPointer {
+		n.nullable = g.nullable
+		n.base = typename.String
+		// Tag interpreters dispatch on FieldContext.Base; hand them the
+		// same corrected view the jsonschema tag gets, or they would
+		// classify the field at its native kind and bypass the
+		// coerced-form column (emitting a numeric const against a string
+		// schema, or an inert bound instead of the documented rejection).
+		n.tagView = &Schema{Types: []string{typename.Null, typename.String}}
+	} else {
+		payload.Type = typename.String
+	}
+
+	return n
+}
 
-		// A type= override replaces the field's type wholesale, so the field is
-		// now inline: it is not a reference and not nullable.
-		if res.TypeOverridden {
-			fieldNode = rebuildOverriddenField(fieldNode)
+// applySchemaTag applies a field's jsonschema struct tag to its node, parsing
+// each scalar at fieldType's kind, and returns the node, rebuilt when a type=
+// override replaced the field's type.
+func applySchemaTag(
+	tag string, fieldType reflect.Type, fieldNode *node, tagTypeSchema *Schema, stringOverride bool,
+) (*node, error) {
+	res, err := tagparse.Apply(
+		tag, fieldType, fieldNode.authored, fieldNode.payload, tagTypeSchema, stringOverride)
+	if err != nil {
+		// Tagparse carries its own ErrInvalidType sentinel; map it onto the
+		// package's exported ErrInvalidType so errors.Is keeps working.
+		if errors.Is(err, tagparse.ErrInvalidType) {
+			err = fmt.Errorf("%w: %w", ErrInvalidType, err)
 		}
+
+		// Tagparse errors already carry the "jsonschema tag:" prefix.
+		return nil, err
 	}
 
-	// Add to parent. The payload (bare) is shared into parent.Properties so a
-	// build-time interpreter sees the sibling shape; render overwrites it.
+	// A type= override replaces the field's type wholesale, so the field is
+	// now inline: it is not a reference and not nullable.
+	if res.TypeOverridden {
+		return rebuildOverriddenField(fieldNode), nil
+	}
+
+	return fieldNode, nil
+}
+
+// addProperty registers a field's node in its parent object: the payload
+// (bare) is shared into parent.Properties so a build-time interpreter sees the
+// sibling shape, which render overwrites, and a field encoding/json always
+// writes is required.
+func addProperty(parent *node, fi structFieldInfo, fieldNode *node) {
 	if parent.payload.Properties == nil {
 		parent.payload.Properties = map[string]*Schema{}
 	}
@@ -1793,8 +2084,6 @@ func (g *generator) buildFieldSchema(
 	parent.payload.Properties[fi.jsonName] = fieldNode.payload
 	parent.payload.PropertyOrder = append(parent.payload.PropertyOrder, fi.jsonName)
 	parent.props = append(parent.props, nodeProp{name: fi.jsonName, schema: fieldNode})
-
-	return fieldNode, nil
 }
 
 // rebuildOverriddenField rebuilds a field node after a type= override replaced
@@ -1856,21 +2145,59 @@ func (g *generator) fieldContext(
 		StructField: fi.field,
 		Draft:       g.draft,
 		node:        fieldNode,
+		naming:      g.naming,
+		omittable:   fi.omitempty || fi.omitzero,
+		omitsZero:   omitsZero(fi),
+	}
+}
+
+// omitsZero reports whether the marshaled object leaves the field out exactly
+// when its value is the zero value: omitzero does for every type without an
+// IsZero method, omitempty for the kinds whose empty value is their zero
+// value. A field promoted
+// through a pointer embed is also left out when the embed is nil, whatever its
+// own value.
+func omitsZero(fi structFieldInfo) bool {
+	if fi.optional {
+		return false
+	}
+
+	if fi.omitzero {
+		// An IsZero method decides omitzero in place of the Go zero value,
+		// and need not agree with it.
+		t := fi.field.Type
+
+		return !t.Implements(isZeroerType) && !reflect.PointerTo(t).Implements(isZeroerType)
+	}
+
+	if !fi.omitempty {
+		return false
+	}
+
+	switch fi.field.Type.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.Struct:
+		// Omitempty leaves out an empty non-nil slice or map, never a
+		// struct, and only a zero-length array.
+		return false
+	default:
+		return true
 	}
 }
 
-// applyFieldInterpreters runs the registered tag interpreters for a field on its
-// authored canvas. It runs after all field payloads are in place so interpreters
-// see the full parent.Properties. Const/enum placement and the Draft-07 $ref
+// applyFieldInterpreters runs the registered tag interpreters, then the field
+// interpreters, for a field on its authored canvas. It runs after all field
+// payloads are in place so interpreters see the full parent.Properties. Const/enum placement and the Draft-07 $ref
 // wrap are handled by render, from the complete graph.
 func (g *generator) applyFieldInterpreters(
 	parentType reflect.Type,
 	fi structFieldInfo,
 	fieldNode, parent *node,
+	siblings func(name string) (FieldContext, bool),
 ) error {
 	for _, reg := range g.tagInterpreters {
 		if tag, ok := fi.field.Tag.Lookup(reg.key); ok {
 			fc := g.fieldContext(parentType, fi, fieldNode, parent.payload)
+			fc.siblings = siblings
 
 			err := reg.interp.Interpret(g.ctx, fc, Tag{Key: reg.key, Value: tag})
 			if err != nil {
@@ -1879,6 +2206,16 @@ func (g *generator) applyFieldInterpreters(
 		}
 	}
 
+	for _, interp := range g.fieldInterpreters {
+		fc := g.fieldContext(parentType, fi, fieldNode, parent.payload)
+		fc.siblings = siblings
+
+		err := interp.InterpretField(g.ctx, fc)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
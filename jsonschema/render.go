@@ -52,6 +52,11 @@ func (g *generator) renderBase(n *node) *Schema {
 			n.payload.AllOf = append(n.payload.AllOf, branch)
 		}
 
+		// A map inlined under a naming dialect collects the other keys.
+		if n.items != nil {
+			n.payload.AdditionalProperties = g.render(n.items)
+		}
+
 		return n.payload
 
 	case kindList:
@@ -76,6 +81,12 @@ func (g *generator) renderBase(n *node) *Schema {
 	case kindMap:
 		n.payload.AdditionalProperties = g.render(n.items)
 
+		if n.keys != nil {
+			if keys := g.render(n.keys); !schemashape.IsEmpty(keys) {
+				n.payload.PropertyNames = keys
+			}
+		}
+
 		return n.payload
 
 	case kindRef:
@@ -83,6 +94,9 @@ func (g *generator) renderBase(n *node) *Schema {
 
 		return g.renderRef(n.payload, n.def)
 
+	case kindUnion:
+		return g.renderUnion(n)
+
 	default:
 		return n.payload
 	}
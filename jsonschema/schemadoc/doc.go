@@ -0,0 +1,65 @@
+// Package schemadoc renders a JSON Schema as reference documentation: a
+// Markdown file or a self-contained HTML page that a reader can navigate
+// without a schema-aware tool.
+//
+// It serves any [jsonschema.Schema], whether generated from Go types by the
+// jsonschema package or parsed from a file, such as a Helm chart's
+// values.schema.json that magicschema infers.
+//
+// # Usage
+//
+//	err := schemadoc.Render(w, schema, schemadoc.Markdown,
+//	    schemadoc.WithTitle("Server configuration"),
+//	)
+//
+// To render many schemas under one option set, [NewRenderer] parses the
+// templates once and returns a reusable [Renderer].
+//
+// # Layout
+//
+// The document opens with the root schema, followed by one section per
+// object the root reaches inline and one per definition ($defs, or Draft 7's
+// definitions), each under its own anchor. A section lists the schema's type,
+// default, constraints, and enum values, its oneOf and anyOf alternatives,
+// and, for an object, a property table giving each property's name, type,
+// whether it is required, default, constraints, and description. A $ref to a
+// definition links to the definition's section, and an inline object nested
+// in a property, an array's items, a map's values, or an alternative links to
+// a section of its own, named by its path from the enclosing section (for
+// example image.pullPolicy, or hosts[] for the items of hosts).
+//
+// Properties are listed in [jsonschema.Schema.PropertyOrder] order, which a
+// generated schema carries, and any others in name order. Parsing a file
+// does not set PropertyOrder; the jsonschemadoc command sets it to the order
+// the file lists the properties in.
+//
+// Enum values are paired with their descriptions from the
+// x-enum-descriptions extension the jsonschema package's enum extender
+// emits; a property's are listed after its description, under an "Allowed
+// values:" label in Markdown. A deprecated schema or property is flagged as
+// such. A pointer's nullable encoding (an anyOf or oneOf of a schema and
+// {"type": "null"}) is shown as the schema's type or null, with the
+// constraints of the non-null branch.
+// # Templates
+//
+// Each format renders through a set of named templates: text/template for
+// [Markdown] and html/template for [HTML]. [WithTemplate] parses further
+// template text over the defaults, so a {{define}} of one of the names below
+// replaces that part of the output and leaves the rest as it was:
+//
+//   - document: the whole output, executed with the [Document].
+//   - toc: the table of contents, executed with the [Document].
+//   - section: one [Section], with its heading and anchor.
+//   - details: a section's type, default, constraints, enum values,
+//     alternatives, and properties, shared by the root and the other sections.
+//   - properties: a section's property table, executed with its []Property.
+//   - alternatives: a oneOf or anyOf list, executed with its []Alternative.
+//   - enum: enum values, executed with their []EnumValue.
+//   - constraints: a constraint list, executed with its []Constraint.
+//   - type: a [Type], rendered recursively for unions, items, and values.
+//
+// Besides the template built-ins, the templates can call indent, which
+// returns two spaces per level of its argument, and, in Markdown only, cell,
+// which escapes text for a table cell, and code, which formats text as
+// inline code that may sit in one.
+package schemadoc
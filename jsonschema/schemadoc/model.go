@@ -0,0 +1,680 @@
+package schemadoc
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonptr"
+)
+
+// enumDescriptionsKeyword is the extension keyword the jsonschema package's
+// enum extender emits, carrying one description per enum value.
+const enumDescriptionsKeyword = "x-enum-descriptions"
+
+// A Document is the model the templates render: the root schema's section,
+// then every other section in reading order.
+type Document struct {
+	// Root is the root schema's section. Its Name is the document's title:
+	// the [WithTitle] option, else the root schema's title, else "Schema".
+	Root *Section
+
+	// Sections are the sections after Root: the inline objects the root
+	// reaches, then each definition in name order followed by the inline
+	// objects it reaches, each section before those nested in it.
+	Sections []*Section
+}
+
+// A Section documents one schema: the root, a definition, or an inline
+// object.
+type Section struct {
+	// Anchor is the section's fragment identifier, unique in the document.
+	Anchor string
+
+	// Name is the document's title for the root, the definition's name for a
+	// definition, and otherwise the object's path from the enclosing root or
+	// definition.
+	Name string
+
+	// Depth is the section's nesting level in the table of contents, which
+	// does not list the root: 0 for the root, a definition, and an object the
+	// root holds, and one more than the enclosing section's otherwise.
+	Depth int
+
+	// Definition reports whether the section documents a definition.
+	Definition bool
+
+	// Deprecated reports whether the schema is marked deprecated.
+	Deprecated bool
+
+	// Description is the schema's description, or its title without one.
+	Description string
+
+	// Type is the schema's type. An object's type does not link to the
+	// section itself.
+	Type Type
+
+	// Default is the schema's default as compact JSON, or "" without one.
+	Default string
+
+	Constraints []Constraint
+	Enum        []EnumValue
+
+	// OneOf and AnyOf are the schema's alternatives, except for those of a
+	// nullable encoding, which Type shows.
+	OneOf []Alternative
+	AnyOf []Alternative
+
+	Properties []Property
+}
+
+// A Property is one row of a section's property table.
+type Property struct {
+	Name        string
+	Type        Type
+	Required    bool
+	Deprecated  bool
+	Default     string
+	Description string
+	Constraints []Constraint
+	Enum        []EnumValue
+}
+
+// A Type is the type of a schema, as a name or a composition of types. It
+// renders recursively: a union as its members separated by "|", an
+// intersection as its members separated by "&", and otherwise as Name,
+// linked to Anchor when set, and qualified by Items or Values.
+type Type struct {
+	// Name is a JSON type name, "any" for a schema accepting every value,
+	// "never" for one accepting none, or a definition's name for a $ref to it.
+	// A $ref that does not target a definition is named by its value.
+	Name string
+
+	// Anchor is the anchor of the section Name links to: the definition's
+	// for a $ref, the object's own section for an inline object with
+	// properties, or "".
+	Anchor string
+
+	// Items is an array's element type, or nil when unconstrained.
+	Items *Type
+
+	// Values is the type of a map-like object's values, or nil when the
+	// object has properties or unconstrained additional properties.
+	Values *Type
+
+	// Union is the member types of a type list, a oneOf or anyOf, or a
+	// nullable encoding; Intersection is those of an allOf. A type with
+	// either set has no Name.
+	Union        []Type
+	Intersection []Type
+}
+
+// A Constraint is a validation keyword and its value, as the schema spells
+// them: minLength and 1, or pattern and ^[a-z]+$.
+type Constraint struct {
+	Keyword string
+	Value   string
+}
+
+// An EnumValue is one enum value as JSON, with its description from the
+// x-enum-descriptions extension, or "" without one.
+type EnumValue struct {
+	Value       string
+	Description string
+}
+
+// An Alternative is one branch of a oneOf or anyOf.
+type Alternative struct {
+	Type        Type
+	Description string
+}
+
+// builder assembles a [Document], assigning each section a unique anchor.
+type builder struct {
+	doc     *Document
+	anchors map[string]bool
+
+	// Defs maps each local ref to a definition, as "#/$defs/" or
+	// "#/definitions/" and the escaped name, to the type linking to it.
+	defs map[string]Type
+
+	// Sections maps each schema given a section to it, so a schema reached
+	// twice links to one section.
+	sections map[*jsonschema.Schema]*Section
+}
+
+// newDocument builds the [Document] of s under title, which falls back to the
+// schema's own title.
+func newDocument(s *jsonschema.Schema, title string) *Document {
+	b := &builder{
+		doc:      &Document{},
+		anchors:  map[string]bool{},
+		defs:     map[string]Type{},
+		sections: map[*jsonschema.Schema]*Section{},
+	}
+
+	title = cmp.Or(title, s.Title, "Schema")
+	root := &Section{Anchor: b.anchor("root"), Name: title}
+	b.doc.Root = root
+	b.defs["#"] = Type{Name: title, Anchor: root.Anchor}
+
+	// Every definition's anchor is taken before any section is built, so a
+	// $ref met anywhere links to it.
+	var defs []*Section
+
+	var defSchemas []*jsonschema.Schema
+
+	for _, group := range []struct {
+		prefix string
+		defs   map[string]*jsonschema.Schema
+	}{
+		{"#/$defs/", s.Defs},
+		{"#/definitions/", s.Definitions},
+	} {
+		for _, name := range slices.Sorted(maps.Keys(group.defs)) {
+			sec := &Section{Anchor: b.anchor(name), Name: name, Definition: true}
+			b.defs[group.prefix+jsonptr.Escape(name)] = Type{Name: name, Anchor: sec.Anchor}
+			defs = append(defs, sec)
+			defSchemas = append(defSchemas, group.defs[name])
+		}
+	}
+
+	b.fill(root, s, "", 0)
+
+	// The root's title, which a description would fall back to, already
+	// names the document.
+	if root.Description == s.Title && s.Description == "" {
+		root.Description = ""
+	}
+
+	for i, sec := range defs {
+		b.doc.Sections = append(b.doc.Sections, sec)
+		b.fill(sec, defSchemas[i], sec.Name, 1)
+	}
+
+	return b.doc
+}
+
+// anchor returns a fragment identifier for name that no other section has:
+// its lower-case letters, digits, and underscores, with every other run of
+// characters a hyphen, and a numeric suffix when taken.
+func (b *builder) anchor(name string) string {
+	var sb strings.Builder
+
+	sep := false
+
+	for _, r := range strings.ToLower(name) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			sep = true
+
+			continue
+		}
+
+		if sep && sb.Len() > 0 {
+			sb.WriteByte('-')
+		}
+
+		sep = false
+
+		sb.WriteRune(r)
+	}
+
+	base := cmp.Or(sb.String(), "section")
+
+	anchor := base
+	for i := 2; b.anchors[anchor]; i++ {
+		anchor = base + "-" + strconv.Itoa(i)
+	}
+
+	b.anchors[anchor] = true
+
+	return anchor
+}
+
+// section returns the section of the inline object s at path, building it
+// the first time s is met.
+func (b *builder) section(s *jsonschema.Schema, path string, depth int) *Section {
+	if sec, ok := b.sections[s]; ok {
+		return sec
+	}
+
+	sec := &Section{Anchor: b.anchor(path), Name: path, Depth: depth}
+	b.doc.Sections = append(b.doc.Sections, sec)
+	b.fill(sec, s, path, depth+1)
+
+	return sec
+}
+
+// fill documents s, at path, in sec, building on the way the sections of
+// the inline objects it reaches, which are at depth.
+func (b *builder) fill(sec *Section, s *jsonschema.Schema, path string, depth int) {
+	if s == nil {
+		s = &jsonschema.Schema{}
+	}
+
+	b.sections[s] = sec
+
+	body := s
+	if inner := nonNull(s); inner != nil {
+		body = inner
+		b.sections[inner] = sec
+	}
+
+	sec.Type = unlink(b.typeOf(s, path, depth), sec.Anchor)
+	sec.Deprecated = s.Deprecated || body.Deprecated
+	sec.Description = description(s, body)
+	sec.Default = defaultValue(s, body)
+	sec.Constraints = constraints(body)
+	sec.Enum = enumValues(body)
+	sec.OneOf = b.alternatives(body.OneOf, path, depth)
+	sec.AnyOf = b.alternatives(body.AnyOf, path, depth)
+
+	required := map[string]bool{}
+	for _, name := range body.Required {
+		required[name] = true
+	}
+
+	for _, name := range propertyNames(body) {
+		sec.Properties = append(sec.Properties,
+			b.property(name, body.Properties[name], required[name], join(path, name), depth))
+	}
+}
+
+// property documents the property name, whose schema is s, at path.
+func (b *builder) property(name string, s *jsonschema.Schema, required bool, path string, depth int) Property {
+	if s == nil {
+		s = &jsonschema.Schema{}
+	}
+
+	body := s
+	if inner := nonNull(s); inner != nil {
+		body = inner
+	}
+
+	return Property{
+		Name:        name,
+		Type:        b.typeOf(s, path, depth),
+		Required:    required,
+		Deprecated:  s.Deprecated || body.Deprecated,
+		Default:     defaultValue(s, body),
+		Description: description(s, body),
+		Constraints: constraints(body),
+		Enum:        enumValues(body),
+	}
+}
+
+// alternatives documents the branches of a oneOf or anyOf at path.
+func (b *builder) alternatives(branches []*jsonschema.Schema, path string, depth int) []Alternative {
+	var alts []Alternative
+
+	for i, branch := range branches {
+		if branch == nil {
+			continue
+		}
+
+		alts = append(alts, Alternative{
+			Type:        b.typeOf(branch, option(path, i), depth),
+			Description: description(branch, branch),
+		})
+	}
+
+	return alts
+}
+
+// typeOf returns the type of s, at path, building a section for each inline
+// object with properties it reaches.
+func (b *builder) typeOf(s *jsonschema.Schema, path string, depth int) Type {
+	switch {
+	case s == nil || jsonschema.IsTrueSchema(s):
+		return Type{Name: "any"}
+	case jsonschema.IsFalseSchema(s):
+		return Type{Name: "never"}
+	case s.Ref != "":
+		if t, ok := b.defs[s.Ref]; ok {
+			return t
+		}
+
+		return Type{Name: s.Ref}
+	}
+
+	if inner := nonNull(s); inner != nil {
+		return Type{Union: []Type{b.typeOf(inner, path, depth), {Name: "null"}}}
+	}
+
+	names := s.Types
+	if s.Type != "" {
+		names = []string{s.Type}
+	}
+
+	if len(names) == 0 {
+		names = impliedTypes(s)
+	}
+
+	if len(names) == 0 {
+		switch {
+		case len(s.OneOf) > 0:
+			return Type{Union: b.branchTypes(s.OneOf, path, depth)}
+		case len(s.AnyOf) > 0:
+			return Type{Union: b.branchTypes(s.AnyOf, path, depth)}
+		case len(s.AllOf) == 1:
+			return b.typeOf(s.AllOf[0], path, depth)
+		case len(s.AllOf) > 1:
+			return Type{Intersection: b.branchTypes(s.AllOf, path, depth)}
+		}
+
+		return Type{Name: "any"}
+	}
+
+	types := make([]Type, 0, len(names))
+
+	for _, name := range names {
+		t := Type{Name: name}
+
+		switch name {
+		case "object":
+			ap := s.AdditionalProperties
+
+			switch {
+			case len(s.Properties) > 0:
+				t.Anchor = b.section(s, path, depth).Anchor
+			case ap != nil && !jsonschema.IsTrueSchema(ap) && !jsonschema.IsFalseSchema(ap):
+				values := b.typeOf(ap, join(path, "*"), depth)
+				t.Values = &values
+			}
+
+		case "array":
+			if s.Items != nil {
+				items := b.typeOf(s.Items, path+"[]", depth)
+				t.Items = &items
+			}
+		}
+
+		types = append(types, t)
+	}
+
+	if len(types) == 1 {
+		return types[0]
+	}
+
+	return Type{Union: types}
+}
+
+// branchTypes returns the type of each branch of a composition at path.
+func (b *builder) branchTypes(branches []*jsonschema.Schema, path string, depth int) []Type {
+	types := make([]Type, 0, len(branches))
+	for i, branch := range branches {
+		types = append(types, b.typeOf(branch, option(path, i), depth))
+	}
+
+	return types
+}
+
+// impliedTypes returns the JSON types a schema without a type keyword
+// implies by its other keywords: object for properties, array for items, and
+// the types of its const or enum values.
+func impliedTypes(s *jsonschema.Schema) []string {
+	switch {
+	case len(s.Properties) > 0:
+		return []string{"object"}
+	case s.Items != nil || len(s.PrefixItems) > 0:
+		return []string{"array"}
+	case s.Const != nil:
+		return []string{kindOf(*s.Const)}
+	}
+
+	var names []string
+
+	for _, v := range s.Enum {
+		if kind := kindOf(v); !slices.Contains(names, kind) {
+			names = append(names, kind)
+		}
+	}
+
+	return names
+}
+
+// kindOf returns the JSON type name of a decoded JSON value.
+func kindOf(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
+
+		return "number"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return "any"
+	}
+}
+
+// nonNull returns the non-null branch of a nullable encoding, an anyOf or
+// oneOf of exactly a schema and {"type": "null"} with no other keywords, or
+// nil when s is not one.
+func nonNull(s *jsonschema.Schema) *jsonschema.Schema {
+	branches := s.AnyOf
+	if branches == nil {
+		branches = s.OneOf
+	}
+
+	if len(branches) != 2 || s.AnyOf != nil && s.OneOf != nil || s.Type != "" || s.Types != nil {
+		return nil
+	}
+
+	for i, branch := range branches {
+		other := branches[1-i]
+		if branch != nil && other != nil && isNull(branch) && !isNull(other) {
+			return other
+		}
+	}
+
+	return nil
+}
+
+// isNull reports whether s is exactly {"type": "null"}.
+func isNull(s *jsonschema.Schema) bool {
+	rest := *s
+	rest.Type = ""
+
+	return s.Type == "null" && jsonschema.IsTrueSchema(&rest)
+}
+
+// unlink returns t without links to the anchor, so a section's type does not
+// link to the section itself.
+func unlink(t Type, anchor string) Type {
+	if t.Anchor == anchor {
+		t.Anchor = ""
+	}
+
+	if t.Union != nil {
+		t.Union = slices.Clone(t.Union)
+		for i := range t.Union {
+			t.Union[i] = unlink(t.Union[i], anchor)
+		}
+	}
+
+	return t
+}
+
+// description returns the description of s, falling back to that of body,
+// the non-null branch of its nullable encoding, then to their titles.
+func description(s, body *jsonschema.Schema) string {
+	return cmp.Or(s.Description, body.Description, s.Title, body.Title)
+}
+
+// defaultValue returns the default of s, or of body, as compact JSON.
+func defaultValue(s, body *jsonschema.Schema) string {
+	switch {
+	case s.Default != nil:
+		return jsonText(s.Default)
+	case body.Default != nil:
+		return jsonText(body.Default)
+	}
+
+	return ""
+}
+
+// constraints returns the validation keywords s sets, other than its type,
+// enum, and subschemas, in a fixed order.
+func constraints(s *jsonschema.Schema) []Constraint {
+	var cs []Constraint
+
+	text := func(keyword, value string) {
+		if value != "" {
+			cs = append(cs, Constraint{Keyword: keyword, Value: value})
+		}
+	}
+	number := func(keyword string, value *float64) {
+		if value != nil {
+			text(keyword, strconv.FormatFloat(*value, 'f', -1, 64))
+		}
+	}
+	integer := func(keyword string, value *int) {
+		if value != nil {
+			text(keyword, strconv.Itoa(*value))
+		}
+	}
+	flag := func(keyword string, value bool) {
+		if value {
+			text(keyword, "true")
+		}
+	}
+
+	if s.Const != nil {
+		text("const", jsonText(*s.Const))
+	}
+
+	text("format", s.Format)
+	text("pattern", s.Pattern)
+	integer("minLength", s.MinLength)
+	integer("maxLength", s.MaxLength)
+	number("minimum", s.Minimum)
+	number("exclusiveMinimum", s.ExclusiveMinimum)
+	number("maximum", s.Maximum)
+	number("exclusiveMaximum", s.ExclusiveMaximum)
+	number("multipleOf", s.MultipleOf)
+	integer("minItems", s.MinItems)
+	integer("maxItems", s.MaxItems)
+	flag("uniqueItems", s.UniqueItems)
+	integer("minProperties", s.MinProperties)
+	integer("maxProperties", s.MaxProperties)
+
+	if jsonschema.IsFalseSchema(s.AdditionalProperties) && len(s.Properties) > 0 {
+		text("additionalProperties", "false")
+	}
+
+	text("contentEncoding", s.ContentEncoding)
+	text("contentMediaType", s.ContentMediaType)
+	flag("readOnly", s.ReadOnly)
+	flag("writeOnly", s.WriteOnly)
+
+	return cs
+}
+
+// enumValues returns the enum values of s paired with their descriptions.
+func enumValues(s *jsonschema.Schema) []EnumValue {
+	// A generated schema holds the descriptions as a []string, and a parsed
+	// one as a []any.
+	var descriptions []string
+
+	switch d := s.Extra[enumDescriptionsKeyword].(type) {
+	case []string:
+		descriptions = d
+	case []any:
+		for _, v := range d {
+			text, _ := v.(string)
+			descriptions = append(descriptions, text)
+		}
+	}
+
+	values := make([]EnumValue, 0, len(s.Enum))
+
+	for i, v := range s.Enum {
+		ev := EnumValue{Value: jsonText(v)}
+		if i < len(descriptions) {
+			ev.Description = descriptions[i]
+		}
+
+		values = append(values, ev)
+	}
+
+	return values
+}
+
+// propertyNames returns the names of the properties of s: those in its
+// PropertyOrder, in that order, then the others sorted.
+func propertyNames(s *jsonschema.Schema) []string {
+	names := make([]string, 0, len(s.Properties))
+
+	for _, name := range s.PropertyOrder {
+		if _, ok := s.Properties[name]; ok && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(s.Properties)) {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// jsonText returns v as compact JSON, with no HTML escaping, since the
+// templates escape for their own format.
+func jsonText(v any) string {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	err := enc.Encode(v)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// join returns the path of the member name of the object at path.
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+// option returns the path of branch i of the composition at path, numbered
+// from one.
+func option(path string, i int) string {
+	name := "option " + strconv.Itoa(i+1)
+	if path == "" {
+		return name
+	}
+
+	return path + " (" + name + ")"
+}
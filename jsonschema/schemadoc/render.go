@@ -0,0 +1,192 @@
+package schemadoc
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"html"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+// ErrUnknownFormat is returned by [NewRenderer] and [Render] for a [Format]
+// they do not render.
+var ErrUnknownFormat = errors.New("unknown documentation format")
+
+// A Format is an output format of a [Renderer].
+type Format string
+
+const (
+	// Markdown renders GitHub-flavored Markdown, with an HTML anchor before
+	// each section heading.
+	Markdown Format = "markdown"
+
+	// HTML renders a self-contained HTML page, its styles inline.
+	HTML Format = "html"
+)
+
+var (
+	//go:embed templates/markdown.tmpl
+	markdownTemplate string
+
+	//go:embed templates/html.tmpl
+	htmlTemplate string
+)
+
+// Option configures a [Renderer].
+type Option interface {
+	apply(r *Renderer)
+}
+
+// optionFunc adapts a function to [Option].
+type optionFunc func(*Renderer)
+
+func (f optionFunc) apply(r *Renderer) { f(r) }
+
+// WithTitle sets the document's title, in place of the root schema's title
+// or, without one, "Schema".
+func WithTitle(title string) Option {
+	return optionFunc(func(r *Renderer) { r.title = title })
+}
+
+// WithTemplate parses text over the format's default templates, so each
+// {{define}} in it replaces the default template of that name (see the
+// package documentation for the names). Several WithTemplate options parse
+// in order, a later definition replacing an earlier one.
+func WithTemplate(text string) Option {
+	return optionFunc(func(r *Renderer) { r.overrides = append(r.overrides, text) })
+}
+
+// executor is the part of a parsed text/template or html/template template
+// set a [Renderer] runs.
+type executor interface {
+	ExecuteTemplate(w io.Writer, name string, data any) error
+}
+
+// A Renderer renders schemas as documentation in one format, its templates
+// parsed once. It is safe for concurrent use.
+type Renderer struct {
+	title     string
+	overrides []string
+	tmpl      executor
+}
+
+// NewRenderer returns a [Renderer] for format with the given options
+// applied. Nil options are skipped. An unknown format returns an error
+// wrapping [ErrUnknownFormat], and a [WithTemplate] text that does not parse
+// returns the parse error.
+func NewRenderer(format Format, opts ...Option) (*Renderer, error) {
+	r := &Renderer{}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt.apply(r)
+		}
+	}
+
+	var err error
+
+	switch format {
+	case Markdown:
+		r.tmpl, err = parseText(append([]string{markdownTemplate}, r.overrides...))
+	case HTML:
+		r.tmpl, err = parseHTML(append([]string{htmlTemplate}, r.overrides...))
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Render writes the documentation of s to w. A nil s documents the schema
+// accepting every value.
+func (r *Renderer) Render(w io.Writer, s *jsonschema.Schema) error {
+	if s == nil {
+		s = &jsonschema.Schema{}
+	}
+
+	err := r.tmpl.ExecuteTemplate(w, "document", newDocument(s, r.title))
+	if err != nil {
+		return fmt.Errorf("render documentation: %w", err)
+	}
+
+	return nil
+}
+
+// Render writes the documentation of s to w in format. It is
+// [NewRenderer] followed by [Renderer.Render], for a single schema.
+func Render(w io.Writer, s *jsonschema.Schema, format Format, opts ...Option) error {
+	r, err := NewRenderer(format, opts...)
+	if err != nil {
+		return err
+	}
+
+	return r.Render(w, s)
+}
+
+// parseText parses the Markdown template texts in order.
+func parseText(texts []string) (*texttemplate.Template, error) {
+	tmpl := texttemplate.New("markdown").Funcs(texttemplate.FuncMap{
+		"cell":   markdownCell,
+		"code":   markdownCode,
+		"indent": indent,
+	})
+
+	for _, text := range texts {
+		_, err := tmpl.Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("parse template: %w", err)
+		}
+	}
+
+	return tmpl, nil
+}
+
+// parseHTML parses the HTML template texts in order.
+func parseHTML(texts []string) (*htmltemplate.Template, error) {
+	tmpl := htmltemplate.New("html").Funcs(htmltemplate.FuncMap{
+		"indent": indent,
+	})
+
+	for _, text := range texts {
+		_, err := tmpl.Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("parse template: %w", err)
+		}
+	}
+
+	return tmpl, nil
+}
+
+// markdownCellEscaper escapes the characters that end a Markdown table cell
+// or row.
+var markdownCellEscaper = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+// markdownCell escapes text for a Markdown table cell.
+func markdownCell(text string) string {
+	return markdownCellEscaper.Replace(text)
+}
+
+// markdownCode returns text as inline code: a code span, or, for text
+// holding a backtick or a |, which a code span cannot carry into a table
+// cell, an HTML code element with the | as a character reference.
+func markdownCode(text string) string {
+	if !strings.ContainsAny(text, "`|") {
+		return "`" + text + "`"
+	}
+
+	return "<code>" + strings.ReplaceAll(html.EscapeString(text), "|", "&#124;") + "</code>"
+}
+
+// indent returns two spaces per level.
+func indent(level int) string {
+	return strings.Repeat("  ", level)
+}
@@ -0,0 +1,303 @@
+package schemadoc_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha"
+	"go.jacobcolvin.com/x/jsonschema/schemadoc"
+)
+
+// configSchema exercises every part of a section: definitions, an enum with
+// descriptions, a deprecated property, a nullable encoding, a oneOf with an
+// inline object, a map, and constraints.
+const configSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"title": "Config",
+	"description": "Server configuration.",
+	"type": "object",
+	"properties": {
+		"name": {"type": "string", "minLength": 1, "pattern": "^a|b$", "description": "The name.\nSecond line."},
+		"mode": {"$ref": "#/$defs/Mode"},
+		"peers": {"type": "array", "items": {"anyOf": [{"$ref": "#/$defs/Peer"}, {"type": "null"}]}},
+		"retries": {"type": "integer", "deprecated": true, "default": 3},
+		"level": {"type": "integer", "enum": [1, 2], "x-enum-descriptions": ["Quiet."]},
+		"labels": {"type": "object", "additionalProperties": {"type": "string"}},
+		"shape": {"oneOf": [{"$ref": "#/$defs/Peer"}, {"type": "object", "properties": {"r": {"type": "number"}}}]}
+	},
+	"required": ["name"],
+	"additionalProperties": false,
+	"$defs": {
+		"Mode": {
+			"type": "string",
+			"enum": ["fast", "safe"],
+			"x-enum-descriptions": ["Skips checks.", "Runs every check."]
+		},
+		"Peer": {
+			"type": "object",
+			"description": "A cluster member.",
+			"properties": {
+				"addr": {"type": "string", "format": "hostname"},
+				"next": {"anyOf": [{"$ref": "#/$defs/Peer"}, {"type": "null"}]}
+			}
+		}
+	}
+}`
+
+func parse(t *testing.T, data string) *jsonschema.Schema {
+	t.Helper()
+
+	s, err := jsonschema.ParseSchema([]byte(data))
+	require.NoError(t, err)
+
+	return s
+}
+
+func TestRender_Markdown(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	require.NoError(t, schemadoc.Render(&buf, parse(t, configSchema), schemadoc.Markdown))
+	assert.Equal(t, `<a id="root"></a>
+
+# Config
+
+Server configuration.
+
+## Contents
+
+- [shape (option 2)](#shape-option-2)
+- [Mode](#mode)
+- [Peer](#peer)
+
+**Type:** object
+
+**Constraints:** additionalProperties: `+"`false`"+`
+
+| Property | Type | Required | Default | Constraints | Description |
+| --- | --- | --- | --- | --- | --- |
+| `+"`labels`"+` | object of string | no |  |  |  |
+| `+"`level`"+` | integer | no |  |  | Allowed values:<br>`+"`1`: Quiet.<br>`2`"+` |
+| `+"`mode`"+` | [Mode](#mode) | no |  |  |  |
+| `+"`name`"+` | string | yes |  | pattern: <code>^a&#124;b$</code>, minLength: `+"`1`"+` | The name.<br>Second line. |
+| `+"`peers`"+` | array of ([Peer](#peer) \| null) | no |  |  |  |
+| `+"`retries`"+` (deprecated) | integer | no | `+"`3`"+` |  |  |
+| `+"`shape`"+` | [Peer](#peer) \| [object](#shape-option-2) | no |  |  |  |
+
+<a id="shape-option-2"></a>
+
+## shape (option 2)
+
+**Type:** object
+
+| Property | Type | Required | Default | Constraints | Description |
+| --- | --- | --- | --- | --- | --- |
+| `+"`r`"+` | number | no |  |  |  |
+
+<a id="mode"></a>
+
+## Mode
+
+**Type:** string
+
+| Value | Description |
+| --- | --- |
+| `+"`\"fast\"`"+` | Skips checks. |
+| `+"`\"safe\"`"+` | Runs every check. |
+
+<a id="peer"></a>
+
+## Peer
+
+A cluster member.
+
+**Type:** object
+
+| Property | Type | Required | Default | Constraints | Description |
+| --- | --- | --- | --- | --- | --- |
+| `+"`addr`"+` | string | no |  | format: `+"`hostname`"+` |  |
+| `+"`next`"+` | [Peer](#peer) \| null | no |  |  |  |
+`, buf.String())
+}
+
+func TestRender_HTML(t *testing.T) {
+	t.Parallel()
+
+	s := parse(t, configSchema)
+	s.Description = "Server <b>configuration</b>."
+
+	var buf bytes.Buffer
+
+	require.NoError(t, schemadoc.Render(&buf, s, schemadoc.HTML, schemadoc.WithTitle("Server")))
+
+	out := buf.String()
+	for _, want := range []string{
+		"<title>Server</title>",
+		`<section id="peer">`,
+		`<a href="#peer">Peer</a> | null`,
+		`<li style="margin-left: 0rem"><a href="#shape-option-2">shape (option 2)</a></li>`,
+		`<td><code>retries</code> <span class="deprecated">(deprecated)</span></td>`,
+		`<li><code>1</code>: Quiet.</li>`,
+		`<tr><td><code>&#34;fast&#34;</code></td><td class="description">Skips checks.</td></tr>`,
+		`pattern: <code>^a|b$</code>, minLength: <code>1</code>`,
+		"Server &lt;b&gt;configuration&lt;/b&gt;.",
+	} {
+		assert.Contains(t, out, want)
+	}
+}
+
+func TestRender_Sections(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		schema string
+		want   []string
+	}{
+		"draft 7 definitions": {
+			schema: `{
+				"$schema": "http://json-schema.org/draft-07/schema#",
+				"properties": {"image": {"$ref": "#/definitions/Image"}},
+				"definitions": {"Image": {"type": "object", "properties": {"tag": {"type": "string"}}}}
+			}`,
+			want: []string{"| `image` | [Image](#image) |", "- [Image](#image)", "## Image"},
+		},
+		"nested inline objects": {
+			schema: `{
+				"type": "object",
+				"properties": {
+					"ingress": {
+						"type": "object",
+						"properties": {
+							"hosts": {"type": "array", "items": {"type": "object", "properties": {"host": {"type": "string"}}}}
+						}
+					},
+					"nameOverride": {"type": ["string", "null"], "description": "Override the name"}
+				}
+			}`,
+			want: []string{
+				"- [ingress](#ingress)\n  - [ingress.hosts[]](#ingress-hosts)",
+				"| `hosts` | array of [object](#ingress-hosts) |",
+				"| `nameOverride` | string \\| null | no |  |  | Override the name |",
+			},
+		},
+		"deprecated definition with anyOf": {
+			schema: `{
+				"$ref": "#/$defs/Value",
+				"$defs": {"Value": {"deprecated": true, "anyOf": [
+					{"type": "string", "description": "A name."},
+					{"type": "integer", "minimum": 0}
+				]}}
+			}`,
+			want: []string{
+				"**Type:** [Value](#value)",
+				"## Value\n\n**Deprecated.**",
+				"**Type:** string \\| integer",
+				"At least one of:\n\n- string: A name.\n- integer",
+			},
+		},
+		"anchor collisions": {
+			schema: `{
+				"properties": {"a.b": {"properties": {"x": {}}}, "a": {"properties": {"b": {"properties": {"y": {}}}}}}
+			}`,
+			want: []string{"[a.b](#a-b)", "[a.b](#a-b-2)", "| `x` | any |"},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			require.NoError(t, schemadoc.Render(&buf, parse(t, tc.schema), schemadoc.Markdown))
+
+			for _, want := range tc.want {
+				assert.Contains(t, buf.String(), want)
+			}
+		})
+	}
+}
+
+func TestRender_GeneratedSchema(t *testing.T) {
+	t.Parallel()
+
+	type peer struct {
+		Addr string `json:"addr"`
+	}
+
+	type server struct {
+		Port    int           `json:"port" jsonschema:"minimum=1"`
+		Host    *string       `json:"host,omitempty"`
+		Peers   []*peer       `json:"peers"`
+		Palette alpha.Palette `json:"palette"`
+	}
+
+	s, err := jsonschema.GenerateFor[server](t.Context(),
+		jsonschema.WithTypeSchemaExtender(jsonschema.NewGoCommentProvider().Enums()),
+	)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, schemadoc.Render(&buf, s, schemadoc.Markdown))
+
+	out := buf.String()
+
+	// Properties follow the struct's field order, not their names'.
+	assert.Less(t, strings.Index(out, "| `port`"), strings.Index(out, "| `host`"))
+
+	for _, want := range []string{
+		"| `port` | integer | yes |  | minimum: `1` |",
+		"| `host` | string \\| null | no |",
+		"| `peers` | null \\| array of ([peer](#peer) \\| null) | yes |",
+		"| `phase` | string | yes |  |  | Allowed values:<br>`\"Pending\"`: PhasePending documents the pending phase.<br>",
+	} {
+		assert.Contains(t, out, want)
+	}
+}
+
+func TestRender_TemplateOverride(t *testing.T) {
+	t.Parallel()
+
+	r, err := schemadoc.NewRenderer(schemadoc.Markdown,
+		schemadoc.WithTemplate(`{{define "properties"}}{{range $i, $p := .}}{{if $i}}{{"\n"}}{{end}}`+
+			`* {{$p.Name}}: {{template "type" $p.Type}}{{end}}{{end}}`),
+		schemadoc.WithTemplate(`{{define "constraints"}}{{len .}} constraints{{end}}`),
+	)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, r.Render(&buf, parse(t, `{
+		"title": "T",
+		"minProperties": 1,
+		"properties": {"a": {"type": "string"}, "b": {"type": "array", "items": {"type": "integer"}}}
+	}`)))
+	assert.Equal(t, `<a id="root"></a>
+
+# T
+
+**Type:** object
+
+**Constraints:** 1 constraints
+
+* a: string
+* b: array of integer
+`, buf.String())
+}
+
+func TestNewRenderer_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, err := schemadoc.NewRenderer("pdf")
+	require.ErrorIs(t, err, schemadoc.ErrUnknownFormat)
+
+	_, err = schemadoc.NewRenderer(schemadoc.HTML, schemadoc.WithTemplate(`{{define "type"}}{{.Name}`))
+	require.ErrorContains(t, err, "parse template")
+}
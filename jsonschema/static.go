@@ -0,0 +1,875 @@
+package jsonschema
+
+import (
+	"context"
+	"fmt"
+	"go/types"
+	"reflect"
+	"slices"
+	"strings"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonptr"
+	"go.jacobcolvin.com/x/jsonschema/internal/jsontag"
+	"go.jacobcolvin.com/x/jsonschema/internal/reflectkind"
+	"go.jacobcolvin.com/x/jsonschema/internal/typename"
+)
+
+var (
+	// StaticBuiltins maps the "importpath.Name" of each type with a built-in
+	// override to its [reflect.Type], so the static path reaches the same
+	// override (and the same $defs entry) as reflection.
+	staticBuiltins = func() map[string]reflect.Type {
+		m := map[string]reflect.Type{}
+		for _, t := range []reflect.Type{
+			typeTime, typeSlogLevel, typeJSONRawMessage, typeJSONNumber, typeBigInt, typeBigRat, typeBigFloat,
+		} {
+			m[t.PkgPath()+"."+t.Name()] = t
+		}
+
+		return m
+	}()
+
+	// StaticBasics maps each basic kind with a reflect counterpart to the
+	// predeclared type of that kind.
+	staticBasics = map[types.BasicKind]reflect.Type{
+		types.Bool:       reflect.TypeFor[bool](),
+		types.Int:        reflect.TypeFor[int](),
+		types.Int8:       reflect.TypeFor[int8](),
+		types.Int16:      reflect.TypeFor[int16](),
+		types.Int32:      reflect.TypeFor[int32](),
+		types.Int64:      reflect.TypeFor[int64](),
+		types.Uint:       reflect.TypeFor[uint](),
+		types.Uint8:      reflect.TypeFor[uint8](),
+		types.Uint16:     reflect.TypeFor[uint16](),
+		types.Uint32:     reflect.TypeFor[uint32](),
+		types.Uint64:     reflect.TypeFor[uint64](),
+		types.Uintptr:    reflect.TypeFor[uintptr](),
+		types.Float32:    reflect.TypeFor[float32](),
+		types.Float64:    reflect.TypeFor[float64](),
+		types.Complex64:  reflect.TypeFor[complex64](),
+		types.Complex128: reflect.TypeFor[complex128](),
+		types.String:     reflect.TypeFor[string](),
+	}
+
+	// StaticInterceptors are the methods through which a type's own code
+	// decides its schema or its encoding. Reflection calls or honors them;
+	// the static path cannot, so a type whose method set holds one is
+	// reported as [ErrStaticUnsupported].
+	staticInterceptors = []string{"JSONSchema", "JSONSchemaExtend", "MarshalJSON", "MarshalText"}
+
+	typeAny         = reflect.TypeFor[any]()
+	typeEmptyStruct = reflect.TypeFor[struct{}]()
+)
+
+// GenerateStatic generates a JSON Schema for a type known through go/types
+// rather than [reflect.Type], such as one loaded by
+// golang.org/x/tools/go/packages from source that cannot be compiled into the
+// running program. It builds the same IR as [Generate] and follows the same
+// encoding/json rules, so for a plain data type both produce identical
+// schemas.
+//
+// Nothing of the type's own code runs: a type whose method set holds
+// JSONSchema, JSONSchemaExtend, MarshalJSON, or MarshalText, a generic
+// instantiation, and a named pointer type are reported as
+// [ErrStaticUnsupported], and so is every option that consults a Go value
+// ([WithTypeSchema], providers, extenders, interpreters, a description
+// provider, a custom [Namer], [WithDefaultsFrom], [WithGenericDefinitions],
+// [WithUnion], and [WithFieldNaming]). The built-in overrides (time.Time,
+// slog.Level, json.RawMessage, json.Number, and the math/big types) apply as
+// they do under reflection.
+func GenerateStatic(ctx context.Context, t types.Type, opts ...GenerateOption) (*Schema, error) {
+	g := newGenerator(opts)
+
+	err := g.checkStatic()
+	if err != nil {
+		return nil, err
+	}
+
+	return newStaticBuilder(g.forRun(ctx)).generate(t)
+}
+
+// checkStatic reports the first configured option the static path cannot
+// honor: each hands a [reflect.Type] or a Go value to caller code.
+func (g *generator) checkStatic() error {
+	for _, o := range []struct {
+		name string
+		set  bool
+	}{
+		{"WithTypeSchema or WithTypeSchemaProvider", len(g.typeProviders) > 0},
+		{"WithTypeSchemaExtender", len(g.typeExtenders) > 0},
+		{"WithFieldInterpreter", len(g.fieldInterpreters) > 0},
+		{"WithTagInterpreter", len(g.tagInterpreters) > 0},
+		{"WithDescriptionProvider", g.descriptionProvider != nil},
+		{"WithNamer", g.customNamer},
+		{"WithDefaultsFrom", g.defaultsFromSet},
+		{"WithGenericDefinitions", g.genericBase != ""},
+		{"WithUnion", len(g.unions) > 0},
+		{"WithFieldNaming", g.naming != nil},
+	} {
+		if o.set {
+			return fmt.Errorf("%w: option %s", ErrStaticUnsupported, o.name)
+		}
+	}
+
+	return nil
+}
+
+// staticBuilder builds the generation IR from go/types. It mirrors the
+// reflection builders in reflect.go step for step, minus the steps that run
+// caller code, and shares the generator's def list, render, and document
+// assembly. A type with a built-in override is resolved to its real
+// [reflect.Type] and built by the reflection path itself.
+type staticBuilder struct {
+	g *generator
+	// Defs maps each named type registered in $defs to its entry, as the
+	// generator's typeToDef does for reflected types.
+	defs map[*types.TypeName]*defEntry
+	// Visiting holds the named types mid-build, for cycle detection.
+	visiting map[*types.TypeName]bool
+	// Shaping holds the named types mid-[staticBuilder.shape], so a
+	// recursive type's shape terminates.
+	shaping map[*types.TypeName]bool
+}
+
+// staticField is a collected struct field and its go/types type. The
+// field's reflect.StructField carries the type's shape (see
+// [staticBuilder.shape]).
+type staticField struct {
+	typ  types.Type
+	info structFieldInfo
+}
+
+// staticEmbed is a struct type queued by [staticBuilder.collectStructFields]
+// for the next depth.
+type staticEmbed struct {
+	typ      types.Type
+	index    []int
+	optional bool
+}
+
+func newStaticBuilder(g *generator) *staticBuilder {
+	return &staticBuilder{
+		g:        g,
+		defs:     map[*types.TypeName]*defEntry{},
+		visiting: map[*types.TypeName]bool{},
+		shaping:  map[*types.TypeName]bool{},
+	}
+}
+
+// generate produces the root schema for t, as [generator.generate] does.
+func (b *staticBuilder) generate(t types.Type) (*Schema, error) {
+	if t == nil {
+		return nil, fmt.Errorf("%w: nil type", ErrUnsupportedType)
+	}
+
+	root, err := b.schemaForType(t, false)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, root, reached := b.g.renderRootNode(root, true)
+
+	if b.g.rootTitle {
+		b.g.titleRoot(schema, root, b.rootName(t))
+	}
+
+	return b.g.attachDefs(schema, reached)
+}
+
+// rootName returns the definition name of the root type after its pointers,
+// which titles the root under [WithRootTitle]: the default namer's answer,
+// or the predeclared name reflection reports for a basic type.
+func (b *staticBuilder) rootName(t types.Type) string {
+	t = types.Unalias(t)
+	for {
+		p, ok := t.(*types.Pointer)
+		if !ok {
+			break
+		}
+
+		t = types.Unalias(p.Elem())
+	}
+
+	if rt, ok := staticBuiltin(t); ok {
+		return b.g.schemaName(rt)
+	}
+
+	switch t := t.(type) {
+	case *types.Named:
+		return jsonptr.SafeToken(t.Obj().Name())
+	case *types.Basic:
+		if rt, ok := staticBasics[t.Kind()]; ok {
+			return rt.Name()
+		}
+	}
+
+	return ""
+}
+
+// newDefEntry registers a placeholder $defs entry for the named type obj,
+// returning the existing entry on a re-entry, as [generator.newDefEntry]
+// does. The entry carries no [reflect.Type].
+func (b *staticBuilder) newDefEntry(obj *types.TypeName) *defEntry {
+	if e, ok := b.defs[obj]; ok {
+		return e
+	}
+
+	e := &defEntry{baseName: jsonptr.SafeToken(obj.Name())}
+	if obj.Pkg() != nil {
+		e.pkgPath = obj.Pkg().Path()
+	}
+
+	b.defs[obj] = e
+	b.g.defs = append(b.g.defs, e)
+
+	return e
+}
+
+// schemaForType produces the IR node for t; see [generator.schemaForType].
+func (b *staticBuilder) schemaForType(t types.Type, nullable bool) (*node, error) {
+	g := b.g
+
+	// Follow pointers. A pointer at any level makes the schema nullable.
+	t = types.Unalias(t)
+	for {
+		p, ok := t.(*types.Pointer)
+		if !ok {
+			break
+		}
+
+		nullable = g.nullable
+		t = types.Unalias(p.Elem())
+	}
+
+	if types.IsInterface(t) {
+		nullable = g.nullable
+	}
+
+	obj, err := staticTypeName(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj != nil {
+		if e, exists := b.defs[obj]; exists {
+			return g.refNode(e, nullable), nil
+		}
+	}
+
+	// Built-in overrides resolve to the real type, so they share the
+	// reflection path's schema and $defs entry.
+	if rt, ok := staticBuiltin(t); ok {
+		s, _ := g.builtinOverride(rt)
+		return g.handleBuiltinType(rt, s, nullable)
+	}
+
+	err = checkInterceptors(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := t.Underlying().(*types.Struct); ok {
+		return b.schemaForStruct(t, obj, nullable)
+	}
+
+	// Cycle detection for named container types, as in reflection's step 6.
+	// Without providers, extenders, or unions, a named non-struct type is
+	// never extracted, so only a guarded type is built bare.
+	guarded := obj != nil && isStaticContainer(t)
+	if guarded {
+		if b.visiting[obj] {
+			return g.refNode(b.newDefEntry(obj), nullable), nil
+		}
+
+		b.visiting[obj] = true
+	}
+
+	kindNullable := nullable
+	if guarded {
+		kindNullable = false
+	}
+
+	n, err := b.schemaForKind(t, kindNullable)
+	if guarded {
+		delete(b.visiting, obj)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if obj != nil {
+		if e, cyclic := b.defs[obj]; cyclic {
+			if e.body == nil {
+				e.body = n
+			}
+
+			return g.refNode(e, nullable), nil
+		}
+	}
+
+	if guarded && !n.nilableContainer() {
+		n.nullable = nullable
+	}
+
+	return n, nil
+}
+
+// schemaForKind handles the kind-based step; see [generator.schemaForKind].
+func (b *staticBuilder) schemaForKind(t types.Type, nullable bool) (*node, error) {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		if rt, ok := staticBasics[u.Kind()]; ok {
+			if s, ok := scalarKindSchema(rt.Kind()); ok {
+				return b.g.scalarNode(s, nullable), nil
+			}
+		}
+
+	case *types.Interface:
+		return b.g.scalarNode(&Schema{}, nullable), nil
+
+	case *types.Slice:
+		return b.schemaForSlice(u, nullable)
+
+	case *types.Array:
+		return b.schemaForArray(u, nullable)
+
+	case *types.Map:
+		return b.schemaForMap(u, nullable)
+	}
+
+	// Func, chan, complex, and unsafe.Pointer have no JSON Schema
+	// representation; encoding/json cannot marshal them either.
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, t)
+}
+
+// schemaForSlice generates a slice's node; see [generator.schemaForSlice].
+func (b *staticBuilder) schemaForSlice(t *types.Slice, nullable bool) (*node, error) {
+	if isStaticBase64Elem(t.Elem()) {
+		return b.g.byteSliceNode(), nil
+	}
+
+	items, err := b.schemaForType(t.Elem(), false)
+	if err != nil {
+		return nil, fmt.Errorf("element type: %w", err)
+	}
+
+	return &node{
+		kind:     kindList,
+		payload:  &Schema{Items: items.payload},
+		items:    items,
+		nullable: nullable || b.g.nullContainers(),
+		base:     typename.Array,
+	}, nil
+}
+
+// schemaForArray generates a fixed-length array's tuple node; see
+// [generator.schemaForArray].
+func (b *staticBuilder) schemaForArray(t *types.Array, nullable bool) (*node, error) {
+	n := int(t.Len())
+
+	prefix := make([]*node, n)
+	elems := make([]*Schema, n)
+
+	for i := range prefix {
+		item, err := b.schemaForType(t.Elem(), false)
+		if err != nil {
+			return nil, fmt.Errorf("element type: %w", err)
+		}
+
+		prefix[i] = item
+		elems[i] = item.payload
+	}
+
+	s := &Schema{
+		Type:     typename.Array,
+		MinItems: new(n),
+		MaxItems: new(n),
+	}
+	if !b.g.profile.prefixItemsTuple {
+		s.ItemsArray = elems
+	} else {
+		s.PrefixItems = elems
+	}
+
+	return &node{kind: kindTuple, payload: s, prefix: prefix, nullable: nullable}, nil
+}
+
+// schemaForMap generates a map's node; see [generator.schemaForMap]. A
+// TextMarshaler key writes its names through its own code, so it is not
+// supported.
+func (b *staticBuilder) schemaForMap(t *types.Map, nullable bool) (*node, error) {
+	key := types.Unalias(t.Key())
+
+	var pattern string
+
+	// Encoding/json checks a string kind before any method, and reflection
+	// builds the key node in the same order.
+	switch k, _ := key.Underlying().(*types.Basic); {
+	case k != nil && k.Info()&types.IsString != 0:
+	case types.NewMethodSet(key).Lookup(nil, "MarshalText") != nil:
+		return nil, fmt.Errorf("%w: map key type %s has a MarshalText method", ErrStaticUnsupported, key)
+	case k == nil:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedMapKey, key)
+	case k.Info()&types.IsUnsigned != 0:
+		pattern = `^[0-9]+$`
+	case k.Info()&types.IsInteger != 0:
+		pattern = `^-?[0-9]+$`
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedMapKey, key)
+	}
+
+	val, err := b.schemaForType(t.Elem(), false)
+	if err != nil {
+		return nil, fmt.Errorf("map value type: %w", err)
+	}
+
+	keys := b.g.scalarNode(&Schema{}, false)
+	payload := &Schema{AdditionalProperties: val.payload}
+
+	if pattern != "" {
+		keys.payload = &Schema{Type: typename.String, Pattern: pattern}
+		payload.PropertyNames = keys.payload
+	}
+
+	return &node{
+		kind:     kindMap,
+		payload:  payload,
+		items:    val,
+		keys:     keys,
+		nullable: nullable || b.g.nullContainers(),
+		base:     typename.Object,
+	}, nil
+}
+
+// schemaForStruct generates a struct's node, extracting a named struct to
+// $defs; see [generator.schemaForStruct]. Obj is nil for an unnamed struct,
+// which cannot recur without a named type and so needs no cycle tracking.
+func (b *staticBuilder) schemaForStruct(t types.Type, obj *types.TypeName, nullable bool) (*node, error) {
+	g := b.g
+
+	if obj == nil {
+		n, err := b.buildStructSchema(t)
+		if err != nil {
+			return nil, err
+		}
+
+		n.nullable = nullable
+
+		return n, nil
+	}
+
+	if b.visiting[obj] {
+		return g.refNode(b.newDefEntry(obj), nullable), nil
+	}
+
+	b.visiting[obj] = true
+
+	n, err := b.buildStructSchema(t)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(b.visiting, obj)
+
+	// Extracted to $defs, or reached again while it was built (a self-reference
+	// created a placeholder entry) with definitions off.
+	e, cyclic := b.defs[obj]
+	if g.definitions || cyclic {
+		if !cyclic {
+			e = b.newDefEntry(obj)
+		}
+
+		if e.body == nil {
+			e.body = n
+		}
+
+		return g.refNode(e, nullable), nil
+	}
+
+	n.nullable = nullable
+
+	return n, nil
+}
+
+// buildStructSchema builds a struct's object node; see
+// [generator.buildStructSchema].
+func (b *staticBuilder) buildStructSchema(t types.Type) (*node, error) {
+	s := &Schema{
+		Type: typename.Object,
+	}
+
+	if !b.g.additionalProperties {
+		s.AdditionalProperties = &Schema{Not: &Schema{}}
+	}
+
+	obj := &node{kind: kindObject, payload: s}
+
+	fields, err := b.collectStructFields(t)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range fields {
+		err := b.buildFieldSchema(f, obj)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", f.info.jsonName, err)
+		}
+	}
+
+	return obj, nil
+}
+
+// buildFieldSchema generates a struct field's schema, applies the
+// json:",string" override and the jsonschema struct tag, and registers it in
+// the parent; see [generator.buildFieldSchema].
+func (b *staticBuilder) buildFieldSchema(f staticField, parent *node) error {
+	fi := f.info
+	fieldType := fi.field.Type
+
+	stringOverride := fi.jsonString && reflectkind.IsStringableType(fieldType)
+	tagTypeSchema := (*Schema)(nil)
+
+	var (
+		fieldNode *node
+		err       error
+	)
+
+	if stringOverride {
+		fieldNode = b.g.stringOverrideNode(fieldType.Kind() == reflect.Pointer)
+		tagTypeSchema = fieldNode.tagView
+	} else {
+		fieldNode, err = b.schemaForType(f.typ, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	fieldNode.isField = true
+
+	allocCanvasTree(fieldNode, b.g.draft)
+
+	if tag, ok := fi.field.Tag.Lookup("jsonschema"); ok {
+		fieldNode, err = applySchemaTag(tag, fieldType, fieldNode, tagTypeSchema, stringOverride)
+		if err != nil {
+			return err
+		}
+	}
+
+	addProperty(parent, fi, fieldNode)
+
+	return nil
+}
+
+// collectStructFields resolves a struct's fields by encoding/json's rules;
+// see [generator.collectStructFields]. Without providers no embed is composed
+// via allOf, so every embedded struct is flattened and no ghost sightings
+// arise. An embedded struct whose methods would intercept its schema or
+// encoding is reported as [ErrStaticUnsupported].
+//
+//nolint:nestif // Mirrors encoding/json's field collection logic which is inherently nested.
+func (b *staticBuilder) collectStructFields(t types.Type) ([]staticField, error) {
+	type fieldLevel struct {
+		field  staticField
+		depth  int
+		tagged bool
+	}
+
+	byName := map[string][]fieldLevel{}
+
+	var order []string
+
+	record := func(name string, fl fieldLevel, dup bool) {
+		if _, seen := byName[name]; !seen {
+			order = append(order, name)
+		}
+
+		byName[name] = append(byName[name], fl)
+		if dup {
+			byName[name] = append(byName[name], fl)
+		}
+	}
+
+	visited := map[types.Type]bool{}
+	next := []staticEmbed{{typ: t}}
+
+	var count, nextCount map[types.Type]int
+
+	for depth := 0; len(next) > 0; depth++ {
+		current := next
+		next = nil
+		count, nextCount = nextCount, map[types.Type]int{}
+
+		for _, e := range current {
+			if visited[e.typ] {
+				continue
+			}
+
+			visited[e.typ] = true
+
+			dup := count[e.typ] > 1
+
+			est, _ := e.typ.Underlying().(*types.Struct)
+
+			for i := range est.NumFields() {
+				v := est.Field(i)
+				f := b.structField(v, est.Tag(i), append(slices.Clone(e.index), i))
+				sf := staticField{typ: v.Type(), info: structFieldInfo{field: f, optional: e.optional}}
+
+				if f.Anonymous {
+					ft := types.Unalias(v.Type())
+
+					p, embeddedViaPointer := ft.(*types.Pointer)
+					if embeddedViaPointer {
+						ft = types.Unalias(p.Elem())
+					}
+
+					_, isStruct := ft.Underlying().(*types.Struct)
+
+					if !f.IsExported() && !isStruct {
+						continue
+					}
+
+					tagVal, hasTag := f.Tag.Lookup("json")
+					explicitName, _, _ := strings.Cut(tagVal, ",")
+					if hasTag && jsontag.ValidName(explicitName) {
+						info := jsontag.Parse(f)
+						if info.JSONName == "" {
+							continue // json:"-"
+						}
+
+						record(info.JSONName, fieldLevel{field: sf, depth: depth, tagged: true}, dup)
+
+						continue
+					}
+
+					if isStruct {
+						err := checkInterceptors(ft)
+						if err != nil {
+							return nil, fmt.Errorf("embedded %s: %w", ft, err)
+						}
+
+						nextCount[ft]++
+						if nextCount[ft] == 1 {
+							next = append(next, staticEmbed{
+								typ:      ft,
+								index:    f.Index,
+								optional: e.optional || embeddedViaPointer,
+							})
+						}
+
+						continue
+					}
+
+					record(f.Name, fieldLevel{field: sf, depth: depth}, dup)
+
+					continue
+				}
+
+				if !f.IsExported() {
+					continue
+				}
+
+				info := jsontag.Parse(f)
+				if info.JSONName == "" {
+					continue // json:"-"
+				}
+
+				record(info.JSONName, fieldLevel{field: sf, depth: depth, tagged: info.TaggedName}, dup)
+			}
+		}
+	}
+
+	var result []staticField
+
+	for _, name := range order {
+		candidates := byName[name]
+
+		minDepth := candidates[0].depth
+		for _, c := range candidates[1:] {
+			minDepth = min(minDepth, c.depth)
+		}
+
+		var atMin []fieldLevel
+
+		for _, c := range candidates {
+			if c.depth == minDepth {
+				atMin = append(atMin, c)
+			}
+		}
+
+		if len(atMin) > 1 {
+			var tagged []fieldLevel
+
+			for _, c := range atMin {
+				if c.tagged {
+					tagged = append(tagged, c)
+				}
+			}
+
+			if len(tagged) != 1 {
+				continue
+			}
+
+			atMin = tagged
+		}
+
+		sf := atMin[0].field
+
+		info := jsontag.Parse(sf.info.field)
+		if info.JSONName == "" {
+			continue
+		}
+
+		sf.info.jsonName = info.JSONName
+		sf.info.omitempty = info.Omitempty || sf.info.optional
+		sf.info.omitzero = info.Omitzero
+		sf.info.jsonString = info.JSONString
+		result = append(result, sf)
+	}
+
+	slices.SortStableFunc(result, func(a, b staticField) int {
+		return slices.Compare(a.info.field.Index, b.info.field.Index)
+	})
+
+	return result, nil
+}
+
+// structField builds the reflect.StructField of v, which the field-name and
+// tag parsers read, with the field type's shape as its type.
+func (b *staticBuilder) structField(v *types.Var, tag string, index []int) reflect.StructField {
+	f := reflect.StructField{
+		Name:      v.Name(),
+		Tag:       reflect.StructTag(tag),
+		Index:     index,
+		Anonymous: v.Embedded(),
+		Type:      b.shape(v.Type()),
+	}
+
+	if !v.Exported() && v.Pkg() != nil {
+		f.PkgPath = v.Pkg().Path()
+	}
+
+	return f
+}
+
+// shape returns a [reflect.Type] of t's kind structure: the predeclared type
+// for a basic kind, and the unnamed pointer, slice, array, or map of the
+// shapes of its parts. A struct's shape is struct{} and an interface's is
+// any. It stands in for t where the shared code reads only kinds: the
+// jsonschema tag's classification of a field and the json:",string" check.
+func (b *staticBuilder) shape(t types.Type) reflect.Type {
+	t = types.Unalias(t)
+
+	if rt, ok := staticBuiltin(t); ok {
+		return rt
+	}
+
+	if n, ok := t.(*types.Named); ok {
+		if b.shaping[n.Obj()] {
+			return typeAny
+		}
+
+		b.shaping[n.Obj()] = true
+		defer delete(b.shaping, n.Obj())
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		if rt, ok := staticBasics[u.Kind()]; ok {
+			return rt
+		}
+
+	case *types.Pointer:
+		return reflect.PointerTo(b.shape(u.Elem()))
+
+	case *types.Slice:
+		return reflect.SliceOf(b.shape(u.Elem()))
+
+	case *types.Array:
+		return reflect.ArrayOf(int(u.Len()), b.shape(u.Elem()))
+
+	case *types.Map:
+		return reflect.MapOf(b.shape(u.Key()), b.shape(u.Elem()))
+
+	case *types.Struct:
+		return typeEmptyStruct
+	}
+
+	return typeAny
+}
+
+// staticTypeName returns the declaring object of a named type, or nil for an
+// unnamed one. A generic instantiation, whose name reflection spells with its
+// type arguments, a type parameter, and a named pointer type are not
+// supported.
+func staticTypeName(t types.Type) (*types.TypeName, error) {
+	switch t := t.(type) {
+	case *types.Named:
+		if t.TypeArgs().Len() > 0 {
+			return nil, fmt.Errorf("%w: generic instantiation %s", ErrStaticUnsupported, t)
+		}
+
+		if _, ok := t.Underlying().(*types.Pointer); ok {
+			return nil, fmt.Errorf("%w: named pointer type %s", ErrStaticUnsupported, t)
+		}
+
+		return t.Obj(), nil
+
+	case *types.TypeParam:
+		return nil, fmt.Errorf("%w: type parameter %s", ErrStaticUnsupported, t)
+	}
+
+	return nil, nil
+}
+
+// staticBuiltin returns the [reflect.Type] of t when t has a built-in
+// override.
+func staticBuiltin(t types.Type) (reflect.Type, bool) {
+	n, ok := t.(*types.Named)
+	if !ok || n.Obj().Pkg() == nil {
+		return nil, false
+	}
+
+	rt, ok := staticBuiltins[n.Obj().Pkg().Path()+"."+n.Obj().Name()]
+
+	return rt, ok
+}
+
+// checkInterceptors reports t when its method set, or its pointer's, holds
+// one of [staticInterceptors]. An interface's methods are its own.
+func checkInterceptors(t types.Type) error {
+	ms := types.NewMethodSet(t)
+	if !types.IsInterface(t) {
+		ms = types.NewMethodSet(types.NewPointer(t))
+	}
+
+	for _, name := range staticInterceptors {
+		if ms.Lookup(nil, name) != nil {
+			return fmt.Errorf("%w: %s has a %s method", ErrStaticUnsupported, t, name)
+		}
+	}
+
+	return nil
+}
+
+// isStaticContainer reports whether t's underlying type can hold a value of
+// t and so form a cycle: a slice, array, or map (see
+// [reflectkind.IsRecursiveContainerKind]).
+func isStaticContainer(t types.Type) bool {
+	switch t.Underlying().(type) {
+	case *types.Slice, *types.Array, *types.Map:
+		return true
+	default:
+		return false
+	}
+}
+
+// isStaticBase64Elem reports whether a slice of elem marshals as a base64
+// string: elem is of kind uint8 and, as checked for the slice's other
+// elements, has no marshaler of its own (see [reflectkind.IsBase64ByteSlice]).
+func isStaticBase64Elem(elem types.Type) bool {
+	k, ok := elem.Underlying().(*types.Basic)
+
+	return ok && k.Kind() == types.Uint8 && checkInterceptors(types.Unalias(elem)) == nil
+}
@@ -0,0 +1,165 @@
+package jsonschema_test
+
+import (
+	"go/token"
+	"go/types"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/testtypes/plain"
+)
+
+const (
+	plainPkg = "go.jacobcolvin.com/x/jsonschema/internal/testtypes/plain"
+	alphaPkg = "go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha"
+)
+
+// loadScope type-checks the package at path and returns its scope.
+func loadScope(t *testing.T, path string) *types.Scope {
+	t.Helper()
+
+	pkgs, err := packages.Load(&packages.Config{
+		Context: t.Context(),
+		Mode:    packages.NeedName | packages.NeedTypes,
+	}, path)
+	require.NoError(t, err)
+	require.Len(t, pkgs, 1)
+	require.Empty(t, pkgs[0].Errors)
+
+	return pkgs[0].Types.Scope()
+}
+
+// TestGenerateStatic_MatchesReflection generates every type of the plain
+// corpus both ways under several option sets and requires identical schemas.
+func TestGenerateStatic_MatchesReflection(t *testing.T) {
+	t.Parallel()
+
+	scope := loadScope(t, plainPkg)
+
+	corpus := map[string]reflect.Type{
+		"Scalars":     reflect.TypeFor[plain.Scalars](),
+		"Mode":        reflect.TypeFor[plain.Mode](),
+		"Level":       reflect.TypeFor[plain.Level](),
+		"Alias":       reflect.TypeFor[plain.Alias](),
+		"Collections": reflect.TypeFor[plain.Collections](),
+		"Item":        reflect.TypeFor[plain.Item](),
+		"Base":        reflect.TypeFor[plain.Base](),
+		"Labeled":     reflect.TypeFor[plain.Labeled](),
+		"Outer":       reflect.TypeFor[plain.Outer](),
+		"Left":        reflect.TypeFor[plain.Left](),
+		"Right":       reflect.TypeFor[plain.Right](),
+		"Ambiguous":   reflect.TypeFor[plain.Ambiguous](),
+		"Tree":        reflect.TypeFor[plain.Tree](),
+		"List":        reflect.TypeFor[plain.List](),
+		"Graph":       reflect.TypeFor[plain.Graph](),
+		"Node":        reflect.TypeFor[plain.Node](),
+		"Matrix":      reflect.TypeFor[plain.Matrix](),
+		"Anonymous":   reflect.TypeFor[plain.Anonymous](),
+		"Widget":      reflect.TypeFor[plain.Widget](),
+	}
+
+	// Every exported type of the corpus is compared.
+	var exported []string
+
+	for _, name := range scope.Names() {
+		if _, ok := scope.Lookup(name).(*types.TypeName); ok && token.IsExported(name) {
+			exported = append(exported, name)
+		}
+	}
+
+	assert.ElementsMatch(t, exported, slices.Collect(maps.Keys(corpus)))
+
+	optionSets := map[string][]jsonschema.GenerateOption{
+		"defaults":              nil,
+		"draft 7":               {jsonschema.WithDraft(jsonschema.Draft7)},
+		"not nullable":          {jsonschema.WithNullable(false)},
+		"no definitions":        {jsonschema.WithDefinitions(false)},
+		"additional properties": {jsonschema.WithAdditionalProperties(true)},
+		"root title":            {jsonschema.WithRootTitle(true), jsonschema.WithDraft(jsonschema.Draft7)},
+	}
+
+	for setName, opts := range optionSets {
+		for name, rt := range corpus {
+			t.Run(setName+"/"+name, func(t *testing.T) {
+				t.Parallel()
+
+				want, err := jsonschema.Generate(t.Context(), rt, opts...)
+				require.NoError(t, err)
+
+				got, err := jsonschema.GenerateStatic(t.Context(), scope.Lookup(name).Type(), opts...)
+				require.NoError(t, err)
+
+				assert.JSONEq(t, marshalSchema(t, want), marshalSchema(t, got))
+
+				// A pointer root is nullable both ways.
+				want, err = jsonschema.Generate(t.Context(), reflect.PointerTo(rt), opts...)
+				require.NoError(t, err)
+
+				got, err = jsonschema.GenerateStatic(t.Context(), types.NewPointer(scope.Lookup(name).Type()), opts...)
+				require.NoError(t, err)
+
+				assert.JSONEq(t, marshalSchema(t, want), marshalSchema(t, got))
+			})
+		}
+	}
+}
+
+func TestGenerateStatic_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	scope := loadScope(t, alphaPkg)
+
+	tcs := map[string]struct {
+		typ  types.Type
+		opts []jsonschema.GenerateOption
+		want string
+	}{
+		"provider": {
+			typ:  scope.Lookup("ProviderSingleton").Type(),
+			want: "has a JSONSchema method",
+		},
+		"generic": {
+			typ:  scope.Lookup("Box").Type(),
+			want: "type parameter T",
+		},
+		"namer option": {
+			typ: types.Typ[types.String],
+			opts: []jsonschema.GenerateOption{jsonschema.WithNamer(jsonschema.NamerFunc(
+				func(jsonschema.TypeContext) string { return "" }))},
+			want: "option WithNamer",
+		},
+		"type schema option": {
+			typ:  types.Typ[types.String],
+			opts: []jsonschema.GenerateOption{jsonschema.WithTypeSchemaFor[int](jsonschema.TypeSchema{})},
+			want: "option WithTypeSchema",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := jsonschema.GenerateStatic(t.Context(), tc.typ, tc.opts...)
+			require.ErrorIs(t, err, jsonschema.ErrStaticUnsupported)
+			assert.ErrorContains(t, err, tc.want)
+		})
+	}
+}
+
+func TestGenerateStatic_UnsupportedType(t *testing.T) {
+	t.Parallel()
+
+	ch := types.NewStruct([]*types.Var{
+		types.NewField(0, nil, "C", types.NewChan(types.SendRecv, types.Typ[types.Int]), false),
+	}, nil)
+
+	_, err := jsonschema.GenerateStatic(t.Context(), ch)
+	require.ErrorIs(t, err, jsonschema.ErrUnsupportedType)
+}
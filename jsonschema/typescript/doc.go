@@ -0,0 +1,57 @@
+// Package typescript converts a JSON Schema to TypeScript declarations, the
+// contents of a .d.ts file, so a frontend can share the types a Go service
+// generates its schemas from instead of maintaining them by hand.
+//
+// It serves any [jsonschema.Schema], whether generated from Go types by the
+// jsonschema package or parsed from a file. A $ref is followed only within
+// the document; resolve remote references first, for example with
+// [jsonschema.Inline].
+//
+// # Usage
+//
+//	src, err := typescript.Generate(schema, typescript.WithRootName("Config"))
+//
+// # Declarations
+//
+// The root schema is declared first, named by [WithRootName], or without
+// it, by its title, or "Root". Each definition ($defs, or Draft 7's
+// definitions) follows in name order, named by its key. A name that is not
+// a TypeScript identifier is converted to one in PascalCase (my-chart
+// becomes MyChart), prefixed with Root or Def where it would start with a
+// digit or be empty, and a name that would clash with a reserved word, a
+// built-in type, or an earlier declaration gains a suffix. A schema that is
+// an object type with nothing else to express is declared as an interface,
+// and any other schema as a type alias. A $ref to a definition, or to the
+// root, names its declaration; a $ref to any other schema in the document is
+// expanded in place.
+//
+// # Types
+//
+// The mapping follows the JSON data model:
+//
+//   - string, number, integer, boolean, and null become string, number,
+//     number, boolean, and null, and a list of types a union of them.
+//   - enum and const become a union of literal types.
+//   - An object becomes an object type whose properties are optional (?)
+//     unless required. additionalProperties becomes an index signature; an
+//     object with neither properties nor additionalProperties gets one
+//     admitting any value, and additionalProperties false gets none.
+//   - An array becomes T[] of its items, and prefixItems (Draft 7's items
+//     array) a tuple, whose elements beyond minItems are optional and whose
+//     remaining items, unless closed by false, become a rest element.
+//   - anyOf and oneOf become unions, so a pointer's nullable encoding (an
+//     anyOf of a schema and {"type": "null"}) becomes T | null, and allOf an
+//     intersection. A schema with a type and a composition, or a $ref with
+//     siblings, becomes the intersection of both.
+//   - The true schema becomes unknown and the false schema never.
+//
+// A schema's description, or without one, its title, becomes its JSDoc
+// comment, together with a @deprecated tag for a deprecated schema and a
+// @default tag for its default.
+//
+// Validation constraints such as minLength and pattern have no TypeScript
+// counterpart and are left out. Structure TypeScript cannot express, such
+// as not, if/then/else, patternProperties, and dependentSchemas, widens the
+// type to one admitting every valid value, and a line comment before the
+// declaration or member says what was widened and why.
+package typescript
@@ -0,0 +1,527 @@
+package typescript
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+// ErrUnresolvedRef is returned by [Generate] for a $ref to a schema the
+// document does not contain.
+var ErrUnresolvedRef = errors.New("unresolved reference")
+
+// Option configures [Generate].
+type Option interface {
+	apply(g *generator)
+}
+
+// optionFunc adapts a function to [Option].
+type optionFunc func(*generator)
+
+func (f optionFunc) apply(g *generator) { f(g) }
+
+// WithRootName sets the name of the root schema's declaration, in place of
+// the schema's title or, without one, "Root".
+func WithRootName(name string) Option {
+	return optionFunc(func(g *generator) { g.rootName = name })
+}
+
+// generator holds the state of one [Generate] call.
+type generator struct {
+	rootName string
+
+	// names holds the identifier of each declared schema, so a $ref to it
+	// names the declaration.
+	names map[*jsonschema.Schema]string
+
+	// pointers holds each schema of the document by its JSON Pointer, and
+	// anchors by its $anchor, for resolving a $ref.
+	pointers map[string]*jsonschema.Schema
+	anchors  map[string]*jsonschema.Schema
+
+	// expanding holds the undeclared schemas a $ref is being expanded into,
+	// so a cycle through them is cut instead of recursing forever.
+	expanding map[*jsonschema.Schema]bool
+
+	err error
+}
+
+// declaration is one exported interface or type alias.
+type declaration struct {
+	name   string
+	schema *jsonschema.Schema
+}
+
+// Generate returns the TypeScript declarations of s and its definitions,
+// formatted as a .d.ts file. A nil s declares the schema accepting every
+// value. A $ref within the document that addresses no schema returns an
+// error wrapping [ErrUnresolvedRef].
+func Generate(s *jsonschema.Schema, opts ...Option) ([]byte, error) {
+	g := &generator{
+		names:     map[*jsonschema.Schema]string{},
+		pointers:  map[string]*jsonschema.Schema{},
+		anchors:   map[string]*jsonschema.Schema{},
+		expanding: map[*jsonschema.Schema]bool{},
+	}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt.apply(g)
+		}
+	}
+
+	if s == nil {
+		s = &jsonschema.Schema{}
+	}
+
+	for loc, node := range jsonschema.Schemas(s) {
+		g.pointers[loc.Pointer] = node
+		if node.Anchor != "" {
+			g.anchors[node.Anchor] = node
+		}
+	}
+
+	decls := g.declarations(s)
+
+	var b bytes.Buffer
+
+	b.WriteString("// Code generated by typescript.Generate. DO NOT EDIT.\n")
+
+	for _, d := range decls {
+		b.WriteString("\n")
+		g.declare(&b, d)
+	}
+
+	if g.err != nil {
+		return nil, g.err
+	}
+
+	return b.Bytes(), nil
+}
+
+// declarations returns the root's declaration followed by one per
+// definition in name order, each with its unique identifier recorded.
+func (g *generator) declarations(s *jsonschema.Schema) []declaration {
+	used := map[string]bool{}
+
+	add := func(decls []declaration, name, base string, schema *jsonschema.Schema) []declaration {
+		if _, ok := g.names[schema]; ok {
+			return decls
+		}
+
+		name = unique(identifier(name, base), used)
+		g.names[schema] = name
+
+		return append(decls, declaration{name: name, schema: schema})
+	}
+
+	root := g.rootName
+	if root == "" {
+		root = s.Title
+	}
+
+	decls := add(nil, root, "Root", s)
+
+	for _, defs := range []map[string]*jsonschema.Schema{s.Defs, s.Definitions} {
+		for _, key := range slices.Sorted(maps.Keys(defs)) {
+			if defs[key] != nil {
+				decls = add(decls, key, "Def", defs[key])
+			}
+		}
+	}
+
+	return decls
+}
+
+// declare writes the declaration d: an interface for a plain object type,
+// and a type alias otherwise.
+func (g *generator) declare(b *bytes.Buffer, d declaration) {
+	var n notes
+
+	if isInterface(d.schema) {
+		body := g.members(d.schema, 1, &n)
+		noteUnapplied(d.schema, &n)
+
+		n.write(b, 0)
+		writeDoc(b, d.schema, 0)
+		fmt.Fprintf(b, "export interface %s {\n%s}\n", d.name, body)
+
+		return
+	}
+
+	t := g.typeOf(d.schema, 0, &n)
+
+	n.write(b, 0)
+	writeDoc(b, d.schema, 0)
+	fmt.Fprintf(b, "export type %s = %s;\n", d.name, t.text)
+}
+
+// isInterface reports whether s is an object type with nothing else to type,
+// so it can be declared as an interface. Keywords TypeScript cannot express
+// at all (see [noteUnapplied]) are noted on either declaration.
+func isInterface(s *jsonschema.Schema) bool {
+	if s.Type != "object" && (s.Type != "" || s.Types != nil || !isObject(s)) {
+		return false
+	}
+
+	return s.Ref == "" && s.DynamicRef == "" && s.Const == nil && s.Enum == nil &&
+		s.AllOf == nil && s.AnyOf == nil && s.OneOf == nil
+}
+
+// members returns the members of the object type of s, one per property and
+// then its index signature, each line indented to level, with what they
+// widen noted in n.
+func (g *generator) members(s *jsonschema.Schema, level int, n *notes) string {
+	var b bytes.Buffer
+
+	required := map[string]bool{}
+	for _, name := range s.Required {
+		required[name] = true
+	}
+
+	names := propertyNames(s)
+	values := make([]expr, 0, len(names))
+
+	for _, name := range names {
+		p := s.Properties[name]
+
+		var pn notes
+
+		t := g.typeOf(p, level, &pn)
+		values = append(values, t)
+
+		optional := "?"
+		if required[name] {
+			optional = ""
+		}
+
+		pn.write(&b, level)
+		writeDoc(&b, p, level)
+		fmt.Fprintf(&b, "%s%s%s: %s;\n", indent(level), propertyKey(name), optional, t.text)
+	}
+
+	if value, ok := g.indexSignature(s, values, level, n); ok {
+		fmt.Fprintf(&b, "%s[key: string]: %s;\n", indent(level), value.text)
+	}
+
+	if s.PropertyNames != nil && !jsonschema.IsTrueSchema(s.PropertyNames) {
+		n.add("propertyNames: TypeScript cannot constrain the keys of an object beyond string, so any key is allowed.")
+	}
+
+	if s.UnevaluatedProperties != nil && !jsonschema.IsTrueSchema(s.UnevaluatedProperties) &&
+		!jsonschema.IsFalseSchema(s.UnevaluatedProperties) {
+		n.add("unevaluatedProperties: TypeScript cannot type the properties other keywords leave unevaluated, " +
+			"so they are not typed.")
+	}
+
+	return b.String() + indent(level-1)
+}
+
+// indexSignature returns the value type of the index signature of the
+// object type of s, whose declared properties have the types values, and
+// whether it has one.
+func (g *generator) indexSignature(s *jsonschema.Schema, values []expr, level int, n *notes) (expr, bool) {
+	additional := s.AdditionalProperties
+	closed := jsonschema.IsFalseSchema(additional)
+
+	var types []expr
+
+	for _, pattern := range slices.Sorted(maps.Keys(s.PatternProperties)) {
+		types = append(types, g.typeOf(s.PatternProperties[pattern], level, n))
+	}
+
+	if len(types) > 0 {
+		n.add("patternProperties: TypeScript cannot restrict keys to a pattern, so the index signature admits every key.")
+	}
+
+	// Without additionalProperties, the keys no pattern matches may hold any
+	// value.
+	switch {
+	case additional != nil && !closed:
+		types = append(types, g.typeOf(additional, level, n))
+	case additional == nil && (len(types) > 0 || len(values) == 0):
+		types = append(types, unknown)
+	case closed && len(types) == 0 && len(values) == 0:
+		return never, true
+	}
+
+	if len(types) == 0 {
+		return expr{}, false
+	}
+
+	value := union(types)
+	if value != unknown && len(values) > 0 {
+		widened := union(append(slices.Clone(types), values...))
+		if widened != value {
+			n.add("index signature: TypeScript requires it to admit the declared properties' types too, " +
+				"so it is widened to include them.")
+
+			value = widened
+		}
+	}
+
+	return value, true
+}
+
+// propertyNames returns the property names of s: those in its
+// PropertyOrder first, then the rest in name order.
+func propertyNames(s *jsonschema.Schema) []string {
+	names := make([]string, 0, len(s.Properties))
+
+	for _, name := range s.PropertyOrder {
+		if _, ok := s.Properties[name]; ok && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(s.Properties)) {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// resolve returns the type a $ref names: its declaration's name, or the
+// type of the schema it addresses, expanded in place.
+func (g *generator) resolve(ref string, level int, n *notes) expr {
+	fragment, ok := strings.CutPrefix(ref, "#")
+	if !ok {
+		n.add(fmt.Sprintf("$ref %q: references outside the document are not followed, so the type is unknown; "+
+			"inline them with jsonschema.Inline first.", ref))
+
+		return unknown
+	}
+
+	var target *jsonschema.Schema
+
+	ptr, err := url.PathUnescape(fragment)
+	if err == nil {
+		if ptr == "" || strings.HasPrefix(ptr, "/") {
+			target = g.pointers[ptr]
+		} else {
+			target = g.anchors[ptr]
+		}
+	}
+
+	if target == nil {
+		if g.err == nil {
+			g.err = fmt.Errorf("%w: %q", ErrUnresolvedRef, ref)
+		}
+
+		return unknown
+	}
+
+	if name, ok := g.names[target]; ok {
+		return expr{text: name, prec: precPrimary}
+	}
+
+	if g.expanding[target] {
+		n.add(fmt.Sprintf("$ref %q: the reference is recursive but not to a definition, so TypeScript cannot name it "+
+			"and the type is unknown; move its target to $defs.", ref))
+
+		return unknown
+	}
+
+	g.expanding[target] = true
+	defer delete(g.expanding, target)
+
+	return g.typeOf(target, level, n)
+}
+
+// notes collects the widening comments of a declaration or member.
+type notes []string
+
+// add records the comment text, once.
+func (n *notes) add(text string) {
+	if !slices.Contains(*n, text) {
+		*n = append(*n, text)
+	}
+}
+
+// write writes the comments as line comments indented to level.
+func (n notes) write(b *bytes.Buffer, level int) {
+	for _, text := range n {
+		fmt.Fprintf(b, "%s// %s\n", indent(level), text)
+	}
+}
+
+// docEscaper keeps a description from closing its JSDoc comment early.
+var docEscaper = strings.NewReplacer("*/", `*\/`)
+
+// writeDoc writes the JSDoc comment of s, indented to level: its
+// description, or without one, its title, then its @deprecated and
+// @default tags. It writes nothing for a schema with none of them.
+func writeDoc(b *bytes.Buffer, s *jsonschema.Schema, level int) {
+	if s == nil {
+		return
+	}
+
+	var lines []string
+
+	text := s.Description
+	if text == "" {
+		text = s.Title
+	}
+
+	if text = strings.TrimSpace(text); text != "" {
+		lines = strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	}
+
+	var tags []string
+
+	if s.Deprecated {
+		tags = append(tags, "@deprecated")
+	}
+
+	if s.Default != nil {
+		tags = append(tags, "@default "+jsonText(s.Default))
+	}
+
+	if len(lines) > 0 && len(tags) > 0 {
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, tags...)
+
+	switch len(lines) {
+	case 0:
+		return
+	case 1:
+		fmt.Fprintf(b, "%s/** %s */\n", indent(level), docEscaper.Replace(lines[0]))
+
+		return
+	}
+
+	fmt.Fprintf(b, "%s/**\n", indent(level))
+
+	for _, line := range lines {
+		line = strings.TrimRightFunc(docEscaper.Replace(line), unicode.IsSpace)
+		if line == "" {
+			fmt.Fprintf(b, "%s *\n", indent(level))
+		} else {
+			fmt.Fprintf(b, "%s * %s\n", indent(level), line)
+		}
+	}
+
+	fmt.Fprintf(b, "%s */\n", indent(level))
+}
+
+// jsonText returns v as compact JSON, with no HTML escaping.
+func jsonText(v any) string {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	err := enc.Encode(v)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// indent returns two spaces per level.
+func indent(level int) string {
+	return strings.Repeat("  ", max(level, 0))
+}
+
+// reserved holds the names a declaration cannot take: TypeScript's reserved
+// words and the built-in types a declaration would shadow.
+var reserved = map[string]bool{}
+
+func init() {
+	for _, name := range strings.Fields(`
+		break case catch class const continue debugger default delete do else
+		enum export extends false finally for function if import in instanceof
+		new null return super switch this throw true try typeof var void while
+		with implements interface let package private protected public static
+		yield any boolean number string symbol bigint object never unknown
+		undefined type as declare module namespace keyof readonly infer is
+		Array Record Partial Required Readonly Pick Omit Exclude Extract
+		Object String Number Boolean Symbol Function Date Map Set Promise Error
+	`) {
+		reserved[name] = true
+	}
+}
+
+// identifier returns name as a TypeScript identifier: name itself when it is
+// one, and otherwise its runs of letters and digits joined in PascalCase,
+// with base before a leading digit. It returns base for a name with no
+// letters or digits.
+func identifier(name, base string) string {
+	if isIdentifier(name) {
+		return name
+	}
+
+	var b strings.Builder
+
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		r := []rune(part)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+
+	id := b.String()
+	if id == "" || unicode.IsDigit([]rune(id)[0]) {
+		id = base + id
+	}
+
+	return id
+}
+
+// unique returns name, or name with the smallest numeric suffix from 2 that
+// makes it neither reserved nor in used, and records it in used. The name
+// is never empty, so a suffix always extends an identifier.
+func unique(name string, used map[string]bool) string {
+	candidate := name
+	for i := 2; reserved[candidate] || used[candidate]; i++ {
+		candidate = name + strconv.Itoa(i)
+	}
+
+	used[candidate] = true
+
+	return candidate
+}
+
+// isIdentifier reports whether name is a TypeScript identifier (reserved
+// words included).
+func isIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for i, r := range name {
+		switch {
+		case r == '_' || r == '$' || unicode.IsLetter(r):
+		case i > 0 && unicode.IsDigit(r):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// propertyKey returns name as an object type's property key: bare when it
+// is an identifier, and a string literal otherwise.
+func propertyKey(name string) string {
+	if isIdentifier(name) {
+		return name
+	}
+
+	return jsonText(name)
+}
@@ -0,0 +1,270 @@
+package typescript_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+	"go.jacobcolvin.com/x/jsonschema/internal/testtypes/alpha"
+	"go.jacobcolvin.com/x/jsonschema/typescript"
+)
+
+// configSchema exercises every declaration form: definitions, an enum, a
+// const, a deprecated property with a default, a nullable encoding, a
+// oneOf with an inline object, a map, a tuple, and a widened index
+// signature.
+const configSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"title": "Config",
+	"description": "Server configuration.",
+	"type": "object",
+	"properties": {
+		"name": {"type": "string", "minLength": 1, "description": "The name.\nSecond line."},
+		"kind": {"const": "config"},
+		"mode": {"$ref": "#/$defs/Mode"},
+		"peers": {"type": "array", "items": {"anyOf": [{"$ref": "#/$defs/Peer"}, {"type": "null"}]}},
+		"retries": {"type": "integer", "deprecated": true, "default": 3, "description": "Retry count."},
+		"labels": {"type": "object", "additionalProperties": {"type": "string"}},
+		"point": {
+			"type": "array",
+			"prefixItems": [{"type": "number"}, {"type": "number"}, {"type": ["string", "null"]}],
+			"items": false,
+			"minItems": 2
+		},
+		"shape": {"oneOf": [{"$ref": "#/$defs/Peer"}, {"type": "object", "properties": {"r": {"type": "number"}}, "required": ["r"]}]},
+		"extra": {"type": "object", "properties": {"a": {"type": "integer"}}, "additionalProperties": {"type": "string"}},
+		"my-key": {"type": ["string", "integer"]}
+	},
+	"required": ["name", "kind"],
+	"additionalProperties": false,
+	"$defs": {
+		"Mode": {"type": "string", "enum": ["fast", "safe"], "description": "How checks run."},
+		"Peer": {
+			"type": "object",
+			"description": "A cluster member. Ends */ early.",
+			"properties": {
+				"addr": {"type": "string"},
+				"next": {"anyOf": [{"$ref": "#/$defs/Peer"}, {"type": "null"}]}
+			}
+		}
+	}
+}`
+
+func parse(t *testing.T, data string) *jsonschema.Schema {
+	t.Helper()
+
+	s, err := jsonschema.ParseSchema([]byte(data))
+	require.NoError(t, err)
+
+	return s
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	out, err := typescript.Generate(parse(t, configSchema))
+	require.NoError(t, err)
+	assert.Equal(t, `// Code generated by typescript.Generate. DO NOT EDIT.
+
+/** Server configuration. */
+export interface Config {
+  // index signature: TypeScript requires it to admit the declared properties' types too, so it is widened to include them.
+  extra?: {
+    a?: number;
+    [key: string]: string | number;
+  };
+  kind: "config";
+  labels?: {
+    [key: string]: string;
+  };
+  mode?: Mode;
+  "my-key"?: string | number;
+  /**
+   * The name.
+   * Second line.
+   */
+  name: string;
+  peers?: (Peer | null)[];
+  point?: [number, number, (string | null)?];
+  /**
+   * Retry count.
+   *
+   * @deprecated
+   * @default 3
+   */
+  retries?: number;
+  shape?: Peer | {
+    r: number;
+  };
+}
+
+/** How checks run. */
+export type Mode = "fast" | "safe";
+
+/** A cluster member. Ends *\/ early. */
+export interface Peer {
+  addr?: string;
+  next?: Peer | null;
+}
+`, string(out))
+}
+
+func TestGenerate_Types(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		schema string
+		opts   []typescript.Option
+		want   string
+	}{
+		"root name": {
+			schema: `{"title": "my-chart", "type": "string"}`,
+			want:   "/** my-chart */\nexport type MyChart = string;\n",
+		},
+		"root name option": {
+			schema: `{"title": "my-chart", "type": "boolean"}`,
+			opts:   []typescript.Option{typescript.WithRootName("Values")},
+			want:   "export type Values = boolean;\n",
+		},
+		"true and false schemas": {
+			schema: `{"prefixItems": [true, false]}`,
+			want:   "export type Root = [unknown?, never?, ...unknown[]];\n",
+		},
+		"empty object": {
+			schema: `{"type": "object"}`,
+			want:   "export interface Root {\n  [key: string]: unknown;\n}\n",
+		},
+		"closed empty object": {
+			schema: `{"type": "object", "additionalProperties": false}`,
+			want:   "export interface Root {\n  [key: string]: never;\n}\n",
+		},
+		"draft 7 tuple": {
+			schema: `{
+				"$schema": "http://json-schema.org/draft-07/schema#",
+				"items": [{"type": "string"}, {"$ref": "#/definitions/Port"}],
+				"additionalItems": {"type": "boolean"},
+				"minItems": 1,
+				"definitions": {"Port": {"type": "integer"}}
+			}`,
+			want: "export type Root = [string, Port?, ...boolean[]];\n\nexport type Port = number;\n",
+		},
+		"enum and const literals": {
+			schema: `{"enum": [1, "a", true, null, [1, "b"], {"k": -2.5}]}`,
+			want:   "export type Root = 1 | \"a\" | true | null | [1, \"b\"] | {\n  k: -2.5;\n};\n",
+		},
+		"composition": {
+			schema: `{"type": "object", "properties": {"a": {"type": "string"}}, "allOf": [{"$ref": "#/$defs/B"}], "anyOf": [{"type": "string"}, {"type": "number"}],
+				"$defs": {"B": {"required": ["b"], "properties": {"b": {"type": "number"}}}}}`,
+			want: "export type Root = {\n  a?: string;\n} & B & (string | number);\n\nexport interface B {\n  b: number;\n}\n",
+		},
+		"nested local ref": {
+			schema: `{"properties": {"a": {"type": "integer"}, "b": {"$ref": "#/properties/a"}}}`,
+			want:   "export interface Root {\n  a?: number;\n  b?: number;\n}\n",
+		},
+		"recursive root": {
+			schema: `{"properties": {"child": {"$ref": "#"}}}`,
+			want:   "export interface Root {\n  child?: Root;\n}\n",
+		},
+		"reserved and colliding names": {
+			schema: `{"$defs": {"string": {"type": "string"}, "string2": {"type": "number"}, "a.b": {"$ref": "#/$defs/string"}}}`,
+			want: "export type Root = unknown;\n\nexport type AB = string2;\n\n" +
+				"export type string2 = string;\n\nexport type string22 = number;\n",
+		},
+		"names without letters or digits": {
+			schema: `{"title": "2fast", "properties": {"a": {"$ref": "#/$defs/-"}, "b": {"$ref": "#/$defs/~0"}},
+				"$defs": {"-": {"type": "string"}, "~": {"type": "number"}, "3d": {"type": "boolean"}}}`,
+			want: "export interface Root2fast {\n  a?: Def;\n  b?: Def2;\n}\n\n" +
+				"export type Def = string;\n\nexport type Def3d = boolean;\n\nexport type Def2 = number;\n",
+		},
+		"not and conditionals": {
+			schema: `{"type": "string", "not": {"const": ""}, "if": {"minLength": 2}, "then": {"pattern": "^a"}}`,
+			want: "// not: TypeScript has no negated types, so the values it excludes are allowed.\n" +
+				"// if/then/else: TypeScript cannot make a type conditional on a value, so then and else are not applied.\n" +
+				"export type Root = string;\n",
+		},
+		"unapplied keywords on an interface": {
+			schema: `{"type": "object", "properties": {"a": {"type": "string"}}, "not": {"required": ["b"]},
+				"if": {"required": ["a"]}, "then": {"required": ["c"]}, "dependentSchemas": {"a": {"required": ["d"]}}}`,
+			want: "// not: TypeScript has no negated types, so the values it excludes are allowed.\n" +
+				"// if/then/else: TypeScript cannot make a type conditional on a value, so then and else are not applied.\n" +
+				"// dependentSchemas: TypeScript cannot make a type conditional on a property's presence, " +
+				"so the dependent schemas are not applied.\n" +
+				"export interface Root {\n  a?: string;\n}\n",
+		},
+		"pattern properties": {
+			schema: `{"properties": {"x-id": {"type": "integer"}}, "patternProperties": {"^x-": {"type": "string"}}, "required": ["x-id"],
+				"additionalProperties": false}`,
+			want: "// patternProperties: TypeScript cannot restrict keys to a pattern, so the index signature admits every key.\n" +
+				"// index signature: TypeScript requires it to admit the declared properties' types too, so it is widened to include them.\n" +
+				"export interface Root {\n  \"x-id\": number;\n  [key: string]: string | number;\n}\n",
+		},
+		"open pattern properties": {
+			schema: `{"patternProperties": {"^x-": {"type": "string"}}}`,
+			want:   "export interface Root {\n  [key: string]: unknown;\n}\n",
+		},
+		"remote ref": {
+			schema: `{"properties": {"a": {"$ref": "https://example.com/a.json"}}}`,
+			want: "  // $ref \"https://example.com/a.json\": references outside the document are not followed, so the type is unknown; " +
+				"inline them with jsonschema.Inline first.\n  a?: unknown;\n",
+		},
+		"recursive inline ref": {
+			schema: `{"properties": {"a": {"properties": {"b": {"$ref": "#/properties/a"}}}}}`,
+			want:   "b?: unknown;",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := typescript.Generate(parse(t, tc.schema), tc.opts...)
+			require.NoError(t, err)
+			assert.Contains(t, string(out), tc.want)
+		})
+	}
+}
+
+func TestGenerate_GeneratedSchema(t *testing.T) {
+	t.Parallel()
+
+	type peer struct {
+		Addr string `json:"addr"`
+	}
+
+	type server struct {
+		Port    int           `json:"port" jsonschema:"minimum=1"`
+		Host    *string       `json:"host,omitempty"`
+		Peers   []*peer       `json:"peers"`
+		Palette alpha.Palette `json:"palette"`
+	}
+
+	s, err := jsonschema.GenerateFor[server](t.Context())
+	require.NoError(t, err)
+
+	out, err := typescript.Generate(s, typescript.WithRootName("Server"))
+	require.NoError(t, err)
+
+	// Properties follow the struct's field order, not their names'.
+	assert.Contains(t, string(out), `export interface Server {
+  port: number;
+  host?: string | null;
+  peers: null | (peer | null)[];
+  palette: Palette;
+}
+`)
+	assert.Contains(t, string(out), "export interface peer {\n  addr: string;\n}\n")
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, err := typescript.Generate(parse(t, `{"properties": {"a": {"$ref": "#/$defs/Missing"}}}`))
+	require.ErrorIs(t, err, typescript.ErrUnresolvedRef)
+	require.ErrorContains(t, err, `"#/$defs/Missing"`)
+
+	out, err := typescript.Generate(nil)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "export type Root = unknown;\n")
+}
@@ -0,0 +1,314 @@
+package typescript
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+// expr is a TypeScript type expression, with the precedence of its
+// outermost operator, so an operand binding more loosely than its context
+// is parenthesized.
+type expr struct {
+	text string
+	prec int
+}
+
+// Precedences of type expressions, loosest first.
+const (
+	precUnion = iota + 1
+	precIntersection
+	precPrimary
+)
+
+var (
+	unknown = expr{text: "unknown", prec: precPrimary}
+	never   = expr{text: "never", prec: precPrimary}
+)
+
+// operand returns e parenthesized when it binds more loosely than prec.
+func (e expr) operand(prec int) string {
+	if e.prec < prec {
+		return "(" + e.text + ")"
+	}
+
+	return e.text
+}
+
+// typeOf returns the type of s, its object types indented for level, with
+// what it widens noted in n.
+func (g *generator) typeOf(s *jsonschema.Schema, level int, n *notes) expr {
+	if s == nil || jsonschema.IsTrueSchema(s) {
+		return unknown
+	}
+
+	if jsonschema.IsFalseSchema(s) {
+		return never
+	}
+
+	var parts []expr
+
+	if s.Ref != "" {
+		parts = append(parts, g.resolve(s.Ref, level, n))
+	}
+
+	if s.DynamicRef != "" {
+		n.add(fmt.Sprintf("$dynamicRef %q: TypeScript cannot resolve a reference at validation time, so the type is unknown.",
+			s.DynamicRef))
+	}
+
+	switch {
+	case s.Const != nil:
+		parts = append(parts, g.literal(*s.Const, level))
+	case s.Enum != nil:
+		values := make([]expr, 0, len(s.Enum))
+		for _, v := range s.Enum {
+			values = append(values, g.literal(v, level))
+		}
+
+		parts = append(parts, union(values))
+	default:
+		if base, ok := g.base(s, level, n); ok {
+			parts = append(parts, base)
+		}
+	}
+
+	for _, sub := range s.AllOf {
+		parts = append(parts, g.typeOf(sub, level, n))
+	}
+
+	for _, branches := range [][]*jsonschema.Schema{s.AnyOf, s.OneOf} {
+		if branches == nil {
+			continue
+		}
+
+		types := make([]expr, 0, len(branches))
+		for _, sub := range branches {
+			types = append(types, g.typeOf(sub, level, n))
+		}
+
+		parts = append(parts, union(types))
+	}
+
+	noteUnapplied(s, n)
+
+	return intersection(parts)
+}
+
+// noteUnapplied notes in n the keywords of s that TypeScript cannot express
+// and that therefore widen its type: not, if/then/else, and dependent
+// schemas.
+func noteUnapplied(s *jsonschema.Schema, n *notes) {
+	if s.Not != nil {
+		n.add("not: TypeScript has no negated types, so the values it excludes are allowed.")
+	}
+
+	if s.If != nil {
+		n.add("if/then/else: TypeScript cannot make a type conditional on a value, so then and else are not applied.")
+	}
+
+	if s.DependentSchemas != nil || s.DependencySchemas != nil {
+		n.add("dependentSchemas: TypeScript cannot make a type conditional on a property's presence, " +
+			"so the dependent schemas are not applied.")
+	}
+}
+
+// base returns the type of the type keywords of s, or without them, of the
+// object or array keywords it has, and whether it has any of them.
+func (g *generator) base(s *jsonschema.Schema, level int, n *notes) (expr, bool) {
+	types := s.Types
+	if s.Type != "" {
+		types = []string{s.Type}
+	}
+
+	if types == nil {
+		switch {
+		case isObject(s):
+			types = []string{"object"}
+		case isArray(s):
+			types = []string{"array"}
+		default:
+			return expr{}, false
+		}
+	}
+
+	exprs := make([]expr, 0, len(types))
+
+	for _, t := range types {
+		switch t {
+		case "string", "number", "boolean", "null":
+			exprs = append(exprs, expr{text: t, prec: precPrimary})
+		case "integer":
+			exprs = append(exprs, expr{text: "number", prec: precPrimary})
+		case "array":
+			exprs = append(exprs, g.array(s, level, n))
+		case "object":
+			exprs = append(exprs, expr{text: "{\n" + g.members(s, level+1, n) + "}", prec: precPrimary})
+		default:
+			exprs = append(exprs, unknown)
+		}
+	}
+
+	return union(exprs), true
+}
+
+// isObject reports whether s has a keyword typing an object's members.
+func isObject(s *jsonschema.Schema) bool {
+	return s.Properties != nil || s.AdditionalProperties != nil || s.PatternProperties != nil
+}
+
+// isArray reports whether s has a keyword typing an array's items.
+func isArray(s *jsonschema.Schema) bool {
+	return s.Items != nil || s.ItemsArray != nil || s.PrefixItems != nil
+}
+
+// array returns the array type of s: T[] of its items, or a tuple of its
+// prefixItems (or Draft 7 items array).
+func (g *generator) array(s *jsonschema.Schema, level int, n *notes) expr {
+	prefix, rest := s.PrefixItems, s.Items
+	if prefix == nil && s.ItemsArray != nil {
+		prefix, rest = s.ItemsArray, s.AdditionalItems
+	}
+
+	if s.UnevaluatedItems != nil && !jsonschema.IsTrueSchema(s.UnevaluatedItems) &&
+		!jsonschema.IsFalseSchema(s.UnevaluatedItems) {
+		n.add("unevaluatedItems: TypeScript cannot type the items other keywords leave unevaluated, so they are not typed.")
+	}
+
+	if prefix == nil {
+		return expr{text: g.typeOf(s.Items, level, n).operand(precPrimary) + "[]", prec: precPrimary}
+	}
+
+	elems := make([]string, 0, len(prefix)+1)
+
+	for i, item := range prefix {
+		elem := g.typeOf(item, level, n)
+		if s.MinItems == nil || i >= *s.MinItems {
+			elems = append(elems, elem.operand(precPrimary)+"?")
+		} else {
+			elems = append(elems, elem.text)
+		}
+	}
+
+	if !jsonschema.IsFalseSchema(rest) {
+		elems = append(elems, "..."+g.typeOf(rest, level, n).operand(precPrimary)+"[]")
+	}
+
+	return expr{text: "[" + strings.Join(elems, ", ") + "]", prec: precPrimary}
+}
+
+// literal returns the literal type of the JSON value v: a string, number,
+// boolean, or null literal, or an object or tuple type of literals.
+func (g *generator) literal(v any, level int) expr {
+	switch v := v.(type) {
+	case map[string]any:
+		if len(v) == 0 {
+			return expr{text: "{}", prec: precPrimary}
+		}
+
+		var b strings.Builder
+
+		b.WriteString("{\n")
+
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			fmt.Fprintf(&b, "%s%s: %s;\n", indent(level+1), propertyKey(key), g.literal(v[key], level+1).text)
+		}
+
+		b.WriteString(indent(level) + "}")
+
+		return expr{text: b.String(), prec: precPrimary}
+	case []any:
+		elems := make([]string, 0, len(v))
+		for _, elem := range v {
+			elems = append(elems, g.literal(elem, level).text)
+		}
+
+		return expr{text: "[" + strings.Join(elems, ", ") + "]", prec: precPrimary}
+	case json.RawMessage:
+		var decoded any
+
+		err := json.Unmarshal(v, &decoded)
+		if err != nil {
+			return unknown
+		}
+
+		return g.literal(decoded, level)
+	}
+
+	text := jsonText(v)
+	if text == "" {
+		return unknown
+	}
+
+	return expr{text: text, prec: precPrimary}
+}
+
+// union returns the union of types: unknown when one of them is, never when
+// there are none, and otherwise the distinct types other than never.
+func union(types []expr) expr {
+	var members []string
+
+	for _, t := range types {
+		switch t {
+		case unknown:
+			return unknown
+		case never:
+			continue
+		}
+
+		if !slices.Contains(members, t.text) {
+			members = append(members, t.text)
+		}
+	}
+
+	switch len(members) {
+	case 0:
+		return never
+	case 1:
+		for _, t := range types {
+			if t.text == members[0] {
+				return t
+			}
+		}
+	}
+
+	return expr{text: strings.Join(members, " | "), prec: precUnion}
+}
+
+// intersection returns the intersection of types: never when one of them
+// is, unknown when there are none, and otherwise the distinct types other
+// than unknown.
+func intersection(types []expr) expr {
+	var members []expr
+
+	for _, t := range types {
+		switch t {
+		case never:
+			return never
+		case unknown:
+			continue
+		}
+
+		if !slices.ContainsFunc(members, func(m expr) bool { return m.text == t.text }) {
+			members = append(members, t)
+		}
+	}
+
+	switch len(members) {
+	case 0:
+		return unknown
+	case 1:
+		return members[0]
+	}
+
+	operands := make([]string, 0, len(members))
+	for _, t := range members {
+		operands = append(operands, t.operand(precIntersection))
+	}
+
+	return expr{text: strings.Join(operands, " & "), prec: precIntersection}
+}
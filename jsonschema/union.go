@@ -0,0 +1,270 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonvalue"
+	"go.jacobcolvin.com/x/jsonschema/internal/numkind"
+	"go.jacobcolvin.com/x/jsonschema/internal/schemashape"
+)
+
+// Union is a discriminated union over the implementations of interface I: each
+// registered variant is a concrete type that a discriminator property,
+// present in every variant's JSON, names with a fixed value. The same
+// registration drives both sides of the wire, so the schema [WithUnion] emits
+// and the decoding [Union.Unmarshal] performs cannot drift apart.
+//
+// A Union is immutable once built and safe for concurrent use.
+type Union[I any] struct {
+	spec *unionSpec
+}
+
+// UnionVariant is one concrete type of a [Union] and the discriminator value
+// that selects it. Build one with [Variant].
+type UnionVariant struct {
+	typ   reflect.Type
+	value string
+}
+
+// Variant returns the [UnionVariant] selecting T when the discriminator
+// property holds value. T is a struct type, or a pointer to one when the
+// interface is implemented by the pointer's method set; [Union.Unmarshal]
+// returns a T either way.
+func Variant[T any](value string) UnionVariant {
+	return UnionVariant{typ: reflect.TypeFor[T](), value: value}
+}
+
+// unionSpec is the type-erased registration a [Union] wraps, the form the
+// generator consults.
+type unionSpec struct {
+	iface    reflect.Type
+	byValue  map[string]UnionVariant
+	property string
+	variants []UnionVariant // registration order, the oneOf order
+}
+
+// NewUnion builds the discriminated union over interface I whose variants are
+// told apart by the JSON property named property. It reports
+// [ErrInvalidUnion] when I is not a named interface type, when property is
+// empty, or when a variant has an empty or repeated value, repeats a type,
+// does not implement I, is not a struct (or pointer to one), or has no
+// property of that name among its JSON fields.
+func NewUnion[I any](property string, variants ...UnionVariant) (*Union[I], error) {
+	iface := reflect.TypeFor[I]()
+	if iface.Kind() != reflect.Interface || iface.Name() == "" {
+		return nil, fmt.Errorf("%w: %s is not a named interface type", ErrInvalidUnion, iface)
+	}
+
+	if property == "" {
+		return nil, fmt.Errorf("%w: %s: empty discriminator property", ErrInvalidUnion, iface)
+	}
+
+	spec := &unionSpec{
+		iface:    iface,
+		property: property,
+		byValue:  make(map[string]UnionVariant, len(variants)),
+		variants: make([]UnionVariant, 0, len(variants)),
+	}
+
+	types := make(map[reflect.Type]bool, len(variants))
+
+	for _, v := range variants {
+		switch {
+		case v.typ == nil:
+			return nil, fmt.Errorf("%w: %s: zero variant", ErrInvalidUnion, iface)
+		case v.value == "":
+			return nil, fmt.Errorf("%w: %s: empty discriminator value for %s", ErrInvalidUnion, iface, v.typ)
+		case spec.byValue[v.value].typ != nil:
+			return nil, fmt.Errorf("%w: %s: discriminator value %q registered twice", ErrInvalidUnion, iface, v.value)
+		case types[v.typ]:
+			return nil, fmt.Errorf("%w: %s: variant %s registered twice", ErrInvalidUnion, iface, v.typ)
+		case !v.typ.Implements(iface):
+			return nil, fmt.Errorf("%w: %s does not implement %s", ErrInvalidUnion, v.typ, iface)
+		}
+
+		st := numkind.DerefType(v.typ)
+		if st.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("%w: variant %s is not a struct", ErrInvalidUnion, v.typ)
+		}
+
+		if !jsonvalue.HasField(st, property) {
+			return nil, fmt.Errorf("%w: variant %s has no %q property", ErrInvalidUnion, v.typ, property)
+		}
+
+		types[v.typ] = true
+		spec.byValue[v.value] = v
+		spec.variants = append(spec.variants, v)
+	}
+
+	return &Union[I]{spec: spec}, nil
+}
+
+// MustNewUnion is [NewUnion] but panics on error; intended for package-scope
+// variables, where a registration either always succeeds or always fails.
+func MustNewUnion[I any](property string, variants ...UnionVariant) *Union[I] {
+	u, err := NewUnion[I](property, variants...)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
+
+// Unmarshal decodes data into the variant its discriminator property names
+// and returns it as an I. A JSON null decodes to the zero I, as encoding/json
+// decodes null into an interface. A missing discriminator, one that is not a
+// string, or a value no variant registered is reported as
+// [ErrUnknownVariant]; a malformed document or a variant that fails to decode
+// returns the encoding/json error.
+//
+// Unlike encoding/json's field matching, the discriminator property name is
+// matched exactly, as the schema's required keyword matches it.
+func (u *Union[I]) Unmarshal(data []byte) (I, error) {
+	var zero I
+
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return zero, nil
+	}
+
+	var members map[string]json.RawMessage
+
+	err := json.Unmarshal(data, &members)
+	if err != nil {
+		return zero, err
+	}
+
+	raw, ok := members[u.spec.property]
+	if !ok {
+		return zero, fmt.Errorf("%w: %s: missing %q", ErrUnknownVariant, u.spec.iface, u.spec.property)
+	}
+
+	var value string
+
+	err = json.Unmarshal(raw, &value)
+	if err != nil {
+		return zero, fmt.Errorf("%w: %s: %q is %s, not a string", ErrUnknownVariant, u.spec.iface, u.spec.property, raw)
+	}
+
+	v, ok := u.spec.byValue[value]
+	if !ok {
+		return zero, fmt.Errorf("%w: %s: %q is %q", ErrUnknownVariant, u.spec.iface, u.spec.property, value)
+	}
+
+	ptr := reflect.New(numkind.DerefType(v.typ))
+
+	err = json.Unmarshal(data, ptr.Interface())
+	if err != nil {
+		return zero, err
+	}
+
+	out := ptr
+	if v.typ.Kind() != reflect.Pointer {
+		out = ptr.Elem()
+	}
+
+	return out.Interface().(I), nil //nolint:forcetypeassert // NewUnion checked that every variant implements I.
+}
+
+// WithUnion generates interface I as the discriminated union u describes, in
+// place of the unrestricted schema an interface otherwise reflects as: a
+// oneOf with one branch per variant, in registration order, each a $ref to
+// the variant's definition whose discriminator property is pinned with const
+// and required. The OpenAPI discriminator object (propertyName plus a mapping
+// from each value to its branch's $ref) rides along as the "discriminator"
+// extension keyword, which validators ignore. With [WithDefinitions] disabled
+// the branches are inlined and the mapping is omitted.
+//
+// The union is registered under I's definition name like a named struct, and
+// a nil interface still admits null. A registration takes the place of kind
+// reflection only, so a [TypeSchemaProvider] override of I, or a
+// [JSONSchemaProvider] in I's method set, still wins. Registering a second
+// union for the same interface replaces the first. A nil u is ignored.
+func WithUnion[I any](u *Union[I]) GenerateOption {
+	return generateOptionFunc(func(g *generator) {
+		if u == nil {
+			return
+		}
+
+		if g.unions == nil {
+			g.unions = map[reflect.Type]*unionSpec{}
+		}
+
+		g.unions[u.spec.iface] = u.spec
+	})
+}
+
+// schemaForUnion builds the kindUnion node for a registered interface. Each
+// branch is the variant's struct schema, built without a null of its own: the
+// interface position carries the null for the union as a whole.
+func (g *generator) schemaForUnion(spec *unionSpec) (*node, error) {
+	un := &unionNode{property: spec.property, branches: make([]unionBranch, 0, len(spec.variants))}
+
+	for _, v := range spec.variants {
+		branch, err := g.schemaForType(numkind.DerefType(v.typ), false)
+		if err != nil {
+			return nil, err
+		}
+
+		un.branches = append(un.branches, unionBranch{node: branch, value: v.value})
+	}
+
+	return &node{kind: kindUnion, payload: &Schema{}, union: un}, nil
+}
+
+// renderUnion renders a union's branches into its payload's oneOf, pinning
+// each branch's discriminator, and attaches the discriminator extension. The
+// mapping is emitted only when every branch is a $ref, since it names
+// definitions, not inline schemas.
+func (g *generator) renderUnion(n *node) *Schema {
+	un := n.union
+	mapping := make(map[string]any, len(un.branches))
+	n.payload.OneOf = make([]*Schema, 0, len(un.branches))
+
+	for _, b := range un.branches {
+		branch := g.render(b.node)
+		if branch.Ref != "" && mapping != nil {
+			mapping[b.value] = branch.Ref
+		} else {
+			mapping = nil
+		}
+
+		n.payload.OneOf = append(n.payload.OneOf, g.pinDiscriminator(branch, un.property, b.value))
+	}
+
+	discriminator := map[string]any{"propertyName": un.property}
+	if mapping != nil {
+		discriminator["mapping"] = mapping
+	}
+
+	if n.payload.Extra == nil {
+		n.payload.Extra = map[string]any{}
+	}
+
+	n.payload.Extra["discriminator"] = discriminator
+
+	return n.payload
+}
+
+// pinDiscriminator constrains branch to instances whose property holds value.
+// A bare $ref takes the constraint as siblings where the draft honors them;
+// any other branch (an inline struct, or a $ref under Draft 7) is composed with
+// it through allOf, leaving the branch itself untouched.
+func (g *generator) pinDiscriminator(branch *Schema, property, value string) *Schema {
+	var c any = value
+
+	pin := &Schema{
+		Properties: map[string]*Schema{property: {Const: &c}},
+		Required:   []string{property},
+	}
+
+	if branch.Ref != "" && g.profile.honorRefSiblings && !schemashape.HasRefSiblings(branch) {
+		pin.Ref = branch.Ref
+
+		return pin
+	}
+
+	return &Schema{AllOf: []*Schema{branch, pin}}
+}
@@ -0,0 +1,263 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+type UnionSource interface{ isUnionSource() }
+
+type UnionGit struct {
+	Kind string `json:"kind"`
+	URL  string `json:"url"`
+}
+
+func (UnionGit) isUnionSource() {}
+
+type UnionHTTP struct {
+	Kind     string `json:"kind"`
+	Endpoint string `json:"endpoint"`
+}
+
+func (*UnionHTTP) isUnionSource() {}
+
+type unionConfig struct {
+	Source UnionSource `json:"source"`
+}
+
+var unionSources = jsonschema.MustNewUnion[UnionSource]("kind",
+	jsonschema.Variant[UnionGit]("git"),
+	jsonschema.Variant[*UnionHTTP]("http"),
+)
+
+type UnionExpr interface{ isUnionExpr() }
+
+type UnionLit struct {
+	Op    string `json:"op"`
+	Value int    `json:"value"`
+}
+
+func (UnionLit) isUnionExpr() {}
+
+type UnionAdd struct {
+	Left  UnionExpr `json:"left"`
+	Right UnionExpr `json:"right"`
+	Op    string    `json:"op"`
+}
+
+func (UnionAdd) isUnionExpr() {}
+
+var unionExprs = jsonschema.MustNewUnion[UnionExpr]("op",
+	jsonschema.Variant[UnionLit]("lit"),
+	jsonschema.Variant[UnionAdd]("add"),
+)
+
+func TestWithUnion(t *testing.T) {
+	t.Parallel()
+
+	s := jsonschema.MustGenerateFor[unionConfig](jsonschema.WithUnion(unionSources))
+
+	got, err := json.Marshal(s.Defs["UnionSource"])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"oneOf": [
+			{"$ref": "#/$defs/UnionGit", "properties": {"kind": {"const": "git"}}, "required": ["kind"]},
+			{"$ref": "#/$defs/UnionHTTP", "properties": {"kind": {"const": "http"}}, "required": ["kind"]}
+		],
+		"discriminator": {
+			"propertyName": "kind",
+			"mapping": {"git": "#/$defs/UnionGit", "http": "#/$defs/UnionHTTP"}
+		}
+	}`, string(got))
+
+	assert.Contains(t, s.Defs, "UnionGit")
+	assert.Contains(t, s.Defs, "UnionHTTP")
+
+	tests := map[string]struct {
+		instance string
+		valid    bool
+	}{
+		"git":            {instance: `{"source": {"kind": "git", "url": "u"}}`, valid: true},
+		"http":           {instance: `{"source": {"kind": "http", "endpoint": "e"}}`, valid: true},
+		"null":           {instance: `{"source": null}`, valid: true},
+		"unknown kind":   {instance: `{"source": {"kind": "ftp", "url": "u"}}`},
+		"missing kind":   {instance: `{"source": {"url": "u"}}`},
+		"wrong branch":   {instance: `{"source": {"kind": "git", "url": 1}}`},
+		"not an object":  {instance: `{"source": "git"}`},
+		"kind not const": {instance: `{"source": {"kind": 1, "url": "u"}}`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateJSON(t.Context(), s, []byte(tc.instance))
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWithUnion_Shapes(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts []jsonschema.GenerateOption
+		path func(s *jsonschema.Schema) *jsonschema.Schema
+		want string
+	}{
+		"draft 7": {
+			opts: []jsonschema.GenerateOption{jsonschema.WithDraft(jsonschema.Draft7)},
+			path: func(s *jsonschema.Schema) *jsonschema.Schema { return s.Definitions["UnionSource"].OneOf[0] },
+			want: `{"allOf": [
+				{"$ref": "#/definitions/UnionGit"},
+				{"properties": {"kind": {"const": "git"}}, "required": ["kind"]}
+			]}`,
+		},
+		"definitions disabled": {
+			opts: []jsonschema.GenerateOption{jsonschema.WithDefinitions(false)},
+			path: func(s *jsonschema.Schema) *jsonschema.Schema {
+				return s.Properties["source"].AnyOf[0].OneOf[0].AllOf[1]
+			},
+			want: `{"properties": {"kind": {"const": "git"}}, "required": ["kind"]}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := jsonschema.GenerateFor[unionConfig](t.Context(),
+				append(tc.opts, jsonschema.WithUnion(unionSources))...)
+			require.NoError(t, err)
+
+			got, err := json.Marshal(tc.path(s))
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestWithUnion_Recursive(t *testing.T) {
+	t.Parallel()
+
+	for name, opts := range map[string][]jsonschema.GenerateOption{
+		"definitions":          nil,
+		"definitions disabled": {jsonschema.WithDefinitions(false)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := jsonschema.GenerateFor[UnionExpr](t.Context(), append(opts, jsonschema.WithUnion(unionExprs))...)
+			require.NoError(t, err)
+
+			valid := `{"op": "add", "left": {"op": "lit", "value": 1}, "right": {"op": "add", "left": {"op": "lit", "value": 2}, "right": null}}`
+			require.NoError(t, validateJSON(t.Context(), s, []byte(valid)))
+
+			invalid := `{"op": "add", "left": {"op": "lit", "value": "x"}, "right": null}`
+			require.Error(t, validateJSON(t.Context(), s, []byte(invalid)))
+		})
+	}
+}
+
+func TestUnion_Unmarshal(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		want  UnionSource
+		err   error
+		input string
+	}{
+		"value variant":   {input: `{"kind": "git", "url": "u"}`, want: UnionGit{Kind: "git", URL: "u"}},
+		"pointer variant": {input: `{"kind": "http", "endpoint": "e"}`, want: &UnionHTTP{Kind: "http", Endpoint: "e"}},
+		"null":            {input: ` null `},
+		"missing":         {input: `{"url": "u"}`, err: jsonschema.ErrUnknownVariant},
+		"unknown":         {input: `{"kind": "ftp"}`, err: jsonschema.ErrUnknownVariant},
+		"not a string":    {input: `{"kind": 1}`, err: jsonschema.ErrUnknownVariant},
+		"case differs":    {input: `{"Kind": "git"}`, err: jsonschema.ErrUnknownVariant},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := unionSources.Unmarshal([]byte(tc.input))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	_, err := unionSources.Unmarshal([]byte(`[1]`))
+	require.Error(t, err)
+
+	_, err = unionSources.Unmarshal([]byte(`{"kind": "git", "url": 1}`))
+	require.Error(t, err)
+}
+
+func TestNewUnion_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func() error{
+		"not an interface": func() error {
+			_, err := jsonschema.NewUnion[UnionGit]("kind")
+			return err
+		},
+		"unnamed interface": func() error {
+			_, err := jsonschema.NewUnion[any]("kind")
+			return err
+		},
+		"empty property": func() error {
+			_, err := jsonschema.NewUnion[UnionSource]("", jsonschema.Variant[UnionGit]("git"))
+			return err
+		},
+		"empty value": func() error {
+			_, err := jsonschema.NewUnion[UnionSource]("kind", jsonschema.Variant[UnionGit](""))
+			return err
+		},
+		"duplicate value": func() error {
+			_, err := jsonschema.NewUnion[UnionSource]("kind",
+				jsonschema.Variant[UnionGit]("git"), jsonschema.Variant[*UnionHTTP]("git"))
+			return err
+		},
+		"duplicate type": func() error {
+			_, err := jsonschema.NewUnion[UnionSource]("kind",
+				jsonschema.Variant[UnionGit]("git"), jsonschema.Variant[UnionGit]("git2"))
+			return err
+		},
+		"not implemented": func() error {
+			// Only *UnionHTTP carries the method.
+			_, err := jsonschema.NewUnion[UnionSource]("kind", jsonschema.Variant[UnionHTTP]("http"))
+			return err
+		},
+		"no discriminator field": func() error {
+			_, err := jsonschema.NewUnion[UnionSource]("source", jsonschema.Variant[UnionGit]("git"))
+			return err
+		},
+		"zero variant": func() error {
+			_, err := jsonschema.NewUnion[UnionSource]("kind", jsonschema.UnionVariant{})
+			return err
+		},
+	}
+
+	for name, build := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorIs(t, build(), jsonschema.ErrInvalidUnion)
+		})
+	}
+}
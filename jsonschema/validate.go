@@ -20,11 +20,14 @@ import (
 
 	"go.jacobcolvin.com/x/jsonschema/internal/annotations"
 	"go.jacobcolvin.com/x/jsonschema/internal/content"
+	"go.jacobcolvin.com/x/jsonschema/internal/fieldname"
 	"go.jacobcolvin.com/x/jsonschema/internal/format"
 	"go.jacobcolvin.com/x/jsonschema/internal/jsonequal"
 	"go.jacobcolvin.com/x/jsonschema/internal/jsonptr"
+	"go.jacobcolvin.com/x/jsonschema/internal/jsonvalue"
 	"go.jacobcolvin.com/x/jsonschema/internal/keywordmeta"
 	"go.jacobcolvin.com/x/jsonschema/internal/normalize"
+	"go.jacobcolvin.com/x/jsonschema/internal/numcheck"
 	"go.jacobcolvin.com/x/jsonschema/internal/numrat"
 	"go.jacobcolvin.com/x/jsonschema/internal/refresolve"
 	"go.jacobcolvin.com/x/jsonschema/internal/regexcache"
@@ -84,6 +87,19 @@ func WithContent(enabled bool) ValidateOption {
 	return validateOptionFunc(func(v *validator) { v.contentEnabled = enabled })
 }
 
+// WithMarshaler makes [Validator.ValidateValue] compute a Go value's instance
+// by calling marshal and decoding its output as [Validator.ValidateJSON] does,
+// instead of walking the value under the [WithFieldNaming] dialect's rules.
+// Use it to validate what a real encoder emits, with the encoder's own
+// options: encoding/json/v2's Marshal (schemas generated under
+// [NamingJSONv2]), or github.com/go-json-experiment/json's with
+// ExperimentalSupportFormatTag, which the format tag option needs where the
+// standard library's v2 rejects it. The Go field paths of [WithGoFieldPaths]
+// are still resolved through the dialect. A nil marshal restores the walk.
+func WithMarshaler(marshal func(v any) ([]byte, error)) ValidateOption {
+	return validateOptionFunc(func(v *validator) { v.marshal = marshal })
+}
+
 // WithResolveOptions passes [ResolveOptions] (an alias for the upstream
 // options type) to Schema.Resolve for structural pre-validation. The
 // validation walk resolves local fragment refs directly and remote/absolute
@@ -325,7 +341,7 @@ type validator struct {
 	// no keyword the cache covers. A schema reached only at validation time (a
 	// remote or JSON-pointer fallback schema) is outside the index, so its cache
 	// lookup misses and the validation path computes the value directly.
-	numericBounds []*precomputedBounds // numeric bound keywords as rationals, by node id
+	numericBounds []*numcheck.Bounds // numeric bound keywords as rationals, by node id
 
 	root               *Schema
 	resolveOpts        *ResolveOptions
@@ -358,6 +374,22 @@ type validator struct {
 	// shares the slice by reference; it is read-only after Compile.
 	activeRows []*keywordEntry
 
+	// The customKeywords are the [WithKeyword] registrations by keyword name;
+	// customRows are the dispatch rows of those whose vocabulary is active,
+	// built with activeRows (which points into it). The customVocabs set is
+	// the vocabulary URIs the resolved vocabulary set activates, or nil when
+	// no set was supplied and every registered vocabulary is active.
+	customKeywords map[string]*customKeyword
+	customRows     []keywordEntry
+	customVocabs   map[string]bool
+
+	// The customEvals cache holds each node's compiled custom keyword
+	// evaluators by keyword name (see numericBounds). The compileErr is the
+	// first failure a custom keyword's compile step reported, which Compile
+	// returns once the precompute pass finishes.
+	customEvals []map[string]KeywordEvaluator
+	compileErr  error
+
 	draft   Draft
 	profile draftProfile // per-draft behavioral policy, resolved once from draft
 	vocabs  vocab.Set    // resolved active vocabularies
@@ -370,6 +402,21 @@ type validator struct {
 	// checker instead of treating it as annotation-only.
 	formatsVocabDriven bool
 	contentEnabled     bool // assert contentEncoding/contentMediaType (WithContent)
+	goFieldPaths       bool // attach Go origins in ValidateValue (WithGoFieldPaths)
+
+	// The WithFieldNaming dialect ValidateValue encodes Go values under; nil
+	// is encoding/json's.
+	naming *fieldname.Dialect
+	// The WithMarshaler encoder ValidateValue marshals Go values with
+	// instead; nil walks them under naming.
+	marshal func(any) ([]byte, error)
+
+	// The limits are the WithFailFast, WithMaxErrors, and resource limit
+	// configuration; budget is a run's spending against them, allocated by
+	// forInstance only when a limit is active, so an unlimited run pays one
+	// nil check per node.
+	limits runLimits
+	budget *runBudget
 
 	// Treat $id as an inert annotation during the registry walk: no URI or
 	// anchor registration, no base-URI change, in any form including the
@@ -406,6 +453,11 @@ func newValidator(ctx context.Context, schema *Schema, opts []ValidateOption) (*
 		opt.applyValidate(v)
 	}
 
+	err := v.checkCustomKeywords()
+	if err != nil {
+		return nil, err
+	}
+
 	// Detect draft from $schema field; a WithDraft override wins.
 	draft, err := resolveDraft(schema, v.draftOverride)
 	if err != nil {
@@ -527,6 +579,11 @@ func (v *validator) forInstance(ctx context.Context) *validator {
 	//nolint:contextcheck // See the comment above.
 	rv.refFetch = rv.remoteFetch(rv.refSession, true)
 
+	rv.budget = nil
+	if rv.limits.active() {
+		rv.budget = &runBudget{}
+	}
+
 	return &rv
 }
 
@@ -540,7 +597,9 @@ func (v *validator) forInstance(ctx context.Context) *validator {
 //
 // Draft-07 always gets vocab.All; vocabulary is a 2020-12 concept.
 func (v *validator) resolveVocabularies() error {
-	// Draft-07 has no vocabulary concept.
+	// Draft-07 has no vocabulary concept, so every registered custom
+	// vocabulary is active along with the full built-in set (a nil
+	// customVocabs).
 	if !v.profile.vocabularies {
 		v.vocabs = vocab.All()
 
@@ -570,7 +629,21 @@ func (v *validator) resolveVocabularies() error {
 		return nil
 	}
 
-	if uri := vocab.CheckUnknown(rawVocabs); uri != "" {
+	// A registered custom vocabulary is one this run recognizes, so it is
+	// left out of the unknown-vocabulary check and activated by its presence,
+	// exactly as vocab.Resolve activates a standard one.
+	standard := make(map[string]bool, len(rawVocabs))
+	v.customVocabs = map[string]bool{}
+
+	for uri, required := range rawVocabs {
+		if v.registeredVocab(uri) {
+			v.customVocabs[uri] = true
+		} else {
+			standard[uri] = required
+		}
+	}
+
+	if uri := vocab.CheckUnknown(standard); uri != "" {
 		return fmt.Errorf("%w: %s", ErrUnknownVocabulary, uri)
 	}
 
@@ -585,7 +658,7 @@ func (v *validator) resolveVocabularies() error {
 		}
 	}
 
-	v.vocabs = vocab.Resolve(rawVocabs)
+	v.vocabs = vocab.Resolve(standard)
 
 	return nil
 }
@@ -610,20 +683,6 @@ func (v *validator) resolveFormats() {
 	}
 }
 
-// precomputedBounds holds the numeric bound keywords of a schema as rationals,
-// converted once at Compile time so validateNumeric and validateNumericUnbounded
-// reuse them instead of re-parsing the float64 bounds on every numeric instance.
-// A nil field denotes either an absent keyword or a NaN/Inf bound that has no
-// rational form (mirroring [numrat.Float64ToRat]). The rationals are operands only:
-// comparisons read them and never mutate them.
-type precomputedBounds struct {
-	multipleOf       *big.Rat
-	minimum          *big.Rat
-	maximum          *big.Rat
-	exclusiveMinimum *big.Rat
-	exclusiveMaximum *big.Rat
-}
-
 // compiledPattern caches the result of compiling a regular expression pattern at
 // Compile time. It records the compiled regexp or, when the pattern is one Go's
 // RE2 engine rejects, the compile error, so validation reproduces the same
@@ -660,6 +719,7 @@ func (v *validator) sizeCaches(n int) {
 	v.sortedPatternKeys = growSlice(v.sortedPatternKeys, n)
 	v.itemsPlans = growSlice(v.itemsPlans, n)
 	v.depKeys = growSlice(v.depKeys, n)
+	v.customEvals = growSlice(v.customEvals, n)
 }
 
 // growSlice returns s extended to length n with zero-value elements, or s
@@ -817,37 +877,12 @@ func dependencyKeysFor[T any](
 	return slices.Sorted(maps.Keys(deps))
 }
 
-// computeBounds converts a schema's numeric bound keywords to rationals,
-// returning nil when the schema sets none of them so the cache holds an entry
+// computeBounds returns the [numcheck.Bounds] for a schema's numeric bound
+// keywords, nil when the schema sets none of them so the cache holds an entry
 // only for schemas that constrain numbers.
-func computeBounds(schema *Schema) *precomputedBounds {
-	if schema.MultipleOf == nil && schema.Minimum == nil && schema.Maximum == nil &&
-		schema.ExclusiveMinimum == nil && schema.ExclusiveMaximum == nil {
-		return nil
-	}
-
-	b := &precomputedBounds{}
-	if schema.MultipleOf != nil {
-		b.multipleOf = numrat.Float64ToRat(*schema.MultipleOf)
-	}
-
-	if schema.Minimum != nil {
-		b.minimum = numrat.Float64ToRat(*schema.Minimum)
-	}
-
-	if schema.Maximum != nil {
-		b.maximum = numrat.Float64ToRat(*schema.Maximum)
-	}
-
-	if schema.ExclusiveMinimum != nil {
-		b.exclusiveMinimum = numrat.Float64ToRat(*schema.ExclusiveMinimum)
-	}
-
-	if schema.ExclusiveMaximum != nil {
-		b.exclusiveMaximum = numrat.Float64ToRat(*schema.ExclusiveMaximum)
-	}
-
-	return b
+func computeBounds(schema *Schema) *numcheck.Bounds {
+	return numcheck.New(schema.MultipleOf, schema.Minimum, schema.Maximum,
+		schema.ExclusiveMinimum, schema.ExclusiveMaximum)
 }
 
 // runContext returns the context of the current compile or validation run
@@ -1300,8 +1335,14 @@ func Compile(ctx context.Context, schema *Schema, opts ...ValidateOption) (*Vali
 	// Precompute derived per-node state (numeric bounds and compiled patterns)
 	// into the id-indexed caches while still single-threaded, so the
 	// returned Validator only reads these caches once shared across goroutines.
+	// The same pass compiles the custom keywords, whose first failure fails
+	// compilation.
 	v.precompute()
 
+	if v.compileErr != nil {
+		return nil, v.compileErr
+	}
+
 	// Structural pre-validation via Schema.Resolve.
 	// A Loader is always provided so Schema.Resolve doesn't fail on remote
 	// refs. When a RefResolver is configured, it is called during loading
@@ -1407,6 +1448,10 @@ func Compile(ctx context.Context, schema *Schema, opts ...ValidateOption) (*Vali
 		if from < v.index.len() {
 			v.sizeCaches(v.index.len())
 			v.precomputeRange(from, v.index.len())
+
+			if v.compileErr != nil {
+				return nil, v.compileErr
+			}
 		}
 	}
 
@@ -1882,21 +1927,37 @@ func normalizeAndCheck(instance any) (any, error) {
 // [Validate] entry point calls it directly so an instance it already normalized
 // is not walked a second time.
 func (c *Validator) validateNormalized(ctx context.Context, instance any) error {
+	err := c.proto.limits.checkInstance(instance)
+	if err != nil {
+		return err
+	}
+
 	v := c.proto.forInstance(ctx)
 
 	// The run context reaches the resolver through the per-run ctx field set
 	// by forInstance: the recursive walk cannot thread a parameter.
 	//nolint:contextcheck // See the comment above.
 	errs := v.validate(v.root, instance, instanceLocation{}, schemaLocation{}, nil)
-	if len(errs) == 0 {
-		return nil
+
+	var result *ValidationError
+
+	switch len(errs) {
+	case 0:
+	case 1:
+		result = errs[0]
+	default:
+		result = &ValidationError{Causes: errs}
 	}
 
-	if len(errs) == 1 {
-		return errs[0]
+	if b := v.budget; b != nil && b.exceeded != "" {
+		return &LimitError{Partial: result, Limit: b.exceeded, Max: v.limits.limitMax(b.exceeded)}
 	}
 
-	return &ValidationError{Causes: errs}
+	if result == nil {
+		return nil
+	}
+
+	return result
 }
 
 // ValidateJSON decodes data as a JSON instance (numbers as [json.Number]) and
@@ -1913,35 +1974,67 @@ func (c *Validator) ValidateJSON(ctx context.Context, data []byte) error {
 	return c.Validate(ctx, instance)
 }
 
-// ValidateValue marshals v with encoding/json and validates its JSON form
-// against the compiled schema. It accepts the Go values [Validator.Validate]
-// rejects, namely structs and other types encoding/json can marshal, so an
-// instance of the very type a schema was generated for validates in one
-// call. What is validated is the value's marshaled form, exactly what a JSON
-// consumer of the value would see: json tags, omitempty and omitzero, and
-// MarshalJSON implementations all apply. A non-pointer v is marshaled
-// through a pointer to a copy, so pointer-receiver MarshalJSON and
-// MarshalText implementations (big.Int's, for example) apply exactly as
-// they would for &v -- generation resolves marshalers through the type's
-// full method set, and ValidateValue(ctx, v) and ValidateValue(ctx, &v)
-// validate the same JSON form. The bytes are decoded back with the
-// [Validator.ValidateJSON] discipline (numbers as [json.Number]).
+// ValidateValue validates the JSON form of v against the compiled schema. It
+// accepts the Go values [Validator.Validate] rejects, namely structs and other
+// types encoding/json can marshal, so an instance of the very type a schema
+// was generated for validates in one call. What is validated is exactly what
+// a JSON consumer of the value would see -- the value encoding/json marshals,
+// decoded with the [Validator.ValidateJSON] discipline (numbers as
+// [json.Number]) -- with json tags, omitempty and omitzero, the ,string
+// option, and MarshalJSON and MarshalText implementations all applied.
+//
+// The JSON form is computed by walking v with reflection under
+// encoding/json's rules rather than by marshaling and re-decoding it: only a
+// MarshalJSON or MarshalText method produces bytes, which are decoded in
+// place. The per-type walk is planned once per [reflect.Type] and cached. A
+// non-pointer v is walked through a pointer to a copy, so pointer-receiver
+// MarshalJSON and MarshalText implementations (big.Int's, for example) apply
+// exactly as they would for &v -- generation resolves marshalers through the
+// type's full method set, and ValidateValue(ctx, v) and ValidateValue(ctx, &v)
+// validate the same JSON form.
+//
+// Under [WithFieldNaming] the walk follows the dialect's rules instead, so a
+// value validates as yaml.v3 or encoding/json/v2 would encode it, and
+// [WithMarshaler] replaces the walk with a call to a real encoder.
 //
 // Returns nil on success or an error that can be unwrapped to
 // [*ValidationError] via [errors.AsType]. A value encoding/json cannot marshal
-// returns the wrapped marshal error, which does not unwrap to
-// [*ValidationError]; this covers channels, cyclic values, and unsupported
-// floats.
+// returns the marshal error encoding/json would return, wrapped, which does
+// not unwrap to [*ValidationError]; this covers channels, cyclic values, and
+// unsupported floats.
 //
 // The context is passed to the [RefResolver] for remote refs reached during
 // this validation run (see [Validator.Validate]).
 func (c *Validator) ValidateValue(ctx context.Context, v any) error {
-	data, err := json.Marshal(addressableInstance(v))
+	v = addressableInstance(v)
+
+	instance, err := c.instanceOf(v)
 	if err != nil {
 		return fmt.Errorf("marshal instance: %w", err)
 	}
 
-	return c.ValidateJSON(ctx, data)
+	err = c.Validate(ctx, instance)
+
+	if ve, ok := errors.AsType[*ValidationError](err); ok && c.proto.goFieldPaths {
+		newGoPathResolver(v, c.proto.naming).annotate(ve, map[*ValidationError]bool{})
+	}
+
+	return err
+}
+
+// instanceOf returns the JSON instance of Go value v: the walk under the
+// naming dialect, or the decoded output of the WithMarshaler encoder.
+func (c *Validator) instanceOf(v any) (any, error) {
+	if c.proto.marshal == nil {
+		return jsonvalue.OfDialect(v, c.proto.naming) //nolint:wrapcheck // ValidateValue wraps it.
+	}
+
+	data, err := c.proto.marshal(v)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // ValidateValue wraps it.
+	}
+
+	return normalize.DecodeJSONInstance(data) //nolint:wrapcheck // ValidateValue wraps it.
 }
 
 // addressableInstance returns v, or a pointer to a copy of v when v is not
@@ -2217,7 +2310,7 @@ func (v *validator) validate(
 	schemaPath schemaLocation,
 	ann *annotations.Set,
 ) []*ValidationError {
-	if schema == nil {
+	if schema == nil || !v.enterNode() {
 		return nil
 	}
 
@@ -2262,9 +2355,11 @@ func (v *validator) validate(
 		}
 	}
 
-	// If this schema uses unevaluated* keywords but the caller didn't provide
+	// If this schema uses unevaluated* keywords (or a custom keyword, whose
+	// context exposes its siblings' annotations) but the caller didn't provide
 	// annotations, create a local annotations object to track evaluated items.
-	if ann == nil && (schema.UnevaluatedProperties != nil || schema.UnevaluatedItems != nil) {
+	if ann == nil && (schema.UnevaluatedProperties != nil || schema.UnevaluatedItems != nil ||
+		v.hasCustomKeyword(schema)) {
 		ann = annotations.New()
 	}
 
@@ -2297,7 +2392,13 @@ func (v *validator) validate(
 			continue
 		}
 
-		errs = append(errs, e.eval(ctx)...)
+		before := v.failureCount()
+		rowErrs := e.eval(ctx)
+		errs = append(errs, rowErrs...)
+
+		if v.afterKeyword(before, rowErrs) {
+			break
+		}
 	}
 
 	return errs
@@ -2569,7 +2670,7 @@ func (v *validator) inIndex(id int) bool {
 // per-node cache and converting on the fly for a schema outside the index
 // (a remote or JSON-pointer fallback schema reached only at validation time).
 // The returned rationals are operands only; callers must not mutate them.
-func (v *validator) boundsFor(id int, schema *Schema) *precomputedBounds {
+func (v *validator) boundsFor(id int, schema *Schema) *numcheck.Bounds {
 	if v.inIndex(id) && v.numericBounds[id] != nil {
 		return v.numericBounds[id]
 	}
@@ -2628,251 +2729,20 @@ func (v *validator) patternPropertyFor(id int, pattern string) compiledPattern {
 	return compiledPattern{re: re, err: err}
 }
 
-// evalNumeric checks numeric keywords.
+// evalNumeric checks the numeric bound keywords through the node's
+// [numcheck.Bounds], one leaf error per violated bound sharing the instance
+// path and keyword schema-path location.
 func evalNumeric(ctx evalContext) []*ValidationError {
-	v, schema, instance := ctx.v, ctx.schema, ctx.instance
-	instancePath, schemaPath := ctx.instancePath, ctx.schemaPath
-	nodeID := ctx.nodeID
-
-	if !numrat.IsNumeric(instance) {
+	bounds := ctx.v.boundsFor(ctx.nodeID, ctx.schema)
+	if bounds == nil {
 		return nil
 	}
 
-	// Decompose a JSON number exactly once. An over-cap literal (the DoS guard)
-	// takes the magnitude-class comparison without a second scan of the literal;
-	// an unparseable one has no value to compare and fails every present bound
-	// keyword closed; an exactly-comparable one yields the rational the bounded
-	// checks use. A float64 (the default) converts through its shortest
-	// decimal, and a non-finite float yields no rational and likewise fails
-	// closed.
-	var val *big.Rat
-
-	switch n := instance.(type) {
-	case json.Number:
-		d, ok := numrat.ParseDecNumber(string(n))
-		if !ok {
-			return validateNumericNonComparable(
-				schema, fmt.Sprintf("%q", string(n)), instancePath, schemaPath)
-		}
-
-		if !d.ExactlyComparable() {
-			return v.validateNumericUnbounded(nodeID, schema, d, string(n), instancePath, schemaPath)
-		}
-
-		val = d.Rat()
-
-	default:
-		var ok bool
-
-		val, ok = numrat.ToBigRat(instance)
-		if !ok {
-			return validateNumericNonComparable(
-				schema, fmt.Sprintf("%v", instance), instancePath, schemaPath)
-		}
-	}
-
-	var errs []*ValidationError
-
-	// One error per failed bound, sharing the instance path and keyword
-	// schema-path location.
-	add := func(keyword, msg string) {
-		errs = append(errs, leafError(instancePath, schemaPath, keyword, msg))
-	}
-
-	bounds := v.boundsFor(nodeID, schema)
-
-	if schema.MultipleOf != nil {
-		switch {
-		case *schema.MultipleOf <= 0:
-			// MultipleOf MUST be strictly greater than 0; a non-positive
-			// divisor makes the schema invalid. Compile rejects it with
-			// [ErrNonPositiveMultipleOf] wherever its vetting reaches, so
-			// this is a backstop for a schema outside that coverage.
-			add(KeywordMultipleOf, fmt.Sprintf("multipleOf must be greater than 0, got %v", *schema.MultipleOf))
-
-		default:
-			// A NaN/Inf divisor has no rational form (numrat.Float64ToRat returns
-			// nil); the constraint cannot apply, so skip it rather than
-			// dividing by a nil *big.Rat. Quo writes its own receiver, so the
-			// cached divisor stays an operand and is never mutated.
-			divisor := bounds.multipleOf
-			if divisor != nil {
-				quotient := new(big.Rat).Quo(val, divisor)
-				if !quotient.IsInt() {
-					add(
-						KeywordMultipleOf,
-						fmt.Sprintf("%s is not a multiple of %v", numrat.RatString(val), *schema.MultipleOf),
-					)
-				}
-			}
-		}
-	}
-
-	// A nil bound denotes a NaN/Inf value with no rational form; such a bound
-	// cannot constrain a finite instance, so the comparison is skipped.
-	if schema.Minimum != nil {
-		if bound := bounds.minimum; bound != nil && val.Cmp(bound) < 0 {
-			add(KeywordMinimum, fmt.Sprintf("%s is less than %v", numrat.RatString(val), *schema.Minimum))
-		}
-	}
-
-	if schema.Maximum != nil {
-		if bound := bounds.maximum; bound != nil && val.Cmp(bound) > 0 {
-			add(KeywordMaximum, fmt.Sprintf("%s is greater than %v", numrat.RatString(val), *schema.Maximum))
-		}
-	}
-
-	if schema.ExclusiveMinimum != nil {
-		if bound := bounds.exclusiveMinimum; bound != nil && val.Cmp(bound) <= 0 {
-			add(
-				KeywordExclusiveMinimum,
-				fmt.Sprintf("%s is less than or equal to %v", numrat.RatString(val), *schema.ExclusiveMinimum),
-			)
-		}
-	}
-
-	if schema.ExclusiveMaximum != nil {
-		if bound := bounds.exclusiveMaximum; bound != nil && val.Cmp(bound) >= 0 {
-			add(
-				KeywordExclusiveMaximum,
-				fmt.Sprintf("%s is greater than or equal to %v", numrat.RatString(val), *schema.ExclusiveMaximum),
-			)
-		}
-	}
-
-	return errs
-}
-
-// validateNumericUnbounded checks the numeric bound keywords for a
-// [json.Number] whose exact expansion is too expensive (see numrat.MaxNumberLen): a
-// huge magnitude (exponent above the cap), a tiny magnitude (exponent below
-// the negative cap), or a significand longer than the cap. Every such value
-// still orders deterministically against any float64 bound via
-// [numrat.DecNumber.CmpRat], and equality with a bound is impossible, so the
-// inclusive and exclusive variants of each bound coincide. An over-cap integer
-// still has its multipleOf divisibility enforced through modular arithmetic
-// (see [numrat.IntegerMultipleOf]); only an over-cap non-integer skips it, since
-// expanding its fractional part is unbounded. The schema-validity check (a
-// non-positive divisor) fires regardless. A zero value is always
-// ExactlyComparable, so it never reaches this path.
-func (v *validator) validateNumericUnbounded(
-	id int,
-	schema *Schema,
-	d numrat.DecNumber,
-	literal string,
-	instancePath instanceLocation,
-	schemaPath schemaLocation,
-) []*ValidationError {
-	num := numrat.TruncateNumber(literal)
-
-	var errs []*ValidationError
-
-	add := func(keyword, msg string) {
-		errs = append(errs, leafError(instancePath, schemaPath, keyword, msg))
-	}
-
-	bounds := v.boundsFor(id, schema)
-
-	// A non-positive multipleOf makes the schema invalid independent of the
-	// instance value. For a positive divisor, an over-cap integer's
-	// divisibility is still decidable via modular arithmetic at cost linear in
-	// the literal (see numrat.IntegerMultipleOf), so it is enforced. A
-	// non-integral over-cap value keeps the documented skip: expanding its
-	// fractional part is unbounded.
-	if schema.MultipleOf != nil {
-		switch {
-		case *schema.MultipleOf <= 0:
-			add(KeywordMultipleOf, fmt.Sprintf("multipleOf must be greater than 0, got %v", *schema.MultipleOf))
-		case bounds.multipleOf != nil && d.IsIntegral() &&
-			!numrat.IntegerMultipleOf(d, literal, bounds.multipleOf):
-			add(KeywordMultipleOf, fmt.Sprintf("%s is not a multiple of %v", num, *schema.MultipleOf))
-		}
-	}
-
-	// A nil bound denotes a NaN/Inf value with no rational form; such a bound
-	// cannot constrain a finite instance, so the comparison is skipped. The
-	// comparison reads the bound and never mutates it, so the cached rational
-	// stays shared.
-	if schema.Minimum != nil {
-		if b := bounds.minimum; b != nil && d.CmpRat(b) < 0 {
-			add(KeywordMinimum, fmt.Sprintf("%s is less than %v", num, *schema.Minimum))
-		}
-	}
-
-	if schema.Maximum != nil {
-		if b := bounds.maximum; b != nil && d.CmpRat(b) > 0 {
-			add(KeywordMaximum, fmt.Sprintf("%s is greater than %v", num, *schema.Maximum))
-		}
-	}
-
-	if schema.ExclusiveMinimum != nil {
-		// On the unbounded path CmpRat never reports equality (an over-cap value
-		// cannot equal the finite float64 bound), so the violation is always a
-		// strict inequality; the message omits the "or equal to" the bounded path
-		// uses, where equality is reachable.
-		if b := bounds.exclusiveMinimum; b != nil && d.CmpRat(b) < 0 {
-			add(KeywordExclusiveMinimum, fmt.Sprintf("%s is less than %v", num, *schema.ExclusiveMinimum))
-		}
-	}
-
-	if schema.ExclusiveMaximum != nil {
-		if b := bounds.exclusiveMaximum; b != nil && d.CmpRat(b) > 0 {
-			add(KeywordExclusiveMaximum, fmt.Sprintf("%s is greater than %v", num, *schema.ExclusiveMaximum))
-		}
-	}
-
-	return errs
-}
-
-// validateNumericNonComparable reports every numeric bound keyword present on
-// the schema as violated by an instance that carries no numeric value to
-// compare: a non-finite float64 (NaN or an infinity, which JSON cannot
-// represent) or a [json.Number] whose literal is not a valid JSON number. Such
-// a value still passes a bare type assertion (normalize admits both as
-// number-shaped leaves), but a bound written to constrain a number fails
-// closed against it rather than being silently skipped: +Inf under a maximum,
-// or an unparseable literal under a minimum, must not validate. A
-// non-positive multipleOf keeps its schema-validity message, which is
-// independent of the instance value.
-func validateNumericNonComparable(
-	schema *Schema,
-	desc string,
-	instancePath instanceLocation,
-	schemaPath schemaLocation,
-) []*ValidationError {
 	var errs []*ValidationError
 
-	add := func(keyword, msg string) {
-		errs = append(errs, leafError(instancePath, schemaPath, keyword, msg))
-	}
-
-	noValue := func(keyword string) {
-		add(keyword, fmt.Sprintf("%s has no numeric value to compare with %s", desc, keyword))
-	}
-
-	if schema.MultipleOf != nil {
-		if *schema.MultipleOf <= 0 {
-			add(KeywordMultipleOf, fmt.Sprintf("multipleOf must be greater than 0, got %v", *schema.MultipleOf))
-		} else {
-			noValue(KeywordMultipleOf)
-		}
-	}
-
-	if schema.Minimum != nil {
-		noValue(KeywordMinimum)
-	}
-
-	if schema.Maximum != nil {
-		noValue(KeywordMaximum)
-	}
-
-	if schema.ExclusiveMinimum != nil {
-		noValue(KeywordExclusiveMinimum)
-	}
-
-	if schema.ExclusiveMaximum != nil {
-		noValue(KeywordExclusiveMaximum)
-	}
+	bounds.Check(ctx.instance, func(keyword, msg string) {
+		errs = append(errs, leafError(ctx.instancePath, ctx.schemaPath, keyword, msg))
+	})
 
 	return errs
 }
@@ -3081,6 +2951,8 @@ func evalContains(ctx evalContext) []*ValidationError {
 	// rather than rebuilding it on every iteration.
 	containsSchemaPath := schemaPath.kw(KeywordContains)
 
+	leave := v.speculate()
+
 	for i, item := range arr {
 		childErrs := v.validate(schema.Contains, item, instancePath.index(i), containsSchemaPath, nil)
 		if len(childErrs) == 0 {
@@ -3095,6 +2967,12 @@ func evalContains(ctx evalContext) []*ValidationError {
 		}
 	}
 
+	leave()
+
+	if v.halted() {
+		return nil
+	}
+
 	// Only the explicit minContains/maxContains keywords belong to the 2020-12
 	// validation vocabulary; with it disabled they are skipped (not in effect),
 	// while the default minContains=1 floor below still applies, since the
@@ -3518,6 +3396,10 @@ func evalAllOf(ctx evalContext) []*ValidationError {
 		childErrs := v.validate(sub, ctx.instance, instancePath, childSchemaPath, subAnn)
 		if len(childErrs) > 0 {
 			allCauses = append(allCauses, childErrs...)
+
+			if v.stopBranches() {
+				break
+			}
 		} else {
 			subAnns = append(subAnns, subAnn)
 		}
@@ -3551,6 +3433,8 @@ func evalAnyOf(ctx evalContext) []*ValidationError {
 
 	var allCauses []*ValidationError
 
+	leave := v.speculate()
+
 	for i, sub := range schema.AnyOf {
 		subAnn := ann.Child()
 		childSchemaPath := schemaPath.kw(KeywordAnyOf).idx(i)
@@ -3559,11 +3443,22 @@ func evalAnyOf(ctx evalContext) []*ValidationError {
 			matched = true
 
 			ann.Merge(subAnn)
+
+			// The other branches matter only for their annotations.
+			if ann == nil && v.stopBranches() {
+				break
+			}
 		} else {
 			allCauses = append(allCauses, childErrs...)
 		}
 	}
 
+	leave()
+
+	if v.halted() {
+		return nil
+	}
+
 	if !matched {
 		return []*ValidationError{
 			wrapError(instancePath, schemaPath, KeywordAnyOf, "did not validate against any subschema", allCauses),
@@ -3591,6 +3486,8 @@ func evalOneOf(ctx evalContext) []*ValidationError {
 		matchedAnn *annotations.Set
 	)
 
+	leave := v.speculate()
+
 	for i, sub := range schema.OneOf {
 		subAnn := ann.Child()
 		childSchemaPath := schemaPath.kw(KeywordOneOf).idx(i)
@@ -3598,11 +3495,21 @@ func evalOneOf(ctx evalContext) []*ValidationError {
 		if len(childErrs) == 0 {
 			matchCount++
 			matchedAnn = subAnn
+
+			if matchCount > 1 && v.stopBranches() {
+				break
+			}
 		} else {
 			allCauses = append(allCauses, childErrs...)
 		}
 	}
 
+	leave()
+
+	if v.halted() {
+		return nil
+	}
+
 	switch {
 	case matchCount == 0:
 		return []*ValidationError{
@@ -3629,7 +3536,14 @@ func evalNot(ctx evalContext) []*ValidationError {
 		return nil
 	}
 
+	leave := ctx.v.speculate()
 	childErrs := ctx.v.validate(schema.Not, ctx.instance, ctx.instancePath, ctx.schemaPath.kw(KeywordNot), nil)
+	leave()
+
+	if ctx.v.halted() {
+		return nil
+	}
+
 	if len(childErrs) == 0 {
 		return []*ValidationError{
 			leafError(ctx.instancePath, ctx.schemaPath, KeywordNot, "should not validate against the schema"),
@@ -3654,7 +3568,14 @@ func evalIfThenElse(ctx evalContext) []*ValidationError {
 	var errs []*ValidationError
 
 	ifAnn := ann.Child()
+	leave := v.speculate()
 	ifErrs := v.validate(schema.If, instance, instancePath, schemaPath.kw(KeywordIf), ifAnn)
+	leave()
+
+	if v.halted() {
+		return nil
+	}
+
 	ifPassed := len(ifErrs) == 0
 
 	if ifPassed {
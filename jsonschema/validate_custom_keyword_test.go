@@ -0,0 +1,343 @@
+package jsonschema_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+const uniqueByVocab = "https://example.com/vocab/unique-by"
+
+var errDuplicate = errors.New("duplicate key")
+
+// uniqueBy implements "x-unique-by": the instance array's object items must
+// carry distinct values under the named property.
+var uniqueBy = jsonschema.CustomKeywordFunc(
+	func(_ context.Context, c jsonschema.KeywordCompileContext) (jsonschema.KeywordEvaluator, error) {
+		var prop string
+
+		err := json.Unmarshal(c.Value, &prop)
+		if err != nil {
+			return nil, fmt.Errorf("value must be a property name: %w", err)
+		}
+
+		return jsonschema.KeywordEvaluatorFunc(func(_ context.Context, kc *jsonschema.KeywordContext) error {
+			items, ok := kc.Instance().([]any)
+			if !ok {
+				return nil
+			}
+
+			seen := map[any]bool{}
+
+			for _, item := range items {
+				obj, ok := item.(map[string]any)
+				if !ok {
+					continue
+				}
+
+				key := fmt.Sprint(obj[prop])
+				if seen[key] {
+					return fmt.Errorf("%w %q", errDuplicate, key)
+				}
+
+				seen[key] = true
+			}
+
+			return nil
+		}), nil
+	},
+)
+
+func mustSchema(t *testing.T, doc string) *jsonschema.Schema {
+	t.Helper()
+
+	var s jsonschema.Schema
+
+	require.NoError(t, json.Unmarshal([]byte(doc), &s))
+
+	return &s
+}
+
+func TestValidateCustomKeyword(t *testing.T) {
+	t.Parallel()
+
+	withUniqueBy := jsonschema.WithKeyword(uniqueByVocab, "x-unique-by", uniqueBy)
+
+	tests := map[string]struct {
+		schema   string
+		opts     []jsonschema.ValidateOption
+		instance any
+		valid    bool
+		path     string
+	}{
+		"distinct keys pass": {
+			schema:   `{"x-unique-by": "id"}`,
+			opts:     []jsonschema.ValidateOption{withUniqueBy},
+			instance: []any{map[string]any{"id": "a"}, map[string]any{"id": "b"}},
+			valid:    true,
+		},
+		"duplicate keys fail": {
+			schema:   `{"x-unique-by": "id"}`,
+			opts:     []jsonschema.ValidateOption{withUniqueBy},
+			instance: []any{map[string]any{"id": "a"}, map[string]any{"id": "a"}},
+			path:     "/x-unique-by",
+		},
+		"nested node reports its evaluation path": {
+			schema:   `{"properties": {"list": {"x-unique-by": "id"}}}`,
+			opts:     []jsonschema.ValidateOption{withUniqueBy},
+			instance: map[string]any{"list": []any{map[string]any{"id": 1.0}, map[string]any{"id": 1.0}}},
+			path:     "/properties/list/x-unique-by",
+		},
+		"unregistered keyword is ignored": {
+			schema:   `{"x-unique-by": "id"}`,
+			instance: []any{map[string]any{"id": "a"}, map[string]any{"id": "a"}},
+			valid:    true,
+		},
+		"inactive vocabulary is ignored": {
+			schema: `{"x-unique-by": "id"}`,
+			opts: []jsonschema.ValidateOption{
+				withUniqueBy,
+				jsonschema.WithVocabularies(jsonschema.VocabCore2020, jsonschema.VocabValidation2020),
+			},
+			instance: []any{map[string]any{"id": "a"}, map[string]any{"id": "a"}},
+			valid:    true,
+		},
+		"vocabulary listed by WithVocabularies is active": {
+			schema: `{"x-unique-by": "id"}`,
+			opts: []jsonschema.ValidateOption{
+				withUniqueBy,
+				jsonschema.WithVocabularies(jsonschema.VocabCore2020, uniqueByVocab),
+			},
+			instance: []any{map[string]any{"id": "a"}, map[string]any{"id": "a"}},
+			path:     "/x-unique-by",
+		},
+		"draft-07 evaluates every registered keyword": {
+			schema:   `{"$schema": "http://json-schema.org/draft-07/schema#", "x-unique-by": "id"}`,
+			opts:     []jsonschema.ValidateOption{withUniqueBy},
+			instance: []any{map[string]any{"id": "a"}, map[string]any{"id": "a"}},
+			path:     "/x-unique-by",
+		},
+		"draft-07 $ref suppresses the keyword with its other siblings": {
+			schema: `{
+				"$schema": "http://json-schema.org/draft-07/schema#",
+				"definitions": {"any": {}},
+				"$ref": "#/definitions/any",
+				"x-unique-by": "id"
+			}`,
+			opts:     []jsonschema.ValidateOption{withUniqueBy},
+			instance: []any{map[string]any{"id": "a"}, map[string]any{"id": "a"}},
+			valid:    true,
+		},
+		"2020-12 $ref sibling is evaluated": {
+			schema: `{
+				"$defs": {"any": {}},
+				"$ref": "#/$defs/any",
+				"x-unique-by": "id"
+			}`,
+			opts:     []jsonschema.ValidateOption{withUniqueBy},
+			instance: []any{map[string]any{"id": "a"}, map[string]any{"id": "a"}},
+			path:     "/x-unique-by",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := jsonschema.Validate(t.Context(), mustSchema(t, tc.schema), tc.instance, tc.opts...)
+			if tc.valid {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			require.ErrorIs(t, err, errDuplicate)
+
+			var ve *jsonschema.ValidationError
+
+			require.ErrorAs(t, err, &ve)
+
+			leaf := ve
+			for len(leaf.Causes) > 0 {
+				leaf = leaf.Causes[0]
+			}
+
+			assert.Equal(t, "x-unique-by", leaf.Keyword)
+			assert.Equal(t, tc.path, leaf.SchemaPath)
+		})
+	}
+}
+
+func TestValidateCustomKeywordMetaSchemaVocabulary(t *testing.T) {
+	t.Parallel()
+
+	const metaURI = "https://example.com/meta/unique-by"
+
+	meta := func(required bool) jsonschema.RefResolver {
+		return jsonschema.RefResolverFunc(func(_ context.Context, uri string) (*jsonschema.Schema, error) {
+			if uri != metaURI {
+				return nil, jsonschema.ErrNotResolved
+			}
+
+			return &jsonschema.Schema{Vocabulary: map[string]bool{
+				jsonschema.VocabCore2020:       true,
+				jsonschema.VocabApplicator2020: true,
+				uniqueByVocab:                  required,
+			}}, nil
+		})
+	}
+
+	dup := []any{map[string]any{"id": "a"}, map[string]any{"id": "a"}}
+	schema := mustSchema(t, `{"$schema": "`+metaURI+`", "x-unique-by": "id"}`)
+
+	t.Run("required vocabulary is recognized once registered", func(t *testing.T) {
+		t.Parallel()
+
+		err := jsonschema.Validate(t.Context(), schema, dup,
+			jsonschema.WithMetaSchemaResolver(meta(true)),
+			jsonschema.WithKeyword(uniqueByVocab, "x-unique-by", uniqueBy))
+		require.ErrorIs(t, err, errDuplicate)
+	})
+
+	t.Run("optional vocabulary is active", func(t *testing.T) {
+		t.Parallel()
+
+		err := jsonschema.Validate(t.Context(), schema, dup,
+			jsonschema.WithMetaSchemaResolver(meta(false)),
+			jsonschema.WithKeyword(uniqueByVocab, "x-unique-by", uniqueBy))
+		require.ErrorIs(t, err, errDuplicate)
+	})
+
+	t.Run("required vocabulary is unknown without the registration", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := jsonschema.Compile(t.Context(), schema, jsonschema.WithMetaSchemaResolver(meta(true)))
+		require.ErrorIs(t, err, jsonschema.ErrUnknownVocabulary)
+	})
+
+	t.Run("vocabulary absent from the metaschema is inactive", func(t *testing.T) {
+		t.Parallel()
+
+		err := jsonschema.Validate(t.Context(), schema, dup,
+			jsonschema.WithMetaSchemaResolver(meta(false)),
+			jsonschema.WithKeyword("https://example.com/vocab/other", "x-unique-by", uniqueBy))
+		require.NoError(t, err)
+	})
+}
+
+func TestCompileCustomKeywordErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		schema string
+		opt    jsonschema.ValidateOption
+		want   string
+	}{
+		"built-in keyword name": {
+			schema: `{}`,
+			opt:    jsonschema.WithKeyword(uniqueByVocab, "minimum", uniqueBy),
+			want:   `"minimum" is a built-in keyword`,
+		},
+		"compile failure names the keyword and node": {
+			schema: `{"properties": {"list": {"x-unique-by": 5}}}`,
+			opt:    jsonschema.WithKeyword(uniqueByVocab, "x-unique-by", uniqueBy),
+			want:   `"x-unique-by" at #/properties/list`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := jsonschema.Compile(t.Context(), mustSchema(t, tc.schema), tc.opt)
+			require.ErrorIs(t, err, jsonschema.ErrInvalidKeyword)
+			assert.ErrorContains(t, err, tc.want)
+		})
+	}
+}
+
+func TestValidateCustomKeywordContext(t *testing.T) {
+	t.Parallel()
+
+	// "x-closed" fails on any property no sibling evaluated; "x-a-mark"
+	// annotates and "x-b-read" reads that annotation back.
+	closed := jsonschema.CustomKeywordFunc(
+		func(context.Context, jsonschema.KeywordCompileContext) (jsonschema.KeywordEvaluator, error) {
+			return jsonschema.KeywordEvaluatorFunc(func(_ context.Context, kc *jsonschema.KeywordContext) error {
+				obj, ok := kc.Instance().(map[string]any)
+				if !ok {
+					return nil
+				}
+
+				for name := range obj {
+					if !kc.PropertyEvaluated(name) {
+						return kc.Error(fmt.Sprintf("property %q not evaluated", name))
+					}
+				}
+
+				return nil
+			}), nil
+		},
+	)
+
+	var seen any
+
+	mark := jsonschema.CustomKeywordFunc(
+		func(_ context.Context, c jsonschema.KeywordCompileContext) (jsonschema.KeywordEvaluator, error) {
+			return jsonschema.KeywordEvaluatorFunc(func(_ context.Context, kc *jsonschema.KeywordContext) error {
+				kc.Annotate(string(c.Value))
+
+				return nil
+			}), nil
+		},
+	)
+	read := jsonschema.CustomKeywordFunc(
+		func(context.Context, jsonschema.KeywordCompileContext) (jsonschema.KeywordEvaluator, error) {
+			return jsonschema.KeywordEvaluatorFunc(func(_ context.Context, kc *jsonschema.KeywordContext) error {
+				seen, _ = kc.Annotation("x-a-mark")
+
+				return nil
+			}), nil
+		},
+	)
+
+	opts := []jsonschema.ValidateOption{
+		jsonschema.WithKeyword(uniqueByVocab, "x-closed", closed),
+		jsonschema.WithKeyword(uniqueByVocab, "x-a-mark", mark),
+		jsonschema.WithKeyword(uniqueByVocab, "x-b-read", read),
+	}
+
+	v, err := jsonschema.Compile(t.Context(), mustSchema(t, `{
+		"allOf": [{"properties": {"a": true}, "x-a-mark": "from-allOf"}],
+		"properties": {"b": true},
+		"x-closed": true,
+		"x-b-read": true
+	}`), opts...)
+	require.NoError(t, err)
+
+	require.NoError(t, v.Validate(t.Context(), map[string]any{"a": 1.0, "b": 2.0}))
+	assert.Equal(t, `"from-allOf"`, seen)
+
+	err = v.Validate(t.Context(), map[string]any{"a": 1.0, "c": 3.0})
+	require.Error(t, err)
+
+	var ve *jsonschema.ValidationError
+
+	require.ErrorAs(t, err, &ve)
+
+	leaf := ve
+	for len(leaf.Causes) > 0 {
+		leaf = leaf.Causes[0]
+	}
+
+	assert.Equal(t, "x-closed", leaf.Keyword)
+	assert.Equal(t, `property "c" not evaluated`, leaf.Message)
+}
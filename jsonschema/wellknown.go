@@ -0,0 +1,152 @@
+package jsonschema
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"math"
+	"net"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"time"
+
+	"go.jacobcolvin.com/x/jsonschema/internal/numkind"
+	"go.jacobcolvin.com/x/jsonschema/internal/reflectkind"
+	"go.jacobcolvin.com/x/jsonschema/internal/typename"
+)
+
+// Patterns the well-known types pack matches marshaled text against. They
+// are shape checks, not parsers: an address's octets and groups are left to
+// the ipv4 and ipv6 formats where a format applies, and are only outlined
+// where a pattern has to stand in for one.
+const (
+	// zonedIPv6Pattern matches an IPv6 address with a zone ("fe80::1%eth0"),
+	// which the ipv6 format rejects.
+	zonedIPv6Pattern = `^[0-9A-Fa-f:]*:[0-9A-Fa-f:.]*%.+$`
+	// prefixPattern matches [netip.Prefix] text: an IPv4 or IPv6 CIDR, or
+	// the empty string of the zero Prefix.
+	prefixPattern = `^([0-9]{1,3}(\.[0-9]{1,3}){3}/[0-9]{1,2}|[0-9A-Fa-f:]*:[0-9A-Fa-f:.]*/[0-9]{1,3})?$`
+	// addrPortPattern matches [netip.AddrPort] text: an IPv4 address or a
+	// bracketed, possibly zoned, IPv6 address followed by a port, or the
+	// empty string of an AddrPort with the zero Addr.
+	addrPortPattern = `^(([0-9]{1,3}(\.[0-9]{1,3}){3}|\[[0-9A-Fa-f:]*:[0-9A-Fa-f:.]*(%.+)?\]):[0-9]{1,5})?$`
+	// locationPattern matches an IANA time zone name ("UTC",
+	// "America/Argentina/Buenos_Aires", "Etc/GMT+5").
+	locationPattern = `^[A-Za-z0-9_+-]+(/[A-Za-z0-9_+-]+)*$`
+)
+
+var (
+	typeNetIP         = reflect.TypeFor[net.IP]()
+	typeNetIPAddr     = reflect.TypeFor[netip.Addr]()
+	typeNetIPPrefix   = reflect.TypeFor[netip.Prefix]()
+	typeNetIPAddrPort = reflect.TypeFor[netip.AddrPort]()
+	typeMailAddress   = reflect.TypeFor[mail.Address]()
+	typeFileMode      = reflect.TypeFor[fs.FileMode]()
+	typeURL           = reflect.TypeFor[url.URL]()
+	typeLocation      = reflect.TypeFor[time.Location]()
+)
+
+// WellKnownTypes returns a [TypeSchemaProvider] describing standard library
+// types whose reflected schema misses what encoding/json emits for them.
+// Register it with [WithTypeSchemaProvider]; it answers [ErrTypeNotHandled]
+// for every other type. It maps:
+//
+//   - [netip.Addr] and [net.IP] to a string that is an ipv4 or ipv6 format,
+//     a zoned IPv6 address, or the empty string their zero values marshal
+//     to.
+//   - [netip.Prefix] to a string matching an IPv4 or IPv6 CIDR, or empty.
+//   - [netip.AddrPort] to a string matching an address and port ("1.2.3.4:80",
+//     "[::1]:80"), or empty.
+//   - [net/mail.Address] to the object it reflects as, with the email format
+//     on its Address field.
+//   - [io/fs.FileMode] (and so [os.FileMode]) to the integer mode bits
+//     encoding/json writes, not the "-rw-r--r--" form of its String method.
+//   - A TextMarshaler wrapping [net/url.URL] to a uri-reference string, and
+//     one wrapping [time.Location] to a string matching an IANA zone name.
+//     Neither type marshals as text itself (a URL is the struct object
+//     encoding/json emits, a Location an empty object), so the pack leaves
+//     them to reflection; a wrapper is a type implementing
+//     [encoding.TextMarshaler] directly, not [encoding/json.Marshaler], that
+//     is a defined type of either or a struct whose only field holds one (or
+//     a pointer to one), and is assumed to marshal its String form.
+//
+// The formats are annotations unless the validator asserts them
+// ([WithFormats]). A [netip.Prefix] with out-of-range bits marshals as
+// "invalid Prefix", and a [time.FixedZone] can be named anything; both fall
+// outside their patterns. Like the built-in overrides, entries are matched
+// by exact [reflect.Type] and describe encoding/json's output.
+func WellKnownTypes() TypeSchemaProvider {
+	return wellKnownTypes{}
+}
+
+// wellKnownTypes is the [TypeSchemaProvider] [WellKnownTypes] returns.
+type wellKnownTypes struct{}
+
+func (wellKnownTypes) SchemaForType(_ context.Context, tc TypeContext) (TypeSchema, error) {
+	s := wellKnownSchema(tc.Type)
+	if s == nil {
+		return TypeSchema{}, fmt.Errorf("%w: %s", ErrTypeNotHandled, tc.Type)
+	}
+
+	return TypeSchema{Value: s}, nil
+}
+
+// wellKnownSchema returns the pack's schema for t, or nil.
+func wellKnownSchema(t reflect.Type) *Schema {
+	switch t {
+	case typeNetIPAddr, typeNetIP:
+		return &Schema{
+			Type: typename.String,
+			AnyOf: []*Schema{
+				{Format: "ipv4"},
+				{Format: "ipv6"},
+				{Pattern: zonedIPv6Pattern},
+				{MaxLength: new(0)},
+			},
+		}
+	case typeNetIPPrefix:
+		return &Schema{Type: typename.String, Pattern: prefixPattern}
+	case typeNetIPAddrPort:
+		return &Schema{Type: typename.String, Pattern: addrPortPattern}
+	case typeMailAddress:
+		return &Schema{
+			Type: typename.Object,
+			Properties: map[string]*Schema{
+				"Name":    {Type: typename.String},
+				"Address": {Type: typename.String, Format: "email"},
+			},
+			Required:             []string{"Name", "Address"},
+			AdditionalProperties: &Schema{Not: &Schema{}},
+		}
+	case typeFileMode:
+		return boundedInteger(0, math.MaxUint32)
+	}
+
+	switch {
+	case textWraps(t, typeURL):
+		return &Schema{Type: typename.String, Format: "uri-reference"}
+	case textWraps(t, typeLocation):
+		return &Schema{Type: typename.String, Pattern: locationPattern}
+	}
+
+	return nil
+}
+
+// textWraps reports whether t is a TextMarshaler wrapping target: a type
+// implementing [encoding.TextMarshaler] directly, and not
+// [encoding/json.Marshaler], that is a defined type of target or a struct
+// whose only field is a target or a pointer to one.
+func textWraps(t, target reflect.Type) bool {
+	if t == target || t.Kind() != reflect.Struct ||
+		!reflectkind.IsDirectTextMarshaler(t) || reflectkind.ImplementsJSONMarshaler(t) {
+		return false
+	}
+
+	if t.ConvertibleTo(target) {
+		return true
+	}
+
+	return t.NumField() == 1 && numkind.DerefType(t.Field(0).Type) == target
+}
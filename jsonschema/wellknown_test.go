@@ -0,0 +1,138 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.jacobcolvin.com/x/jsonschema"
+)
+
+// urlText is a defined type of url.URL marshaling its String form.
+type urlText url.URL
+
+func (u *urlText) MarshalText() ([]byte, error) { return []byte((*url.URL)(u).String()), nil }
+
+// urlJSON wraps a URL but marshals through MarshalJSON, which encoding/json
+// prefers, so the pack leaves it alone.
+type urlJSON struct{ *url.URL }
+
+func (urlJSON) MarshalText() ([]byte, error) { return []byte("text"), nil }
+
+func (urlJSON) MarshalJSON() ([]byte, error) { return []byte(`{}`), nil }
+
+// urlPair holds a URL beside another field, so it is not a wrapper.
+type urlPair struct {
+	URL  *url.URL
+	Note string
+}
+
+func (urlPair) MarshalText() ([]byte, error) { return []byte("pair"), nil }
+
+func TestWellKnownTypes(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		typ  reflect.Type
+		want string
+	}{
+		"netip.Addr": {
+			typ:  reflect.TypeFor[netip.Addr](),
+			want: `{"type":"string","anyOf":[{"format":"ipv4"},{"format":"ipv6"},{"pattern":"^[0-9A-Fa-f:]*:[0-9A-Fa-f:.]*%.+$"},{"maxLength":0}]}`,
+		},
+		"netip.Prefix": {
+			typ:  reflect.TypeFor[netip.Prefix](),
+			want: `{"type":"string","pattern":"^([0-9]{1,3}(\\.[0-9]{1,3}){3}/[0-9]{1,2}|[0-9A-Fa-f:]*:[0-9A-Fa-f:.]*/[0-9]{1,3})?$"}`,
+		},
+		"mail.Address": {
+			typ: reflect.TypeFor[mail.Address](),
+			want: `{
+				"type": "object",
+				"properties": {"Name": {"type": "string"}, "Address": {"type": "string", "format": "email"}},
+				"required": ["Name", "Address"],
+				"additionalProperties": false
+			}`,
+		},
+		"os.FileMode": {
+			typ:  reflect.TypeFor[os.FileMode](),
+			want: `{"type":"integer","minimum":0,"maximum":4294967295}`,
+		},
+		"url wrapper": {
+			typ:  reflect.TypeFor[textURL](),
+			want: `{"type":"string","format":"uri-reference"}`,
+		},
+		"defined url": {
+			typ:  reflect.TypeFor[urlText](),
+			want: `{"type":"string","format":"uri-reference"}`,
+		},
+		"location wrapper": {
+			typ:  reflect.TypeFor[textLocation](),
+			want: `{"type":"string","pattern":"^[A-Za-z0-9_+-]+(/[A-Za-z0-9_+-]+)*$"}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ts, err := jsonschema.WellKnownTypes().SchemaForType(t.Context(), jsonschema.TypeContext{Type: tc.typ})
+			require.NoError(t, err)
+
+			got, err := json.Marshal(ts.Value)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestWellKnownTypesNotHandled(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]reflect.Type{
+		"url.URL":        reflect.TypeFor[url.URL](),
+		"time.Location":  reflect.TypeFor[time.Location](),
+		"json marshaler": reflect.TypeFor[urlJSON](),
+		"not a wrapper":  reflect.TypeFor[urlPair](),
+		"string":         reflect.TypeFor[string](),
+	}
+
+	for name, typ := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := jsonschema.WellKnownTypes().SchemaForType(t.Context(), jsonschema.TypeContext{Type: typ})
+			require.ErrorIs(t, err, jsonschema.ErrTypeNotHandled)
+		})
+	}
+}
+
+// TestWellKnownTypesNullablePointer covers a pointer to a pack type, which
+// keeps the null branch reflection gives any pointer.
+func TestWellKnownTypesNullablePointer(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Listen *netip.AddrPort `json:"listen"`
+	}
+
+	s, err := jsonschema.GenerateFor[config](t.Context(),
+		jsonschema.WithTypeSchemaProvider(jsonschema.WellKnownTypes()))
+	require.NoError(t, err)
+
+	v, err := jsonschema.Compile(t.Context(), s)
+	require.NoError(t, err)
+
+	for _, doc := range []string{`{"listen":null}`, `{"listen":"[::1]:8080"}`, `{"listen":""}`} {
+		require.NoError(t, v.ValidateJSON(t.Context(), []byte(doc)), doc)
+	}
+
+	require.Error(t, v.ValidateJSON(t.Context(), []byte(`{"listen":"localhost:8080"}`)))
+}